@@ -29,7 +29,7 @@ func TestNewAccount(t *testing.T) {
 	privateKey := a.PrivateKey
 	public := a.PublicKey()
 
-	a2, err := WalletFromPrivateKeyBase58(privateKey.String())
+	a2, err := WalletFromPrivateKeyBase58(privateKey.Base58())
 	require.NoError(t, err)
 
 	require.Equal(t, privateKey, a2.PrivateKey)
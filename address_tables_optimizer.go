@@ -0,0 +1,170 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// Serialized cost of referencing an address lookup table in a v0 message:
+// the table address, and the lengths of its writable and readonly index lists.
+const addressTableLookupOverhead = PublicKeyLength + 1 + 1
+
+// AddressTablesOptimization is the result of OptimizeAddressTables.
+type AddressTablesOptimization struct {
+	// The compiled v0 transaction, without signatures.
+	Transaction *Transaction
+	// The tables referenced by the transaction, among the candidates.
+	Tables map[PublicKey]PublicKeySlice
+	// Serialized size of the signed transaction.
+	Size int
+	// Serialized size of the signed transaction without lookup tables.
+	BaselineSize int
+	// Bytes saved by the lookup tables (BaselineSize - Size).
+	Savings int
+}
+
+// OptimizeAddressTables chooses, among candidate address lookup tables, the ones
+// to reference to minimize the serialized size of the transaction, and compiles
+// the transaction into a v0 message.
+//
+// Each account loaded from a table costs 1 byte instead of 32, but each referenced
+// table costs 34 bytes, so a table is only worth referencing if it holds enough of
+// the accounts; the tables are chosen greedily by the bytes they save.
+// Signers, the fee payer and the invoked programs cannot be loaded from tables.
+func OptimizeAddressTables(
+	instructions []Instruction,
+	recentBlockHash Hash,
+	payer PublicKey,
+	candidates map[PublicKey]PublicKeySlice,
+) (*AddressTablesOptimization, error) {
+	// Accounts that can be loaded from a table.
+	eligible := make(map[PublicKey]struct{})
+	excluded := map[PublicKey]struct{}{payer: {}}
+	for _, instruction := range instructions {
+		excluded[instruction.ProgramID()] = struct{}{}
+		for _, account := range instruction.Accounts() {
+			if account.IsSigner {
+				excluded[account.PublicKey] = struct{}{}
+			}
+		}
+	}
+	for _, instruction := range instructions {
+		for _, account := range instruction.Accounts() {
+			if _, ok := excluded[account.PublicKey]; !ok {
+				eligible[account.PublicKey] = struct{}{}
+			}
+		}
+	}
+
+	// Sorted for deterministic choices between equivalent tables.
+	tableIDs := make(PublicKeySlice, 0, len(candidates))
+	for tableID, addresses := range candidates {
+		if len(addresses) > 255 {
+			return nil, fmt.Errorf("max lookup table index exceeded for %s table", tableID)
+		}
+		tableIDs = append(tableIDs, tableID)
+	}
+	sort.Slice(tableIDs, func(i, j int) bool {
+		return bytes.Compare(tableIDs[i][:], tableIDs[j][:]) < 0
+	})
+
+	chosen := make(map[PublicKey]PublicKeySlice)
+	for len(eligible) > 0 {
+		var best PublicKey
+		bestGain := 0
+		for _, tableID := range tableIDs {
+			if _, ok := chosen[tableID]; ok {
+				continue
+			}
+			covered := 0
+			seen := make(map[PublicKey]struct{})
+			for _, address := range candidates[tableID] {
+				if _, ok := eligible[address]; !ok {
+					continue
+				}
+				if _, ok := seen[address]; ok {
+					continue
+				}
+				seen[address] = struct{}{}
+				covered++
+			}
+			gain := covered*(PublicKeyLength-1) - addressTableLookupOverhead
+			if gain > bestGain {
+				best, bestGain = tableID, gain
+			}
+		}
+		if bestGain <= 0 {
+			break
+		}
+		chosen[best] = candidates[best]
+		for _, address := range candidates[best] {
+			delete(eligible, address)
+		}
+	}
+
+	baseline, err := NewTransaction(instructions, recentBlockHash, TransactionPayer(payer))
+	if err != nil {
+		return nil, err
+	}
+	baseline.Message.SetVersion(MessageVersionV0)
+	baselineSize, err := signedTransactionSize(baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := baseline
+	size := baselineSize
+	if len(chosen) > 0 {
+		tx, err = NewTransaction(instructions, recentBlockHash, TransactionPayer(payer), TransactionAddressTables(chosen))
+		if err != nil {
+			return nil, err
+		}
+		tx.Message.SetVersion(MessageVersionV0)
+		size, err = signedTransactionSize(tx)
+		if err != nil {
+			return nil, err
+		}
+		// Tables holding only accounts assigned to other
+		// tables are not referenced by the message.
+		referenced := make(map[PublicKey]PublicKeySlice)
+		for _, lookup := range tx.Message.GetAddressTableLookups() {
+			referenced[lookup.AccountKey] = chosen[lookup.AccountKey]
+		}
+		chosen = referenced
+	}
+
+	return &AddressTablesOptimization{
+		Transaction:  tx,
+		Tables:       chosen,
+		Size:         size,
+		BaselineSize: baselineSize,
+		Savings:      baselineSize - size,
+	}, nil
+}
+
+// signedTransactionSize returns the serialized size
+// of the transaction once all its signatures are set.
+func signedTransactionSize(tx *Transaction) (int, error) {
+	signed := *tx
+	signed.Signatures = make([]Signature, tx.Message.Header.NumRequiredSignatures)
+	data, err := signed.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizeAddressTables(t *testing.T) {
+	payer := PublicKey{1}
+	program := PublicKey{2}
+	var accounts PublicKeySlice
+	metas := AccountMetaSlice{Meta(payer).SIGNER().WRITE()}
+	for i := 0; i < 10; i++ {
+		account := PublicKey{10, byte(i)}
+		accounts = append(accounts, account)
+		meta := Meta(account)
+		if i%2 == 0 {
+			meta.WRITE()
+		}
+		metas = append(metas, meta)
+	}
+	instructions := []Instruction{NewInstruction(program, metas, []byte{1, 2, 3})}
+
+	big := PublicKey{20}
+	small := PublicKey{21}
+	rest := PublicKey{22}
+	candidates := map[PublicKey]PublicKeySlice{
+		// Most of the accounts, plus the payer and the program which cannot be looked up.
+		big: append(PublicKeySlice{payer, program}, accounts[:8]...),
+		// A single account: not worth the table overhead.
+		small: {accounts[8]},
+		// Two accounts already in the big table, and the last two.
+		rest: {accounts[0], accounts[1], accounts[8], accounts[9]},
+	}
+
+	out, err := OptimizeAddressTables(instructions, Hash{}, payer, candidates)
+	require.NoError(t, err)
+	require.Equal(t, map[PublicKey]PublicKeySlice{big: candidates[big], rest: candidates[rest]}, out.Tables)
+	require.True(t, out.Transaction.Message.IsVersioned())
+	require.Equal(t, out.BaselineSize-out.Size, out.Savings)
+	// 10 accounts move to the tables (31 bytes saved each), 2 tables are referenced (34 bytes each).
+	require.Equal(t, 10*31-2*34, out.Savings)
+
+	size, err := signedTransactionSize(out.Transaction)
+	require.NoError(t, err)
+	require.Equal(t, out.Size, size)
+
+	// The compiled message resolves to the original accounts.
+	require.NoError(t, out.Transaction.Message.ResolveLookups())
+	resolved, err := out.Transaction.Message.Instructions[0].ResolveInstructionAccounts(&out.Transaction.Message)
+	require.NoError(t, err)
+	for i, meta := range resolved {
+		require.Equal(t, metas[i].PublicKey, meta.PublicKey)
+	}
+
+	// No table worth referencing.
+	out, err = OptimizeAddressTables(instructions, Hash{}, payer, map[PublicKey]PublicKeySlice{small: candidates[small]})
+	require.NoError(t, err)
+	require.Empty(t, out.Tables)
+	require.Equal(t, 0, out.Savings)
+	require.True(t, out.Transaction.Message.IsVersioned())
+	require.Equal(t, 0, out.Transaction.Message.NumLookups())
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package addressbook resolves names entered by users (configured aliases,
+// .sol domains, or names of custom naming services) to public keys.
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ErrNotFound is returned by a Resolver that does not know the name.
+var ErrNotFound = errors.New("name not found")
+
+// Resolver resolves a name to a public key.
+// Names are passed normalized (see Normalize).
+// Resolve must return ErrNotFound (possibly wrapped) if the name is unknown.
+type Resolver interface {
+	Resolve(ctx context.Context, name string) (solana.PublicKey, error)
+}
+
+// ResolverFunc is an adapter to use a function as a Resolver.
+type ResolverFunc func(ctx context.Context, name string) (solana.PublicKey, error)
+
+func (fn ResolverFunc) Resolve(ctx context.Context, name string) (solana.PublicKey, error) {
+	return fn(ctx, name)
+}
+
+type chain []Resolver
+
+// Chain returns a Resolver that tries each of the provided resolvers in order,
+// returning the first match. Errors other than ErrNotFound are returned immediately.
+func Chain(resolvers ...Resolver) Resolver {
+	return chain(resolvers)
+}
+
+func (c chain) Resolve(ctx context.Context, name string) (solana.PublicKey, error) {
+	for _, resolver := range c {
+		key, err := resolver.Resolve(ctx, name)
+		if err == nil {
+			return key, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return solana.PublicKey{}, err
+		}
+	}
+	return solana.PublicKey{}, ErrNotFound
+}
+
+// Suffix returns a Resolver that only passes the names ending with
+// the provided suffix (e.g. ".sol", or the TLD of a custom naming service)
+// to the resolver.
+func Suffix(suffix string, resolver Resolver) Resolver {
+	suffix = strings.ToLower(suffix)
+	return ResolverFunc(func(ctx context.Context, name string) (solana.PublicKey, error) {
+		if !strings.HasSuffix(name, suffix) || len(name) == len(suffix) {
+			return solana.PublicKey{}, ErrNotFound
+		}
+		return resolver.Resolve(ctx, name)
+	})
+}
+
+// Normalize returns the canonical form of a name (trimmed and lowercased),
+// or an error if it is not a valid name.
+func Normalize(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", errors.New("empty name")
+	}
+	for _, r := range name {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return "", fmt.Errorf("invalid character %q in name %q", r, name)
+		}
+	}
+	if strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") || strings.Contains(name, "..") {
+		return "", fmt.Errorf("invalid name %q", name)
+	}
+	return name, nil
+}
+
+// Lookup returns the public key designated by the input, which is either
+// a base58 public key (returned as is) or a name passed to the resolver
+// (e.g. "treasury" or "bonfida.sol").
+func Lookup(ctx context.Context, resolver Resolver, input string) (solana.PublicKey, error) {
+	input = strings.TrimSpace(input)
+	if key, err := solana.PublicKeyFromBase58(input); err == nil {
+		return key, nil
+	}
+	name, err := Normalize(input)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	if resolver == nil {
+		return solana.PublicKey{}, fmt.Errorf("%q: %w", input, ErrNotFound)
+	}
+	key, err := resolver.Resolve(ctx, name)
+	if err != nil {
+		return solana.PublicKey{}, fmt.Errorf("%q: %w", input, err)
+	}
+	if key.IsZero() {
+		return solana.PublicKey{}, fmt.Errorf("%q resolved to the zero address", input)
+	}
+	return key, nil
+}
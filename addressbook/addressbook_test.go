@@ -0,0 +1,223 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addressbook
+
+import (
+	"context"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	treasury = solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	owner    = solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+)
+
+func TestLookup(t *testing.T) {
+	ctx := context.Background()
+	res, err := NewStaticResolver(map[string]solana.PublicKey{"Treasury": treasury})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Len())
+
+	// Public keys are returned as is.
+	key, err := Lookup(ctx, res, owner.String())
+	require.NoError(t, err)
+	require.Equal(t, owner, key)
+	key, err = Lookup(ctx, nil, owner.String())
+	require.NoError(t, err)
+	require.Equal(t, owner, key)
+
+	// Names are normalized.
+	key, err = Lookup(ctx, res, "  TREASURY ")
+	require.NoError(t, err)
+	require.Equal(t, treasury, key)
+
+	_, err = Lookup(ctx, res, "unknown")
+	require.True(t, errors.Is(err, ErrNotFound))
+	_, err = Lookup(ctx, nil, "treasury")
+	require.True(t, errors.Is(err, ErrNotFound))
+
+	for _, invalid := range []string{"", "   ", "two words", "bad..sol", ".sol", "treasury."} {
+		_, err = Lookup(ctx, res, invalid)
+		require.Error(t, err, invalid)
+		require.False(t, errors.Is(err, ErrNotFound), invalid)
+	}
+
+	zero := ResolverFunc(func(ctx context.Context, name string) (solana.PublicKey, error) {
+		return solana.PublicKey{}, nil
+	})
+	_, err = Lookup(ctx, zero, "treasury")
+	require.EqualError(t, err, `"treasury" resolved to the zero address`)
+}
+
+func TestStaticResolver(t *testing.T) {
+	aliases, err := ParseAliases("treasury="+treasury.String(), "ops = "+owner.String())
+	require.NoError(t, err)
+	require.Equal(t, map[string]solana.PublicKey{"treasury": treasury, "ops": owner}, aliases)
+	res, err := NewStaticResolver(aliases)
+	require.NoError(t, err)
+	key, err := res.Resolve(context.Background(), "ops")
+	require.NoError(t, err)
+	require.Equal(t, owner, key)
+
+	_, err = ParseAliases("treasury")
+	require.Error(t, err)
+	_, err = ParseAliases("treasury=nope")
+	require.Error(t, err)
+	require.Error(t, res.Add("zero", solana.PublicKey{}))
+	require.Error(t, res.Add("", treasury))
+}
+
+func TestChainAndSuffix(t *testing.T) {
+	ctx := context.Background()
+	static, err := NewStaticResolver(map[string]solana.PublicKey{"treasury": treasury})
+	require.NoError(t, err)
+	var custom []string
+	ens := ResolverFunc(func(ctx context.Context, name string) (solana.PublicKey, error) {
+		custom = append(custom, name)
+		if name == "vitalik.eth" {
+			return owner, nil
+		}
+		return solana.PublicKey{}, ErrNotFound
+	})
+	res := Chain(static, Suffix(".ETH", ens))
+
+	key, err := Lookup(ctx, res, "treasury")
+	require.NoError(t, err)
+	require.Equal(t, treasury, key)
+	key, err = Lookup(ctx, res, "Vitalik.eth")
+	require.NoError(t, err)
+	require.Equal(t, owner, key)
+	_, err = Lookup(ctx, res, "unknown")
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.Equal(t, []string{"vitalik.eth"}, custom)
+
+	// Other errors stop the chain.
+	failing := ResolverFunc(func(ctx context.Context, name string) (solana.PublicKey, error) {
+		return solana.PublicKey{}, errors.New("boom")
+	})
+	_, err = Chain(failing, static).Resolve(ctx, "treasury")
+	require.EqualError(t, err, "boom")
+}
+
+func TestCachingResolver(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	inner := ResolverFunc(func(ctx context.Context, name string) (solana.PublicKey, error) {
+		calls++
+		if name == "treasury" {
+			return treasury, nil
+		}
+		return solana.PublicKey{}, ErrNotFound
+	})
+	now := time.Unix(1700000000, 0)
+	res := NewCachingResolver(inner, time.Minute, time.Second)
+	res.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		key, err := res.Resolve(ctx, "treasury")
+		require.NoError(t, err)
+		require.Equal(t, treasury, key)
+		_, err = res.Resolve(ctx, "unknown")
+		require.True(t, errors.Is(err, ErrNotFound))
+	}
+	require.Equal(t, 2, calls)
+
+	now = now.Add(2 * time.Second)
+	_, err := res.Resolve(ctx, "unknown")
+	require.True(t, errors.Is(err, ErrNotFound))
+	_, err = res.Resolve(ctx, "treasury")
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	res.Invalidate("treasury")
+	_, err = res.Resolve(ctx, "treasury")
+	require.NoError(t, err)
+	require.Equal(t, 4, calls)
+}
+
+// nameNode is a JSONRPCClient serving name accounts.
+type nameNode struct {
+	accounts map[solana.PublicKey][]byte
+}
+
+func (node *nameNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if method != "getAccountInfo" {
+		return fmt.Errorf("unexpected method %q", method)
+	}
+	var value interface{}
+	if data, ok := node.accounts[params[0].(solana.PublicKey)]; ok {
+		value = rpc.M{
+			"data":       []string{base64.StdEncoding.EncodeToString(data), "base64"},
+			"executable": false,
+			"lamports":   1,
+			"owner":      NameServiceProgramID,
+			"rentEpoch":  0,
+		}
+	}
+	raw, err := stdjson.Marshal(rpc.M{"context": rpc.M{"slot": 1}, "value": value})
+	if err != nil {
+		return err
+	}
+	return stdjson.Unmarshal(raw, out)
+}
+
+func (node *nameNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestSNSResolver(t *testing.T) {
+	ctx := context.Background()
+	domain, err := DomainKey("bonfida.sol")
+	require.NoError(t, err)
+	sub, err := DomainKey("dex.bonfida.sol")
+	require.NoError(t, err)
+	require.NotEqual(t, domain, sub)
+
+	header := func(owner solana.PublicKey) []byte {
+		data := make([]byte, nameRegistryHeaderSize)
+		copy(data[0:32], SolTLDAuthority[:])
+		copy(data[32:64], owner[:])
+		return data
+	}
+	node := &nameNode{accounts: map[solana.PublicKey][]byte{
+		domain: header(owner),
+		sub:    header(treasury),
+	}}
+	res := NewSNSResolver(rpc.NewWithCustomRPCClient(node))
+
+	key, err := Lookup(ctx, res, "Bonfida.sol")
+	require.NoError(t, err)
+	require.Equal(t, owner, key)
+	key, err = Lookup(ctx, res, "dex.bonfida.sol")
+	require.NoError(t, err)
+	require.Equal(t, treasury, key)
+
+	_, err = Lookup(ctx, res, "unknown.sol")
+	require.True(t, errors.Is(err, ErrNotFound))
+	_, err = Lookup(ctx, res, "bonfida")
+	require.True(t, errors.Is(err, ErrNotFound))
+	_, err = DomainKey("a.b.c.sol")
+	require.Error(t, err)
+}
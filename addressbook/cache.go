@@ -0,0 +1,107 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+type cacheEntry struct {
+	key       solana.PublicKey
+	found     bool
+	expiresAt time.Time
+}
+
+// CachingResolver caches the results of another resolver.
+// Unknown names are cached too (for NegativeTTL), so that repeatedly
+// looking up an unknown name does not hit the underlying resolver every time.
+// Other errors are not cached.
+type CachingResolver struct {
+	resolver    Resolver
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+	now     func() time.Time
+}
+
+// NewCachingResolver wraps the provided resolver with a cache;
+// a zero ttl caches forever, and a zero negativeTTL disables the caching of unknown names.
+// Names of on-chain naming services can be transferred, so their ttl should be finite.
+func NewCachingResolver(resolver Resolver, ttl time.Duration, negativeTTL time.Duration) *CachingResolver {
+	return &CachingResolver{
+		resolver:    resolver,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		entries:     make(map[string]cacheEntry),
+		now:         time.Now,
+	}
+}
+
+func (res *CachingResolver) Resolve(ctx context.Context, name string) (solana.PublicKey, error) {
+	now := res.now()
+	res.mu.RLock()
+	entry, ok := res.entries[name]
+	res.mu.RUnlock()
+	if ok && (entry.expiresAt.IsZero() || now.Before(entry.expiresAt)) {
+		if !entry.found {
+			return solana.PublicKey{}, ErrNotFound
+		}
+		return entry.key, nil
+	}
+
+	key, err := res.resolver.Resolve(ctx, name)
+	switch {
+	case err == nil:
+		res.store(name, cacheEntry{key: key, found: true}, res.ttl, now)
+		return key, nil
+	case errors.Is(err, ErrNotFound):
+		if res.negativeTTL > 0 {
+			res.store(name, cacheEntry{}, res.negativeTTL, now)
+		}
+		return solana.PublicKey{}, err
+	default:
+		return solana.PublicKey{}, err
+	}
+}
+
+func (res *CachingResolver) store(name string, entry cacheEntry, ttl time.Duration, now time.Time) {
+	if ttl > 0 {
+		entry.expiresAt = now.Add(ttl)
+	}
+	res.mu.Lock()
+	res.entries[name] = entry
+	res.mu.Unlock()
+}
+
+// Invalidate removes the provided name from the cache.
+func (res *CachingResolver) Invalidate(name string) {
+	res.mu.Lock()
+	delete(res.entries, name)
+	res.mu.Unlock()
+}
+
+// Purge empties the cache.
+func (res *CachingResolver) Purge() {
+	res.mu.Lock()
+	res.entries = make(map[string]cacheEntry)
+	res.mu.Unlock()
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addressbook
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+var (
+	// NameServiceProgramID is the ID of the SPL Name Service program.
+	NameServiceProgramID = solana.MustPublicKeyFromBase58("namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX")
+
+	// SolTLDAuthority is the name account of the .sol top-level domain,
+	// the parent of all the .sol domains.
+	SolTLDAuthority = solana.MustPublicKeyFromBase58("58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx")
+)
+
+const (
+	nameServiceHashPrefix = "SPL Name Service"
+
+	// Size of the header of a name account: parent name, owner and class.
+	nameRegistryHeaderSize = 96
+)
+
+// NameAccountKey returns the address of the name account of the provided
+// name, with the provided class and parent (zero keys if none).
+func NameAccountKey(name string, class solana.PublicKey, parent solana.PublicKey) (solana.PublicKey, error) {
+	hashed := sha256.Sum256([]byte(nameServiceHashPrefix + name))
+	key, _, err := solana.FindProgramAddress(
+		[][]byte{hashed[:], class[:], parent[:]},
+		NameServiceProgramID,
+	)
+	return key, err
+}
+
+// DomainKey returns the address of the name account of a .sol domain
+// (e.g. "bonfida.sol") or sub-domain (e.g. "dex.bonfida.sol").
+func DomainKey(domain string) (solana.PublicKey, error) {
+	labels := strings.Split(strings.TrimSuffix(domain, ".sol"), ".")
+	if len(labels) > 2 {
+		return solana.PublicKey{}, fmt.Errorf("invalid domain %q: too many labels", domain)
+	}
+	for _, label := range labels {
+		if label == "" {
+			return solana.PublicKey{}, fmt.Errorf("invalid domain %q", domain)
+		}
+	}
+	key, err := NameAccountKey(labels[len(labels)-1], solana.PublicKey{}, SolTLDAuthority)
+	if err != nil || len(labels) == 1 {
+		return key, err
+	}
+	// Sub-domains are prefixed with a zero byte.
+	return NameAccountKey("\x00"+labels[0], solana.PublicKey{}, key)
+}
+
+// NewSNSResolver returns a resolver of .sol domains, which resolves
+// a domain to the owner of its name account.
+// Names without the .sol suffix are not found.
+func NewSNSResolver(rpcClient *rpc.Client) Resolver {
+	return Suffix(".sol", ResolverFunc(func(ctx context.Context, name string) (solana.PublicKey, error) {
+		key, err := DomainKey(name)
+		if err != nil {
+			return solana.PublicKey{}, err
+		}
+		data, err := rpcClient.GetAccountDataSlice(ctx, key, 0, nameRegistryHeaderSize)
+		if err != nil {
+			if errors.Is(err, rpc.ErrNotFound) {
+				return solana.PublicKey{}, ErrNotFound
+			}
+			return solana.PublicKey{}, err
+		}
+		if len(data) < nameRegistryHeaderSize {
+			return solana.PublicKey{}, fmt.Errorf("invalid name account %s: %d bytes", key, len(data))
+		}
+		return solana.PublicKeyFromBytes(data[32:64]), nil
+	}))
+}
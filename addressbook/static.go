@@ -0,0 +1,95 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addressbook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// StaticResolver resolves names from an in-memory address book
+// (e.g. aliases loaded from a configuration file).
+// StaticResolver is safe for concurrent use.
+type StaticResolver struct {
+	mu      sync.RWMutex
+	entries map[string]solana.PublicKey
+}
+
+// NewStaticResolver creates a resolver of the provided aliases.
+func NewStaticResolver(aliases map[string]solana.PublicKey) (*StaticResolver, error) {
+	res := &StaticResolver{
+		entries: make(map[string]solana.PublicKey, len(aliases)),
+	}
+	for name, key := range aliases {
+		if err := res.Add(name, key); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// ParseAliases parses aliases in the "name=address" form, e.g. from
+// a command-line flag.
+func ParseAliases(aliases ...string) (map[string]solana.PublicKey, error) {
+	out := make(map[string]solana.PublicKey, len(aliases))
+	for _, alias := range aliases {
+		parts := strings.SplitN(alias, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid alias %q, expected name=address", alias)
+		}
+		key, err := solana.PublicKeyFromBase58(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid address of alias %q: %w", parts[0], err)
+		}
+		out[strings.TrimSpace(parts[0])] = key
+	}
+	return out, nil
+}
+
+// Add adds (or replaces) an alias.
+func (res *StaticResolver) Add(name string, key solana.PublicKey) error {
+	name, err := Normalize(name)
+	if err != nil {
+		return err
+	}
+	if key.IsZero() {
+		return fmt.Errorf("alias %q designates the zero address", name)
+	}
+	res.mu.Lock()
+	res.entries[name] = key
+	res.mu.Unlock()
+	return nil
+}
+
+func (res *StaticResolver) Resolve(ctx context.Context, name string) (solana.PublicKey, error) {
+	res.mu.RLock()
+	key, ok := res.entries[name]
+	res.mu.RUnlock()
+	if !ok {
+		return solana.PublicKey{}, ErrNotFound
+	}
+	return key, nil
+}
+
+// Len returns the number of aliases.
+func (res *StaticResolver) Len() int {
+	res.mu.RLock()
+	defer res.mu.RUnlock()
+	return len(res.entries)
+}
@@ -0,0 +1,373 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package airdrop distributes SOL or tokens to many recipients: the transfers
+// are packed into as few transactions as possible, the missing associated
+// token accounts of the recipients are created, and the progress is recorded
+// in a Receipt, from which an interrupted distribution can be resumed without
+// paying anyone twice.
+package airdrop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	sendandconfirmtransaction "github.com/gagliardetto/solana-go/rpc/sendAndConfirmTransaction"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+// Maximum serialized size of a transaction.
+const maxTransactionSize = 1232
+
+// Maximum number of accounts of a getMultipleAccounts
+// or signatures of a getSignatureStatuses call.
+const maxAccountsPerCall = 100
+
+// Sender sends a transaction, and waits for its confirmation.
+type Sender interface {
+	Send(ctx context.Context, tx *solana.Transaction) (solana.Signature, error)
+}
+
+// SenderFunc is a function implementing the Sender interface.
+type SenderFunc func(ctx context.Context, tx *solana.Transaction) (solana.Signature, error)
+
+func (f SenderFunc) Send(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+	return f(ctx, tx)
+}
+
+// NewSender returns a Sender sending the transactions with sendTransaction,
+// and waiting for their confirmation with a signature subscription.
+func NewSender(rpcClient *rpc.Client, wsClient *ws.Client, opts rpc.TransactionOpts, timeout time.Duration) Sender {
+	return SenderFunc(func(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+		return sendandconfirmtransaction.SendAndConfirmTransactionWithOpts(ctx, rpcClient, wsClient, tx, opts, &timeout)
+	})
+}
+
+// Opts are the options of a Distributor.
+type Opts struct {
+	// Maximum number of transfers per transaction; as many as fit if zero.
+	MaxTransfersPerTransaction int
+
+	// Commitment of the blockhashes, and of the checks of the accounts and of the transactions.
+	// Defaults to "confirmed".
+	Commitment rpc.CommitmentType
+
+	// Called after each change of the receipt, e.g. to save it.
+	OnUpdate func(receipt *Receipt)
+}
+
+// Distributor executes distributions, paid by the sender.
+type Distributor struct {
+	client *rpc.Client
+	sender Sender
+	signer solana.Signer
+	opts   Opts
+}
+
+// New creates a distributor. The signer is the sender of the
+// distributed funds, and the fee payer of the transactions.
+func New(client *rpc.Client, sender Sender, signer solana.Signer, opts *Opts) *Distributor {
+	d := &Distributor{
+		client: client,
+		sender: sender,
+		signer: signer,
+	}
+	if opts != nil {
+		d.opts = *opts
+	}
+	if d.opts.Commitment == "" {
+		d.opts.Commitment = rpc.CommitmentConfirmed
+	}
+	return d
+}
+
+func (d *Distributor) updated(receipt *Receipt) {
+	if d.opts.OnUpdate != nil {
+		d.opts.OnUpdate(receipt)
+	}
+}
+
+// Distribute executes the pending transfers of the receipt, after checking the
+// outcome of the transfers sent by a previous run. The transfers are sent one
+// transaction at a time. A transfer whose transaction failed to send stays sent
+// until its blockhash expires, so that it is never paid twice: an error is
+// returned if some transfers are not confirmed, and Distribute can be called
+// again later to complete them.
+func (d *Distributor) Distribute(ctx context.Context, receipt *Receipt) error {
+	if !receipt.Sender.Equals(d.signer.PublicKey()) {
+		return fmt.Errorf("signer %s is not the sender %s", d.signer.PublicKey(), receipt.Sender)
+	}
+	if err := d.checkSent(ctx, receipt); err != nil {
+		return err
+	}
+
+	var pending []*RecipientReceipt
+	for _, recipient := range receipt.Recipients {
+		if recipient.Status == StatusPending {
+			pending = append(pending, recipient)
+		}
+	}
+	var missing map[solana.PublicKey]bool
+	if receipt.Mint != nil {
+		var err error
+		if missing, err = d.missingTokenAccounts(ctx, *receipt.Mint, pending); err != nil {
+			return err
+		}
+	}
+
+	for len(pending) > 0 {
+		blockhash, err := d.client.GetLatestBlockhash(ctx, d.opts.Commitment)
+		if err != nil {
+			return fmt.Errorf("unable to get a blockhash: %w", err)
+		}
+		tx, count, err := d.pack(receipt, pending, missing, blockhash.Value.Blockhash)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.SignWithSigners(d.signer); err != nil {
+			return fmt.Errorf("unable to sign transaction: %w", err)
+		}
+		batch := pending[:count]
+		pending = pending[count:]
+
+		// Recorded before sending: if the process stops while the transaction is in
+		// flight, the next run checks the transaction instead of sending again.
+		signature := tx.Signatures[0]
+		for _, recipient := range batch {
+			recipient.Status = StatusSent
+			recipient.Signature = &signature
+			recipient.Blockhash = &blockhash.Value.Blockhash
+			recipient.Error = ""
+		}
+		d.updated(receipt)
+
+		if _, err := d.sender.Send(ctx, tx); err != nil {
+			for _, recipient := range batch {
+				recipient.Error = err.Error()
+			}
+			d.updated(receipt)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+		for _, recipient := range batch {
+			recipient.Status = StatusConfirmed
+			delete(missing, recipient.Recipient)
+		}
+		d.updated(receipt)
+	}
+
+	// The transactions that failed are checked and set back to pending
+	// when they cannot land anymore, to be sent again by the next run.
+	if err := d.checkSent(ctx, receipt); err != nil {
+		return err
+	}
+	if pending := receipt.Count(StatusPending) + receipt.Count(StatusSent); pending > 0 {
+		return fmt.Errorf("%d transfers are not confirmed", pending)
+	}
+	return nil
+}
+
+// pack builds a transaction with as many of the pending transfers as possible,
+// and returns the number of transfers it holds.
+func (d *Distributor) pack(
+	receipt *Receipt,
+	pending []*RecipientReceipt,
+	missing map[solana.PublicKey]bool,
+	blockhash solana.Hash,
+) (*solana.Transaction, int, error) {
+	sender := receipt.Sender
+	var source solana.PublicKey
+	if receipt.Mint != nil {
+		var err error
+		if source, _, err = solana.FindAssociatedTokenAddress(sender, *receipt.Mint); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	var (
+		instructions []solana.Instruction
+		tx           *solana.Transaction
+		created      = make(map[solana.PublicKey]bool)
+	)
+	for count, recipient := range pending {
+		if d.opts.MaxTransfersPerTransaction > 0 && count == d.opts.MaxTransfersPerTransaction {
+			return tx, count, nil
+		}
+		next := instructions
+		if receipt.Mint == nil {
+			next = append(next, system.NewTransferInstruction(recipient.Amount, sender, recipient.Recipient).Build())
+		} else {
+			destination, _, err := solana.FindAssociatedTokenAddress(recipient.Recipient, *receipt.Mint)
+			if err != nil {
+				return nil, 0, err
+			}
+			if missing[recipient.Recipient] && !created[recipient.Recipient] {
+				next = append(next, associatedtokenaccount.NewCreateInstruction(sender, recipient.Recipient, *receipt.Mint).Build())
+			}
+			next = append(next, token.NewTransferInstruction(recipient.Amount, source, destination, sender, nil).Build())
+		}
+
+		candidate, err := solana.NewTransaction(next, blockhash, solana.TransactionPayer(sender))
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to build transaction: %w", err)
+		}
+		size, err := signedSize(candidate)
+		if err != nil {
+			return nil, 0, err
+		}
+		if size > maxTransactionSize {
+			if count == 0 {
+				return nil, 0, fmt.Errorf("transfer to %s does not fit in a transaction", recipient.Recipient)
+			}
+			return tx, count, nil
+		}
+		instructions, tx = next, candidate
+		if missing[recipient.Recipient] {
+			created[recipient.Recipient] = true
+		}
+	}
+	return tx, len(pending), nil
+}
+
+func signedSize(tx *solana.Transaction) (int, error) {
+	signed := *tx
+	signed.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+	data, err := signed.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// missingTokenAccounts returns the recipients without associated token account.
+func (d *Distributor) missingTokenAccounts(ctx context.Context, mint solana.PublicKey, recipients []*RecipientReceipt) (map[solana.PublicKey]bool, error) {
+	missing := make(map[solana.PublicKey]bool)
+	for start := 0; start < len(recipients); start += maxAccountsPerCall {
+		chunk := recipients[start:]
+		if len(chunk) > maxAccountsPerCall {
+			chunk = chunk[:maxAccountsPerCall]
+		}
+		accounts := make([]solana.PublicKey, len(chunk))
+		for i, recipient := range chunk {
+			ata, _, err := solana.FindAssociatedTokenAddress(recipient.Recipient, mint)
+			if err != nil {
+				return nil, err
+			}
+			accounts[i] = ata
+		}
+		zero := uint64(0)
+		out, err := d.client.GetMultipleAccountsWithOpts(ctx, accounts, &rpc.GetMultipleAccountsOpts{
+			Encoding:   solana.EncodingBase64,
+			Commitment: d.opts.Commitment,
+			DataSlice:  &rpc.DataSlice{Offset: &zero, Length: &zero},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the token accounts: %w", err)
+		}
+		if len(out.Value) != len(chunk) {
+			return nil, fmt.Errorf("got %d token accounts, expected %d", len(out.Value), len(chunk))
+		}
+		for i, account := range out.Value {
+			if account == nil {
+				missing[chunk[i].Recipient] = true
+			}
+		}
+	}
+	return missing, nil
+}
+
+// checkSent resolves the outcome of the sent transfers: they are confirmed if
+// their transaction succeeded, and pending again if it failed or if it cannot
+// land anymore. The transfers whose transaction may still land are left sent.
+func (d *Distributor) checkSent(ctx context.Context, receipt *Receipt) error {
+	var sent []*RecipientReceipt
+	for _, recipient := range receipt.Recipients {
+		if recipient.Status == StatusSent {
+			if recipient.Signature == nil {
+				return errors.New("sent transfer without signature")
+			}
+			sent = append(sent, recipient)
+		}
+	}
+	if len(sent) == 0 {
+		return nil
+	}
+
+	// The signatures of the transactions, and their transfers.
+	var signatures []solana.Signature
+	transfers := make(map[solana.Signature][]*RecipientReceipt)
+	for _, recipient := range sent {
+		if _, ok := transfers[*recipient.Signature]; !ok {
+			signatures = append(signatures, *recipient.Signature)
+		}
+		transfers[*recipient.Signature] = append(transfers[*recipient.Signature], recipient)
+	}
+
+	for start := 0; start < len(signatures); start += maxAccountsPerCall {
+		chunk := signatures[start:]
+		if len(chunk) > maxAccountsPerCall {
+			chunk = chunk[:maxAccountsPerCall]
+		}
+		out, err := d.client.GetSignatureStatuses(ctx, true, chunk...)
+		if err != nil {
+			return fmt.Errorf("unable to get the signature statuses: %w", err)
+		}
+		if len(out.Value) != len(chunk) {
+			return fmt.Errorf("got %d signature statuses, expected %d", len(out.Value), len(chunk))
+		}
+		for i, status := range out.Value {
+			batch := transfers[chunk[i]]
+			switch {
+			case status != nil && status.Err != nil:
+				for _, recipient := range batch {
+					recipient.Status = StatusPending
+					recipient.Error = fmt.Sprintf("transaction failed: %v", status.Err)
+				}
+			case status != nil && (status.ConfirmationStatus == rpc.ConfirmationStatusConfirmed || status.ConfirmationStatus == rpc.ConfirmationStatusFinalized):
+				for _, recipient := range batch {
+					recipient.Status = StatusConfirmed
+					recipient.Error = ""
+				}
+			case status != nil:
+				// Processed: it will most likely be confirmed.
+			default:
+				if batch[0].Blockhash == nil {
+					continue
+				}
+				valid, err := d.client.IsBlockhashValid(ctx, *batch[0].Blockhash, d.opts.Commitment)
+				if err != nil {
+					return fmt.Errorf("unable to check blockhash: %w", err)
+				}
+				if valid.Value {
+					// The transaction may still land.
+					continue
+				}
+				for _, recipient := range batch {
+					recipient.Status = StatusPending
+				}
+			}
+		}
+	}
+	d.updated(receipt)
+	return nil
+}
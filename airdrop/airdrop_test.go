@@ -0,0 +1,212 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package airdrop
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testRPC is a RPC server: the token accounts in existing exist, the signatures
+// in statuses have the corresponding status, and the blockhashes are expired.
+type testRPC struct {
+	existing map[solana.PublicKey]bool
+	statuses map[solana.Signature]string
+}
+
+func (r *testRPC) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	var request struct {
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var result string
+	switch request.Method {
+	case "getLatestBlockhash":
+		result = fmt.Sprintf(`{"context":{"slot":1},"value":{"blockhash":"%s","lastValidBlockHeight":100}}`, solana.Hash{1})
+	case "isBlockhashValid":
+		result = `{"context":{"slot":1},"value":false}`
+	case "getMultipleAccounts":
+		var accounts []solana.PublicKey
+		json.Unmarshal(request.Params[0], &accounts)
+		var values []string
+		for _, account := range accounts {
+			if r.existing[account] {
+				values = append(values, fmt.Sprintf(`{"lamports":1,"owner":"%s","data":["","base64"],"executable":false,"rentEpoch":0}`, solana.TokenProgramID))
+			} else {
+				values = append(values, "null")
+			}
+		}
+		result = `{"context":{"slot":1},"value":[` + strings.Join(values, ",") + `]}`
+	case "getSignatureStatuses":
+		var signatures []solana.Signature
+		json.Unmarshal(request.Params[0], &signatures)
+		var values []string
+		for _, signature := range signatures {
+			if status, ok := r.statuses[signature]; ok {
+				values = append(values, status)
+			} else {
+				values = append(values, "null")
+			}
+		}
+		result = `{"context":{"slot":1},"value":[` + strings.Join(values, ",") + `]}`
+	default:
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	rw.Write([]byte(`{"jsonrpc":"2.0","id":0,"result":` + result + `}`))
+}
+
+const confirmedStatus = `{"slot":1,"confirmations":null,"err":null,"confirmationStatus":"confirmed"}`
+
+func TestDistribute_SOL(t *testing.T) {
+	server := httptest.NewServer(&testRPC{})
+	defer server.Close()
+
+	signer := solana.NewWallet().PrivateKey
+	var recipients []Recipient
+	for i := 0; i < 50; i++ {
+		recipients = append(recipients, Recipient{Address: solana.NewWallet().PublicKey(), Amount: uint64(1000 + i)})
+	}
+	receipt := NewReceipt(signer.PublicKey(), nil, recipients)
+
+	var sent []*solana.Transaction
+	failed := 0
+	sender := SenderFunc(func(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+		size, err := signedSize(tx)
+		require.NoError(t, err)
+		require.LessOrEqual(t, size, maxTransactionSize)
+		require.NoError(t, tx.VerifySignatures())
+		if failed == 0 {
+			failed = len(tx.Message.Instructions)
+			return solana.Signature{}, errors.New("blockhash not found")
+		}
+		sent = append(sent, tx)
+		return tx.Signatures[0], nil
+	})
+	updates := 0
+	d := New(rpc.New(server.URL), sender, signer, &Opts{OnUpdate: func(*Receipt) { updates++ }})
+
+	// The first transaction fails: its transfers are sent again
+	// by the next run, since its blockhash expired.
+	err := d.Distribute(context.Background(), receipt)
+	require.Error(t, err)
+	require.False(t, receipt.Complete())
+	require.Equal(t, failed, receipt.Count(StatusPending))
+	require.NoError(t, d.Distribute(context.Background(), receipt))
+	require.True(t, receipt.Complete())
+	require.True(t, len(sent) > 2)
+	require.NotZero(t, updates)
+
+	transferred := 0
+	for _, tx := range sent {
+		transferred += len(tx.Message.Instructions)
+	}
+	require.Equal(t, len(recipients), transferred)
+
+	require.Error(t, receipt.Verify())
+	require.NoError(t, receipt.Sign(signer))
+	require.NoError(t, receipt.Verify())
+	receipt.Recipients[0].Amount++
+	require.Error(t, receipt.Verify())
+}
+
+func TestDistribute_Token(t *testing.T) {
+	signer := solana.NewWallet().PrivateKey
+	mint := solana.NewWallet().PublicKey()
+	withAccount, withoutAccount := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+	ata, _, err := solana.FindAssociatedTokenAddress(withAccount, mint)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(&testRPC{existing: map[solana.PublicKey]bool{ata: true}})
+	defer server.Close()
+
+	var sent []*solana.Transaction
+	sender := SenderFunc(func(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+		sent = append(sent, tx)
+		return tx.Signatures[0], nil
+	})
+	receipt := NewReceipt(signer.PublicKey(), &mint, []Recipient{
+		{Address: withAccount, Amount: 1},
+		{Address: withoutAccount, Amount: 2},
+		{Address: withoutAccount, Amount: 3},
+	})
+	require.NoError(t, New(rpc.New(server.URL), sender, signer, nil).Distribute(context.Background(), receipt))
+	require.True(t, receipt.Complete())
+
+	// One transfer per recipient, and a single creation of the missing account.
+	require.Len(t, sent, 1)
+	var programs []solana.PublicKey
+	for _, inst := range sent[0].Message.Instructions {
+		program, err := sent[0].Message.Program(inst.ProgramIDIndex)
+		require.NoError(t, err)
+		programs = append(programs, program)
+	}
+	require.Equal(t, []solana.PublicKey{
+		solana.TokenProgramID,
+		solana.SPLAssociatedTokenAccountProgramID,
+		solana.TokenProgramID,
+		solana.TokenProgramID,
+	}, programs)
+}
+
+func TestDistribute_Resume(t *testing.T) {
+	signer := solana.NewWallet().PrivateKey
+	confirmed, expired := solana.Signature{1}, solana.Signature{2}
+	hash := solana.Hash{2}
+	server := httptest.NewServer(&testRPC{statuses: map[solana.Signature]string{confirmed: confirmedStatus}})
+	defer server.Close()
+
+	receipt := NewReceipt(signer.PublicKey(), nil, []Recipient{
+		{Address: solana.PublicKey{1}, Amount: 1},
+		{Address: solana.PublicKey{2}, Amount: 2},
+		{Address: solana.PublicKey{3}, Amount: 3},
+	})
+	receipt.Recipients[0].Status, receipt.Recipients[0].Signature, receipt.Recipients[0].Blockhash = StatusSent, &confirmed, &hash
+	receipt.Recipients[1].Status, receipt.Recipients[1].Signature, receipt.Recipients[1].Blockhash = StatusSent, &expired, &hash
+
+	// Save and load the receipt, as a resumed distribution would.
+	data, err := json.Marshal(receipt)
+	require.NoError(t, err)
+	receipt = new(Receipt)
+	require.NoError(t, json.Unmarshal(data, receipt))
+
+	var sent []*solana.Transaction
+	sender := SenderFunc(func(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+		sent = append(sent, tx)
+		return tx.Signatures[0], nil
+	})
+	require.NoError(t, New(rpc.New(server.URL), sender, signer, nil).Distribute(context.Background(), receipt))
+	require.True(t, receipt.Complete())
+	require.Equal(t, confirmed, *receipt.Recipients[0].Signature)
+	require.Len(t, sent, 1)
+	require.Equal(t, []solana.PublicKey{signer.PublicKey(), {2}, {3}, solana.SystemProgramID}, []solana.PublicKey(sent[0].Message.AccountKeys))
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package airdrop
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Status is the status of the transfer to a recipient.
+type Status string
+
+const (
+	// The transfer was not sent yet (or it must be sent again).
+	StatusPending Status = "pending"
+	// The transfer was sent, but its outcome is unknown: it is checked
+	// before anything is sent again to the recipient.
+	StatusSent Status = "sent"
+	// The transfer is confirmed.
+	StatusConfirmed Status = "confirmed"
+)
+
+// Recipient is a recipient of a distribution.
+type Recipient struct {
+	Address solana.PublicKey
+	// Lamports, or raw amount of tokens (ignoring decimals).
+	Amount uint64
+}
+
+// RecipientReceipt is the status of the transfer to a recipient.
+type RecipientReceipt struct {
+	Recipient solana.PublicKey `json:"recipient"`
+	Amount    uint64           `json:"amount"`
+	Status    Status           `json:"status"`
+	// The transaction of the transfer, once sent.
+	Signature *solana.Signature `json:"signature,omitempty"`
+	// The blockhash of the transaction, to know whether it can still land.
+	Blockhash *solana.Hash `json:"blockhash,omitempty"`
+	// The last error of the transfer.
+	Error string `json:"error,omitempty"`
+}
+
+// Receipt is the report of a distribution: it is updated as the transfers
+// progress, and can be saved (as JSON) to resume the distribution later.
+// Once complete, it can be signed by the sender.
+type Receipt struct {
+	Sender solana.PublicKey `json:"sender"`
+	// The distributed token; nil for SOL.
+	Mint       *solana.PublicKey   `json:"mint,omitempty"`
+	Recipients []*RecipientReceipt `json:"recipients"`
+	// Signature of the receipt by the sender (see Sign).
+	Signature *solana.Signature `json:"signature,omitempty"`
+}
+
+// NewReceipt creates the receipt of a new distribution
+// of SOL (if mint is nil) or tokens.
+func NewReceipt(sender solana.PublicKey, mint *solana.PublicKey, recipients []Recipient) *Receipt {
+	receipt := &Receipt{
+		Sender:     sender,
+		Mint:       mint,
+		Recipients: make([]*RecipientReceipt, len(recipients)),
+	}
+	for i, recipient := range recipients {
+		receipt.Recipients[i] = &RecipientReceipt{
+			Recipient: recipient.Address,
+			Amount:    recipient.Amount,
+			Status:    StatusPending,
+		}
+	}
+	return receipt
+}
+
+// Count returns the number of recipients with the status.
+func (receipt *Receipt) Count(status Status) int {
+	count := 0
+	for _, recipient := range receipt.Recipients {
+		if recipient.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Complete returns true if all the transfers are confirmed.
+func (receipt *Receipt) Complete() bool {
+	return receipt.Count(StatusConfirmed) == len(receipt.Recipients)
+}
+
+// signedContent returns the JSON encoding of the receipt without its signature.
+func (receipt *Receipt) signedContent() ([]byte, error) {
+	unsigned := *receipt
+	unsigned.Signature = nil
+	return json.Marshal(unsigned)
+}
+
+// Sign signs the JSON encoding of the receipt (without signature) with the sender.
+func (receipt *Receipt) Sign(signer solana.Signer) error {
+	if !signer.PublicKey().Equals(receipt.Sender) {
+		return fmt.Errorf("signer %s is not the sender %s", signer.PublicKey(), receipt.Sender)
+	}
+	content, err := receipt.signedContent()
+	if err != nil {
+		return err
+	}
+	signature, err := signer.Sign(content)
+	if err != nil {
+		return err
+	}
+	receipt.Signature = &signature
+	return nil
+}
+
+// Verify checks the signature of the receipt by the sender.
+func (receipt *Receipt) Verify() error {
+	if receipt.Signature == nil {
+		return fmt.Errorf("receipt is not signed")
+	}
+	content, err := receipt.signedContent()
+	if err != nil {
+		return err
+	}
+	if !receipt.Signature.Verify(receipt.Sender, content) {
+		return fmt.Errorf("invalid receipt signature")
+	}
+	return nil
+}
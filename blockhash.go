@@ -0,0 +1,58 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"context"
+	"fmt"
+)
+
+// BlockhashProvider provides the recent blockhash of new transactions.
+// See the rpc/blockhash package for the implementations backed by an RPC node.
+type BlockhashProvider interface {
+	RecentBlockhash(ctx context.Context) (Hash, error)
+}
+
+// BlockhashProviderFunc adapts a function to a BlockhashProvider.
+type BlockhashProviderFunc func(ctx context.Context) (Hash, error)
+
+func (f BlockhashProviderFunc) RecentBlockhash(ctx context.Context) (Hash, error) {
+	return f(ctx)
+}
+
+// SetBlockhashProvider sets the provider of the recent blockhash,
+// used by BuildWithContext when no blockhash was set explicitly.
+func (builder *TransactionBuilder) SetBlockhashProvider(provider BlockhashProvider) *TransactionBuilder {
+	builder.blockhashProvider = provider
+	return builder
+}
+
+// BuildWithContext builds and returns a *Transaction; if the recent blockhash
+// was not set, it is fetched from the blockhash provider.
+func (builder *TransactionBuilder) BuildWithContext(ctx context.Context) (*Transaction, error) {
+	recentBlockHash := builder.recentBlockHash
+	if recentBlockHash.IsZero() && builder.blockhashProvider != nil {
+		var err error
+		recentBlockHash, err = builder.blockhashProvider.RecentBlockhash(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get recent blockhash: %w", err)
+		}
+	}
+	return NewTransaction(
+		builder.instructions,
+		recentBlockHash,
+		builder.opts...,
+	)
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionBuilderBlockhashProvider(t *testing.T) {
+	payer := NewWallet().PublicKey()
+	calls := 0
+	provider := BlockhashProviderFunc(func(ctx context.Context) (Hash, error) {
+		calls++
+		return Hash{7}, nil
+	})
+	inst := &testTransactionInstructions{
+		accounts:  []*AccountMeta{{PublicKey: payer, IsSigner: true, IsWritable: true}},
+		data:      []byte{0xaa},
+		programID: SystemProgramID,
+	}
+
+	tx, err := NewTransactionBuilder().
+		AddInstruction(inst).
+		SetBlockhashProvider(provider).
+		BuildWithContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, Hash{7}, tx.Message.RecentBlockhash)
+	require.Equal(t, 1, calls)
+
+	// An explicit blockhash takes precedence.
+	tx, err = NewTransactionBuilder().
+		AddInstruction(inst).
+		SetRecentBlockHash(Hash{8}).
+		SetBlockhashProvider(provider).
+		BuildWithContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, Hash{8}, tx.Message.RecentBlockhash)
+	require.Equal(t, 1, calls)
+
+	_, err = NewTransactionBuilder().
+		AddInstruction(inst).
+		SetBlockhashProvider(BlockhashProviderFunc(func(ctx context.Context) (Hash, error) {
+			return Hash{}, errors.New("unavailable")
+		})).
+		BuildWithContext(context.Background())
+	require.EqualError(t, err, "unable to get recent blockhash: unavailable")
+}
@@ -15,10 +15,14 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/addressbook"
 	"github.com/gagliardetto/solana-go/rpc"
 
 	"github.com/gagliardetto/solana-go/vault"
@@ -46,6 +50,24 @@ func getClient() *rpc.Client {
 	return api
 }
 
+// resolveAddress returns the public key designated by the input: an address,
+// an alias set with --alias, or a .sol domain.
+func resolveAddress(ctx context.Context, client *rpc.Client, input string) (solana.PublicKey, error) {
+	aliases, err := addressbook.ParseAliases(viper.GetStringSlice("global-alias")...)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	static, err := addressbook.NewStaticResolver(aliases)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	resolver := addressbook.Chain(
+		static,
+		addressbook.NewCachingResolver(addressbook.NewSNSResolver(client), time.Minute, 0),
+	)
+	return addressbook.Lookup(ctx, resolver, input)
+}
+
 func sanitizeAPIURL(input string) string {
 	switch input {
 	case "devnet":
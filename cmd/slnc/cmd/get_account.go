@@ -22,8 +22,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/gagliardetto/solana-go"
-
 	"github.com/spf13/cobra"
 )
 
@@ -35,7 +33,12 @@ var getAccountCmd = &cobra.Command{
 		client := getClient()
 		ctx := context.Background()
 
-		resp, err := client.GetAccountInfo(ctx, solana.MustPublicKeyFromBase58(args[0]))
+		address, err := resolveAddress(ctx, client, args[0])
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetAccountInfo(ctx, address)
 		if err != nil {
 			return err
 		}
@@ -20,7 +20,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/gagliardetto/solana-go"
 	"github.com/spf13/cobra"
 )
 
@@ -31,9 +30,14 @@ var getBalanceCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client := getClient()
 
+		address, err := resolveAddress(cmd.Context(), client, args[0])
+		if err != nil {
+			return err
+		}
+
 		resp, err := client.GetBalance(
 			cmd.Context(),
-			solana.MustPublicKeyFromBase58(args[0]),
+			address,
 			"",
 		)
 		if err != nil {
@@ -15,6 +15,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !nolegacy
+// +build !nolegacy
+
 package cmd
 
 import (
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/spf13/cobra"
 )
@@ -35,7 +34,7 @@ var requestCmd = &cobra.Command{
 
 		client := getClient()
 
-		address, err := solana.PublicKeyFromBase58(args[0])
+		address, err := resolveAddress(context.Background(), client, args[0])
 		if err != nil {
 			return fmt.Errorf("invalid account address %q: %w", args[0], err)
 		}
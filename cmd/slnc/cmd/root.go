@@ -55,6 +55,7 @@ func init() {
 	RootCmd.PersistentFlags().StringP("rpc-url", "u", defaultRPCURL, "API endpoint of eos.io blockchain node")
 	RootCmd.PersistentFlags().StringSliceP("http-header", "H", []string{}, "HTTP header to add to JSON-RPC requests")
 	RootCmd.PersistentFlags().StringP("kms-gcp-keypath", "", "", "Path to the cryptoKeys within a keyRing on GCP")
+	RootCmd.PersistentFlags().StringSliceP("alias", "", []string{}, "Address alias usable in place of an address, as name=address")
 
 	RootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		SetupLogger()
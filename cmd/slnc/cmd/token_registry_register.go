@@ -88,7 +88,7 @@ var tokenRegistryRegisterCmd = &cobra.Command{
 			return fmt.Errorf("registrar key must be present in the vault to register a token")
 		}
 
-		blockHashResult, err := client.GetRecentBlockhash(context.Background(), rpc.CommitmentMax)
+		blockHashResult, err := client.GetLatestBlockhash(context.Background(), rpc.CommitmentFinalized)
 		if err != nil {
 			return fmt.Errorf("unable retrieve recent block hash: %w", err)
 		}
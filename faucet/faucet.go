@@ -0,0 +1,89 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package faucet requests devnet and testnet airdrops from the RPC nodes
+// and from web faucets, falling back to the next faucet when one is throttled.
+package faucet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ErrThrottled is returned (possibly wrapped) by a Faucet that refused
+// the airdrop because of rate limiting.
+var ErrThrottled = errors.New("faucet throttled the request")
+
+// Faucet airdrops lamports to an account.
+type Faucet interface {
+	Airdrop(ctx context.Context, account solana.PublicKey, lamports uint64) (solana.Signature, error)
+}
+
+// FaucetFunc is an adapter to use a function as a Faucet.
+type FaucetFunc func(ctx context.Context, account solana.PublicKey, lamports uint64) (solana.Signature, error)
+
+func (fn FaucetFunc) Airdrop(ctx context.Context, account solana.PublicKey, lamports uint64) (solana.Signature, error) {
+	return fn(ctx, account, lamports)
+}
+
+// FallbackError is returned by a Fallback faucet when all the faucets failed.
+type FallbackError struct {
+	Errors []error
+}
+
+func (e *FallbackError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("all the faucets failed: %s", strings.Join(msgs, "; "))
+}
+
+// Throttled returns true if all the faucets failed because of rate limiting.
+func (e *FallbackError) Throttled() bool {
+	for _, err := range e.Errors {
+		if !errors.Is(err, ErrThrottled) {
+			return false
+		}
+	}
+	return len(e.Errors) > 0
+}
+
+type fallback []Faucet
+
+// Fallback returns a Faucet that tries each of the provided faucets in order,
+// until one of them succeeds. If all of them fail, it returns a *FallbackError.
+// Context errors are returned immediately.
+func Fallback(faucets ...Faucet) Faucet {
+	return fallback(faucets)
+}
+
+func (f fallback) Airdrop(ctx context.Context, account solana.PublicKey, lamports uint64) (solana.Signature, error) {
+	var errs []error
+	for _, faucet := range f {
+		sig, err := faucet.Airdrop(ctx, account, lamports)
+		if err == nil {
+			return sig, nil
+		}
+		if ctx.Err() != nil {
+			return solana.Signature{}, ctx.Err()
+		}
+		errs = append(errs, err)
+	}
+	return solana.Signature{}, &FallbackError{Errors: errs}
+}
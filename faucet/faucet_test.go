@@ -0,0 +1,81 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faucet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebFaucet(t *testing.T) {
+	sig := solana.Signature{1, 2, 3}
+	account := solana.PublicKey{9}
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		fmt.Fprintf(w, `{"signature": %q}`, sig)
+	}))
+	defer server.Close()
+
+	f := NewWebFaucet(server.URL, &WebFaucetOpts{
+		Header: http.Header{"X-Api-Key": []string{"secret"}},
+	})
+	out, err := f.Airdrop(context.Background(), account, 1000)
+	require.NoError(t, err)
+	require.Equal(t, sig, out)
+	require.Equal(t, map[string]interface{}{"address": account.String(), "lamports": float64(1000)}, got)
+}
+
+func TestWebFaucet_throttled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err := NewWebFaucet(server.URL, nil).Airdrop(context.Background(), solana.PublicKey{}, 1)
+	require.True(t, errors.Is(err, ErrThrottled))
+}
+
+func TestFallback(t *testing.T) {
+	sig := solana.Signature{7}
+	throttled := FaucetFunc(func(ctx context.Context, account solana.PublicKey, lamports uint64) (solana.Signature, error) {
+		return solana.Signature{}, ErrThrottled
+	})
+	ok := FaucetFunc(func(ctx context.Context, account solana.PublicKey, lamports uint64) (solana.Signature, error) {
+		return sig, nil
+	})
+
+	out, err := Fallback(throttled, ok).Airdrop(context.Background(), solana.PublicKey{}, 1)
+	require.NoError(t, err)
+	require.Equal(t, sig, out)
+
+	_, err = Fallback(throttled, throttled).Airdrop(context.Background(), solana.PublicKey{}, 1)
+	var fallbackErr *FallbackError
+	require.True(t, errors.As(err, &fallbackErr))
+	require.Len(t, fallbackErr.Errors, 2)
+	require.True(t, fallbackErr.Throttled())
+
+	require.True(t, isThrottled(errors.New("429 Too Many Requests: airdrop limit reached")))
+	require.False(t, isThrottled(errors.New("invalid param")))
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faucet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// RPCFaucet requests airdrops with the requestAirdrop RPC method.
+type RPCFaucet struct {
+	client     *rpc.Client
+	commitment rpc.CommitmentType
+}
+
+// NewRPCFaucet creates a Faucet that uses the requestAirdrop method of the provided client.
+// The commitment is optional.
+func NewRPCFaucet(client *rpc.Client, commitment rpc.CommitmentType) *RPCFaucet {
+	return &RPCFaucet{
+		client:     client,
+		commitment: commitment,
+	}
+}
+
+func (f *RPCFaucet) Airdrop(ctx context.Context, account solana.PublicKey, lamports uint64) (solana.Signature, error) {
+	sig, err := f.client.RequestAirdrop(ctx, account, lamports, f.commitment)
+	if err != nil {
+		if isThrottled(err) {
+			return solana.Signature{}, fmt.Errorf("%w: %v", ErrThrottled, err)
+		}
+		return solana.Signature{}, err
+	}
+	return sig, nil
+}
+
+func isThrottled(err error) bool {
+	var httpErr *jsonrpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.Code == 429 {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "429") ||
+		strings.Contains(msg, "rate limit") ||
+		strings.Contains(msg, "airdrop limit") ||
+		strings.Contains(msg, "faucet has run dry")
+}
+
+// NewDevNetFaucet returns a Faucet that uses the public devnet RPC endpoint.
+func NewDevNetFaucet() *RPCFaucet {
+	return NewRPCFaucet(rpc.New(rpc.DevNet_RPC), rpc.CommitmentConfirmed)
+}
+
+// NewTestNetFaucet returns a Faucet that uses the public testnet RPC endpoint.
+func NewTestNetFaucet() *RPCFaucet {
+	return NewRPCFaucet(rpc.New(rpc.TestNet_RPC), rpc.CommitmentConfirmed)
+}
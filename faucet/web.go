@@ -0,0 +1,120 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+type WebFaucetOpts struct {
+	// The HTTP client; defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Headers added to each request (e.g. an API key).
+	Header http.Header
+
+	// Encodes the JSON body of the request;
+	// defaults to `{"address": "<base58>", "lamports": <amount>}`.
+	EncodeRequest func(account solana.PublicKey, lamports uint64) ([]byte, error)
+
+	// Decodes the signature of the airdrop from the body of the response;
+	// defaults to reading `{"signature": "<base58>"}`.
+	DecodeResponse func(body []byte) (solana.Signature, error)
+}
+
+// WebFaucet requests airdrops from an HTTP faucet that doesn't require a captcha:
+// it POSTs a JSON body to the faucet URL, and reads the signature from the response.
+// A 429 response is reported as ErrThrottled.
+type WebFaucet struct {
+	url  string
+	opts WebFaucetOpts
+}
+
+func NewWebFaucet(url string, opts *WebFaucetOpts) *WebFaucet {
+	f := &WebFaucet{
+		url: url,
+	}
+	if opts != nil {
+		f.opts = *opts
+	}
+	if f.opts.HTTPClient == nil {
+		f.opts.HTTPClient = http.DefaultClient
+	}
+	if f.opts.EncodeRequest == nil {
+		f.opts.EncodeRequest = encodeWebRequest
+	}
+	if f.opts.DecodeResponse == nil {
+		f.opts.DecodeResponse = decodeWebResponse
+	}
+	return f
+}
+
+func encodeWebRequest(account solana.PublicKey, lamports uint64) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"address":  account,
+		"lamports": lamports,
+	})
+}
+
+func decodeWebResponse(body []byte) (solana.Signature, error) {
+	var resp struct {
+		Signature solana.Signature `json:"signature"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return solana.Signature{}, fmt.Errorf("unable to decode faucet response: %w", err)
+	}
+	if resp.Signature.IsZero() {
+		return solana.Signature{}, fmt.Errorf("faucet response has no signature: %s", body)
+	}
+	return resp.Signature, nil
+}
+
+func (f *WebFaucet) Airdrop(ctx context.Context, account solana.PublicKey, lamports uint64) (solana.Signature, error) {
+	body, err := f.opts.EncodeRequest(account, lamports)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.url, bytes.NewReader(body))
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range f.opts.Header {
+		req.Header[k] = v
+	}
+	resp, err := f.opts.HTTPClient.Do(req)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return solana.Signature{}, fmt.Errorf("%w: %s", ErrThrottled, f.url)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return solana.Signature{}, fmt.Errorf("faucet %s returned %s: %s", f.url, resp.Status, respBody)
+	}
+	return f.opts.DecodeResponse(respBody)
+}
@@ -0,0 +1,50 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"context"
+	"fmt"
+)
+
+// FeeCalculator computes the fee, in lamports, the network will charge for
+// a message, e.g. with the getFeeForMessage RPC method (see rpc.Client.FeeForMessage).
+type FeeCalculator interface {
+	FeeForMessage(ctx context.Context, message *Message) (uint64, error)
+}
+
+// FeeCalculatorFunc adapts a function to a FeeCalculator.
+type FeeCalculatorFunc func(ctx context.Context, message *Message) (uint64, error)
+
+func (f FeeCalculatorFunc) FeeForMessage(ctx context.Context, message *Message) (uint64, error) {
+	return f(ctx, message)
+}
+
+// Fee returns the exact fee, in lamports, the network will charge for the
+// transaction (base fee of the signatures and prioritization fee), as
+// computed by the calculator; the transaction does not need to be signed:
+//
+//	tx, err := solana.NewTransactionBuilder().
+//		AddInstruction(instruction).
+//		SetRecentBlockHash(blockhash).
+//		Build()
+//	fee, err := tx.Fee(ctx, rpcClient)
+func (tx *Transaction) Fee(ctx context.Context, calculator FeeCalculator) (uint64, error) {
+	fee, err := calculator.FeeForMessage(ctx, &tx.Message)
+	if err != nil {
+		return 0, fmt.Errorf("unable to compute fee: %w", err)
+	}
+	return fee, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashutil
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+// BLAKE3 in hash mode (no key, no key derivation), with 32-byte outputs;
+// see the reference implementation at https://github.com/BLAKE3-team/BLAKE3.
+
+const (
+	blake3BlockLen = 64
+	blake3ChunkLen = 1024
+
+	blake3ChunkStart = 1 << 0
+	blake3ChunkEnd   = 1 << 1
+	blake3Parent     = 1 << 2
+	blake3Root       = 1 << 3
+)
+
+var blake3IV = [8]uint32{
+	0x6A09E667, 0xBB67AE85, 0x3C6EF372, 0xA54FF53A,
+	0x510E527F, 0x9B05688C, 0x1F83D9AB, 0x5BE0CD19,
+}
+
+var blake3MsgPermutation = [16]int{2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8}
+
+func blake3G(state *[16]uint32, a, b, c, d int, mx, my uint32) {
+	state[a] = state[a] + state[b] + mx
+	state[d] = bits.RotateLeft32(state[d]^state[a], -16)
+	state[c] = state[c] + state[d]
+	state[b] = bits.RotateLeft32(state[b]^state[c], -12)
+	state[a] = state[a] + state[b] + my
+	state[d] = bits.RotateLeft32(state[d]^state[a], -8)
+	state[c] = state[c] + state[d]
+	state[b] = bits.RotateLeft32(state[b]^state[c], -7)
+}
+
+func blake3Round(state *[16]uint32, m *[16]uint32) {
+	// Columns.
+	blake3G(state, 0, 4, 8, 12, m[0], m[1])
+	blake3G(state, 1, 5, 9, 13, m[2], m[3])
+	blake3G(state, 2, 6, 10, 14, m[4], m[5])
+	blake3G(state, 3, 7, 11, 15, m[6], m[7])
+	// Diagonals.
+	blake3G(state, 0, 5, 10, 15, m[8], m[9])
+	blake3G(state, 1, 6, 11, 12, m[10], m[11])
+	blake3G(state, 2, 7, 8, 13, m[12], m[13])
+	blake3G(state, 3, 4, 9, 14, m[14], m[15])
+}
+
+func blake3Compress(cv [8]uint32, block [16]uint32, counter uint64, blockLen uint32, flags uint32) [16]uint32 {
+	state := [16]uint32{
+		cv[0], cv[1], cv[2], cv[3], cv[4], cv[5], cv[6], cv[7],
+		blake3IV[0], blake3IV[1], blake3IV[2], blake3IV[3],
+		uint32(counter), uint32(counter >> 32), blockLen, flags,
+	}
+	for round := 0; round < 7; round++ {
+		blake3Round(&state, &block)
+		if round < 6 {
+			var permuted [16]uint32
+			for i, j := range blake3MsgPermutation {
+				permuted[i] = block[j]
+			}
+			block = permuted
+		}
+	}
+	for i := 0; i < 8; i++ {
+		state[i] ^= state[i+8]
+		state[i+8] ^= cv[i]
+	}
+	return state
+}
+
+func blake3Words(block []byte) (words [16]uint32) {
+	var buf [blake3BlockLen]byte
+	copy(buf[:], block)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint32(buf[4*i:])
+	}
+	return words
+}
+
+func blake3First8(words [16]uint32) (out [8]uint32) {
+	copy(out[:], words[:8])
+	return out
+}
+
+// blake3Output is a node of the tree, before compression; the root node is
+// compressed with the root flag.
+type blake3Output struct {
+	cv       [8]uint32
+	block    [16]uint32
+	counter  uint64
+	blockLen uint32
+	flags    uint32
+}
+
+func (o *blake3Output) chainingValue() [8]uint32 {
+	return blake3First8(blake3Compress(o.cv, o.block, o.counter, o.blockLen, o.flags))
+}
+
+func (o *blake3Output) rootHash() (out [Size]byte) {
+	words := blake3Compress(o.cv, o.block, 0, o.blockLen, o.flags|blake3Root)
+	for i := 0; i < 8; i++ {
+		binary.LittleEndian.PutUint32(out[4*i:], words[i])
+	}
+	return out
+}
+
+func blake3ParentOutput(left, right [8]uint32) *blake3Output {
+	var block [16]uint32
+	copy(block[:8], left[:])
+	copy(block[8:], right[:])
+	return &blake3Output{
+		cv:       blake3IV,
+		block:    block,
+		blockLen: blake3BlockLen,
+		flags:    blake3Parent,
+	}
+}
+
+type blake3ChunkState struct {
+	cv               [8]uint32
+	counter          uint64
+	block            [blake3BlockLen]byte
+	blockLen         int
+	blocksCompressed int
+}
+
+func newBlake3ChunkState(counter uint64) blake3ChunkState {
+	return blake3ChunkState{cv: blake3IV, counter: counter}
+}
+
+func (cs *blake3ChunkState) len() int {
+	return blake3BlockLen*cs.blocksCompressed + cs.blockLen
+}
+
+func (cs *blake3ChunkState) startFlag() uint32 {
+	if cs.blocksCompressed == 0 {
+		return blake3ChunkStart
+	}
+	return 0
+}
+
+func (cs *blake3ChunkState) update(input []byte) {
+	for len(input) > 0 {
+		// The last block of the chunk is compressed by output,
+		// so a full block is only compressed when more input follows.
+		if cs.blockLen == blake3BlockLen {
+			cs.cv = blake3First8(blake3Compress(cs.cv, blake3Words(cs.block[:]), cs.counter, blake3BlockLen, cs.startFlag()))
+			cs.blocksCompressed++
+			cs.block = [blake3BlockLen]byte{}
+			cs.blockLen = 0
+		}
+		n := copy(cs.block[cs.blockLen:], input)
+		cs.blockLen += n
+		input = input[n:]
+	}
+}
+
+func (cs *blake3ChunkState) output() *blake3Output {
+	return &blake3Output{
+		cv:       cs.cv,
+		block:    blake3Words(cs.block[:cs.blockLen]),
+		counter:  cs.counter,
+		blockLen: uint32(cs.blockLen),
+		flags:    cs.startFlag() | blake3ChunkEnd,
+	}
+}
+
+type blake3Hasher struct {
+	chunk   blake3ChunkState
+	cvStack [][8]uint32
+}
+
+// NewBlake3 returns a new hash.Hash computing BLAKE3 hashes of Size bytes.
+func NewBlake3() hash.Hash {
+	h := &blake3Hasher{}
+	h.Reset()
+	return h
+}
+
+func (h *blake3Hasher) Reset() {
+	h.chunk = newBlake3ChunkState(0)
+	h.cvStack = h.cvStack[:0]
+}
+
+func (h *blake3Hasher) Size() int { return Size }
+
+func (h *blake3Hasher) BlockSize() int { return blake3BlockLen }
+
+// addChunkChainingValue pushes the chaining value of a completed chunk,
+// merging first the completed subtrees: their number is the number of
+// trailing zeros of the total number of chunks.
+func (h *blake3Hasher) addChunkChainingValue(cv [8]uint32, totalChunks uint64) {
+	for totalChunks&1 == 0 {
+		left := h.cvStack[len(h.cvStack)-1]
+		h.cvStack = h.cvStack[:len(h.cvStack)-1]
+		cv = blake3ParentOutput(left, cv).chainingValue()
+		totalChunks >>= 1
+	}
+	h.cvStack = append(h.cvStack, cv)
+}
+
+func (h *blake3Hasher) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// The last chunk is compressed by Sum,
+		// so a full chunk is only finalized when more input follows.
+		if h.chunk.len() == blake3ChunkLen {
+			cv := h.chunk.output().chainingValue()
+			totalChunks := h.chunk.counter + 1
+			h.addChunkChainingValue(cv, totalChunks)
+			h.chunk = newBlake3ChunkState(totalChunks)
+		}
+		take := blake3ChunkLen - h.chunk.len()
+		if take > len(p) {
+			take = len(p)
+		}
+		h.chunk.update(p[:take])
+		p = p[take:]
+	}
+	return n, nil
+}
+
+func (h *blake3Hasher) Sum(b []byte) []byte {
+	output := h.chunk.output()
+	for i := len(h.cvStack) - 1; i >= 0; i-- {
+		output = blake3ParentOutput(h.cvStack[i], output.chainingValue())
+	}
+	out := output.rootHash()
+	return append(b, out[:]...)
+}
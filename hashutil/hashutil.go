@@ -0,0 +1,109 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hashutil provides the hash functions of the Solana runtime (the
+// sol_sha256, sol_keccak256 and sol_blake3 syscalls) and the domain-separated
+// hashes built on them by programs, so that clients compute the same hashes
+// as the programs they interact with.
+package hashutil
+
+import (
+	"crypto/sha256"
+	"hash"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Size is the size of the hashes, in bytes.
+const Size = 32
+
+// Sha256 returns the SHA-256 hash of the concatenation of the slices,
+// like the sol_sha256 syscall (solana_program::hash::hashv).
+func Sha256(data ...[]byte) [Size]byte {
+	return sum(sha256.New(), data)
+}
+
+// Keccak256 returns the Keccak-256 hash of the concatenation of the slices,
+// like the sol_keccak256 syscall (solana_program::keccak::hashv).
+// This is the legacy Keccak used by Ethereum, not SHA3-256.
+func Keccak256(data ...[]byte) [Size]byte {
+	return sum(sha3.NewLegacyKeccak256(), data)
+}
+
+// Blake3 returns the BLAKE3 hash of the concatenation of the slices,
+// like the sol_blake3 syscall (solana_program::blake3::hashv).
+func Blake3(data ...[]byte) [Size]byte {
+	return sum(NewBlake3(), data)
+}
+
+func sum(h hash.Hash, data [][]byte) (out [Size]byte) {
+	for _, d := range data {
+		h.Write(d)
+	}
+	copy(out[:], h.Sum(nil))
+	return out
+}
+
+// Domain prefixes of the leaves and of the intermediate nodes of the merkle
+// trees of the runtime (solana-merkle-tree) and of the programs following it
+// (e.g. the Jito merkle distributor).
+const (
+	LeafPrefix         byte = 0
+	IntermediatePrefix byte = 1
+)
+
+// MerkleLeaf returns the SHA-256 hash of a merkle tree leaf: sha256(0 || data...).
+func MerkleLeaf(data ...[]byte) [Size]byte {
+	return Sha256(append([][]byte{{LeafPrefix}}, data...)...)
+}
+
+// MerkleNode returns the SHA-256 hash of an intermediate merkle tree node:
+// sha256(1 || left || right).
+func MerkleNode(left, right [Size]byte) [Size]byte {
+	return Sha256([]byte{IntermediatePrefix}, left[:], right[:])
+}
+
+// Namespaces of the Anchor discriminators.
+const (
+	AnchorGlobalNamespace  = "global"
+	AnchorAccountNamespace = "account"
+	AnchorEventNamespace   = "event"
+	AnchorStateNamespace   = "state"
+)
+
+// AnchorSighash returns the Anchor discriminator of the name in the
+// namespace: the first 8 bytes of sha256("<namespace>:<name>").
+func AnchorSighash(namespace string, name string) (out [8]byte) {
+	h := Sha256([]byte(namespace + ":" + name))
+	copy(out[:], h[:8])
+	return out
+}
+
+// AnchorInstructionDiscriminator returns the discriminator of the instruction,
+// whose name is the snake_case name of its handler (e.g. "new_claim").
+func AnchorInstructionDiscriminator(name string) [8]byte {
+	return AnchorSighash(AnchorGlobalNamespace, name)
+}
+
+// AnchorAccountDiscriminator returns the discriminator of the account,
+// whose name is the CamelCase name of its type (e.g. "ClaimStatus").
+func AnchorAccountDiscriminator(name string) [8]byte {
+	return AnchorSighash(AnchorAccountNamespace, name)
+}
+
+// AnchorEventDiscriminator returns the discriminator of the event,
+// whose name is the CamelCase name of its type.
+func AnchorEventDiscriminator(name string) [8]byte {
+	return AnchorSighash(AnchorEventNamespace, name)
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashutil
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestSha256(t *testing.T) {
+	h := Sha256()
+	require.Equal(t, mustHex(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"), h[:])
+	h = Sha256([]byte("a"), []byte("bc"))
+	require.Equal(t, mustHex(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"), h[:])
+}
+
+func TestKeccak256(t *testing.T) {
+	h := Keccak256()
+	require.Equal(t, mustHex(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"), h[:])
+	h = Keccak256([]byte("ab"), []byte("c"))
+	require.Equal(t, mustHex(t, "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"), h[:])
+}
+
+func TestBlake3(t *testing.T) {
+	h := Blake3()
+	require.Equal(t, mustHex(t, "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262"), h[:])
+	h = Blake3([]byte("a"), []byte("bc"))
+	require.Equal(t, mustHex(t, "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85"), h[:])
+
+	// Official test vectors: the input is the sequence 0, 1, ..., 250, 0, 1, ...
+	for _, vector := range []struct {
+		len  int
+		hash string
+	}{
+		{1024, "42214739f095a406f3fc83deb889744ac00df831c10daa55189b5d121c855af7"},
+		{1025, "d00278ae47eb27b34faecf67b4fe263f82d5412916c1ffd97c8cb7fb814b8444"},
+		{2048, "e776b6028c7cd22a4d0ba182a8bf62205d2ef576467e838ed6f2529b85fba24a"},
+	} {
+		input := make([]byte, vector.len)
+		for i := range input {
+			input[i] = byte(i % 251)
+		}
+		h := Blake3(input)
+		require.Equal(t, mustHex(t, vector.hash), h[:], vector.len)
+
+		// Written in uneven pieces.
+		hasher := NewBlake3()
+		for i := 0; i < len(input); i += 100 {
+			end := i + 100
+			if end > len(input) {
+				end = len(input)
+			}
+			hasher.Write(input[i:end])
+		}
+		require.Equal(t, mustHex(t, vector.hash), hasher.Sum(nil), vector.len)
+	}
+}
+
+func TestMerkle(t *testing.T) {
+	leaf := MerkleLeaf([]byte("a"), []byte("b"))
+	require.Equal(t, Sha256([]byte{0}, []byte("ab")), leaf)
+	require.Equal(t, Sha256([]byte{1}, leaf[:], leaf[:]), MerkleNode(leaf, leaf))
+}
+
+func TestAnchorDiscriminators(t *testing.T) {
+	require.Equal(t, [8]byte{0xaf, 0xaf, 0x6d, 0x1f, 0x0d, 0x98, 0x9b, 0xed}, AnchorInstructionDiscriminator("initialize"))
+	require.Equal(t, [8]byte{0x4e, 0xb1, 0x62, 0x7b, 0xd2, 0x15, 0xbb, 0x53}, AnchorInstructionDiscriminator("new_claim"))
+
+	h := Sha256([]byte("account:ClaimStatus"))
+	d := AnchorAccountDiscriminator("ClaimStatus")
+	require.Equal(t, h[:8], d[:])
+	require.Equal(t, AnchorSighash("event", "Claimed"), AnchorEventDiscriminator("Claimed"))
+}
@@ -0,0 +1,169 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hdwallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// DefaultGapLimit is the default number of consecutive unused accounts
+// after which Discover stops.
+const DefaultGapLimit = 20
+
+type DiscoverOpts struct {
+	// The derivation path of the accounts; defaults to PathBIP44Change.
+	Path PathFunc
+
+	// The number of consecutive unused accounts after which the discovery stops;
+	// defaults to DefaultGapLimit.
+	GapLimit int
+
+	// The maximum number of accounts to derive; zero means no limit.
+	MaxAccounts int
+
+	// Also consider used the accounts that have a transaction history
+	// (but no balance); this costs one more request per account.
+	CheckHistory bool
+}
+
+// DiscoveredAccount is an account found by Discover.
+type DiscoveredAccount struct {
+	Index      uint32
+	Path       string
+	PrivateKey solana.PrivateKey
+
+	// Lamports of the account.
+	Lamports uint64
+	// Number of token accounts (Token and Token-2022) owned by the account.
+	TokenAccounts int
+	// Whether the account has a transaction history (only set if CheckHistory).
+	HasHistory bool
+}
+
+func (acc *DiscoveredAccount) PublicKey() solana.PublicKey {
+	return acc.PrivateKey.PublicKey()
+}
+
+func (acc *DiscoveredAccount) isUsed() bool {
+	return acc.Lamports > 0 || acc.TokenAccounts > 0 || acc.HasHistory
+}
+
+// Discover derives the accounts of the seed in sequence, and returns the used ones
+// (with lamports, token accounts, or optionally a transaction history),
+// stopping after GapLimit consecutive unused accounts.
+func Discover(ctx context.Context, rpcClient *rpc.Client, seed []byte, opts *DiscoverOpts) ([]*DiscoveredAccount, error) {
+	if opts == nil {
+		opts = &DiscoverOpts{}
+	}
+	pathFunc := opts.Path
+	if pathFunc == nil {
+		pathFunc = PathBIP44Change
+	}
+	gapLimit := opts.GapLimit
+	if gapLimit <= 0 {
+		gapLimit = DefaultGapLimit
+	}
+
+	var out []*DiscoveredAccount
+	gap := 0
+	for index := uint32(0); gap < gapLimit; {
+		// Check the accounts in batches of the gap size.
+		batchSize := gapLimit - gap
+		if opts.MaxAccounts > 0 {
+			if int(index) >= opts.MaxAccounts {
+				break
+			}
+			if remaining := opts.MaxAccounts - int(index); batchSize > remaining {
+				batchSize = remaining
+			}
+		}
+		batch := make([]*DiscoveredAccount, batchSize)
+		keys := make([]solana.PublicKey, batchSize)
+		for i := range batch {
+			path := pathFunc(index + uint32(i))
+			key, err := DeriveKey(seed, path)
+			if err != nil {
+				return nil, err
+			}
+			batch[i] = &DiscoveredAccount{
+				Index:      index + uint32(i),
+				Path:       FormatPath(path),
+				PrivateKey: key,
+			}
+			keys[i] = key.PublicKey()
+		}
+		index += uint32(batchSize)
+
+		resp, err := rpcClient.GetMultipleAccountsWithOpts(ctx, keys, &rpc.GetMultipleAccountsOpts{
+			Encoding: solana.EncodingBase64,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get accounts: %w", err)
+		}
+		for i, acc := range batch {
+			if i < len(resp.Value) && resp.Value[i] != nil {
+				acc.Lamports = resp.Value[i].Lamports
+			}
+			if err := checkUsage(ctx, rpcClient, acc, opts.CheckHistory); err != nil {
+				return nil, err
+			}
+			if acc.isUsed() {
+				out = append(out, acc)
+				gap = 0
+			} else {
+				gap++
+			}
+		}
+	}
+	return out, nil
+}
+
+// checkUsage counts the token accounts of the account, and looks for its history if needed.
+func checkUsage(ctx context.Context, rpcClient *rpc.Client, acc *DiscoveredAccount, checkHistory bool) error {
+	for _, programID := range []solana.PublicKey{solana.TokenProgramID, solana.Token2022ProgramID} {
+		programID := programID
+		resp, err := rpcClient.GetTokenAccountsByOwner(
+			ctx,
+			acc.PublicKey(),
+			&rpc.GetTokenAccountsConfig{ProgramId: &programID},
+			&rpc.GetTokenAccountsOpts{
+				Encoding: solana.EncodingBase64,
+				// Only the number of accounts is needed.
+				DataSlice: &rpc.DataSlice{Offset: new(uint64), Length: new(uint64)},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to get token accounts of %s: %w", acc.PublicKey(), err)
+		}
+		if resp != nil {
+			acc.TokenAccounts += len(resp.Value)
+		}
+	}
+	if checkHistory && !acc.isUsed() {
+		limit := 1
+		sigs, err := rpcClient.GetSignaturesForAddressWithOpts(ctx, acc.PublicKey(), &rpc.GetSignaturesForAddressOpts{
+			Limit: &limit,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to get history of %s: %w", acc.PublicKey(), err)
+		}
+		acc.HasHistory = len(sigs) > 0
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hdwallet derives Solana keypairs from BIP39 mnemonics
+// (with SLIP-0010 ed25519 derivation, as Phantom, Solflare and solana-keygen do),
+// and discovers the accounts of a wallet being recovered.
+package hdwallet
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// HardenedOffset is added to the index of hardened path components;
+// ed25519 only supports hardened derivation.
+const HardenedOffset uint32 = 0x80000000
+
+// SolanaCoinType is the SLIP-0044 coin type of Solana.
+const SolanaCoinType = 501
+
+// SeedFromMnemonic returns the BIP39 seed of the mnemonic and (optional) passphrase.
+// The words are normalized to lowercase and separated by a single space;
+// the mnemonic is not validated against the BIP39 wordlist.
+func SeedFromMnemonic(mnemonic string, passphrase string) []byte {
+	normalized := strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+	return pbkdf2.Key([]byte(normalized), []byte("mnemonic"+passphrase), 2048, 64, sha512.New)
+}
+
+// ParsePath parses a derivation path like "m/44'/501'/0'/0'".
+// All the components must be hardened.
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path %q: must start with \"m\"", path)
+	}
+	out := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		if !strings.HasSuffix(part, "'") {
+			return nil, fmt.Errorf("invalid derivation path %q: component %q is not hardened", path, part)
+		}
+		index, err := strconv.ParseUint(strings.TrimSuffix(part, "'"), 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path %q: %w", path, err)
+		}
+		out = append(out, uint32(index)+HardenedOffset)
+	}
+	return out, nil
+}
+
+// FormatPath formats a derivation path (e.g. "m/44'/501'/0'/0'").
+func FormatPath(path []uint32) string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, index := range path {
+		b.WriteString("/")
+		b.WriteString(strconv.FormatUint(uint64(index&^HardenedOffset), 10))
+		if index >= HardenedOffset {
+			b.WriteString("'")
+		}
+	}
+	return b.String()
+}
+
+// PathFunc returns the derivation path of the account at the provided index.
+type PathFunc func(index uint32) []uint32
+
+// PathBIP44Change is m/44'/501'/{index}'/0', used by Phantom, Solflare and
+// solana-keygen (with the "prompt://?key=index/0" syntax).
+func PathBIP44Change(index uint32) []uint32 {
+	return []uint32{44 + HardenedOffset, SolanaCoinType + HardenedOffset, index + HardenedOffset, HardenedOffset}
+}
+
+// PathBIP44 is m/44'/501'/{index}', used by some older wallets.
+func PathBIP44(index uint32) []uint32 {
+	return []uint32{44 + HardenedOffset, SolanaCoinType + HardenedOffset, index + HardenedOffset}
+}
+
+// DeriveKey derives the private key at the provided path from the seed,
+// with SLIP-0010 for ed25519.
+func DeriveKey(seed []byte, path []uint32) (solana.PrivateKey, error) {
+	key, chainCode := hmacSHA512([]byte("ed25519 seed"), seed)
+	for _, index := range path {
+		if index < HardenedOffset {
+			return nil, fmt.Errorf("ed25519 only supports hardened derivation; got index %d", index)
+		}
+		data := make([]byte, 0, 1+32+4)
+		data = append(data, 0x00)
+		data = append(data, key...)
+		data = append(data, make([]byte, 4)...)
+		binary.BigEndian.PutUint32(data[33:], index)
+		key, chainCode = hmacSHA512(chainCode, data)
+	}
+	return solana.PrivateKey(ed25519.NewKeyFromSeed(key)), nil
+}
+
+func hmacSHA512(key []byte, data []byte) (left []byte, right []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+// DeriveMnemonicKey derives the private key at the provided index from the mnemonic.
+// If pathFunc is nil, PathBIP44Change is used.
+func DeriveMnemonicKey(mnemonic string, passphrase string, pathFunc PathFunc, index uint32) (solana.PrivateKey, error) {
+	if pathFunc == nil {
+		pathFunc = PathBIP44Change
+	}
+	return DeriveKey(SeedFromMnemonic(mnemonic, passphrase), pathFunc(index))
+}
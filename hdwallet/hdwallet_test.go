@@ -0,0 +1,156 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hdwallet
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestSeedFromMnemonic(t *testing.T) {
+	// From the BIP39 test vectors.
+	require.Equal(t,
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		hex.EncodeToString(SeedFromMnemonic(testMnemonic, "TREZOR")),
+	)
+	require.Equal(t, SeedFromMnemonic(testMnemonic, ""), SeedFromMnemonic("  Abandon "+testMnemonic[8:]+"\n", ""))
+}
+
+func TestDeriveKey(t *testing.T) {
+	// From the SLIP-0010 test vectors for ed25519.
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	master, err := DeriveKey(seed, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", hex.EncodeToString(master[:32]))
+
+	path, err := ParsePath("m/0'")
+	require.NoError(t, err)
+	child, err := DeriveKey(seed, path)
+	require.NoError(t, err)
+	require.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", hex.EncodeToString(child[:32]))
+
+	_, err = DeriveKey(seed, []uint32{0})
+	require.Error(t, err)
+}
+
+func TestParsePath(t *testing.T) {
+	path, err := ParsePath("m/44'/501'/3'/0'")
+	require.NoError(t, err)
+	require.Equal(t, PathBIP44Change(3), path)
+	require.Equal(t, "m/44'/501'/3'/0'", FormatPath(path))
+	require.Equal(t, "m/44'/501'/3'", FormatPath(PathBIP44(3)))
+
+	_, err = ParsePath("m/44'/501'/0")
+	require.Error(t, err)
+	_, err = ParsePath("44'/501'")
+	require.Error(t, err)
+}
+
+// walletNode is a rpc.JSONRPCClient where only some accounts are used.
+type walletNode struct {
+	lamports map[solana.PublicKey]uint64
+	tokens   map[solana.PublicKey]bool
+}
+
+func (node *walletNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	var result interface{}
+	switch method {
+	case "getMultipleAccounts":
+		values := []interface{}{}
+		for _, key := range params[0].([]solana.PublicKey) {
+			if lamports, ok := node.lamports[key]; ok {
+				values = append(values, map[string]interface{}{
+					"lamports": lamports, "owner": solana.SystemProgramID, "data": []string{"", "base64"},
+				})
+			} else {
+				values = append(values, nil)
+			}
+		}
+		result = map[string]interface{}{"context": map[string]interface{}{"slot": 1}, "value": values}
+	case "getTokenAccountsByOwner":
+		values := []interface{}{}
+		config := params[1].(rpc.M)
+		if node.tokens[params[0].(solana.PublicKey)] && config["programId"].(*solana.PublicKey).Equals(solana.TokenProgramID) {
+			values = append(values, map[string]interface{}{
+				"pubkey":  solana.PublicKey{1},
+				"account": map[string]interface{}{"lamports": 1, "owner": solana.TokenProgramID, "data": []string{"", "base64"}},
+			})
+		}
+		result = map[string]interface{}{"context": map[string]interface{}{"slot": 1}, "value": values}
+	default:
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, out)
+}
+
+func (node *walletNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestDiscover(t *testing.T) {
+	seed := SeedFromMnemonic(testMnemonic, "")
+	key := func(index uint32) solana.PublicKey {
+		k, err := DeriveKey(seed, PathBIP44Change(index))
+		require.NoError(t, err)
+		return k.PublicKey()
+	}
+	node := &walletNode{
+		lamports: map[solana.PublicKey]uint64{
+			key(0): 100,
+			key(4): 0, // exists, but empty
+			key(6): 5,
+		},
+		tokens: map[solana.PublicKey]bool{
+			key(9): true,
+		},
+	}
+	client := rpc.NewWithCustomRPCClient(node)
+
+	// The account 6 is after a gap of 5 unused accounts.
+	accounts, err := Discover(context.Background(), client, seed, &DiscoverOpts{GapLimit: 3})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, uint32(0), accounts[0].Index)
+	require.Equal(t, uint64(100), accounts[0].Lamports)
+
+	accounts, err = Discover(context.Background(), client, seed, &DiscoverOpts{GapLimit: 6})
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+	require.Equal(t, uint32(6), accounts[1].Index)
+	require.Equal(t, "m/44'/501'/6'/0'", accounts[1].Path)
+	require.Equal(t, key(6), accounts[1].PublicKey())
+	require.Equal(t, uint32(9), accounts[2].Index)
+	require.Equal(t, 1, accounts[2].TokenAccounts)
+
+	accounts, err = Discover(context.Background(), client, seed, &DiscoverOpts{GapLimit: 6, MaxAccounts: 7})
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+}
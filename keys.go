@@ -23,10 +23,13 @@ import (
 	"crypto/ed25519"
 	crypto_rand "crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
+	"runtime"
 	"sort"
 
 	"filippo.io/edwards25519"
@@ -66,10 +69,52 @@ func PrivateKeyFromSolanaKeygenFile(file string) (PrivateKey, error) {
 	return PrivateKey([]byte(values)), nil
 }
 
+// String returns a redacted representation of the private key, so that
+// the key is not leaked by accident in logs or error messages.
+// Use Base58 to export the key.
 func (k PrivateKey) String() string {
+	if len(k) != ed25519.PrivateKeySize {
+		return "PrivateKey(REDACTED)"
+	}
+	return "PrivateKey(REDACTED, public key " + k.PublicKey().String() + ")"
+}
+
+// Format implements fmt.Formatter so that all the formatting
+// verbs (%s, %v, %x, %#v, ...) print the redacted String.
+func (k PrivateKey) Format(f fmt.State, verb rune) {
+	io.WriteString(f, k.String())
+}
+
+// Base58 returns the private key encoded in base58,
+// as accepted by PrivateKeyFromBase58.
+func (k PrivateKey) Base58() string {
 	return base58.Encode(k)
 }
 
+// Equals compares two private keys in constant time.
+func (k PrivateKey) Equals(other PrivateKey) bool {
+	return subtle.ConstantTimeCompare(k, other) == 1
+}
+
+// Zero overwrites the private key with zeros in place.
+// Call it once the key is not needed anymore to limit the time
+// the key material stays in memory.
+func (k PrivateKey) Zero() {
+	for i := range k {
+		k[i] = 0
+	}
+	// Keep the writes from being optimized away.
+	runtime.KeepAlive(k)
+}
+
+// Wipe zeroes the private key and releases it; any later use of the key
+// fails instead of signing with a zero key: Sign returns
+// ErrInvalidPrivateKeyLength, and PublicKey returns the zero key.
+func (k *PrivateKey) Wipe() {
+	k.Zero()
+	*k = nil
+}
+
 func NewRandomPrivateKey() (PrivateKey, error) {
 	pub, priv, err := ed25519.GenerateKey(crypto_rand.Reader)
 	if err != nil {
@@ -80,7 +125,14 @@ func NewRandomPrivateKey() (PrivateKey, error) {
 	return PrivateKey(priv), nil
 }
 
+// ErrInvalidPrivateKeyLength is returned when signing with a private key
+// that is not 64 bytes long (e.g. a key that was wiped).
+var ErrInvalidPrivateKeyLength = errors.New("invalid private key length")
+
 func (k PrivateKey) Sign(payload []byte) (Signature, error) {
+	if len(k) != ed25519.PrivateKeySize {
+		return Signature{}, ErrInvalidPrivateKeyLength
+	}
 	p := ed25519.PrivateKey(k)
 	signData, err := p.Sign(crypto_rand.Reader, payload, crypto.Hash(0))
 	if err != nil {
@@ -93,7 +145,12 @@ func (k PrivateKey) Sign(payload []byte) (Signature, error) {
 	return signature, err
 }
 
+// PublicKey returns the public key of the private key,
+// or the zero key if the private key is not 64 bytes long (e.g. if it was wiped).
 func (k PrivateKey) PublicKey() PublicKey {
+	if len(k) != ed25519.PrivateKeySize {
+		return PublicKey{}
+	}
 	p := ed25519.PrivateKey(k)
 	pub := p.Public().(ed25519.PublicKey)
 
@@ -184,6 +241,11 @@ func (p PublicKey) Equals(pb PublicKey) bool {
 	return p == pb
 }
 
+// EqualsConstantTime is like Equals, but compares the keys in constant time.
+func (p PublicKey) EqualsConstantTime(pb PublicKey) bool {
+	return subtle.ConstantTimeCompare(p[:], pb[:]) == 1
+}
+
 // IsAnyOf checks if p is equals to any of the provided keys.
 func (p PublicKey) IsAnyOf(keys ...PublicKey) bool {
 	for _, k := range keys {
@@ -608,6 +670,22 @@ func FindAssociatedTokenAddress(
 	return findAssociatedTokenAddressAndBumpSeed(
 		wallet,
 		mint,
+		TokenProgramID,
+		SPLAssociatedTokenAccountProgramID,
+	)
+}
+
+// FindAssociatedTokenAddressWithProgram is like FindAssociatedTokenAddress,
+// but for mints owned by the provided token program (e.g. Token2022ProgramID).
+func FindAssociatedTokenAddressWithProgram(
+	wallet PublicKey,
+	mint PublicKey,
+	tokenProgramID PublicKey,
+) (PublicKey, uint8, error) {
+	return findAssociatedTokenAddressAndBumpSeed(
+		wallet,
+		mint,
+		tokenProgramID,
 		SPLAssociatedTokenAccountProgramID,
 	)
 }
@@ -615,11 +693,12 @@ func FindAssociatedTokenAddress(
 func findAssociatedTokenAddressAndBumpSeed(
 	walletAddress PublicKey,
 	splTokenMintAddress PublicKey,
+	tokenProgramID PublicKey,
 	programID PublicKey,
 ) (PublicKey, uint8, error) {
 	return FindProgramAddress([][]byte{
 		walletAddress[:],
-		TokenProgramID[:],
+		tokenProgramID[:],
 		splTokenMintAddress[:],
 	},
 		programID,
@@ -0,0 +1,225 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"crypto/ed25519"
+	crypto_rand "crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	pemTypePrivateKey        = "PRIVATE KEY"
+	pemTypePublicKey         = "PUBLIC KEY"
+	pemTypeOpenSSHPrivateKey = "OPENSSH PRIVATE KEY"
+)
+
+// PrivateKeyFromDER decodes an ed25519 private key
+// from its PKCS#8 DER encoding.
+func PrivateKeyFromDER(der []byte) (PrivateKey, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse PKCS#8 key: %w", err)
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("not an ed25519 key: %T", key)
+	}
+	return PrivateKey(edKey), nil
+}
+
+// PrivateKeyFromPEM decodes an ed25519 private key from a PEM block,
+// either PKCS#8 ("PRIVATE KEY", as written by `openssl genpkey -algorithm ed25519`)
+// or OpenSSH ("OPENSSH PRIVATE KEY", as written by `ssh-keygen -t ed25519`).
+// Encrypted OpenSSH keys require PrivateKeyFromOpenSSH.
+func PrivateKeyFromPEM(data []byte) (PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	switch block.Type {
+	case pemTypePrivateKey:
+		return PrivateKeyFromDER(block.Bytes)
+	case pemTypeOpenSSHPrivateKey:
+		return PrivateKeyFromOpenSSH(data, nil)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+// PrivateKeyFromOpenSSH decodes an ed25519 private key in the OpenSSH format.
+// The passphrase is required only if the key is encrypted.
+func PrivateKeyFromOpenSSH(data []byte, passphrase []byte) (PrivateKey, error) {
+	var key interface{}
+	var err error
+	if len(passphrase) > 0 {
+		key, err = ssh.ParseRawPrivateKeyWithPassphrase(data, passphrase)
+	} else {
+		key, err = ssh.ParseRawPrivateKey(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse OpenSSH key: %w", err)
+	}
+	switch edKey := key.(type) {
+	case *ed25519.PrivateKey:
+		return PrivateKey(*edKey), nil
+	case ed25519.PrivateKey:
+		return PrivateKey(edKey), nil
+	default:
+		return nil, fmt.Errorf("not an ed25519 key: %T", key)
+	}
+}
+
+// DER encodes the private key in PKCS#8 DER.
+func (k PrivateKey) DER() ([]byte, error) {
+	if len(k) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: %d", len(k))
+	}
+	return x509.MarshalPKCS8PrivateKey(ed25519.PrivateKey(k))
+}
+
+// PEM encodes the private key in a PKCS#8 "PRIVATE KEY" PEM block,
+// as read by openssl and most PKI tooling.
+func (k PrivateKey) PEM() ([]byte, error) {
+	der, err := k.DER()
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der}), nil
+}
+
+// OpenSSH encodes the private key, unencrypted, in the OpenSSH format
+// (as written by `ssh-keygen -t ed25519 -N ""`).
+func (k PrivateKey) OpenSSH(comment string) ([]byte, error) {
+	if len(k) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: %d", len(k))
+	}
+	pub := k.PublicKey()
+
+	var check [4]byte
+	if _, err := crypto_rand.Read(check[:]); err != nil {
+		return nil, err
+	}
+	checkInt := binary.BigEndian.Uint32(check[:])
+	private := ssh.Marshal(struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+	}{
+		Check1:  checkInt,
+		Check2:  checkInt,
+		KeyType: ssh.KeyAlgoED25519,
+		Pub:     pub[:],
+		Priv:    []byte(k),
+		Comment: comment,
+	})
+	// The private section is padded to the cipher block size (8 without encryption).
+	for i := byte(1); len(private)%8 != 0; i++ {
+		private = append(private, i)
+	}
+
+	sshPub, err := ssh.NewPublicKey(ed25519.PublicKey(pub[:]))
+	if err != nil {
+		return nil, err
+	}
+	body := ssh.Marshal(struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       sshPub.Marshal(),
+		PrivKeyBlock: private,
+	})
+	const magic = "openssh-key-v1\x00"
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  pemTypeOpenSSHPrivateKey,
+		Bytes: append([]byte(magic), body...),
+	}), nil
+}
+
+// PublicKeyFromPEM decodes an ed25519 public key from
+// a PKIX "PUBLIC KEY" PEM block.
+func PublicKeyFromPEM(data []byte) (PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return PublicKey{}, errors.New("no PEM block found")
+	}
+	if block.Type != pemTypePublicKey {
+		return PublicKey{}, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("parse PKIX key: %w", err)
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return PublicKey{}, fmt.Errorf("not an ed25519 key: %T", key)
+	}
+	return PublicKeyFromBytes(edKey), nil
+}
+
+// PEM encodes the public key in a PKIX "PUBLIC KEY" PEM block.
+func (p PublicKey) PEM() ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(ed25519.PublicKey(p[:]))
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: der}), nil
+}
+
+// PublicKeyFromOpenSSH decodes an ed25519 public key in the
+// authorized_keys format ("ssh-ed25519 AAAA... comment").
+func PublicKeyFromOpenSSH(data []byte) (PublicKey, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey(data)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("parse OpenSSH public key: %w", err)
+	}
+	cryptoKey, ok := key.(ssh.CryptoPublicKey)
+	if !ok {
+		return PublicKey{}, fmt.Errorf("unsupported key type %q", key.Type())
+	}
+	edKey, ok := cryptoKey.CryptoPublicKey().(ed25519.PublicKey)
+	if !ok {
+		return PublicKey{}, fmt.Errorf("not an ed25519 key: %s", key.Type())
+	}
+	return PublicKeyFromBytes(edKey), nil
+}
+
+// OpenSSH encodes the public key in the authorized_keys format,
+// without trailing newline.
+func (p PublicKey) OpenSSH() string {
+	sshPub, err := ssh.NewPublicKey(ed25519.PublicKey(p[:]))
+	if err != nil {
+		// Cannot happen for a 32 bytes ed25519 key.
+		panic(err)
+	}
+	return strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(sshPub)), "\n")
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"flag"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -131,6 +132,56 @@ func TestPrivateKeyFromSolanaKeygenFile(t *testing.T) {
 	}
 }
 
+func TestPrivateKey_Redacted(t *testing.T) {
+	key, err := NewRandomPrivateKey()
+	require.NoError(t, err)
+	encoded := key.Base58()
+
+	expected := "PrivateKey(REDACTED, public key " + key.PublicKey().String() + ")"
+	require.Equal(t, expected, key.String())
+	for _, format := range []string{"%s", "%v", "%+v", "%#v", "%x", "%X", "%q", "%d"} {
+		require.Equal(t, expected, fmt.Sprintf(format, key), format)
+	}
+	wallet := Wallet{PrivateKey: key}
+	require.NotContains(t, fmt.Sprintf("%+v", wallet), encoded)
+	require.Equal(t, "PrivateKey(REDACTED)", PrivateKey{1, 2}.String())
+
+	decoded, err := PrivateKeyFromBase58(encoded)
+	require.NoError(t, err)
+	require.True(t, key.Equals(decoded))
+}
+
+func TestPrivateKey_Wipe(t *testing.T) {
+	key, err := NewRandomPrivateKey()
+	require.NoError(t, err)
+	other := append(PrivateKey{}, key...)
+	backing := other
+	require.True(t, key.Equals(other))
+
+	key.Zero()
+	require.Equal(t, make(PrivateKey, 64), key)
+	require.False(t, key.Equals(other))
+
+	other.Wipe()
+	require.Nil(t, other)
+	require.Equal(t, make(PrivateKey, 64), backing)
+
+	// Using the wiped key fails without panicking.
+	_, err = other.Sign([]byte("hello"))
+	require.True(t, errors.Is(err, ErrInvalidPrivateKeyLength))
+	require.True(t, other.PublicKey().IsZero())
+}
+
+func TestEqualsConstantTime(t *testing.T) {
+	a := PublicKey{1, 2, 3}
+	require.True(t, a.EqualsConstantTime(PublicKey{1, 2, 3}))
+	require.False(t, a.EqualsConstantTime(PublicKey{1, 2, 4}))
+
+	sig := Signature{1, 2, 3}
+	require.True(t, sig.EqualsConstantTime(Signature{1, 2, 3}))
+	require.False(t, sig.EqualsConstantTime(Signature{1, 2}))
+}
+
 func TestPublicKey_MarshalText(t *testing.T) {
 	keyString := "4wBqpZM9k69W87zdYXT2bRtLViWqTiJV3i2Kn9q7S6j"
 	keyParsed := MustPublicKeyFromBase58(keyString)
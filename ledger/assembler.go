@@ -0,0 +1,91 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Assembler collects the data shreds of a slot, in any order,
+// and decodes the entries of the slot once they are all received.
+type Assembler struct {
+	slot   uint64
+	shreds map[uint32]*Shred
+	// Index of the last data shred of the slot, -1 until received.
+	last int64
+}
+
+// NewAssembler returns an assembler of the entries of the slot.
+func NewAssembler(slot uint64) *Assembler {
+	return &Assembler{
+		slot:   slot,
+		shreds: make(map[uint32]*Shred),
+		last:   -1,
+	}
+}
+
+// Add adds a data shred of the slot; the code shreds are ignored.
+func (a *Assembler) Add(shred *Shred) error {
+	if shred.Slot != a.slot {
+		return fmt.Errorf("shred of slot %d, expected %d", shred.Slot, a.slot)
+	}
+	if shred.Type() != ShredTypeData {
+		return nil
+	}
+	if a.last >= 0 && int64(shred.Index) > a.last {
+		return fmt.Errorf("shred %d after the last shred %d of the slot", shred.Index, a.last)
+	}
+	if shred.LastInSlot() {
+		a.last = int64(shred.Index)
+	}
+	a.shreds[shred.Index] = shred
+	return nil
+}
+
+// Complete tells whether all the data shreds of the slot were received.
+func (a *Assembler) Complete() bool {
+	return a.last >= 0 && int64(len(a.shreds)) == a.last+1
+}
+
+// Entries decodes the entries of the slot, once complete.
+func (a *Assembler) Entries() ([]*Entry, error) {
+	if !a.Complete() {
+		return nil, errors.New("missing data shreds")
+	}
+	var entries []*Entry
+	var batch []byte
+	for index := uint32(0); int64(index) <= a.last; index++ {
+		shred := a.shreds[index]
+		data, err := shred.Data()
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, data...)
+		if !shred.DataComplete() {
+			continue
+		}
+		decoded, err := DecodeEntries(batch)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the entries of shreds up to %d: %w", index, err)
+		}
+		entries = append(entries, decoded...)
+		batch = nil
+	}
+	if len(batch) != 0 {
+		return nil, errors.New("last data shred does not complete the data")
+	}
+	return entries, nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ledger decodes the ledger data structures below the RPC API: the
+// entries of the blocks (see Entry) and the shreds they are split into to be
+// propagated by the validators (see Shred), e.g. to ingest the blocks directly
+// from the validators or from the Old Faithful archives.
+package ledger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/hashutil"
+)
+
+// Entry is a Proof of History entry: the hash of the previous entry hashed
+// NumHashes times, the last time together with the signatures of the
+// transactions of the entry, if any.
+type Entry struct {
+	NumHashes    uint64
+	Hash         solana.Hash
+	Transactions []*solana.Transaction
+}
+
+// IsTick tells whether the entry is a tick, i.e. it has no transactions.
+func (entry *Entry) IsTick() bool {
+	return len(entry.Transactions) == 0
+}
+
+// MarshalWithEncoder encodes the entry in the bincode format of the ledger.
+func (entry Entry) MarshalWithEncoder(encoder *bin.Encoder) error {
+	if err := encoder.WriteUint64(entry.NumHashes, binary.LittleEndian); err != nil {
+		return err
+	}
+	if err := encoder.WriteBytes(entry.Hash[:], false); err != nil {
+		return err
+	}
+	if err := encoder.WriteUint64(uint64(len(entry.Transactions)), binary.LittleEndian); err != nil {
+		return err
+	}
+	for _, tx := range entry.Transactions {
+		if err := tx.MarshalWithEncoder(encoder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalWithDecoder decodes the entry from the bincode format of the ledger.
+func (entry *Entry) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
+	entry.NumHashes, err = decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return fmt.Errorf("unable to read num hashes: %w", err)
+	}
+	if _, err = decoder.Read(entry.Hash[:]); err != nil {
+		return fmt.Errorf("unable to read hash: %w", err)
+	}
+	count, err := decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return fmt.Errorf("unable to read the number of transactions: %w", err)
+	}
+	if count > uint64(decoder.Remaining()) {
+		return fmt.Errorf("number of transactions %d is too large for remaining bytes %d", count, decoder.Remaining())
+	}
+	entry.Transactions = nil
+	if count > 0 {
+		entry.Transactions = make([]*solana.Transaction, count)
+	}
+	for i := range entry.Transactions {
+		entry.Transactions[i] = new(solana.Transaction)
+		if err := entry.Transactions[i].UnmarshalWithDecoder(decoder); err != nil {
+			return fmt.Errorf("unable to decode transaction %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// DecodeEntries decodes a batch of entries, i.e. the concatenated data of the
+// data shreds of a slot up to a shred completing the data (see
+// Shred.DataComplete).
+func DecodeEntries(data []byte) ([]*Entry, error) {
+	decoder := bin.NewBinDecoder(data)
+	count, err := decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the number of entries: %w", err)
+	}
+	if count > uint64(decoder.Remaining())/48 {
+		return nil, fmt.Errorf("number of entries %d is too large for remaining bytes %d", count, decoder.Remaining())
+	}
+	entries := make([]*Entry, count)
+	for i := range entries {
+		entries[i] = new(Entry)
+		if err := entries[i].UnmarshalWithDecoder(decoder); err != nil {
+			return nil, fmt.Errorf("unable to decode entry %d: %w", i, err)
+		}
+	}
+	return entries, nil
+}
+
+// EncodeEntries encodes a batch of entries, as decoded by DecodeEntries.
+func EncodeEntries(entries []*Entry) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	encoder := bin.NewBinEncoder(buf)
+	if err := encoder.WriteUint64(uint64(len(entries)), binary.LittleEndian); err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if err := entry.MarshalWithEncoder(encoder); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// TransactionsHash returns the hash mixed in the Proof of History by the
+// transactions of an entry: the root of the merkle tree of their signatures.
+func TransactionsHash(transactions []*solana.Transaction) solana.Hash {
+	var nodes [][hashutil.Size]byte
+	for _, tx := range transactions {
+		for _, sig := range tx.Signatures {
+			nodes = append(nodes, hashutil.MerkleLeaf(sig[:]))
+		}
+	}
+	if len(nodes) == 0 {
+		return solana.Hash{}
+	}
+	for len(nodes) > 1 {
+		next := make([][hashutil.Size]byte, 0, (len(nodes)+1)/2)
+		for i := 0; i < len(nodes); i += 2 {
+			right := nodes[i]
+			if i+1 < len(nodes) {
+				right = nodes[i+1]
+			}
+			next = append(next, hashutil.MerkleNode(nodes[i], right))
+		}
+		nodes = next
+	}
+	return solana.Hash(nodes[0])
+}
+
+// NextHash returns the hash of the entry following the hash of the previous
+// entry with the given number of hashes and transactions.
+func NextHash(prev solana.Hash, numHashes uint64, transactions []*solana.Transaction) solana.Hash {
+	if numHashes == 0 && len(transactions) == 0 {
+		return prev
+	}
+	hash := prev
+	for i := uint64(1); i < numHashes; i++ {
+		hash = hashutil.Sha256(hash[:])
+	}
+	if len(transactions) == 0 {
+		return hashutil.Sha256(hash[:])
+	}
+	mixin := TransactionsHash(transactions)
+	return hashutil.Sha256(hash[:], mixin[:])
+}
+
+// ErrInvalidEntryHash is returned when the hash of an entry
+// does not follow the hash of the previous entry.
+var ErrInvalidEntryHash = errors.New("invalid entry hash")
+
+// Verify checks that the hash of the entry follows the hash of the previous
+// entry (or the last blockhash for the first entry of a slot).
+func (entry *Entry) Verify(prev solana.Hash) error {
+	if NextHash(prev, entry.NumHashes, entry.Transactions) != entry.Hash {
+		return ErrInvalidEntryHash
+	}
+	return nil
+}
+
+// VerifyEntries checks the Proof of History of the entries, starting from the
+// hash preceding the first one; the hash of the last tick of a slot is its
+// blockhash.
+func VerifyEntries(prev solana.Hash, entries []*Entry) error {
+	for i, entry := range entries {
+		if err := entry.Verify(prev); err != nil {
+			return fmt.Errorf("entry %d: %w", i, err)
+		}
+		prev = entry.Hash
+	}
+	return nil
+}
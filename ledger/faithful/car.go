@@ -0,0 +1,139 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faithful
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// maxSectionSize bounds the size of the sections of a CAR file.
+const maxSectionSize = 32 << 20
+
+// CARReader reads the blocks of a CAR (Content Addressable aRchive) file,
+// the container of the Old Faithful archives; CARv2 files are read through
+// their inner CARv1 data.
+type CARReader struct {
+	r     *bufio.Reader
+	Roots []CID
+}
+
+// NewCARReader reads the header of the CAR file.
+func NewCARReader(r io.Reader) (*CARReader, error) {
+	cr := &CARReader{r: bufio.NewReaderSize(r, 1<<20)}
+	header, err := cr.readHeader()
+	if err != nil {
+		return nil, err
+	}
+	version, err := fields{header["version"]}.uint(0, "CAR version")
+	if err != nil {
+		return nil, err
+	}
+	switch version {
+	case 1:
+	case 2:
+		// The CARv2 pragma is followed by a fixed header
+		// locating the inner CARv1 data.
+		var v2 [40]byte
+		if _, err := io.ReadFull(cr.r, v2[:]); err != nil {
+			return nil, fmt.Errorf("unable to read the CARv2 header: %w", err)
+		}
+		dataOffset := binary.LittleEndian.Uint64(v2[16:])
+		dataSize := binary.LittleEndian.Uint64(v2[24:])
+		// The pragma is 11 bytes long.
+		if dataOffset < 51 {
+			return nil, errors.New("invalid CARv2 data offset")
+		}
+		if _, err := io.CopyN(ioutil.Discard, cr.r, int64(dataOffset-51)); err != nil {
+			return nil, fmt.Errorf("unable to seek the CARv2 data: %w", err)
+		}
+		cr.r = bufio.NewReaderSize(io.LimitReader(cr.r, int64(dataSize)), 1<<20)
+		if header, err = cr.readHeader(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported CAR version %d", version)
+	}
+	roots, err := fields{header["roots"]}.links(0, "CAR roots")
+	if err != nil {
+		return nil, err
+	}
+	cr.Roots = roots
+	return cr, nil
+}
+
+func (cr *CARReader) readHeader() (map[string]interface{}, error) {
+	data, err := cr.readSection()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the CAR header: %w", err)
+	}
+	value, err := decodeCBOR(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the CAR header: %w", err)
+	}
+	header, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid CAR header")
+	}
+	return header, nil
+}
+
+func (cr *CARReader) readSection() ([]byte, error) {
+	size, err := binary.ReadUvarint(cr.r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxSectionSize {
+		return nil, fmt.Errorf("CAR section too large: %d bytes", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(cr.r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Next returns the next block of the CAR file, after checking its data
+// against its CID; it returns io.EOF at the end of the file.
+func (cr *CARReader) Next() (CID, []byte, error) {
+	section, err := cr.readSection()
+	if err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return "", nil, fmt.Errorf("truncated CAR file: %w", err)
+		}
+		return "", nil, err
+	}
+	cid, n, err := readCID(section)
+	if err != nil {
+		return "", nil, err
+	}
+	data := section[n:]
+	code, digest, err := cid.digest()
+	if err != nil {
+		return "", nil, err
+	}
+	if code == multihashSha256 {
+		sum := sha256.Sum256(data)
+		if string(sum[:]) != string(digest) {
+			return "", nil, fmt.Errorf("data of block %s does not match its CID", cid)
+		}
+	}
+	return cid, data, nil
+}
@@ -0,0 +1,361 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faithful
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/mr-tron/base58"
+)
+
+// CID is the binary form of an IPLD content identifier.
+type CID string
+
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// String returns the multibase base32 form of the CID (e.g. "bafy...").
+func (cid CID) String() string {
+	if len(cid) == 34 && cid[0] == 0x12 {
+		// CIDv0: the base58 encoding of the multihash.
+		return base58.Encode([]byte(cid))
+	}
+	return "b" + base32Lower.EncodeToString([]byte(cid))
+}
+
+// Multihash codes and multicodecs of the CIDs of the Old Faithful archives.
+const (
+	multihashSha256 = 0x12
+	codecDagCBOR    = 0x71
+	codecRaw        = 0x55
+)
+
+// readCID reads the CID at the start of data and returns its length.
+func readCID(data []byte) (CID, int, error) {
+	if len(data) >= 2 && data[0] == multihashSha256 && data[1] == 32 {
+		if len(data) < 34 {
+			return "", 0, errors.New("truncated CIDv0")
+		}
+		return CID(data[:34]), 34, nil
+	}
+	pos := 0
+	next := func() (uint64, error) {
+		v, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return 0, errors.New("invalid CID varint")
+		}
+		pos += n
+		return v, nil
+	}
+	version, err := next()
+	if err != nil {
+		return "", 0, err
+	}
+	if version != 1 {
+		return "", 0, fmt.Errorf("unsupported CID version %d", version)
+	}
+	if _, err := next(); err != nil { // codec
+		return "", 0, err
+	}
+	if _, err := next(); err != nil { // multihash code
+		return "", 0, err
+	}
+	size, err := next()
+	if err != nil {
+		return "", 0, err
+	}
+	if uint64(len(data)-pos) < size {
+		return "", 0, errors.New("truncated CID digest")
+	}
+	pos += int(size)
+	return CID(data[:pos]), pos, nil
+}
+
+// digest returns the multihash code and the digest of the CID.
+func (cid CID) digest() (code uint64, digest []byte, err error) {
+	data := []byte(cid)
+	if len(data) == 34 && data[0] == multihashSha256 {
+		return multihashSha256, data[2:], nil
+	}
+	pos := 0
+	for i := 0; i < 3; i++ {
+		v, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return 0, nil, errors.New("invalid CID varint")
+		}
+		pos += n
+		code = v
+	}
+	_, n := binary.Uvarint(data[pos:])
+	if n <= 0 {
+		return 0, nil, errors.New("invalid CID varint")
+	}
+	return code, data[pos+n:], nil
+}
+
+// cborTagCID is the CBOR tag of the links of DAG-CBOR.
+const cborTagCID = 42
+
+// cborDecoder decodes the subset of CBOR used by DAG-CBOR: integers, byte and
+// text strings, arrays, maps with text keys, links, booleans, null and floats.
+// The values are decoded to uint64, int64, []byte, string, []interface{},
+// map[string]interface{}, CID, bool, nil and float64.
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+func decodeCBOR(data []byte) (interface{}, error) {
+	dec := &cborDecoder{data: data}
+	value, err := dec.decode(0)
+	if err != nil {
+		return nil, err
+	}
+	if dec.pos != len(data) {
+		return nil, fmt.Errorf("%d trailing bytes", len(data)-dec.pos)
+	}
+	return value, nil
+}
+
+const maxCBORDepth = 64
+
+func (dec *cborDecoder) header() (major byte, arg uint64, err error) {
+	if dec.pos >= len(dec.data) {
+		return 0, 0, errors.New("unexpected end of CBOR data")
+	}
+	b := dec.data[dec.pos]
+	dec.pos++
+	major, info := b>>5, b&0x1f
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("unsupported CBOR additional information %d", info)
+	}
+	if len(dec.data)-dec.pos < size {
+		return 0, 0, errors.New("unexpected end of CBOR data")
+	}
+	for _, c := range dec.data[dec.pos : dec.pos+size] {
+		arg = arg<<8 | uint64(c)
+	}
+	dec.pos += size
+	return major, arg, nil
+}
+
+func (dec *cborDecoder) bytes(n uint64) ([]byte, error) {
+	if uint64(len(dec.data)-dec.pos) < n {
+		return nil, errors.New("unexpected end of CBOR data")
+	}
+	out := dec.data[dec.pos : dec.pos+int(n)]
+	dec.pos += int(n)
+	return out, nil
+}
+
+func (dec *cborDecoder) decode(depth int) (interface{}, error) {
+	if depth > maxCBORDepth {
+		return nil, errors.New("CBOR data too deep")
+	}
+	start := dec.pos
+	major, arg, err := dec.header()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case 0:
+		return arg, nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, errors.New("CBOR negative integer overflow")
+		}
+		return -1 - int64(arg), nil
+	case 2:
+		return dec.bytes(arg)
+	case 3:
+		b, err := dec.bytes(arg)
+		return string(b), err
+	case 4:
+		// Each item takes at least one byte.
+		if arg > uint64(len(dec.data)-dec.pos) {
+			return nil, errors.New("CBOR array too long")
+		}
+		out := make([]interface{}, arg)
+		for i := range out {
+			if out[i], err = dec.decode(depth + 1); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case 5:
+		if arg > uint64(len(dec.data)-dec.pos)/2 {
+			return nil, errors.New("CBOR map too long")
+		}
+		out := make(map[string]interface{}, arg)
+		for i := uint64(0); i < arg; i++ {
+			key, err := dec.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported CBOR map key %T", key)
+			}
+			if out[k], err = dec.decode(depth + 1); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case 6:
+		if arg != cborTagCID {
+			return nil, fmt.Errorf("unsupported CBOR tag %d", arg)
+		}
+		value, err := dec.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		b, ok := value.([]byte)
+		if !ok || len(b) < 2 || b[0] != 0 {
+			return nil, errors.New("invalid CBOR link")
+		}
+		cid, n, err := readCID(b[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n != len(b)-1 {
+			return nil, errors.New("invalid CBOR link")
+		}
+		return cid, nil
+	default:
+		switch dec.data[start] & 0x1f {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22, 23:
+			return nil, nil
+		case 25:
+			return float64(halfToFloat(uint16(arg))), nil
+		case 26:
+			return float64(math.Float32frombits(uint32(arg))), nil
+		case 27:
+			return math.Float64frombits(arg), nil
+		}
+		return nil, fmt.Errorf("unsupported CBOR simple value %d", arg)
+	}
+}
+
+func halfToFloat(h uint16) float32 {
+	sign := uint32(h>>15) << 31
+	exp := uint32(h>>10) & 0x1f
+	frac := uint32(h) & 0x3ff
+	switch exp {
+	case 0:
+		f := float32(frac) / (1 << 24)
+		if sign != 0 {
+			return -f
+		}
+		return f
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | frac<<13)
+	}
+	return math.Float32frombits(sign | (exp+112)<<23 | frac<<13)
+}
+
+// Helpers to read the fields of the nodes, encoded as CBOR arrays.
+
+type fields []interface{}
+
+func (f fields) get(i int) interface{} {
+	if i < len(f) {
+		return f[i]
+	}
+	return nil
+}
+
+func (f fields) uint(i int, name string) (uint64, error) {
+	switch v := f.get(i).(type) {
+	case uint64:
+		return v, nil
+	case int64:
+		if v >= 0 {
+			return uint64(v), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s: %v", name, f.get(i))
+}
+
+func (f fields) int(i int, name string) (int64, error) {
+	switch v := f.get(i).(type) {
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v), nil
+		}
+	case int64:
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid %s: %v", name, f.get(i))
+}
+
+func (f fields) bytes(i int, name string) ([]byte, error) {
+	v, ok := f.get(i).([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+	return v, nil
+}
+
+func (f fields) list(i int, name string) (fields, error) {
+	if f.get(i) == nil {
+		return nil, nil
+	}
+	v, ok := f.get(i).([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+	return v, nil
+}
+
+func (f fields) link(i int, name string) (CID, error) {
+	v, ok := f.get(i).(CID)
+	if !ok {
+		return "", fmt.Errorf("invalid %s", name)
+	}
+	return v, nil
+}
+
+func (f fields) links(i int, name string) ([]CID, error) {
+	list, err := f.list(i, name)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]CID, len(list))
+	for j := range list {
+		if out[j], err = list.link(j, name); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
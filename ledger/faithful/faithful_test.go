@@ -0,0 +1,305 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faithful
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+func cborHeader(buf *bytes.Buffer, major byte, arg uint64) {
+	switch {
+	case arg < 24:
+		buf.WriteByte(major<<5 | byte(arg))
+	case arg <= 0xff:
+		buf.WriteByte(major<<5 | 24)
+		buf.WriteByte(byte(arg))
+	case arg <= 0xffff:
+		buf.WriteByte(major<<5 | 25)
+		binary.Write(buf, binary.BigEndian, uint16(arg))
+	case arg <= 0xffffffff:
+		buf.WriteByte(major<<5 | 26)
+		binary.Write(buf, binary.BigEndian, uint32(arg))
+	default:
+		buf.WriteByte(major<<5 | 27)
+		binary.Write(buf, binary.BigEndian, arg)
+	}
+}
+
+func encodeCBOR(buf *bytes.Buffer, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteByte(0xf6)
+	case int:
+		if v < 0 {
+			cborHeader(buf, 1, uint64(-1-v))
+		} else {
+			cborHeader(buf, 0, uint64(v))
+		}
+	case uint64:
+		cborHeader(buf, 0, v)
+	case []byte:
+		cborHeader(buf, 2, uint64(len(v)))
+		buf.Write(v)
+	case string:
+		cborHeader(buf, 3, uint64(len(v)))
+		buf.WriteString(v)
+	case []interface{}:
+		cborHeader(buf, 4, uint64(len(v)))
+		for _, item := range v {
+			encodeCBOR(buf, item)
+		}
+	case map[string]interface{}:
+		cborHeader(buf, 5, uint64(len(v)))
+		for key, item := range v {
+			encodeCBOR(buf, key)
+			encodeCBOR(buf, item)
+		}
+	case CID:
+		cborHeader(buf, 6, cborTagCID)
+		encodeCBOR(buf, append([]byte{0}, v...))
+	default:
+		panic(v)
+	}
+}
+
+type carWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *carWriter) section(data []byte) {
+	var size [binary.MaxVarintLen64]byte
+	w.buf.Write(size[:binary.PutUvarint(size[:], uint64(len(data)))])
+	w.buf.Write(data)
+}
+
+func (w *carWriter) node(fields ...interface{}) CID {
+	var data bytes.Buffer
+	encodeCBOR(&data, fields)
+	sum := sha256.Sum256(data.Bytes())
+	cid := CID(append([]byte{1, codecDagCBOR, multihashSha256, 32}, sum[:]...))
+	w.section(append([]byte(cid), data.Bytes()...))
+	return cid
+}
+
+type protoWriter struct {
+	bytes.Buffer
+}
+
+func (w *protoWriter) varint(field int, v uint64) {
+	w.uvarint(uint64(field<<3 | 0))
+	w.uvarint(v)
+}
+
+func (w *protoWriter) bytes(field int, b []byte) {
+	w.uvarint(uint64(field<<3 | 2))
+	w.uvarint(uint64(len(b)))
+	w.Write(b)
+}
+
+func (w *protoWriter) uvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.Write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func frame(data []byte, next ...interface{}) []interface{} {
+	var links interface{}
+	if len(next) > 0 {
+		links = next
+	}
+	return []interface{}{int(KindDataFrame), nil, nil, nil, data, links}
+}
+
+func hashBytes(b byte) []byte {
+	hash := solana.Hash{b}
+	return hash[:]
+}
+
+func compress(t *testing.T, data []byte) []byte {
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer encoder.Close()
+	return encoder.EncodeAll(data, nil)
+}
+
+func TestReader(t *testing.T) {
+	payer, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(1, payer.PublicKey(), solana.SystemProgramID).Build(),
+		},
+		solana.Hash{1},
+	)
+	require.NoError(t, err)
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey { return &payer })
+	require.NoError(t, err)
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	var txErr protoWriter
+	txErr.bytes(1, []byte{8, 0, 0, 0, 1, 25, 0, 0, 0, 42, 0, 0, 0})
+	var pre protoWriter
+	pre.uvarint(5000)
+	pre.uvarint(1)
+	var inner protoWriter
+	inner.varint(1, 2)
+	inner.bytes(2, []byte{0, 1})
+	inner.bytes(3, []byte{1, 2, 3})
+	var inners protoWriter
+	inners.varint(1, 0)
+	inners.bytes(2, inner.Bytes())
+	var amount protoWriter
+	amount.varint(2, 6)
+	amount.bytes(3, []byte("1500000"))
+	var balance protoWriter
+	balance.varint(1, 1)
+	balance.bytes(2, []byte(solana.WrappedSol.String()))
+	balance.bytes(3, amount.Bytes())
+	balance.bytes(4, []byte(payer.PublicKey().String()))
+	var meta protoWriter
+	meta.bytes(1, txErr.Bytes())
+	meta.varint(2, 5000)
+	meta.bytes(3, pre.Bytes())
+	meta.varint(4, 0)
+	meta.varint(4, 1)
+	meta.bytes(5, inners.Bytes())
+	meta.bytes(6, []byte("Program log: hello"))
+	meta.bytes(8, balance.Bytes())
+	meta.bytes(12, solana.SystemProgramID[:])
+
+	var reward protoWriter
+	reward.bytes(1, []byte(payer.PublicKey().String()))
+	reward.varint(2, 2500)
+	reward.varint(3, 100000)
+	reward.varint(4, 1)
+	var rewards protoWriter
+	rewards.bytes(1, reward.Bytes())
+
+	var car carWriter
+	var header bytes.Buffer
+	encodeCBOR(&header, map[string]interface{}{"version": 1, "roots": []interface{}{CID("\x01\x71\x12\x20" + string(make([]byte, 32)))}})
+	car.section(header.Bytes())
+
+	// Block 10: the transaction split in two data frames.
+	rest := car.node(frame(raw[40:])...)
+	txCID := car.node(int(KindTransaction), frame(raw[:40], rest), frame(compress(t, meta.Bytes())), 10, 0)
+	tick := car.node(int(KindEntry), 3, hashBytes(7), []interface{}{})
+	entry := car.node(int(KindEntry), 1, hashBytes(8), []interface{}{txCID})
+	rewardsCID := car.node(int(KindRewards), 10, frame(compress(t, rewards.Bytes())))
+	car.node(int(KindBlock), 10, []interface{}{[]interface{}{0, -1}, []interface{}{1, 0}},
+		[]interface{}{tick, entry}, []interface{}{9, 1700000000, 5}, rewardsCID)
+	// Block 12, empty.
+	tick = car.node(int(KindEntry), 3, hashBytes(9), []interface{}{})
+	block := car.node(int(KindBlock), 12, []interface{}{}, []interface{}{tick}, []interface{}{10, 0}, nil)
+	subset := car.node(int(KindSubset), 10, 12, []interface{}{block})
+	car.node(int(KindEpoch), 0, []interface{}{subset})
+
+	reader, err := NewReader(bytes.NewReader(car.buf.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	first, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), first.Slot)
+	require.Equal(t, uint64(9), first.ParentSlot)
+	require.Equal(t, solana.Hash{8}, first.Blockhash)
+	require.Equal(t, solana.Hash{}, first.PreviousBlockhash)
+	require.Equal(t, solana.UnixTimeSeconds(1700000000), *first.BlockTime)
+	require.Equal(t, uint64(5), *first.BlockHeight)
+	require.Len(t, first.Entries, 2)
+	require.True(t, first.Entries[0].IsTick())
+	require.Equal(t, []solana.Signature{tx.Signatures[0]}, first.Signatures)
+	require.Equal(t, []rpc.BlockReward{{Pubkey: payer.PublicKey(), Lamports: 2500, PostBalance: 100000, RewardType: rpc.RewardTypeFee}}, first.Rewards)
+
+	require.Len(t, first.Transactions, 1)
+	txWithMeta := first.Transactions[0]
+	require.Equal(t, rpc.LegacyTransactionVersion, txWithMeta.Version)
+	decoded, err := txWithMeta.GetTransaction()
+	require.NoError(t, err)
+	require.Equal(t, tx.Signatures, decoded.Signatures)
+	require.Equal(t, map[string]interface{}{"InstructionError": []interface{}{float64(1), map[string]interface{}{"Custom": float64(42)}}}, txWithMeta.Meta.Err)
+	require.Equal(t, uint64(5000), txWithMeta.Meta.Fee)
+	require.Equal(t, []uint64{5000, 1}, txWithMeta.Meta.PreBalances)
+	require.Equal(t, []uint64{0, 1}, txWithMeta.Meta.PostBalances)
+	require.Equal(t, []string{"Program log: hello"}, txWithMeta.Meta.LogMessages)
+	require.Equal(t, []rpc.InnerInstruction{{
+		Index:        0,
+		Instructions: []solana.CompiledInstruction{{ProgramIDIndex: 2, Accounts: []uint16{0, 1}, Data: solana.Base58{1, 2, 3}}},
+	}}, txWithMeta.Meta.InnerInstructions)
+	require.Equal(t, solana.PublicKeySlice{solana.SystemProgramID}, txWithMeta.Meta.LoadedAddresses.Writable)
+	require.Len(t, txWithMeta.Meta.PostTokenBalances, 1)
+	require.Equal(t, solana.WrappedSol, txWithMeta.Meta.PostTokenBalances[0].Mint)
+	require.Equal(t, "1.5", txWithMeta.Meta.PostTokenBalances[0].UiTokenAmount.String())
+	require.Equal(t, big.NewInt(1500000), txWithMeta.Meta.PostTokenBalances[0].UiTokenAmount.Raw())
+
+	second, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), second.Slot)
+	require.Equal(t, solana.Hash{8}, second.PreviousBlockhash)
+	require.Equal(t, solana.Hash{9}, second.Blockhash)
+	require.Nil(t, second.BlockTime)
+	require.Empty(t, second.Transactions)
+
+	_, err = reader.Next()
+	require.Equal(t, io.EOF, err)
+	require.Equal(t, &Epoch{Epoch: 0, Subsets: []CID{subset}}, reader.Epoch())
+}
+
+func TestCARReader_Corrupted(t *testing.T) {
+	var car carWriter
+	var header bytes.Buffer
+	encodeCBOR(&header, map[string]interface{}{"version": 1, "roots": []interface{}{}})
+	car.section(header.Bytes())
+	car.node(int(KindEntry), 3, hashBytes(7), []interface{}{})
+	data := car.buf.Bytes()
+	data[len(data)-40] ^= 1
+
+	reader, err := NewCARReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	_, _, err = reader.Next()
+	require.Error(t, err)
+
+	_, err = NewCARReader(bytes.NewReader(data[:3]))
+	require.Error(t, err)
+}
+
+func TestDecodeTransactionError(t *testing.T) {
+	for _, tt := range []struct {
+		data []byte
+		want interface{}
+	}{
+		{[]byte{0, 0, 0, 0}, "AccountInUse"},
+		{[]byte{8, 0, 0, 0, 2, 0, 0, 0, 0}, map[string]interface{}{"InstructionError": []interface{}{float64(2), "GenericError"}}},
+		{[]byte{30, 0, 0, 0, 3}, map[string]interface{}{"DuplicateInstruction": float64(3)}},
+		{[]byte{31, 0, 0, 0, 1}, map[string]interface{}{"InsufficientFundsForRent": map[string]interface{}{"account_index": float64(1)}}},
+	} {
+		got, err := DecodeTransactionError(tt.data)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got)
+	}
+	_, err := DecodeTransactionError([]byte{200, 0, 0, 0})
+	require.Error(t, err)
+}
@@ -0,0 +1,501 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faithful
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// protoReader reads the fields of a protobuf message.
+type protoReader struct {
+	data []byte
+	pos  int
+}
+
+// next reads the next field; value is set for the varint and fixed wire
+// types, bytes for the length-delimited one.
+func (r *protoReader) next() (field int, wireType int, value uint64, bytes []byte, err error) {
+	key, err := r.varint()
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+	field, wireType = int(key>>3), int(key&7)
+	switch wireType {
+	case 0:
+		value, err = r.varint()
+	case 1:
+		if len(r.data)-r.pos < 8 {
+			return 0, 0, 0, nil, errors.New("truncated protobuf field")
+		}
+		value = binary.LittleEndian.Uint64(r.data[r.pos:])
+		r.pos += 8
+	case 2:
+		var size uint64
+		if size, err = r.varint(); err != nil {
+			break
+		}
+		if uint64(len(r.data)-r.pos) < size {
+			return 0, 0, 0, nil, errors.New("truncated protobuf field")
+		}
+		bytes = r.data[r.pos : r.pos+int(size)]
+		r.pos += int(size)
+	case 5:
+		if len(r.data)-r.pos < 4 {
+			return 0, 0, 0, nil, errors.New("truncated protobuf field")
+		}
+		value = uint64(binary.LittleEndian.Uint32(r.data[r.pos:]))
+		r.pos += 4
+	default:
+		err = fmt.Errorf("unsupported protobuf wire type %d", wireType)
+	}
+	return field, wireType, value, bytes, err
+}
+
+func (r *protoReader) varint() (uint64, error) {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 {
+		return 0, errors.New("invalid protobuf varint")
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *protoReader) done() bool {
+	return r.pos >= len(r.data)
+}
+
+// appendUint64s appends the values of a repeated uint64 field,
+// packed or not.
+func appendUint64s(out []uint64, wireType int, value uint64, bytes []byte) ([]uint64, error) {
+	if wireType == 0 {
+		return append(out, value), nil
+	}
+	packed := &protoReader{data: bytes}
+	for !packed.done() {
+		v, err := packed.varint()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// DecodeTransactionMeta decodes the status metadata of a transaction of an
+// archive (the protobuf TransactionStatusMeta of the Solana confirmed blocks,
+// once decompressed) into the type of the RPC responses.
+// The error of a failed transaction is decoded to the value
+// of the JSON responses (see DecodeTransactionError).
+func DecodeTransactionMeta(data []byte) (*rpc.TransactionMeta, error) {
+	meta := &rpc.TransactionMeta{
+		Status: rpc.DeprecatedTransactionMetaStatus{"Ok": nil},
+	}
+	logsNone, innerNone := false, false
+	r := &protoReader{data: data}
+	for !r.done() {
+		field, wireType, value, bytes, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+		switch field {
+		case 1:
+			var txErr []byte
+			inner := &protoReader{data: bytes}
+			for !inner.done() {
+				f, _, _, b, err := inner.next()
+				if err != nil {
+					return nil, err
+				}
+				if f == 1 {
+					txErr = b
+				}
+			}
+			if meta.Err, err = DecodeTransactionError(txErr); err != nil {
+				return nil, err
+			}
+			meta.Status = rpc.DeprecatedTransactionMetaStatus{"Err": meta.Err}
+		case 2:
+			meta.Fee = value
+		case 3:
+			if meta.PreBalances, err = appendUint64s(meta.PreBalances, wireType, value, bytes); err != nil {
+				return nil, err
+			}
+		case 4:
+			if meta.PostBalances, err = appendUint64s(meta.PostBalances, wireType, value, bytes); err != nil {
+				return nil, err
+			}
+		case 5:
+			inner, err := decodeInnerInstructions(bytes)
+			if err != nil {
+				return nil, err
+			}
+			meta.InnerInstructions = append(meta.InnerInstructions, inner)
+		case 6:
+			meta.LogMessages = append(meta.LogMessages, string(bytes))
+		case 7, 8:
+			balance, err := decodeTokenBalance(bytes)
+			if err != nil {
+				return nil, err
+			}
+			if field == 7 {
+				meta.PreTokenBalances = append(meta.PreTokenBalances, balance)
+			} else {
+				meta.PostTokenBalances = append(meta.PostTokenBalances, balance)
+			}
+		case 9:
+			reward, err := decodeReward(bytes)
+			if err != nil {
+				return nil, err
+			}
+			meta.Rewards = append(meta.Rewards, reward)
+		case 10:
+			logsNone = value != 0
+		case 11:
+			innerNone = value != 0
+		case 12, 13:
+			if len(bytes) != solana.PublicKeyLength {
+				return nil, fmt.Errorf("invalid loaded address length %d", len(bytes))
+			}
+			key := solana.PublicKeyFromBytes(bytes)
+			if field == 12 {
+				meta.LoadedAddresses.Writable = append(meta.LoadedAddresses.Writable, key)
+			} else {
+				meta.LoadedAddresses.ReadOnly = append(meta.LoadedAddresses.ReadOnly, key)
+			}
+		}
+	}
+	if logsNone {
+		meta.LogMessages = nil
+	} else if meta.LogMessages == nil {
+		meta.LogMessages = []string{}
+	}
+	if innerNone {
+		meta.InnerInstructions = nil
+	} else if meta.InnerInstructions == nil {
+		meta.InnerInstructions = []rpc.InnerInstruction{}
+	}
+	return meta, nil
+}
+
+func decodeInnerInstructions(data []byte) (out rpc.InnerInstruction, err error) {
+	r := &protoReader{data: data}
+	for !r.done() {
+		field, _, value, bytes, err := r.next()
+		if err != nil {
+			return out, err
+		}
+		switch field {
+		case 1:
+			out.Index = uint16(value)
+		case 2:
+			var instruction solana.CompiledInstruction
+			inner := &protoReader{data: bytes}
+			for !inner.done() {
+				f, _, v, b, err := inner.next()
+				if err != nil {
+					return out, err
+				}
+				switch f {
+				case 1:
+					instruction.ProgramIDIndex = uint16(v)
+				case 2:
+					instruction.Accounts = make([]uint16, len(b))
+					for i, index := range b {
+						instruction.Accounts[i] = uint16(index)
+					}
+				case 3:
+					instruction.Data = solana.Base58(b)
+				}
+			}
+			out.Instructions = append(out.Instructions, instruction)
+		}
+	}
+	return out, nil
+}
+
+func decodeTokenBalance(data []byte) (out rpc.TokenBalance, err error) {
+	var amount string
+	var decimals uint8
+	r := &protoReader{data: data}
+	for !r.done() {
+		field, _, value, bytes, err := r.next()
+		if err != nil {
+			return out, err
+		}
+		switch field {
+		case 1:
+			out.AccountIndex = uint16(value)
+		case 2:
+			if out.Mint, err = solana.PublicKeyFromBase58(string(bytes)); err != nil {
+				return out, fmt.Errorf("invalid mint: %w", err)
+			}
+		case 3:
+			inner := &protoReader{data: bytes}
+			for !inner.done() {
+				f, _, v, b, err := inner.next()
+				if err != nil {
+					return out, err
+				}
+				switch f {
+				case 2:
+					decimals = uint8(v)
+				case 3:
+					amount = string(b)
+				}
+			}
+		case 4:
+			if len(bytes) == 0 {
+				continue
+			}
+			owner, err := solana.PublicKeyFromBase58(string(bytes))
+			if err != nil {
+				return out, fmt.Errorf("invalid owner: %w", err)
+			}
+			out.Owner = &owner
+		}
+	}
+	raw, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return out, fmt.Errorf("invalid token amount %q", amount)
+	}
+	out.UiTokenAmount = rpc.NewUiTokenAmount(raw, decimals)
+	return out, nil
+}
+
+var rewardTypes = map[uint64]rpc.RewardType{
+	1: rpc.RewardTypeFee,
+	2: rpc.RewardTypeRent,
+	3: rpc.RewardTypeStaking,
+	4: rpc.RewardTypeVoting,
+}
+
+func decodeReward(data []byte) (out rpc.BlockReward, err error) {
+	r := &protoReader{data: data}
+	for !r.done() {
+		field, _, value, bytes, err := r.next()
+		if err != nil {
+			return out, err
+		}
+		switch field {
+		case 1:
+			if out.Pubkey, err = solana.PublicKeyFromBase58(string(bytes)); err != nil {
+				return out, fmt.Errorf("invalid reward pubkey: %w", err)
+			}
+		case 2:
+			out.Lamports = int64(value)
+		case 3:
+			out.PostBalance = value
+		case 4:
+			out.RewardType = rewardTypes[value]
+		case 5:
+			if len(bytes) == 0 {
+				continue
+			}
+			commission, err := strconv.ParseUint(string(bytes), 10, 8)
+			if err != nil {
+				return out, fmt.Errorf("invalid commission %q", bytes)
+			}
+			c := uint8(commission)
+			out.Commission = &c
+		}
+	}
+	return out, nil
+}
+
+// DecodeRewards decodes the rewards of a block of an archive (the protobuf
+// Rewards of the Solana confirmed blocks, once decompressed).
+func DecodeRewards(data []byte) ([]rpc.BlockReward, error) {
+	rewards := []rpc.BlockReward{}
+	r := &protoReader{data: data}
+	for !r.done() {
+		field, _, _, bytes, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+		if field != 1 {
+			continue
+		}
+		reward, err := decodeReward(bytes)
+		if err != nil {
+			return nil, err
+		}
+		rewards = append(rewards, reward)
+	}
+	return rewards, nil
+}
+
+// Names of the variants of the TransactionError enum.
+var transactionErrors = []string{
+	"AccountInUse",
+	"AccountLoadedTwice",
+	"AccountNotFound",
+	"ProgramAccountNotFound",
+	"InsufficientFundsForFee",
+	"InvalidAccountForFee",
+	"AlreadyProcessed",
+	"BlockhashNotFound",
+	"InstructionError",
+	"CallChainTooDeep",
+	"MissingSignatureForFee",
+	"InvalidAccountIndex",
+	"SignatureFailure",
+	"InvalidProgramForExecution",
+	"SanitizeFailure",
+	"ClusterMaintenance",
+	"AccountBorrowOutstanding",
+	"WouldExceedMaxBlockCostLimit",
+	"UnsupportedVersion",
+	"InvalidWritableAccount",
+	"WouldExceedMaxAccountCostLimit",
+	"WouldExceedAccountDataBlockLimit",
+	"TooManyAccountLocks",
+	"AddressLookupTableNotFound",
+	"InvalidAddressLookupTableOwner",
+	"InvalidAddressLookupTableData",
+	"InvalidAddressLookupTableIndex",
+	"InvalidRentPayingAccount",
+	"WouldExceedMaxVoteCostLimit",
+	"WouldExceedAccountDataTotalLimit",
+	"DuplicateInstruction",
+	"InsufficientFundsForRent",
+	"MaxLoadedAccountsDataSizeExceeded",
+	"InvalidLoadedAccountsDataSizeLimit",
+	"ResanitizationNeeded",
+	"ProgramExecutionTemporarilyRestricted",
+	"UnbalancedTransaction",
+	"ProgramCacheHitMaxLimit",
+	"CommitCancelled",
+}
+
+// Names of the variants of the InstructionError enum.
+var instructionErrors = []string{
+	"GenericError",
+	"InvalidArgument",
+	"InvalidInstructionData",
+	"InvalidAccountData",
+	"AccountDataTooSmall",
+	"InsufficientFunds",
+	"IncorrectProgramId",
+	"MissingRequiredSignature",
+	"AccountAlreadyInitialized",
+	"UninitializedAccount",
+	"UnbalancedInstruction",
+	"ModifiedProgramId",
+	"ExternalAccountLamportSpend",
+	"ExternalAccountDataModified",
+	"ReadonlyLamportChange",
+	"ReadonlyDataModified",
+	"DuplicateAccountIndex",
+	"ExecutableModified",
+	"RentEpochModified",
+	"NotEnoughAccountKeys",
+	"AccountDataSizeChanged",
+	"AccountNotExecutable",
+	"AccountBorrowFailed",
+	"AccountBorrowOutstanding",
+	"DuplicateAccountOutOfSync",
+	"Custom",
+	"InvalidError",
+	"ExecutableDataModified",
+	"ExecutableLamportChange",
+	"ExecutableAccountNotRentExempt",
+	"UnsupportedProgramId",
+	"CallDepth",
+	"MissingAccount",
+	"ReentrancyNotAllowed",
+	"MaxSeedLengthExceeded",
+	"InvalidSeeds",
+	"InvalidRealloc",
+	"ComputationalBudgetExceeded",
+	"PrivilegeEscalation",
+	"ProgramEnvironmentSetupFailure",
+	"ProgramFailedToComplete",
+	"ProgramFailedToCompile",
+	"Immutable",
+	"IncorrectAuthority",
+	"BorshIoError",
+	"AccountNotRentExempt",
+	"InvalidAccountOwner",
+	"ArithmeticOverflow",
+	"UnsupportedSysvar",
+	"IllegalOwner",
+	"MaxAccountsDataAllocationsExceeded",
+	"MaxAccountsExceeded",
+	"MaxInstructionTraceLengthExceeded",
+	"BuiltinProgramsMustConsumeComputeUnits",
+}
+
+// DecodeTransactionError decodes a bincode TransactionError to the value of
+// the JSON responses of the RPC (e.g. "AccountInUse", or
+// {"InstructionError": [0, {"Custom": 1}]}, numbers being float64).
+func DecodeTransactionError(data []byte) (interface{}, error) {
+	if len(data) < 4 {
+		return nil, errors.New("invalid transaction error")
+	}
+	variant := binary.LittleEndian.Uint32(data)
+	if variant >= uint32(len(transactionErrors)) {
+		return nil, fmt.Errorf("unknown transaction error %d", variant)
+	}
+	name := transactionErrors[variant]
+	data = data[4:]
+	switch name {
+	case "InstructionError":
+		if len(data) < 5 {
+			return nil, errors.New("invalid instruction error")
+		}
+		index := float64(data[0])
+		variant := binary.LittleEndian.Uint32(data[1:])
+		if variant >= uint32(len(instructionErrors)) {
+			return nil, fmt.Errorf("unknown instruction error %d", variant)
+		}
+		var instructionErr interface{} = instructionErrors[variant]
+		switch instructionErrors[variant] {
+		case "Custom":
+			if len(data) < 9 {
+				return nil, errors.New("invalid custom instruction error")
+			}
+			instructionErr = map[string]interface{}{"Custom": float64(binary.LittleEndian.Uint32(data[5:]))}
+		case "BorshIoError":
+			if len(data) < 13 {
+				return nil, errors.New("invalid borsh instruction error")
+			}
+			size := binary.LittleEndian.Uint64(data[5:])
+			if size > math.MaxInt32 || uint64(len(data)-13) < size {
+				return nil, errors.New("invalid borsh instruction error")
+			}
+			instructionErr = map[string]interface{}{"BorshIoError": string(data[13 : 13+size])}
+		}
+		return map[string]interface{}{name: []interface{}{index, instructionErr}}, nil
+	case "DuplicateInstruction":
+		if len(data) < 1 {
+			return nil, errors.New("invalid transaction error")
+		}
+		return map[string]interface{}{name: float64(data[0])}, nil
+	case "InsufficientFundsForRent", "ProgramExecutionTemporarilyRestricted":
+		if len(data) < 1 {
+			return nil, errors.New("invalid transaction error")
+		}
+		return map[string]interface{}{name: map[string]interface{}{"account_index": float64(data[0])}}, nil
+	}
+	return name, nil
+}
@@ -0,0 +1,304 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faithful
+
+import (
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Kind is the kind of a node of an Old Faithful archive,
+// the first field of every node.
+type Kind uint64
+
+const (
+	KindTransaction Kind = iota
+	KindEntry
+	KindBlock
+	KindSubset
+	KindEpoch
+	KindRewards
+	KindDataFrame
+)
+
+func (kind Kind) String() string {
+	switch kind {
+	case KindTransaction:
+		return "Transaction"
+	case KindEntry:
+		return "Entry"
+	case KindBlock:
+		return "Block"
+	case KindSubset:
+		return "Subset"
+	case KindEpoch:
+		return "Epoch"
+	case KindRewards:
+		return "Rewards"
+	case KindDataFrame:
+		return "DataFrame"
+	default:
+		return fmt.Sprintf("Kind(%d)", uint64(kind))
+	}
+}
+
+// Epoch is the root node of the archive of an epoch.
+type Epoch struct {
+	Epoch   uint64
+	Subsets []CID
+}
+
+// Subset is a node linking a range of the blocks of an epoch.
+type Subset struct {
+	First  uint64
+	Last   uint64
+	Blocks []CID
+}
+
+// Shredding locates the end of an entry in the shreds of its block.
+type Shredding struct {
+	EntryEndIndex int64
+	ShredEndIndex int64
+}
+
+// SlotMeta is the metadata of a block.
+type SlotMeta struct {
+	ParentSlot  uint64
+	BlockTime   int64
+	BlockHeight *uint64
+}
+
+// BlockNode is the node of a block, linking its entries and its rewards.
+type BlockNode struct {
+	Slot      uint64
+	Shredding []Shredding
+	Entries   []CID
+	Meta      SlotMeta
+	Rewards   CID
+}
+
+// EntryNode is the node of an entry, linking its transactions.
+type EntryNode struct {
+	NumHashes    uint64
+	Hash         solana.Hash
+	Transactions []CID
+}
+
+// TransactionNode is the node of a transaction: the transaction (in its wire
+// format) and its status metadata (zstd-compressed protobuf).
+type TransactionNode struct {
+	Data     DataFrame
+	Metadata DataFrame
+	Slot     uint64
+	// Index of the transaction in its block, if known.
+	Index *uint64
+}
+
+// RewardsNode is the node of the rewards of a block
+// (zstd-compressed protobuf).
+type RewardsNode struct {
+	Slot uint64
+	Data DataFrame
+}
+
+// DataFrame is a part of a buffer too large for a single node:
+// the buffer is the data of the frame followed by the data of
+// the frames it links, depth-first.
+type DataFrame struct {
+	Hash  *uint64
+	Index *uint64
+	Total *uint64
+	Data  []byte
+	Next  []CID
+}
+
+// Node is a decoded node: *Epoch, *Subset, *BlockNode, *EntryNode,
+// *TransactionNode, *RewardsNode or *DataFrame.
+type Node interface{}
+
+// DecodeNode decodes a DAG-CBOR node of an Old Faithful archive.
+func DecodeNode(data []byte) (Node, error) {
+	value, err := decodeCBOR(data)
+	if err != nil {
+		return nil, err
+	}
+	f, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid node: %T", value)
+	}
+	kind, err := fields(f).uint(0, "kind")
+	if err != nil {
+		return nil, err
+	}
+	node, err := decodeNode(Kind(kind), f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s node: %w", Kind(kind), err)
+	}
+	return node, nil
+}
+
+func decodeNode(kind Kind, f fields) (node Node, err error) {
+	switch kind {
+	case KindEpoch:
+		out := new(Epoch)
+		if out.Epoch, err = f.uint(1, "epoch"); err != nil {
+			return nil, err
+		}
+		out.Subsets, err = f.links(2, "subsets")
+		return out, err
+	case KindSubset:
+		out := new(Subset)
+		if out.First, err = f.uint(1, "first"); err != nil {
+			return nil, err
+		}
+		if out.Last, err = f.uint(2, "last"); err != nil {
+			return nil, err
+		}
+		out.Blocks, err = f.links(3, "blocks")
+		return out, err
+	case KindBlock:
+		return decodeBlockNode(f)
+	case KindEntry:
+		out := new(EntryNode)
+		if out.NumHashes, err = f.uint(1, "numHashes"); err != nil {
+			return nil, err
+		}
+		hash, err := f.bytes(2, "hash")
+		if err != nil {
+			return nil, err
+		}
+		if len(hash) != solana.PublicKeyLength {
+			return nil, fmt.Errorf("invalid hash length %d", len(hash))
+		}
+		copy(out.Hash[:], hash)
+		out.Transactions, err = f.links(3, "transactions")
+		return out, err
+	case KindTransaction:
+		out := new(TransactionNode)
+		if out.Data, err = decodeDataFrameField(f, 1, "data"); err != nil {
+			return nil, err
+		}
+		if out.Metadata, err = decodeDataFrameField(f, 2, "metadata"); err != nil {
+			return nil, err
+		}
+		if out.Slot, err = f.uint(3, "slot"); err != nil {
+			return nil, err
+		}
+		out.Index, err = optionalUint(f, 4, "index")
+		return out, err
+	case KindRewards:
+		out := new(RewardsNode)
+		if out.Slot, err = f.uint(1, "slot"); err != nil {
+			return nil, err
+		}
+		out.Data, err = decodeDataFrameField(f, 2, "data")
+		return out, err
+	case KindDataFrame:
+		frame, err := decodeDataFrame(f)
+		if err != nil {
+			return nil, err
+		}
+		return &frame, nil
+	default:
+		return nil, fmt.Errorf("unknown kind")
+	}
+}
+
+func decodeBlockNode(f fields) (*BlockNode, error) {
+	out := new(BlockNode)
+	var err error
+	if out.Slot, err = f.uint(1, "slot"); err != nil {
+		return nil, err
+	}
+	shredding, err := f.list(2, "shredding")
+	if err != nil {
+		return nil, err
+	}
+	out.Shredding = make([]Shredding, len(shredding))
+	for i := range shredding {
+		s, err := shredding.list(i, "shredding")
+		if err != nil {
+			return nil, err
+		}
+		if out.Shredding[i].EntryEndIndex, err = s.int(0, "entryEndIdx"); err != nil {
+			return nil, err
+		}
+		if out.Shredding[i].ShredEndIndex, err = s.int(1, "shredEndIdx"); err != nil {
+			return nil, err
+		}
+	}
+	if out.Entries, err = f.links(3, "entries"); err != nil {
+		return nil, err
+	}
+	meta, err := f.list(4, "meta")
+	if err != nil {
+		return nil, err
+	}
+	if out.Meta.ParentSlot, err = meta.uint(0, "parent_slot"); err != nil {
+		return nil, err
+	}
+	if out.Meta.BlockTime, err = meta.int(1, "blocktime"); err != nil {
+		return nil, err
+	}
+	if out.Meta.BlockHeight, err = optionalUint(meta, 2, "block_height"); err != nil {
+		return nil, err
+	}
+	if f.get(5) != nil {
+		if out.Rewards, err = f.link(5, "rewards"); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func decodeDataFrameField(f fields, i int, name string) (DataFrame, error) {
+	frame, err := f.list(i, name)
+	if err != nil {
+		return DataFrame{}, err
+	}
+	if kind, err := frame.uint(0, "kind"); err != nil || Kind(kind) != KindDataFrame {
+		return DataFrame{}, fmt.Errorf("invalid %s", name)
+	}
+	return decodeDataFrame(frame)
+}
+
+func decodeDataFrame(f fields) (out DataFrame, err error) {
+	if out.Hash, err = optionalUint(f, 1, "hash"); err != nil {
+		return out, err
+	}
+	if out.Index, err = optionalUint(f, 2, "index"); err != nil {
+		return out, err
+	}
+	if out.Total, err = optionalUint(f, 3, "total"); err != nil {
+		return out, err
+	}
+	if out.Data, err = f.bytes(4, "data"); err != nil {
+		return out, err
+	}
+	out.Next, err = f.links(5, "next")
+	return out, err
+}
+
+func optionalUint(f fields, i int, name string) (*uint64, error) {
+	if f.get(i) == nil {
+		return nil, nil
+	}
+	v, err := f.uint(i, name)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package faithful reads the Old Faithful archives of the Solana ledger: one
+// CAR file per epoch, holding DAG-CBOR nodes of the blocks, entries and
+// transactions of the epoch (see https://docs.old-faithful.net). Blocks are
+// decoded into the same types as the ones of the RPC client, so backfills can
+// switch from the RPC to the archives without changes.
+package faithful
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/ledger"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Block is a block of an archive: the result of getBlock with the "full"
+// transaction details and the rewards, with the entries of the block.
+type Block struct {
+	Slot uint64
+	rpc.GetBlockResult
+	Entries []*ledger.Entry
+}
+
+// Reader reads the blocks of an archive, in order.
+//
+// The nodes of a block precede the node of the block in the archives, so the
+// reader keeps the nodes read since the previous block until the next block.
+type Reader struct {
+	car   *CARReader
+	zstd  *zstd.Decoder
+	nodes map[CID]Node
+	prev  *Block
+	epoch *Epoch
+}
+
+// NewReader reads the archive of an epoch.
+func NewReader(r io.Reader) (*Reader, error) {
+	car, err := NewCARReader(r)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{
+		car:   car,
+		zstd:  decoder,
+		nodes: make(map[CID]Node),
+	}, nil
+}
+
+// Close releases the resources of the reader, not closing the underlying reader.
+func (r *Reader) Close() {
+	r.zstd.Close()
+}
+
+// Epoch returns the root node of the archive, read after the last block.
+func (r *Reader) Epoch() *Epoch {
+	return r.epoch
+}
+
+// Next returns the next block of the archive, or io.EOF at the end.
+func (r *Reader) Next() (*Block, error) {
+	for {
+		cid, data, err := r.car.Next()
+		if err != nil {
+			return nil, err
+		}
+		node, err := DecodeNode(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode node %s: %w", cid, err)
+		}
+		switch node := node.(type) {
+		case *BlockNode:
+			block, err := r.assemble(node)
+			if err != nil {
+				return nil, fmt.Errorf("unable to assemble block %d: %w", node.Slot, err)
+			}
+			r.nodes = make(map[CID]Node)
+			r.prev = block
+			return block, nil
+		case *Epoch:
+			r.epoch = node
+		case *Subset:
+		default:
+			r.nodes[cid] = node
+		}
+	}
+}
+
+func (r *Reader) assemble(node *BlockNode) (*Block, error) {
+	block := &Block{Slot: node.Slot}
+	block.ParentSlot = node.Meta.ParentSlot
+	block.BlockHeight = node.Meta.BlockHeight
+	if node.Meta.BlockTime != 0 {
+		blockTime := solana.UnixTimeSeconds(node.Meta.BlockTime)
+		block.BlockTime = &blockTime
+	}
+	block.Transactions = []rpc.TransactionWithMeta{}
+	block.Signatures = []solana.Signature{}
+
+	for _, cid := range node.Entries {
+		entryNode, ok := r.nodes[cid].(*EntryNode)
+		if !ok {
+			return nil, fmt.Errorf("missing entry %s", cid)
+		}
+		entry := &ledger.Entry{
+			NumHashes: entryNode.NumHashes,
+			Hash:      entryNode.Hash,
+		}
+		for _, cid := range entryNode.Transactions {
+			txNode, ok := r.nodes[cid].(*TransactionNode)
+			if !ok {
+				return nil, fmt.Errorf("missing transaction %s", cid)
+			}
+			tx, txWithMeta, err := r.transaction(txNode, block)
+			if err != nil {
+				return nil, fmt.Errorf("transaction %s: %w", cid, err)
+			}
+			entry.Transactions = append(entry.Transactions, tx)
+			block.Transactions = append(block.Transactions, txWithMeta)
+			block.Signatures = append(block.Signatures, tx.Signatures[0])
+		}
+		block.Entries = append(block.Entries, entry)
+		block.Blockhash = entry.Hash
+	}
+
+	if prev := r.prev; prev != nil && prev.Slot == block.ParentSlot {
+		block.PreviousBlockhash = prev.Blockhash
+	}
+
+	if rewards, ok := r.nodes[node.Rewards].(*RewardsNode); ok {
+		data, err := r.data(rewards.Data)
+		if err != nil {
+			return nil, fmt.Errorf("rewards: %w", err)
+		}
+		if block.Rewards, err = DecodeRewards(data); err != nil {
+			return nil, fmt.Errorf("rewards: %w", err)
+		}
+	}
+	return block, nil
+}
+
+func (r *Reader) transaction(node *TransactionNode, block *Block) (*solana.Transaction, rpc.TransactionWithMeta, error) {
+	var out rpc.TransactionWithMeta
+	raw, err := r.frames(node.Data, 0)
+	if err != nil {
+		return nil, out, err
+	}
+	tx := new(solana.Transaction)
+	if err := tx.UnmarshalWithDecoder(bin.NewBinDecoder(raw)); err != nil {
+		return nil, out, err
+	}
+	if len(tx.Signatures) == 0 {
+		return nil, out, errors.New("transaction without signatures")
+	}
+	out.Slot = block.Slot
+	out.BlockTime = block.BlockTime
+	out.Transaction = rpc.DataBytesOrJSONFromBytes(raw)
+	out.Version = rpc.LegacyTransactionVersion
+	if tx.Message.IsVersioned() {
+		out.Version = 0
+	}
+
+	meta, err := r.data(node.Metadata)
+	if err != nil {
+		return nil, out, fmt.Errorf("metadata: %w", err)
+	}
+	if len(meta) > 0 {
+		if out.Meta, err = DecodeTransactionMeta(meta); err != nil {
+			return nil, out, fmt.Errorf("unsupported metadata (only the protobuf encoding is supported): %w", err)
+		}
+	}
+	return tx, out, nil
+}
+
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// data returns the buffer of the frames, decompressed if compressed.
+func (r *Reader) data(frame DataFrame) ([]byte, error) {
+	data, err := r.frames(frame, 0)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(data, zstdMagic) {
+		return r.zstd.DecodeAll(data, nil)
+	}
+	return data, nil
+}
+
+const maxFrameDepth = 32
+
+// frames returns the buffer of the frame followed by the frames it links.
+func (r *Reader) frames(frame DataFrame, depth int) ([]byte, error) {
+	if len(frame.Next) == 0 {
+		return frame.Data, nil
+	}
+	if depth > maxFrameDepth {
+		return nil, errors.New("data frames too deep")
+	}
+	data := append([]byte(nil), frame.Data...)
+	for _, cid := range frame.Next {
+		next, ok := r.nodes[cid].(*DataFrame)
+		if !ok {
+			return nil, fmt.Errorf("missing data frame %s", cid)
+		}
+		more, err := r.frames(*next, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, more...)
+	}
+	return data, nil
+}
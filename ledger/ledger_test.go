@@ -0,0 +1,248 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/hashutil"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/require"
+)
+
+func testTransaction(t *testing.T, lamports uint64) *solana.Transaction {
+	payer, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(lamports, payer.PublicKey(), solana.SystemProgramID).Build(),
+		},
+		solana.Hash{1},
+	)
+	require.NoError(t, err)
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey { return &payer })
+	require.NoError(t, err)
+	return tx
+}
+
+func testEntries(t *testing.T, prev solana.Hash) []*Entry {
+	txs := []*solana.Transaction{testTransaction(t, 1), testTransaction(t, 2), testTransaction(t, 3)}
+	entries := []*Entry{
+		{NumHashes: 12},
+		{NumHashes: 3, Transactions: txs[:1]},
+		{NumHashes: 1, Transactions: txs[1:]},
+		{NumHashes: 7},
+	}
+	for _, entry := range entries {
+		entry.Hash = NextHash(prev, entry.NumHashes, entry.Transactions)
+		prev = entry.Hash
+	}
+	return entries
+}
+
+func TestEntries(t *testing.T) {
+	prev := solana.Hash{9}
+	entries := testEntries(t, prev)
+	require.True(t, entries[0].IsTick())
+	require.False(t, entries[1].IsTick())
+	require.NoError(t, VerifyEntries(prev, entries))
+
+	data, err := EncodeEntries(entries)
+	require.NoError(t, err)
+	decoded, err := DecodeEntries(data)
+	require.NoError(t, err)
+	require.Equal(t, entries, decoded)
+
+	decoded[2].Transactions[0], decoded[2].Transactions[1] = decoded[2].Transactions[1], decoded[2].Transactions[0]
+	require.ErrorIs(t, VerifyEntries(prev, decoded), ErrInvalidEntryHash)
+	require.ErrorIs(t, VerifyEntries(solana.Hash{8}, entries), ErrInvalidEntryHash)
+
+	_, err = DecodeEntries(data[:len(data)-1])
+	require.Error(t, err)
+}
+
+func TestTransactionsHash(t *testing.T) {
+	require.Equal(t, solana.Hash{}, TransactionsHash(nil))
+
+	a, b, c := solana.Signature{1}, solana.Signature{2}, solana.Signature{3}
+	leaf := func(sig solana.Signature) [32]byte { return hashutil.MerkleLeaf(sig[:]) }
+	require.Equal(t,
+		solana.Hash(leaf(a)),
+		TransactionsHash([]*solana.Transaction{{Signatures: []solana.Signature{a}}}),
+	)
+	require.Equal(t,
+		solana.Hash(hashutil.MerkleNode(hashutil.MerkleNode(leaf(a), leaf(b)), hashutil.MerkleNode(leaf(c), leaf(c)))),
+		TransactionsHash([]*solana.Transaction{{Signatures: []solana.Signature{a, b}}, {Signatures: []solana.Signature{c}}}),
+	)
+}
+
+func TestShredVariant(t *testing.T) {
+	require.Equal(t, ShredTypeData, ShredVariantLegacyData.Type())
+	require.Equal(t, ShredTypeCode, ShredVariantLegacyCode.Type())
+	require.False(t, ShredVariantLegacyData.IsMerkle())
+	require.Equal(t, 1051, ShredVariantLegacyData.Capacity())
+	require.False(t, ShredVariant(0xff).Valid())
+
+	variant, err := MerkleShredVariant(ShredTypeData, 6, true, true)
+	require.NoError(t, err)
+	require.Equal(t, ShredVariant(0xb6), variant)
+	require.Equal(t, ShredTypeData, variant.Type())
+	require.Equal(t, 6, variant.ProofSize())
+	require.True(t, variant.Chained())
+	require.True(t, variant.Resigned())
+	require.Equal(t, 1203-88-32-6*20-64, variant.Capacity())
+	require.Equal(t, "merkle data (proof size 6, chained, resigned)", variant.String())
+
+	variant, err = MerkleShredVariant(ShredTypeCode, 5, false, false)
+	require.NoError(t, err)
+	require.Equal(t, ShredVariant(0x45), variant)
+	require.Equal(t, ShredTypeCode, variant.Type())
+	require.False(t, variant.Chained())
+	require.Equal(t, 1228-89-5*20, variant.Capacity())
+
+	_, err = MerkleShredVariant(ShredTypeCode, 5, false, true)
+	require.Error(t, err)
+}
+
+// makeErasureBatch splits the data into the four merkle data shreds of an
+// erasure batch signed by the leader.
+func makeErasureBatch(t *testing.T, leader solana.PrivateKey, slot uint64, fecSetIndex uint32, data []byte, last bool, chainedRoot solana.Hash) []*Shred {
+	const proofSize = 2
+	variant, err := MerkleShredVariant(ShredTypeData, proofSize, true, false)
+	require.NoError(t, err)
+	capacity := variant.Capacity()
+
+	var payloads [][]byte
+	for i := 0; i < 1<<proofSize; i++ {
+		n := capacity
+		if n > len(data) {
+			n = len(data)
+		}
+		payload := make([]byte, variant.PayloadSize())
+		payload[64] = byte(variant)
+		binary.LittleEndian.PutUint64(payload[65:], slot)
+		binary.LittleEndian.PutUint32(payload[73:], fecSetIndex+uint32(i))
+		binary.LittleEndian.PutUint16(payload[77:], 50093)
+		binary.LittleEndian.PutUint32(payload[79:], fecSetIndex)
+		binary.LittleEndian.PutUint16(payload[83:], 1)
+		binary.LittleEndian.PutUint16(payload[86:], uint16(SizeOfDataHeaders+n))
+		copy(payload[SizeOfDataHeaders:], data[:n])
+		data = data[n:]
+		if i == 1<<proofSize-1 {
+			require.Empty(t, data)
+			payload[85] = ShredFlagDataComplete
+			if last {
+				payload[85] = ShredFlagLastInSlot
+			}
+		}
+		copy(payload[SizeOfDataHeaders+capacity:], chainedRoot[:])
+		payloads = append(payloads, payload)
+	}
+
+	var level []solana.Hash
+	for _, payload := range payloads {
+		shred, err := ParseShred(payload)
+		require.NoError(t, err)
+		level = append(level, shred.MerkleLeaf())
+	}
+	tree := [][]solana.Hash{level}
+	for len(level) > 1 {
+		var next []solana.Hash
+		for i := 0; i < len(level); i += 2 {
+			right := level[i]
+			if i+1 < len(level) {
+				right = level[i+1]
+			}
+			next = append(next, JoinMerkleNodes(level[i][:], right[:]))
+		}
+		tree = append(tree, next)
+		level = next
+	}
+	signature, err := leader.Sign(level[0][:])
+	require.NoError(t, err)
+
+	var shreds []*Shred
+	for i, payload := range payloads {
+		offset := SizeOfDataHeaders + capacity + SizeOfMerkleRoot
+		index := i
+		for _, nodes := range tree[:len(tree)-1] {
+			sibling := index ^ 1
+			if sibling >= len(nodes) {
+				sibling = index
+			}
+			copy(payload[offset:offset+SizeOfMerkleProofEntry], nodes[sibling][:])
+			offset += SizeOfMerkleProofEntry
+			index >>= 1
+		}
+		copy(payload, signature[:])
+		shred, err := ParseShred(payload)
+		require.NoError(t, err)
+		shreds = append(shreds, shred)
+	}
+	return shreds
+}
+
+func TestShreds(t *testing.T) {
+	leader, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+
+	entries := testEntries(t, solana.Hash{9})
+	// Spread the first batch of entries over several shreds.
+	for i := 0; i < 30; i++ {
+		entries = append([]*Entry{{NumHashes: uint64(i)}}, entries...)
+	}
+	first, err := EncodeEntries(entries[:32])
+	require.NoError(t, err)
+	second, err := EncodeEntries(entries[32:])
+	require.NoError(t, err)
+
+	batch := makeErasureBatch(t, leader, 100, 0, first, false, solana.Hash{})
+	require.NotEmpty(t, batch[1].Payload[SizeOfDataHeaders:batch[1].Size])
+	root, err := batch[0].MerkleRoot()
+	require.NoError(t, err)
+	batch = append(batch, makeErasureBatch(t, leader, 100, uint32(len(batch)), second, true, root)...)
+
+	assembler := NewAssembler(100)
+	for i := len(batch) - 1; i >= 0; i-- {
+		shred := batch[i]
+		require.Equal(t, uint64(99), shred.ParentSlot())
+		require.True(t, shred.VerifySignature(leader.PublicKey()))
+		require.False(t, shred.VerifySignature(solana.SystemProgramID))
+		require.False(t, assembler.Complete())
+		require.NoError(t, assembler.Add(shred))
+	}
+	require.True(t, assembler.Complete())
+	require.True(t, batch[len(batch)-1].LastInSlot())
+	chained, ok := batch[len(batch)-1].ChainedMerkleRoot()
+	require.True(t, ok)
+	require.Equal(t, root, chained)
+
+	decoded, err := assembler.Entries()
+	require.NoError(t, err)
+	require.Equal(t, entries, decoded)
+
+	require.Error(t, assembler.Add(&Shred{CommonHeader: CommonHeader{Slot: 101}}))
+
+	tampered := append([]byte(nil), batch[0].Payload...)
+	tampered[SizeOfDataHeaders] ^= 1
+	shred, err := ParseShred(tampered)
+	require.NoError(t, err)
+	require.False(t, shred.VerifySignature(leader.PublicKey()))
+
+	_, err = ParseShred(batch[0].Payload[:MerkleDataPayloadSize-1])
+	require.Error(t, err)
+}
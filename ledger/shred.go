@@ -0,0 +1,513 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Sizes of the shreds.
+const (
+	SizeOfCommonHeader = 83
+	SizeOfDataHeaders  = SizeOfCommonHeader + 5
+	SizeOfCodeHeaders  = SizeOfCommonHeader + 6
+
+	// Size of the payload of the legacy shreds.
+	LegacyPayloadSize = 1228
+	// Size of the payload of the merkle data shreds.
+	MerkleDataPayloadSize = 1203
+	// Size of the payload of the merkle code shreds.
+	MerkleCodePayloadSize = 1228
+
+	SizeOfMerkleRoot       = 32
+	SizeOfMerkleProofEntry = 20
+
+	legacyErasureShardSize = LegacyPayloadSize - SizeOfCodeHeaders
+)
+
+// ShredType is the type of a shred: data shreds carry the entries of a slot,
+// code shreds carry the Reed-Solomon erasure codes recovering lost data shreds.
+type ShredType uint8
+
+const (
+	ShredTypeData ShredType = iota
+	ShredTypeCode
+)
+
+func (typ ShredType) String() string {
+	switch typ {
+	case ShredTypeData:
+		return "data"
+	case ShredTypeCode:
+		return "code"
+	default:
+		return fmt.Sprintf("ShredType(%d)", uint8(typ))
+	}
+}
+
+// ShredVariant is the byte of the common header of a shred telling its type,
+// whether it is a legacy shred or a merkle shred (signed by the root of the
+// merkle tree of its erasure batch), and for the merkle shreds the size of
+// the merkle proof, whether it is chained to the merkle root of the previous
+// erasure batch and whether it is signed by its retransmitter.
+type ShredVariant byte
+
+const (
+	ShredVariantLegacyCode ShredVariant = 0b0101_1010
+	ShredVariantLegacyData ShredVariant = 0b1010_0101
+)
+
+// Prefixes of the merkle variants; the low four bits are the proof size.
+const (
+	merkleCode                 = 0b0100_0000
+	merkleCodeChained          = 0b0110_0000
+	merkleCodeChainedResigned  = 0b0111_0000
+	merkleData                 = 0b1000_0000
+	merkleDataChained          = 0b1001_0000
+	merkleDataChainedResigned  = 0b1011_0000
+	merkleVariantMask          = 0b1111_0000
+	merkleProofSizeVariantMask = 0b0000_1111
+)
+
+// MerkleShredVariant returns the variant of the merkle shreds.
+func MerkleShredVariant(typ ShredType, proofSize uint8, chained bool, resigned bool) (ShredVariant, error) {
+	if proofSize > merkleProofSizeVariantMask {
+		return 0, fmt.Errorf("invalid proof size %d", proofSize)
+	}
+	if resigned && !chained {
+		return 0, errors.New("resigned shreds must be chained")
+	}
+	var prefix byte
+	switch {
+	case typ == ShredTypeCode && resigned:
+		prefix = merkleCodeChainedResigned
+	case typ == ShredTypeCode && chained:
+		prefix = merkleCodeChained
+	case typ == ShredTypeCode:
+		prefix = merkleCode
+	case resigned:
+		prefix = merkleDataChainedResigned
+	case chained:
+		prefix = merkleDataChained
+	default:
+		prefix = merkleData
+	}
+	return ShredVariant(prefix | proofSize), nil
+}
+
+// Valid tells whether the variant is known.
+func (v ShredVariant) Valid() bool {
+	if v == ShredVariantLegacyCode || v == ShredVariantLegacyData {
+		return true
+	}
+	switch byte(v) & merkleVariantMask {
+	case merkleCode, merkleCodeChained, merkleCodeChainedResigned,
+		merkleData, merkleDataChained, merkleDataChainedResigned:
+		return true
+	}
+	return false
+}
+
+// Type returns the type of the shreds of the variant.
+func (v ShredVariant) Type() ShredType {
+	if v == ShredVariantLegacyCode {
+		return ShredTypeCode
+	}
+	if v == ShredVariantLegacyData {
+		return ShredTypeData
+	}
+	switch byte(v) & merkleVariantMask {
+	case merkleCode, merkleCodeChained, merkleCodeChainedResigned:
+		return ShredTypeCode
+	}
+	return ShredTypeData
+}
+
+// IsMerkle tells whether the shreds of the variant are merkle shreds.
+func (v ShredVariant) IsMerkle() bool {
+	return v.Valid() && v != ShredVariantLegacyCode && v != ShredVariantLegacyData
+}
+
+// ProofSize returns the number of entries of the merkle proof of the merkle
+// shreds of the variant.
+func (v ShredVariant) ProofSize() int {
+	if !v.IsMerkle() {
+		return 0
+	}
+	return int(byte(v) & merkleProofSizeVariantMask)
+}
+
+// Chained tells whether the merkle shreds of the variant carry the merkle root
+// of the previous erasure batch.
+func (v ShredVariant) Chained() bool {
+	switch byte(v) & merkleVariantMask {
+	case merkleCodeChained, merkleCodeChainedResigned, merkleDataChained, merkleDataChainedResigned:
+		return v.IsMerkle()
+	}
+	return false
+}
+
+// Resigned tells whether the merkle shreds of the variant are signed by their
+// retransmitter.
+func (v ShredVariant) Resigned() bool {
+	switch byte(v) & merkleVariantMask {
+	case merkleCodeChainedResigned, merkleDataChainedResigned:
+		return v.IsMerkle()
+	}
+	return false
+}
+
+// PayloadSize returns the size of the payload of the shreds of the variant.
+func (v ShredVariant) PayloadSize() int {
+	if v.IsMerkle() && v.Type() == ShredTypeData {
+		return MerkleDataPayloadSize
+	}
+	if v.IsMerkle() {
+		return MerkleCodePayloadSize
+	}
+	return LegacyPayloadSize
+}
+
+func (v ShredVariant) headersSize() int {
+	if v.Type() == ShredTypeCode {
+		return SizeOfCodeHeaders
+	}
+	return SizeOfDataHeaders
+}
+
+// Capacity returns the number of bytes of data (for the data shreds) or of
+// erasure codes (for the code shreds) carried by the shreds of the variant.
+func (v ShredVariant) Capacity() int {
+	if !v.IsMerkle() {
+		// The erasure codes of the legacy shreds cover the beginning of the
+		// payload of the data shreds, headers included.
+		if v.Type() == ShredTypeCode {
+			return legacyErasureShardSize
+		}
+		return legacyErasureShardSize - SizeOfDataHeaders
+	}
+	capacity := v.PayloadSize() - v.headersSize()
+	capacity -= v.ProofSize() * SizeOfMerkleProofEntry
+	if v.Chained() {
+		capacity -= SizeOfMerkleRoot
+	}
+	if v.Resigned() {
+		capacity -= solana.SignatureLength
+	}
+	return capacity
+}
+
+func (v ShredVariant) String() string {
+	if !v.Valid() {
+		return fmt.Sprintf("ShredVariant(%#08b)", byte(v))
+	}
+	if !v.IsMerkle() {
+		return fmt.Sprintf("legacy %s", v.Type())
+	}
+	out := fmt.Sprintf("merkle %s (proof size %d", v.Type(), v.ProofSize())
+	if v.Chained() {
+		out += ", chained"
+	}
+	if v.Resigned() {
+		out += ", resigned"
+	}
+	return out + ")"
+}
+
+// Flags of the data shreds.
+const (
+	// Mask of the number of ticks of the slot when the shred was made.
+	ShredFlagTickReferenceMask = 0b0011_1111
+	// The shred completes a batch of entries (see DecodeEntries).
+	ShredFlagDataComplete = 0b0100_0000
+	// The shred is the last data shred of its slot.
+	ShredFlagLastInSlot = 0b1100_0000
+)
+
+// CommonHeader is the header of all shreds.
+type CommonHeader struct {
+	Signature solana.Signature
+	Variant   ShredVariant
+	Slot      uint64
+	// Index of the shred among the data or code shreds of the slot.
+	Index uint32
+	// Version of the shreds of the cluster.
+	Version uint16
+	// Index of the first data shred of the erasure batch.
+	FECSetIndex uint32
+}
+
+// DataHeader is the header following the common header of the data shreds.
+type DataHeader struct {
+	// Distance to the parent slot.
+	ParentOffset uint16
+	Flags        uint8
+	// Size of the headers and the data of the shred.
+	Size uint16
+}
+
+// CodeHeader is the header following the common header of the code shreds.
+type CodeHeader struct {
+	NumDataShreds   uint16
+	NumCodingShreds uint16
+	// Position of the shred among the code shreds of its erasure batch.
+	Position uint16
+}
+
+// Shred is a fragment of a block, as propagated by Turbine.
+type Shred struct {
+	CommonHeader
+	// Set for the data shreds.
+	DataHeader
+	// Set for the code shreds.
+	CodeHeader
+
+	Payload []byte
+}
+
+// ParseShred decodes the shred of the payload, which can be followed by other
+// bytes (e.g. the nonce of the repair responses).
+func ParseShred(payload []byte) (*Shred, error) {
+	if len(payload) < SizeOfCodeHeaders {
+		return nil, fmt.Errorf("shred too short: %d bytes", len(payload))
+	}
+	shred := new(Shred)
+	copy(shred.Signature[:], payload)
+	shred.Variant = ShredVariant(payload[64])
+	if !shred.Variant.Valid() {
+		return nil, fmt.Errorf("invalid shred variant %#08b", payload[64])
+	}
+	shred.Slot = binary.LittleEndian.Uint64(payload[65:])
+	shred.Index = binary.LittleEndian.Uint32(payload[73:])
+	shred.Version = binary.LittleEndian.Uint16(payload[77:])
+	shred.FECSetIndex = binary.LittleEndian.Uint32(payload[79:])
+
+	size := shred.Variant.PayloadSize()
+	switch shred.Variant.Type() {
+	case ShredTypeData:
+		shred.ParentOffset = binary.LittleEndian.Uint16(payload[83:])
+		shred.Flags = payload[85]
+		shred.Size = binary.LittleEndian.Uint16(payload[86:])
+		if int(shred.Size) < SizeOfDataHeaders || int(shred.Size) > SizeOfDataHeaders+shred.Variant.Capacity() {
+			return nil, fmt.Errorf("invalid data shred size %d", shred.Size)
+		}
+		if shred.ParentOffset == 0 && shred.Slot != 0 || uint64(shred.ParentOffset) > shred.Slot {
+			return nil, fmt.Errorf("invalid parent offset %d of slot %d", shred.ParentOffset, shred.Slot)
+		}
+		if shred.Index < shred.FECSetIndex {
+			return nil, fmt.Errorf("index %d before the erasure batch %d", shred.Index, shred.FECSetIndex)
+		}
+		if !shred.Variant.IsMerkle() && len(payload) < size {
+			// The legacy data shreds can be trimmed after their data.
+			size = len(payload)
+			if size < int(shred.Size) {
+				return nil, fmt.Errorf("shred too short: %d bytes, size %d", len(payload), shred.Size)
+			}
+		}
+	case ShredTypeCode:
+		shred.NumDataShreds = binary.LittleEndian.Uint16(payload[83:])
+		shred.NumCodingShreds = binary.LittleEndian.Uint16(payload[85:])
+		shred.Position = binary.LittleEndian.Uint16(payload[87:])
+		if shred.NumDataShreds == 0 || shred.NumCodingShreds == 0 || shred.Position >= shred.NumCodingShreds {
+			return nil, fmt.Errorf("invalid code shred header %+v", shred.CodeHeader)
+		}
+	}
+	if len(payload) < size {
+		return nil, fmt.Errorf("shred too short: %d bytes, expected %d", len(payload), size)
+	}
+	shred.Payload = payload[:size]
+	return shred, nil
+}
+
+// Type returns the type of the shred.
+func (shred *Shred) Type() ShredType {
+	return shred.Variant.Type()
+}
+
+// ParentSlot returns the parent slot of the slot of the data shred.
+func (shred *Shred) ParentSlot() uint64 {
+	return shred.Slot - uint64(shred.ParentOffset)
+}
+
+// DataComplete tells whether the data shred completes a batch of entries.
+func (shred *Shred) DataComplete() bool {
+	return shred.Type() == ShredTypeData && shred.Flags&ShredFlagDataComplete != 0
+}
+
+// LastInSlot tells whether the data shred is the last one of its slot.
+func (shred *Shred) LastInSlot() bool {
+	return shred.Type() == ShredTypeData && shred.Flags&ShredFlagLastInSlot == ShredFlagLastInSlot
+}
+
+// ReferenceTick returns the number of ticks of the slot
+// when the data shred was made.
+func (shred *Shred) ReferenceTick() uint8 {
+	return shred.Flags & ShredFlagTickReferenceMask
+}
+
+// Data returns the data carried by the data shred, to be concatenated with
+// the data of the other data shreds of the slot (see Assembler).
+func (shred *Shred) Data() ([]byte, error) {
+	if shred.Type() != ShredTypeData {
+		return nil, errors.New("not a data shred")
+	}
+	return shred.Payload[SizeOfDataHeaders:shred.Size], nil
+}
+
+// ErasureShard returns the bytes of the shred covered by the erasure codes of
+// its erasure batch.
+func (shred *Shred) ErasureShard() []byte {
+	if !shred.Variant.IsMerkle() {
+		if shred.Type() == ShredTypeCode {
+			return shred.Payload[SizeOfCodeHeaders:]
+		}
+		shard := make([]byte, legacyErasureShardSize)
+		copy(shard, shred.Payload)
+		return shard
+	}
+	if shred.Type() == ShredTypeCode {
+		return shred.Payload[SizeOfCodeHeaders : SizeOfCodeHeaders+shred.Variant.Capacity()]
+	}
+	return shred.Payload[solana.SignatureLength : SizeOfDataHeaders+shred.Variant.Capacity()]
+}
+
+// ErasureShardIndex returns the index of the shred in its erasure batch,
+// the data shreds followed by the code shreds.
+func (shred *Shred) ErasureShardIndex() int {
+	if shred.Type() == ShredTypeCode {
+		return int(shred.NumDataShreds) + int(shred.Position)
+	}
+	return int(shred.Index - shred.FECSetIndex)
+}
+
+func (shred *Shred) proofOffset() int {
+	offset := shred.Variant.headersSize() + shred.Variant.Capacity()
+	if shred.Variant.Chained() {
+		offset += SizeOfMerkleRoot
+	}
+	return offset
+}
+
+// ChainedMerkleRoot returns the merkle root of the previous erasure batch
+// carried by the chained merkle shred.
+func (shred *Shred) ChainedMerkleRoot() (root solana.Hash, ok bool) {
+	if !shred.Variant.Chained() {
+		return root, false
+	}
+	offset := shred.proofOffset() - SizeOfMerkleRoot
+	copy(root[:], shred.Payload[offset:])
+	return root, true
+}
+
+// MerkleProof returns the entries of the merkle proof of the merkle shred:
+// the first 20 bytes of the hashes of the siblings of the nodes of the path
+// from the shred to the merkle root of its erasure batch.
+func (shred *Shred) MerkleProof() [][SizeOfMerkleProofEntry]byte {
+	proof := make([][SizeOfMerkleProofEntry]byte, shred.Variant.ProofSize())
+	offset := shred.proofOffset()
+	for i := range proof {
+		copy(proof[i][:], shred.Payload[offset+i*SizeOfMerkleProofEntry:])
+	}
+	return proof
+}
+
+// RetransmitterSignature returns the signature of the retransmitter
+// of the resigned merkle shred.
+func (shred *Shred) RetransmitterSignature() (sig solana.Signature, ok bool) {
+	if !shred.Variant.Resigned() {
+		return sig, false
+	}
+	copy(sig[:], shred.Payload[len(shred.Payload)-solana.SignatureLength:])
+	return sig, true
+}
+
+var (
+	merkleHashPrefixLeaf = []byte("\x00SOLANA_MERKLE_SHREDS_LEAF")
+	merkleHashPrefixNode = []byte("\x01SOLANA_MERKLE_SHREDS_NODE")
+)
+
+// MerkleLeaf returns the hash of the leaf of the merkle shred in the merkle
+// tree of its erasure batch.
+func (shred *Shred) MerkleLeaf() solana.Hash {
+	h := sha256.New()
+	h.Write(merkleHashPrefixLeaf)
+	h.Write(shred.Payload[solana.SignatureLength:shred.proofOffset()])
+	var out solana.Hash
+	h.Sum(out[:0])
+	return out
+}
+
+// JoinMerkleNodes returns the hash of the parent of two nodes
+// of the merkle tree of an erasure batch.
+func JoinMerkleNodes(left, right []byte) solana.Hash {
+	h := sha256.New()
+	h.Write(merkleHashPrefixNode)
+	h.Write(left[:SizeOfMerkleProofEntry])
+	h.Write(right[:SizeOfMerkleProofEntry])
+	var out solana.Hash
+	h.Sum(out[:0])
+	return out
+}
+
+// MerkleRoot returns the merkle root of the erasure batch of the merkle shred,
+// computed from its leaf and its merkle proof; it is the message signed by
+// the leader.
+func (shred *Shred) MerkleRoot() (solana.Hash, error) {
+	if !shred.Variant.IsMerkle() {
+		return solana.Hash{}, errors.New("not a merkle shred")
+	}
+	index := shred.ErasureShardIndex()
+	node := shred.MerkleLeaf()
+	for _, other := range shred.MerkleProof() {
+		if index%2 == 0 {
+			node = JoinMerkleNodes(node[:], other[:])
+		} else {
+			node = JoinMerkleNodes(other[:], node[:])
+		}
+		index >>= 1
+	}
+	if index != 0 {
+		return solana.Hash{}, fmt.Errorf("invalid merkle proof of shred %d", shred.ErasureShardIndex())
+	}
+	return node, nil
+}
+
+// SignedMessage returns the message signed by the leader: the merkle root of
+// the erasure batch of the merkle shreds, the payload after the signature of
+// the legacy shreds.
+func (shred *Shred) SignedMessage() ([]byte, error) {
+	if !shred.Variant.IsMerkle() {
+		return shred.Payload[solana.SignatureLength:], nil
+	}
+	root, err := shred.MerkleRoot()
+	if err != nil {
+		return nil, err
+	}
+	return root[:], nil
+}
+
+// VerifySignature checks that the shred is signed by the leader of its slot.
+func (shred *Shred) VerifySignature(leader solana.PublicKey) bool {
+	message, err := shred.SignedMessage()
+	if err != nil {
+		return false
+	}
+	return shred.Signature.Verify(leader, message)
+}
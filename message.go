@@ -18,6 +18,7 @@
 package solana
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 
@@ -414,6 +415,26 @@ func (mx *Message) ResolveLookups() (err error) {
 	return nil
 }
 
+// FetchAndResolveLookups fetches the address tables of the lookups of the
+// message with the fetcher (e.g. the RPC fetcher of the address-lookup-table
+// program package), and resolves the lookups (see ResolveLookups).
+func (mx *Message) FetchAndResolveLookups(ctx context.Context, fetcher AddressTablesFetcher) error {
+	if mx.resolved || mx.NumLookups() == 0 {
+		return nil
+	}
+	tables, err := fetcher.FetchAddressTables(ctx, mx.addressTableLookups.GetTableIDs())
+	if err != nil {
+		return fmt.Errorf("unable to fetch address tables: %w", err)
+	}
+	if err := mx.SetAddressTables(tables); err != nil {
+		return err
+	}
+	if err := mx.ResolveLookups(); err != nil {
+		return fmt.Errorf("unable to resolve lookups: %w", err)
+	}
+	return nil
+}
+
 // GetAllKeys returns ALL the message's account keys (including the keys from resolved address lookup tables).
 func (mx Message) GetAllKeys() (keys PublicKeySlice, err error) {
 	if mx.resolved {
@@ -468,7 +489,7 @@ func (mx *Message) UnmarshalV0(decoder *bin.Decoder) (err error) {
 			}
 
 			// read writable indexes
-			writableIndexesLen, err := decoder.ReadCompactU16()
+			writableIndexesLen, err := readCompactU16(decoder)
 			if err != nil {
 				return fmt.Errorf("failed to read writable indexes length: %w", err)
 			}
@@ -482,7 +503,7 @@ func (mx *Message) UnmarshalV0(decoder *bin.Decoder) (err error) {
 			}
 
 			// read readonly indexes
-			readonlyIndexesLen, err := decoder.ReadCompactU16()
+			readonlyIndexesLen, err := readCompactU16(decoder)
 			if err != nil {
 				return fmt.Errorf("failed to read readonly indexes length: %w", err)
 			}
@@ -515,7 +536,7 @@ func (mx *Message) UnmarshalLegacy(decoder *bin.Decoder) (err error) {
 		}
 	}
 	{
-		numAccountKeys, err := decoder.ReadCompactU16()
+		numAccountKeys, err := readCompactU16(decoder)
 		if err != nil {
 			return fmt.Errorf("unable to decode numAccountKeys: %w", err)
 		}
@@ -537,7 +558,7 @@ func (mx *Message) UnmarshalLegacy(decoder *bin.Decoder) (err error) {
 		}
 	}
 	{
-		numInstructions, err := decoder.ReadCompactU16()
+		numInstructions, err := readCompactU16(decoder)
 		if err != nil {
 			return fmt.Errorf("unable to decode numInstructions: %w", err)
 		}
@@ -553,7 +574,7 @@ func (mx *Message) UnmarshalLegacy(decoder *bin.Decoder) (err error) {
 			mx.Instructions[instructionIndex].ProgramIDIndex = uint16(programIDIndex)
 
 			{
-				numAccounts, err := decoder.ReadCompactU16()
+				numAccounts, err := readCompactU16(decoder)
 				if err != nil {
 					return fmt.Errorf("unable to decode numAccounts for ix[%d]: %w", instructionIndex, err)
 				}
@@ -570,7 +591,7 @@ func (mx *Message) UnmarshalLegacy(decoder *bin.Decoder) (err error) {
 				}
 			}
 			{
-				dataLen, err := decoder.ReadCompactU16()
+				dataLen, err := readCompactU16(decoder)
 				if err != nil {
 					return fmt.Errorf("unable to decode dataLen for ix[%d]: %w", instructionIndex, err)
 				}
@@ -19,6 +19,7 @@ package solana
 
 import (
 	"crypto/ed25519"
+	"crypto/subtle"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -112,6 +113,11 @@ func (sig Signature) Equals(pb Signature) bool {
 	return sig == pb
 }
 
+// EqualsConstantTime is like Equals, but compares the signatures in constant time.
+func (sig Signature) EqualsConstantTime(pb Signature) bool {
+	return subtle.ConstantTimeCompare(sig[:], pb[:]) == 1
+}
+
 // SignatureFromBase58 decodes a base58 string into a Signature.
 func SignatureFromBase58(in string) (out Signature, err error) {
 	val, err := base58.Decode(in)
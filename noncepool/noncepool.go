@@ -0,0 +1,296 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package noncepool manages a pool of durable nonce accounts, to sign
+// transactions long before they are sent (e.g. offline, or in advance of a
+// scheduled submission): each transaction leases a nonce account, whose
+// current nonce replaces the recent blockhash, and the nonce account is
+// recycled once the transaction advanced the nonce.
+package noncepool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// ErrClosed is returned by Acquire after the pool is closed.
+var ErrClosed = errors.New("nonce pool is closed")
+
+// DefaultPollInterval is the default interval between two checks
+// of a nonce account in RecycleWhenAdvanced.
+const DefaultPollInterval = 2 * time.Second
+
+// Opts are the options of a Pool.
+type Opts struct {
+	// Commitment of the getAccountInfo requests.
+	// If not provided, the default is "finalized", so that a nonce is
+	// never leased again before the transaction that advanced it is final.
+	Commitment rpc.CommitmentType
+
+	// The interval between two checks of a nonce account in RecycleWhenAdvanced.
+	// If not provided, the default is DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// Pool leases nonce accounts, controlled by the same authority,
+// to the outgoing transactions; each nonce account is leased to
+// at most one transaction at a time.
+type Pool struct {
+	client       *rpc.Client
+	authority    solana.PublicKey
+	commitment   rpc.CommitmentType
+	pollInterval time.Duration
+
+	mu     sync.Mutex
+	free   []solana.PublicKey
+	leased map[solana.PublicKey]*Lease
+	wakeup chan struct{}
+	closed bool
+}
+
+// New creates a pool of the provided nonce accounts, whose nonce authority
+// must be the provided authority. See CreateNonceAccountInstructions to
+// create the nonce accounts.
+func New(client *rpc.Client, authority solana.PublicKey, accounts []solana.PublicKey, opts *Opts) *Pool {
+	if opts == nil {
+		opts = &Opts{}
+	}
+	pool := &Pool{
+		client:       client,
+		authority:    authority,
+		commitment:   opts.Commitment,
+		pollInterval: opts.PollInterval,
+		free:         append([]solana.PublicKey{}, accounts...),
+		leased:       make(map[solana.PublicKey]*Lease),
+		wakeup:       make(chan struct{}),
+	}
+	if pool.commitment == "" {
+		pool.commitment = rpc.CommitmentFinalized
+	}
+	if pool.pollInterval <= 0 {
+		pool.pollInterval = DefaultPollInterval
+	}
+	return pool
+}
+
+// Lease is a nonce account leased to a transaction.
+type Lease struct {
+	// The nonce account.
+	Account solana.PublicKey
+
+	// The nonce authority, which must sign the transaction.
+	Authority solana.PublicKey
+
+	// The current nonce, to be used as the recent blockhash of the transaction.
+	Nonce solana.Hash
+
+	// The fee of each signature stored in the nonce account.
+	LamportsPerSignature uint64
+}
+
+// AdvanceInstruction returns the instruction that advances the nonce,
+// which must be the first instruction of the transaction.
+func (lease *Lease) AdvanceInstruction() solana.Instruction {
+	return system.NewAdvanceNonceAccountInstruction(
+		lease.Account,
+		solana.SysVarRecentBlockHashesPubkey,
+		lease.Authority,
+	).Build()
+}
+
+// NewTransaction creates a transaction that uses the leased nonce:
+// the advance instruction is prepended to the provided instructions,
+// and the nonce is used as the recent blockhash.
+func (lease *Lease) NewTransaction(instructions []solana.Instruction, opts ...solana.TransactionOption) (*solana.Transaction, error) {
+	all := append([]solana.Instruction{lease.AdvanceInstruction()}, instructions...)
+	return solana.NewTransaction(all, lease.Nonce, opts...)
+}
+
+// Size returns the number of nonce accounts of the pool.
+func (pool *Pool) Size() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return len(pool.free) + len(pool.leased)
+}
+
+// Available returns the number of nonce accounts that are not leased.
+func (pool *Pool) Available() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return len(pool.free)
+}
+
+// Add adds nonce accounts to the pool (e.g. after creating them).
+func (pool *Pool) Add(accounts ...solana.PublicKey) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.free = append(pool.free, accounts...)
+	pool.notifyLocked()
+}
+
+func (pool *Pool) notifyLocked() {
+	close(pool.wakeup)
+	pool.wakeup = make(chan struct{})
+}
+
+// Close makes the pending and the future calls of Acquire fail with ErrClosed.
+func (pool *Pool) Close() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if !pool.closed {
+		pool.closed = true
+		pool.notifyLocked()
+	}
+}
+
+// Acquire leases a nonce account, waiting until one is available,
+// and fetches its current nonce.
+func (pool *Pool) Acquire(ctx context.Context) (*Lease, error) {
+	for {
+		pool.mu.Lock()
+		if pool.closed {
+			pool.mu.Unlock()
+			return nil, ErrClosed
+		}
+		if len(pool.free) == 0 {
+			wakeup := pool.wakeup
+			pool.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-wakeup:
+				continue
+			}
+		}
+		account := pool.free[0]
+		pool.free = pool.free[1:]
+		lease := &Lease{
+			Account:   account,
+			Authority: pool.authority,
+		}
+		pool.leased[account] = lease
+		pool.mu.Unlock()
+
+		state, err := pool.fetch(ctx, account)
+		if err != nil {
+			pool.Release(lease)
+			return nil, err
+		}
+		lease.Nonce = solana.Hash(state.Nonce)
+		lease.LamportsPerSignature = state.FeeCalculator.LamportsPerSignature
+		return lease, nil
+	}
+}
+
+// Release returns the nonce account to the pool without waiting for the
+// nonce to advance: only call it if the transaction was never sent
+// (or will never be sent).
+func (pool *Pool) Release(lease *Lease) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if _, ok := pool.leased[lease.Account]; !ok {
+		return
+	}
+	delete(pool.leased, lease.Account)
+	pool.free = append(pool.free, lease.Account)
+	pool.notifyLocked()
+}
+
+// RecycleWhenAdvanced waits until the nonce of the leased account has advanced
+// (i.e. the transaction landed with the configured commitment, even if it failed),
+// then returns the nonce account to the pool.
+// If the context is canceled first, the nonce account remains leased.
+func (pool *Pool) RecycleWhenAdvanced(ctx context.Context, lease *Lease) error {
+	for {
+		state, err := pool.fetch(ctx, lease.Account)
+		if err != nil {
+			return err
+		}
+		if solana.Hash(state.Nonce) != lease.Nonce {
+			pool.Release(lease)
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pool.pollInterval):
+		}
+	}
+}
+
+func (pool *Pool) fetch(ctx context.Context, account solana.PublicKey) (*system.NonceAccount, error) {
+	info, err := pool.client.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
+		Commitment: pool.commitment,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get nonce account %s: %w", account, err)
+	}
+	state, err := DecodeNonceAccount(info.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nonce account %s: %w", account, err)
+	}
+	if !state.AuthorizedPubkey.Equals(pool.authority) {
+		return nil, fmt.Errorf("invalid nonce account %s: the authority is %s", account, state.AuthorizedPubkey)
+	}
+	return state, nil
+}
+
+// DecodeNonceAccount decodes an initialized nonce account.
+func DecodeNonceAccount(account *rpc.Account) (*system.NonceAccount, error) {
+	if !account.Owner.Equals(solana.SystemProgramID) {
+		return nil, fmt.Errorf("not owned by the system program")
+	}
+	data := account.Data.GetBinary()
+	if len(data) != system.NonceAccountSize {
+		return nil, fmt.Errorf("expected %d bytes of data, got %d", system.NonceAccountSize, len(data))
+	}
+	var state system.NonceAccount
+	if err := bin.NewBinDecoder(data).Decode(&state); err != nil {
+		return nil, err
+	}
+	if state.State != system.NonceStateInitialized {
+		return nil, fmt.Errorf("not initialized")
+	}
+	return &state, nil
+}
+
+// CreateNonceAccountInstructions returns the instructions that create and
+// initialize a nonce account; the new account must sign the transaction.
+// See solana.MinimumBalanceForRentExemption (or getMinimumBalanceForRentExemption)
+// for the lamports, with system.NonceAccountSize bytes of data.
+func CreateNonceAccountInstructions(funder solana.PublicKey, nonceAccount solana.PublicKey, authority solana.PublicKey, lamports uint64) []solana.Instruction {
+	return []solana.Instruction{
+		system.NewCreateAccountInstruction(
+			lamports,
+			system.NonceAccountSize,
+			solana.SystemProgramID,
+			funder,
+			nonceAccount,
+		).Build(),
+		system.NewInitializeNonceAccountInstruction(
+			authority,
+			nonceAccount,
+			solana.SysVarRecentBlockHashesPubkey,
+			solana.SysVarRentPubkey,
+		).Build(),
+	}
+}
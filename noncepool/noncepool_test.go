@@ -0,0 +1,168 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noncepool
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// nonceNode is a JSONRPCClient that serves nonce accounts.
+type nonceNode struct {
+	mu     sync.Mutex
+	nonces map[solana.PublicKey]*system.NonceAccount
+}
+
+func (node *nonceNode) advance(account solana.PublicKey) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.nonces[account].Nonce[0]++
+}
+
+func (node *nonceNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if method != "getAccountInfo" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	state, ok := node.nonces[params[0].(solana.PublicKey)]
+	if !ok {
+		return json.Unmarshal([]byte(`{"context":{"slot":1},"value":null}`), out)
+	}
+	buf := new(bytes.Buffer)
+	if err := bin.NewBinEncoder(buf).Encode(state); err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(fmt.Sprintf(
+		`{"context":{"slot":1},"value":{"lamports":1447680,"owner":%q,"data":[%q,"base64"],"executable":false,"rentEpoch":0}}`,
+		solana.SystemProgramID, base64.StdEncoding.EncodeToString(buf.Bytes()),
+	)), out)
+}
+
+func (node *nonceNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestPool(t *testing.T) {
+	ctx := context.Background()
+	authority := solana.NewWallet().PublicKey()
+	accounts := []solana.PublicKey{{1}, {2}}
+	node := &nonceNode{nonces: map[solana.PublicKey]*system.NonceAccount{}}
+	for i, account := range accounts {
+		node.nonces[account] = &system.NonceAccount{
+			Version:          1,
+			State:            system.NonceStateInitialized,
+			AuthorizedPubkey: authority,
+			Nonce:            solana.PublicKey{byte(10 * (i + 1))},
+			FeeCalculator:    system.FeeCalculator{LamportsPerSignature: 5000},
+		}
+	}
+	pool := New(rpc.NewWithCustomRPCClient(node), authority, accounts, &Opts{PollInterval: time.Millisecond})
+	require.Equal(t, 2, pool.Size())
+
+	first, err := pool.Acquire(ctx)
+	require.NoError(t, err)
+	require.Equal(t, accounts[0], first.Account)
+	require.Equal(t, solana.Hash{10}, first.Nonce)
+	require.Equal(t, uint64(5000), first.LamportsPerSignature)
+
+	second, err := pool.Acquire(ctx)
+	require.NoError(t, err)
+	require.Equal(t, accounts[1], second.Account)
+	require.Equal(t, 0, pool.Available())
+
+	// The pool is exhausted.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = pool.Acquire(timeoutCtx)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	tx, err := first.NewTransaction(
+		[]solana.Instruction{system.NewTransferInstruction(1, authority, solana.PublicKey{9}).Build()},
+		solana.TransactionPayer(authority),
+	)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{10}, tx.Message.RecentBlockhash)
+	program, err := tx.Message.Program(tx.Message.Instructions[0].ProgramIDIndex)
+	require.NoError(t, err)
+	require.Equal(t, solana.SystemProgramID, program)
+	require.Equal(t, []byte{4, 0, 0, 0}, []byte(tx.Message.Instructions[0].Data))
+
+	// A waiting Acquire gets the nonce account once it is recycled.
+	acquired := make(chan *Lease)
+	go func() {
+		lease, err := pool.Acquire(ctx)
+		require.NoError(t, err)
+		acquired <- lease
+	}()
+	recycled := make(chan error)
+	go func() { recycled <- pool.RecycleWhenAdvanced(ctx, first) }()
+	select {
+	case <-acquired:
+		t.Fatal("acquired a nonce account before it advanced")
+	case <-time.After(20 * time.Millisecond):
+	}
+	node.advance(accounts[0])
+	require.NoError(t, <-recycled)
+	third := <-acquired
+	require.Equal(t, accounts[0], third.Account)
+	require.Equal(t, solana.Hash{11}, third.Nonce)
+
+	pool.Release(second)
+	require.Equal(t, 1, pool.Available())
+
+	pool.Close()
+	_, err = pool.Acquire(ctx)
+	require.Equal(t, ErrClosed, err)
+}
+
+func TestPoolInvalidAuthority(t *testing.T) {
+	account := solana.PublicKey{1}
+	node := &nonceNode{nonces: map[solana.PublicKey]*system.NonceAccount{
+		account: {Version: 1, State: system.NonceStateInitialized, AuthorizedPubkey: solana.PublicKey{7}},
+	}}
+	pool := New(rpc.NewWithCustomRPCClient(node), solana.PublicKey{8}, []solana.PublicKey{account}, nil)
+	_, err := pool.Acquire(context.Background())
+	require.EqualError(t, err, fmt.Sprintf("invalid nonce account %s: the authority is %s", account, solana.PublicKey{7}))
+	require.Equal(t, 1, pool.Available())
+}
+
+func TestCreateNonceAccountInstructions(t *testing.T) {
+	funder := solana.PublicKey{1}
+	nonceAccount := solana.PublicKey{2}
+	authority := solana.PublicKey{3}
+	instructions := CreateNonceAccountInstructions(funder, nonceAccount, authority, 1447680)
+	require.Len(t, instructions, 2)
+
+	create := instructions[0].(*system.Instruction).Impl.(system.CreateAccount)
+	require.Equal(t, uint64(system.NonceAccountSize), *create.Space)
+	require.Equal(t, solana.SystemProgramID, *create.Owner)
+	initialize := instructions[1].(*system.Instruction).Impl.(system.InitializeNonceAccount)
+	require.Equal(t, authority, *initialize.Authorized)
+	require.Equal(t, nonceAccount, initialize.GetNonceAccount().PublicKey)
+}
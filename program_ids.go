@@ -39,6 +39,10 @@ var (
 	FeatureProgramID = MustPublicKeyFromBase58("Feature111111111111111111111111111111111111")
 
 	ComputeBudget = MustPublicKeyFromBase58("ComputeBudget111111111111111111111111111111")
+
+	// Create and manage address lookup tables, used by versioned transactions
+	// to load accounts by index.
+	AddressLookupTableProgramID = MustPublicKeyFromBase58("AddressLookupTab1e1111111111111111111111111")
 )
 
 // SPL:
@@ -47,6 +51,10 @@ var (
 	// This program defines a common implementation for Fungible and Non Fungible tokens.
 	TokenProgramID = MustPublicKeyFromBase58("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
 
+	// The Token-2022 program (a.k.a. Token Extensions) is a superset of the Token program,
+	// adding opt-in mint and account extensions (transfer fees, interest, metadata, etc).
+	Token2022ProgramID = MustPublicKeyFromBase58("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")
+
 	// A Uniswap-like exchange for the Token program on the Solana blockchain,
 	// implementing multiple automated market maker (AMM) curves.
 	TokenSwapProgramID = MustPublicKeyFromBase58("SwaPpA9LAaLfeLi3a68M4DjnLqgtticKg6CnyNwgAC8")
@@ -0,0 +1,236 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addresslookuptable
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// FindLookupTableAddress returns the address of the lookup table created by
+// the authority at the recent slot, and its bump seed.
+func FindLookupTableAddress(authority ag_solanago.PublicKey, recentSlot uint64) (ag_solanago.PublicKey, uint8, error) {
+	slot := make([]byte, 8)
+	binary.LittleEndian.PutUint64(slot, recentSlot)
+	return ag_solanago.FindProgramAddress([][]byte{authority[:], slot}, ProgramID)
+}
+
+// CreateLookupTable creates an address lookup table at the address derived
+// from the authority and a recent slot (see FindLookupTableAddress).
+type CreateLookupTable struct {
+	// A recent slot, in the slot hashes sysvar
+	RecentSlot *uint64
+
+	// Bump seed of the address of the lookup table
+	BumpSeed *uint8
+
+	// [0] = [WRITE] LookupTableAccount
+	// ··········· Uninitialized lookup table account
+	//
+	// [1] = [] AuthorityAccount
+	// ··········· Authority of the lookup table
+	//
+	// [2] = [WRITE, SIGNER] PayerAccount
+	// ··········· Account paying the rent of the lookup table
+	//
+	// [3] = [] $(SystemProgramID)
+	// ··········· System program
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewCreateLookupTableInstructionBuilder creates a new `CreateLookupTable` instruction builder.
+func NewCreateLookupTableInstructionBuilder() *CreateLookupTable {
+	nd := &CreateLookupTable{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 4),
+	}
+	nd.AccountMetaSlice[3] = ag_solanago.Meta(ag_solanago.SystemProgramID)
+	return nd
+}
+
+// A recent slot, in the slot hashes sysvar
+func (inst *CreateLookupTable) SetRecentSlot(recentSlot uint64) *CreateLookupTable {
+	inst.RecentSlot = &recentSlot
+	return inst
+}
+
+// Bump seed of the address of the lookup table
+func (inst *CreateLookupTable) SetBumpSeed(bumpSeed uint8) *CreateLookupTable {
+	inst.BumpSeed = &bumpSeed
+	return inst
+}
+
+// Uninitialized lookup table account
+func (inst *CreateLookupTable) SetLookupTableAccount(lookupTableAccount ag_solanago.PublicKey) *CreateLookupTable {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(lookupTableAccount).WRITE()
+	return inst
+}
+
+func (inst *CreateLookupTable) GetLookupTableAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Authority of the lookup table
+func (inst *CreateLookupTable) SetAuthorityAccount(authorityAccount ag_solanago.PublicKey) *CreateLookupTable {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(authorityAccount)
+	return inst
+}
+
+func (inst *CreateLookupTable) GetAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+// Account paying the rent of the lookup table
+func (inst *CreateLookupTable) SetPayerAccount(payerAccount ag_solanago.PublicKey) *CreateLookupTable {
+	inst.AccountMetaSlice[2] = ag_solanago.Meta(payerAccount).WRITE().SIGNER()
+	return inst
+}
+
+func (inst *CreateLookupTable) GetPayerAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[2]
+}
+
+// System program
+func (inst *CreateLookupTable) SetSystemProgramAccount(systemProgram ag_solanago.PublicKey) *CreateLookupTable {
+	inst.AccountMetaSlice[3] = ag_solanago.Meta(systemProgram)
+	return inst
+}
+
+func (inst *CreateLookupTable) GetSystemProgramAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[3]
+}
+
+func (inst CreateLookupTable) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_CreateLookupTable, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst CreateLookupTable) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *CreateLookupTable) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if inst.RecentSlot == nil {
+			return errors.New("RecentSlot parameter is not set")
+		}
+		if inst.BumpSeed == nil {
+			return errors.New("BumpSeed parameter is not set")
+		}
+	}
+
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *CreateLookupTable) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("CreateLookupTable")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("RecentSlot", *inst.RecentSlot))
+						paramsBranch.Child(ag_format.Param("  BumpSeed", *inst.BumpSeed))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("  LookupTable", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("    Authority", inst.AccountMetaSlice[1]))
+						accountsBranch.Child(ag_format.Meta("        Payer", inst.AccountMetaSlice[2]))
+						accountsBranch.Child(ag_format.Meta("SystemProgram", inst.AccountMetaSlice[3]))
+					})
+				})
+		})
+}
+
+func (inst CreateLookupTable) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `RecentSlot` param:
+	{
+		err := encoder.Encode(*inst.RecentSlot)
+		if err != nil {
+			return err
+		}
+	}
+	// Serialize `BumpSeed` param:
+	{
+		err := encoder.Encode(*inst.BumpSeed)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inst *CreateLookupTable) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `RecentSlot` param:
+	{
+		err := decoder.Decode(&inst.RecentSlot)
+		if err != nil {
+			return err
+		}
+	}
+	// Deserialize `BumpSeed` param:
+	{
+		err := decoder.Decode(&inst.BumpSeed)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewCreateLookupTableInstruction declares a new CreateLookupTable instruction with the provided
+// parameters and accounts, and returns the address of the lookup table.
+func NewCreateLookupTableInstruction(
+	// Parameters:
+	recentSlot uint64,
+	// Accounts:
+	authorityAccount ag_solanago.PublicKey,
+	payerAccount ag_solanago.PublicKey) (*CreateLookupTable, ag_solanago.PublicKey, error) {
+	lookupTable, bumpSeed, err := FindLookupTableAddress(authorityAccount, recentSlot)
+	if err != nil {
+		return nil, ag_solanago.PublicKey{}, err
+	}
+	return NewCreateLookupTableInstructionBuilder().
+		SetRecentSlot(recentSlot).
+		SetBumpSeed(bumpSeed).
+		SetLookupTableAccount(lookupTable).
+		SetAuthorityAccount(authorityAccount).
+		SetPayerAccount(payerAccount), lookupTable, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addresslookuptable
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// DeactivateLookupTable deactivates the lookup table; it can be closed once
+// the deactivation slot is no longer in the slot hashes sysvar.
+type DeactivateLookupTable struct {
+
+	// [0] = [WRITE] LookupTableAccount
+	// ··········· Lookup table account
+	//
+	// [1] = [SIGNER] AuthorityAccount
+	// ··········· Authority of the lookup table
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewDeactivateLookupTableInstructionBuilder creates a new `DeactivateLookupTable` instruction builder.
+func NewDeactivateLookupTableInstructionBuilder() *DeactivateLookupTable {
+	nd := &DeactivateLookupTable{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 2),
+	}
+	return nd
+}
+
+// Lookup table account
+func (inst *DeactivateLookupTable) SetLookupTableAccount(lookupTableAccount ag_solanago.PublicKey) *DeactivateLookupTable {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(lookupTableAccount).WRITE()
+	return inst
+}
+
+func (inst *DeactivateLookupTable) GetLookupTableAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Authority of the lookup table
+func (inst *DeactivateLookupTable) SetAuthorityAccount(authorityAccount ag_solanago.PublicKey) *DeactivateLookupTable {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(authorityAccount).SIGNER()
+	return inst
+}
+
+func (inst *DeactivateLookupTable) GetAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+func (inst DeactivateLookupTable) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_DeactivateLookupTable, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst DeactivateLookupTable) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *DeactivateLookupTable) Validate() error {
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *DeactivateLookupTable) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("DeactivateLookupTable")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("LookupTable", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("  Authority", inst.AccountMetaSlice[1]))
+					})
+				})
+		})
+}
+
+func (inst DeactivateLookupTable) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	return nil
+}
+
+func (inst *DeactivateLookupTable) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	return nil
+}
+
+// NewDeactivateLookupTableInstruction declares a new DeactivateLookupTable instruction with the provided parameters and accounts.
+func NewDeactivateLookupTableInstruction(
+	// Accounts:
+	lookupTableAccount ag_solanago.PublicKey,
+	authorityAccount ag_solanago.PublicKey) *DeactivateLookupTable {
+	return NewDeactivateLookupTableInstructionBuilder().
+		SetLookupTableAccount(lookupTableAccount).
+		SetAuthorityAccount(authorityAccount)
+}
@@ -0,0 +1,212 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addresslookuptable
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// MaxAddresses is the maximum number of addresses of a lookup table.
+const MaxAddresses = 256
+
+// ExtendLookupTable appends addresses to the lookup table; the addresses can
+// be used by transactions from the next slot.
+type ExtendLookupTable struct {
+	// Addresses to append to the lookup table
+	NewAddresses ag_solanago.PublicKeySlice
+
+	// [0] = [WRITE] LookupTableAccount
+	// ··········· Lookup table account
+	//
+	// [1] = [SIGNER] AuthorityAccount
+	// ··········· Authority of the lookup table
+	//
+	// [2] = [WRITE, SIGNER] PayerAccount (optional)
+	// ··········· Account paying the additional rent
+	//
+	// [3] = [] $(SystemProgramID) (optional)
+	// ··········· System program (required with the payer)
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewExtendLookupTableInstructionBuilder creates a new `ExtendLookupTable` instruction builder.
+func NewExtendLookupTableInstructionBuilder() *ExtendLookupTable {
+	nd := &ExtendLookupTable{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 2),
+	}
+	return nd
+}
+
+// Addresses to append to the lookup table
+func (inst *ExtendLookupTable) SetNewAddresses(newAddresses ...ag_solanago.PublicKey) *ExtendLookupTable {
+	inst.NewAddresses = newAddresses
+	return inst
+}
+
+// Lookup table account
+func (inst *ExtendLookupTable) SetLookupTableAccount(lookupTableAccount ag_solanago.PublicKey) *ExtendLookupTable {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(lookupTableAccount).WRITE()
+	return inst
+}
+
+func (inst *ExtendLookupTable) GetLookupTableAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Authority of the lookup table
+func (inst *ExtendLookupTable) SetAuthorityAccount(authorityAccount ag_solanago.PublicKey) *ExtendLookupTable {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(authorityAccount).SIGNER()
+	return inst
+}
+
+func (inst *ExtendLookupTable) GetAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+// Account paying the additional rent of the lookup table (with the system program),
+// required unless the lookup table already holds enough lamports.
+func (inst *ExtendLookupTable) SetPayerAccount(payerAccount ag_solanago.PublicKey) *ExtendLookupTable {
+	inst.AccountMetaSlice = append(inst.AccountMetaSlice[:2],
+		ag_solanago.Meta(payerAccount).WRITE().SIGNER(),
+		ag_solanago.Meta(ag_solanago.SystemProgramID),
+	)
+	return inst
+}
+
+// GetPayerAccount returns the account paying the additional rent, or nil if not set.
+func (inst *ExtendLookupTable) GetPayerAccount() *ag_solanago.AccountMeta {
+	if len(inst.AccountMetaSlice) < 3 {
+		return nil
+	}
+	return inst.AccountMetaSlice[2]
+}
+
+func (inst ExtendLookupTable) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_ExtendLookupTable, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst ExtendLookupTable) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *ExtendLookupTable) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if len(inst.NewAddresses) == 0 {
+			return errors.New("NewAddresses parameter is not set")
+		}
+		if len(inst.NewAddresses) > MaxAddresses {
+			return fmt.Errorf("too many addresses: %d (max %d)", len(inst.NewAddresses), MaxAddresses)
+		}
+	}
+
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *ExtendLookupTable) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("ExtendLookupTable")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("NewAddresses", inst.NewAddresses))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("  LookupTable", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("    Authority", inst.AccountMetaSlice[1]))
+						accountsBranch.Child(ag_format.MetaIfSetByIndex("        Payer", inst.AccountMetaSlice, 2))
+						accountsBranch.Child(ag_format.MetaIfSetByIndex("SystemProgram", inst.AccountMetaSlice, 3))
+					})
+				})
+		})
+}
+
+// The addresses are encoded as a bincode vector (u64 length).
+func (inst ExtendLookupTable) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `NewAddresses` param:
+	{
+		err := encoder.WriteUint64(uint64(len(inst.NewAddresses)), binary.LittleEndian)
+		if err != nil {
+			return err
+		}
+		for _, address := range inst.NewAddresses {
+			if err := encoder.WriteBytes(address[:], false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (inst *ExtendLookupTable) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `NewAddresses` param:
+	{
+		count, err := decoder.ReadUint64(binary.LittleEndian)
+		if err != nil {
+			return fmt.Errorf("unable to read the number of addresses: %w", err)
+		}
+		if count > uint64(decoder.Remaining()/ag_solanago.PublicKeyLength) {
+			return fmt.Errorf("number of addresses %d is too large for remaining bytes %d", count, decoder.Remaining())
+		}
+		inst.NewAddresses = make(ag_solanago.PublicKeySlice, count)
+		for i := range inst.NewAddresses {
+			if _, err := decoder.Read(inst.NewAddresses[i][:]); err != nil {
+				return fmt.Errorf("unable to read address %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// NewExtendLookupTableInstruction declares a new ExtendLookupTable instruction with the provided parameters and accounts.
+func NewExtendLookupTableInstruction(
+	// Parameters:
+	newAddresses ag_solanago.PublicKeySlice,
+	// Accounts:
+	lookupTableAccount ag_solanago.PublicKey,
+	authorityAccount ag_solanago.PublicKey) *ExtendLookupTable {
+	return NewExtendLookupTableInstructionBuilder().
+		SetNewAddresses(newAddresses...).
+		SetLookupTableAccount(lookupTableAccount).
+		SetAuthorityAccount(authorityAccount)
+}
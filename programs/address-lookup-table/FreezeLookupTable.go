@@ -0,0 +1,130 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addresslookuptable
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// FreezeLookupTable freezes the lookup table, making it immutable;
+// a frozen lookup table cannot be deactivated.
+type FreezeLookupTable struct {
+
+	// [0] = [WRITE] LookupTableAccount
+	// ··········· Lookup table account
+	//
+	// [1] = [SIGNER] AuthorityAccount
+	// ··········· Authority of the lookup table
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewFreezeLookupTableInstructionBuilder creates a new `FreezeLookupTable` instruction builder.
+func NewFreezeLookupTableInstructionBuilder() *FreezeLookupTable {
+	nd := &FreezeLookupTable{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 2),
+	}
+	return nd
+}
+
+// Lookup table account
+func (inst *FreezeLookupTable) SetLookupTableAccount(lookupTableAccount ag_solanago.PublicKey) *FreezeLookupTable {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(lookupTableAccount).WRITE()
+	return inst
+}
+
+func (inst *FreezeLookupTable) GetLookupTableAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Authority of the lookup table
+func (inst *FreezeLookupTable) SetAuthorityAccount(authorityAccount ag_solanago.PublicKey) *FreezeLookupTable {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(authorityAccount).SIGNER()
+	return inst
+}
+
+func (inst *FreezeLookupTable) GetAuthorityAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+func (inst FreezeLookupTable) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_FreezeLookupTable, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst FreezeLookupTable) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *FreezeLookupTable) Validate() error {
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *FreezeLookupTable) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("FreezeLookupTable")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("LookupTable", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("  Authority", inst.AccountMetaSlice[1]))
+					})
+				})
+		})
+}
+
+func (inst FreezeLookupTable) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	return nil
+}
+
+func (inst *FreezeLookupTable) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	return nil
+}
+
+// NewFreezeLookupTableInstruction declares a new FreezeLookupTable instruction with the provided parameters and accounts.
+func NewFreezeLookupTableInstruction(
+	// Accounts:
+	lookupTableAccount ag_solanago.PublicKey,
+	authorityAccount ag_solanago.PublicKey) *FreezeLookupTable {
+	return NewFreezeLookupTableInstructionBuilder().
+		SetLookupTableAccount(lookupTableAccount).
+		SetAuthorityAccount(authorityAccount)
+}
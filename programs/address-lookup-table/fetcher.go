@@ -0,0 +1,52 @@
+package addresslookuptable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// RPCTablesFetcher fetches address lookup tables with an RPC client.
+// It implements solana.AddressTablesFetcher.
+type RPCTablesFetcher struct {
+	client     *rpc.Client
+	commitment rpc.CommitmentType
+}
+
+var _ solana.AddressTablesFetcher = (*RPCTablesFetcher)(nil)
+
+// NewRPCTablesFetcher creates a fetcher reading the tables at the provided commitment.
+func NewRPCTablesFetcher(client *rpc.Client, commitment rpc.CommitmentType) *RPCTablesFetcher {
+	return &RPCTablesFetcher{
+		client:     client,
+		commitment: commitment,
+	}
+}
+
+// FetchAddressTables fetches the tables with a single getMultipleAccounts call.
+func (f *RPCTablesFetcher) FetchAddressTables(ctx context.Context, tableIDs solana.PublicKeySlice) (map[solana.PublicKey]solana.PublicKeySlice, error) {
+	out, err := f.client.GetMultipleAccountsWithOpts(ctx, tableIDs, &rpc.GetMultipleAccountsOpts{
+		Commitment: f.commitment,
+		Encoding:   solana.EncodingBase64,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Value) != len(tableIDs) {
+		return nil, fmt.Errorf("expected %d accounts, got %d", len(tableIDs), len(out.Value))
+	}
+	tables := make(map[solana.PublicKey]solana.PublicKeySlice, len(tableIDs))
+	for i, account := range out.Value {
+		if account == nil {
+			return nil, fmt.Errorf("address table %s not found", tableIDs[i])
+		}
+		state, err := DecodeAddressLookupTableState(account.Data.GetBinary())
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode address table %s: %w", tableIDs[i], err)
+		}
+		tables[tableIDs[i]] = state.Addresses
+	}
+	return tables, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addresslookuptable
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstructions(t *testing.T) {
+	lookupTable := solana.PublicKey{1}
+	authority := solana.PublicKey{2}
+	payer := solana.PublicKey{3}
+	address := solana.PublicKey{4}
+
+	create, createdTable, err := NewCreateLookupTableInstruction(1234, authority, payer)
+	require.NoError(t, err)
+	derived, bump, err := FindLookupTableAddress(authority, 1234)
+	require.NoError(t, err)
+	require.Equal(t, derived, createdTable)
+	require.Equal(t, createdTable, create.AccountMetaSlice[0].PublicKey)
+	require.False(t, create.AccountMetaSlice[1].IsSigner)
+
+	tests := []struct {
+		name     string
+		inst     *Instruction
+		data     []byte
+		accounts int
+	}{
+		{
+			name:     "CreateLookupTable",
+			inst:     create.Build(),
+			data:     []byte{0, 0, 0, 0, 0xd2, 0x04, 0, 0, 0, 0, 0, 0, bump},
+			accounts: 4,
+		},
+		{
+			name:     "FreezeLookupTable",
+			inst:     NewFreezeLookupTableInstruction(lookupTable, authority).Build(),
+			data:     []byte{1, 0, 0, 0},
+			accounts: 2,
+		},
+		{
+			name:     "ExtendLookupTable",
+			inst:     NewExtendLookupTableInstruction(solana.PublicKeySlice{address}, lookupTable, authority).SetPayerAccount(payer).Build(),
+			data:     append([]byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}, address[:]...),
+			accounts: 4,
+		},
+		{
+			name:     "DeactivateLookupTable",
+			inst:     NewDeactivateLookupTableInstruction(lookupTable, authority).Build(),
+			data:     []byte{3, 0, 0, 0},
+			accounts: 2,
+		},
+		{
+			name:     "CloseLookupTable",
+			inst:     NewCloseLookupTableInstruction(lookupTable, authority, payer).Build(),
+			data:     []byte{4, 0, 0, 0},
+			accounts: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, ProgramID, test.inst.ProgramID())
+			data, err := test.inst.Data()
+			require.NoError(t, err)
+			require.Equal(t, test.data, data)
+			require.Len(t, test.inst.Accounts(), test.accounts)
+
+			decoded, err := DecodeInstruction(test.inst.Accounts(), data)
+			require.NoError(t, err)
+			require.Equal(t, test.inst.TypeID, decoded.TypeID)
+			redata, err := decoded.Data()
+			require.NoError(t, err)
+			require.Equal(t, data, redata)
+		})
+	}
+
+	decoded, err := DecodeInstruction(nil, append([]byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}, address[:]...))
+	require.NoError(t, err)
+	require.Equal(t, solana.PublicKeySlice{address}, decoded.Impl.(*ExtendLookupTable).NewAddresses)
+	_, err = DecodeInstruction(nil, []byte{2, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0})
+	require.Error(t, err)
+}
+
+func TestInstructions_Validate(t *testing.T) {
+	lookupTable := solana.PublicKey{1}
+	authority := solana.PublicKey{2}
+
+	_, err := NewExtendLookupTableInstructionBuilder().
+		SetLookupTableAccount(lookupTable).
+		SetAuthorityAccount(authority).
+		ValidateAndBuild()
+	require.EqualError(t, err, "NewAddresses parameter is not set")
+
+	_, err = NewCloseLookupTableInstructionBuilder().
+		SetLookupTableAccount(lookupTable).
+		SetAuthorityAccount(authority).
+		ValidateAndBuild()
+	require.EqualError(t, err, "ins.AccountMetaSlice[2] is not set")
+
+	_, err = NewCreateLookupTableInstructionBuilder().
+		SetRecentSlot(1).
+		SetLookupTableAccount(lookupTable).
+		SetAuthorityAccount(authority).
+		SetPayerAccount(authority).
+		ValidateAndBuild()
+	require.EqualError(t, err, "BumpSeed parameter is not set")
+
+	inst, err := NewFreezeLookupTableInstructionBuilder().
+		SetLookupTableAccount(lookupTable).
+		SetAuthorityAccount(authority).
+		ValidateAndBuild()
+	require.NoError(t, err)
+	require.Len(t, inst.Accounts(), 2)
+}
@@ -0,0 +1,202 @@
+package bpfloader
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// UpgradeableLoaderStateType is the type of an account
+// owned by the upgradeable BPF loader.
+type UpgradeableLoaderStateType uint32
+
+const (
+	UpgradeableLoaderStateUninitialized UpgradeableLoaderStateType = iota
+	UpgradeableLoaderStateBuffer
+	UpgradeableLoaderStateProgram
+	UpgradeableLoaderStateProgramData
+)
+
+func (t UpgradeableLoaderStateType) String() string {
+	switch t {
+	case UpgradeableLoaderStateUninitialized:
+		return "Uninitialized"
+	case UpgradeableLoaderStateBuffer:
+		return "Buffer"
+	case UpgradeableLoaderStateProgram:
+		return "Program"
+	case UpgradeableLoaderStateProgramData:
+		return "ProgramData"
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint32(t))
+	}
+}
+
+// Sizes of the metadata of the upgradeable loader accounts;
+// the executable data of buffer and programdata accounts follows it.
+const (
+	UpgradeableLoaderBufferMetadataSize      = 4 + 1 + 32
+	UpgradeableLoaderProgramSize             = 4 + 32
+	UpgradeableLoaderProgramDataMetadataSize = 4 + 8 + 1 + 32
+)
+
+// UpgradeableLoaderState is the decoded state of an account
+// owned by the upgradeable BPF loader.
+type UpgradeableLoaderState struct {
+	Type UpgradeableLoaderStateType
+	// Authority of a buffer, or upgrade authority of a programdata account;
+	// nil if the program is immutable.
+	Authority *solana.PublicKey
+	// Address of the programdata account of a program account.
+	ProgramDataAddress solana.PublicKey
+	// Slot of the last deployment of a programdata account.
+	Slot uint64
+}
+
+// DecodeUpgradeableLoaderState decodes the state at the start
+// of the data of an upgradeable loader account.
+func DecodeUpgradeableLoaderState(data []byte) (*UpgradeableLoaderState, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("data too short: %d bytes", len(data))
+	}
+	state := &UpgradeableLoaderState{
+		Type: UpgradeableLoaderStateType(binary.LittleEndian.Uint32(data)),
+	}
+	readAuthority := func(offset int) error {
+		if len(data) < offset+1 {
+			return fmt.Errorf("data too short for %s: %d bytes", state.Type, len(data))
+		}
+		switch data[offset] {
+		case 0:
+			return nil
+		case 1:
+			if len(data) < offset+1+32 {
+				return fmt.Errorf("data too short for %s: %d bytes", state.Type, len(data))
+			}
+			authority := solana.PublicKeyFromBytes(data[offset+1 : offset+1+32])
+			state.Authority = &authority
+			return nil
+		default:
+			return fmt.Errorf("invalid option tag %d", data[offset])
+		}
+	}
+	switch state.Type {
+	case UpgradeableLoaderStateUninitialized:
+	case UpgradeableLoaderStateBuffer:
+		if err := readAuthority(4); err != nil {
+			return nil, err
+		}
+	case UpgradeableLoaderStateProgram:
+		if len(data) < UpgradeableLoaderProgramSize {
+			return nil, fmt.Errorf("data too short for %s: %d bytes", state.Type, len(data))
+		}
+		state.ProgramDataAddress = solana.PublicKeyFromBytes(data[4:36])
+	case UpgradeableLoaderStateProgramData:
+		if len(data) < 12 {
+			return nil, fmt.Errorf("data too short for %s: %d bytes", state.Type, len(data))
+		}
+		state.Slot = binary.LittleEndian.Uint64(data[4:12])
+		if err := readAuthority(12); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown upgradeable loader state %d", uint32(state.Type))
+	}
+	return state, nil
+}
+
+// ProgramDataAddress returns the address of the programdata
+// account of a program deployed with the upgradeable loader.
+func ProgramDataAddress(programID solana.PublicKey) (solana.PublicKey, error) {
+	address, _, err := solana.FindProgramAddress(
+		[][]byte{programID[:]},
+		solana.BPFLoaderUpgradeableProgramID,
+	)
+	return address, err
+}
+
+// ProgramUpgradeInfo describes whether and by whom a program can be upgraded.
+type ProgramUpgradeInfo struct {
+	ProgramID solana.PublicKey
+	// The loader owning the program account.
+	Loader solana.PublicKey
+	// Whether the program can be upgraded: it is deployed with the
+	// upgradeable loader and has an upgrade authority.
+	Upgradeable bool
+	// Nil if the program is not upgradeable.
+	UpgradeAuthority *solana.PublicKey
+	// Set only for programs of the upgradeable loader.
+	ProgramDataAddress *solana.PublicKey
+	// Slot of the last deployment; zero if not deployed
+	// with the upgradeable loader.
+	LastDeploySlot uint64
+	// Length of the executable data (the ELF) of the program.
+	ExecutableDataLen int
+}
+
+// GetProgramUpgradeInfo fetches the program account and, for programs of the
+// upgradeable loader, its programdata account, to report the upgrade authority,
+// the last deployment slot and the size of the program.
+func GetProgramUpgradeInfo(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	programID solana.PublicKey,
+) (*ProgramUpgradeInfo, error) {
+	opts := &rpc.GetAccountInfoOpts{
+		Encoding: solana.EncodingBase64Zstd,
+	}
+	program, err := rpcClient.GetAccountInfoWithOpts(ctx, programID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get program account: %w", err)
+	}
+	if !program.Value.Executable {
+		return nil, fmt.Errorf("account %s is not an executable program", programID)
+	}
+	info := &ProgramUpgradeInfo{
+		ProgramID: programID,
+		Loader:    program.Value.Owner,
+	}
+	if !program.Value.Owner.Equals(solana.BPFLoaderUpgradeableProgramID) {
+		// The legacy loaders store the executable data in the program account.
+		info.ExecutableDataLen = len(program.GetBinary())
+		return info, nil
+	}
+
+	state, err := DecodeUpgradeableLoaderState(program.GetBinary())
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode program account: %w", err)
+	}
+	if state.Type != UpgradeableLoaderStateProgram {
+		return nil, fmt.Errorf("unexpected program account state %s", state.Type)
+	}
+	programDataAddress := state.ProgramDataAddress
+	info.ProgramDataAddress = &programDataAddress
+
+	programData, err := rpcClient.GetAccountInfoWithOpts(ctx, programDataAddress, opts)
+	if err != nil {
+		if errors.Is(err, rpc.ErrNotFound) {
+			// The program was closed.
+			return info, nil
+		}
+		return nil, fmt.Errorf("unable to get programdata account: %w", err)
+	}
+	data := programData.GetBinary()
+	state, err = DecodeUpgradeableLoaderState(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode programdata account: %w", err)
+	}
+	if state.Type != UpgradeableLoaderStateProgramData {
+		return nil, fmt.Errorf("unexpected programdata account state %s", state.Type)
+	}
+	info.LastDeploySlot = state.Slot
+	info.UpgradeAuthority = state.Authority
+	info.Upgradeable = state.Authority != nil
+	if len(data) > UpgradeableLoaderProgramDataMetadataSize {
+		info.ExecutableDataLen = len(data) - UpgradeableLoaderProgramDataMetadataSize
+	}
+	return info, nil
+}
@@ -0,0 +1,128 @@
+package bpfloader
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeProgramData(slot uint64, authority *solana.PublicKey, elfLen int) []byte {
+	data := make([]byte, UpgradeableLoaderProgramDataMetadataSize+elfLen)
+	binary.LittleEndian.PutUint32(data, uint32(UpgradeableLoaderStateProgramData))
+	binary.LittleEndian.PutUint64(data[4:], slot)
+	if authority != nil {
+		data[12] = 1
+		copy(data[13:], authority[:])
+	}
+	return data
+}
+
+func encodeProgram(programData solana.PublicKey) []byte {
+	data := make([]byte, UpgradeableLoaderProgramSize)
+	binary.LittleEndian.PutUint32(data, uint32(UpgradeableLoaderStateProgram))
+	copy(data[4:], programData[:])
+	return data
+}
+
+func TestDecodeUpgradeableLoaderState(t *testing.T) {
+	authority := solana.PublicKey{9}
+	state, err := DecodeUpgradeableLoaderState(encodeProgramData(1234, &authority, 10))
+	require.NoError(t, err)
+	require.Equal(t, &UpgradeableLoaderState{
+		Type:      UpgradeableLoaderStateProgramData,
+		Slot:      1234,
+		Authority: &authority,
+	}, state)
+
+	// Immutable program: the authority is None.
+	state, err = DecodeUpgradeableLoaderState(encodeProgramData(1234, nil, 0)[:13])
+	require.NoError(t, err)
+	require.Nil(t, state.Authority)
+
+	state, err = DecodeUpgradeableLoaderState(encodeProgram(solana.PublicKey{3}))
+	require.NoError(t, err)
+	require.Equal(t, UpgradeableLoaderStateProgram, state.Type)
+	require.Equal(t, solana.PublicKey{3}, state.ProgramDataAddress)
+
+	_, err = DecodeUpgradeableLoaderState(encodeProgram(solana.PublicKey{3})[:20])
+	require.EqualError(t, err, "data too short for Program: 20 bytes")
+	_, err = DecodeUpgradeableLoaderState([]byte{7, 0, 0, 0})
+	require.EqualError(t, err, "unknown upgradeable loader state 7")
+}
+
+type programNode struct {
+	accounts map[solana.PublicKey]string
+}
+
+func (node *programNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if method != "getAccountInfo" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	value, ok := node.accounts[params[0].(solana.PublicKey)]
+	if !ok {
+		value = "null"
+	}
+	return stdjson.Unmarshal([]byte(`{"context":{"slot":1},"value":`+value+`}`), out)
+}
+
+func (node *programNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return fmt.Errorf("not implemented")
+}
+
+func accountJSON(owner solana.PublicKey, executable bool, data []byte) string {
+	return fmt.Sprintf(`{"lamports":1,"owner":%q,"data":[%q,"base64"],"executable":%v,"rentEpoch":0}`,
+		owner, base64.StdEncoding.EncodeToString(data), executable)
+}
+
+func TestGetProgramUpgradeInfo(t *testing.T) {
+	programID := solana.PublicKey{1}
+	programData, err := ProgramDataAddress(programID)
+	require.NoError(t, err)
+	authority := solana.PublicKey{9}
+	legacyProgram := solana.PublicKey{2}
+
+	node := &programNode{accounts: map[solana.PublicKey]string{
+		programID:     accountJSON(solana.BPFLoaderUpgradeableProgramID, true, encodeProgram(programData)),
+		programData:   accountJSON(solana.BPFLoaderUpgradeableProgramID, false, encodeProgramData(500, &authority, 1000)),
+		legacyProgram: accountJSON(solana.BPFLoaderProgramID, true, make([]byte, 300)),
+	}}
+	client := rpc.NewWithCustomRPCClient(node)
+
+	info, err := GetProgramUpgradeInfo(context.Background(), client, programID)
+	require.NoError(t, err)
+	require.Equal(t, &ProgramUpgradeInfo{
+		ProgramID:          programID,
+		Loader:             solana.BPFLoaderUpgradeableProgramID,
+		Upgradeable:        true,
+		UpgradeAuthority:   &authority,
+		ProgramDataAddress: &programData,
+		LastDeploySlot:     500,
+		ExecutableDataLen:  1000,
+	}, info)
+
+	node.accounts[programData] = accountJSON(solana.BPFLoaderUpgradeableProgramID, false, encodeProgramData(600, nil, 1000))
+	info, err = GetProgramUpgradeInfo(context.Background(), client, programID)
+	require.NoError(t, err)
+	require.False(t, info.Upgradeable)
+	require.Nil(t, info.UpgradeAuthority)
+	require.Equal(t, uint64(600), info.LastDeploySlot)
+
+	info, err = GetProgramUpgradeInfo(context.Background(), client, legacyProgram)
+	require.NoError(t, err)
+	require.Equal(t, &ProgramUpgradeInfo{
+		ProgramID:         legacyProgram,
+		Loader:            solana.BPFLoaderProgramID,
+		ExecutableDataLen: 300,
+	}, info)
+
+	_, err = GetProgramUpgradeInfo(context.Background(), client, solana.PublicKey{5})
+	require.Error(t, err)
+}
@@ -0,0 +1,30 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package computebudget
+
+import (
+	"github.com/gagliardetto/solana-go"
+)
+
+// RequestUnits requests compute units and pays an additional fee.
+//
+// Deprecated: rejected by the runtime; use SetComputeUnitLimit
+// and SetComputeUnitPrice instead. Kept to decode old transactions.
+type RequestUnits struct {
+	Units         uint32
+	AdditionalFee uint32
+
+	solana.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
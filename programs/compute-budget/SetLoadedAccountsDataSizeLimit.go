@@ -0,0 +1,125 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package computebudget
+
+import (
+	"errors"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// SetLoadedAccountsDataSizeLimit sets the maximum size, in bytes, of the accounts
+// the transaction can load, at most MaxLoadedAccountsDataSizeBytes.
+type SetLoadedAccountsDataSizeLimit struct {
+	// Maximum size of the loaded accounts, in bytes
+	Bytes *uint32
+
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewSetLoadedAccountsDataSizeLimitInstructionBuilder creates a new `SetLoadedAccountsDataSizeLimit` instruction builder.
+func NewSetLoadedAccountsDataSizeLimitInstructionBuilder() *SetLoadedAccountsDataSizeLimit {
+	nd := &SetLoadedAccountsDataSizeLimit{}
+	return nd
+}
+
+// Maximum size of the loaded accounts, in bytes
+func (inst *SetLoadedAccountsDataSizeLimit) SetBytes(bytes uint32) *SetLoadedAccountsDataSizeLimit {
+	inst.Bytes = &bytes
+	return inst
+}
+
+func (inst SetLoadedAccountsDataSizeLimit) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint8(Instruction_SetLoadedAccountsDataSizeLimit),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst SetLoadedAccountsDataSizeLimit) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *SetLoadedAccountsDataSizeLimit) Validate() error {
+	// Check whether all (required) parameters are set:
+	{
+		if inst.Bytes == nil {
+			return errors.New("Bytes parameter is not set")
+		}
+		if *inst.Bytes > MaxLoadedAccountsDataSizeBytes {
+			return fmt.Errorf("loaded accounts data size limit %d is over the maximum %d", *inst.Bytes, MaxLoadedAccountsDataSizeBytes)
+		}
+	}
+	return nil
+}
+
+func (inst *SetLoadedAccountsDataSizeLimit) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("SetLoadedAccountsDataSizeLimit")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {
+						paramsBranch.Child(ag_format.Param("Bytes", *inst.Bytes))
+					})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {})
+				})
+		})
+}
+
+func (inst SetLoadedAccountsDataSizeLimit) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	// Serialize `Bytes` param:
+	{
+		err := encoder.Encode(*inst.Bytes)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inst *SetLoadedAccountsDataSizeLimit) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	// Deserialize `Bytes` param:
+	{
+		err := decoder.Decode(&inst.Bytes)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewSetLoadedAccountsDataSizeLimitInstruction declares a new SetLoadedAccountsDataSizeLimit instruction with the provided parameters and accounts.
+func NewSetLoadedAccountsDataSizeLimitInstruction(
+	// Parameters:
+	bytes uint32) *SetLoadedAccountsDataSizeLimit {
+	return NewSetLoadedAccountsDataSizeLimitInstructionBuilder().
+		SetBytes(bytes)
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package computebudget
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/davecgh/go-spew/spew"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/text"
+	"github.com/gagliardetto/treeout"
+)
+
+var ProgramID solana.PublicKey = solana.ComputeBudget
+
+const ProgramName = "ComputeBudget"
+
+func SetProgramID(pubkey solana.PublicKey) {
+	ProgramID = pubkey
+	solana.RegisterInstructionDecoder(ProgramID, registryDecodeInstruction)
+}
+
+func init() {
+	solana.RegisterInstructionDecoder(ProgramID, registryDecodeInstruction)
+}
+
+const (
+	// Deprecated: replaced by SetComputeUnitLimit and SetComputeUnitPrice.
+	Instruction_RequestUnits uint8 = iota
+	Instruction_RequestHeapFrame
+	Instruction_SetComputeUnitLimit
+	Instruction_SetComputeUnitPrice
+	Instruction_SetLoadedAccountsDataSizeLimit
+)
+
+const (
+	// Maximum number of compute units a transaction can consume.
+	MaxComputeUnitLimit = 1_400_000
+	// Heap frame size of the programs when not requested, in bytes.
+	MinHeapFrameBytes = 32 * 1024
+	// Maximum heap frame size a transaction can request, in bytes.
+	MaxHeapFrameBytes = 256 * 1024
+	// Maximum size of the accounts a transaction can load, in bytes.
+	MaxLoadedAccountsDataSizeBytes = 64 * 1024 * 1024
+)
+
+type Instruction struct {
+	bin.BaseVariant
+}
+
+func (inst *Instruction) EncodeToTree(parent treeout.Branches) {
+	if enToTree, ok := inst.Impl.(text.EncodableToTree); ok {
+		enToTree.EncodeToTree(parent)
+	} else {
+		parent.Child(spew.Sdump(inst))
+	}
+}
+
+var InstructionImplDef = bin.NewVariantDefinition(
+	bin.Uint8TypeIDEncoding,
+	[]bin.VariantType{
+		{Name: "RequestUnits", Type: (*RequestUnits)(nil)},
+		{Name: "RequestHeapFrame", Type: (*RequestHeapFrame)(nil)},
+		{Name: "SetComputeUnitLimit", Type: (*SetComputeUnitLimit)(nil)},
+		{Name: "SetComputeUnitPrice", Type: (*SetComputeUnitPrice)(nil)},
+		{Name: "SetLoadedAccountsDataSizeLimit", Type: (*SetLoadedAccountsDataSizeLimit)(nil)},
+	},
+)
+
+func (inst *Instruction) ProgramID() solana.PublicKey {
+	return ProgramID
+}
+
+func (inst *Instruction) Accounts() (out []*solana.AccountMeta) {
+	return inst.Impl.(solana.AccountsGettable).GetAccounts()
+}
+
+func (inst *Instruction) Data() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := bin.NewBinEncoder(buf).Encode(inst); err != nil {
+		return nil, fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (inst *Instruction) TextEncode(encoder *text.Encoder, option *text.Option) error {
+	return encoder.Encode(inst.Impl, option)
+}
+
+func (inst *Instruction) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	return inst.BaseVariant.UnmarshalBinaryVariant(decoder, InstructionImplDef)
+}
+
+func (inst Instruction) MarshalWithEncoder(encoder *bin.Encoder) error {
+	err := encoder.WriteUint8(inst.TypeID.Uint8())
+	if err != nil {
+		return fmt.Errorf("unable to write variant type: %w", err)
+	}
+	return encoder.Encode(inst.Impl)
+}
+
+func registryDecodeInstruction(accounts []*solana.AccountMeta, data []byte) (interface{}, error) {
+	inst, err := DecodeInstruction(accounts, data)
+	if err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+func DecodeInstruction(accounts []*solana.AccountMeta, data []byte) (*Instruction, error) {
+	inst := new(Instruction)
+	if err := bin.NewBinDecoder(data).Decode(inst); err != nil {
+		return nil, fmt.Errorf("unable to decode instruction: %w", err)
+	}
+	if v, ok := inst.Impl.(solana.AccountsSettable); ok {
+		err := v.SetAccounts(accounts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set accounts for instruction: %w", err)
+		}
+	}
+	return inst, nil
+}
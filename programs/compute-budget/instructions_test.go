@@ -0,0 +1,113 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package computebudget
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstructions(t *testing.T) {
+	tests := []struct {
+		name string
+		inst *Instruction
+		data []byte
+	}{
+		{
+			name: "RequestHeapFrame",
+			inst: NewRequestHeapFrameInstruction(MaxHeapFrameBytes).Build(),
+			data: []byte{1, 0, 0, 4, 0},
+		},
+		{
+			name: "SetComputeUnitLimit",
+			inst: NewSetComputeUnitLimitInstruction(200_000).Build(),
+			data: []byte{2, 0x40, 0x0d, 0x03, 0},
+		},
+		{
+			name: "SetComputeUnitPrice",
+			inst: NewSetComputeUnitPriceInstruction(10_000).Build(),
+			data: []byte{3, 0x10, 0x27, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name: "SetLoadedAccountsDataSizeLimit",
+			inst: NewSetLoadedAccountsDataSizeLimitInstruction(65536).Build(),
+			data: []byte{4, 0, 0, 1, 0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, solana.ComputeBudget, test.inst.ProgramID())
+			require.Empty(t, test.inst.Accounts())
+			data, err := test.inst.Data()
+			require.NoError(t, err)
+			require.Equal(t, test.data, data)
+
+			decoded, err := DecodeInstruction(nil, data)
+			require.NoError(t, err)
+			require.Equal(t, test.inst.TypeID, decoded.TypeID)
+			redata, err := decoded.Data()
+			require.NoError(t, err)
+			require.Equal(t, data, redata)
+		})
+	}
+
+	decoded, err := DecodeInstruction(nil, []byte{0, 1, 0, 0, 0, 2, 0, 0, 0})
+	require.NoError(t, err)
+	require.Equal(t, &RequestUnits{Units: 1, AdditionalFee: 2}, decoded.Impl)
+}
+
+func TestInstructions_Validate(t *testing.T) {
+	_, err := NewSetComputeUnitPriceInstructionBuilder().ValidateAndBuild()
+	require.EqualError(t, err, "MicroLamports parameter is not set")
+	_, err = NewSetComputeUnitLimitInstruction(MaxComputeUnitLimit + 1).ValidateAndBuild()
+	require.EqualError(t, err, "compute unit limit 1400001 is over the maximum 1400000")
+	_, err = NewRequestHeapFrameInstruction(MinHeapFrameBytes + 1).ValidateAndBuild()
+	require.EqualError(t, err, "invalid heap frame size: 32769")
+	_, err = NewSetLoadedAccountsDataSizeLimitInstruction(MaxLoadedAccountsDataSizeBytes + 1).ValidateAndBuild()
+	require.Error(t, err)
+
+	inst, err := NewRequestHeapFrameInstruction(MaxHeapFrameBytes).ValidateAndBuild()
+	require.NoError(t, err)
+	require.Equal(t, uint32(MaxHeapFrameBytes), *inst.Impl.(RequestHeapFrame).Bytes)
+}
+
+func TestPrependInstructions(t *testing.T) {
+	from := solana.PublicKey{1}
+	to := solana.PublicKey{2}
+	tx, err := solana.NewTransactionBuilder().
+		AddInstruction(system.NewTransferInstruction(1, from, to).Build()).
+		PrependInstructions(
+			NewSetComputeUnitLimitInstruction(200_000).Build(),
+			NewSetComputeUnitPriceInstruction(10_000).Build(),
+		).
+		SetRecentBlockHash(solana.Hash{3}).
+		Build()
+	require.NoError(t, err)
+
+	require.Equal(t, from, tx.Message.AccountKeys[0])
+	require.Len(t, tx.Message.Instructions, 3)
+	for i, typeID := range []uint8{Instruction_SetComputeUnitLimit, Instruction_SetComputeUnitPrice} {
+		programID, err := tx.Message.Program(tx.Message.Instructions[i].ProgramIDIndex)
+		require.NoError(t, err)
+		require.Equal(t, solana.ComputeBudget, programID)
+		require.Equal(t, typeID, tx.Message.Instructions[i].Data[0])
+	}
+	programID, err := tx.Message.Program(tx.Message.Instructions[2].ProgramIDIndex)
+	require.NoError(t, err)
+	require.Equal(t, solana.SystemProgramID, programID)
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkledistributor
+
+import (
+	"encoding/binary"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/hashutil"
+)
+
+var (
+	// The Saber merkle distributor program.
+	SaberProgramID = solana.MustPublicKeyFromBase58("MRKGLMizK9XSTaD1d1jbVkdHZbQVCSnPpYiTw9aKQv8")
+	// The Jito merkle distributor program.
+	JitoProgramID = solana.MustPublicKeyFromBase58("mERKcfxMC5SqJn4Ld4BUris3WKZZ1ojjWJ3A3J5CKxv")
+)
+
+var (
+	distributorSeed = []byte("MerkleDistributor")
+	claimStatusSeed = []byte("ClaimStatus")
+)
+
+// discriminator returns the Anchor discriminator of the instruction.
+func discriminator(name string) []byte {
+	sum := hashutil.AnchorInstructionDiscriminator(name)
+	return sum[:]
+}
+
+func encodeProof(data []byte, proof [][32]byte) []byte {
+	data = append(data, u32(uint32(len(proof)))...)
+	for _, node := range proof {
+		data = append(data, node[:]...)
+	}
+	return data
+}
+
+func u32(v uint32) []byte {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, v)
+	return buf
+}
+
+// FindSaberDistributorAddress returns the distributor created with the base key.
+func FindSaberDistributorAddress(base solana.PublicKey) (solana.PublicKey, uint8, error) {
+	return solana.FindProgramAddress([][]byte{distributorSeed, base[:]}, SaberProgramID)
+}
+
+// FindSaberClaimStatusAddress returns the account recording the claim
+// of the index in the distributor.
+func FindSaberClaimStatusAddress(distributor solana.PublicKey, index uint64) (solana.PublicKey, uint8, error) {
+	return solana.FindProgramAddress([][]byte{claimStatusSeed, u64(index), distributor[:]}, SaberProgramID)
+}
+
+// NewSaberClaimInstruction returns the instruction claiming the tokens of the claim
+// (with its proof) from the distributor: they are transferred from the token
+// account of the distributor to the token account "to".
+// The claimant and the payer of the claim status account must sign.
+func NewSaberClaimInstruction(
+	distributor solana.PublicKey,
+	claim *Claim,
+	from solana.PublicKey,
+	to solana.PublicKey,
+	payer solana.PublicKey,
+) (solana.Instruction, error) {
+	claimStatus, bump, err := FindSaberClaimStatusAddress(distributor, claim.Index)
+	if err != nil {
+		return nil, err
+	}
+	data := discriminator("claim")
+	data = append(data, bump)
+	data = append(data, u64(claim.Index)...)
+	data = append(data, u64(claim.Amount)...)
+	data = encodeProof(data, claim.Proof)
+
+	return solana.NewInstruction(SaberProgramID, solana.AccountMetaSlice{
+		solana.Meta(distributor).WRITE(),
+		solana.Meta(claimStatus).WRITE(),
+		solana.Meta(from).WRITE(),
+		solana.Meta(to).WRITE(),
+		solana.Meta(claim.Claimant).SIGNER(),
+		solana.Meta(payer).WRITE().SIGNER(),
+		solana.Meta(solana.SystemProgramID),
+		solana.Meta(solana.TokenProgramID),
+	}, data), nil
+}
+
+// FindJitoDistributorAddress returns the distributor of the version of the airdrop of the mint.
+func FindJitoDistributorAddress(mint solana.PublicKey, version uint64) (solana.PublicKey, uint8, error) {
+	return solana.FindProgramAddress([][]byte{distributorSeed, mint[:], u64(version)}, JitoProgramID)
+}
+
+// FindJitoClaimStatusAddress returns the account recording the claim
+// of the claimant in the distributor.
+func FindJitoClaimStatusAddress(distributor solana.PublicKey, claimant solana.PublicKey) (solana.PublicKey, uint8, error) {
+	return solana.FindProgramAddress([][]byte{claimStatusSeed, claimant[:], distributor[:]}, JitoProgramID)
+}
+
+// NewJitoClaimInstruction returns the `new_claim` instruction claiming the unlocked
+// tokens of the claim (with its proof) from the distributor: they are transferred
+// from the token account of the distributor to the token account "to".
+// The claimant pays for the claim status account, and must sign.
+func NewJitoClaimInstruction(
+	distributor solana.PublicKey,
+	claim *Claim,
+	from solana.PublicKey,
+	to solana.PublicKey,
+) (solana.Instruction, error) {
+	claimStatus, _, err := FindJitoClaimStatusAddress(distributor, claim.Claimant)
+	if err != nil {
+		return nil, err
+	}
+	data := discriminator("new_claim")
+	data = append(data, u64(claim.Amount)...)
+	data = append(data, u64(claim.LockedAmount)...)
+	data = encodeProof(data, claim.Proof)
+
+	return solana.NewInstruction(JitoProgramID, solana.AccountMetaSlice{
+		solana.Meta(distributor).WRITE(),
+		solana.Meta(claimStatus).WRITE(),
+		solana.Meta(from).WRITE(),
+		solana.Meta(to).WRITE(),
+		solana.Meta(claim.Claimant).WRITE().SIGNER(),
+		solana.Meta(solana.TokenProgramID),
+		solana.Meta(solana.SystemProgramID),
+	}, data), nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package merkledistributor builds the merkle trees of the airdrops of the
+// merkle distributor programs (Saber's and Jito's), and the instructions to
+// claim them, so that the claims can be generated and verified off-chain.
+package merkledistributor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/hashutil"
+)
+
+// Claim is the allocation of a recipient of a distribution.
+type Claim struct {
+	// Index of the claim; only used by the Saber format.
+	Index    uint64
+	Claimant solana.PublicKey
+	// The claimed amount; the unlocked amount in the Jito format.
+	Amount uint64
+	// The amount vested over time; only used by the Jito format.
+	LockedAmount uint64
+
+	// The proof of the claim, set by NewDistribution.
+	Proof [][32]byte
+}
+
+// Format is the leaf and node hashing scheme of a distributor program.
+type Format struct {
+	// Leaf returns the leaf of the claim.
+	Leaf func(claim *Claim) [32]byte
+	// Node returns the parent of two nodes, in order.
+	Node func(left, right [32]byte) [32]byte
+	// Sort (and deduplicate) the leaves before building the tree.
+	SortLeaves bool
+	// Hash the last node of an odd level with itself,
+	// instead of moving it up to the next level.
+	HashOddWithItself bool
+}
+
+// SaberFormat is the format of the Saber merkle distributor: the leaves are
+// keccak256(index, claimant, amount), sorted, and the nodes are the keccak256
+// of the sorted pairs of children.
+var SaberFormat = &Format{
+	Leaf: func(claim *Claim) [32]byte {
+		return hashutil.Keccak256(u64(claim.Index), claim.Claimant[:], u64(claim.Amount))
+	},
+	Node: func(left, right [32]byte) [32]byte {
+		return hashutil.Keccak256(left[:], right[:])
+	},
+	SortLeaves: true,
+}
+
+// JitoFormat is the format of the Jito merkle distributor: the leaves are
+// sha256(0x00, sha256(claimant, unlocked amount, locked amount)), and the nodes
+// are the sha256 of 0x01 and the sorted pair of children.
+var JitoFormat = &Format{
+	Leaf: func(claim *Claim) [32]byte {
+		node := hashutil.Sha256(claim.Claimant[:], u64(claim.Amount), u64(claim.LockedAmount))
+		return hashutil.MerkleLeaf(node[:])
+	},
+	Node: func(left, right [32]byte) [32]byte {
+		return hashutil.MerkleNode(left, right)
+	},
+	HashOddWithItself: true,
+}
+
+func u64(v uint64) []byte {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, v)
+	return buf
+}
+
+// parent returns the parent of two siblings, hashed in sorted order.
+func (format *Format) parent(a, b [32]byte) [32]byte {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return format.Node(a, b)
+}
+
+// Verify checks the proof of the leaf against the root.
+func (format *Format) Verify(proof [][32]byte, root [32]byte, leaf [32]byte) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = format.parent(computed, sibling)
+	}
+	return computed == root
+}
+
+// Tree is a merkle tree.
+type Tree struct {
+	format *Format
+	// The levels of the tree, from the leaves to the root.
+	levels [][][32]byte
+	index  map[[32]byte]int
+}
+
+// NewTree builds the merkle tree of the leaves.
+func NewTree(format *Format, leaves [][32]byte) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, errors.New("no leaves")
+	}
+	level := append([][32]byte(nil), leaves...)
+	if format.SortLeaves {
+		sort.Slice(level, func(i, j int) bool { return bytes.Compare(level[i][:], level[j][:]) < 0 })
+		deduped := level[:1]
+		for _, leaf := range level[1:] {
+			if leaf != deduped[len(deduped)-1] {
+				deduped = append(deduped, leaf)
+			}
+		}
+		level = deduped
+	}
+	tree := &Tree{
+		format: format,
+		index:  make(map[[32]byte]int, len(level)),
+	}
+	for i, leaf := range level {
+		if _, ok := tree.index[leaf]; !ok {
+			tree.index[leaf] = i
+		}
+	}
+	tree.levels = append(tree.levels, level)
+	for len(level) > 1 {
+		next := make([][32]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			switch {
+			case i+1 < len(level):
+				next = append(next, format.parent(level[i], level[i+1]))
+			case format.HashOddWithItself:
+				next = append(next, format.parent(level[i], level[i]))
+			default:
+				next = append(next, level[i])
+			}
+		}
+		tree.levels = append(tree.levels, next)
+		level = next
+	}
+	return tree, nil
+}
+
+// Root returns the root of the tree.
+func (tree *Tree) Root() [32]byte {
+	return tree.levels[len(tree.levels)-1][0]
+}
+
+// Proof returns the proof of the leaf.
+func (tree *Tree) Proof(leaf [32]byte) ([][32]byte, error) {
+	i, ok := tree.index[leaf]
+	if !ok {
+		return nil, fmt.Errorf("leaf %x is not in the tree", leaf)
+	}
+	var proof [][32]byte
+	for _, level := range tree.levels[:len(tree.levels)-1] {
+		sibling := i ^ 1
+		switch {
+		case sibling < len(level):
+			proof = append(proof, level[sibling])
+		case tree.format.HashOddWithItself:
+			proof = append(proof, level[i])
+		}
+		i /= 2
+	}
+	return proof, nil
+}
+
+// Distribution is the merkle tree of the claims of an airdrop.
+type Distribution struct {
+	Format *Format
+	Root   [32]byte
+	Claims []*Claim
+	// The sum of the claimed amounts (locked included).
+	MaxTotalClaim uint64
+	// The number of claims.
+	MaxNumNodes uint64
+}
+
+// NewDistribution builds the merkle tree of the claims, and sets their proofs.
+// The claims must be unique.
+func NewDistribution(format *Format, claims []*Claim) (*Distribution, error) {
+	leaves := make([][32]byte, len(claims))
+	seen := make(map[[32]byte]bool, len(claims))
+	var total uint64
+	for i, claim := range claims {
+		leaves[i] = format.Leaf(claim)
+		if seen[leaves[i]] {
+			return nil, fmt.Errorf("duplicate claim of %s", claim.Claimant)
+		}
+		seen[leaves[i]] = true
+		total += claim.Amount + claim.LockedAmount
+	}
+	tree, err := NewTree(format, leaves)
+	if err != nil {
+		return nil, err
+	}
+	for i, claim := range claims {
+		if claim.Proof, err = tree.Proof(leaves[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &Distribution{
+		Format:        format,
+		Root:          tree.Root(),
+		Claims:        claims,
+		MaxTotalClaim: total,
+		MaxNumNodes:   uint64(len(claims)),
+	}, nil
+}
+
+// Verify checks the proof of the claim against the root of the distribution.
+func (d *Distribution) Verify(claim *Claim) bool {
+	return d.Format.Verify(claim.Proof, d.Root, d.Format.Leaf(claim))
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkledistributor
+
+import (
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func testClaims(n int) []*Claim {
+	claims := make([]*Claim, n)
+	for i := range claims {
+		claims[i] = &Claim{
+			Index:        uint64(i),
+			Claimant:     solana.PublicKey{byte(i + 1)},
+			Amount:       uint64(1000 * (i + 1)),
+			LockedAmount: uint64(i),
+		}
+	}
+	return claims
+}
+
+func TestDistribution(t *testing.T) {
+	for name, format := range map[string]*Format{"saber": SaberFormat, "jito": JitoFormat} {
+		for n := 1; n <= 9; n++ {
+			claims := testClaims(n)
+			distribution, err := NewDistribution(format, claims)
+			require.NoError(t, err, name)
+			require.Equal(t, uint64(n), distribution.MaxNumNodes)
+			for _, claim := range claims {
+				require.True(t, distribution.Verify(claim), "%s: claim %d of %d", name, claim.Index, n)
+
+				tampered := *claim
+				tampered.Amount++
+				require.False(t, distribution.Verify(&tampered), name)
+			}
+		}
+	}
+
+	_, err := NewDistribution(SaberFormat, append(testClaims(2), testClaims(1)...))
+	require.Error(t, err)
+	_, err = NewDistribution(SaberFormat, nil)
+	require.Error(t, err)
+}
+
+func TestNewTree(t *testing.T) {
+	a, b, c := [32]byte{3}, [32]byte{1}, [32]byte{2}
+
+	// Saber: sorted leaves, and the odd node moves up.
+	tree, err := NewTree(SaberFormat, [][32]byte{a, b, c})
+	require.NoError(t, err)
+	require.Equal(t, SaberFormat.parent(SaberFormat.Node(b, c), a), tree.Root())
+	proof, err := tree.Proof(a)
+	require.NoError(t, err)
+	require.Equal(t, [][32]byte{SaberFormat.Node(b, c)}, proof)
+
+	// Jito: the odd node is hashed with itself.
+	tree, err = NewTree(JitoFormat, [][32]byte{a, b, c})
+	require.NoError(t, err)
+	require.Equal(t, JitoFormat.parent(JitoFormat.parent(a, b), JitoFormat.Node(c, c)), tree.Root())
+
+	_, err = tree.Proof([32]byte{9})
+	require.Error(t, err)
+}
+
+func TestClaimInstructions(t *testing.T) {
+	distributor, from, to, payer := solana.PublicKey{10}, solana.PublicKey{11}, solana.PublicKey{12}, solana.PublicKey{13}
+	claims := testClaims(3)
+	_, err := NewDistribution(SaberFormat, claims)
+	require.NoError(t, err)
+
+	inst, err := NewSaberClaimInstruction(distributor, claims[1], from, to, payer)
+	require.NoError(t, err)
+	require.Equal(t, SaberProgramID, inst.ProgramID())
+	claimStatus, bump, err := FindSaberClaimStatusAddress(distributor, 1)
+	require.NoError(t, err)
+	require.Equal(t, claimStatus, inst.Accounts()[1].PublicKey)
+	require.True(t, inst.Accounts()[4].IsSigner)
+	require.Equal(t, claims[1].Claimant, inst.Accounts()[4].PublicKey)
+
+	data, err := inst.Data()
+	require.NoError(t, err)
+	dec := bin.NewBorshDecoder(data)
+	disc, err := dec.ReadNBytes(8)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x3e, 0xc6, 0xd6, 0xc1, 0xd5, 0x9f, 0x6c, 0xd2}, disc)
+	var args struct {
+		Bump   uint8
+		Index  uint64
+		Amount uint64
+		Proof  [][32]byte
+	}
+	require.NoError(t, dec.Decode(&args))
+	require.Equal(t, bump, args.Bump)
+	require.Equal(t, uint64(1), args.Index)
+	require.Equal(t, uint64(2000), args.Amount)
+	require.Equal(t, claims[1].Proof, args.Proof)
+
+	inst, err = NewJitoClaimInstruction(distributor, claims[1], from, to)
+	require.NoError(t, err)
+	require.Equal(t, JitoProgramID, inst.ProgramID())
+	claimStatus, _, err = FindJitoClaimStatusAddress(distributor, claims[1].Claimant)
+	require.NoError(t, err)
+	require.Equal(t, claimStatus, inst.Accounts()[1].PublicKey)
+	data, err = inst.Data()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x4e, 0xb1, 0x62, 0x7b, 0xd2, 0x15, 0xbb, 0x53}, data[:8])
+	require.Len(t, data, 8+8+8+4+32*len(claims[1].Proof))
+}
@@ -0,0 +1,174 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secp256k1
+
+import (
+	"errors"
+	"math/big"
+)
+
+// The arithmetic of the secp256k1 curve, on math/big: it is not constant-time,
+// so the package only handles public data (signatures, public keys and hashes),
+// and doesn't sign.
+
+var (
+	curveP, _  = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F", 16)
+	curveN, _  = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141", 16)
+	curveGx, _ = new(big.Int).SetString("79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798", 16)
+	curveGy, _ = new(big.Int).SetString("483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8", 16)
+	curveB     = big.NewInt(7)
+	halfN      = new(big.Int).Rsh(curveN, 1)
+)
+
+// point is an affine point of the curve; nil is the point at infinity.
+type point struct {
+	x, y *big.Int
+}
+
+var generator = &point{x: curveGx, y: curveGy}
+
+func mod(v *big.Int, m *big.Int) *big.Int {
+	return v.Mod(v, m)
+}
+
+func (a *point) add(b *point) *point {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.x.Cmp(b.x) == 0 {
+		if a.y.Cmp(b.y) != 0 || a.y.Sign() == 0 {
+			return nil
+		}
+		return a.double()
+	}
+	// lambda = (y2 - y1) / (x2 - x1)
+	num := mod(new(big.Int).Sub(b.y, a.y), curveP)
+	den := new(big.Int).ModInverse(mod(new(big.Int).Sub(b.x, a.x), curveP), curveP)
+	lambda := mod(num.Mul(num, den), curveP)
+	return a.fromLambda(lambda, b.x)
+}
+
+func (a *point) double() *point {
+	if a == nil || a.y.Sign() == 0 {
+		return nil
+	}
+	// lambda = 3 x^2 / 2 y
+	num := new(big.Int).Mul(a.x, a.x)
+	num.Mul(num, big.NewInt(3))
+	den := new(big.Int).ModInverse(new(big.Int).Lsh(a.y, 1), curveP)
+	lambda := mod(num.Mul(num, den), curveP)
+	return a.fromLambda(lambda, a.x)
+}
+
+// fromLambda returns the sum of a and of the point of abscissa bx on the line of slope lambda.
+func (a *point) fromLambda(lambda *big.Int, bx *big.Int) *point {
+	x := new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, a.x)
+	x.Sub(x, bx)
+	mod(x, curveP)
+	y := new(big.Int).Sub(a.x, x)
+	y.Mul(y, lambda)
+	y.Sub(y, a.y)
+	mod(y, curveP)
+	return &point{x: x, y: y}
+}
+
+func (a *point) mul(k *big.Int) *point {
+	var result *point
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		result = result.double()
+		if k.Bit(i) == 1 {
+			result = result.add(a)
+		}
+	}
+	return result
+}
+
+// decompress returns the point of the abscissa, with an odd ordinate if odd.
+func decompress(x *big.Int, odd bool) (*point, error) {
+	if x.Cmp(curveP) >= 0 {
+		return nil, errors.New("invalid point")
+	}
+	// y^2 = x^3 + 7
+	rhs := new(big.Int).Exp(x, big.NewInt(3), curveP)
+	mod(rhs.Add(rhs, curveB), curveP)
+	// p = 3 mod 4: y = rhs^((p+1)/4)
+	exp := new(big.Int).Add(curveP, big.NewInt(1))
+	exp.Rsh(exp, 2)
+	y := new(big.Int).Exp(rhs, exp, curveP)
+	if new(big.Int).Exp(y, big.NewInt(2), curveP).Cmp(rhs) != 0 {
+		return nil, errors.New("invalid point")
+	}
+	if (y.Bit(0) == 1) != odd {
+		y.Sub(curveP, y)
+	}
+	return &point{x: x, y: y}, nil
+}
+
+func (a *point) serialize() (out [PublicKeySize]byte) {
+	a.x.FillBytes(out[:32])
+	a.y.FillBytes(out[32:])
+	return out
+}
+
+func parsePublicKey(publicKey [PublicKeySize]byte) (*point, error) {
+	a := &point{
+		x: new(big.Int).SetBytes(publicKey[:32]),
+		y: new(big.Int).SetBytes(publicKey[32:]),
+	}
+	if a.x.Cmp(curveP) >= 0 || a.y.Cmp(curveP) >= 0 {
+		return nil, errors.New("invalid public key")
+	}
+	lhs := new(big.Int).Exp(a.y, big.NewInt(2), curveP)
+	rhs := new(big.Int).Exp(a.x, big.NewInt(3), curveP)
+	mod(rhs.Add(rhs, curveB), curveP)
+	if lhs.Cmp(rhs) != 0 {
+		return nil, errors.New("invalid public key: not on the curve")
+	}
+	return a, nil
+}
+
+// recoverPoint returns the public key signing the hash with the signature (r, s),
+// as ecrecover does.
+func recoverPoint(hash [32]byte, r, s *big.Int, recoveryID uint8) (*point, error) {
+	if recoveryID > 3 {
+		return nil, errors.New("invalid recovery id")
+	}
+	if r.Sign() <= 0 || r.Cmp(curveN) >= 0 || s.Sign() <= 0 || s.Cmp(curveN) >= 0 {
+		return nil, errors.New("invalid signature")
+	}
+	x := new(big.Int).Set(r)
+	if recoveryID&2 != 0 {
+		x.Add(x, curveN)
+	}
+	R, err := decompress(x, recoveryID&1 == 1)
+	if err != nil {
+		return nil, errors.New("invalid signature")
+	}
+	// Q = r^-1 (s R - e G)
+	rInv := new(big.Int).ModInverse(r, curveN)
+	e := new(big.Int).SetBytes(hash[:])
+	u1 := mod(new(big.Int).Neg(e), curveN)
+	u1 = mod(u1.Mul(u1, rInv), curveN)
+	u2 := mod(new(big.Int).Mul(s, rInv), curveN)
+	Q := generator.mul(u1).add(R.mul(u2))
+	if Q == nil {
+		return nil, errors.New("invalid signature")
+	}
+	return Q, nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secp256k1
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// SignatureOffsetsSize is the size of the serialized SignatureOffsets.
+const SignatureOffsetsSize = 11
+
+// SignedMessage is a message signed by an Ethereum address.
+type SignedMessage struct {
+	EthAddress EthAddress
+	Signature  [SignatureSize]byte
+	RecoveryID uint8
+	Message    []byte
+}
+
+// NewSignedMessage returns the message signed by the address with the
+// 65-byte Ethereum signature (see ParseEthSignature).
+func NewSignedMessage(address EthAddress, message []byte, signature []byte) (*SignedMessage, error) {
+	sig, recoveryID, err := ParseEthSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedMessage{
+		EthAddress: address,
+		Signature:  sig,
+		RecoveryID: recoveryID,
+		Message:    message,
+	}, nil
+}
+
+// Verify checks that the message was signed by the address, as the program does.
+func (signed *SignedMessage) Verify() error {
+	return Verify(signed.EthAddress, signed.Message, signed.Signature, signed.RecoveryID)
+}
+
+// SignatureOffsets locates the data of a signature verified by the program:
+// each part is in the data of the instruction of the transaction at the index.
+type SignatureOffsets struct {
+	// Offset of the signature (64 bytes) followed by its recovery id.
+	SignatureOffset           uint16
+	SignatureInstructionIndex uint8
+	// Offset of the address (20 bytes).
+	EthAddressOffset           uint16
+	EthAddressInstructionIndex uint8
+	MessageDataOffset          uint16
+	MessageDataSize            uint16
+	MessageInstructionIndex    uint8
+}
+
+func (offsets *SignatureOffsets) encode(data []byte) []byte {
+	data = append(data, u16(offsets.SignatureOffset)...)
+	data = append(data, offsets.SignatureInstructionIndex)
+	data = append(data, u16(offsets.EthAddressOffset)...)
+	data = append(data, offsets.EthAddressInstructionIndex)
+	data = append(data, u16(offsets.MessageDataOffset)...)
+	data = append(data, u16(offsets.MessageDataSize)...)
+	return append(data, offsets.MessageInstructionIndex)
+}
+
+func decodeOffsets(data []byte) SignatureOffsets {
+	return SignatureOffsets{
+		SignatureOffset:            binary.LittleEndian.Uint16(data[0:]),
+		SignatureInstructionIndex:  data[2],
+		EthAddressOffset:           binary.LittleEndian.Uint16(data[3:]),
+		EthAddressInstructionIndex: data[5],
+		MessageDataOffset:          binary.LittleEndian.Uint16(data[6:]),
+		MessageDataSize:            binary.LittleEndian.Uint16(data[8:]),
+		MessageInstructionIndex:    data[10],
+	}
+}
+
+func u16(v uint16) []byte {
+	buf := make([]byte, 2)
+	binary.LittleEndian.PutUint16(buf, v)
+	return buf
+}
+
+// NewSecp256k1Instruction returns the instruction verifying the signed messages,
+// whose data holds the messages: instructionIndex is the index of the
+// instruction in the transaction, to which the offsets refer.
+func NewSecp256k1Instruction(instructionIndex uint8, messages ...*SignedMessage) (*solana.GenericInstruction, error) {
+	if len(messages) == 0 {
+		return nil, errors.New("no signed messages")
+	}
+	if len(messages) > math.MaxUint8 {
+		return nil, fmt.Errorf("too many signed messages: %d", len(messages))
+	}
+
+	data := []byte{uint8(len(messages))}
+	var payload []byte
+	offset := 1 + len(messages)*SignatureOffsetsSize
+	for _, message := range messages {
+		addressOffset := offset + len(payload)
+		signatureOffset := addressOffset + EthAddressSize
+		messageOffset := signatureOffset + SignatureSize + 1
+		if messageOffset+len(message.Message) > math.MaxUint16 {
+			return nil, errors.New("signed messages too large")
+		}
+		offsets := SignatureOffsets{
+			SignatureOffset:            uint16(signatureOffset),
+			SignatureInstructionIndex:  instructionIndex,
+			EthAddressOffset:           uint16(addressOffset),
+			EthAddressInstructionIndex: instructionIndex,
+			MessageDataOffset:          uint16(messageOffset),
+			MessageDataSize:            uint16(len(message.Message)),
+			MessageInstructionIndex:    instructionIndex,
+		}
+		data = offsets.encode(data)
+
+		payload = append(payload, message.EthAddress[:]...)
+		payload = append(payload, message.Signature[:]...)
+		payload = append(payload, message.RecoveryID)
+		payload = append(payload, message.Message...)
+	}
+	return solana.NewInstruction(solana.Secp256k1ProgramID, solana.AccountMetaSlice{}, append(data, payload...)), nil
+}
+
+// DecodeInstruction returns the offsets of the signatures verified
+// by the instruction with the data.
+func DecodeInstruction(data []byte) ([]SignatureOffsets, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty instruction data")
+	}
+	count := int(data[0])
+	if count == 0 && len(data) > 1 {
+		return nil, errors.New("invalid instruction data: no signatures")
+	}
+	if len(data) < 1+count*SignatureOffsetsSize {
+		return nil, errors.New("invalid instruction data: too short")
+	}
+	offsets := make([]SignatureOffsets, count)
+	for i := range offsets {
+		offsets[i] = decodeOffsets(data[1+i*SignatureOffsetsSize:])
+	}
+	return offsets, nil
+}
+
+// VerifyInstruction verifies the signatures of the instruction with the data,
+// as the program does; instructionDatas are the data of the instructions of
+// the transaction, indexed as in the offsets (nil to read them all in data).
+func VerifyInstruction(data []byte, instructionDatas [][]byte) error {
+	offsets, err := DecodeInstruction(data)
+	if err != nil {
+		return err
+	}
+	slice := func(index uint8, offset uint16, size int) ([]byte, error) {
+		source := data
+		if instructionDatas != nil {
+			if int(index) >= len(instructionDatas) {
+				return nil, fmt.Errorf("invalid instruction index: %d", index)
+			}
+			source = instructionDatas[index]
+		}
+		if int(offset)+size > len(source) {
+			return nil, errors.New("invalid offsets: out of bounds")
+		}
+		return source[int(offset) : int(offset)+size], nil
+	}
+
+	for i, o := range offsets {
+		sig, err := slice(o.SignatureInstructionIndex, o.SignatureOffset, SignatureSize+1)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		address, err := slice(o.EthAddressInstructionIndex, o.EthAddressOffset, EthAddressSize)
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		message, err := slice(o.MessageInstructionIndex, o.MessageDataOffset, int(o.MessageDataSize))
+		if err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+
+		signed := &SignedMessage{
+			RecoveryID: sig[SignatureSize],
+			Message:    message,
+		}
+		copy(signed.Signature[:], sig)
+		copy(signed.EthAddress[:], address)
+		if err := signed.Verify(); err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secp256k1 builds and verifies the instructions of the secp256k1
+// program, which verifies Ethereum-style signatures (ecrecover) in
+// transactions, e.g. for bridges: the program recovers the signer of the
+// keccak256 hash of each message, and checks that its Ethereum address is the
+// expected one; the programs of the transaction can then inspect the verified
+// messages via the instructions sysvar.
+package secp256k1
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/gagliardetto/solana-go/hashutil"
+)
+
+const (
+	// Size of an uncompressed public key, without its 0x04 prefix.
+	PublicKeySize = 64
+	// Size of a signature (r || s), without its recovery id.
+	SignatureSize = 64
+	// Size of an Ethereum address.
+	EthAddressSize = 20
+)
+
+// EthAddress is an Ethereum address: the last 20 bytes of the
+// keccak256 hash of the uncompressed public key.
+type EthAddress [EthAddressSize]byte
+
+// EthAddressFromPublicKey returns the address of the public key, either
+// uncompressed (64 bytes) or with its 0x04 prefix (65 bytes).
+func EthAddressFromPublicKey(publicKey []byte) (EthAddress, error) {
+	if len(publicKey) == PublicKeySize+1 && publicKey[0] == 4 {
+		publicKey = publicKey[1:]
+	}
+	if len(publicKey) != PublicKeySize {
+		return EthAddress{}, fmt.Errorf("invalid public key size: %d", len(publicKey))
+	}
+	var key [PublicKeySize]byte
+	copy(key[:], publicKey)
+	if _, err := parsePublicKey(key); err != nil {
+		return EthAddress{}, err
+	}
+	return ethAddress(key), nil
+}
+
+func ethAddress(publicKey [PublicKeySize]byte) (address EthAddress) {
+	hash := hashutil.Keccak256(publicKey[:])
+	copy(address[:], hash[32-EthAddressSize:])
+	return address
+}
+
+// EthAddressFromHex parses an address in hex, with or without its 0x prefix;
+// its EIP-55 checksum is verified if it is mixed-case.
+func EthAddressFromHex(s string) (address EthAddress, err error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(s) != 2*EthAddressSize {
+		return address, fmt.Errorf("invalid address length: %d", len(s))
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return address, fmt.Errorf("invalid address: %w", err)
+	}
+	copy(address[:], b)
+	if s != strings.ToLower(s) && s != strings.ToUpper(s) && "0x"+s != address.String() {
+		return address, errors.New("invalid address checksum")
+	}
+	return address, nil
+}
+
+// String returns the address in hex, with its EIP-55 checksum.
+func (address EthAddress) String() string {
+	lower := hex.EncodeToString(address[:])
+	hash := hashutil.Keccak256([]byte(lower))
+	out := []byte(lower)
+	for i, c := range out {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0xf
+		}
+		if c >= 'a' && nibble >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out)
+}
+
+// EthSignedMessage returns the message signed by the personal_sign method of
+// the Ethereum wallets (EIP-191): "\x19Ethereum Signed Message:\n" followed by
+// the length of the message and the message. This is the message to verify
+// with the program for such signatures.
+func EthSignedMessage(message []byte) []byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+	return append([]byte(prefix), message...)
+}
+
+// ParseEthSignature parses a 65-byte Ethereum signature, r || s || v, where
+// v is the recovery id, either raw (0, 1), legacy (27, 28), or EIP-155 (35 +
+// 2 * chain id + recovery id). A high s is normalized (with the recovery id),
+// as the signature remains valid for the same signer.
+func ParseEthSignature(signature []byte) (sig [SignatureSize]byte, recoveryID uint8, err error) {
+	if len(signature) != SignatureSize+1 {
+		return sig, 0, fmt.Errorf("invalid signature size: %d", len(signature))
+	}
+	switch v := signature[SignatureSize]; {
+	case v < 2:
+		recoveryID = v
+	case v == 27 || v == 28:
+		recoveryID = v - 27
+	case v >= 35:
+		recoveryID = (v - 35) % 2
+	default:
+		return sig, 0, fmt.Errorf("invalid recovery id: %d", v)
+	}
+	copy(sig[:], signature)
+
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(halfN) > 0 && s.Cmp(curveN) < 0 {
+		s.Sub(curveN, s)
+		s.FillBytes(sig[32:])
+		recoveryID ^= 1
+	}
+	return sig, recoveryID, nil
+}
+
+// RecoverPublicKey returns the uncompressed public key signing the hash
+// with the signature and its recovery id (0 to 3).
+func RecoverPublicKey(hash [32]byte, signature [SignatureSize]byte, recoveryID uint8) ([PublicKeySize]byte, error) {
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	publicKey, err := recoverPoint(hash, r, s, recoveryID)
+	if err != nil {
+		return [PublicKeySize]byte{}, err
+	}
+	return publicKey.serialize(), nil
+}
+
+// RecoverEthAddress returns the address signing the message (hashed with
+// keccak256, as the program does) with the signature and its recovery id.
+func RecoverEthAddress(message []byte, signature [SignatureSize]byte, recoveryID uint8) (EthAddress, error) {
+	publicKey, err := RecoverPublicKey(hashutil.Keccak256(message), signature, recoveryID)
+	if err != nil {
+		return EthAddress{}, err
+	}
+	return ethAddress(publicKey), nil
+}
+
+// Verify checks that the message was signed by the address, as the program does.
+func Verify(address EthAddress, message []byte, signature [SignatureSize]byte, recoveryID uint8) error {
+	signer, err := RecoverEthAddress(message, signature, recoveryID)
+	if err != nil {
+		return err
+	}
+	if signer != address {
+		return fmt.Errorf("message signed by %s, not %s", signer, address)
+	}
+	return nil
+}
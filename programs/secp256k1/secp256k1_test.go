@@ -0,0 +1,164 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secp256k1
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+// The example of the web3.js documentation (web3.eth.accounts.sign).
+const (
+	testPrivateKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testAddress    = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+	testSignature  = "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func testKey(t *testing.T) (key [privateKeySize]byte) {
+	copy(key[:], mustHex(t, testPrivateKey))
+	return key
+}
+
+func TestEthAddress(t *testing.T) {
+	var one [privateKeySize]byte
+	one[31] = 1
+	publicKey, err := publicKeyFromPrivateKey(one)
+	require.NoError(t, err)
+	address, err := EthAddressFromPublicKey(append([]byte{4}, publicKey[:]...))
+	require.NoError(t, err)
+	require.Equal(t, "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf", address.String())
+
+	parsed, err := EthAddressFromHex("0x7e5f4552091a69125d5dfcb7b8c2659029395bdf")
+	require.NoError(t, err)
+	require.Equal(t, address, parsed)
+	parsed, err = EthAddressFromHex(address.String())
+	require.NoError(t, err)
+	require.Equal(t, address, parsed)
+	_, err = EthAddressFromHex("0x7e5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	require.EqualError(t, err, "invalid address checksum")
+
+	_, err = EthAddressFromPublicKey(make([]byte, PublicKeySize))
+	require.Error(t, err)
+}
+
+func TestSign(t *testing.T) {
+	signed, err := signMessage(testKey(t), EthSignedMessage([]byte("Some data")))
+	require.NoError(t, err)
+	require.Equal(t, testAddress, signed.EthAddress.String())
+	require.Equal(t, mustHex(t, testSignature)[:SignatureSize], signed.Signature[:])
+	require.Equal(t, uint8(1), signed.RecoveryID)
+	require.NoError(t, signed.Verify())
+}
+
+func TestParseEthSignature(t *testing.T) {
+	address, err := EthAddressFromHex(testAddress)
+	require.NoError(t, err)
+	raw := mustHex(t, testSignature)
+	message := EthSignedMessage([]byte("Some data"))
+
+	sig, recoveryID, err := ParseEthSignature(raw)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), recoveryID)
+	recovered, err := RecoverEthAddress(message, sig, recoveryID)
+	require.NoError(t, err)
+	require.Equal(t, address, recovered)
+
+	// Raw and EIP-155 (chain id 1) recovery ids.
+	for _, v := range []byte{1, 38} {
+		raw[SignatureSize] = v
+		_, recoveryID, err = ParseEthSignature(raw)
+		require.NoError(t, err)
+		require.Equal(t, uint8(1), recoveryID)
+	}
+	raw[SignatureSize] = 30
+	_, _, err = ParseEthSignature(raw)
+	require.Error(t, err)
+
+	// High s.
+	raw[SignatureSize] = 28
+	s := new(big.Int).Sub(curveN, new(big.Int).SetBytes(raw[32:64]))
+	s.FillBytes(raw[32:64])
+	raw[SignatureSize] = 27
+	normalized, recoveryID, err := ParseEthSignature(raw)
+	require.NoError(t, err)
+	require.Equal(t, sig, normalized)
+	require.Equal(t, uint8(1), recoveryID)
+
+	require.Error(t, Verify(address, []byte("Some data"), sig, 1))
+}
+
+func TestNewSecp256k1Instruction(t *testing.T) {
+	first, err := signMessage(testKey(t), []byte("hello"))
+	require.NoError(t, err)
+	address, err := EthAddressFromHex(testAddress)
+	require.NoError(t, err)
+	second, err := NewSignedMessage(address, EthSignedMessage([]byte("Some data")), mustHex(t, testSignature))
+	require.NoError(t, err)
+
+	instruction, err := NewSecp256k1Instruction(2, first, second)
+	require.NoError(t, err)
+	require.Equal(t, solana.Secp256k1ProgramID, instruction.ProgramID())
+	data, err := instruction.Data()
+	require.NoError(t, err)
+
+	offsets, err := DecodeInstruction(data)
+	require.NoError(t, err)
+	require.Equal(t, []SignatureOffsets{
+		{
+			SignatureOffset:            23 + 20,
+			SignatureInstructionIndex:  2,
+			EthAddressOffset:           23,
+			EthAddressInstructionIndex: 2,
+			MessageDataOffset:          23 + 20 + 65,
+			MessageDataSize:            5,
+			MessageInstructionIndex:    2,
+		},
+		{
+			SignatureOffset:            113 + 20,
+			SignatureInstructionIndex:  2,
+			EthAddressOffset:           113,
+			EthAddressInstructionIndex: 2,
+			MessageDataOffset:          113 + 20 + 65,
+			MessageDataSize:            uint16(len(second.Message)),
+			MessageInstructionIndex:    2,
+		},
+	}, offsets)
+	require.Equal(t, len(data), 113+20+65+len(second.Message))
+
+	require.NoError(t, VerifyInstruction(data, nil))
+	require.NoError(t, VerifyInstruction(data, [][]byte{nil, nil, data}))
+	require.Error(t, VerifyInstruction(data, [][]byte{data}))
+
+	// Tampered message.
+	data[len(data)-1]++
+	require.Error(t, VerifyInstruction(data, nil))
+
+	_, err = NewSecp256k1Instruction(0)
+	require.Error(t, err)
+	_, err = DecodeInstruction([]byte{0, 1})
+	require.Error(t, err)
+	_, err = DecodeInstruction([]byte{2, 0})
+	require.Error(t, err)
+}
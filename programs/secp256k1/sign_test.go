@@ -0,0 +1,127 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secp256k1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/gagliardetto/solana-go/hashutil"
+)
+
+// Size of a private key.
+const privateKeySize = 32
+
+// publicKeyFromPrivateKey returns the uncompressed public key of the private key.
+func publicKeyFromPrivateKey(privateKey [privateKeySize]byte) ([PublicKeySize]byte, error) {
+	d, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return [PublicKeySize]byte{}, err
+	}
+	return generator.mul(d).serialize(), nil
+}
+
+func parsePrivateKey(privateKey [privateKeySize]byte) (*big.Int, error) {
+	d := new(big.Int).SetBytes(privateKey[:])
+	if d.Sign() == 0 || d.Cmp(curveN) >= 0 {
+		return nil, errors.New("invalid private key")
+	}
+	return d, nil
+}
+
+// signMessage signs the message (hashed with keccak256) with the private key, with a
+// deterministic nonce (RFC 6979) and a low s, like the Ethereum wallets.
+// It is not constant-time, and only signs the messages of the tests.
+func signMessage(privateKey [privateKeySize]byte, message []byte) (*SignedMessage, error) {
+	d, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	r, s, recoveryID, err := sign(d, hashutil.Keccak256(message))
+	if err != nil {
+		return nil, err
+	}
+	signed := &SignedMessage{
+		EthAddress: ethAddress(generator.mul(d).serialize()),
+		RecoveryID: recoveryID,
+		Message:    message,
+	}
+	r.FillBytes(signed.Signature[:32])
+	s.FillBytes(signed.Signature[32:])
+	return signed, nil
+}
+
+// nonceRFC6979 returns the deterministic nonce of RFC 6979 (with HMAC-SHA256)
+// of the signature of the hash by the private key d.
+func nonceRFC6979(d *big.Int, hash [32]byte) *big.Int {
+	var x, h [32]byte
+	d.FillBytes(x[:])
+	mod(new(big.Int).SetBytes(hash[:]), curveN).FillBytes(h[:])
+
+	mac := func(key []byte, data ...[]byte) []byte {
+		m := hmac.New(sha256.New, key)
+		for _, b := range data {
+			m.Write(b)
+		}
+		return m.Sum(nil)
+	}
+	v := make([]byte, 32)
+	k := make([]byte, 32)
+	for i := range v {
+		v[i] = 1
+	}
+	k = mac(k, v, []byte{0}, x[:], h[:])
+	v = mac(k, v)
+	k = mac(k, v, []byte{1}, x[:], h[:])
+	v = mac(k, v)
+	for {
+		v = mac(k, v)
+		nonce := new(big.Int).SetBytes(v)
+		if nonce.Sign() > 0 && nonce.Cmp(curveN) < 0 {
+			return nonce
+		}
+		k = mac(k, v, []byte{0})
+		v = mac(k, v)
+	}
+}
+
+// sign returns the signature (r, s) of the hash by the private key d,
+// with a low s, and its recovery id.
+func sign(d *big.Int, hash [32]byte) (r, s *big.Int, recoveryID uint8, err error) {
+	e := new(big.Int).SetBytes(hash[:])
+	k := nonceRFC6979(d, hash)
+	R := generator.mul(k)
+	r = mod(new(big.Int).Set(R.x), curveN)
+	recoveryID = uint8(R.y.Bit(0))
+	if R.x.Cmp(curveN) >= 0 {
+		recoveryID |= 2
+	}
+	// s = k^-1 (e + r d)
+	s = new(big.Int).Mul(r, d)
+	s.Add(s, e)
+	s.Mul(s, new(big.Int).ModInverse(k, curveN))
+	mod(s, curveN)
+	if r.Sign() == 0 || s.Sign() == 0 {
+		// Negligible probability.
+		return nil, nil, 0, errors.New("invalid nonce")
+	}
+	if s.Cmp(halfN) > 0 {
+		s.Sub(curveN, s)
+		recoveryID ^= 1
+	}
+	return r, s, recoveryID, nil
+}
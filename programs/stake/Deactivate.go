@@ -0,0 +1,145 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Deactivate deactivates the stake of a delegated stake account;
+// the lamports can be withdrawn once the stake is inactive.
+type Deactivate struct {
+
+	// [0] = [WRITE] StakeAccount
+	// ··········· Delegated stake account
+	//
+	// [1] = [] $(SysVarClockPubkey)
+	// ··········· Clock sysvar
+	//
+	// [2] = [SIGNER] StakerAccount
+	// ··········· Staker authority
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewDeactivateInstructionBuilder creates a new `Deactivate` instruction builder.
+func NewDeactivateInstructionBuilder() *Deactivate {
+	nd := &Deactivate{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 3),
+	}
+	nd.AccountMetaSlice[1] = ag_solanago.Meta(ag_solanago.SysVarClockPubkey)
+	return nd
+}
+
+// Delegated stake account
+func (inst *Deactivate) SetStakeAccount(stakeAccount ag_solanago.PublicKey) *Deactivate {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(stakeAccount).WRITE()
+	return inst
+}
+
+func (inst *Deactivate) GetStakeAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Clock sysvar
+func (inst *Deactivate) SetSysVarClockPubkeyAccount(SysVarClockPubkey ag_solanago.PublicKey) *Deactivate {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(SysVarClockPubkey)
+	return inst
+}
+
+func (inst *Deactivate) GetSysVarClockPubkeyAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+// Staker authority
+func (inst *Deactivate) SetStakerAccount(stakerAccount ag_solanago.PublicKey) *Deactivate {
+	inst.AccountMetaSlice[2] = ag_solanago.Meta(stakerAccount).SIGNER()
+	return inst
+}
+
+func (inst *Deactivate) GetStakerAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[2]
+}
+
+func (inst Deactivate) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_Deactivate, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst Deactivate) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *Deactivate) Validate() error {
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *Deactivate) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("Deactivate")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("      Stake", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("SysVarClock", inst.AccountMetaSlice[1]))
+						accountsBranch.Child(ag_format.Meta("     Staker", inst.AccountMetaSlice[2]))
+					})
+				})
+		})
+}
+
+func (inst Deactivate) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	return nil
+}
+
+func (inst *Deactivate) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	return nil
+}
+
+// NewDeactivateInstruction declares a new Deactivate instruction with the provided parameters and accounts.
+func NewDeactivateInstruction(
+	// Accounts:
+	stakeAccount ag_solanago.PublicKey,
+	stakerAccount ag_solanago.PublicKey) *Deactivate {
+	return NewDeactivateInstructionBuilder().
+		SetStakeAccount(stakeAccount).
+		SetStakerAccount(stakerAccount)
+}
@@ -0,0 +1,190 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_format "github.com/gagliardetto/solana-go/text/format"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// DelegateStake delegates a stake account to a vote account.
+type DelegateStake struct {
+
+	// [0] = [WRITE] StakeAccount
+	// ··········· Initialized stake account
+	//
+	// [1] = [] VoteAccount
+	// ··········· Vote account to delegate to
+	//
+	// [2] = [] $(SysVarClockPubkey)
+	// ··········· Clock sysvar
+	//
+	// [3] = [] $(SysVarStakeHistoryPubkey)
+	// ··········· Stake history sysvar
+	//
+	// [4] = [] $(StakeConfigID)
+	// ··········· Stake config account
+	//
+	// [5] = [SIGNER] StakerAccount
+	// ··········· Staker authority
+	ag_solanago.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewDelegateStakeInstructionBuilder creates a new `DelegateStake` instruction builder.
+func NewDelegateStakeInstructionBuilder() *DelegateStake {
+	nd := &DelegateStake{
+		AccountMetaSlice: make(ag_solanago.AccountMetaSlice, 6),
+	}
+	nd.AccountMetaSlice[2] = ag_solanago.Meta(ag_solanago.SysVarClockPubkey)
+	nd.AccountMetaSlice[3] = ag_solanago.Meta(ag_solanago.SysVarStakeHistoryPubkey)
+	nd.AccountMetaSlice[4] = ag_solanago.Meta(StakeConfigID)
+	return nd
+}
+
+// Initialized stake account
+func (inst *DelegateStake) SetStakeAccount(stakeAccount ag_solanago.PublicKey) *DelegateStake {
+	inst.AccountMetaSlice[0] = ag_solanago.Meta(stakeAccount).WRITE()
+	return inst
+}
+
+func (inst *DelegateStake) GetStakeAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Vote account to delegate to
+func (inst *DelegateStake) SetVoteAccount(voteAccount ag_solanago.PublicKey) *DelegateStake {
+	inst.AccountMetaSlice[1] = ag_solanago.Meta(voteAccount)
+	return inst
+}
+
+func (inst *DelegateStake) GetVoteAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[1]
+}
+
+// Clock sysvar
+func (inst *DelegateStake) SetSysVarClockPubkeyAccount(SysVarClockPubkey ag_solanago.PublicKey) *DelegateStake {
+	inst.AccountMetaSlice[2] = ag_solanago.Meta(SysVarClockPubkey)
+	return inst
+}
+
+func (inst *DelegateStake) GetSysVarClockPubkeyAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[2]
+}
+
+// Stake history sysvar
+func (inst *DelegateStake) SetSysVarStakeHistoryPubkeyAccount(SysVarStakeHistoryPubkey ag_solanago.PublicKey) *DelegateStake {
+	inst.AccountMetaSlice[3] = ag_solanago.Meta(SysVarStakeHistoryPubkey)
+	return inst
+}
+
+func (inst *DelegateStake) GetSysVarStakeHistoryPubkeyAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[3]
+}
+
+// Stake config account
+func (inst *DelegateStake) SetStakeConfigAccount(stakeConfigAccount ag_solanago.PublicKey) *DelegateStake {
+	inst.AccountMetaSlice[4] = ag_solanago.Meta(stakeConfigAccount)
+	return inst
+}
+
+func (inst *DelegateStake) GetStakeConfigAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[4]
+}
+
+// Staker authority
+func (inst *DelegateStake) SetStakerAccount(stakerAccount ag_solanago.PublicKey) *DelegateStake {
+	inst.AccountMetaSlice[5] = ag_solanago.Meta(stakerAccount).SIGNER()
+	return inst
+}
+
+func (inst *DelegateStake) GetStakerAccount() *ag_solanago.AccountMeta {
+	return inst.AccountMetaSlice[5]
+}
+
+func (inst DelegateStake) Build() *Instruction {
+	return &Instruction{BaseVariant: ag_binary.BaseVariant{
+		Impl:   inst,
+		TypeID: ag_binary.TypeIDFromUint32(Instruction_DelegateStake, binary.LittleEndian),
+	}}
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (inst DelegateStake) ValidateAndBuild() (*Instruction, error) {
+	if err := inst.Validate(); err != nil {
+		return nil, err
+	}
+	return inst.Build(), nil
+}
+
+func (inst *DelegateStake) Validate() error {
+	// Check whether all accounts are set:
+	for accIndex, acc := range inst.AccountMetaSlice {
+		if acc == nil {
+			return fmt.Errorf("ins.AccountMetaSlice[%v] is not set", accIndex)
+		}
+	}
+	return nil
+}
+
+func (inst *DelegateStake) EncodeToTree(parent ag_treeout.Branches) {
+	parent.Child(ag_format.Program(ProgramName, ProgramID)).
+		//
+		ParentFunc(func(programBranch ag_treeout.Branches) {
+			programBranch.Child(ag_format.Instruction("DelegateStake")).
+				//
+				ParentFunc(func(instructionBranch ag_treeout.Branches) {
+
+					// Parameters of the instruction:
+					instructionBranch.Child("Params").ParentFunc(func(paramsBranch ag_treeout.Branches) {})
+
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts").ParentFunc(func(accountsBranch ag_treeout.Branches) {
+						accountsBranch.Child(ag_format.Meta("             Stake", inst.AccountMetaSlice[0]))
+						accountsBranch.Child(ag_format.Meta("              Vote", inst.AccountMetaSlice[1]))
+						accountsBranch.Child(ag_format.Meta("       SysVarClock", inst.AccountMetaSlice[2]))
+						accountsBranch.Child(ag_format.Meta("SysVarStakeHistory", inst.AccountMetaSlice[3]))
+						accountsBranch.Child(ag_format.Meta("       StakeConfig", inst.AccountMetaSlice[4]))
+						accountsBranch.Child(ag_format.Meta("            Staker", inst.AccountMetaSlice[5]))
+					})
+				})
+		})
+}
+
+func (inst DelegateStake) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	return nil
+}
+
+func (inst *DelegateStake) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	return nil
+}
+
+// NewDelegateStakeInstruction declares a new DelegateStake instruction with the provided parameters and accounts.
+func NewDelegateStakeInstruction(
+	// Accounts:
+	stakeAccount ag_solanago.PublicKey,
+	voteAccount ag_solanago.PublicKey,
+	stakerAccount ag_solanago.PublicKey) *DelegateStake {
+	return NewDelegateStakeInstructionBuilder().
+		SetStakeAccount(stakeAccount).
+		SetVoteAccount(voteAccount).
+		SetStakerAccount(stakerAccount)
+}
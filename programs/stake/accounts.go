@@ -0,0 +1,291 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+var ProgramID solana.PublicKey = solana.StakeProgramID
+
+const ProgramName = "Stake"
+
+// AccountSize is the size of the data of a stake account.
+const AccountSize = 200
+
+// Offsets of the authorities in the data of a stake account
+// (after the state discriminator and the rent-exempt reserve),
+// e.g. for getProgramAccounts memcmp filters.
+const (
+	StakerOffset     = 12
+	WithdrawerOffset = 44
+)
+
+// StakeStateType is the discriminator of the state of a stake account.
+type StakeStateType uint32
+
+const (
+	StakeStateUninitialized StakeStateType = iota
+	StakeStateInitialized
+	StakeStateStake
+	StakeStateRewardsPool
+)
+
+func (t StakeStateType) String() string {
+	switch t {
+	case StakeStateUninitialized:
+		return "Uninitialized"
+	case StakeStateInitialized:
+		return "Initialized"
+	case StakeStateStake:
+		return "Stake"
+	case StakeStateRewardsPool:
+		return "RewardsPool"
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint32(t))
+	}
+}
+
+// StakeAccount is the state of a stake account.
+type StakeAccount struct {
+	Type StakeStateType
+
+	// Set if Type is StakeStateInitialized or StakeStateStake.
+	Meta *Meta
+
+	// Set if Type is StakeStateStake.
+	Stake *Stake
+
+	// Set if Type is StakeStateStake.
+	StakeFlags uint8
+}
+
+type Meta struct {
+	RentExemptReserve uint64
+	Authorized        Authorized
+	Lockup            Lockup
+}
+
+type Authorized struct {
+	Staker     solana.PublicKey
+	Withdrawer solana.PublicKey
+}
+
+type Lockup struct {
+	// The stake cannot be withdrawn before this timestamp
+	// or epoch, unless the transaction is signed by the custodian.
+	UnixTimestamp int64
+	Epoch         uint64
+	Custodian     solana.PublicKey
+}
+
+type Stake struct {
+	Delegation      Delegation
+	CreditsObserved uint64
+}
+
+type Delegation struct {
+	// The vote account the stake is delegated to.
+	VoterPubkey solana.PublicKey
+
+	// The activated stake, in lamports.
+	Stake uint64
+
+	ActivationEpoch uint64
+
+	// math.MaxUint64 if the stake is not deactivated.
+	DeactivationEpoch uint64
+
+	// Deprecated.
+	WarmupCooldownRate float64
+}
+
+// IsDeactivating returns true if the delegation was deactivated.
+func (d *Delegation) IsDeactivating() bool {
+	return d.DeactivationEpoch != ^uint64(0)
+}
+
+// DecodeStakeAccount decodes the data of a stake account.
+func DecodeStakeAccount(data []byte) (*StakeAccount, error) {
+	acc := new(StakeAccount)
+	if err := bin.NewBinDecoder(data).Decode(acc); err != nil {
+		return nil, fmt.Errorf("unable to decode stake account: %w", err)
+	}
+	return acc, nil
+}
+
+func (obj StakeAccount) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	err = encoder.WriteUint32(uint32(obj.Type), binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	if obj.Type != StakeStateInitialized && obj.Type != StakeStateStake {
+		return nil
+	}
+	if obj.Meta == nil {
+		return fmt.Errorf("Meta is required for state %s", obj.Type)
+	}
+	if err = obj.Meta.MarshalWithEncoder(encoder); err != nil {
+		return err
+	}
+	if obj.Type != StakeStateStake {
+		return nil
+	}
+	if obj.Stake == nil {
+		return fmt.Errorf("Stake is required for state %s", obj.Type)
+	}
+	if err = obj.Stake.MarshalWithEncoder(encoder); err != nil {
+		return err
+	}
+	return encoder.WriteUint8(obj.StakeFlags)
+}
+
+func (obj *StakeAccount) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
+	typ, err := decoder.ReadUint32(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	obj.Type = StakeStateType(typ)
+	switch obj.Type {
+	case StakeStateUninitialized, StakeStateRewardsPool:
+		return nil
+	case StakeStateInitialized, StakeStateStake:
+	default:
+		return fmt.Errorf("unknown stake state: %d", typ)
+	}
+	obj.Meta = new(Meta)
+	if err = obj.Meta.UnmarshalWithDecoder(decoder); err != nil {
+		return err
+	}
+	if obj.Type != StakeStateStake {
+		return nil
+	}
+	obj.Stake = new(Stake)
+	if err = obj.Stake.UnmarshalWithDecoder(decoder); err != nil {
+		return err
+	}
+	// The stake flags were added in v2 of the state.
+	if decoder.Remaining() > 0 {
+		obj.StakeFlags, err = decoder.ReadUint8()
+	}
+	return err
+}
+
+func (obj Meta) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	err = encoder.WriteUint64(obj.RentExemptReserve, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteBytes(obj.Authorized.Staker[:], false)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteBytes(obj.Authorized.Withdrawer[:], false)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteInt64(obj.Lockup.UnixTimestamp, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteUint64(obj.Lockup.Epoch, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	return encoder.WriteBytes(obj.Lockup.Custodian[:], false)
+}
+
+func (obj *Meta) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
+	obj.RentExemptReserve, err = decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	if obj.Authorized.Staker, err = readPublicKey(decoder); err != nil {
+		return err
+	}
+	if obj.Authorized.Withdrawer, err = readPublicKey(decoder); err != nil {
+		return err
+	}
+	obj.Lockup.UnixTimestamp, err = decoder.ReadInt64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	obj.Lockup.Epoch, err = decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	obj.Lockup.Custodian, err = readPublicKey(decoder)
+	return err
+}
+
+func (obj Stake) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	err = encoder.WriteBytes(obj.Delegation.VoterPubkey[:], false)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteUint64(obj.Delegation.Stake, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteUint64(obj.Delegation.ActivationEpoch, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteUint64(obj.Delegation.DeactivationEpoch, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteFloat64(obj.Delegation.WarmupCooldownRate, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	return encoder.WriteUint64(obj.CreditsObserved, binary.LittleEndian)
+}
+
+func (obj *Stake) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
+	if obj.Delegation.VoterPubkey, err = readPublicKey(decoder); err != nil {
+		return err
+	}
+	obj.Delegation.Stake, err = decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	obj.Delegation.ActivationEpoch, err = decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	obj.Delegation.DeactivationEpoch, err = decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	obj.Delegation.WarmupCooldownRate, err = decoder.ReadFloat64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	obj.CreditsObserved, err = decoder.ReadUint64(binary.LittleEndian)
+	return err
+}
+
+func readPublicKey(decoder *bin.Decoder) (solana.PublicKey, error) {
+	buf, err := decoder.ReadNBytes(32)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	return solana.PublicKeyFromBytes(buf), nil
+}
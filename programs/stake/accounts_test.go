@@ -0,0 +1,74 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"bytes"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeStakeAccount(t *testing.T, acc *StakeAccount) []byte {
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(acc))
+	data := buf.Bytes()
+	if len(data) < AccountSize {
+		data = append(data, make([]byte, AccountSize-len(data))...)
+	}
+	return data
+}
+
+func TestStakeAccount(t *testing.T) {
+	staker := solana.PublicKey{1}
+	withdrawer := solana.PublicKey{2}
+	acc := &StakeAccount{
+		Type: StakeStateStake,
+		Meta: &Meta{
+			RentExemptReserve: 2282880,
+			Authorized:        Authorized{Staker: staker, Withdrawer: withdrawer},
+			Lockup:            Lockup{UnixTimestamp: 10, Epoch: 20, Custodian: solana.PublicKey{3}},
+		},
+		Stake: &Stake{
+			Delegation: Delegation{
+				VoterPubkey:        solana.PublicKey{4},
+				Stake:              1000000000,
+				ActivationEpoch:    500,
+				DeactivationEpoch:  ^uint64(0),
+				WarmupCooldownRate: 0.25,
+			},
+			CreditsObserved: 42,
+		},
+	}
+	data := encodeStakeAccount(t, acc)
+	require.Len(t, data, AccountSize)
+	require.Equal(t, staker[:], data[StakerOffset:StakerOffset+32])
+	require.Equal(t, withdrawer[:], data[WithdrawerOffset:WithdrawerOffset+32])
+
+	decoded, err := DecodeStakeAccount(data)
+	require.NoError(t, err)
+	require.Equal(t, acc, decoded)
+	require.False(t, decoded.Stake.Delegation.IsDeactivating())
+
+	initialized, err := DecodeStakeAccount(encodeStakeAccount(t, &StakeAccount{Type: StakeStateInitialized, Meta: acc.Meta}))
+	require.NoError(t, err)
+	require.Equal(t, StakeStateInitialized, initialized.Type)
+	require.Nil(t, initialized.Stake)
+
+	_, err = DecodeStakeAccount([]byte{9, 0, 0, 0})
+	require.EqualError(t, err, "unable to decode stake account: unknown stake state: 9")
+}
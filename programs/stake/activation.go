@@ -0,0 +1,167 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// Fraction of the cluster-wide effective stake that can be
+// activated or deactivated in an epoch.
+const (
+	DefaultWarmupCooldownRate = 0.25
+	// Since the activation of the reduce_stake_warmup_cooldown feature.
+	NewWarmupCooldownRate = 0.09
+)
+
+// WarmupCooldownRate returns the warmup and cooldown rate at the epoch.
+// newRateActivationEpoch is the epoch of the activation of the
+// reduce_stake_warmup_cooldown feature, nil if it is not active.
+func WarmupCooldownRate(epoch uint64, newRateActivationEpoch *uint64) float64 {
+	if newRateActivationEpoch == nil || epoch < *newRateActivationEpoch {
+		return DefaultWarmupCooldownRate
+	}
+	return NewWarmupCooldownRate
+}
+
+// StakeActivationStatus is the effective, activating
+// and deactivating stake of a delegation at an epoch.
+type StakeActivationStatus struct {
+	Effective    uint64
+	Activating   uint64
+	Deactivating uint64
+}
+
+// State returns the activation state, as returned by getStakeActivation.
+func (status StakeActivationStatus) State() rpc.ActivationStateType {
+	switch {
+	case status.Deactivating > 0:
+		return rpc.ActivationStateDeactivating
+	case status.Activating > 0:
+		return rpc.ActivationStateActivating
+	case status.Effective > 0:
+		return rpc.ActivationStateActive
+	default:
+		return rpc.ActivationStateInactive
+	}
+}
+
+// IsBootstrap returns true for the stake delegated in the genesis,
+// which is active from the start.
+func (d *Delegation) IsBootstrap() bool {
+	return d.ActivationEpoch == ^uint64(0)
+}
+
+// ActivationStatus computes the effective, activating and deactivating stake
+// of the delegation at the target epoch, from the cluster-wide stake history,
+// as the runtime does: every epoch, the activating (or deactivating) stake
+// gets its share of the warmup (or cooldown) allowance of the cluster.
+//
+// The history must contain the epochs from the activation (or deactivation)
+// up to the one before the target epoch; see WarmupCooldownRate
+// for newRateActivationEpoch.
+func (d *Delegation) ActivationStatus(targetEpoch uint64, history StakeHistory, newRateActivationEpoch *uint64) StakeActivationStatus {
+	effective, activating := d.stakeAndActivating(targetEpoch, history, newRateActivationEpoch)
+
+	switch {
+	case targetEpoch < d.DeactivationEpoch:
+		return StakeActivationStatus{Effective: effective, Activating: activating}
+	case targetEpoch == d.DeactivationEpoch:
+		return StakeActivationStatus{Effective: effective, Deactivating: effective}
+	}
+
+	prevEpoch := d.DeactivationEpoch
+	prev := history.Get(prevEpoch)
+	if prev == nil {
+		// No history: the stake is considered fully deactivated.
+		return StakeActivationStatus{}
+	}
+	current := effective
+	for {
+		epoch := prevEpoch + 1
+		if prev.Deactivating == 0 {
+			break
+		}
+		weight := float64(current) / float64(prev.Deactivating)
+		newlyNotEffectiveCluster := float64(prev.Effective) * WarmupCooldownRate(epoch, newRateActivationEpoch)
+		newlyNotEffective := maxUint64(uint64(weight*newlyNotEffectiveCluster), 1)
+		if newlyNotEffective >= current {
+			current = 0
+			break
+		}
+		current -= newlyNotEffective
+		if epoch >= targetEpoch {
+			break
+		}
+		if prev = history.Get(epoch); prev == nil {
+			break
+		}
+		prevEpoch = epoch
+	}
+	return StakeActivationStatus{Effective: current, Deactivating: current}
+}
+
+// stakeAndActivating returns the effective and the activating stake at the
+// target epoch, ignoring the deactivation.
+func (d *Delegation) stakeAndActivating(targetEpoch uint64, history StakeHistory, newRateActivationEpoch *uint64) (uint64, uint64) {
+	delegated := d.Stake
+	switch {
+	case d.IsBootstrap():
+		return delegated, 0
+	case d.ActivationEpoch == d.DeactivationEpoch:
+		// Deactivated in the epoch of the activation.
+		return 0, 0
+	case targetEpoch == d.ActivationEpoch:
+		return 0, delegated
+	case targetEpoch < d.ActivationEpoch:
+		return 0, 0
+	}
+
+	prevEpoch := d.ActivationEpoch
+	prev := history.Get(prevEpoch)
+	if prev == nil {
+		// No history: the stake is considered fully active.
+		return delegated, 0
+	}
+	var current uint64
+	for {
+		epoch := prevEpoch + 1
+		if prev.Activating == 0 {
+			break
+		}
+		weight := float64(delegated-current) / float64(prev.Activating)
+		newlyEffectiveCluster := float64(prev.Effective) * WarmupCooldownRate(epoch, newRateActivationEpoch)
+		current += maxUint64(uint64(weight*newlyEffectiveCluster), 1)
+		if current >= delegated {
+			current = delegated
+			break
+		}
+		if epoch >= targetEpoch || epoch >= d.DeactivationEpoch {
+			break
+		}
+		if prev = history.Get(epoch); prev == nil {
+			break
+		}
+		prevEpoch = epoch
+	}
+	return current, delegated - current
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"bytes"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStakeHistory(t *testing.T) {
+	history := StakeHistory{
+		{Epoch: 12, Effective: 300, Activating: 20, Deactivating: 10},
+		{Epoch: 11, Effective: 200},
+		{Epoch: 9, Effective: 100, Activating: 100},
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(history))
+	require.Len(t, buf.Bytes(), 8+3*32)
+
+	decoded, err := DecodeStakeHistory(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, history, decoded)
+
+	require.Equal(t, &history[0], decoded.Get(12))
+	require.Equal(t, &history[2], decoded.Get(9))
+	require.Nil(t, decoded.Get(10))
+	require.Nil(t, decoded.Get(13))
+	require.Nil(t, decoded.Get(1))
+
+	_, err = DecodeStakeHistory([]byte{2, 0, 0, 0, 0, 0, 0, 0, 1})
+	require.EqualError(t, err, "unable to decode stake history: invalid stake history length: 2")
+}
+
+func TestDelegation_ActivationStatus(t *testing.T) {
+	// A delegation of 100 activated at epoch 10, deactivated at epoch 14,
+	// the only stake moving in the cluster.
+	history := StakeHistory{
+		{Epoch: 16, Effective: 113, Deactivating: 13},
+		{Epoch: 15, Effective: 150, Deactivating: 50},
+		{Epoch: 14, Effective: 200, Deactivating: 100},
+		{Epoch: 13, Effective: 195, Activating: 5},
+		{Epoch: 12, Effective: 156, Activating: 44},
+		{Epoch: 11, Effective: 125, Activating: 75},
+		{Epoch: 10, Effective: 100, Activating: 100},
+	}
+	delegation := &Delegation{Stake: 100, ActivationEpoch: 10, DeactivationEpoch: 14}
+
+	cases := []struct {
+		epoch  uint64
+		status StakeActivationStatus
+		state  rpc.ActivationStateType
+	}{
+		{9, StakeActivationStatus{}, rpc.ActivationStateInactive},
+		{10, StakeActivationStatus{Activating: 100}, rpc.ActivationStateActivating},
+		{11, StakeActivationStatus{Effective: 25, Activating: 75}, rpc.ActivationStateActivating},
+		{12, StakeActivationStatus{Effective: 56, Activating: 44}, rpc.ActivationStateActivating},
+		{13, StakeActivationStatus{Effective: 95, Activating: 5}, rpc.ActivationStateActivating},
+		{14, StakeActivationStatus{Effective: 100, Deactivating: 100}, rpc.ActivationStateDeactivating},
+		{15, StakeActivationStatus{Effective: 50, Deactivating: 50}, rpc.ActivationStateDeactivating},
+		{16, StakeActivationStatus{Effective: 13, Deactivating: 13}, rpc.ActivationStateDeactivating},
+		{17, StakeActivationStatus{}, rpc.ActivationStateInactive},
+	}
+	for _, c := range cases {
+		status := delegation.ActivationStatus(c.epoch, history, nil)
+		require.Equal(t, c.status, status, "epoch %d", c.epoch)
+		require.Equal(t, c.state, status.State(), "epoch %d", c.epoch)
+	}
+
+	// Not deactivated.
+	active := &Delegation{Stake: 100, ActivationEpoch: 10, DeactivationEpoch: ^uint64(0)}
+	require.Equal(t, StakeActivationStatus{Effective: 100}, active.ActivationStatus(20, history, nil))
+	require.Equal(t, rpc.ActivationStateActive, active.ActivationStatus(20, history, nil).State())
+
+	// The reduced rate from epoch 12: 125*0.09 = 11 newly effective in epoch 12.
+	newRateEpoch := uint64(12)
+	require.Equal(t, StakeActivationStatus{Effective: 36, Activating: 64}, active.ActivationStatus(12, history, &newRateEpoch))
+
+	// Without history, the stake is fully active, then fully inactive.
+	require.Equal(t, StakeActivationStatus{Effective: 100}, active.ActivationStatus(12, nil, nil))
+	require.Equal(t, StakeActivationStatus{}, delegation.ActivationStatus(15, nil, nil))
+
+	bootstrap := &Delegation{Stake: 100, ActivationEpoch: ^uint64(0), DeactivationEpoch: ^uint64(0)}
+	require.Equal(t, StakeActivationStatus{Effective: 100}, bootstrap.ActivationStatus(0, nil, nil))
+
+	cancelled := &Delegation{Stake: 100, ActivationEpoch: 10, DeactivationEpoch: 10}
+	require.Equal(t, StakeActivationStatus{}, cancelled.ActivationStatus(12, history, nil))
+}
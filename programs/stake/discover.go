@@ -0,0 +1,103 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// AuthorityRole selects which authority of the stake accounts to match.
+type AuthorityRole int
+
+const (
+	// Match the staker or the withdrawer authority.
+	AuthorityAny AuthorityRole = iota
+	AuthorityStaker
+	AuthorityWithdrawer
+)
+
+// KeyedStakeAccount is a stake account with its address and balance.
+type KeyedStakeAccount struct {
+	Address  solana.PublicKey
+	Lamports uint64
+	State    *StakeAccount
+}
+
+// FindStakeAccountsByAuthority returns the stake accounts whose staker and/or
+// withdrawer authority (depending on the role) is the provided authority,
+// with their decoded state.
+func FindStakeAccountsByAuthority(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	authority solana.PublicKey,
+	role AuthorityRole,
+	commitment rpc.CommitmentType,
+) ([]*KeyedStakeAccount, error) {
+	var offsets []uint64
+	switch role {
+	case AuthorityAny:
+		offsets = []uint64{StakerOffset, WithdrawerOffset}
+	case AuthorityStaker:
+		offsets = []uint64{StakerOffset}
+	case AuthorityWithdrawer:
+		offsets = []uint64{WithdrawerOffset}
+	default:
+		return nil, fmt.Errorf("invalid authority role: %d", role)
+	}
+
+	var out []*KeyedStakeAccount
+	seen := make(map[solana.PublicKey]struct{})
+	for _, offset := range offsets {
+		accounts, err := rpcClient.GetProgramAccountsWithOpts(ctx, ProgramID, &rpc.GetProgramAccountsOpts{
+			Commitment: commitment,
+			Encoding:   solana.EncodingBase64,
+			Filters: []rpc.RPCFilter{
+				{DataSize: AccountSize},
+				{
+					Memcmp: &rpc.RPCFilterMemcmp{
+						Offset: offset,
+						Bytes:  solana.Base58(authority[:]),
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, account := range accounts {
+			if account == nil || account.Account == nil {
+				continue
+			}
+			if _, ok := seen[account.Pubkey]; ok {
+				continue
+			}
+			seen[account.Pubkey] = struct{}{}
+			state, err := DecodeStakeAccount(account.Account.Data.GetBinary())
+			if err != nil {
+				return nil, fmt.Errorf("stake account %s: %w", account.Pubkey, err)
+			}
+			out = append(out, &KeyedStakeAccount{
+				Address:  account.Pubkey,
+				Lamports: account.Account.Lamports,
+				State:    state,
+			})
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// stakeNode is a JSONRPCClient that serves stake accounts,
+// applying the memcmp filters of getProgramAccounts.
+type stakeNode struct {
+	t        *testing.T
+	accounts map[solana.PublicKey][]byte
+}
+
+func (node *stakeNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if method != "getProgramAccounts" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	filters := params[1].(rpc.M)["filters"].([]rpc.RPCFilter)
+	require.Len(node.t, filters, 2)
+	require.Equal(node.t, uint64(AccountSize), filters[0].DataSize)
+	memcmp := filters[1].Memcmp
+
+	var values []string
+	for address, data := range node.accounts {
+		if !strings.HasPrefix(string(data[memcmp.Offset:]), string(memcmp.Bytes)) {
+			continue
+		}
+		values = append(values, fmt.Sprintf(
+			`{"pubkey":%q,"account":{"lamports":3000000,"owner":%q,"data":[%q,"base64"],"executable":false,"rentEpoch":0}}`,
+			address, ProgramID, base64.StdEncoding.EncodeToString(data),
+		))
+	}
+	return json.Unmarshal([]byte("["+strings.Join(values, ",")+"]"), out)
+}
+
+func (node *stakeNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestFindStakeAccountsByAuthority(t *testing.T) {
+	authority := solana.PublicKey{1}
+	other := solana.PublicKey{2}
+	newAccount := func(staker solana.PublicKey, withdrawer solana.PublicKey) []byte {
+		return encodeStakeAccount(t, &StakeAccount{
+			Type: StakeStateInitialized,
+			Meta: &Meta{Authorized: Authorized{Staker: staker, Withdrawer: withdrawer}},
+		})
+	}
+	both := solana.PublicKey{10}
+	stakerOnly := solana.PublicKey{11}
+	withdrawerOnly := solana.PublicKey{12}
+	node := &stakeNode{
+		t: t,
+		accounts: map[solana.PublicKey][]byte{
+			both:                 newAccount(authority, authority),
+			stakerOnly:           newAccount(authority, other),
+			withdrawerOnly:       newAccount(other, authority),
+			solana.PublicKey{13}: newAccount(other, other),
+		},
+	}
+	client := rpc.NewWithCustomRPCClient(node)
+
+	find := func(role AuthorityRole) map[solana.PublicKey]*KeyedStakeAccount {
+		accounts, err := FindStakeAccountsByAuthority(context.Background(), client, authority, role, "")
+		require.NoError(t, err)
+		out := make(map[solana.PublicKey]*KeyedStakeAccount)
+		for _, account := range accounts {
+			out[account.Address] = account
+		}
+		require.Len(t, out, len(accounts))
+		return out
+	}
+
+	all := find(AuthorityAny)
+	require.Len(t, all, 3)
+	require.Contains(t, all, both)
+	require.Contains(t, all, stakerOnly)
+	require.Contains(t, all, withdrawerOnly)
+	require.Equal(t, uint64(3000000), all[both].Lamports)
+	require.Equal(t, StakeStateInitialized, all[both].State.Type)
+
+	stakers := find(AuthorityStaker)
+	require.Len(t, stakers, 2)
+	require.Contains(t, stakers, stakerOnly)
+
+	withdrawers := find(AuthorityWithdrawer)
+	require.Len(t, withdrawers, 2)
+	require.Contains(t, withdrawers, withdrawerOnly)
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// StakeHistoryEntry is the cluster-wide stake at an epoch.
+type StakeHistoryEntry struct {
+	Epoch uint64
+
+	// The effective stake, in lamports.
+	Effective uint64
+	// The stake being activated during the epoch.
+	Activating uint64
+	// The stake being deactivated during the epoch.
+	Deactivating uint64
+}
+
+// StakeHistory is the content of the StakeHistory sysvar:
+// the cluster-wide stake of the last 512 epochs, newest first.
+type StakeHistory []StakeHistoryEntry
+
+// DecodeStakeHistory decodes the data of the StakeHistory sysvar.
+func DecodeStakeHistory(data []byte) (StakeHistory, error) {
+	var history StakeHistory
+	if err := bin.NewBinDecoder(data).Decode(&history); err != nil {
+		return nil, fmt.Errorf("unable to decode stake history: %w", err)
+	}
+	return history, nil
+}
+
+// GetStakeHistory fetches and decodes the StakeHistory sysvar.
+func GetStakeHistory(ctx context.Context, client *rpc.Client, commitment rpc.CommitmentType) (StakeHistory, error) {
+	info, err := client.GetAccountInfoWithOpts(ctx, solana.SysVarStakeHistoryPubkey, &rpc.GetAccountInfoOpts{
+		Commitment: commitment,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get stake history: %w", err)
+	}
+	return DecodeStakeHistory(info.Value.Data.GetBinary())
+}
+
+// Get returns the entry of the epoch, or nil if it is not in the history.
+func (history StakeHistory) Get(epoch uint64) *StakeHistoryEntry {
+	i := sort.Search(len(history), func(i int) bool { return history[i].Epoch <= epoch })
+	if i < len(history) && history[i].Epoch == epoch {
+		return &history[i]
+	}
+	return nil
+}
+
+func (history StakeHistory) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	err = encoder.WriteUint64(uint64(len(history)), binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	for _, entry := range history {
+		for _, v := range []uint64{entry.Epoch, entry.Effective, entry.Activating, entry.Deactivating} {
+			if err = encoder.WriteUint64(v, binary.LittleEndian); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (history *StakeHistory) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
+	count, err := decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	if count > uint64(decoder.Remaining()/32) {
+		return fmt.Errorf("invalid stake history length: %d", count)
+	}
+	*history = make(StakeHistory, count)
+	for i := range *history {
+		entry := &(*history)[i]
+		for _, v := range []*uint64{&entry.Epoch, &entry.Effective, &entry.Activating, &entry.Deactivating} {
+			if *v, err = decoder.ReadUint64(binary.LittleEndian); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
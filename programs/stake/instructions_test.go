@@ -0,0 +1,122 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstructions(t *testing.T) {
+	stakeAccount := solana.PublicKey{1}
+	authority := solana.PublicKey{2}
+	vote := solana.PublicKey{3}
+
+	tests := []struct {
+		name     string
+		inst     *Instruction
+		data     []byte
+		accounts int
+	}{
+		{
+			name:     "Initialize",
+			inst:     NewInitializeInstruction(authority, authority, Lockup{}, stakeAccount).Build(),
+			data:     append([]byte{0, 0, 0, 0}, append(append(authority.Bytes(), authority.Bytes()...), make([]byte, 48)...)...),
+			accounts: 2,
+		},
+		{
+			name:     "Authorize",
+			inst:     NewAuthorizeInstruction(vote, StakeAuthorizeWithdrawer, stakeAccount, authority).Build(),
+			data:     append(append([]byte{1, 0, 0, 0}, vote.Bytes()...), 1, 0, 0, 0),
+			accounts: 3,
+		},
+		{
+			name:     "DelegateStake",
+			inst:     NewDelegateStakeInstruction(stakeAccount, vote, authority).Build(),
+			data:     []byte{2, 0, 0, 0},
+			accounts: 6,
+		},
+		{
+			name:     "Split",
+			inst:     NewSplitInstruction(10, stakeAccount, vote, authority).Build(),
+			data:     []byte{3, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0},
+			accounts: 3,
+		},
+		{
+			name:     "Withdraw",
+			inst:     NewWithdrawInstruction(10, stakeAccount, vote, authority).SetCustodianAccount(vote).Build(),
+			data:     []byte{4, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0},
+			accounts: 6,
+		},
+		{
+			name:     "Deactivate",
+			inst:     NewDeactivateInstruction(stakeAccount, authority).Build(),
+			data:     []byte{5, 0, 0, 0},
+			accounts: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, ProgramID, test.inst.ProgramID())
+			data, err := test.inst.Data()
+			require.NoError(t, err)
+			require.Equal(t, test.data, data)
+			require.Len(t, test.inst.Accounts(), test.accounts)
+
+			decoded, err := DecodeInstruction(test.inst.Accounts(), data)
+			require.NoError(t, err)
+			require.Equal(t, test.inst.TypeID, decoded.TypeID)
+			redata, err := decoded.Data()
+			require.NoError(t, err)
+			require.Equal(t, data, redata)
+		})
+	}
+}
+
+func TestInstructions_Validate(t *testing.T) {
+	stakeAccount := solana.PublicKey{1}
+	authority := solana.PublicKey{2}
+
+	_, err := NewSplitInstructionBuilder().
+		SetStakeAccount(stakeAccount).
+		SetSplitStakeAccount(stakeAccount).
+		SetStakerAccount(authority).
+		ValidateAndBuild()
+	require.EqualError(t, err, "Lamports parameter is not set")
+
+	_, err = NewWithdrawInstructionBuilder().
+		SetLamports(10).
+		SetStakeAccount(stakeAccount).
+		SetWithdrawerAccount(authority).
+		ValidateAndBuild()
+	require.EqualError(t, err, "ins.AccountMetaSlice[1] is not set")
+
+	_, err = NewAuthorizeInstructionBuilder().
+		SetNewAuthority(authority).
+		SetStakeAccount(stakeAccount).
+		SetAuthorityAccount(authority).
+		ValidateAndBuild()
+	require.EqualError(t, err, "StakeAuthorize parameter is not set")
+
+	inst, err := NewDelegateStakeInstructionBuilder().
+		SetStakeAccount(stakeAccount).
+		SetVoteAccount(solana.PublicKey{3}).
+		SetStakerAccount(authority).
+		ValidateAndBuild()
+	require.NoError(t, err)
+	require.Equal(t, StakeConfigID, inst.Accounts()[4].PublicKey)
+}
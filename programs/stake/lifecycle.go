@@ -0,0 +1,283 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// DelegationPhase is the phase of the lifecycle of a stake account.
+type DelegationPhase string
+
+const (
+	// The stake account is initialized, but not delegated.
+	PhaseUndelegated DelegationPhase = "undelegated"
+
+	PhaseActivating   DelegationPhase = DelegationPhase(rpc.ActivationStateActivating)
+	PhaseActive       DelegationPhase = DelegationPhase(rpc.ActivationStateActive)
+	PhaseDeactivating DelegationPhase = DelegationPhase(rpc.ActivationStateDeactivating)
+	PhaseInactive     DelegationPhase = DelegationPhase(rpc.ActivationStateInactive)
+
+	// The stake account was closed (i.e. all its lamports were withdrawn).
+	PhaseWithdrawn DelegationPhase = "withdrawn"
+)
+
+// DefaultLifecyclePollInterval is the default interval between
+// two checks of the stake account in Lifecycle.WaitFor.
+const DefaultLifecyclePollInterval = 30 * time.Second
+
+// DelegationStatus is the status of a stake account at an epoch.
+type DelegationStatus struct {
+	Phase DelegationPhase
+	Epoch uint64
+
+	// The balance of the stake account.
+	Lamports uint64
+
+	// The active and the inactive stake (only set when delegated).
+	ActiveStake   uint64
+	InactiveStake uint64
+}
+
+// DelegationEvent is emitted when the phase of
+// the stake account changes, or a new epoch starts.
+type DelegationEvent struct {
+	// The previous phase; empty for the first event.
+	Previous DelegationPhase
+	Status   *DelegationStatus
+}
+
+// LifecycleOpts are the options of a Lifecycle.
+type LifecycleOpts struct {
+	// Commitment of the requests.
+	// If not provided, the default is "confirmed".
+	Commitment rpc.CommitmentType
+
+	// The interval between two checks of the stake account in WaitFor.
+	// If not provided, the default is DefaultLifecyclePollInterval.
+	PollInterval time.Duration
+
+	// Pays the fees of the transactions; if not provided, the authority pays.
+	FeePayer solana.Signer
+
+	// Called on every state change. Optional.
+	OnEvent func(event *DelegationEvent)
+}
+
+// Lifecycle drives a stake account whose staker and withdrawer authority is
+// the same signer: delegation, activation, deactivation and withdrawal.
+type Lifecycle struct {
+	client       *rpc.Client
+	stakeAccount solana.PublicKey
+	authority    solana.Signer
+	commitment   rpc.CommitmentType
+	pollInterval time.Duration
+	feePayer     solana.Signer
+	onEvent      func(event *DelegationEvent)
+
+	last *DelegationStatus
+}
+
+// NewLifecycle creates a lifecycle of an existing stake account.
+func NewLifecycle(client *rpc.Client, stakeAccount solana.PublicKey, authority solana.Signer, opts *LifecycleOpts) *Lifecycle {
+	if opts == nil {
+		opts = &LifecycleOpts{}
+	}
+	lc := &Lifecycle{
+		client:       client,
+		stakeAccount: stakeAccount,
+		authority:    authority,
+		commitment:   opts.Commitment,
+		pollInterval: opts.PollInterval,
+		feePayer:     opts.FeePayer,
+		onEvent:      opts.OnEvent,
+	}
+	if lc.commitment == "" {
+		lc.commitment = rpc.CommitmentConfirmed
+	}
+	if lc.pollInterval <= 0 {
+		lc.pollInterval = DefaultLifecyclePollInterval
+	}
+	if lc.feePayer == nil {
+		lc.feePayer = authority
+	}
+	return lc
+}
+
+// StakeAccount returns the address of the stake account.
+func (lc *Lifecycle) StakeAccount() solana.PublicKey {
+	return lc.stakeAccount
+}
+
+// CreateAndDelegate creates the stake account (funded by the fee payer with
+// the provided lamports, which must include the rent-exempt reserve of
+// AccountSize bytes), initializes it with the authority as staker and
+// withdrawer, and delegates it to the vote account, in a single transaction.
+// The new stake account must sign the transaction.
+func (lc *Lifecycle) CreateAndDelegate(
+	ctx context.Context,
+	newStakeAccount solana.Signer,
+	lamports uint64,
+	voteAccount solana.PublicKey,
+) (solana.Signature, error) {
+	if !newStakeAccount.PublicKey().Equals(lc.stakeAccount) {
+		return solana.Signature{}, fmt.Errorf("expected stake account %s, got %s", lc.stakeAccount, newStakeAccount.PublicKey())
+	}
+	if reserve := solana.MinimumBalanceForRentExemption(AccountSize); lamports <= reserve {
+		return solana.Signature{}, fmt.Errorf("%d lamports do not exceed the rent-exempt reserve of %d", lamports, reserve)
+	}
+	authority := lc.authority.PublicKey()
+	return lc.send(ctx, []solana.Instruction{
+		system.NewCreateAccountInstruction(lamports, AccountSize, ProgramID, lc.feePayer.PublicKey(), lc.stakeAccount).Build(),
+		NewInitializeInstruction(authority, authority, Lockup{}, lc.stakeAccount).Build(),
+		NewDelegateStakeInstruction(lc.stakeAccount, voteAccount, authority).Build(),
+	}, newStakeAccount)
+}
+
+// Delegate delegates an undelegated (or inactive) stake account to the vote account.
+func (lc *Lifecycle) Delegate(ctx context.Context, voteAccount solana.PublicKey) (solana.Signature, error) {
+	return lc.send(ctx, []solana.Instruction{
+		NewDelegateStakeInstruction(lc.stakeAccount, voteAccount, lc.authority.PublicKey()).Build(),
+	})
+}
+
+// Deactivate deactivates the stake; it becomes inactive at the end of the cooldown.
+func (lc *Lifecycle) Deactivate(ctx context.Context) (solana.Signature, error) {
+	return lc.send(ctx, []solana.Instruction{
+		NewDeactivateInstruction(lc.stakeAccount, lc.authority.PublicKey()).Build(),
+	})
+}
+
+// Withdraw withdraws all the lamports of the stake account (closing it)
+// to the recipient; the stake must be inactive (or undelegated).
+func (lc *Lifecycle) Withdraw(ctx context.Context, recipient solana.PublicKey) (solana.Signature, error) {
+	status, err := lc.Poll(ctx)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	if status.Phase != PhaseInactive && status.Phase != PhaseUndelegated {
+		return solana.Signature{}, fmt.Errorf("cannot withdraw from a stake account in phase %s", status.Phase)
+	}
+	return lc.send(ctx, []solana.Instruction{
+		NewWithdrawInstruction(status.Lamports, lc.stakeAccount, recipient, lc.authority.PublicKey()).Build(),
+	})
+}
+
+// Status fetches the current status of the stake account.
+func (lc *Lifecycle) Status(ctx context.Context) (*DelegationStatus, error) {
+	epochInfo, err := lc.client.GetEpochInfo(ctx, lc.commitment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get epoch: %w", err)
+	}
+	status := &DelegationStatus{Epoch: epochInfo.Epoch}
+
+	info, err := lc.client.GetAccountInfoWithOpts(ctx, lc.stakeAccount, &rpc.GetAccountInfoOpts{
+		Commitment: lc.commitment,
+	})
+	if errors.Is(err, rpc.ErrNotFound) {
+		status.Phase = PhaseWithdrawn
+		return status, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get stake account: %w", err)
+	}
+	status.Lamports = info.Value.Lamports
+	state, err := DecodeStakeAccount(info.Value.Data.GetBinary())
+	if err != nil {
+		return nil, err
+	}
+	switch state.Type {
+	case StakeStateInitialized:
+		status.Phase = PhaseUndelegated
+		return status, nil
+	case StakeStateStake:
+	default:
+		return nil, fmt.Errorf("unexpected stake state: %s", state.Type)
+	}
+
+	activation, err := lc.client.GetStakeActivation(ctx, lc.stakeAccount, lc.commitment, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get stake activation: %w", err)
+	}
+	status.Phase = DelegationPhase(activation.State)
+	status.ActiveStake = activation.Active
+	status.InactiveStake = activation.Inactive
+	return status, nil
+}
+
+// Poll fetches the current status of the stake account, and emits an
+// event if the phase changed, or a new epoch started, since the last poll.
+func (lc *Lifecycle) Poll(ctx context.Context) (*DelegationStatus, error) {
+	status, err := lc.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	last := lc.last
+	lc.last = status
+	if last != nil && last.Phase == status.Phase && last.Epoch == status.Epoch {
+		return status, nil
+	}
+	if lc.onEvent != nil {
+		event := &DelegationEvent{Status: status}
+		if last != nil {
+			event.Previous = last.Phase
+		}
+		lc.onEvent(event)
+	}
+	return status, nil
+}
+
+// WaitFor polls the stake account until it reaches the provided phase
+// (e.g. PhaseActive after the delegation, PhaseInactive after the deactivation).
+func (lc *Lifecycle) WaitFor(ctx context.Context, phase DelegationPhase) (*DelegationStatus, error) {
+	for {
+		status, err := lc.Poll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if status.Phase == phase {
+			return status, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lc.pollInterval):
+		}
+	}
+}
+
+func (lc *Lifecycle) send(ctx context.Context, instructions []solana.Instruction, signers ...solana.Signer) (solana.Signature, error) {
+	latest, err := lc.client.GetLatestBlockhash(ctx, lc.commitment)
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("unable to get recent blockhash: %w", err)
+	}
+	tx, err := solana.NewTransaction(instructions, latest.Value.Blockhash, solana.TransactionPayer(lc.feePayer.PublicKey()))
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	if _, err := tx.SignWithSigners(append(signers, lc.feePayer, lc.authority)...); err != nil {
+		return solana.Signature{}, err
+	}
+	return lc.client.SendTransactionWithOpts(ctx, tx, rpc.TransactionOpts{
+		PreflightCommitment: lc.commitment,
+	})
+}
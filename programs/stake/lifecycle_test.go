@@ -0,0 +1,166 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stake
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// lifecycleNode is a JSONRPCClient that simulates a stake account:
+// each getEpochInfo call advances the epoch, and the activation
+// progresses by one phase per epoch.
+type lifecycleNode struct {
+	t          *testing.T
+	epoch      uint64
+	lamports   uint64
+	state      *StakeAccount
+	activation rpc.ActivationStateType
+	sent       []*solana.Transaction
+}
+
+func (node *lifecycleNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	var result string
+	switch method {
+	case "getEpochInfo":
+		node.epoch++
+		switch node.activation {
+		case rpc.ActivationStateActivating:
+			node.activation = rpc.ActivationStateActive
+		case rpc.ActivationStateDeactivating:
+			node.activation = rpc.ActivationStateInactive
+		}
+		result = fmt.Sprintf(`{"absoluteSlot":1,"blockHeight":1,"epoch":%d,"slotIndex":0,"slotsInEpoch":432000}`, node.epoch)
+	case "getAccountInfo":
+		if node.state == nil {
+			result = `{"context":{"slot":1},"value":null}`
+			break
+		}
+		buf := encodeStakeAccount(node.t, node.state)
+		result = fmt.Sprintf(
+			`{"context":{"slot":1},"value":{"lamports":%d,"owner":%q,"data":[%q,"base64"],"executable":false,"rentEpoch":0}}`,
+			node.lamports, ProgramID, base64.StdEncoding.EncodeToString(buf),
+		)
+	case "getStakeActivation":
+		active := uint64(0)
+		if node.activation == rpc.ActivationStateActive || node.activation == rpc.ActivationStateDeactivating {
+			active = node.lamports
+		}
+		result = fmt.Sprintf(`{"state":%q,"active":%d,"inactive":%d}`, node.activation, active, node.lamports-active)
+	case "getLatestBlockhash":
+		result = `{"context":{"slot":1},"value":{"blockhash":"11111111111111111111111111111111","lastValidBlockHeight":100}}`
+	case "sendTransaction":
+		tx := new(solana.Transaction)
+		err := tx.UnmarshalBase64(params[0].(string))
+		if err != nil {
+			return err
+		}
+		if err := tx.VerifySignatures(); err != nil {
+			return err
+		}
+		node.sent = append(node.sent, tx)
+		node.apply(tx)
+		result = fmt.Sprintf("%q", tx.Signatures[0])
+	default:
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	return json.Unmarshal([]byte(result), out)
+}
+
+func (node *lifecycleNode) apply(tx *solana.Transaction) {
+	for _, compiled := range tx.Message.Instructions {
+		program, _ := tx.Message.Program(compiled.ProgramIDIndex)
+		if program.Equals(solana.SystemProgramID) {
+			inst, _ := system.DecodeInstruction(nil, compiled.Data)
+			node.lamports = *inst.Impl.(*system.CreateAccount).Lamports
+			continue
+		}
+		inst, _ := DecodeInstruction(nil, compiled.Data)
+		switch impl := inst.Impl.(type) {
+		case *Initialize:
+			node.state = &StakeAccount{Type: StakeStateInitialized, Meta: &Meta{Authorized: *impl.Authorized}}
+		case *DelegateStake:
+			node.state.Type = StakeStateStake
+			node.state.Stake = &Stake{}
+			node.activation = rpc.ActivationStateActivating
+		case *Deactivate:
+			node.activation = rpc.ActivationStateDeactivating
+		case *Withdraw:
+			node.state = nil
+		}
+	}
+}
+
+func (node *lifecycleNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	node := &lifecycleNode{t: t}
+	authority := solana.NewWallet().PrivateKey
+	stakeAccount := solana.NewWallet().PrivateKey
+
+	var events []*DelegationEvent
+	lc := NewLifecycle(rpc.NewWithCustomRPCClient(node), stakeAccount.PublicKey(), authority, &LifecycleOpts{
+		PollInterval: time.Millisecond,
+		OnEvent:      func(event *DelegationEvent) { events = append(events, event) },
+	})
+
+	_, err := lc.CreateAndDelegate(ctx, stakeAccount, 1000, solana.PublicKey{9})
+	require.EqualError(t, err, "1000 lamports do not exceed the rent-exempt reserve of 2282880")
+
+	_, err = lc.CreateAndDelegate(ctx, stakeAccount, 10000000, solana.PublicKey{9})
+	require.NoError(t, err)
+	require.Len(t, node.sent, 1)
+	require.Len(t, node.sent[0].Message.Instructions, 3)
+
+	status, err := lc.WaitFor(ctx, PhaseActive)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10000000), status.ActiveStake)
+
+	_, err = lc.Withdraw(ctx, authority.PublicKey())
+	require.EqualError(t, err, "cannot withdraw from a stake account in phase active")
+
+	_, err = lc.Deactivate(ctx)
+	require.NoError(t, err)
+	_, err = lc.WaitFor(ctx, PhaseInactive)
+	require.NoError(t, err)
+
+	_, err = lc.Withdraw(ctx, authority.PublicKey())
+	require.NoError(t, err)
+	status, err = lc.WaitFor(ctx, PhaseWithdrawn)
+	require.NoError(t, err)
+
+	var phases []DelegationPhase
+	for _, event := range events {
+		if len(phases) == 0 || phases[len(phases)-1] != event.Status.Phase {
+			phases = append(phases, event.Status.Phase)
+		}
+	}
+	require.Equal(t, []DelegationPhase{PhaseActive, PhaseInactive, PhaseWithdrawn}, phases)
+	require.Equal(t, DelegationPhase(""), events[0].Previous)
+	require.Equal(t, PhaseActive, events[1].Previous)
+}
@@ -52,7 +52,7 @@ func TestEncDec(t *testing.T) {
 
 	{
 		payerPrivateKey := solana.MustPrivateKeyFromBase58("5LRLfrUP22VtiNaPGAEgHPucoJmG8ejmomMVmpn4fkXjexYsT7RQGfGuMePG5PKvecZxMGrqa6EP2RmYcm7TYQvX")
-		payerAccount, _ := solana.WalletFromPrivateKeyBase58(payerPrivateKey.String())
+		payerAccount, _ := solana.WalletFromPrivateKeyBase58(payerPrivateKey.Base58())
 		programID := "4sCcZNQR8vfWckyi5L9KdptdaiLxdiMjVgKQay7HxzmK"
 		programPubKey := solana.MustPublicKeyFromBase58(programID)
 
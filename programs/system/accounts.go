@@ -21,6 +21,12 @@ import (
 	"github.com/gagliardetto/solana-go"
 )
 
+// NonceAccountSize is the size of the data of a nonce account.
+const NonceAccountSize = 80
+
+// NonceStateInitialized is the State of an initialized nonce account.
+const NonceStateInitialized = 1
+
 type NonceAccount struct {
 	Version          uint32
 	State            uint32
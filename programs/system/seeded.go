@@ -0,0 +1,214 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+	"fmt"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// SeededAccount is an account whose address is derived
+// from a base key, a seed and an owner (see solana.CreateWithSeed),
+// e.g. one of many deposit addresses controlled by the same base key.
+type SeededAccount struct {
+	Address ag_solanago.PublicKey
+	Base    ag_solanago.PublicKey
+	Seed    string
+	Owner   ag_solanago.PublicKey
+}
+
+// DeriveSeededAccount derives the address of the account with the provided base, seed and owner.
+func DeriveSeededAccount(base ag_solanago.PublicKey, seed string, owner ag_solanago.PublicKey) (*SeededAccount, error) {
+	address, err := ag_solanago.CreateWithSeed(base, seed, owner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid seed %q: %w", seed, err)
+	}
+	return &SeededAccount{
+		Address: address,
+		Base:    base,
+		Seed:    seed,
+		Owner:   owner,
+	}, nil
+}
+
+// DeriveSeededAccounts derives the addresses of the accounts
+// with the provided base and owner, one for each seed.
+func DeriveSeededAccounts(base ag_solanago.PublicKey, owner ag_solanago.PublicKey, seeds ...string) ([]*SeededAccount, error) {
+	out := make([]*SeededAccount, len(seeds))
+	for i, seed := range seeds {
+		account, err := DeriveSeededAccount(base, seed, owner)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = account
+	}
+	return out, nil
+}
+
+// SequentialSeeds returns count seeds made of the prefix and
+// a sequence number starting at from (e.g. "deposit-0", "deposit-1", ...).
+func SequentialSeeds(prefix string, from int, count int) []string {
+	out := make([]string, count)
+	for i := range out {
+		out[i] = fmt.Sprintf("%s%d", prefix, from+i)
+	}
+	return out
+}
+
+// CreateInstruction returns the instruction that creates the account,
+// funded by the funder. The base key must sign the transaction.
+func (acc *SeededAccount) CreateInstruction(funder ag_solanago.PublicKey, lamports uint64, space uint64) *CreateAccountWithSeed {
+	return NewCreateAccountWithSeedInstruction(
+		acc.Base,
+		acc.Seed,
+		lamports,
+		space,
+		acc.Owner,
+		funder,
+		acc.Address,
+		acc.Base,
+	)
+}
+
+// AllocateInstruction returns the instruction that allocates the data of an
+// account that already holds lamports. The base key must sign the transaction.
+func (acc *SeededAccount) AllocateInstruction(space uint64) *AllocateWithSeed {
+	return NewAllocateWithSeedInstruction(
+		acc.Base,
+		acc.Seed,
+		space,
+		acc.Owner,
+		acc.Address,
+		acc.Base,
+	)
+}
+
+// AssignInstruction returns the instruction that assigns an account that already
+// holds lamports to its owner. The base key must sign the transaction.
+func (acc *SeededAccount) AssignInstruction() *AssignWithSeed {
+	return NewAssignWithSeedInstruction(
+		acc.Base,
+		acc.Seed,
+		acc.Owner,
+		acc.Address,
+		acc.Base,
+	)
+}
+
+// SeededAccountState is the on-chain state of a seeded account.
+type SeededAccountState int
+
+const (
+	// The account does not exist.
+	SeededAccountMissing SeededAccountState = iota
+	// The account holds lamports, but was not created yet
+	// (e.g. a deposit was made to its address).
+	SeededAccountFunded
+	// The account was created, and is owned by its owner.
+	SeededAccountCreated
+)
+
+// GetSeededAccountStates fetches the state of the provided accounts.
+// It returns an error if one of them is owned by a program
+// other than its owner (and the system program).
+func GetSeededAccountStates(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	accounts []*SeededAccount,
+) ([]SeededAccountState, []*rpc.Account, error) {
+	states := make([]SeededAccountState, len(accounts))
+	infos := make([]*rpc.Account, len(accounts))
+	// getMultipleAccounts accepts at most 100 accounts.
+	const chunkSize = 100
+	for start := 0; start < len(accounts); start += chunkSize {
+		end := start + chunkSize
+		if end > len(accounts) {
+			end = len(accounts)
+		}
+		keys := make([]ag_solanago.PublicKey, 0, end-start)
+		for _, acc := range accounts[start:end] {
+			keys = append(keys, acc.Address)
+		}
+		resp, err := rpcClient.GetMultipleAccountsWithOpts(ctx, keys, &rpc.GetMultipleAccountsOpts{
+			Encoding: ag_solanago.EncodingBase64,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(resp.Value) != len(keys) {
+			return nil, nil, fmt.Errorf("expected %d accounts, got %d", len(keys), len(resp.Value))
+		}
+		for i, info := range resp.Value {
+			acc := accounts[start+i]
+			infos[start+i] = info
+			switch {
+			case info == nil:
+				states[start+i] = SeededAccountMissing
+			case info.Owner.Equals(ag_solanago.SystemProgramID) &&
+				(!acc.Owner.Equals(ag_solanago.SystemProgramID) || len(info.Data.GetBinary()) == 0):
+				states[start+i] = SeededAccountFunded
+			case info.Owner.Equals(acc.Owner):
+				states[start+i] = SeededAccountCreated
+			default:
+				return nil, nil, fmt.Errorf("seeded account %s (seed %q) is owned by %s, expected %s", acc.Address, acc.Seed, info.Owner, acc.Owner)
+			}
+		}
+	}
+	return states, infos, nil
+}
+
+// BuildCreateSeededAccountsInstructions returns the instructions that create the
+// provided accounts, with the provided space and rent-exempt balance, funded by the funder:
+//   - missing accounts are created with CreateAccountWithSeed;
+//   - accounts that already hold lamports (which CreateAccountWithSeed rejects)
+//     are topped up to the rent-exempt balance, allocated and assigned;
+//   - accounts already created are skipped.
+//
+// The base keys must sign the transaction(s).
+func BuildCreateSeededAccountsInstructions(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	funder ag_solanago.PublicKey,
+	accounts []*SeededAccount,
+	space uint64,
+) ([]ag_solanago.Instruction, error) {
+	states, infos, err := GetSeededAccountStates(ctx, rpcClient, accounts)
+	if err != nil {
+		return nil, err
+	}
+	minBalance := ag_solanago.MinimumBalanceForRentExemption(space)
+
+	var out []ag_solanago.Instruction
+	for i, acc := range accounts {
+		switch states[i] {
+		case SeededAccountMissing:
+			out = append(out, acc.CreateInstruction(funder, minBalance, space).Build())
+		case SeededAccountFunded:
+			if infos[i].Lamports < minBalance {
+				out = append(out, NewTransferInstruction(minBalance-infos[i].Lamports, funder, acc.Address).Build())
+			}
+			if space > 0 {
+				out = append(out, acc.AllocateInstruction(space).Build())
+			}
+			if !acc.Owner.Equals(ag_solanago.SystemProgramID) {
+				out = append(out, acc.AssignInstruction().Build())
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// accountsNode is a rpc.JSONRPCClient that serves getMultipleAccounts.
+type accountsNode struct {
+	accounts map[solana.PublicKey]string
+}
+
+func (node *accountsNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if method != "getMultipleAccounts" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	values := []json.RawMessage{}
+	for _, key := range params[0].([]solana.PublicKey) {
+		if account, ok := node.accounts[key]; ok {
+			values = append(values, json.RawMessage(account))
+		} else {
+			values = append(values, json.RawMessage("null"))
+		}
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"context": map[string]interface{}{"slot": 1},
+		"value":   values,
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, out)
+}
+
+func (node *accountsNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestSeededAccounts(t *testing.T) {
+	base := solana.NewWallet().PublicKey()
+	funder := solana.NewWallet().PublicKey()
+	owner := solana.SystemProgramID
+
+	seeds := SequentialSeeds("deposit-", 0, 3)
+	require.Equal(t, []string{"deposit-0", "deposit-1", "deposit-2"}, seeds)
+
+	accounts, err := DeriveSeededAccounts(base, owner, seeds...)
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+	expected, err := solana.CreateWithSeed(base, "deposit-1", owner)
+	require.NoError(t, err)
+	require.Equal(t, expected, accounts[1].Address)
+
+	_, err = DeriveSeededAccount(base, "a-seed-that-is-longer-than-32-bytes", owner)
+	require.Error(t, err)
+
+	account := func(lamports uint64, owner solana.PublicKey) string {
+		return fmt.Sprintf(`{"lamports":%d,"owner":%q,"data":["","base64"],"executable":false,"rentEpoch":0}`, lamports, owner)
+	}
+	node := &accountsNode{
+		accounts: map[solana.PublicKey]string{
+			// Received a deposit before being created.
+			accounts[1].Address: account(1000, solana.SystemProgramID),
+		},
+	}
+	client := rpc.NewWithCustomRPCClient(node)
+
+	states, _, err := GetSeededAccountStates(context.Background(), client, accounts)
+	require.NoError(t, err)
+	require.Equal(t, []SeededAccountState{SeededAccountMissing, SeededAccountFunded, SeededAccountMissing}, states)
+
+	instructions, err := BuildCreateSeededAccountsInstructions(context.Background(), client, funder, accounts, 0)
+	require.NoError(t, err)
+	require.Len(t, instructions, 3)
+
+	minBalance := solana.MinimumBalanceForRentExemption(0)
+	create := instructions[0].(*Instruction).Impl.(CreateAccountWithSeed)
+	require.Equal(t, minBalance, *create.Lamports)
+	require.Equal(t, accounts[0].Address, create.GetCreatedAccount().PublicKey)
+	topUp := instructions[1].(*Instruction).Impl.(Transfer)
+	require.Equal(t, minBalance-1000, *topUp.Lamports)
+
+	// An account owned by another program is rejected.
+	node.accounts[accounts[2].Address] = account(1000, solana.TokenProgramID)
+	_, err = BuildCreateSeededAccountsInstructions(context.Background(), client, funder, accounts, 0)
+	require.Error(t, err)
+}
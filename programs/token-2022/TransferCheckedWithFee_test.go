@@ -0,0 +1,71 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	ag_gofuzz "github.com/gagliardetto/gofuzz"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_TransferCheckedWithFee(t *testing.T) {
+	fu := ag_gofuzz.New().NilChance(0)
+	for i := 0; i < 1; i++ {
+		t.Run("TransferCheckedWithFee"+strconv.Itoa(i), func(t *testing.T) {
+			{
+				params := new(TransferCheckedWithFee)
+				fu.Fuzz(params)
+				params.Accounts = nil
+				params.Signers = nil
+				buf := new(bytes.Buffer)
+				err := encodeT(*params, buf)
+				ag_require.NoError(t, err)
+				//
+				got := new(TransferCheckedWithFee)
+				err = decodeT(got, buf.Bytes())
+				params.Accounts = nil
+				params.Signers = nil
+				ag_require.NoError(t, err)
+				ag_require.Equal(t, params, got)
+			}
+		})
+	}
+}
+
+func TestDecodeInstruction_TransferCheckedWithFee(t *testing.T) {
+	source := ag_solanago.NewWallet().PublicKey()
+	mint := ag_solanago.NewWallet().PublicKey()
+	destination := ag_solanago.NewWallet().PublicKey()
+	owner := ag_solanago.NewWallet().PublicKey()
+
+	inst := NewTransferCheckedWithFeeInstruction(1_000_000, 6, 5_000, source, mint, destination, owner, nil).Build()
+	data, err := inst.Data()
+	ag_require.NoError(t, err)
+	ag_require.Equal(t, []byte{Instruction_TransferFeeExtension, TransferFeeInstruction_TransferCheckedWithFee}, data[:2])
+	ag_require.Len(t, data, 2+8+1+8)
+
+	decoded, err := DecodeInstruction(inst.Accounts(), data)
+	ag_require.NoError(t, err)
+	got := decoded.Impl.(*TransferCheckedWithFee)
+	ag_require.Equal(t, uint64(1_000_000), *got.Amount)
+	ag_require.Equal(t, uint8(6), *got.Decimals)
+	ag_require.Equal(t, uint64(5_000), *got.Fee)
+	ag_require.Equal(t, owner, got.GetOwnerAccount().PublicKey)
+	ag_require.True(t, got.GetOwnerAccount().IsSigner)
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"bytes"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go/programs/token"
+)
+
+const (
+	// Size of the base state of a mint (same as the Token program).
+	MINT_SIZE = 82
+	// Size of the base state of a token account (same as the Token program).
+	ACCOUNT_SIZE = 165
+)
+
+// AccountType is the byte written right after the base state
+// of an account that has extensions, to disambiguate mints from token accounts.
+type AccountType uint8
+
+const (
+	AccountTypeUninitialized AccountType = iota
+	AccountTypeMint
+	AccountTypeAccount
+)
+
+// Mint is a Token-2022 mint: the base Token mint state plus its extensions.
+type Mint struct {
+	token.Mint
+
+	Extensions ExtensionList
+}
+
+// DecodeMint decodes a Token-2022 mint (with its extensions) from the provided account data.
+func DecodeMint(data []byte) (*Mint, error) {
+	mint := new(Mint)
+	if err := bin.NewBinDecoder(data).Decode(mint); err != nil {
+		return nil, fmt.Errorf("unable to decode mint: %w", err)
+	}
+	return mint, nil
+}
+
+func (mint *Mint) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
+	data, err := dec.ReadNBytes(dec.Remaining())
+	if err != nil {
+		return err
+	}
+	if len(data) < MINT_SIZE {
+		return fmt.Errorf("mint data too short: %d bytes", len(data))
+	}
+	if err := bin.NewBinDecoder(data[:MINT_SIZE]).Decode(&mint.Mint); err != nil {
+		return err
+	}
+	mint.Extensions, err = decodeExtensions(data, AccountTypeMint)
+	return err
+}
+
+func (mint Mint) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	buf := new(bytes.Buffer)
+	if err := bin.NewBinEncoder(buf).Encode(mint.Mint); err != nil {
+		return err
+	}
+	if len(mint.Extensions) > 0 {
+		// Pad the base state to the size of a token account,
+		// so that the account type is always at the same offset.
+		buf.Write(make([]byte, ACCOUNT_SIZE-MINT_SIZE))
+		buf.WriteByte(byte(AccountTypeMint))
+		buf.Write(encodeTLV(mint.Extensions))
+	}
+	return encoder.WriteBytes(buf.Bytes(), false)
+}
+
+// Account is a Token-2022 token account: the base Token account state plus its extensions.
+type Account struct {
+	token.Account
+
+	Extensions ExtensionList
+}
+
+// DecodeAccount decodes a Token-2022 token account (with its extensions) from the provided account data.
+func DecodeAccount(data []byte) (*Account, error) {
+	acc := new(Account)
+	if err := bin.NewBinDecoder(data).Decode(acc); err != nil {
+		return nil, fmt.Errorf("unable to decode token account: %w", err)
+	}
+	return acc, nil
+}
+
+func (acc *Account) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
+	data, err := dec.ReadNBytes(dec.Remaining())
+	if err != nil {
+		return err
+	}
+	if len(data) < ACCOUNT_SIZE {
+		return fmt.Errorf("token account data too short: %d bytes", len(data))
+	}
+	if err := bin.NewBinDecoder(data[:ACCOUNT_SIZE]).Decode(&acc.Account); err != nil {
+		return err
+	}
+	acc.Extensions, err = decodeExtensions(data, AccountTypeAccount)
+	return err
+}
+
+func (acc Account) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	buf := new(bytes.Buffer)
+	if err := bin.NewBinEncoder(buf).Encode(acc.Account); err != nil {
+		return err
+	}
+	if len(acc.Extensions) > 0 {
+		buf.WriteByte(byte(AccountTypeAccount))
+		buf.Write(encodeTLV(acc.Extensions))
+	}
+	return encoder.WriteBytes(buf.Bytes(), false)
+}
+
+// decodeExtensions decodes the extensions that follow the base state
+// (padded to ACCOUNT_SIZE) and the account type byte.
+func decodeExtensions(data []byte, expected AccountType) (ExtensionList, error) {
+	if len(data) <= ACCOUNT_SIZE {
+		// No extensions.
+		return nil, nil
+	}
+	if got := AccountType(data[ACCOUNT_SIZE]); got != expected {
+		return nil, fmt.Errorf("invalid account type: expected %d, got %d", expected, got)
+	}
+	return parseTLV(data[ACCOUNT_SIZE+1:])
+}
@@ -0,0 +1,239 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// DecodePermanentDelegate decodes the value of a `PermanentDelegate` extension.
+func DecodePermanentDelegate(data []byte) (*solana.PublicKey, error) {
+	if len(data) != 32 {
+		return nil, fmt.Errorf("invalid PermanentDelegate size: expected 32, got %d", len(data))
+	}
+	return optionalNonZeroPubkey(data), nil
+}
+
+// DecodeMintCloseAuthority decodes the value of a `MintCloseAuthority` extension.
+func DecodeMintCloseAuthority(data []byte) (*solana.PublicKey, error) {
+	if len(data) != 32 {
+		return nil, fmt.Errorf("invalid MintCloseAuthority size: expected 32, got %d", len(data))
+	}
+	return optionalNonZeroPubkey(data), nil
+}
+
+// DecodeDefaultAccountState decodes the value of a `DefaultAccountState` extension.
+func DecodeDefaultAccountState(data []byte) (token.AccountState, error) {
+	if len(data) != 1 {
+		return 0, fmt.Errorf("invalid DefaultAccountState size: expected 1, got %d", len(data))
+	}
+	return token.AccountState(data[0]), nil
+}
+
+// TransferHook is the state of the `TransferHook` mint extension.
+type TransferHook struct {
+	// Authority that can set the transfer hook program id.
+	Authority *solana.PublicKey
+
+	// Program that authorizes the transfer.
+	ProgramID *solana.PublicKey
+}
+
+// DecodeTransferHook decodes the value of a `TransferHook` extension.
+func DecodeTransferHook(data []byte) (*TransferHook, error) {
+	if len(data) != 64 {
+		return nil, fmt.Errorf("invalid TransferHook size: expected 64, got %d", len(data))
+	}
+	return &TransferHook{
+		Authority: optionalNonZeroPubkey(data[0:32]),
+		ProgramID: optionalNonZeroPubkey(data[32:64]),
+	}, nil
+}
+
+// Bytes returns the binary representation of the extension value.
+func (hook TransferHook) Bytes() []byte {
+	out := make([]byte, 64)
+	putOptionalNonZeroPubkey(out[0:32], hook.Authority)
+	putOptionalNonZeroPubkey(out[32:64], hook.ProgramID)
+	return out
+}
+
+// MintRisk is a property of a mint that lets someone other than
+// the holder act on, or restrict, the holder's tokens.
+type MintRisk string
+
+const (
+	// The supply can be increased by the mint authority.
+	MintRiskMintAuthority MintRisk = "mint-authority"
+	// Token accounts can be frozen by the freeze authority.
+	MintRiskFreezeAuthority MintRisk = "freeze-authority"
+	// The permanent delegate can transfer or burn tokens from any account.
+	MintRiskPermanentDelegate MintRisk = "permanent-delegate"
+	// Every transfer invokes a program that can reject it.
+	MintRiskTransferHook MintRisk = "transfer-hook"
+	// New token accounts are frozen by default.
+	MintRiskDefaultFrozen MintRisk = "default-frozen"
+	// Transfers are charged a fee, which can be changed by the fee authority.
+	MintRiskTransferFee MintRisk = "transfer-fee"
+	// The mint can be closed by the close authority once the supply is zero.
+	MintRiskCloseAuthority MintRisk = "close-authority"
+	// The tokens cannot be transferred.
+	MintRiskNonTransferable MintRisk = "non-transferable"
+)
+
+// MintAudit is a summary of the authorities and risky extensions of a mint.
+type MintAudit struct {
+	Mint      solana.PublicKey
+	ProgramID solana.PublicKey
+
+	Supply   uint64
+	Decimals uint8
+
+	MintAuthority   *solana.PublicKey
+	FreezeAuthority *solana.PublicKey
+
+	// Token-2022 only; nil if the mint does not have the extension.
+	PermanentDelegate   *solana.PublicKey
+	TransferHook        *TransferHook
+	DefaultAccountState *token.AccountState
+	TransferFeeConfig   *TransferFeeConfig
+	MintCloseAuthority  *solana.PublicKey
+
+	// All the extensions of the mint.
+	Extensions []ExtensionType
+
+	// The risks found, in a stable order.
+	Risks []MintRisk
+}
+
+// HasRisk returns true if the audit found the provided risk.
+func (audit *MintAudit) HasRisk(risk MintRisk) bool {
+	for _, r := range audit.Risks {
+		if r == risk {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSafe returns true if no risks were found.
+func (audit *MintAudit) IsSafe() bool {
+	return len(audit.Risks) == 0
+}
+
+// AuditMintState produces the audit of an already decoded mint.
+func AuditMintState(address solana.PublicKey, programID solana.PublicKey, mint *Mint) (*MintAudit, error) {
+	audit := &MintAudit{
+		Mint:            address,
+		ProgramID:       programID,
+		Supply:          mint.Supply,
+		Decimals:        mint.Decimals,
+		MintAuthority:   mint.MintAuthority,
+		FreezeAuthority: mint.FreezeAuthority,
+		Extensions:      mint.Extensions.Types(),
+	}
+	if audit.MintAuthority != nil {
+		audit.Risks = append(audit.Risks, MintRiskMintAuthority)
+	}
+	if audit.FreezeAuthority != nil {
+		audit.Risks = append(audit.Risks, MintRiskFreezeAuthority)
+	}
+
+	if value, ok := mint.Extensions.Get(ExtensionPermanentDelegate); ok {
+		delegate, err := DecodePermanentDelegate(value)
+		if err != nil {
+			return nil, err
+		}
+		audit.PermanentDelegate = delegate
+		if delegate != nil {
+			audit.Risks = append(audit.Risks, MintRiskPermanentDelegate)
+		}
+	}
+	if value, ok := mint.Extensions.Get(ExtensionTransferHook); ok {
+		hook, err := DecodeTransferHook(value)
+		if err != nil {
+			return nil, err
+		}
+		audit.TransferHook = hook
+		// Without a program, the hook is a no-op, but the authority can set one.
+		if hook.ProgramID != nil || hook.Authority != nil {
+			audit.Risks = append(audit.Risks, MintRiskTransferHook)
+		}
+	}
+	if value, ok := mint.Extensions.Get(ExtensionDefaultAccountState); ok {
+		state, err := DecodeDefaultAccountState(value)
+		if err != nil {
+			return nil, err
+		}
+		audit.DefaultAccountState = &state
+		if state == token.Frozen {
+			audit.Risks = append(audit.Risks, MintRiskDefaultFrozen)
+		}
+	}
+	{
+		cfg, err := mint.GetTransferFeeConfig()
+		if err != nil {
+			return nil, err
+		}
+		audit.TransferFeeConfig = cfg
+		if cfg != nil &&
+			(cfg.TransferFeeConfigAuthority != nil ||
+				cfg.OlderTransferFee.TransferFeeBasisPoints > 0 ||
+				cfg.NewerTransferFee.TransferFeeBasisPoints > 0) {
+			audit.Risks = append(audit.Risks, MintRiskTransferFee)
+		}
+	}
+	if value, ok := mint.Extensions.Get(ExtensionMintCloseAuthority); ok {
+		authority, err := DecodeMintCloseAuthority(value)
+		if err != nil {
+			return nil, err
+		}
+		audit.MintCloseAuthority = authority
+		if authority != nil {
+			audit.Risks = append(audit.Risks, MintRiskCloseAuthority)
+		}
+	}
+	if mint.Extensions.Has(ExtensionNonTransferable) {
+		audit.Risks = append(audit.Risks, MintRiskNonTransferable)
+	}
+	return audit, nil
+}
+
+// AuditMint fetches the provided mint (of either the Token or the Token-2022 program)
+// and reports its authorities and risky extensions.
+func AuditMint(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	mint solana.PublicKey,
+) (*MintAudit, error) {
+	account, err := rpcClient.GetAccountInfo(ctx, mint)
+	if err != nil {
+		return nil, err
+	}
+	owner := account.Value.Owner
+	if !owner.Equals(solana.TokenProgramID) && !owner.Equals(solana.Token2022ProgramID) {
+		return nil, fmt.Errorf("account %s is not a mint: owned by %s", mint, owner)
+	}
+	mintState, err := DecodeMint(account.GetBinary())
+	if err != nil {
+		return nil, err
+	}
+	return AuditMintState(mint, owner, mintState)
+}
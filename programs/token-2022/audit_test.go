@@ -0,0 +1,92 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditMintState(t *testing.T) {
+	address := solana.MustPublicKeyFromBase58("2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo")
+	authority := solana.MustPublicKeyFromBase58("Q6XprfkF8RQQKoQVG33xT88H7wi8Uk1B1CC7YAs69Gi")
+	hookProgram := solana.MustPublicKeyFromBase58("9Q1EFpFgnqGbFGAvXLWYBfGWAbJ97Gv8vsEcVFq6mhyg")
+
+	{
+		// A plain mint with no authorities.
+		audit, err := AuditMintState(address, solana.TokenProgramID, &Mint{
+			Mint: token.Mint{Supply: 100, Decimals: 9, IsInitialized: true},
+		})
+		require.NoError(t, err)
+		require.True(t, audit.IsSafe())
+		require.Equal(t, uint64(100), audit.Supply)
+		require.Equal(t, uint8(9), audit.Decimals)
+	}
+	{
+		hook := TransferHook{ProgramID: hookProgram.ToPointer()}
+		mint := &Mint{
+			Mint: token.Mint{
+				MintAuthority:   authority.ToPointer(),
+				FreezeAuthority: authority.ToPointer(),
+				Decimals:        6,
+				IsInitialized:   true,
+			},
+			Extensions: ExtensionList{
+				{Type: ExtensionPermanentDelegate, Value: authority.Bytes()},
+				{Type: ExtensionTransferHook, Value: hook.Bytes()},
+				{Type: ExtensionDefaultAccountState, Value: []byte{byte(token.Frozen)}},
+				{Type: ExtensionMetadataPointer, Value: make([]byte, 64)},
+			},
+		}
+		audit, err := AuditMintState(address, solana.Token2022ProgramID, mint)
+		require.NoError(t, err)
+		require.False(t, audit.IsSafe())
+		require.Equal(t,
+			[]MintRisk{
+				MintRiskMintAuthority,
+				MintRiskFreezeAuthority,
+				MintRiskPermanentDelegate,
+				MintRiskTransferHook,
+				MintRiskDefaultFrozen,
+			},
+			audit.Risks,
+		)
+		require.True(t, audit.HasRisk(MintRiskTransferHook))
+		require.False(t, audit.HasRisk(MintRiskTransferFee))
+		require.Equal(t, authority, *audit.PermanentDelegate)
+		require.Equal(t, &hook, audit.TransferHook)
+		require.Equal(t, token.Frozen, *audit.DefaultAccountState)
+		require.Len(t, audit.Extensions, 4)
+	}
+	{
+		// An unset permanent delegate is not a risk.
+		_, err := AuditMintState(address, solana.Token2022ProgramID, &Mint{
+			Extensions: ExtensionList{
+				{Type: ExtensionPermanentDelegate, Value: make([]byte, 32)},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = AuditMintState(address, solana.Token2022ProgramID, &Mint{
+			Extensions: ExtensionList{
+				{Type: ExtensionPermanentDelegate, Value: make([]byte, 3)},
+			},
+		})
+		require.Error(t, err)
+	}
+}
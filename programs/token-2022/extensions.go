@@ -0,0 +1,225 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+type ExtensionType uint16
+
+const (
+	// Used as padding if the account size would otherwise be 355, same as a multisig.
+	ExtensionUninitialized ExtensionType = iota
+	// Includes transfer fee rate info and accompanying authorities to withdraw and set the fee.
+	ExtensionTransferFeeConfig
+	// Includes withheld transfer fees.
+	ExtensionTransferFeeAmount
+	// Includes an optional mint close authority.
+	ExtensionMintCloseAuthority
+	// Auditor configuration for confidential transfers.
+	ExtensionConfidentialTransferMint
+	// State for confidential transfers.
+	ExtensionConfidentialTransferAccount
+	// Specifies the default Account::state for new Accounts.
+	ExtensionDefaultAccountState
+	// Indicates that the Account owner authority cannot be changed.
+	ExtensionImmutableOwner
+	// Require inbound transfers to have memo.
+	ExtensionMemoTransfer
+	// Indicates that the tokens from this mint can't be transferred.
+	ExtensionNonTransferable
+	// Tokens accrue interest over time.
+	ExtensionInterestBearingConfig
+	// Locks privileged token operations from happening via CPI.
+	ExtensionCpiGuard
+	// Includes an optional permanent delegate.
+	ExtensionPermanentDelegate
+	// Indicates that the tokens in this account belong to a non-transferable mint.
+	ExtensionNonTransferableAccount
+	// Mint requires a CPI to a program implementing the "transfer hook" interface.
+	ExtensionTransferHook
+	// Indicates that the tokens in this account belong to a mint with a transfer hook.
+	ExtensionTransferHookAccount
+	// Includes encrypted withheld fees and the encryption public key that they are encrypted under.
+	ExtensionConfidentialTransferFeeConfig
+	// Includes confidential withheld transfer fees.
+	ExtensionConfidentialTransferFeeAmount
+	// Mint contains a pointer to another account (or the same account) that holds metadata.
+	ExtensionMetadataPointer
+	// Mint contains token-metadata.
+	ExtensionTokenMetadata
+	// Mint contains a pointer to another account (or the same account) that holds group configurations.
+	ExtensionGroupPointer
+	// Mint contains token group configurations.
+	ExtensionTokenGroup
+	// Mint contains a pointer to another account (or the same account) that holds group member configurations.
+	ExtensionGroupMemberPointer
+	// Mint contains token group member configurations.
+	ExtensionTokenGroupMember
+)
+
+func (t ExtensionType) String() string {
+	switch t {
+	case ExtensionUninitialized:
+		return "Uninitialized"
+	case ExtensionTransferFeeConfig:
+		return "TransferFeeConfig"
+	case ExtensionTransferFeeAmount:
+		return "TransferFeeAmount"
+	case ExtensionMintCloseAuthority:
+		return "MintCloseAuthority"
+	case ExtensionConfidentialTransferMint:
+		return "ConfidentialTransferMint"
+	case ExtensionConfidentialTransferAccount:
+		return "ConfidentialTransferAccount"
+	case ExtensionDefaultAccountState:
+		return "DefaultAccountState"
+	case ExtensionImmutableOwner:
+		return "ImmutableOwner"
+	case ExtensionMemoTransfer:
+		return "MemoTransfer"
+	case ExtensionNonTransferable:
+		return "NonTransferable"
+	case ExtensionInterestBearingConfig:
+		return "InterestBearingConfig"
+	case ExtensionCpiGuard:
+		return "CpiGuard"
+	case ExtensionPermanentDelegate:
+		return "PermanentDelegate"
+	case ExtensionNonTransferableAccount:
+		return "NonTransferableAccount"
+	case ExtensionTransferHook:
+		return "TransferHook"
+	case ExtensionTransferHookAccount:
+		return "TransferHookAccount"
+	case ExtensionConfidentialTransferFeeConfig:
+		return "ConfidentialTransferFeeConfig"
+	case ExtensionConfidentialTransferFeeAmount:
+		return "ConfidentialTransferFeeAmount"
+	case ExtensionMetadataPointer:
+		return "MetadataPointer"
+	case ExtensionTokenMetadata:
+		return "TokenMetadata"
+	case ExtensionGroupPointer:
+		return "GroupPointer"
+	case ExtensionTokenGroup:
+		return "TokenGroup"
+	case ExtensionGroupMemberPointer:
+		return "GroupMemberPointer"
+	case ExtensionTokenGroupMember:
+		return "TokenGroupMember"
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint16(t))
+	}
+}
+
+// Extension is a raw TLV (type-length-value) entry
+// found after the base state of a mint or token account.
+type Extension struct {
+	Type  ExtensionType
+	Value []byte
+}
+
+// ExtensionList is the list of extensions of a mint or token account.
+type ExtensionList []Extension
+
+// Get returns the value of the extension of the provided type, if present.
+func (list ExtensionList) Get(typ ExtensionType) ([]byte, bool) {
+	for _, ext := range list {
+		if ext.Type == typ {
+			return ext.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Has returns true if the list contains an extension of the provided type.
+func (list ExtensionList) Has(typ ExtensionType) bool {
+	_, ok := list.Get(typ)
+	return ok
+}
+
+// Types returns the types of all the extensions in the list.
+func (list ExtensionList) Types() []ExtensionType {
+	out := make([]ExtensionType, len(list))
+	for i, ext := range list {
+		out[i] = ext.Type
+	}
+	return out
+}
+
+const (
+	tlvTypeSize   = 2
+	tlvLengthSize = 2
+)
+
+// parseTLV parses the TLV entries in the provided data;
+// parsing stops at the first uninitialized entry.
+func parseTLV(data []byte) (ExtensionList, error) {
+	var out ExtensionList
+	offset := 0
+	for offset+tlvTypeSize+tlvLengthSize <= len(data) {
+		typ := ExtensionType(binary.LittleEndian.Uint16(data[offset:]))
+		if typ == ExtensionUninitialized {
+			break
+		}
+		length := int(binary.LittleEndian.Uint16(data[offset+tlvTypeSize:]))
+		start := offset + tlvTypeSize + tlvLengthSize
+		if start+length > len(data) {
+			return nil, fmt.Errorf("extension %s: length %d overflows remaining %d bytes", typ, length, len(data)-start)
+		}
+		out = append(out, Extension{
+			Type:  typ,
+			Value: data[start : start+length],
+		})
+		offset = start + length
+	}
+	return out, nil
+}
+
+// encodeTLV encodes the provided extensions as TLV entries.
+func encodeTLV(list ExtensionList) []byte {
+	var out []byte
+	for _, ext := range list {
+		var header [tlvTypeSize + tlvLengthSize]byte
+		binary.LittleEndian.PutUint16(header[:], uint16(ext.Type))
+		binary.LittleEndian.PutUint16(header[tlvTypeSize:], uint16(len(ext.Value)))
+		out = append(out, header[:]...)
+		out = append(out, ext.Value...)
+	}
+	return out
+}
+
+// optionalNonZeroPubkey decodes a 32-byte pubkey where the all-zero value means "none".
+func optionalNonZeroPubkey(data []byte) *solana.PublicKey {
+	key := solana.PublicKeyFromBytes(data)
+	if key.IsZero() {
+		return nil
+	}
+	return &key
+}
+
+// putOptionalNonZeroPubkey encodes a pubkey as 32 bytes, where nil is encoded as all zeros.
+func putOptionalNonZeroPubkey(dst []byte, key *solana.PublicKey) {
+	if key == nil {
+		copy(dst, make([]byte, solana.PublicKeyLength))
+		return
+	}
+	copy(dst, key[:])
+}
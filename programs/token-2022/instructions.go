@@ -0,0 +1,258 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The Token-2022 program (Token Extensions) on the Solana blockchain.
+// It is a superset of the Token program: the base instructions and account
+// layouts are the same, and extensions are opt-in on mints and accounts.
+
+package token2022
+
+import (
+	"bytes"
+	"fmt"
+
+	ag_spew "github.com/davecgh/go-spew/spew"
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_text "github.com/gagliardetto/solana-go/text"
+	ag_treeout "github.com/gagliardetto/treeout"
+)
+
+// Maximum number of multisignature signers (max N)
+const MAX_SIGNERS = 11
+
+var ProgramID ag_solanago.PublicKey = ag_solanago.Token2022ProgramID
+
+func SetProgramID(pubkey ag_solanago.PublicKey) {
+	ProgramID = pubkey
+	ag_solanago.RegisterInstructionDecoder(ProgramID, registryDecodeInstruction)
+}
+
+const ProgramName = "Token2022"
+
+func init() {
+	if !ProgramID.IsZero() {
+		ag_solanago.RegisterInstructionDecoder(ProgramID, registryDecodeInstruction)
+	}
+}
+
+// Instruction IDs of the Token-2022 program.
+// IDs 0 to 20 are the same as the ones of the Token program.
+const (
+	Instruction_GetAccountDataSize uint8 = iota + 21
+	Instruction_InitializeImmutableOwner
+	Instruction_AmountToUiAmount
+	Instruction_UiAmountToAmount
+	Instruction_InitializeMintCloseAuthority
+	Instruction_TransferFeeExtension
+	Instruction_ConfidentialTransferExtension
+	Instruction_DefaultAccountStateExtension
+	Instruction_Reallocate
+	Instruction_MemoTransferExtension
+	Instruction_CreateNativeMint
+	Instruction_InitializeNonTransferableMint
+	Instruction_InterestBearingMintExtension
+	Instruction_CpiGuardExtension
+	Instruction_InitializePermanentDelegate
+	Instruction_TransferHookExtension
+	Instruction_ConfidentialTransferFeeExtension
+	Instruction_WithdrawExcessLamports
+	Instruction_MetadataPointerExtension
+	Instruction_GroupPointerExtension
+	Instruction_GroupMemberPointerExtension
+)
+
+// Sub-instruction IDs of the `TransferFeeExtension` instruction.
+const (
+	TransferFeeInstruction_InitializeTransferFeeConfig uint8 = iota
+	TransferFeeInstruction_TransferCheckedWithFee
+	TransferFeeInstruction_WithdrawWithheldTokensFromMint
+	TransferFeeInstruction_WithdrawWithheldTokensFromAccounts
+	TransferFeeInstruction_HarvestWithheldTokensToMint
+	TransferFeeInstruction_SetTransferFee
+)
+
+// InstructionIDToName returns the name of the instruction given its ID.
+func InstructionIDToName(id uint8) string {
+	switch id {
+	case Instruction_GetAccountDataSize:
+		return "GetAccountDataSize"
+	case Instruction_InitializeImmutableOwner:
+		return "InitializeImmutableOwner"
+	case Instruction_AmountToUiAmount:
+		return "AmountToUiAmount"
+	case Instruction_UiAmountToAmount:
+		return "UiAmountToAmount"
+	case Instruction_InitializeMintCloseAuthority:
+		return "InitializeMintCloseAuthority"
+	case Instruction_TransferFeeExtension:
+		return "TransferFeeExtension"
+	case Instruction_ConfidentialTransferExtension:
+		return "ConfidentialTransferExtension"
+	case Instruction_DefaultAccountStateExtension:
+		return "DefaultAccountStateExtension"
+	case Instruction_Reallocate:
+		return "Reallocate"
+	case Instruction_MemoTransferExtension:
+		return "MemoTransferExtension"
+	case Instruction_CreateNativeMint:
+		return "CreateNativeMint"
+	case Instruction_InitializeNonTransferableMint:
+		return "InitializeNonTransferableMint"
+	case Instruction_InterestBearingMintExtension:
+		return "InterestBearingMintExtension"
+	case Instruction_CpiGuardExtension:
+		return "CpiGuardExtension"
+	case Instruction_InitializePermanentDelegate:
+		return "InitializePermanentDelegate"
+	case Instruction_TransferHookExtension:
+		return "TransferHookExtension"
+	case Instruction_ConfidentialTransferFeeExtension:
+		return "ConfidentialTransferFeeExtension"
+	case Instruction_WithdrawExcessLamports:
+		return "WithdrawExcessLamports"
+	case Instruction_MetadataPointerExtension:
+		return "MetadataPointerExtension"
+	case Instruction_GroupPointerExtension:
+		return "GroupPointerExtension"
+	case Instruction_GroupMemberPointerExtension:
+		return "GroupMemberPointerExtension"
+	default:
+		return ""
+	}
+}
+
+// Instruction is a Token-2022 instruction.
+// Extension instructions are identified by the instruction ID
+// plus a sub-instruction ID, which is encoded by the implementation.
+type Instruction struct {
+	ag_binary.BaseVariant
+}
+
+func (inst *Instruction) EncodeToTree(parent ag_treeout.Branches) {
+	if enToTree, ok := inst.Impl.(ag_text.EncodableToTree); ok {
+		enToTree.EncodeToTree(parent)
+	} else {
+		parent.Child(ag_spew.Sdump(inst))
+	}
+}
+
+func (inst *Instruction) ProgramID() ag_solanago.PublicKey {
+	return ProgramID
+}
+
+func (inst *Instruction) Accounts() (out []*ag_solanago.AccountMeta) {
+	return inst.Impl.(ag_solanago.AccountsGettable).GetAccounts()
+}
+
+func (inst *Instruction) Data() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := ag_binary.NewBinEncoder(buf).Encode(inst); err != nil {
+		return nil, fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (inst *Instruction) TextEncode(encoder *ag_text.Encoder, option *ag_text.Option) error {
+	return encoder.Encode(inst.Impl, option)
+}
+
+// instructionKey identifies an instruction by its ID and (for extension instructions) sub-instruction ID.
+type instructionKey struct {
+	id    uint8
+	subID uint8
+}
+
+// instructionImpls maps the supported instructions to a constructor of their implementation.
+var instructionImpls = map[instructionKey]func() interface{}{
+	{Instruction_TransferFeeExtension, TransferFeeInstruction_TransferCheckedWithFee}: func() interface{} { return new(TransferCheckedWithFee) },
+}
+
+// hasSubInstruction returns true if the instruction with the provided ID
+// carries a sub-instruction ID as the second byte of its data.
+func hasSubInstruction(id uint8) bool {
+	switch id {
+	case Instruction_TransferFeeExtension,
+		Instruction_ConfidentialTransferExtension,
+		Instruction_DefaultAccountStateExtension,
+		Instruction_MemoTransferExtension,
+		Instruction_InterestBearingMintExtension,
+		Instruction_CpiGuardExtension,
+		Instruction_TransferHookExtension,
+		Instruction_ConfidentialTransferFeeExtension,
+		Instruction_MetadataPointerExtension,
+		Instruction_GroupPointerExtension,
+		Instruction_GroupMemberPointerExtension:
+		return true
+	default:
+		return false
+	}
+}
+
+func (inst *Instruction) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {
+	id, err := decoder.ReadUint8()
+	if err != nil {
+		return fmt.Errorf("unable to read instruction ID: %w", err)
+	}
+	key := instructionKey{id: id}
+	if hasSubInstruction(id) {
+		// The sub-instruction ID is decoded by the implementation.
+		sub, err := decoder.Peek(1)
+		if err != nil {
+			return fmt.Errorf("unable to read sub-instruction ID: %w", err)
+		}
+		key.subID = sub[0]
+	}
+	newImpl, ok := instructionImpls[key]
+	if !ok {
+		return fmt.Errorf("unsupported instruction: %v (sub-instruction %v)", id, key.subID)
+	}
+	impl := newImpl()
+	if err := decoder.Decode(impl); err != nil {
+		return fmt.Errorf("unable to decode instruction %s: %w", InstructionIDToName(id), err)
+	}
+	inst.TypeID = ag_binary.TypeIDFromUint8(id)
+	inst.Impl = impl
+	return nil
+}
+
+func (inst Instruction) MarshalWithEncoder(encoder *ag_binary.Encoder) error {
+	err := encoder.WriteUint8(inst.TypeID.Uint8())
+	if err != nil {
+		return fmt.Errorf("unable to write variant type: %w", err)
+	}
+	return encoder.Encode(inst.Impl)
+}
+
+func registryDecodeInstruction(accounts []*ag_solanago.AccountMeta, data []byte) (interface{}, error) {
+	inst, err := DecodeInstruction(accounts, data)
+	if err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+func DecodeInstruction(accounts []*ag_solanago.AccountMeta, data []byte) (*Instruction, error) {
+	inst := new(Instruction)
+	if err := ag_binary.NewBinDecoder(data).Decode(inst); err != nil {
+		return nil, fmt.Errorf("unable to decode instruction: %w", err)
+	}
+	if v, ok := inst.Impl.(ag_solanago.AccountsSettable); ok {
+		err := v.SetAccounts(accounts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set accounts for instruction: %w", err)
+		}
+	}
+	return inst, nil
+}
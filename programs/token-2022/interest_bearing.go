@@ -0,0 +1,192 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Number of seconds in a year, as used by the on-chain program.
+const SECONDS_PER_YEAR = 60 * 60 * 24 * 365.24
+
+const interestBearingConfigSize = 32 + 8 + 2 + 8 + 2
+
+// InterestBearingConfig is the state of the `InterestBearingConfig` mint extension.
+// The interest is continuously compounded: the UI amount of a balance grows
+// over time, while the raw amount stays the same.
+type InterestBearingConfig struct {
+	// Authority that can set the interest rate.
+	RateAuthority *solana.PublicKey
+
+	// Timestamp of initialization, from which to base interest calculations.
+	InitializationTimestamp int64
+
+	// Average rate from initialization until the last time it was updated, in basis points.
+	PreUpdateAverageRate int16
+
+	// Timestamp of the last update, used to calculate the total amount accrued.
+	LastUpdateTimestamp int64
+
+	// Current rate, since the last update, in basis points.
+	CurrentRate int16
+}
+
+// DecodeInterestBearingConfig decodes the value of an `InterestBearingConfig` extension.
+func DecodeInterestBearingConfig(data []byte) (*InterestBearingConfig, error) {
+	if len(data) != interestBearingConfigSize {
+		return nil, fmt.Errorf("invalid InterestBearingConfig size: expected %d, got %d", interestBearingConfigSize, len(data))
+	}
+	cfg := new(InterestBearingConfig)
+	cfg.RateAuthority = optionalNonZeroPubkey(data[0:32])
+	cfg.InitializationTimestamp = int64(binary.LittleEndian.Uint64(data[32:40]))
+	cfg.PreUpdateAverageRate = int16(binary.LittleEndian.Uint16(data[40:42]))
+	cfg.LastUpdateTimestamp = int64(binary.LittleEndian.Uint64(data[42:50]))
+	cfg.CurrentRate = int16(binary.LittleEndian.Uint16(data[50:52]))
+	return cfg, nil
+}
+
+// Bytes returns the binary representation of the extension value.
+func (cfg InterestBearingConfig) Bytes() []byte {
+	out := make([]byte, interestBearingConfigSize)
+	putOptionalNonZeroPubkey(out[0:32], cfg.RateAuthority)
+	binary.LittleEndian.PutUint64(out[32:40], uint64(cfg.InitializationTimestamp))
+	binary.LittleEndian.PutUint16(out[40:42], uint16(cfg.PreUpdateAverageRate))
+	binary.LittleEndian.PutUint64(out[42:50], uint64(cfg.LastUpdateTimestamp))
+	binary.LittleEndian.PutUint16(out[50:52], uint16(cfg.CurrentRate))
+	return out
+}
+
+func (cfg InterestBearingConfig) preUpdateExp() float64 {
+	numerator := float64(cfg.LastUpdateTimestamp-cfg.InitializationTimestamp) * float64(cfg.PreUpdateAverageRate)
+	exponent := numerator / SECONDS_PER_YEAR / MAX_FEE_BASIS_POINTS
+	return math.Exp(exponent)
+}
+
+func (cfg InterestBearingConfig) postUpdateExp(unixTimestamp int64) float64 {
+	numerator := float64(unixTimestamp-cfg.LastUpdateTimestamp) * float64(cfg.CurrentRate)
+	exponent := numerator / SECONDS_PER_YEAR / MAX_FEE_BASIS_POINTS
+	return math.Exp(exponent)
+}
+
+func (cfg InterestBearingConfig) totalScale(decimals uint8, unixTimestamp int64) float64 {
+	return cfg.preUpdateExp() * cfg.postUpdateExp(unixTimestamp) / math.Pow10(int(decimals))
+}
+
+// AmountToUiAmount converts a raw amount of tokens to its UI representation
+// (accounting for decimals and the interest accrued at the provided unix timestamp),
+// matching the output of the on-chain `AmountToUiAmount` instruction.
+func (cfg InterestBearingConfig) AmountToUiAmount(amount uint64, decimals uint8, unixTimestamp int64) string {
+	scaled := float64(amount) * cfg.totalScale(decimals, unixTimestamp)
+	if math.IsInf(scaled, 0) {
+		return strconv.FormatUint(math.MaxUint64, 10)
+	}
+	return strconv.FormatFloat(scaled, 'f', -1, 64)
+}
+
+// UiAmountToAmount converts a UI amount of tokens (that includes the interest
+// accrued at the provided unix timestamp) back to a raw amount,
+// matching the output of the on-chain `UiAmountToAmount` instruction.
+func (cfg InterestBearingConfig) UiAmountToAmount(uiAmount string, decimals uint8, unixTimestamp int64) (uint64, error) {
+	scaled, err := strconv.ParseFloat(uiAmount, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid UI amount %q: %w", uiAmount, err)
+	}
+	amount := scaled / cfg.totalScale(decimals, unixTimestamp)
+	if math.IsNaN(amount) || amount < 0 || amount > float64(math.MaxUint64) {
+		return 0, fmt.Errorf("UI amount %q is out of range", uiAmount)
+	}
+	return uint64(math.Round(amount)), nil
+}
+
+// GetInterestBearingConfig returns the decoded `InterestBearingConfig` extension of the mint,
+// or nil if the mint does not have the extension.
+func (mint *Mint) GetInterestBearingConfig() (*InterestBearingConfig, error) {
+	value, ok := mint.Extensions.Get(ExtensionInterestBearingConfig)
+	if !ok {
+		return nil, nil
+	}
+	return DecodeInterestBearingConfig(value)
+}
+
+// AmountToUiAmount converts a raw amount of tokens of this mint to its UI representation.
+// For interest-bearing mints, the interest accrued at the provided unix timestamp is included;
+// for all other mints, the timestamp is ignored.
+func (mint *Mint) AmountToUiAmount(amount uint64, unixTimestamp int64) (string, error) {
+	cfg, err := mint.GetInterestBearingConfig()
+	if err != nil {
+		return "", err
+	}
+	if cfg != nil {
+		return cfg.AmountToUiAmount(amount, mint.Decimals, unixTimestamp), nil
+	}
+	return formatAmountTrimmed(amount, mint.Decimals), nil
+}
+
+// UiAmountToAmount converts a UI amount of tokens of this mint to a raw amount.
+// For interest-bearing mints, the interest accrued at the provided unix timestamp is removed;
+// for all other mints, the timestamp is ignored.
+func (mint *Mint) UiAmountToAmount(uiAmount string, unixTimestamp int64) (uint64, error) {
+	cfg, err := mint.GetInterestBearingConfig()
+	if err != nil {
+		return 0, err
+	}
+	if cfg != nil {
+		return cfg.UiAmountToAmount(uiAmount, mint.Decimals, unixTimestamp)
+	}
+	return parseAmount(uiAmount, mint.Decimals)
+}
+
+// formatAmountTrimmed formats the raw amount with the provided decimals,
+// trimming the trailing zeros (and the decimal point, if there is no fraction).
+func formatAmountTrimmed(amount uint64, decimals uint8) string {
+	s := strconv.FormatUint(amount, 10)
+	if decimals == 0 {
+		return s
+	}
+	if len(s) <= int(decimals) {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+	s = s[:len(s)-int(decimals)] + "." + s[len(s)-int(decimals):]
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// parseAmount parses a decimal UI amount into a raw amount with the provided decimals.
+func parseAmount(uiAmount string, decimals uint8) (uint64, error) {
+	parts := strings.SplitN(uiAmount, ".", 2)
+	whole := parts[0]
+	fraction := ""
+	if len(parts) == 2 {
+		fraction = strings.TrimRight(parts[1], "0")
+	}
+	if len(fraction) > int(decimals) {
+		return 0, fmt.Errorf("UI amount %q has more than %d decimals", uiAmount, decimals)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	digits := whole + fraction + strings.Repeat("0", int(decimals)-len(fraction))
+	amount, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid UI amount %q: %w", uiAmount, err)
+	}
+	return amount, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/stretchr/testify/require"
+)
+
+const intSecondsPerYear = 6 * 6 * 24 * 36524
+
+func TestInterestBearingConfig_AmountToUiAmount(t *testing.T) {
+	// constant 5%
+	cfg := InterestBearingConfig{
+		InitializationTimestamp: 0,
+		PreUpdateAverageRate:    500,
+		LastUpdateTimestamp:     intSecondsPerYear,
+		CurrentRate:             500,
+	}
+	decoded, err := DecodeInterestBearingConfig(cfg.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, &cfg, decoded)
+
+	// 1 year at 5% gives a total of exp(0.05) = 1.0512710963760241
+	require.Equal(t, "1.0512710963760241", cfg.AmountToUiAmount(1, 0, intSecondsPerYear))
+	require.Equal(t, "0.10512710963760241", cfg.AmountToUiAmount(1, 1, intSecondsPerYear))
+	require.Equal(t, "10512710963.76024", cfg.AmountToUiAmount(10_000_000_000, 0, intSecondsPerYear))
+
+	amount, err := cfg.UiAmountToAmount("1.0512710963760241", 0, intSecondsPerYear)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), amount)
+
+	amount, err = cfg.UiAmountToAmount("10512710963.76024", 0, intSecondsPerYear)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10_000_000_000), amount)
+
+	_, err = cfg.UiAmountToAmount("-1", 0, intSecondsPerYear)
+	require.Error(t, err)
+}
+
+func TestMint_AmountToUiAmount(t *testing.T) {
+	mint := &Mint{
+		Mint: token.Mint{
+			Decimals:      6,
+			IsInitialized: true,
+		},
+	}
+	ui, err := mint.AmountToUiAmount(1_500_000, 0)
+	require.NoError(t, err)
+	require.Equal(t, "1.5", ui)
+
+	ui, err = mint.AmountToUiAmount(42, 0)
+	require.NoError(t, err)
+	require.Equal(t, "0.000042", ui)
+
+	ui, err = mint.AmountToUiAmount(3_000_000, 0)
+	require.NoError(t, err)
+	require.Equal(t, "3", ui)
+
+	amount, err := mint.UiAmountToAmount("1.5", 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_500_000), amount)
+
+	_, err = mint.UiAmountToAmount("1.0000001", 0)
+	require.Error(t, err)
+
+	mint.Extensions = ExtensionList{
+		{
+			Type: ExtensionInterestBearingConfig,
+			Value: InterestBearingConfig{
+				PreUpdateAverageRate: 500,
+				LastUpdateTimestamp:  intSecondsPerYear,
+				CurrentRate:          500,
+			}.Bytes(),
+		},
+	}
+	ui, err = mint.AmountToUiAmount(1_000_000, intSecondsPerYear)
+	require.NoError(t, err)
+	require.Equal(t, "1.0512710963760241", ui)
+}
@@ -0,0 +1,370 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	tokenmetadata "github.com/gagliardetto/solana-go/programs/token-metadata"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+const metadataPointerSize = 32 + 32
+
+// MetadataPointer is the state of the `MetadataPointer` mint extension.
+type MetadataPointer struct {
+	// Authority that can set the metadata address.
+	Authority *solana.PublicKey
+
+	// Account address that holds the metadata.
+	MetadataAddress *solana.PublicKey
+}
+
+// DecodeMetadataPointer decodes the value of a `MetadataPointer` extension.
+func DecodeMetadataPointer(data []byte) (*MetadataPointer, error) {
+	if len(data) != metadataPointerSize {
+		return nil, fmt.Errorf("invalid MetadataPointer size: expected %d, got %d", metadataPointerSize, len(data))
+	}
+	return &MetadataPointer{
+		Authority:       optionalNonZeroPubkey(data[0:32]),
+		MetadataAddress: optionalNonZeroPubkey(data[32:64]),
+	}, nil
+}
+
+// Bytes returns the binary representation of the extension value.
+func (ptr MetadataPointer) Bytes() []byte {
+	out := make([]byte, metadataPointerSize)
+	putOptionalNonZeroPubkey(out[0:32], ptr.Authority)
+	putOptionalNonZeroPubkey(out[32:64], ptr.MetadataAddress)
+	return out
+}
+
+// MetadataField is an additional key-value pair of the token metadata.
+type MetadataField struct {
+	Key   string
+	Value string
+}
+
+// TokenMetadata is the state of the `TokenMetadata` mint extension
+// (the SPL Token Metadata Interface), stored directly in the mint account.
+type TokenMetadata struct {
+	// The authority that can sign to update the metadata.
+	UpdateAuthority *solana.PublicKey
+
+	// The associated mint, used to counter spoofing to be sure that metadata
+	// belongs to a particular mint.
+	Mint solana.PublicKey
+
+	// The longer name of the token.
+	Name string
+
+	// The shortened symbol for the token.
+	Symbol string
+
+	// The URI pointing to richer metadata.
+	Uri string
+
+	// Any additional metadata about the token as key-value pairs.
+	AdditionalMetadata []MetadataField
+}
+
+// DecodeTokenMetadata decodes the value of a `TokenMetadata` extension.
+func DecodeTokenMetadata(data []byte) (*TokenMetadata, error) {
+	meta := new(TokenMetadata)
+	if err := bin.NewBorshDecoder(data).Decode(meta); err != nil {
+		return nil, fmt.Errorf("unable to decode token metadata: %w", err)
+	}
+	return meta, nil
+}
+
+func (meta *TokenMetadata) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
+	updateAuthority, err := dec.ReadNBytes(32)
+	if err != nil {
+		return err
+	}
+	meta.UpdateAuthority = optionalNonZeroPubkey(updateAuthority)
+	if _, err = dec.Read(meta.Mint[:]); err != nil {
+		return err
+	}
+	if meta.Name, err = dec.ReadString(); err != nil {
+		return err
+	}
+	if meta.Symbol, err = dec.ReadString(); err != nil {
+		return err
+	}
+	if meta.Uri, err = dec.ReadString(); err != nil {
+		return err
+	}
+	count, err := dec.ReadUint32(bin.LE)
+	if err != nil {
+		return err
+	}
+	// Each field is at least two (empty) strings.
+	if int(count) > dec.Remaining()/8 {
+		return fmt.Errorf("too many additional metadata fields: %d", count)
+	}
+	meta.AdditionalMetadata = make([]MetadataField, count)
+	for i := range meta.AdditionalMetadata {
+		if meta.AdditionalMetadata[i].Key, err = dec.ReadString(); err != nil {
+			return err
+		}
+		if meta.AdditionalMetadata[i].Value, err = dec.ReadString(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (meta TokenMetadata) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	updateAuthority := make([]byte, 32)
+	putOptionalNonZeroPubkey(updateAuthority, meta.UpdateAuthority)
+	if err = encoder.WriteBytes(updateAuthority, false); err != nil {
+		return err
+	}
+	if err = encoder.WriteBytes(meta.Mint[:], false); err != nil {
+		return err
+	}
+	for _, s := range []string{meta.Name, meta.Symbol, meta.Uri} {
+		if err = encoder.WriteString(s); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteUint32(uint32(len(meta.AdditionalMetadata)), bin.LE); err != nil {
+		return err
+	}
+	for _, field := range meta.AdditionalMetadata {
+		if err = encoder.WriteString(field.Key); err != nil {
+			return err
+		}
+		if err = encoder.WriteString(field.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Bytes returns the binary representation of the extension value.
+func (meta TokenMetadata) Bytes() []byte {
+	buf := new(bytes.Buffer)
+	// Writing to a bytes.Buffer never fails.
+	_ = bin.NewBorshEncoder(buf).Encode(meta)
+	return buf.Bytes()
+}
+
+// Get returns the value of the additional metadata field with the provided key.
+func (meta TokenMetadata) Get(key string) (string, bool) {
+	for _, field := range meta.AdditionalMetadata {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// GetMetadataPointer returns the decoded `MetadataPointer` extension of the mint,
+// or nil if the mint does not have the extension.
+func (mint *Mint) GetMetadataPointer() (*MetadataPointer, error) {
+	value, ok := mint.Extensions.Get(ExtensionMetadataPointer)
+	if !ok {
+		return nil, nil
+	}
+	return DecodeMetadataPointer(value)
+}
+
+// GetTokenMetadata returns the decoded `TokenMetadata` extension of the mint,
+// or nil if the mint does not have the extension.
+func (mint *Mint) GetTokenMetadata() (*TokenMetadata, error) {
+	value, ok := mint.Extensions.Get(ExtensionTokenMetadata)
+	if !ok {
+		return nil, nil
+	}
+	return DecodeTokenMetadata(value)
+}
+
+// MetadataSource is where the metadata of a mint was read from.
+type MetadataSource string
+
+const (
+	// The metadata was read from a Token-2022 `TokenMetadata` extension.
+	MetadataSourceToken2022 MetadataSource = "token-2022"
+	// The metadata was read from a Metaplex Token Metadata account.
+	MetadataSourceMetaplex MetadataSource = "metaplex"
+)
+
+// MintMetadata is the metadata of a mint, regardless of where it is stored.
+type MintMetadata struct {
+	Source MetadataSource
+
+	// The account that holds the metadata.
+	Address solana.PublicKey
+
+	Mint            solana.PublicKey
+	UpdateAuthority *solana.PublicKey
+	Name            string
+	Symbol          string
+	Uri             string
+
+	// Only set for Token-2022 metadata.
+	AdditionalMetadata []MetadataField
+
+	// The full Metaplex account; only set for Metaplex metadata.
+	Metaplex *tokenmetadata.Metadata
+}
+
+func newMintMetadataFromToken2022(address solana.PublicKey, meta *TokenMetadata) *MintMetadata {
+	return &MintMetadata{
+		Source:             MetadataSourceToken2022,
+		Address:            address,
+		Mint:               meta.Mint,
+		UpdateAuthority:    meta.UpdateAuthority,
+		Name:               meta.Name,
+		Symbol:             meta.Symbol,
+		Uri:                meta.Uri,
+		AdditionalMetadata: meta.AdditionalMetadata,
+	}
+}
+
+func newMintMetadataFromMetaplex(address solana.PublicKey, meta *tokenmetadata.Metadata) *MintMetadata {
+	updateAuthority := meta.UpdateAuthority
+	return &MintMetadata{
+		Source:          MetadataSourceMetaplex,
+		Address:         address,
+		Mint:            meta.Mint,
+		UpdateAuthority: &updateAuthority,
+		Name:            meta.Data.Name,
+		Symbol:          meta.Data.Symbol,
+		Uri:             meta.Data.Uri,
+		Metaplex:        meta,
+	}
+}
+
+// GetTokenMetadata fetches the metadata of the provided mint (of either
+// the Token or the Token-2022 program), picking the right source:
+// for Token-2022 mints with a `MetadataPointer`, the account it points to
+// (usually the mint itself) is used; otherwise, the Metaplex metadata account
+// of the mint is used.
+func GetTokenMetadata(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	mint solana.PublicKey,
+) (*MintMetadata, error) {
+	account, err := rpcClient.GetAccountInfo(ctx, mint)
+	if err != nil {
+		return nil, err
+	}
+	return GetTokenMetadataOfMintAccount(ctx, rpcClient, mint, account)
+}
+
+// GetTokenMetadataOfMintAccount is like GetTokenMetadata,
+// with the account of the mint already fetched.
+func GetTokenMetadataOfMintAccount(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	mint solana.PublicKey,
+	account *rpc.GetAccountInfoResult,
+) (*MintMetadata, error) {
+	owner := account.Value.Owner
+	switch {
+	case owner.Equals(solana.Token2022ProgramID):
+		mintState, err := DecodeMint(account.GetBinary())
+		if err != nil {
+			return nil, err
+		}
+		meta, err := getToken2022Metadata(ctx, rpcClient, mint, mintState)
+		if err != nil || meta != nil {
+			return meta, err
+		}
+	case owner.Equals(solana.TokenProgramID):
+	default:
+		return nil, fmt.Errorf("account %s is not a mint: owned by %s", mint, owner)
+	}
+
+	addr, _, err := solana.FindTokenMetadataAddress(mint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive metadata address: %w", err)
+	}
+	meta, err := tokenmetadata.GetMetadata(ctx, rpcClient, mint)
+	if err != nil {
+		return nil, err
+	}
+	return newMintMetadataFromMetaplex(addr, meta), nil
+}
+
+// getToken2022Metadata follows the `MetadataPointer` of the mint;
+// returns nil if the mint does not point to any metadata.
+func getToken2022Metadata(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	mint solana.PublicKey,
+	mintState *Mint,
+) (*MintMetadata, error) {
+	ptr, err := mintState.GetMetadataPointer()
+	if err != nil {
+		return nil, err
+	}
+	if ptr == nil || ptr.MetadataAddress == nil {
+		return nil, nil
+	}
+	addr := *ptr.MetadataAddress
+
+	if addr.Equals(mint) {
+		meta, err := mintState.GetTokenMetadata()
+		if err != nil {
+			return nil, err
+		}
+		if meta == nil {
+			return nil, fmt.Errorf("mint %s points to itself but has no TokenMetadata extension", mint)
+		}
+		return newMintMetadataFromToken2022(addr, meta), nil
+	}
+
+	account, err := rpcClient.GetAccountInfo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	switch owner := account.Value.Owner; {
+	case owner.Equals(solana.Token2022ProgramID):
+		other, err := DecodeMint(account.GetBinary())
+		if err != nil {
+			return nil, err
+		}
+		meta, err := other.GetTokenMetadata()
+		if err != nil {
+			return nil, err
+		}
+		if meta == nil {
+			return nil, fmt.Errorf("metadata account %s has no TokenMetadata extension", addr)
+		}
+		if !meta.Mint.Equals(mint) {
+			return nil, fmt.Errorf("metadata account %s belongs to mint %s, not %s", addr, meta.Mint, mint)
+		}
+		return newMintMetadataFromToken2022(addr, meta), nil
+	case owner.Equals(tokenmetadata.ProgramID):
+		meta, err := tokenmetadata.DecodeMetadata(account.GetBinary())
+		if err != nil {
+			return nil, err
+		}
+		if !meta.Mint.Equals(mint) {
+			return nil, fmt.Errorf("metadata account %s belongs to mint %s, not %s", addr, meta.Mint, mint)
+		}
+		return newMintMetadataFromMetaplex(addr, meta), nil
+	default:
+		return nil, fmt.Errorf("unsupported metadata account %s: owned by %s", addr, owner)
+	}
+}
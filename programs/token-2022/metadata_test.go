@@ -0,0 +1,106 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"bytes"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMint_TokenMetadata(t *testing.T) {
+	mintAddress := solana.MustPublicKeyFromBase58("2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo")
+	authority := solana.MustPublicKeyFromBase58("Q6XprfkF8RQQKoQVG33xT88H7wi8Uk1B1CC7YAs69Gi")
+
+	ptr := MetadataPointer{
+		Authority:       authority.ToPointer(),
+		MetadataAddress: mintAddress.ToPointer(),
+	}
+	meta := TokenMetadata{
+		UpdateAuthority: authority.ToPointer(),
+		Mint:            mintAddress,
+		Name:            "PayPal USD",
+		Symbol:          "PYUSD",
+		Uri:             "https://example.com/pyusd.json",
+		AdditionalMetadata: []MetadataField{
+			{Key: "issuer", Value: "Paxos"},
+		},
+	}
+
+	mint := &Mint{
+		Mint: token.Mint{
+			MintAuthority: authority.ToPointer(),
+			Supply:        1_000_000,
+			Decimals:      6,
+			IsInitialized: true,
+		},
+		Extensions: ExtensionList{
+			{Type: ExtensionMetadataPointer, Value: ptr.Bytes()},
+			{Type: ExtensionTokenMetadata, Value: meta.Bytes()},
+		},
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(mint))
+
+	decoded, err := DecodeMint(buf.Bytes())
+	require.NoError(t, err)
+
+	gotPtr, err := decoded.GetMetadataPointer()
+	require.NoError(t, err)
+	require.Equal(t, &ptr, gotPtr)
+
+	gotMeta, err := decoded.GetTokenMetadata()
+	require.NoError(t, err)
+	require.Equal(t, &meta, gotMeta)
+
+	value, ok := gotMeta.Get("issuer")
+	require.True(t, ok)
+	require.Equal(t, "Paxos", value)
+	_, ok = gotMeta.Get("missing")
+	require.False(t, ok)
+
+	// Without the extensions.
+	plain := &Mint{Mint: mint.Mint}
+	gotPtr, err = plain.GetMetadataPointer()
+	require.NoError(t, err)
+	require.Nil(t, gotPtr)
+	gotMeta, err = plain.GetTokenMetadata()
+	require.NoError(t, err)
+	require.Nil(t, gotMeta)
+}
+
+func TestDecodeTokenMetadata_NoUpdateAuthority(t *testing.T) {
+	meta := TokenMetadata{
+		Mint:               solana.MustPublicKeyFromBase58("2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo"),
+		Name:               "Token",
+		Symbol:             "TKN",
+		AdditionalMetadata: []MetadataField{},
+	}
+	data := meta.Bytes()
+	// update_authority + mint + 3 strings + vec length
+	require.Len(t, data, 32+32+(4+5)+(4+3)+4+4)
+
+	decoded, err := DecodeTokenMetadata(data)
+	require.NoError(t, err)
+	require.Nil(t, decoded.UpdateAuthority)
+	require.Equal(t, &meta, decoded)
+
+	_, err = DecodeTokenMetadata(data[:40])
+	require.Error(t, err)
+}
@@ -0,0 +1,32 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"bytes"
+	"fmt"
+	ag_binary "github.com/gagliardetto/binary"
+)
+
+func encodeT(data interface{}, buf *bytes.Buffer) error {
+	if err := ag_binary.NewBinEncoder(buf).Encode(data); err != nil {
+		return fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return nil
+}
+
+func decodeT(dst interface{}, data []byte) error {
+	return ag_binary.NewBinDecoder(data).Decode(dst)
+}
@@ -0,0 +1,169 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Maximum possible fee in basis points is 100%, aka 10_000 basis points.
+const MAX_FEE_BASIS_POINTS = 10_000
+
+const (
+	transferFeeSize       = 8 + 8 + 2
+	transferFeeConfigSize = 32 + 32 + 8 + transferFeeSize + transferFeeSize
+)
+
+// TransferFee is the fee rate in effect starting from a given epoch.
+type TransferFee struct {
+	// First epoch where the transfer fee takes effect.
+	Epoch uint64
+
+	// Maximum fee assessed on transfers, expressed as an amount of tokens.
+	MaximumFee uint64
+
+	// Amount of transfer collected as fees, expressed as basis points of the
+	// transfer amount, ie. increments of 0.01%.
+	TransferFeeBasisPoints uint16
+}
+
+// CalculateFee calculates the fee for the provided pre-fee amount,
+// rounding up and capping at the maximum fee (same as the on-chain program).
+func (fee TransferFee) CalculateFee(preFeeAmount uint64) uint64 {
+	if fee.TransferFeeBasisPoints == 0 || preFeeAmount == 0 {
+		return 0
+	}
+	numerator := new(big.Int).Mul(
+		new(big.Int).SetUint64(preFeeAmount),
+		new(big.Int).SetUint64(uint64(fee.TransferFeeBasisPoints)),
+	)
+	rawFee := ceilDiv(numerator, big.NewInt(MAX_FEE_BASIS_POINTS))
+	if !rawFee.IsUint64() || rawFee.Uint64() > fee.MaximumFee {
+		return fee.MaximumFee
+	}
+	return rawFee.Uint64()
+}
+
+// CalculatePostFeeAmount returns the amount received by the destination
+// after the fee is withheld.
+func (fee TransferFee) CalculatePostFeeAmount(preFeeAmount uint64) uint64 {
+	return preFeeAmount - fee.CalculateFee(preFeeAmount)
+}
+
+func ceilDiv(numerator, denominator *big.Int) *big.Int {
+	out := new(big.Int).Add(numerator, denominator)
+	out.Sub(out, big.NewInt(1))
+	return out.Div(out, denominator)
+}
+
+func (fee *TransferFee) decode(data []byte) {
+	fee.Epoch = binary.LittleEndian.Uint64(data[0:8])
+	fee.MaximumFee = binary.LittleEndian.Uint64(data[8:16])
+	fee.TransferFeeBasisPoints = binary.LittleEndian.Uint16(data[16:18])
+}
+
+func (fee TransferFee) encode(dst []byte) {
+	binary.LittleEndian.PutUint64(dst[0:8], fee.Epoch)
+	binary.LittleEndian.PutUint64(dst[8:16], fee.MaximumFee)
+	binary.LittleEndian.PutUint16(dst[16:18], fee.TransferFeeBasisPoints)
+}
+
+// TransferFeeConfig is the state of the `TransferFeeConfig` mint extension.
+type TransferFeeConfig struct {
+	// Optional authority to set the fee.
+	TransferFeeConfigAuthority *solana.PublicKey
+
+	// Withdraw from mint instructions must be signed by this key.
+	WithdrawWithheldAuthority *solana.PublicKey
+
+	// Withheld transfer fee tokens that have been moved to the mint for withdrawal.
+	WithheldAmount uint64
+
+	// Older transfer fee, used if the current epoch < NewerTransferFee.Epoch.
+	OlderTransferFee TransferFee
+
+	// Newer transfer fee, used if the current epoch >= NewerTransferFee.Epoch.
+	NewerTransferFee TransferFee
+}
+
+// DecodeTransferFeeConfig decodes the value of a `TransferFeeConfig` extension.
+func DecodeTransferFeeConfig(data []byte) (*TransferFeeConfig, error) {
+	if len(data) != transferFeeConfigSize {
+		return nil, fmt.Errorf("invalid TransferFeeConfig size: expected %d, got %d", transferFeeConfigSize, len(data))
+	}
+	cfg := new(TransferFeeConfig)
+	cfg.TransferFeeConfigAuthority = optionalNonZeroPubkey(data[0:32])
+	cfg.WithdrawWithheldAuthority = optionalNonZeroPubkey(data[32:64])
+	cfg.WithheldAmount = binary.LittleEndian.Uint64(data[64:72])
+	cfg.OlderTransferFee.decode(data[72 : 72+transferFeeSize])
+	cfg.NewerTransferFee.decode(data[72+transferFeeSize:])
+	return cfg, nil
+}
+
+// Bytes returns the binary representation of the extension value.
+func (cfg TransferFeeConfig) Bytes() []byte {
+	out := make([]byte, transferFeeConfigSize)
+	putOptionalNonZeroPubkey(out[0:32], cfg.TransferFeeConfigAuthority)
+	putOptionalNonZeroPubkey(out[32:64], cfg.WithdrawWithheldAuthority)
+	binary.LittleEndian.PutUint64(out[64:72], cfg.WithheldAmount)
+	cfg.OlderTransferFee.encode(out[72 : 72+transferFeeSize])
+	cfg.NewerTransferFee.encode(out[72+transferFeeSize:])
+	return out
+}
+
+// GetEpochFee returns the fee in effect at the provided epoch.
+func (cfg TransferFeeConfig) GetEpochFee(epoch uint64) TransferFee {
+	if epoch >= cfg.NewerTransferFee.Epoch {
+		return cfg.NewerTransferFee
+	}
+	return cfg.OlderTransferFee
+}
+
+// CalculateEpochFee calculates the fee for the provided pre-fee amount at the provided epoch.
+func (cfg TransferFeeConfig) CalculateEpochFee(epoch uint64, preFeeAmount uint64) uint64 {
+	return cfg.GetEpochFee(epoch).CalculateFee(preFeeAmount)
+}
+
+// GetTransferFeeConfig returns the decoded `TransferFeeConfig` extension of the mint,
+// or nil if the mint does not have the extension.
+func (mint *Mint) GetTransferFeeConfig() (*TransferFeeConfig, error) {
+	value, ok := mint.Extensions.Get(ExtensionTransferFeeConfig)
+	if !ok {
+		return nil, nil
+	}
+	return DecodeTransferFeeConfig(value)
+}
+
+// CalculateTransferFee calculates the fee withheld when transferring
+// the provided amount of tokens of the provided mint at the provided epoch.
+// If the mint does not have the `TransferFeeConfig` extension, the fee is zero.
+func CalculateTransferFee(mint *Mint, amount uint64, epoch uint64) (uint64, error) {
+	if mint == nil {
+		return 0, errors.New("mint is nil")
+	}
+	cfg, err := mint.GetTransferFeeConfig()
+	if err != nil {
+		return 0, err
+	}
+	if cfg == nil {
+		return 0, nil
+	}
+	return cfg.CalculateEpochFee(epoch, amount), nil
+}
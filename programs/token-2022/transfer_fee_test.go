@@ -0,0 +1,116 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token2022
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferFee_CalculateFee(t *testing.T) {
+	fee := TransferFee{
+		Epoch:                  0,
+		MaximumFee:             5_000,
+		TransferFeeBasisPoints: 100, // 1%
+	}
+	require.Equal(t, uint64(0), fee.CalculateFee(0))
+	// Rounds up.
+	require.Equal(t, uint64(1), fee.CalculateFee(1))
+	require.Equal(t, uint64(1), fee.CalculateFee(100))
+	require.Equal(t, uint64(2), fee.CalculateFee(101))
+	require.Equal(t, uint64(100), fee.CalculateFee(10_000))
+	// Capped at maximum fee.
+	require.Equal(t, uint64(5_000), fee.CalculateFee(1_000_000))
+	// Does not overflow.
+	require.Equal(t, uint64(5_000), fee.CalculateFee(math.MaxUint64))
+	require.Equal(t, uint64(995_000), fee.CalculatePostFeeAmount(1_000_000))
+
+	require.Equal(t, uint64(0), TransferFee{MaximumFee: 10}.CalculateFee(1_000))
+}
+
+func TestCalculateTransferFee(t *testing.T) {
+	authority := solana.MustPublicKeyFromBase58("Q6XprfkF8RQQKoQVG33xT88H7wi8Uk1B1CC7YAs69Gi")
+	cfg := TransferFeeConfig{
+		TransferFeeConfigAuthority: authority.ToPointer(),
+		WithheldAmount:             42,
+		OlderTransferFee: TransferFee{
+			Epoch:                  100,
+			MaximumFee:             math.MaxUint64,
+			TransferFeeBasisPoints: 50,
+		},
+		NewerTransferFee: TransferFee{
+			Epoch:                  200,
+			MaximumFee:             math.MaxUint64,
+			TransferFeeBasisPoints: 250,
+		},
+	}
+	decodedCfg, err := DecodeTransferFeeConfig(cfg.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, &cfg, decodedCfg)
+
+	mint := &Mint{
+		Mint: token.Mint{
+			MintAuthority: authority.ToPointer(),
+			Supply:        1_000_000_000,
+			Decimals:      6,
+			IsInitialized: true,
+		},
+		Extensions: ExtensionList{
+			{Type: ExtensionTransferFeeConfig, Value: cfg.Bytes()},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(mint))
+	require.Equal(t, ACCOUNT_SIZE+1+4+transferFeeConfigSize, buf.Len())
+
+	decoded, err := DecodeMint(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, mint, decoded)
+
+	fee, err := CalculateTransferFee(decoded, 1_000_000, 150)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5_000), fee)
+
+	fee, err = CalculateTransferFee(decoded, 1_000_000, 200)
+	require.NoError(t, err)
+	require.Equal(t, uint64(25_000), fee)
+
+	// No extension, no fee.
+	fee, err = CalculateTransferFee(&Mint{Mint: mint.Mint}, 1_000_000, 200)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), fee)
+
+	inst, err := NewTransferCheckedWithFeeInstructionFromMint(
+		1_000_000,
+		decoded,
+		200,
+		solana.NewWallet().PublicKey(),
+		solana.NewWallet().PublicKey(),
+		solana.NewWallet().PublicKey(),
+		authority,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(25_000), *inst.Fee)
+	require.NoError(t, inst.ValidateFee(decoded, 200))
+	require.Error(t, inst.ValidateFee(decoded, 150))
+}
@@ -0,0 +1,185 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenmetadata
+
+import (
+	"bytes"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+// Instruction_CreateMetadataAccountV3 is the discriminator of the
+// `CreateMetadataAccountV3` instruction of the Token Metadata program.
+const Instruction_CreateMetadataAccountV3 uint8 = 33
+
+// CreateMetadataAccountV3 creates the `Metadata` account of a mint.
+type CreateMetadataAccountV3 struct {
+	AssetData  Data
+	Collection *Collection
+	Uses       *Uses
+	IsMutable  bool
+
+	// [0] = [WRITE] metadata
+	// ··········· The metadata account (see solana.FindTokenMetadataAddress).
+	//
+	// [1] = [] mint
+	//
+	// [2] = [SIGNER] mintAuthority
+	//
+	// [3] = [WRITE, SIGNER] payer
+	//
+	// [4] = [] updateAuthority
+	//
+	// [5] = [] systemProgram
+	solana.AccountMetaSlice `bin:"-" borsh_skip:"true"`
+}
+
+// NewCreateMetadataAccountV3Instruction declares a new CreateMetadataAccountV3
+// instruction; the metadata account is derived from the mint.
+func NewCreateMetadataAccountV3Instruction(
+	// Parameters:
+	data Data,
+	isMutable bool,
+	// Accounts:
+	mint solana.PublicKey,
+	mintAuthority solana.PublicKey,
+	payer solana.PublicKey,
+	updateAuthority solana.PublicKey,
+) (*CreateMetadataAccountV3, error) {
+	metadata, _, err := solana.FindTokenMetadataAddress(mint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive the metadata address: %w", err)
+	}
+	return &CreateMetadataAccountV3{
+		AssetData: data,
+		IsMutable: isMutable,
+		AccountMetaSlice: solana.AccountMetaSlice{
+			solana.Meta(metadata).WRITE(),
+			solana.Meta(mint),
+			solana.Meta(mintAuthority).SIGNER(),
+			solana.Meta(payer).WRITE().SIGNER(),
+			solana.Meta(updateAuthority),
+			solana.Meta(solana.SystemProgramID),
+		},
+	}, nil
+}
+
+// SetCollection sets the (unverified) collection of the asset.
+func (inst *CreateMetadataAccountV3) SetCollection(collection solana.PublicKey) *CreateMetadataAccountV3 {
+	inst.Collection = &Collection{Key: collection}
+	return inst
+}
+
+// SetUses sets the uses of the asset.
+func (inst *CreateMetadataAccountV3) SetUses(uses Uses) *CreateMetadataAccountV3 {
+	inst.Uses = &uses
+	return inst
+}
+
+// GetMetadataAccount gets the "metadata" account.
+func (inst *CreateMetadataAccountV3) GetMetadataAccount() *solana.AccountMeta {
+	return inst.AccountMetaSlice[0]
+}
+
+// Build returns the instruction; it implements solana.Instruction.
+func (inst CreateMetadataAccountV3) Build() solana.Instruction {
+	return &inst
+}
+
+func (inst *CreateMetadataAccountV3) ProgramID() solana.PublicKey {
+	return ProgramID
+}
+
+func (inst *CreateMetadataAccountV3) Accounts() []*solana.AccountMeta {
+	return inst.AccountMetaSlice
+}
+
+func (inst *CreateMetadataAccountV3) Data() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := inst.MarshalWithEncoder(bin.NewBorshEncoder(buf)); err != nil {
+		return nil, fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (inst CreateMetadataAccountV3) MarshalWithEncoder(enc *bin.Encoder) (err error) {
+	if err = enc.WriteUint8(Instruction_CreateMetadataAccountV3); err != nil {
+		return err
+	}
+	// DataV2:
+	if err = enc.WriteString(inst.AssetData.Name); err != nil {
+		return err
+	}
+	if err = enc.WriteString(inst.AssetData.Symbol); err != nil {
+		return err
+	}
+	if err = enc.WriteString(inst.AssetData.Uri); err != nil {
+		return err
+	}
+	if err = enc.WriteUint16(inst.AssetData.SellerFeeBasisPoints, bin.LE); err != nil {
+		return err
+	}
+	if err = enc.WriteOption(inst.AssetData.Creators != nil); err != nil {
+		return err
+	}
+	if inst.AssetData.Creators != nil {
+		if err = enc.WriteUint32(uint32(len(inst.AssetData.Creators)), bin.LE); err != nil {
+			return err
+		}
+		for _, creator := range inst.AssetData.Creators {
+			if _, err = enc.Write(creator.Address[:]); err != nil {
+				return err
+			}
+			if err = enc.WriteBool(creator.Verified); err != nil {
+				return err
+			}
+			if err = enc.WriteUint8(creator.Share); err != nil {
+				return err
+			}
+		}
+	}
+	if err = enc.WriteOption(inst.Collection != nil); err != nil {
+		return err
+	}
+	if inst.Collection != nil {
+		if err = enc.WriteBool(inst.Collection.Verified); err != nil {
+			return err
+		}
+		if _, err = enc.Write(inst.Collection.Key[:]); err != nil {
+			return err
+		}
+	}
+	if err = enc.WriteOption(inst.Uses != nil); err != nil {
+		return err
+	}
+	if inst.Uses != nil {
+		if err = enc.WriteUint8(uint8(inst.Uses.UseMethod)); err != nil {
+			return err
+		}
+		if err = enc.WriteUint64(inst.Uses.Remaining, bin.LE); err != nil {
+			return err
+		}
+		if err = enc.WriteUint64(inst.Uses.Total, bin.LE); err != nil {
+			return err
+		}
+	}
+	if err = enc.WriteBool(inst.IsMutable); err != nil {
+		return err
+	}
+	// The collection details are not supported.
+	return enc.WriteOption(false)
+}
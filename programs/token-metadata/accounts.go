@@ -0,0 +1,250 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenmetadata
+
+import (
+	"fmt"
+	"strings"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+// Key is the discriminator of the accounts of the Token Metadata program.
+type Key uint8
+
+const (
+	KeyUninitialized Key = iota
+	KeyEditionV1
+	KeyMasterEditionV1
+	KeyReservationListV1
+	KeyMetadataV1
+	KeyReservationListV2
+	KeyMasterEditionV2
+	KeyEditionMarker
+	KeyUseAuthorityRecord
+	KeyCollectionAuthorityRecord
+	KeyTokenOwnedEscrow
+	KeyTokenRecord
+	KeyMetadataDelegate
+	KeyEditionMarkerV2
+)
+
+type TokenStandard uint8
+
+const (
+	TokenStandardNonFungible TokenStandard = iota
+	TokenStandardFungibleAsset
+	TokenStandardFungible
+	TokenStandardNonFungibleEdition
+	TokenStandardProgrammableNonFungible
+	TokenStandardProgrammableNonFungibleEdition
+)
+
+type Creator struct {
+	Address  solana.PublicKey
+	Verified bool
+	// In percentages, NOT basis points.
+	Share uint8
+}
+
+type Data struct {
+	// The name of the asset.
+	Name string
+	// The symbol for the asset.
+	Symbol string
+	// URI pointing to JSON representing the asset.
+	Uri string
+	// Royalty basis points that goes to creators in secondary sales (0-10000).
+	SellerFeeBasisPoints uint16
+	// Array of creators, optional.
+	Creators []Creator
+}
+
+type Collection struct {
+	Verified bool
+	Key      solana.PublicKey
+}
+
+type UseMethod uint8
+
+const (
+	UseMethodBurn UseMethod = iota
+	UseMethodMultiple
+	UseMethodSingle
+)
+
+type Uses struct {
+	UseMethod UseMethod
+	Remaining uint64
+	Total     uint64
+}
+
+// Metadata is the `Metadata` account of the Token Metadata program.
+// Fields that were added to the account over time are optional,
+// and are left nil for accounts that were created before they existed.
+type Metadata struct {
+	Key                 Key
+	UpdateAuthority     solana.PublicKey
+	Mint                solana.PublicKey
+	Data                Data
+	PrimarySaleHappened bool
+	IsMutable           bool
+	EditionNonce        *uint8
+	TokenStandard       *TokenStandard
+	Collection          *Collection
+	Uses                *Uses
+}
+
+// DecodeMetadata decodes the provided `Metadata` account data.
+func DecodeMetadata(data []byte) (*Metadata, error) {
+	meta := new(Metadata)
+	if err := bin.NewBorshDecoder(data).Decode(meta); err != nil {
+		return nil, fmt.Errorf("unable to decode metadata: %w", err)
+	}
+	return meta, nil
+}
+
+// trimString removes the null padding that the program adds to the strings.
+func trimString(s string) string {
+	return strings.TrimRight(s, "\x00")
+}
+
+func (meta *Metadata) UnmarshalWithDecoder(dec *bin.Decoder) (err error) {
+	key, err := dec.ReadUint8()
+	if err != nil {
+		return err
+	}
+	meta.Key = Key(key)
+	if meta.Key != KeyMetadataV1 {
+		return fmt.Errorf("not a metadata account: key is %d", key)
+	}
+	if _, err = dec.Read(meta.UpdateAuthority[:]); err != nil {
+		return err
+	}
+	if _, err = dec.Read(meta.Mint[:]); err != nil {
+		return err
+	}
+	if meta.Data.Name, err = dec.ReadString(); err != nil {
+		return err
+	}
+	meta.Data.Name = trimString(meta.Data.Name)
+	if meta.Data.Symbol, err = dec.ReadString(); err != nil {
+		return err
+	}
+	meta.Data.Symbol = trimString(meta.Data.Symbol)
+	if meta.Data.Uri, err = dec.ReadString(); err != nil {
+		return err
+	}
+	meta.Data.Uri = trimString(meta.Data.Uri)
+	if meta.Data.SellerFeeBasisPoints, err = dec.ReadUint16(bin.LE); err != nil {
+		return err
+	}
+	{
+		ok, err := dec.ReadOption()
+		if err != nil {
+			return err
+		}
+		if ok {
+			count, err := dec.ReadUint32(bin.LE)
+			if err != nil {
+				return err
+			}
+			if int(count) > dec.Remaining()/(32+1+1) {
+				return fmt.Errorf("too many creators: %d", count)
+			}
+			meta.Data.Creators = make([]Creator, count)
+			for i := range meta.Data.Creators {
+				if _, err = dec.Read(meta.Data.Creators[i].Address[:]); err != nil {
+					return err
+				}
+				if meta.Data.Creators[i].Verified, err = dec.ReadBool(); err != nil {
+					return err
+				}
+				if meta.Data.Creators[i].Share, err = dec.ReadUint8(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if meta.PrimarySaleHappened, err = dec.ReadBool(); err != nil {
+		return err
+	}
+	if meta.IsMutable, err = dec.ReadBool(); err != nil {
+		return err
+	}
+
+	// The following fields were added in later versions of the program.
+	if !dec.HasRemaining() {
+		return nil
+	}
+	if ok, err := dec.ReadOption(); err != nil {
+		return err
+	} else if ok {
+		v, err := dec.ReadUint8()
+		if err != nil {
+			return err
+		}
+		meta.EditionNonce = &v
+	}
+	if !dec.HasRemaining() {
+		return nil
+	}
+	if ok, err := dec.ReadOption(); err != nil {
+		return err
+	} else if ok {
+		v, err := dec.ReadUint8()
+		if err != nil {
+			return err
+		}
+		standard := TokenStandard(v)
+		meta.TokenStandard = &standard
+	}
+	if !dec.HasRemaining() {
+		return nil
+	}
+	if ok, err := dec.ReadOption(); err != nil {
+		return err
+	} else if ok {
+		meta.Collection = new(Collection)
+		if meta.Collection.Verified, err = dec.ReadBool(); err != nil {
+			return err
+		}
+		if _, err = dec.Read(meta.Collection.Key[:]); err != nil {
+			return err
+		}
+	}
+	if !dec.HasRemaining() {
+		return nil
+	}
+	if ok, err := dec.ReadOption(); err != nil {
+		return err
+	} else if ok {
+		meta.Uses = new(Uses)
+		v, err := dec.ReadUint8()
+		if err != nil {
+			return err
+		}
+		meta.Uses.UseMethod = UseMethod(v)
+		if meta.Uses.Remaining, err = dec.ReadUint64(bin.LE); err != nil {
+			return err
+		}
+		if meta.Uses.Total, err = dec.ReadUint64(bin.LE); err != nil {
+			return err
+		}
+	}
+	// Ignore the rest (collection details, programmable config, padding).
+	return dec.Discard(dec.Remaining())
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenmetadata
+
+import (
+	"bytes"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func paddedString(s string, size int) string {
+	return s + string(make([]byte, size-len(s)))
+}
+
+func TestDecodeMetadata(t *testing.T) {
+	updateAuthority := solana.MustPublicKeyFromBase58("Q6XprfkF8RQQKoQVG33xT88H7wi8Uk1B1CC7YAs69Gi")
+	mint := solana.MustPublicKeyFromBase58("2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo")
+	creator := solana.MustPublicKeyFromBase58("9Q1EFpFgnqGbFGAvXLWYBfGWAbJ97Gv8vsEcVFq6mhyg")
+	collection := solana.MustPublicKeyFromBase58("5Wdwx9TCq5dDsZCBfWcGxjTqmJsBTn3iDixoDeDmDbDh")
+
+	buf := new(bytes.Buffer)
+	enc := bin.NewBorshEncoder(buf)
+	require.NoError(t, enc.WriteUint8(uint8(KeyMetadataV1)))
+	require.NoError(t, enc.WriteBytes(updateAuthority[:], false))
+	require.NoError(t, enc.WriteBytes(mint[:], false))
+	require.NoError(t, enc.WriteString(paddedString("My NFT", 32)))
+	require.NoError(t, enc.WriteString(paddedString("NFT", 10)))
+	require.NoError(t, enc.WriteString(paddedString("https://example.com/1.json", 200)))
+	require.NoError(t, enc.WriteUint16(500, bin.LE))
+	// creators: Some([creator])
+	require.NoError(t, enc.WriteOption(true))
+	require.NoError(t, enc.WriteUint32(1, bin.LE))
+	require.NoError(t, enc.WriteBytes(creator[:], false))
+	require.NoError(t, enc.WriteBool(true))
+	require.NoError(t, enc.WriteUint8(100))
+	// primary_sale_happened, is_mutable
+	require.NoError(t, enc.WriteBool(false))
+	require.NoError(t, enc.WriteBool(true))
+	// edition_nonce: Some(254)
+	require.NoError(t, enc.WriteOption(true))
+	require.NoError(t, enc.WriteUint8(254))
+	// token_standard: Some(NonFungible)
+	require.NoError(t, enc.WriteOption(true))
+	require.NoError(t, enc.WriteUint8(uint8(TokenStandardNonFungible)))
+	// collection: Some(verified)
+	require.NoError(t, enc.WriteOption(true))
+	require.NoError(t, enc.WriteBool(true))
+	require.NoError(t, enc.WriteBytes(collection[:], false))
+	// uses: None
+	require.NoError(t, enc.WriteOption(false))
+	// trailing padding
+	require.NoError(t, enc.WriteBytes(make([]byte, 64), false))
+
+	meta, err := DecodeMetadata(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, KeyMetadataV1, meta.Key)
+	require.Equal(t, updateAuthority, meta.UpdateAuthority)
+	require.Equal(t, mint, meta.Mint)
+	require.Equal(t, "My NFT", meta.Data.Name)
+	require.Equal(t, "NFT", meta.Data.Symbol)
+	require.Equal(t, "https://example.com/1.json", meta.Data.Uri)
+	require.Equal(t, uint16(500), meta.Data.SellerFeeBasisPoints)
+	require.Equal(t, []Creator{{Address: creator, Verified: true, Share: 100}}, meta.Data.Creators)
+	require.False(t, meta.PrimarySaleHappened)
+	require.True(t, meta.IsMutable)
+	require.Equal(t, uint8(254), *meta.EditionNonce)
+	require.Equal(t, TokenStandardNonFungible, *meta.TokenStandard)
+	require.Equal(t, &Collection{Verified: true, Key: collection}, meta.Collection)
+	require.Nil(t, meta.Uses)
+
+	_, err = DecodeMetadata([]byte{byte(KeyMasterEditionV2)})
+	require.Error(t, err)
+}
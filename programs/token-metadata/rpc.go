@@ -0,0 +1,45 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenmetadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+var ProgramID = solana.TokenMetadataProgramID
+
+// GetMetadata fetches and decodes the `Metadata` account of the provided mint.
+func GetMetadata(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	mint solana.PublicKey,
+) (*Metadata, error) {
+	addr, _, err := solana.FindTokenMetadataAddress(mint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive metadata address: %w", err)
+	}
+	account, err := rpcClient.GetAccountInfo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if err := rpc.AssertOwnedBy(account.Value, ProgramID); err != nil {
+		return nil, fmt.Errorf("invalid metadata account %s: %w", addr, err)
+	}
+	return DecodeMetadata(account.GetBinary())
+}
@@ -0,0 +1,325 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenmetadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// Offset of the update authority in the `Metadata` account.
+	updateAuthorityOffset = 1
+	// Offset of the first creator in the `Metadata` account, given
+	// the padding of the name, symbol and uri to their maximum length.
+	firstCreatorOffset = 1 + 32 + 32 + (4 + 32) + (4 + 10) + (4 + 200) + 2 + 1 + 4
+
+	// Maximum number of accounts of a getMultipleAccounts call.
+	maxMultipleAccounts = 100
+)
+
+// SnapshotStage is a stage of a holder snapshot.
+type SnapshotStage string
+
+const (
+	// The metadata accounts are fetched with getProgramAccounts.
+	SnapshotStageMetadata SnapshotStage = "metadata"
+	// The token accounts of the mints are resolved with getTokenLargestAccounts.
+	SnapshotStageTokenAccounts SnapshotStage = "token_accounts"
+	// The owners of the token accounts are resolved with getMultipleAccounts.
+	SnapshotStageOwners SnapshotStage = "owners"
+)
+
+type SnapshotOpts struct {
+	// The metadata accounts of the collection are found with getProgramAccounts,
+	// filtered on their update authority or their first creator (e.g. the
+	// candy machine): one of them is required. The collection of each account is
+	// checked after decoding.
+	UpdateAuthority *solana.PublicKey
+	FirstCreator    *solana.PublicKey
+
+	// Include the NFTs whose collection is not verified.
+	IncludeUnverified bool
+
+	Commitment rpc.CommitmentType
+
+	// Rate limit of the requests; zero for no limit.
+	RequestsPerSecond rate.Limit
+	// Number of concurrent requests.
+	// Defaults to 4.
+	Concurrency int
+
+	// Called after each request with the progress of the current stage.
+	Progress func(stage SnapshotStage, done, total int)
+}
+
+// Holding is a token account holding NFTs of a collection.
+type Holding struct {
+	Mint         solana.PublicKey
+	TokenAccount solana.PublicKey
+	Owner        solana.PublicKey
+	Amount       uint64
+}
+
+// HolderSnapshot are the holders of the NFTs of a collection.
+type HolderSnapshot struct {
+	Collection solana.PublicKey
+	// The metadata of the NFTs of the collection.
+	Metadata []*Metadata
+	// The holdings of the NFTs, in the order of the mints;
+	// the burned NFTs have none.
+	Holdings []*Holding
+}
+
+// Holders returns the number of NFTs held by each owner.
+func (snapshot *HolderSnapshot) Holders() map[solana.PublicKey]uint64 {
+	holders := make(map[solana.PublicKey]uint64)
+	for _, holding := range snapshot.Holdings {
+		holders[holding.Owner] += holding.Amount
+	}
+	return holders
+}
+
+// TakeHolderSnapshot finds the NFTs of the collection (see FindCollectionMetadata),
+// and resolves their current holders (see ResolveHolders), without the DAS API.
+func TakeHolderSnapshot(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	collection solana.PublicKey,
+	opts *SnapshotOpts,
+) (*HolderSnapshot, error) {
+	if opts == nil {
+		opts = &SnapshotOpts{}
+	}
+	metadata, err := FindCollectionMetadata(ctx, rpcClient, collection, opts)
+	if err != nil {
+		return nil, err
+	}
+	mints := make([]solana.PublicKey, len(metadata))
+	for i, meta := range metadata {
+		mints[i] = meta.Mint
+	}
+	holdings, err := ResolveHolders(ctx, rpcClient, mints, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &HolderSnapshot{
+		Collection: collection,
+		Metadata:   metadata,
+		Holdings:   holdings,
+	}, nil
+}
+
+// FindCollectionMetadata returns the `Metadata` accounts of the NFTs of the
+// collection, sorted by mint. Only opts.UpdateAuthority, opts.FirstCreator,
+// opts.IncludeUnverified, opts.Commitment and opts.Progress are used.
+func FindCollectionMetadata(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	collection solana.PublicKey,
+	opts *SnapshotOpts,
+) ([]*Metadata, error) {
+	filters := []rpc.RPCFilter{
+		{Memcmp: &rpc.RPCFilterMemcmp{Offset: 0, Bytes: solana.Base58{byte(KeyMetadataV1)}}},
+	}
+	if opts.UpdateAuthority != nil {
+		filters = append(filters, rpc.RPCFilter{Memcmp: &rpc.RPCFilterMemcmp{Offset: updateAuthorityOffset, Bytes: opts.UpdateAuthority.Bytes()}})
+	}
+	if opts.FirstCreator != nil {
+		filters = append(filters, rpc.RPCFilter{Memcmp: &rpc.RPCFilterMemcmp{Offset: firstCreatorOffset, Bytes: opts.FirstCreator.Bytes()}})
+	}
+	if len(filters) == 1 {
+		return nil, errors.New("an update authority or a first creator is required to find the metadata accounts")
+	}
+
+	accounts, err := rpcClient.GetProgramAccountsWithOpts(ctx, ProgramID, &rpc.GetProgramAccountsOpts{
+		Commitment: opts.Commitment,
+		Encoding:   solana.EncodingBase64,
+		Filters:    filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the metadata accounts: %w", err)
+	}
+	if opts.Progress != nil {
+		opts.Progress(SnapshotStageMetadata, 1, 1)
+	}
+
+	var out []*Metadata
+	for _, account := range accounts {
+		meta, err := DecodeMetadata(account.Account.Data.GetBinary())
+		if err != nil {
+			// Not a valid metadata account: not part of the collection.
+			continue
+		}
+		if meta.Collection == nil || !meta.Collection.Key.Equals(collection) {
+			continue
+		}
+		if !meta.Collection.Verified && !opts.IncludeUnverified {
+			continue
+		}
+		out = append(out, meta)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Mint.String() < out[j].Mint.String() })
+	return out, nil
+}
+
+// ResolveHolders returns the token accounts holding the mints and their owners,
+// using getTokenLargestAccounts for each mint, and getMultipleAccounts for the owners.
+// Only opts.Commitment, opts.RequestsPerSecond, opts.Concurrency and opts.Progress are used.
+func ResolveHolders(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	mints []solana.PublicKey,
+	opts *SnapshotOpts,
+) ([]*Holding, error) {
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	if opts.RequestsPerSecond > 0 {
+		limiter = rate.NewLimiter(opts.RequestsPerSecond, 1)
+	}
+
+	largest := make([][]*Holding, len(mints))
+	err := forEachConcurrently(ctx, len(mints), opts, SnapshotStageTokenAccounts, func(ctx context.Context, i int) error {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		out, err := rpcClient.GetTokenLargestAccounts(ctx, mints[i], opts.Commitment)
+		if err != nil {
+			return fmt.Errorf("unable to get the token accounts of %s: %w", mints[i], err)
+		}
+		for _, account := range out.Value {
+			amount := account.Raw()
+			if amount.Sign() == 0 {
+				continue
+			}
+			largest[i] = append(largest[i], &Holding{
+				Mint:         mints[i],
+				TokenAccount: account.Address,
+				Amount:       amount.Uint64(),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var holdings []*Holding
+	for _, mintHoldings := range largest {
+		holdings = append(holdings, mintHoldings...)
+	}
+
+	// The owner is at offset 32 of the token accounts.
+	offset, length := uint64(32), uint64(32)
+	chunks := (len(holdings) + maxMultipleAccounts - 1) / maxMultipleAccounts
+	err = forEachConcurrently(ctx, chunks, opts, SnapshotStageOwners, func(ctx context.Context, i int) error {
+		chunk := holdings[i*maxMultipleAccounts:]
+		if len(chunk) > maxMultipleAccounts {
+			chunk = chunk[:maxMultipleAccounts]
+		}
+		accounts := make([]solana.PublicKey, len(chunk))
+		for j, holding := range chunk {
+			accounts[j] = holding.TokenAccount
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		out, err := rpcClient.GetMultipleAccountsWithOpts(ctx, accounts, &rpc.GetMultipleAccountsOpts{
+			Encoding:   solana.EncodingBase64,
+			Commitment: opts.Commitment,
+			DataSlice:  &rpc.DataSlice{Offset: &offset, Length: &length},
+		})
+		if err != nil {
+			return fmt.Errorf("unable to get the token accounts: %w", err)
+		}
+		if len(out.Value) != len(chunk) {
+			return fmt.Errorf("got %d token accounts, expected %d", len(out.Value), len(chunk))
+		}
+		for j, account := range out.Value {
+			if account == nil || len(account.Data.GetBinary()) != 32 {
+				return fmt.Errorf("token account %s not found", chunk[j].TokenAccount)
+			}
+			chunk[j].Owner = solana.PublicKeyFromBytes(account.Data.GetBinary())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return holdings, nil
+}
+
+// forEachConcurrently calls fn for each index from 0 to count with opts.Concurrency
+// goroutines, reporting the progress of the stage. It returns the first error.
+func forEachConcurrently(
+	ctx context.Context,
+	count int,
+	opts *SnapshotOpts,
+	stage SnapshotStage,
+	fn func(ctx context.Context, i int) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	indexes := make(chan int)
+	var (
+		mu       sync.Mutex
+		done     int
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				err := fn(ctx, i)
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				done++
+				if err == nil && opts.Progress != nil {
+					opts.Progress(stage, done, count)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+loop:
+	for i := 0; i < count; i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenmetadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestMetadata(t *testing.T, updateAuthority, mint, creator solana.PublicKey, collection *Collection) []byte {
+	buf := new(bytes.Buffer)
+	enc := bin.NewBorshEncoder(buf)
+	require.NoError(t, enc.WriteUint8(uint8(KeyMetadataV1)))
+	require.NoError(t, enc.WriteBytes(updateAuthority[:], false))
+	require.NoError(t, enc.WriteBytes(mint[:], false))
+	require.NoError(t, enc.WriteString(paddedString("NFT", 32)))
+	require.NoError(t, enc.WriteString(paddedString("NFT", 10)))
+	require.NoError(t, enc.WriteString(paddedString("https://example.com/1.json", 200)))
+	require.NoError(t, enc.WriteUint16(500, bin.LE))
+	require.NoError(t, enc.WriteOption(true))
+	require.NoError(t, enc.WriteUint32(1, bin.LE))
+	require.NoError(t, enc.WriteBytes(creator[:], false))
+	require.NoError(t, enc.WriteBool(true))
+	require.NoError(t, enc.WriteUint8(100))
+	require.NoError(t, enc.WriteBool(false))
+	require.NoError(t, enc.WriteBool(true))
+	require.NoError(t, enc.WriteOption(false))
+	require.NoError(t, enc.WriteOption(false))
+	require.NoError(t, enc.WriteOption(collection != nil))
+	if collection != nil {
+		require.NoError(t, enc.WriteBool(collection.Verified))
+		require.NoError(t, enc.WriteBytes(collection.Key[:], false))
+	}
+	require.NoError(t, enc.WriteOption(false))
+	return buf.Bytes()
+}
+
+func TestTakeHolderSnapshot(t *testing.T) {
+	updateAuthority := solana.PublicKey{1}
+	creator := solana.PublicKey{2}
+	collection := solana.PublicKey{3}
+	held, burned, unverified, other := solana.PublicKey{4}, solana.PublicKey{5}, solana.PublicKey{6}, solana.PublicKey{7}
+	tokenAccount, owner := solana.PublicKey{8}, solana.PublicKey{9}
+
+	metadata := []string{
+		base64.StdEncoding.EncodeToString(encodeTestMetadata(t, updateAuthority, held, creator, &Collection{Verified: true, Key: collection})),
+		base64.StdEncoding.EncodeToString(encodeTestMetadata(t, updateAuthority, burned, creator, &Collection{Verified: true, Key: collection})),
+		base64.StdEncoding.EncodeToString(encodeTestMetadata(t, updateAuthority, unverified, creator, &Collection{Verified: false, Key: collection})),
+		base64.StdEncoding.EncodeToString(encodeTestMetadata(t, updateAuthority, other, creator, nil)),
+	}
+	// The first creator filter must match the encoded accounts.
+	data, err := base64.StdEncoding.DecodeString(metadata[0])
+	require.NoError(t, err)
+	require.Equal(t, creator[:], data[firstCreatorOffset:firstCreatorOffset+32])
+
+	var mu sync.Mutex
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		var request struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		require.NoError(t, json.Unmarshal(body, &request))
+		mu.Lock()
+		methods = append(methods, request.Method)
+		mu.Unlock()
+
+		var result string
+		switch request.Method {
+		case "getProgramAccounts":
+			require.Contains(t, string(body), fmt.Sprintf(`{"memcmp":{"offset":326,"bytes":"%s"}}`, creator))
+			var accounts []string
+			for i, data := range metadata {
+				accounts = append(accounts, fmt.Sprintf(`{"pubkey":"%s","account":{"lamports":1,"owner":"%s","data":["%s","base64"],"executable":false,"rentEpoch":0}}`,
+					solana.PublicKey{byte(100 + i)}, ProgramID, data))
+			}
+			result = "[" + strings.Join(accounts, ",") + "]"
+		case "getTokenLargestAccounts":
+			amount := "0"
+			if request.Params[0] == held.String() {
+				amount = "1"
+			}
+			result = fmt.Sprintf(`{"context":{"slot":1},"value":[{"address":"%s","amount":"%s","decimals":0,"uiAmount":null,"uiAmountString":"%s"}]}`, tokenAccount, amount, amount)
+		case "getMultipleAccounts":
+			require.Equal(t, []interface{}{tokenAccount.String()}, request.Params[0])
+			result = fmt.Sprintf(`{"context":{"slot":1},"value":[{"lamports":1,"owner":"%s","data":["%s","base64"],"executable":false,"rentEpoch":0}]}`,
+				solana.TokenProgramID, base64.StdEncoding.EncodeToString(owner[:]))
+		default:
+			t.Errorf("unexpected method %s", request.Method)
+		}
+		rw.Write([]byte(`{"jsonrpc":"2.0","id":0,"result":` + result + `}`))
+	}))
+	defer server.Close()
+
+	var progress []string
+	snapshot, err := TakeHolderSnapshot(context.Background(), rpc.New(server.URL), collection, &SnapshotOpts{
+		FirstCreator: &creator,
+		Concurrency:  1,
+		Progress: func(stage SnapshotStage, done, total int) {
+			progress = append(progress, fmt.Sprintf("%s %d/%d", stage, done, total))
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, snapshot.Metadata, 2)
+	require.Equal(t, held, snapshot.Metadata[0].Mint)
+	require.Equal(t, burned, snapshot.Metadata[1].Mint)
+	require.Equal(t, []*Holding{{Mint: held, TokenAccount: tokenAccount, Owner: owner, Amount: 1}}, snapshot.Holdings)
+	require.Equal(t, map[solana.PublicKey]uint64{owner: 1}, snapshot.Holders())
+	require.Equal(t, []string{"metadata 1/1", "token_accounts 1/2", "token_accounts 2/2", "owners 1/1"}, progress)
+	require.Equal(t, []string{"getProgramAccounts", "getTokenLargestAccounts", "getTokenLargestAccounts", "getMultipleAccounts"}, methods)
+
+	_, err = TakeHolderSnapshot(context.Background(), rpc.New(server.URL), collection, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,214 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vote
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// ValidatorStake is the stake of a validator in a StakeDistribution.
+type ValidatorStake struct {
+	VotePubkey solana.PublicKey
+	NodePubkey solana.PublicKey
+
+	// The activated stake, in lamports, and its share of the total stake.
+	Stake uint64
+	Share float64
+
+	Delinquent bool
+	Commission uint8
+
+	// The published information of the validator; nil if not published or not fetched.
+	Info *ValidatorInfo
+	// The gossip address of the validator (host:port); empty if unknown.
+	Gossip string
+	// The software version of the validator; empty if unknown.
+	Version string
+}
+
+// StakeGrouper returns the group of a validator (e.g. its country or its ASN,
+// from its gossip address); validators with an empty group are not grouped.
+type StakeGrouper func(validator *ValidatorStake) string
+
+// StakeGroup is the stake of the validators of a group.
+type StakeGroup struct {
+	Name       string
+	Stake      uint64
+	Share      float64
+	Validators int
+}
+
+// StakeDistribution is a stake concentration report of the cluster.
+type StakeDistribution struct {
+	TotalStake uint64
+
+	// All the staked validators, by decreasing stake.
+	Validators []*ValidatorStake
+
+	// The smallest set of validators holding more than a third of the stake,
+	// which can halt the cluster.
+	Superminority []*ValidatorStake
+
+	// The number of validators of the superminority.
+	NakamotoCoefficient int
+
+	// The groups of each grouper, by decreasing stake.
+	Groups map[string][]*StakeGroup
+}
+
+// StakeDistributionOpts are the options of GetStakeDistribution.
+type StakeDistributionOpts struct {
+	Commitment rpc.CommitmentType
+
+	// Fetch the information published by the validators.
+	WithValidatorInfo bool
+
+	// Fetch the gossip addresses and versions of the validators (with getClusterNodes).
+	WithClusterNodes bool
+
+	// The groupers, by name.
+	GroupBy map[string]StakeGrouper
+}
+
+// GetStakeDistribution fetches the vote accounts (and optionally the validator
+// information and the cluster nodes), and computes the stake distribution.
+func GetStakeDistribution(ctx context.Context, client *rpc.Client, opts *StakeDistributionOpts) (*StakeDistribution, error) {
+	if opts == nil {
+		opts = &StakeDistributionOpts{}
+	}
+	voteAccounts, err := client.GetVoteAccounts(ctx, &rpc.GetVoteAccountsOpts{Commitment: opts.Commitment})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get vote accounts: %w", err)
+	}
+	var infos map[solana.PublicKey]*ValidatorInfo
+	if opts.WithValidatorInfo {
+		if infos, err = GetValidatorInfos(ctx, client, opts.Commitment); err != nil {
+			return nil, err
+		}
+	}
+	var nodes []*rpc.GetClusterNodesResult
+	if opts.WithClusterNodes {
+		if nodes, err = client.GetClusterNodes(ctx); err != nil {
+			return nil, fmt.Errorf("unable to get cluster nodes: %w", err)
+		}
+	}
+	return ComputeStakeDistribution(voteAccounts, infos, nodes, opts.GroupBy), nil
+}
+
+// ComputeStakeDistribution computes the stake distribution of the vote accounts,
+// the current and the delinquent ones. The validator information (by identity),
+// the cluster nodes and the groupers are optional.
+func ComputeStakeDistribution(
+	voteAccounts *rpc.GetVoteAccountsResult,
+	infos map[solana.PublicKey]*ValidatorInfo,
+	nodes []*rpc.GetClusterNodesResult,
+	groupBy map[string]StakeGrouper,
+) *StakeDistribution {
+	nodesByIdentity := make(map[solana.PublicKey]*rpc.GetClusterNodesResult, len(nodes))
+	for _, node := range nodes {
+		nodesByIdentity[node.Pubkey] = node
+	}
+
+	out := &StakeDistribution{}
+	add := func(accounts []rpc.VoteAccountsResult, delinquent bool) {
+		for _, account := range accounts {
+			if account.ActivatedStake == 0 {
+				continue
+			}
+			validator := &ValidatorStake{
+				VotePubkey: account.VotePubkey,
+				NodePubkey: account.NodePubkey,
+				Stake:      account.ActivatedStake,
+				Delinquent: delinquent,
+				Commission: account.Commission,
+				Info:       infos[account.NodePubkey],
+			}
+			if node, ok := nodesByIdentity[account.NodePubkey]; ok {
+				if node.Gossip != nil {
+					validator.Gossip = *node.Gossip
+				}
+				if node.Version != nil {
+					validator.Version = *node.Version
+				}
+			}
+			out.TotalStake += validator.Stake
+			out.Validators = append(out.Validators, validator)
+		}
+	}
+	add(voteAccounts.Current, false)
+	add(voteAccounts.Delinquent, true)
+	sort.SliceStable(out.Validators, func(i, j int) bool {
+		return out.Validators[i].Stake > out.Validators[j].Stake
+	})
+
+	var cumulated uint64
+	for _, validator := range out.Validators {
+		validator.Share = share(validator.Stake, out.TotalStake)
+		if cumulated*3 <= out.TotalStake {
+			out.Superminority = append(out.Superminority, validator)
+			cumulated += validator.Stake
+		}
+	}
+	out.NakamotoCoefficient = len(out.Superminority)
+
+	if len(groupBy) > 0 {
+		out.Groups = make(map[string][]*StakeGroup, len(groupBy))
+		for name, grouper := range groupBy {
+			out.Groups[name] = groupStake(out.Validators, grouper, out.TotalStake)
+		}
+	}
+	return out
+}
+
+func groupStake(validators []*ValidatorStake, grouper StakeGrouper, totalStake uint64) []*StakeGroup {
+	byName := make(map[string]*StakeGroup)
+	var groups []*StakeGroup
+	for _, validator := range validators {
+		name := grouper(validator)
+		if name == "" {
+			continue
+		}
+		group, ok := byName[name]
+		if !ok {
+			group = &StakeGroup{Name: name}
+			byName[name] = group
+			groups = append(groups, group)
+		}
+		group.Stake += validator.Stake
+		group.Validators++
+	}
+	for _, group := range groups {
+		group.Share = share(group.Stake, totalStake)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Stake != groups[j].Stake {
+			return groups[i].Stake > groups[j].Stake
+		}
+		return groups[i].Name < groups[j].Name
+	})
+	return groups
+}
+
+func share(stake uint64, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(stake) / float64(total)
+}
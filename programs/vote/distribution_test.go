@@ -0,0 +1,153 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vote
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeValidatorInfo(t *testing.T, first solana.PublicKey, identity solana.PublicKey, info string) []byte {
+	buf := new(bytes.Buffer)
+	// Two keys, as a compact-u16.
+	buf.WriteByte(2)
+	buf.Write(first[:])
+	buf.WriteByte(0)
+	buf.Write(identity[:])
+	buf.WriteByte(1)
+	require.NoError(t, binary.Write(buf, binary.LittleEndian, uint64(len(info))))
+	buf.WriteString(info)
+	return buf.Bytes()
+}
+
+func TestDecodeValidatorInfo(t *testing.T) {
+	identity := solana.PublicKey{1}
+	info, err := DecodeValidatorInfo(encodeValidatorInfo(t, ValidatorInfoKey, identity, `{"name":"Validator","website":"https://example.com","iconUrl":"https://example.com/icon.png"}`))
+	require.NoError(t, err)
+	require.Equal(t, &ValidatorInfo{
+		Identity: identity,
+		Name:     "Validator",
+		Website:  "https://example.com",
+		IconURL:  "https://example.com/icon.png",
+	}, info)
+
+	_, err = DecodeValidatorInfo(encodeValidatorInfo(t, solana.PublicKey{2}, identity, `{}`))
+	require.Equal(t, ErrNotValidatorInfo, err)
+	_, err = DecodeValidatorInfo(encodeValidatorInfo(t, ValidatorInfoKey, identity, `{`))
+	require.Error(t, err)
+	_, err = DecodeValidatorInfo(nil)
+	require.Error(t, err)
+}
+
+func TestComputeStakeDistribution(t *testing.T) {
+	vote := func(b byte, stake uint64) rpc.VoteAccountsResult {
+		return rpc.VoteAccountsResult{VotePubkey: solana.PublicKey{b}, NodePubkey: solana.PublicKey{100 + b}, ActivatedStake: stake}
+	}
+	gossip := "10.0.0.1:8001"
+	distribution := ComputeStakeDistribution(
+		&rpc.GetVoteAccountsResult{
+			Current:    []rpc.VoteAccountsResult{vote(1, 100), vote(2, 400), vote(3, 200), vote(4, 0)},
+			Delinquent: []rpc.VoteAccountsResult{vote(5, 300)},
+		},
+		map[solana.PublicKey]*ValidatorInfo{{102}: {Name: "Two"}},
+		[]*rpc.GetClusterNodesResult{{Pubkey: solana.PublicKey{101}, Gossip: &gossip}},
+		map[string]StakeGrouper{
+			"parity": func(v *ValidatorStake) string {
+				if v.VotePubkey[0]%2 == 0 {
+					return "even"
+				}
+				return "odd"
+			},
+			"host": func(v *ValidatorStake) string {
+				return strings.Split(v.Gossip, ":")[0]
+			},
+		},
+	)
+	require.Equal(t, uint64(1000), distribution.TotalStake)
+	require.Len(t, distribution.Validators, 4)
+	var order []byte
+	for _, v := range distribution.Validators {
+		order = append(order, v.VotePubkey[0])
+	}
+	require.Equal(t, []byte{2, 5, 3, 1}, order)
+	require.Equal(t, 0.4, distribution.Validators[0].Share)
+	require.Equal(t, "Two", distribution.Validators[0].Info.Name)
+	require.True(t, distribution.Validators[1].Delinquent)
+	require.Equal(t, gossip, distribution.Validators[3].Gossip)
+
+	// 400 is more than a third of 1000.
+	require.Equal(t, 1, distribution.NakamotoCoefficient)
+	require.Equal(t, distribution.Validators[:1], distribution.Superminority)
+
+	require.Equal(t, []*StakeGroup{
+		{Name: "odd", Stake: 600, Share: 0.6, Validators: 3},
+		{Name: "even", Stake: 400, Share: 0.4, Validators: 1},
+	}, distribution.Groups["parity"])
+	require.Equal(t, []*StakeGroup{{Name: "10.0.0.1", Stake: 100, Share: 0.1, Validators: 1}}, distribution.Groups["host"])
+
+	// Evenly distributed: a third is not enough.
+	distribution = ComputeStakeDistribution(&rpc.GetVoteAccountsResult{
+		Current: []rpc.VoteAccountsResult{vote(1, 100), vote(2, 100), vote(3, 100), vote(4, 100), vote(5, 100), vote(6, 100)},
+	}, nil, nil, nil)
+	require.Equal(t, 3, distribution.NakamotoCoefficient)
+	require.Nil(t, distribution.Groups)
+}
+
+type clusterNode struct {
+	infoAccount string
+}
+
+func (node *clusterNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	switch method {
+	case "getVoteAccounts":
+		return stdjson.Unmarshal([]byte(fmt.Sprintf(
+			`{"current":[{"votePubkey":%q,"nodePubkey":%q,"activatedStake":100}],"delinquent":[]}`,
+			solana.PublicKey{1}, solana.PublicKey{2},
+		)), out)
+	case "getProgramAccounts":
+		return stdjson.Unmarshal([]byte(fmt.Sprintf(
+			`[{"pubkey":%q,"account":{"lamports":1,"owner":%q,"data":[%q,"base64"],"executable":false,"rentEpoch":0}}]`,
+			solana.PublicKey{3}, solana.ConfigProgramID, node.infoAccount,
+		)), out)
+	}
+	return fmt.Errorf("unexpected method %s", method)
+}
+
+func (node *clusterNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return fmt.Errorf("not implemented")
+}
+
+func TestGetStakeDistribution(t *testing.T) {
+	node := &clusterNode{
+		infoAccount: base64.StdEncoding.EncodeToString(encodeValidatorInfo(t, ValidatorInfoKey, solana.PublicKey{2}, `{"name":"Validator"}`)),
+	}
+	distribution, err := GetStakeDistribution(context.Background(), rpc.NewWithCustomRPCClient(node), &StakeDistributionOpts{
+		WithValidatorInfo: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, distribution.NakamotoCoefficient)
+	require.Equal(t, &ValidatorInfo{Account: solana.PublicKey{3}, Identity: solana.PublicKey{2}, Name: "Validator"}, distribution.Validators[0].Info)
+}
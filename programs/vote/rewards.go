@@ -0,0 +1,252 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vote
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// DefaultSlotDuration is the target duration of a slot.
+const DefaultSlotDuration = 400 * time.Millisecond
+
+// EpochCredits are the vote credits of a vote account at the end of an epoch.
+type EpochCredits struct {
+	Epoch           uint64
+	Credits         uint64
+	PreviousCredits uint64
+}
+
+// Earned returns the credits earned during the epoch.
+func (c EpochCredits) Earned() uint64 {
+	if c.Credits < c.PreviousCredits {
+		return 0
+	}
+	return c.Credits - c.PreviousCredits
+}
+
+// ParseEpochCredits parses the epochCredits of getVoteAccounts
+// (an array of [epoch, credits, previousCredits]).
+func ParseEpochCredits(raw [][]int64) ([]EpochCredits, error) {
+	out := make([]EpochCredits, len(raw))
+	for i, entry := range raw {
+		if len(entry) != 3 {
+			return nil, fmt.Errorf("epoch credits entry %d: expected 3 values, got %d", i, len(entry))
+		}
+		for _, v := range entry {
+			if v < 0 {
+				return nil, fmt.Errorf("epoch credits entry %d: negative value %d", i, v)
+			}
+		}
+		out[i] = EpochCredits{
+			Epoch:           uint64(entry[0]),
+			Credits:         uint64(entry[1]),
+			PreviousCredits: uint64(entry[2]),
+		}
+	}
+	return out, nil
+}
+
+// AverageCreditsEarned returns the average credits earned per epoch in the
+// epochs [fromEpoch, toEpoch]; the epochs without credits count as zero.
+func AverageCreditsEarned(credits []EpochCredits, fromEpoch uint64, toEpoch uint64) float64 {
+	if toEpoch < fromEpoch {
+		return 0
+	}
+	var total uint64
+	for _, c := range credits {
+		if c.Epoch >= fromEpoch && c.Epoch <= toEpoch {
+			total += c.Earned()
+		}
+	}
+	return float64(total) / float64(toEpoch-fromEpoch+1)
+}
+
+// EpochsPerYear returns the number of epochs in a year.
+// If slotDuration is zero, DefaultSlotDuration is used.
+func EpochsPerYear(slotsPerEpoch uint64, slotDuration time.Duration) float64 {
+	if slotDuration <= 0 {
+		slotDuration = DefaultSlotDuration
+	}
+	year := 365.25 * 24 * time.Hour
+	return float64(year) / (float64(slotDuration) * float64(slotsPerEpoch))
+}
+
+// APYInputs are the inputs of the APY estimation of a validator.
+type APYInputs struct {
+	// The annual inflation rate allocated to the validators
+	// (the Validator field of getInflationRate).
+	ValidatorInflation float64
+
+	// The total supply, and the total activated stake, in lamports.
+	TotalSupply      uint64
+	TotalActiveStake uint64
+
+	// The credits earned per epoch by the validator, and the stake-weighted
+	// average of the credits earned per epoch by all the validators
+	// (see AverageCreditsEarned and ClusterAverageCredits).
+	ValidatorCredits float64
+	ClusterCredits   float64
+
+	// The commission of the validator (0-100).
+	Commission uint8
+
+	// See EpochsPerYear.
+	EpochsPerYear float64
+}
+
+// EstimateAPR estimates the annual rate of the staking rewards (after the
+// commission) of the stake delegated to a validator, not compounded:
+// the inflation is distributed in proportion to stake * credits, so the rate
+// is the validator inflation, divided by the staked ratio of the supply,
+// scaled by the credits of the validator relative to the cluster average.
+func EstimateAPR(in *APYInputs) float64 {
+	if in.TotalActiveStake == 0 || in.ClusterCredits == 0 {
+		return 0
+	}
+	commission := float64(in.Commission)
+	if commission > 100 {
+		commission = 100
+	}
+	stakedRatio := float64(in.TotalActiveStake) / float64(in.TotalSupply)
+	return in.ValidatorInflation / stakedRatio *
+		(in.ValidatorCredits / in.ClusterCredits) *
+		(1 - commission/100)
+}
+
+// EstimateAPY estimates the annual yield of the staking rewards,
+// compounded every epoch (the rewards are staked at each epoch boundary).
+func EstimateAPY(in *APYInputs) float64 {
+	return APRToAPY(EstimateAPR(in), in.EpochsPerYear)
+}
+
+// APRToAPY compounds the annual rate over the provided periods per year.
+func APRToAPY(apr float64, periodsPerYear float64) float64 {
+	if periodsPerYear <= 0 {
+		return apr
+	}
+	return math.Pow(1+apr/periodsPerYear, periodsPerYear) - 1
+}
+
+// ClusterAverageCredits returns the stake-weighted average of the credits
+// earned per epoch in [fromEpoch, toEpoch] by the provided vote accounts.
+func ClusterAverageCredits(accounts []rpc.VoteAccountsResult, fromEpoch uint64, toEpoch uint64) (float64, error) {
+	var weighted, totalStake float64
+	for _, account := range accounts {
+		credits, err := ParseEpochCredits(account.EpochCredits)
+		if err != nil {
+			return 0, fmt.Errorf("vote account %s: %w", account.VotePubkey, err)
+		}
+		stake := float64(account.ActivatedStake)
+		weighted += AverageCreditsEarned(credits, fromEpoch, toEpoch) * stake
+		totalStake += stake
+	}
+	if totalStake == 0 {
+		return 0, nil
+	}
+	return weighted / totalStake, nil
+}
+
+// ValidatorAPY is the estimated yield of the stake delegated to a validator.
+type ValidatorAPY struct {
+	VotePubkey     solana.PublicKey
+	NodePubkey     solana.PublicKey
+	ActivatedStake uint64
+	Commission     uint8
+
+	// The average credits earned per epoch.
+	Credits float64
+
+	APR float64
+	APY float64
+}
+
+// EstimateValidatorAPYs estimates the APY of all the current validators,
+// from the credits they earned in the last `epochs` complete epochs.
+func EstimateValidatorAPYs(ctx context.Context, client *rpc.Client, epochs uint64) ([]*ValidatorAPY, error) {
+	if epochs == 0 {
+		epochs = 1
+	}
+	epochInfo, err := client.GetEpochInfo(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get epoch info: %w", err)
+	}
+	if epochInfo.Epoch < epochs {
+		return nil, fmt.Errorf("not enough complete epochs: %d", epochInfo.Epoch)
+	}
+	schedule, err := client.GetEpochSchedule(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get epoch schedule: %w", err)
+	}
+	inflation, err := client.GetInflationRate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get inflation rate: %w", err)
+	}
+	supply, err := client.GetSupplyWithOpts(ctx, &rpc.GetSupplyOpts{ExcludeNonCirculatingAccountsList: true})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get supply: %w", err)
+	}
+	voteAccounts, err := client.GetVoteAccounts(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get vote accounts: %w", err)
+	}
+
+	// The current epoch is not complete.
+	toEpoch := epochInfo.Epoch - 1
+	fromEpoch := epochInfo.Epoch - epochs
+	clusterCredits, err := ClusterAverageCredits(voteAccounts.Current, fromEpoch, toEpoch)
+	if err != nil {
+		return nil, err
+	}
+	var totalStake uint64
+	for _, account := range voteAccounts.Current {
+		totalStake += account.ActivatedStake
+	}
+	for _, account := range voteAccounts.Delinquent {
+		totalStake += account.ActivatedStake
+	}
+
+	out := make([]*ValidatorAPY, 0, len(voteAccounts.Current))
+	for _, account := range voteAccounts.Current {
+		credits, err := ParseEpochCredits(account.EpochCredits)
+		if err != nil {
+			return nil, fmt.Errorf("vote account %s: %w", account.VotePubkey, err)
+		}
+		inputs := &APYInputs{
+			ValidatorInflation: inflation.Validator,
+			TotalSupply:        supply.Value.Total,
+			TotalActiveStake:   totalStake,
+			ValidatorCredits:   AverageCreditsEarned(credits, fromEpoch, toEpoch),
+			ClusterCredits:     clusterCredits,
+			Commission:         account.Commission,
+			EpochsPerYear:      EpochsPerYear(schedule.SlotsPerEpoch, 0),
+		}
+		out = append(out, &ValidatorAPY{
+			VotePubkey:     account.VotePubkey,
+			NodePubkey:     account.NodePubkey,
+			ActivatedStake: account.ActivatedStake,
+			Commission:     account.Commission,
+			Credits:        inputs.ValidatorCredits,
+			APR:            EstimateAPR(inputs),
+			APY:            EstimateAPY(inputs),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vote
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochCredits(t *testing.T) {
+	credits, err := ParseEpochCredits([][]int64{
+		{10, 1000, 0},
+		{11, 2500, 1000},
+		{13, 4500, 2500},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1500), credits[1].Earned())
+	// Epoch 12 has no credits.
+	require.InDelta(t, 3500.0/3, AverageCreditsEarned(credits, 11, 13), 1e-9)
+	require.Equal(t, 1250.0, AverageCreditsEarned(credits, 10, 11))
+
+	_, err = ParseEpochCredits([][]int64{{10, 1000}})
+	require.EqualError(t, err, "epoch credits entry 0: expected 3 values, got 2")
+}
+
+func TestEstimateAPY(t *testing.T) {
+	require.InDelta(t, 182.6, EpochsPerYear(432000, 0), 0.1)
+	require.InDelta(t, 365.25, EpochsPerYear(216000, 0), 0.01)
+	require.Equal(t, 0.1, APRToAPY(0.1, 0))
+	require.InDelta(t, 0.10517, APRToAPY(0.1, 1e6), 1e-5)
+
+	inputs := &APYInputs{
+		ValidatorInflation: 0.05,
+		TotalSupply:        1000,
+		TotalActiveStake:   500,
+		ValidatorCredits:   1100,
+		ClusterCredits:     1000,
+		Commission:         10,
+		EpochsPerYear:      182.6,
+	}
+	// 0.05 / 0.5 * 1.1 * 0.9
+	require.InDelta(t, 0.099, EstimateAPR(inputs), 1e-9)
+	require.InDelta(t, APRToAPY(0.099, 182.6), EstimateAPY(inputs), 1e-9)
+
+	inputs.Commission = 100
+	require.Equal(t, 0.0, EstimateAPR(inputs))
+}
+
+func TestClusterAverageCredits(t *testing.T) {
+	avg, err := ClusterAverageCredits([]rpc.VoteAccountsResult{
+		{ActivatedStake: 300, EpochCredits: [][]int64{{5, 1000, 0}}},
+		{ActivatedStake: 100, EpochCredits: [][]int64{{5, 2000, 0}}},
+	}, 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1250.0, avg)
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// ValidatorInfoKey is the first key of the config accounts
+// holding the information published by the validators.
+var ValidatorInfoKey = solana.MustPublicKeyFromBase58("Va1idator1nfo111111111111111111111111111111")
+
+// ValidatorInfo is the information published by a validator
+// with `solana validator-info publish`.
+type ValidatorInfo struct {
+	// The config account holding the information.
+	Account solana.PublicKey `json:"-"`
+	// The identity of the validator.
+	Identity solana.PublicKey `json:"-"`
+
+	Name            string `json:"name"`
+	Website         string `json:"website,omitempty"`
+	Details         string `json:"details,omitempty"`
+	KeybaseUsername string `json:"keybaseUsername,omitempty"`
+	IconURL         string `json:"iconUrl,omitempty"`
+}
+
+// ErrNotValidatorInfo is returned by DecodeValidatorInfo
+// when the config account does not hold validator information.
+var ErrNotValidatorInfo = errors.New("not a validator info account")
+
+// DecodeValidatorInfo decodes the data of a validator info config account:
+// the config keys (the ValidatorInfoKey, then the identity of the validator,
+// as a signer), followed by the information, as a JSON string.
+func DecodeValidatorInfo(data []byte) (*ValidatorInfo, error) {
+	decoder := bin.NewBinDecoder(data)
+	numKeys, err := decoder.ReadCompactU16()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode config keys: %w", err)
+	}
+	if numKeys != 2 {
+		return nil, ErrNotValidatorInfo
+	}
+	var keys [2]solana.PublicKey
+	var signers [2]bool
+	for i := range keys {
+		buf, err := decoder.ReadNBytes(32)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode config keys: %w", err)
+		}
+		keys[i] = solana.PublicKeyFromBytes(buf)
+		if signers[i], err = decoder.ReadBool(); err != nil {
+			return nil, fmt.Errorf("unable to decode config keys: %w", err)
+		}
+	}
+	if !keys[0].Equals(ValidatorInfoKey) || !signers[1] {
+		return nil, ErrNotValidatorInfo
+	}
+	raw, err := decoder.ReadRustString()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode validator info: %w", err)
+	}
+	info := new(ValidatorInfo)
+	if err := json.Unmarshal([]byte(raw), info); err != nil {
+		return nil, fmt.Errorf("unable to decode validator info: %w", err)
+	}
+	info.Identity = keys[1]
+	return info, nil
+}
+
+// GetValidatorInfos fetches the information published by the validators, by identity.
+// The accounts that cannot be decoded are ignored; for an identity with
+// several accounts, one of them is returned.
+func GetValidatorInfos(ctx context.Context, client *rpc.Client, commitment rpc.CommitmentType) (map[solana.PublicKey]*ValidatorInfo, error) {
+	accounts, err := client.GetProgramAccountsWithOpts(ctx, solana.ConfigProgramID, &rpc.GetProgramAccountsOpts{
+		Commitment: commitment,
+		Filters: []rpc.RPCFilter{
+			{Memcmp: &rpc.RPCFilterMemcmp{Offset: 1, Bytes: ValidatorInfoKey[:]}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get validator info accounts: %w", err)
+	}
+	out := make(map[solana.PublicKey]*ValidatorInfo, len(accounts))
+	for _, account := range accounts {
+		info, err := DecodeValidatorInfo(account.Account.Data.GetBinary())
+		if err != nil {
+			continue
+		}
+		info.Account = account.Pubkey
+		out[info.Identity] = info
+	}
+	return out, nil
+}
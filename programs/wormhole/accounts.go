@@ -0,0 +1,118 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wormhole
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/secp256k1"
+)
+
+var (
+	// The Wormhole core bridge program on mainnet-beta.
+	CoreBridgeProgramID = solana.MustPublicKeyFromBase58("worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth")
+	// The Wormhole core bridge program on devnet.
+	DevnetCoreBridgeProgramID = solana.MustPublicKeyFromBase58("3u8hJUVTA4jH1wYAyUur7FFZVQ8H635K3tSHHF4ssjQ5")
+)
+
+var (
+	postedVAAMagic         = []byte("vaa")
+	postedMessageMagic     = []byte("msg")
+	unreliableMessageMagic = []byte("msu")
+)
+
+// FindPostedVAAAddress returns the account of the VAA with the hash (see VAA.Hash)
+// posted to the core bridge.
+func FindPostedVAAAddress(hash [32]byte, programID solana.PublicKey) (solana.PublicKey, uint8, error) {
+	return solana.FindProgramAddress([][]byte{[]byte("PostedVAA"), hash[:]}, programID)
+}
+
+// FindGuardianSetAddress returns the account of the guardian set with the index.
+func FindGuardianSetAddress(index uint32, programID solana.PublicKey) (solana.PublicKey, uint8, error) {
+	seed := make([]byte, 4)
+	binary.BigEndian.PutUint32(seed, index)
+	return solana.FindProgramAddress([][]byte{[]byte("GuardianSet"), seed}, programID)
+}
+
+// PostedVAA is the message of a posted VAA account ("vaa"), or of a
+// message posted by an emitter on Solana ("msg", or "msu" if unreliable).
+type PostedVAA struct {
+	VAAVersion       uint8
+	ConsistencyLevel uint8
+	VAATime          uint32
+	// Account of the verified signatures of the VAA.
+	VAASignatureAccount solana.PublicKey
+	SubmissionTime      uint32
+	Nonce               uint32
+	Sequence            uint64
+	EmitterChain        ChainID
+	EmitterAddress      [32]byte
+	Payload             []byte
+}
+
+// DecodePostedVAA decodes the provided posted VAA account data.
+func DecodePostedVAA(data []byte) (*PostedVAA, error) {
+	if len(data) < 3 {
+		return nil, fmt.Errorf("unable to decode posted VAA: account too short")
+	}
+	magic := data[:3]
+	if !bytes.Equal(magic, postedVAAMagic) && !bytes.Equal(magic, postedMessageMagic) && !bytes.Equal(magic, unreliableMessageMagic) {
+		return nil, fmt.Errorf("unable to decode posted VAA: invalid magic %q", magic)
+	}
+	posted := new(PostedVAA)
+	if err := bin.NewBorshDecoder(data[3:]).Decode(posted); err != nil {
+		return nil, fmt.Errorf("unable to decode posted VAA: %w", err)
+	}
+	return posted, nil
+}
+
+// Hash returns the hash of the body of the posted message, as VAA.Hash.
+func (posted *PostedVAA) Hash() [32]byte {
+	return posted.body().Hash()
+}
+
+func (posted *PostedVAA) body() *VAA {
+	return &VAA{
+		Timestamp:        posted.VAATime,
+		Nonce:            posted.Nonce,
+		EmitterChain:     posted.EmitterChain,
+		EmitterAddress:   posted.EmitterAddress,
+		Sequence:         posted.Sequence,
+		ConsistencyLevel: posted.ConsistencyLevel,
+		Payload:          posted.Payload,
+	}
+}
+
+// GuardianSet is a guardian set account.
+type GuardianSet struct {
+	Index uint32
+	// Ethereum addresses of the guardians.
+	Keys           []secp256k1.EthAddress
+	CreationTime   uint32
+	ExpirationTime uint32
+}
+
+// DecodeGuardianSet decodes the provided guardian set account data.
+func DecodeGuardianSet(data []byte) (*GuardianSet, error) {
+	set := new(GuardianSet)
+	if err := bin.NewBorshDecoder(data).Decode(set); err != nil {
+		return nil, fmt.Errorf("unable to decode guardian set: %w", err)
+	}
+	return set, nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wormhole parses and verifies the Wormhole VAAs (Verified Action
+// Approvals: the messages signed by the guardians), and decodes the accounts
+// of the Wormhole core bridge program on Solana (posted VAAs, guardian sets),
+// so that cross-chain services can verify the messages.
+package wormhole
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go/hashutil"
+	"github.com/gagliardetto/solana-go/programs/secp256k1"
+)
+
+// ChainID is a Wormhole chain id.
+type ChainID uint16
+
+const (
+	ChainIDUnset     ChainID = 0
+	ChainIDSolana    ChainID = 1
+	ChainIDEthereum  ChainID = 2
+	ChainIDTerra     ChainID = 3
+	ChainIDBSC       ChainID = 4
+	ChainIDPolygon   ChainID = 5
+	ChainIDAvalanche ChainID = 6
+	ChainIDOasis     ChainID = 7
+	ChainIDAlgorand  ChainID = 8
+	ChainIDAurora    ChainID = 9
+	ChainIDFantom    ChainID = 10
+	ChainIDKarura    ChainID = 11
+	ChainIDAcala     ChainID = 12
+	ChainIDKlaytn    ChainID = 13
+	ChainIDCelo      ChainID = 14
+	ChainIDNear      ChainID = 15
+	ChainIDMoonbeam  ChainID = 16
+	ChainIDTerra2    ChainID = 18
+	ChainIDInjective ChainID = 19
+	ChainIDSui       ChainID = 21
+	ChainIDAptos     ChainID = 22
+	ChainIDArbitrum  ChainID = 23
+	ChainIDOptimism  ChainID = 24
+	ChainIDBase      ChainID = 30
+)
+
+const (
+	// Size of a guardian signature: r || s || recovery id.
+	SignatureSize = 65
+
+	headerSize    = 1 + 4 + 1
+	signatureSize = 1 + SignatureSize
+	bodySize      = 4 + 4 + 2 + 32 + 8 + 1
+)
+
+// Signature is the signature of a VAA by a guardian.
+type Signature struct {
+	// Index of the guardian in the guardian set.
+	GuardianIndex uint8
+	Signature     [SignatureSize]byte
+}
+
+// VAA is a Wormhole VAA (version 1).
+type VAA struct {
+	Version uint8
+	// Index of the guardian set signing the VAA.
+	GuardianSetIndex uint32
+	Signatures       []*Signature
+
+	// Body.
+	Timestamp        uint32
+	Nonce            uint32
+	EmitterChain     ChainID
+	EmitterAddress   [32]byte
+	Sequence         uint64
+	ConsistencyLevel uint8
+	Payload          []byte
+}
+
+// ParseVAA parses a serialized VAA.
+func ParseVAA(data []byte) (*VAA, error) {
+	if len(data) < headerSize {
+		return nil, errors.New("VAA too short")
+	}
+	vaa := &VAA{
+		Version:          data[0],
+		GuardianSetIndex: binary.BigEndian.Uint32(data[1:]),
+	}
+	if vaa.Version != 1 {
+		return nil, fmt.Errorf("unsupported VAA version: %d", vaa.Version)
+	}
+	count := int(data[5])
+	offset := headerSize
+	if len(data) < offset+count*signatureSize+bodySize {
+		return nil, errors.New("VAA too short")
+	}
+	vaa.Signatures = make([]*Signature, count)
+	for i := range vaa.Signatures {
+		sig := &Signature{GuardianIndex: data[offset]}
+		copy(sig.Signature[:], data[offset+1:offset+signatureSize])
+		vaa.Signatures[i] = sig
+		offset += signatureSize
+	}
+
+	body := data[offset:]
+	vaa.Timestamp = binary.BigEndian.Uint32(body[0:])
+	vaa.Nonce = binary.BigEndian.Uint32(body[4:])
+	vaa.EmitterChain = ChainID(binary.BigEndian.Uint16(body[8:]))
+	copy(vaa.EmitterAddress[:], body[10:42])
+	vaa.Sequence = binary.BigEndian.Uint64(body[42:])
+	vaa.ConsistencyLevel = body[50]
+	vaa.Payload = append([]byte{}, body[bodySize:]...)
+	return vaa, nil
+}
+
+// Time returns the timestamp of the VAA.
+func (vaa *VAA) Time() time.Time {
+	return time.Unix(int64(vaa.Timestamp), 0)
+}
+
+// Body returns the serialized body of the VAA.
+func (vaa *VAA) Body() []byte {
+	body := make([]byte, bodySize, bodySize+len(vaa.Payload))
+	binary.BigEndian.PutUint32(body[0:], vaa.Timestamp)
+	binary.BigEndian.PutUint32(body[4:], vaa.Nonce)
+	binary.BigEndian.PutUint16(body[8:], uint16(vaa.EmitterChain))
+	copy(body[10:42], vaa.EmitterAddress[:])
+	binary.BigEndian.PutUint64(body[42:], vaa.Sequence)
+	body[50] = vaa.ConsistencyLevel
+	return append(body, vaa.Payload...)
+}
+
+// Serialize returns the serialized VAA.
+func (vaa *VAA) Serialize() []byte {
+	data := make([]byte, headerSize, headerSize+len(vaa.Signatures)*signatureSize)
+	data[0] = vaa.Version
+	binary.BigEndian.PutUint32(data[1:], vaa.GuardianSetIndex)
+	data[5] = uint8(len(vaa.Signatures))
+	for _, sig := range vaa.Signatures {
+		data = append(data, sig.GuardianIndex)
+		data = append(data, sig.Signature[:]...)
+	}
+	return append(data, vaa.Body()...)
+}
+
+// Hash returns the keccak256 hash of the body, which identifies the message
+// (e.g. in the address of the posted VAA, see FindPostedVAAAddress).
+func (vaa *VAA) Hash() [32]byte {
+	return hashutil.Keccak256(vaa.Body())
+}
+
+// Digest returns the hash signed by the guardians: the keccak256 hash of Hash.
+func (vaa *VAA) Digest() [32]byte {
+	hash := vaa.Hash()
+	return hashutil.Keccak256(hash[:])
+}
+
+// Quorum returns the number of signatures required for a
+// guardian set of the size: more than two thirds.
+func Quorum(guardians int) int {
+	return guardians*2/3 + 1
+}
+
+// Verify checks that the VAA is signed by a quorum of the guardian set (the
+// Ethereum addresses of the guardians, in order), with the signatures sorted
+// by guardian index.
+func (vaa *VAA) Verify(guardians []secp256k1.EthAddress) error {
+	if len(guardians) == 0 {
+		return errors.New("empty guardian set")
+	}
+	if quorum := Quorum(len(guardians)); len(vaa.Signatures) < quorum {
+		return fmt.Errorf("no quorum: %d signatures, %d required", len(vaa.Signatures), quorum)
+	}
+	digest := vaa.Digest()
+	for i, sig := range vaa.Signatures {
+		if i > 0 && sig.GuardianIndex <= vaa.Signatures[i-1].GuardianIndex {
+			return errors.New("signatures not sorted by guardian index")
+		}
+		if int(sig.GuardianIndex) >= len(guardians) {
+			return fmt.Errorf("invalid guardian index: %d", sig.GuardianIndex)
+		}
+		var rs [secp256k1.SignatureSize]byte
+		copy(rs[:], sig.Signature[:])
+		publicKey, err := secp256k1.RecoverPublicKey(digest, rs, sig.Signature[secp256k1.SignatureSize])
+		if err != nil {
+			return fmt.Errorf("signature of guardian %d: %w", sig.GuardianIndex, err)
+		}
+		signer, err := secp256k1.EthAddressFromPublicKey(publicKey[:])
+		if err != nil {
+			return fmt.Errorf("signature of guardian %d: %w", sig.GuardianIndex, err)
+		}
+		if signer != guardians[sig.GuardianIndex] {
+			return fmt.Errorf("signature of guardian %d: signed by %s", sig.GuardianIndex, signer)
+		}
+	}
+	return nil
+}
+
+// SignedMessages returns the signatures of the VAA as messages verified by the
+// secp256k1 program (see secp256k1.NewSecp256k1Instruction), as the core
+// bridge requires before posting the VAA: the message is Hash, hashed by the
+// program into Digest.
+func (vaa *VAA) SignedMessages(guardians []secp256k1.EthAddress) ([]*secp256k1.SignedMessage, error) {
+	hash := vaa.Hash()
+	messages := make([]*secp256k1.SignedMessage, len(vaa.Signatures))
+	for i, sig := range vaa.Signatures {
+		if int(sig.GuardianIndex) >= len(guardians) {
+			return nil, fmt.Errorf("invalid guardian index: %d", sig.GuardianIndex)
+		}
+		message := &secp256k1.SignedMessage{
+			EthAddress: guardians[sig.GuardianIndex],
+			RecoveryID: sig.Signature[secp256k1.SignatureSize],
+			Message:    hash[:],
+		}
+		copy(message.Signature[:], sig.Signature[:])
+		messages[i] = message
+	}
+	return messages, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wormhole
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+// The guardians of the tests, with the private keys 1, 2, 3 and 4, and
+// their signatures of the VAA of testVAA (RFC 6979 nonces, low s).
+var testGuardianSet = []struct {
+	address   string
+	signature string
+}{
+	{"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf", "36519f0bdfcc0f4a1f30fa745102b9e4309787476e984099ecdeb0609930324a55134e12c112a8a801156a295d4281fd485ed18b277243358dd4885a24a7b15201"},
+	{"0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF", "dfe48b7fd0b1fcb100ae4a2a1df74a2870eca55987a3857a14ad7a1f63a3133c529461d533675ea4b5ab4ca68a32bc835fdf7811cf1df5d6b77b5cef762c7fd901"},
+	{"0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69", "68b4ad553cdb5beee82d5f5763e499cd588697b68788df93e27d680c1f1c68335907605999769a8ad6328883f9fead9283a1647c6cde99fc0d849a047e8abb2e01"},
+	{"0x1efF47bc3a10a45D4B230B5d10E37751FE6AA718", "f2aaf2bec98d5268da29559b719d8c62cef4e40f79810aca2983d54db8c3db095c29649d81fc55e7eb4d12d532323200534f65f00cfb9cb8a6d75e5ba545f58f00"},
+}
+
+func testGuardians(t *testing.T, n int) (addresses []secp256k1.EthAddress) {
+	for _, guardian := range testGuardianSet[:n] {
+		address, err := secp256k1.EthAddressFromHex(guardian.address)
+		require.NoError(t, err)
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+func testVAA(t *testing.T, signers ...int) *VAA {
+	vaa := &VAA{
+		Version:          1,
+		GuardianSetIndex: 3,
+		Timestamp:        1650000000,
+		Nonce:            42,
+		EmitterChain:     ChainIDEthereum,
+		Sequence:         1234,
+		ConsistencyLevel: 15,
+		Payload:          []byte("hello"),
+	}
+	vaa.EmitterAddress[31] = 0xee
+	for _, i := range signers {
+		signature, err := hex.DecodeString(testGuardianSet[i].signature)
+		require.NoError(t, err)
+		sig := &Signature{GuardianIndex: uint8(i)}
+		copy(sig.Signature[:], signature)
+		vaa.Signatures = append(vaa.Signatures, sig)
+	}
+	return vaa
+}
+
+func TestParseVAA(t *testing.T) {
+	vaa := testVAA(t, 0, 2, 3)
+
+	data := vaa.Serialize()
+	require.Len(t, data, 6+3*66+51+5)
+	parsed, err := ParseVAA(data)
+	require.NoError(t, err)
+	require.Equal(t, vaa, parsed)
+	require.Equal(t, int64(1650000000), parsed.Time().Unix())
+
+	_, err = ParseVAA(data[:len(data)-10])
+	require.Error(t, err)
+	data[0] = 2
+	_, err = ParseVAA(data)
+	require.EqualError(t, err, "unsupported VAA version: 2")
+}
+
+func TestVAA_Verify(t *testing.T) {
+	guardians := testGuardians(t, 4)
+	require.Equal(t, 3, Quorum(4))
+	require.Equal(t, 13, Quorum(19))
+
+	require.NoError(t, testVAA(t, 0, 1, 3).Verify(guardians))
+	require.EqualError(t, testVAA(t, 0, 1).Verify(guardians), "no quorum: 2 signatures, 3 required")
+	require.EqualError(t, testVAA(t, 0, 3, 1).Verify(guardians), "signatures not sorted by guardian index")
+	require.EqualError(t, testVAA(t, 0, 0, 1).Verify(guardians), "signatures not sorted by guardian index")
+
+	vaa := testVAA(t, 0, 1, 2)
+	vaa.Payload = []byte("tampered")
+	require.Error(t, vaa.Verify(guardians))
+
+	// Signed by another guardian set.
+	vaa = testVAA(t, 0, 1, 2)
+	require.Error(t, vaa.Verify([]secp256k1.EthAddress{guardians[1], guardians[0], guardians[2], guardians[3]}))
+}
+
+func TestVAA_SignedMessages(t *testing.T) {
+	guardians := testGuardians(t, 4)
+	vaa := testVAA(t, 1, 2, 3)
+
+	messages, err := vaa.SignedMessages(guardians)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	instruction, err := secp256k1.NewSecp256k1Instruction(0, messages...)
+	require.NoError(t, err)
+	data, err := instruction.Data()
+	require.NoError(t, err)
+	require.NoError(t, secp256k1.VerifyInstruction(data, nil))
+
+	_, err = vaa.SignedMessages(guardians[:2])
+	require.Error(t, err)
+}
+
+func TestDecodePostedVAA(t *testing.T) {
+	vaa := testVAA(t, 0)
+
+	posted := &PostedVAA{
+		VAAVersion:          1,
+		ConsistencyLevel:    vaa.ConsistencyLevel,
+		VAATime:             vaa.Timestamp,
+		VAASignatureAccount: solana.SystemProgramID,
+		SubmissionTime:      1650000100,
+		Nonce:               vaa.Nonce,
+		Sequence:            vaa.Sequence,
+		EmitterChain:        vaa.EmitterChain,
+		EmitterAddress:      vaa.EmitterAddress,
+		Payload:             vaa.Payload,
+	}
+	buf := new(bytes.Buffer)
+	buf.WriteString("vaa")
+	require.NoError(t, bin.NewBorshEncoder(buf).Encode(posted))
+	require.Equal(t, 3+1+1+4+32+4+4+8+2+32+4+5, buf.Len())
+
+	decoded, err := DecodePostedVAA(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, posted, decoded)
+	require.Equal(t, vaa.Hash(), decoded.Hash())
+
+	address, _, err := FindPostedVAAAddress(vaa.Hash(), CoreBridgeProgramID)
+	require.NoError(t, err)
+	other, _, err := FindPostedVAAAddress(testVAA(t).Digest(), CoreBridgeProgramID)
+	require.NoError(t, err)
+	require.NotEqual(t, address, other)
+
+	data := buf.Bytes()
+	copy(data, "xyz")
+	_, err = DecodePostedVAA(data)
+	require.Error(t, err)
+}
+
+func TestDecodeGuardianSet(t *testing.T) {
+	guardians := testGuardians(t, 2)
+	set := &GuardianSet{
+		Index:          3,
+		Keys:           guardians,
+		CreationTime:   1650000000,
+		ExpirationTime: 0,
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBorshEncoder(buf).Encode(set))
+	require.Equal(t, 4+4+2*20+4+4, buf.Len())
+
+	decoded, err := DecodeGuardianSet(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, set, decoded)
+
+	_, _, err = FindGuardianSetAddress(3, CoreBridgeProgramID)
+	require.NoError(t, err)
+}
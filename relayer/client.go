@@ -0,0 +1,158 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Client is a FeePayerProvider backed by a remote relayer, which exposes:
+//   - GET  {url}/config: the relayer Config;
+//   - POST {url}/sign: co-signs a TransactionRequest, returns a SignResponse;
+//   - POST {url}/send: co-signs and submits a TransactionRequest, returns a SendResponse.
+//
+// See Server for the server side.
+type Client struct {
+	url        string
+	httpClient *http.Client
+	header     http.Header
+
+	mu     sync.Mutex
+	config *Config
+}
+
+var _ FeePayerProvider = (*Client)(nil)
+
+type ClientOpts struct {
+	// The HTTP client; defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Headers added to each request (e.g. an API key).
+	Header http.Header
+}
+
+// NewClient creates a client of the relayer at the provided base URL.
+func NewClient(url string, opts *ClientOpts) *Client {
+	cl := &Client{
+		url: strings.TrimRight(url, "/"),
+	}
+	if opts != nil {
+		cl.httpClient = opts.HTTPClient
+		cl.header = opts.Header
+	}
+	if cl.httpClient == nil {
+		cl.httpClient = http.DefaultClient
+	}
+	return cl
+}
+
+// Config returns the configuration of the relayer; it is fetched once, and cached.
+func (cl *Client) Config(ctx context.Context) (*Config, error) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	if cl.config != nil {
+		return cl.config, nil
+	}
+	var config Config
+	if err := cl.do(ctx, http.MethodGet, "/config", nil, &config); err != nil {
+		return nil, err
+	}
+	if config.FeePayer.IsZero() {
+		return nil, fmt.Errorf("relayer config has no fee payer")
+	}
+	cl.config = &config
+	return cl.config, nil
+}
+
+func (cl *Client) FeePayer(ctx context.Context) (solana.PublicKey, error) {
+	config, err := cl.Config(ctx)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	return config.FeePayer, nil
+}
+
+func (cl *Client) SignAsFeePayer(ctx context.Context, tx *solana.Transaction) (*solana.Transaction, error) {
+	encoded, err := EncodeTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+	var resp SignResponse
+	if err := cl.do(ctx, http.MethodPost, "/sign", &TransactionRequest{Transaction: encoded}, &resp); err != nil {
+		return nil, err
+	}
+	return DecodeTransaction(resp.Transaction)
+}
+
+// Send has the relayer co-sign and submit the partially signed transaction,
+// and returns its signature.
+func (cl *Client) Send(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+	encoded, err := EncodeTransaction(tx)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	var resp SendResponse
+	if err := cl.do(ctx, http.MethodPost, "/send", &TransactionRequest{Transaction: encoded}, &resp); err != nil {
+		return solana.Signature{}, err
+	}
+	return resp.Signature, nil
+}
+
+func (cl *Client) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, cl.url+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range cl.header {
+		req.Header[k] = v
+	}
+	resp, err := cl.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp ErrorResponse
+		if json.Unmarshal(respBody, &errResp) == nil && errResp.Error != "" {
+			return fmt.Errorf("relayer %s %s: %s: %s", method, path, resp.Status, errResp.Error)
+		}
+		return fmt.Errorf("relayer %s %s: %s", method, path, resp.Status)
+	}
+	return json.Unmarshal(respBody, out)
+}
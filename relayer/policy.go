@@ -0,0 +1,195 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relayer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ErrRejected is returned (wrapped) by ValidateTransaction
+// when the transaction violates the policy of the relayer.
+var ErrRejected = errors.New("transaction rejected")
+
+// DefaultLamportsPerSignature is the base fee of each signature.
+const DefaultLamportsPerSignature = 5000
+
+// Policy is the policy that the transactions must respect to be co-signed.
+type Policy struct {
+	// The programs that the transactions may invoke; empty means any program.
+	// The Compute Budget program is always allowed.
+	AllowedPrograms []solana.PublicKey
+
+	// The maximum fee (base fee plus priority fee) of a transaction,
+	// in lamports; zero means no limit.
+	MaxFee uint64
+
+	// The maximum number of signatures of a transaction
+	// (including the fee payer); zero means no limit.
+	MaxSignatures int
+
+	// The base fee of each signature; defaults to DefaultLamportsPerSignature.
+	LamportsPerSignature uint64
+}
+
+func rejectf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrRejected, fmt.Sprintf(format, args...))
+}
+
+// ValidateTransaction checks that the partially signed transaction can be
+// safely co-signed by the fee payer:
+//   - the fee payer is the first signer, and is not used by any instruction
+//     (so that the transaction cannot drain it, e.g. with a transfer);
+//   - the transaction does not use address lookup tables (which could load the fee payer);
+//   - it only invokes the allowed programs;
+//   - its fee does not exceed the maximum fee;
+//   - all the other signatures are present and valid.
+func ValidateTransaction(tx *solana.Transaction, feePayer solana.PublicKey, policy *Policy) error {
+	if policy == nil {
+		policy = &Policy{}
+	}
+	msg := &tx.Message
+	if len(msg.AccountKeys) == 0 || !msg.AccountKeys[0].Equals(feePayer) {
+		return rejectf("the fee payer must be %s", feePayer)
+	}
+	if msg.IsVersioned() && msg.NumLookups() > 0 {
+		return rejectf("address lookup tables are not supported")
+	}
+	numSigners := int(msg.Header.NumRequiredSignatures)
+	if policy.MaxSignatures > 0 && numSigners > policy.MaxSignatures {
+		return rejectf("too many signatures: %d > %d", numSigners, policy.MaxSignatures)
+	}
+
+	var computeUnitLimit *uint32
+	var computeUnitPrice uint64
+	numInstructions := 0
+	for i, inst := range msg.Instructions {
+		programID, err := msg.Program(inst.ProgramIDIndex)
+		if err != nil {
+			return rejectf("instruction %d: %v", i, err)
+		}
+		if programID.Equals(feePayer) {
+			return rejectf("instruction %d invokes the fee payer", i)
+		}
+		for _, index := range inst.Accounts {
+			if index == 0 {
+				return rejectf("instruction %d uses the fee payer", i)
+			}
+		}
+		if programID.Equals(solana.ComputeBudget) {
+			if err := parseComputeBudget(inst.Data, &computeUnitLimit, &computeUnitPrice); err != nil {
+				return rejectf("instruction %d: %v", i, err)
+			}
+			continue
+		}
+		numInstructions++
+		if len(policy.AllowedPrograms) > 0 && !programID.IsAnyOf(policy.AllowedPrograms...) {
+			return rejectf("instruction %d invokes program %s, which is not allowed", i, programID)
+		}
+	}
+
+	if policy.MaxFee > 0 {
+		fee := EstimateFee(numSigners, numInstructions, computeUnitLimit, computeUnitPrice, policy.LamportsPerSignature)
+		if fee > policy.MaxFee {
+			return rejectf("fee of %d lamports exceeds the maximum of %d", fee, policy.MaxFee)
+		}
+	}
+
+	messageContent, err := msg.MarshalBinary()
+	if err != nil {
+		return rejectf("unable to encode message: %v", err)
+	}
+	if len(tx.Signatures) != numSigners {
+		return rejectf("expected %d signatures, got %d", numSigners, len(tx.Signatures))
+	}
+	for i := 1; i < numSigners; i++ {
+		if !msg.AccountKeys[i].Verify(messageContent, tx.Signatures[i]) {
+			return rejectf("missing or invalid signature of %s", msg.AccountKeys[i])
+		}
+	}
+	return nil
+}
+
+// Default compute unit limit of each instruction, and maximum
+// compute unit limit of a transaction.
+const (
+	defaultInstructionComputeUnitLimit = 200000
+	maxComputeUnitLimit                = 1400000
+)
+
+// EstimateFee returns the fee of a transaction, in lamports: the base fee of each
+// signature, plus the priority fee (the compute unit price, in micro-lamports,
+// times the compute unit limit). If the limit is nil, the default limit is used
+// (200k compute units for each instruction that is not a Compute Budget instruction).
+// A fee that doesn't fit in an uint64 is returned as math.MaxUint64,
+// so that it exceeds any maximum fee.
+func EstimateFee(numSignatures int, numInstructions int, computeUnitLimit *uint32, computeUnitPrice uint64, lamportsPerSignature uint64) uint64 {
+	if lamportsPerSignature == 0 {
+		lamportsPerSignature = DefaultLamportsPerSignature
+	}
+	fee := uint64(numSignatures) * lamportsPerSignature
+	if computeUnitPrice == 0 {
+		return fee
+	}
+	var limit uint64
+	if computeUnitLimit != nil {
+		limit = uint64(*computeUnitLimit)
+	} else {
+		limit = uint64(numInstructions) * defaultInstructionComputeUnitLimit
+	}
+	if limit > maxComputeUnitLimit {
+		limit = maxComputeUnitLimit
+	}
+	hi, lo := bits.Mul64(computeUnitPrice, limit)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+	// Round up to the next lamport.
+	priorityFee := lo / 1000000
+	if lo%1000000 != 0 {
+		priorityFee++
+	}
+	if fee > math.MaxUint64-priorityFee {
+		return math.MaxUint64
+	}
+	return fee + priorityFee
+}
+
+// parseComputeBudget parses the SetComputeUnitLimit (2)
+// and SetComputeUnitPrice (3) instructions.
+func parseComputeBudget(data []byte, limit **uint32, price *uint64) error {
+	if len(data) == 0 {
+		return errors.New("empty compute budget instruction")
+	}
+	switch data[0] {
+	case 2:
+		if len(data) != 5 {
+			return errors.New("invalid SetComputeUnitLimit instruction")
+		}
+		value := binary.LittleEndian.Uint32(data[1:])
+		*limit = &value
+	case 3:
+		if len(data) != 9 {
+			return errors.New("invalid SetComputeUnitPrice instruction")
+		}
+		*price = binary.LittleEndian.Uint64(data[1:])
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relayer
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/require"
+)
+
+func newPartiallySigned(t *testing.T, feePayer solana.PublicKey, user solana.PrivateKey, instructions ...solana.Instruction) *solana.Transaction {
+	tx, err := solana.NewTransaction(instructions, solana.Hash{1}, solana.TransactionPayer(feePayer))
+	require.NoError(t, err)
+	require.NoError(t, PartialSign(tx, user))
+	return tx
+}
+
+func TestValidateTransaction(t *testing.T) {
+	feePayer := solana.NewWallet().PrivateKey.PublicKey()
+	user := solana.NewWallet().PrivateKey
+	memo := newTestInstruction(user.PublicKey())
+
+	valid := newPartiallySigned(t, feePayer, user, memo)
+	require.NoError(t, ValidateTransaction(valid, feePayer, nil))
+	require.NoError(t, ValidateTransaction(valid, feePayer, &Policy{
+		AllowedPrograms: []solana.PublicKey{solana.MemoProgramID},
+		MaxFee:          10000,
+	}))
+
+	tests := []struct {
+		name   string
+		tx     *solana.Transaction
+		policy *Policy
+		err    string
+	}{
+		{
+			name: "wrong fee payer",
+			tx:   newPartiallySigned(t, user.PublicKey(), user, memo),
+			err:  "the fee payer must be " + feePayer.String(),
+		},
+		{
+			name: "drain",
+			tx: newPartiallySigned(t, feePayer, user,
+				system.NewTransferInstruction(1000, feePayer, user.PublicKey()).Build(),
+				memo,
+			),
+			err: "instruction 0 uses the fee payer",
+		},
+		{
+			name:   "program not allowed",
+			tx:     valid,
+			policy: &Policy{AllowedPrograms: []solana.PublicKey{solana.SystemProgramID}},
+			err:    "instruction 0 invokes program " + solana.MemoProgramID.String() + ", which is not allowed",
+		},
+		{
+			name:   "fee cap",
+			tx:     valid,
+			policy: &Policy{MaxFee: 9999},
+			err:    "fee of 10000 lamports exceeds the maximum of 9999",
+		},
+		{
+			// price*limit wraps around to a tiny fee in 64 bits.
+			name: "overflowing priority fee",
+			tx: newPartiallySigned(t, feePayer, user,
+				computebudget.NewSetComputeUnitLimitInstruction(1400000).Build(),
+				computebudget.NewSetComputeUnitPriceInstruction(math.MaxUint64/1400000+1).Build(),
+				memo,
+			),
+			policy: &Policy{MaxFee: 1000000},
+			err:    "fee of 18446744073709551615 lamports exceeds the maximum of 1000000",
+		},
+		{
+			name:   "too many signatures",
+			tx:     valid,
+			policy: &Policy{MaxSignatures: 1},
+			err:    "too many signatures: 2 > 1",
+		},
+		{
+			name: "missing signature",
+			tx: func() *solana.Transaction {
+				tx, err := solana.NewTransaction([]solana.Instruction{memo}, solana.Hash{1}, solana.TransactionPayer(feePayer))
+				require.NoError(t, err)
+				return tx
+			}(),
+			err: "expected 2 signatures, got 0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateTransaction(test.tx, feePayer, test.policy)
+			require.True(t, errors.Is(err, ErrRejected))
+			require.EqualError(t, err, "transaction rejected: "+test.err)
+		})
+	}
+}
+
+func TestEstimateFee(t *testing.T) {
+	require.Equal(t, uint64(10000), EstimateFee(2, 1, nil, 0, 0))
+	// 200k CUs at 1000 micro-lamports each.
+	require.Equal(t, uint64(5200), EstimateFee(1, 1, nil, 1000, 0))
+	limit := uint32(100000)
+	require.Equal(t, uint64(5100), EstimateFee(1, 3, &limit, 1000, 0))
+
+	// Overflows saturate.
+	maxLimit := uint32(1400000)
+	price := uint64(math.MaxUint64 / 1400000)
+	require.Equal(t, uint64(18446744078709), EstimateFee(1, 1, &maxLimit, price, 0))
+	require.Equal(t, uint64(math.MaxUint64), EstimateFee(1, 1, &maxLimit, price+1, 0))
+	require.Equal(t, uint64(math.MaxUint64), EstimateFee(1, 1, &maxLimit, math.MaxUint64, 0))
+}
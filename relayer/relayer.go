@@ -0,0 +1,165 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package relayer implements gasless transactions: the user signs
+// a transaction whose fee payer is a remote relayer, which co-signs
+// it (and optionally submits it) on the user's behalf.
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// FeePayerProvider pays the fees of transactions signed by other signers.
+type FeePayerProvider interface {
+	// FeePayer returns the public key of the fee payer.
+	FeePayer(ctx context.Context) (solana.PublicKey, error)
+
+	// SignAsFeePayer adds the signature of the fee payer to the
+	// partially signed transaction, and returns the fully signed transaction.
+	SignAsFeePayer(ctx context.Context, tx *solana.Transaction) (*solana.Transaction, error)
+}
+
+// Config is the configuration advertised by a relayer.
+type Config struct {
+	// The fee payer of the relayed transactions.
+	FeePayer solana.PublicKey `json:"feePayer"`
+
+	// The programs that the relayed transactions may invoke;
+	// empty means any program.
+	AllowedPrograms []solana.PublicKey `json:"allowedPrograms,omitempty"`
+
+	// The maximum fee, in lamports, of a relayed transaction; zero means no limit.
+	MaxFee uint64 `json:"maxFee,omitempty"`
+}
+
+// TransactionRequest is the body of the sign and send requests.
+type TransactionRequest struct {
+	// The base64-encoded, partially signed transaction.
+	Transaction string `json:"transaction"`
+}
+
+// SignResponse is the body of the response to a sign request.
+type SignResponse struct {
+	// The base64-encoded, fully signed transaction.
+	Transaction string `json:"transaction"`
+}
+
+// SendResponse is the body of the response to a send request.
+type SendResponse struct {
+	Signature solana.Signature `json:"signature"`
+}
+
+// ErrorResponse is the body of the responses with an error status.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// EncodeTransaction encodes the transaction in base64.
+func EncodeTransaction(tx *solana.Transaction) (string, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// DecodeTransaction decodes a base64-encoded transaction.
+func DecodeTransaction(encoded string) (*solana.Transaction, error) {
+	tx := new(solana.Transaction)
+	if err := tx.UnmarshalBase64(encoded); err != nil {
+		return nil, fmt.Errorf("unable to decode transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// NewGaslessTransaction builds a transaction whose fee payer is the one of the
+// provider, and signs it with the provided signers (which must include all the
+// signers required by the instructions, except the fee payer).
+// The returned transaction must be co-signed with SignAsFeePayer.
+func NewGaslessTransaction(
+	ctx context.Context,
+	provider FeePayerProvider,
+	instructions []solana.Instruction,
+	recentBlockhash solana.Hash,
+	signers ...solana.Signer,
+) (*solana.Transaction, error) {
+	feePayer, err := provider.FeePayer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get fee payer: %w", err)
+	}
+	tx, err := solana.NewTransaction(instructions, recentBlockhash, solana.TransactionPayer(feePayer))
+	if err != nil {
+		return nil, err
+	}
+	if err := PartialSign(tx, signers...); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// PartialSign signs the transaction with the provided signers,
+// leaving the signatures of the other signers (e.g. the fee payer) empty.
+func PartialSign(tx *solana.Transaction, signers ...solana.Signer) error {
+	requests, err := tx.SigningRequests()
+	if err != nil {
+		return err
+	}
+	for _, req := range requests {
+		for _, signer := range signers {
+			if !signer.PublicKey().Equals(req.Signer) {
+				continue
+			}
+			sig, err := signer.Sign(req.Message)
+			if err != nil {
+				return fmt.Errorf("unable to sign with %s: %w", req.Signer, err)
+			}
+			if err := tx.ApplySignature(req.Signer, sig); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// SignGaslessTransaction co-signs the partially signed transaction with the
+// fee payer of the provider, and verifies that the provider did not alter the
+// message and that all the signatures are valid.
+func SignGaslessTransaction(ctx context.Context, provider FeePayerProvider, tx *solana.Transaction) (*solana.Transaction, error) {
+	signed, err := provider.SignAsFeePayer(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	want, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	got, err := signed.Message.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(want, got) {
+		return nil, fmt.Errorf("the fee payer altered the transaction message")
+	}
+	if err := signed.VerifySignatures(); err != nil {
+		return nil, fmt.Errorf("invalid signatures after signing by the fee payer: %w", err)
+	}
+	return signed, nil
+}
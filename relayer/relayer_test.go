@@ -0,0 +1,101 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+type testInstruction struct {
+	accounts []*solana.AccountMeta
+}
+
+func (inst *testInstruction) ProgramID() solana.PublicKey     { return solana.MemoProgramID }
+func (inst *testInstruction) Accounts() []*solana.AccountMeta { return inst.accounts }
+func (inst *testInstruction) Data() ([]byte, error)           { return []byte("hello"), nil }
+
+func newTestInstruction(signer solana.PublicKey) solana.Instruction {
+	return &testInstruction{
+		accounts: []*solana.AccountMeta{
+			{PublicKey: signer, IsSigner: true, IsWritable: true},
+		},
+	}
+}
+
+// mockRelayer co-signs the transactions with the fee payer;
+// if tamper is true, it alters the message before signing.
+func mockRelayer(t *testing.T, feePayer solana.PrivateKey, tamper *bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/config":
+			json.NewEncoder(w).Encode(&Config{FeePayer: feePayer.PublicKey()})
+		case "/sign":
+			var req TransactionRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			tx, err := DecodeTransaction(req.Transaction)
+			require.NoError(t, err)
+			if *tamper {
+				tx.Message.RecentBlockhash = solana.Hash{9}
+			}
+			require.NoError(t, PartialSign(tx, feePayer))
+			encoded, err := EncodeTransaction(tx)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(&SignResponse{Transaction: encoded})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&ErrorResponse{Error: "not found"})
+		}
+	}))
+}
+
+func TestClient(t *testing.T) {
+	feePayer := solana.NewWallet().PrivateKey
+	user := solana.NewWallet().PrivateKey
+	tamper := false
+	server := mockRelayer(t, feePayer, &tamper)
+	defer server.Close()
+	ctx := context.Background()
+
+	client := NewClient(server.URL+"/", nil)
+	payer, err := client.FeePayer(ctx)
+	require.NoError(t, err)
+	require.Equal(t, feePayer.PublicKey(), payer)
+
+	tx, err := NewGaslessTransaction(ctx, client, []solana.Instruction{newTestInstruction(user.PublicKey())}, solana.Hash{1}, user)
+	require.NoError(t, err)
+	require.Equal(t, feePayer.PublicKey(), tx.Message.AccountKeys[0])
+	require.True(t, tx.Signatures[0].IsZero())
+	require.False(t, tx.Signatures[1].IsZero())
+	require.False(t, tx.IsFullySigned())
+
+	signed, err := SignGaslessTransaction(ctx, client, tx)
+	require.NoError(t, err)
+	require.True(t, signed.IsFullySigned())
+	require.NoError(t, signed.VerifySignatures())
+
+	tamper = true
+	_, err = SignGaslessTransaction(ctx, client, tx)
+	require.EqualError(t, err, "the fee payer altered the transaction message")
+
+	_, err = client.Send(ctx, tx)
+	require.EqualError(t, err, "relayer POST /send: 404 Not Found: not found")
+}
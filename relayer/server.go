@@ -0,0 +1,182 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// maxRequestSize is the maximum size of the body of the requests;
+// a transaction is at most 1232 bytes, i.e. ~1.6KB in base64.
+const maxRequestSize = 16 * 1024
+
+// Server co-signs (and optionally submits) the transactions that respect its policy.
+type Server struct {
+	feePayer  solana.Signer
+	rpcClient *rpc.Client
+	policy    Policy
+}
+
+var _ FeePayerProvider = (*Server)(nil)
+
+// NewServer creates a relayer server that pays the fees with the provided signer.
+// The rpc client is only needed to submit transactions (see Send).
+// A nil policy only prevents the transactions from using the fee payer.
+func NewServer(feePayer solana.Signer, rpcClient *rpc.Client, policy *Policy) *Server {
+	srv := &Server{
+		feePayer:  feePayer,
+		rpcClient: rpcClient,
+	}
+	if policy != nil {
+		srv.policy = *policy
+	}
+	return srv
+}
+
+// Config returns the configuration advertised to the clients.
+func (srv *Server) Config() *Config {
+	return &Config{
+		FeePayer:        srv.feePayer.PublicKey(),
+		AllowedPrograms: srv.policy.AllowedPrograms,
+		MaxFee:          srv.policy.MaxFee,
+	}
+}
+
+func (srv *Server) FeePayer(ctx context.Context) (solana.PublicKey, error) {
+	return srv.feePayer.PublicKey(), nil
+}
+
+// SignAsFeePayer validates the transaction against the policy,
+// and adds the signature of the fee payer.
+func (srv *Server) SignAsFeePayer(ctx context.Context, tx *solana.Transaction) (*solana.Transaction, error) {
+	feePayer := srv.feePayer.PublicKey()
+	if err := ValidateTransaction(tx, feePayer, &srv.policy); err != nil {
+		return nil, err
+	}
+	req, err := tx.SigningRequest(feePayer)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := srv.feePayer.Sign(req.Message)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign as fee payer: %w", err)
+	}
+	if err := tx.ApplySignature(feePayer, sig); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Send co-signs the transaction, and submits it.
+func (srv *Server) Send(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+	if srv.rpcClient == nil {
+		return solana.Signature{}, errors.New("the relayer has no rpc client")
+	}
+	signed, err := srv.SignAsFeePayer(ctx, tx)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	return srv.rpcClient.SendTransaction(ctx, signed)
+}
+
+// Handler returns the HTTP handler of the relayer endpoints (see Client).
+func (srv *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/config", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		writeJSON(w, http.StatusOK, srv.Config())
+	})
+	mux.HandleFunc("/sign", func(w http.ResponseWriter, r *http.Request) {
+		tx, ok := readTransaction(w, r)
+		if !ok {
+			return
+		}
+		signed, err := srv.SignAsFeePayer(r.Context(), tx)
+		if err != nil {
+			writeError(w, errorStatus(err), err)
+			return
+		}
+		encoded, err := EncodeTransaction(signed)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, &SignResponse{Transaction: encoded})
+	})
+	mux.HandleFunc("/send", func(w http.ResponseWriter, r *http.Request) {
+		tx, ok := readTransaction(w, r)
+		if !ok {
+			return
+		}
+		sig, err := srv.Send(r.Context(), tx)
+		if err != nil {
+			writeError(w, errorStatus(err), err)
+			return
+		}
+		writeJSON(w, http.StatusOK, &SendResponse{Signature: sig})
+	})
+	return mux
+}
+
+func readTransaction(w http.ResponseWriter, r *http.Request) (*solana.Transaction, bool) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return nil, false
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return nil, false
+	}
+	var req TransactionRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return nil, false
+	}
+	tx, err := DecodeTransaction(req.Transaction)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return nil, false
+	}
+	return tx, true
+}
+
+func errorStatus(err error) int {
+	if errors.Is(err, ErrRejected) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, &ErrorResponse{Error: err.Error()})
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	feePayer := solana.NewWallet().PrivateKey
+	user := solana.NewWallet().PrivateKey
+	ctx := context.Background()
+
+	var sent bool
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     interface{}   `json:"id"`
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "sendTransaction", req.Method)
+		tx, err := DecodeTransaction(req.Params[0].(string))
+		require.NoError(t, err)
+		require.NoError(t, tx.VerifySignatures())
+		sent = true
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  tx.Signatures[0].String(),
+		})
+	}))
+	defer node.Close()
+
+	server := NewServer(feePayer, rpc.New(node.URL), &Policy{
+		AllowedPrograms: []solana.PublicKey{solana.MemoProgramID},
+		MaxFee:          10000,
+	})
+	httpServer := httptest.NewServer(server.Handler())
+	defer httpServer.Close()
+
+	client := NewClient(httpServer.URL, nil)
+	config, err := client.Config(ctx)
+	require.NoError(t, err)
+	require.Equal(t, feePayer.PublicKey(), config.FeePayer)
+	require.Equal(t, []solana.PublicKey{solana.MemoProgramID}, config.AllowedPrograms)
+	require.Equal(t, uint64(10000), config.MaxFee)
+
+	tx, err := NewGaslessTransaction(ctx, client, []solana.Instruction{newTestInstruction(user.PublicKey())}, solana.Hash{1}, user)
+	require.NoError(t, err)
+	signed, err := SignGaslessTransaction(ctx, client, tx)
+	require.NoError(t, err)
+	require.True(t, signed.IsFullySigned())
+
+	sig, err := client.Send(ctx, tx)
+	require.NoError(t, err)
+	require.True(t, sent)
+	require.Equal(t, signed.Signatures[0], sig)
+
+	drain := newPartiallySigned(t, feePayer.PublicKey(), user,
+		system.NewTransferInstruction(1000, feePayer.PublicKey(), user.PublicKey()).Build(),
+	)
+	_, err = client.SignAsFeePayer(ctx, drain)
+	require.EqualError(t, err, "relayer POST /sign: 400 Bad Request: transaction rejected: instruction 0 uses the fee payer")
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+)
+
+const (
+	// Default rental rate in lamports/byte-year.
+	DEFAULT_LAMPORTS_PER_BYTE_YEAR = 1_000_000_000 / 100 * 365 / (1024 * 1024)
+
+	// Default amount of time (in years) the balance has to include rent for
+	// the account to be rent exempt.
+	DEFAULT_EXEMPTION_THRESHOLD = 2
+
+	// Account storage overhead for calculation of base rent.
+	// This is the number of bytes required to store an account with no data.
+	ACCOUNT_STORAGE_OVERHEAD = 128
+)
+
+// MinimumBalanceForRentExemption returns the minimum balance (in lamports)
+// for an account with the provided data length to be rent exempt,
+// using the default rent parameters of the cluster.
+// This is the same value returned by the getMinimumBalanceForRentExemption RPC method.
+func MinimumBalanceForRentExemption(dataLen uint64) uint64 {
+	return (ACCOUNT_STORAGE_OVERHEAD + dataLen) * DEFAULT_LAMPORTS_PER_BYTE_YEAR * DEFAULT_EXEMPTION_THRESHOLD
+}
+
+// RentExemptRentEpoch is the rent epoch of the accounts the runtime
+// marked as rent exempt: they never owe rent again.
+const RentExemptRentEpoch = ^uint64(0)
+
+// Rent is the content of the Rent sysvar.
+type Rent struct {
+	// Rental rate in lamports/byte-year.
+	LamportsPerByteYear uint64
+	// Amount of time (in years) the balance has to include rent for
+	// the account to be rent exempt.
+	ExemptionThreshold float64
+	// Percentage of the collected rent that is burned.
+	BurnPercent uint8
+}
+
+// DefaultRent returns the default rent parameters of the cluster.
+func DefaultRent() *Rent {
+	return &Rent{
+		LamportsPerByteYear: DEFAULT_LAMPORTS_PER_BYTE_YEAR,
+		ExemptionThreshold:  DEFAULT_EXEMPTION_THRESHOLD,
+		BurnPercent:         50,
+	}
+}
+
+// DecodeRent decodes the data of the Rent sysvar.
+func DecodeRent(data []byte) (*Rent, error) {
+	rent := new(Rent)
+	if err := bin.NewBinDecoder(data).Decode(rent); err != nil {
+		return nil, fmt.Errorf("unable to decode rent: %w", err)
+	}
+	return rent, nil
+}
+
+// MinimumBalance returns the minimum balance (in lamports)
+// for an account with the provided data length to be rent exempt.
+func (rent *Rent) MinimumBalance(dataLen uint64) uint64 {
+	return uint64(float64((ACCOUNT_STORAGE_OVERHEAD+dataLen)*rent.LamportsPerByteYear) * rent.ExemptionThreshold)
+}
+
+// IsExempt returns true if the balance makes an account
+// with the provided data length rent exempt.
+func (rent *Rent) IsExempt(lamports uint64, dataLen uint64) bool {
+	return lamports >= rent.MinimumBalance(dataLen)
+}
+
+func (rent Rent) MarshalWithEncoder(encoder *bin.Encoder) (err error) {
+	err = encoder.WriteUint64(rent.LamportsPerByteYear, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	err = encoder.WriteFloat64(rent.ExemptionThreshold, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	return encoder.WriteUint8(rent.BurnPercent)
+}
+
+func (rent *Rent) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
+	rent.LamportsPerByteYear, err = decoder.ReadUint64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	rent.ExemptionThreshold, err = decoder.ReadFloat64(binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	rent.BurnPercent, err = decoder.ReadUint8()
+	return err
+}
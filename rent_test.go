@@ -0,0 +1,43 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"bytes"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRent(t *testing.T) {
+	rent := DefaultRent()
+	for _, dataLen := range []uint64{0, 82, 165, 10 * 1024 * 1024} {
+		require.Equal(t, MinimumBalanceForRentExemption(dataLen), rent.MinimumBalance(dataLen))
+	}
+	require.Equal(t, uint64(2039280), rent.MinimumBalance(165))
+	require.True(t, rent.IsExempt(2039280, 165))
+	require.False(t, rent.IsExempt(2039279, 165))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(rent))
+	require.Len(t, buf.Bytes(), 17)
+	decoded, err := DecodeRent(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, rent, decoded)
+
+	_, err = DecodeRent(buf.Bytes()[:16])
+	require.Error(t, err)
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package accountcache caches decoded accounts in memory, so that hot accounts
+// (mints, markets, sysvars, ...) are not fetched and decoded on every use.
+//
+// Entries are keyed by account and decoder, and tagged with the slot the
+// account was read at. They expire after a TTL, and are refreshed by account
+// subscription updates; an entry is never replaced with data from an older slot.
+package accountcache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+// Decoder decodes the data of an account.
+// Its name identifies it in the cache: two decoders
+// with the same name must decode to the same value.
+type Decoder interface {
+	Name() string
+	Decode(account *rpc.Account) (interface{}, error)
+}
+
+type decoderFunc struct {
+	name   string
+	decode func(account *rpc.Account) (interface{}, error)
+}
+
+func (d *decoderFunc) Name() string {
+	return d.name
+}
+
+func (d *decoderFunc) Decode(account *rpc.Account) (interface{}, error) {
+	return d.decode(account)
+}
+
+// NewDecoder creates a Decoder from a function.
+func NewDecoder(name string, decode func(account *rpc.Account) (interface{}, error)) Decoder {
+	return &decoderFunc{name: name, decode: decode}
+}
+
+type Opts struct {
+	// Duration after which an entry is fetched again.
+	// Defaults to 30 seconds; a negative TTL disables the expiry,
+	// for accounts kept up to date by subscriptions.
+	TTL time.Duration
+	// Commitment of the fetches.
+	// Defaults to confirmed.
+	Commitment rpc.CommitmentType
+}
+
+// Entry is a decoded account.
+type Entry struct {
+	Value interface{}
+	// The slot the account was read at.
+	Slot      uint64
+	UpdatedAt time.Time
+}
+
+type cachedAccount struct {
+	// Highest slot seen for the account, by a fetch or an update.
+	slot    uint64
+	entries map[string]*Entry
+	// decoders of the entries, to decode the updates.
+	decoders map[string]Decoder
+}
+
+// Cache is a cache of decoded accounts.
+type Cache struct {
+	client *rpc.Client
+	opts   Opts
+	now    func() time.Time
+
+	mu       sync.Mutex
+	accounts map[solana.PublicKey]*cachedAccount
+}
+
+// New creates a cache fetching the accounts with the provided client.
+func New(client *rpc.Client, opts *Opts) *Cache {
+	cache := &Cache{
+		client:   client,
+		now:      time.Now,
+		accounts: make(map[solana.PublicKey]*cachedAccount),
+	}
+	if opts != nil {
+		cache.opts = *opts
+	}
+	if cache.opts.TTL == 0 {
+		cache.opts.TTL = 30 * time.Second
+	}
+	if cache.opts.Commitment == "" {
+		cache.opts.Commitment = rpc.CommitmentConfirmed
+	}
+	return cache
+}
+
+// Get returns the account decoded with the decoder, from the cache
+// if it is there and not expired, otherwise from the RPC node.
+// It returns rpc.ErrNotFound if the account does not exist.
+func (cache *Cache) Get(ctx context.Context, pubkey solana.PublicKey, decoder Decoder) (*Entry, error) {
+	cache.mu.Lock()
+	var minContextSlot *uint64
+	if account, ok := cache.accounts[pubkey]; ok {
+		if entry, ok := account.entries[decoder.Name()]; ok && !cache.expired(entry) {
+			cache.mu.Unlock()
+			return entry, nil
+		}
+		slot := account.slot
+		minContextSlot = &slot
+	}
+	cache.mu.Unlock()
+
+	out, err := cache.client.GetAccountInfoWithOpts(ctx, pubkey, &rpc.GetAccountInfoOpts{
+		Commitment:     cache.opts.Commitment,
+		MinContextSlot: minContextSlot,
+	})
+	if err != nil {
+		return nil, err
+	}
+	value, err := decoder.Decode(out.Value)
+	if err != nil {
+		return nil, err
+	}
+	entry := &Entry{
+		Value:     value,
+		Slot:      out.Context.Slot,
+		UpdatedAt: cache.now(),
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	account := cache.account(pubkey)
+	if current, ok := account.entries[decoder.Name()]; ok && current.Slot > entry.Slot {
+		// Updated concurrently with more recent data.
+		return current, nil
+	}
+	if entry.Slot > account.slot {
+		account.slot = entry.Slot
+	}
+	account.entries[decoder.Name()] = entry
+	account.decoders[decoder.Name()] = decoder
+	return entry, nil
+}
+
+func (cache *Cache) expired(entry *Entry) bool {
+	return cache.opts.TTL > 0 && cache.now().Sub(entry.UpdatedAt) >= cache.opts.TTL
+}
+
+func (cache *Cache) account(pubkey solana.PublicKey) *cachedAccount {
+	account, ok := cache.accounts[pubkey]
+	if !ok {
+		account = &cachedAccount{
+			entries:  make(map[string]*Entry),
+			decoders: make(map[string]Decoder),
+		}
+		cache.accounts[pubkey] = account
+	}
+	return account
+}
+
+// Update refreshes the cached entries of an account with its state at slot,
+// typically received from an account subscription. Each cached entry is
+// decoded again; entries that fail to decode are dropped.
+// Updates older than the cached entries are ignored.
+func (cache *Cache) Update(pubkey solana.PublicKey, slot uint64, data *rpc.Account) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	account, ok := cache.accounts[pubkey]
+	if !ok || slot < account.slot {
+		return
+	}
+	account.slot = slot
+	now := cache.now()
+	for name, decoder := range account.decoders {
+		value, err := decoder.Decode(data)
+		if err != nil {
+			delete(account.entries, name)
+			delete(account.decoders, name)
+			continue
+		}
+		account.entries[name] = &Entry{
+			Value:     value,
+			Slot:      slot,
+			UpdatedAt: now,
+		}
+	}
+}
+
+// Invalidate drops the cached entries of an account; the next Get
+// will fetch it at a slot at least as recent as the ones already seen.
+func (cache *Cache) Invalidate(pubkey solana.PublicKey) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if account, ok := cache.accounts[pubkey]; ok {
+		account.entries = make(map[string]*Entry)
+		account.decoders = make(map[string]Decoder)
+	}
+}
+
+// Len returns the number of cached entries.
+func (cache *Cache) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	count := 0
+	for _, account := range cache.accounts {
+		count += len(account.entries)
+	}
+	return count
+}
+
+// Purge drops all the cached entries.
+func (cache *Cache) Purge() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.accounts = make(map[solana.PublicKey]*cachedAccount)
+}
+
+// Watch subscribes to an account and applies its updates to the cache
+// until the subscription fails or the returned function is called.
+// If the subscription fails, the entries of the account are invalidated.
+func (cache *Cache) Watch(wsClient *ws.Client, pubkey solana.PublicKey) (stop func(), err error) {
+	sub, err := wsClient.AccountSubscribe(pubkey, cache.opts.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		for {
+			result, err := sub.Recv()
+			if err != nil || result == nil {
+				// A nil result without error means the subscription was closed.
+				cache.Invalidate(pubkey)
+				return
+			}
+			account := result.Value.Account
+			cache.Update(pubkey, result.Context.Slot, &account)
+		}
+	}()
+	return sub.Unsubscribe, nil
+}
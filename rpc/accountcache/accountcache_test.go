@@ -0,0 +1,129 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accountcache
+
+import (
+	"context"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type accountNode struct {
+	slot    uint64
+	data    []byte
+	calls   int
+	minSlot *uint64
+}
+
+func (node *accountNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if method != "getAccountInfo" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	node.calls++
+	node.minSlot = nil
+	if minSlot, ok := params[1].(rpc.M)["minContextSlot"]; ok {
+		slot := minSlot.(uint64)
+		node.minSlot = &slot
+	}
+	if node.data == nil {
+		return stdjson.Unmarshal([]byte(fmt.Sprintf(`{"context":{"slot":%d},"value":null}`, node.slot)), out)
+	}
+	return stdjson.Unmarshal([]byte(fmt.Sprintf(
+		`{"context":{"slot":%d},"value":{"lamports":1,"owner":"11111111111111111111111111111111","data":["%s","base64"],"executable":false,"rentEpoch":0}}`,
+		node.slot, base64.StdEncoding.EncodeToString(node.data),
+	)), out)
+}
+
+func (node *accountNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return fmt.Errorf("not implemented")
+}
+
+var firstByte = NewDecoder("first-byte", func(account *rpc.Account) (interface{}, error) {
+	data := account.Data.GetBinary()
+	if len(data) == 0 {
+		return nil, errors.New("empty")
+	}
+	return data[0], nil
+})
+
+var length = NewDecoder("length", func(account *rpc.Account) (interface{}, error) {
+	return len(account.Data.GetBinary()), nil
+})
+
+func newAccount(data []byte) *rpc.Account {
+	return &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(data)}
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	pubkey := solana.PublicKey{1}
+	node := &accountNode{slot: 100, data: []byte{7, 8}}
+	now := time.Unix(1000, 0)
+	cache := New(rpc.NewWithCustomRPCClient(node), &Opts{TTL: time.Minute})
+	cache.now = func() time.Time { return now }
+
+	entry, err := cache.Get(ctx, pubkey, firstByte)
+	require.NoError(t, err)
+	require.Equal(t, &Entry{Value: byte(7), Slot: 100, UpdatedAt: now}, entry)
+	require.Nil(t, node.minSlot)
+
+	// Cached per decoder.
+	entry, err = cache.Get(ctx, pubkey, firstByte)
+	require.NoError(t, err)
+	require.Equal(t, byte(7), entry.Value)
+	require.Equal(t, 1, node.calls)
+	entry, err = cache.Get(ctx, pubkey, length)
+	require.NoError(t, err)
+	require.Equal(t, 2, entry.Value)
+	require.Equal(t, 2, node.calls)
+	require.Equal(t, 2, cache.Len())
+
+	// Updates decode the new data for each decoder, and older updates are ignored.
+	cache.Update(pubkey, 120, newAccount([]byte{9, 9, 9}))
+	cache.Update(pubkey, 110, newAccount([]byte{1}))
+	entry, _ = cache.Get(ctx, pubkey, firstByte)
+	require.Equal(t, &Entry{Value: byte(9), Slot: 120, UpdatedAt: now}, entry)
+	entry, _ = cache.Get(ctx, pubkey, length)
+	require.Equal(t, 3, entry.Value)
+	require.Equal(t, 2, node.calls)
+
+	// Entries failing to decode an update are dropped.
+	cache.Update(pubkey, 121, newAccount([]byte{}))
+	require.Equal(t, 1, cache.Len())
+
+	// Expired entries are fetched again, no older than the last seen slot.
+	now = now.Add(time.Minute)
+	node.slot = 130
+	entry, err = cache.Get(ctx, pubkey, length)
+	require.NoError(t, err)
+	require.Equal(t, &Entry{Value: 2, Slot: 130, UpdatedAt: now}, entry)
+	require.Equal(t, uint64(121), *node.minSlot)
+
+	cache.Invalidate(pubkey)
+	require.Equal(t, 0, cache.Len())
+
+	node.data = nil
+	_, err = cache.Get(ctx, solana.PublicKey{2}, length)
+	require.Equal(t, rpc.ErrNotFound, err)
+}
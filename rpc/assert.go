@@ -0,0 +1,132 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ErrNilAccount is returned by the assertions when the account is nil.
+var ErrNilAccount = errors.New("account is nil")
+
+// OwnerMismatchError is returned by AssertOwnedBy when the account
+// is not owned by the expected program.
+type OwnerMismatchError struct {
+	Expected solana.PublicKey
+	Actual   solana.PublicKey
+}
+
+func (e *OwnerMismatchError) Error() string {
+	return fmt.Sprintf("account is owned by %s, expected %s", e.Actual, e.Expected)
+}
+
+// NotATAError is returned by AssertIsATA when the address is not
+// the associated token account of the owner and mint.
+type NotATAError struct {
+	Address solana.PublicKey
+	Owner   solana.PublicKey
+	Mint    solana.PublicKey
+}
+
+func (e *NotATAError) Error() string {
+	return fmt.Sprintf("%s is not the associated token account of owner %s for mint %s", e.Address, e.Owner, e.Mint)
+}
+
+// NotRentExemptError is returned by AssertRentExempt when the account
+// balance is below the minimum balance for rent exemption.
+type NotRentExemptError struct {
+	Lamports uint64
+	Minimum  uint64
+	DataLen  uint64
+}
+
+func (e *NotRentExemptError) Error() string {
+	return fmt.Sprintf("account is not rent exempt: has %d lamports, needs %d for %d bytes of data", e.Lamports, e.Minimum, e.DataLen)
+}
+
+// AssertOwnedBy returns an *OwnerMismatchError if the account is not owned
+// by the provided program.
+func AssertOwnedBy(account *Account, programID solana.PublicKey) error {
+	if account == nil {
+		return ErrNilAccount
+	}
+	if !account.Owner.Equals(programID) {
+		return &OwnerMismatchError{
+			Expected: programID,
+			Actual:   account.Owner,
+		}
+	}
+	return nil
+}
+
+// AssertIsATA returns a *NotATAError if the provided address is not
+// the associated token account of the owner and mint, for either
+// the Token or the Token-2022 program.
+func AssertIsATA(address solana.PublicKey, owner solana.PublicKey, mint solana.PublicKey) error {
+	for _, tokenProgramID := range []solana.PublicKey{solana.TokenProgramID, solana.Token2022ProgramID} {
+		ata, _, err := solana.FindAssociatedTokenAddressWithProgram(owner, mint, tokenProgramID)
+		if err != nil {
+			return err
+		}
+		if ata.Equals(address) {
+			return nil
+		}
+	}
+	return &NotATAError{
+		Address: address,
+		Owner:   owner,
+		Mint:    mint,
+	}
+}
+
+// AssertRentExempt returns a *NotRentExemptError if the account balance is
+// below the minimum balance for rent exemption (with the default rent parameters).
+// The size of the account is its Space if returned by the node, or else
+// the length of its data, which must be binary-encoded (i.e. not jsonParsed).
+// If the account was fetched with a DataSlice from a node not returning
+// the Space, the data is not the whole account: use AssertRentExemptWithDataLen.
+func AssertRentExempt(account *Account) error {
+	if account == nil {
+		return ErrNilAccount
+	}
+	var dataLen uint64
+	switch {
+	case account.Space != nil:
+		dataLen = *account.Space
+	case account.Data != nil:
+		dataLen = uint64(len(account.Data.GetBinary()))
+	}
+	return AssertRentExemptWithDataLen(account, dataLen)
+}
+
+// AssertRentExemptWithDataLen is like AssertRentExempt,
+// with the size of the account data provided.
+func AssertRentExemptWithDataLen(account *Account, dataLen uint64) error {
+	if account == nil {
+		return ErrNilAccount
+	}
+	minimum := solana.MinimumBalanceForRentExemption(dataLen)
+	if account.Lamports < minimum {
+		return &NotRentExemptError{
+			Lamports: account.Lamports,
+			Minimum:  minimum,
+			DataLen:  dataLen,
+		}
+	}
+	return nil
+}
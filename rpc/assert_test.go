@@ -0,0 +1,87 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertOwnedBy(t *testing.T) {
+	account := &Account{Owner: solana.TokenProgramID}
+	require.NoError(t, AssertOwnedBy(account, solana.TokenProgramID))
+
+	err := AssertOwnedBy(account, solana.Token2022ProgramID)
+	var ownerErr *OwnerMismatchError
+	require.True(t, errors.As(err, &ownerErr))
+	require.Equal(t, solana.Token2022ProgramID, ownerErr.Expected)
+	require.Equal(t, solana.TokenProgramID, ownerErr.Actual)
+
+	require.Equal(t, ErrNilAccount, AssertOwnedBy(nil, solana.TokenProgramID))
+}
+
+func TestAssertIsATA(t *testing.T) {
+	owner := solana.MustPublicKeyFromBase58("Q6XprfkF8RQQKoQVG33xT88H7wi8Uk1B1CC7YAs69Gi")
+	mint := solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	ata, _, err := solana.FindAssociatedTokenAddress(owner, mint)
+	require.NoError(t, err)
+	require.NoError(t, AssertIsATA(ata, owner, mint))
+
+	ata2022, _, err := solana.FindAssociatedTokenAddressWithProgram(owner, mint, solana.Token2022ProgramID)
+	require.NoError(t, err)
+	require.NotEqual(t, ata, ata2022)
+	require.NoError(t, AssertIsATA(ata2022, owner, mint))
+
+	err = AssertIsATA(owner, owner, mint)
+	var ataErr *NotATAError
+	require.True(t, errors.As(err, &ataErr))
+	require.Equal(t, owner, ataErr.Address)
+}
+
+func TestAssertRentExempt(t *testing.T) {
+	account := &Account{
+		Lamports: 2039280,
+		Data:     DataBytesOrJSONFromBytes(make([]byte, 165)),
+	}
+	require.NoError(t, AssertRentExempt(account))
+
+	account.Lamports--
+	err := AssertRentExempt(account)
+	var rentErr *NotRentExemptError
+	require.True(t, errors.As(err, &rentErr))
+	require.Equal(t, uint64(2039280), rentErr.Minimum)
+	require.Equal(t, uint64(165), rentErr.DataLen)
+
+	require.NoError(t, AssertRentExempt(&Account{Lamports: 890880}))
+	require.Equal(t, ErrNilAccount, AssertRentExempt(nil))
+
+	// Sliced data: the space, or the provided size, is used.
+	space := uint64(165)
+	sliced := &Account{
+		Lamports: 2039279,
+		Data:     DataBytesOrJSONFromBytes(make([]byte, 8)),
+		Space:    &space,
+	}
+	require.True(t, errors.As(AssertRentExempt(sliced), &rentErr))
+	require.Equal(t, uint64(165), rentErr.DataLen)
+	sliced.Space = nil
+	require.NoError(t, AssertRentExempt(sliced))
+	require.True(t, errors.As(AssertRentExemptWithDataLen(sliced, 165), &rentErr))
+	require.Equal(t, uint64(165), rentErr.DataLen)
+}
@@ -0,0 +1,222 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// ErrBatchNotSupported is returned by the CallBatch method of the JSON-RPC
+// clients wrapping a client that cannot send batches; BatchCall then sends
+// the requests one at a time.
+var ErrBatchNotSupported = errors.New("rpc client does not support batches")
+
+// BatchJSONRPCClient is implemented by the JSON-RPC clients
+// that can send several requests in one batch payload,
+// like the clients of the jsonrpc package.
+type BatchJSONRPCClient interface {
+	CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error)
+}
+
+// BatchRequest is a request of a batch.
+type BatchRequest struct {
+	Method string
+	Params []interface{}
+
+	// Pointer the result is decoded into.
+	Result interface{}
+
+	// Set by BatchCall if the request failed, or its
+	// result could not be decoded into Result.
+	Error error
+}
+
+// NewBatchRequest creates a request decoding its result into result, which must be a pointer.
+func NewBatchRequest(method string, params []interface{}, result interface{}) *BatchRequest {
+	return &BatchRequest{
+		Method: method,
+		Params: params,
+		Result: result,
+	}
+}
+
+// BatchCall sends the requests in a single JSON-RPC batch payload,
+// and decodes the result of each request into its Result, or sets its Error.
+// The returned error is only set if the batch as a whole failed.
+//
+// If the JSON-RPC client of the client does not implement BatchJSONRPCClient,
+// or fails with ErrBatchNotSupported, the requests are sent one at a time.
+func (cl *Client) BatchCall(ctx context.Context, requests ...*BatchRequest) error {
+	if len(requests) == 0 {
+		return nil
+	}
+	batchClient, ok := cl.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return cl.callSequentially(ctx, requests)
+	}
+
+	rpcRequests := make(jsonrpc.RPCRequests, len(requests))
+	for i, req := range requests {
+		rpcRequests[i] = &jsonrpc.RPCRequest{
+			Method:  req.Method,
+			Params:  req.Params,
+			JSONRPC: "2.0",
+		}
+	}
+	// The requests are numbered by CallBatch.
+	responses, err := batchClient.CallBatch(ctx, rpcRequests)
+	if errors.Is(err, ErrBatchNotSupported) {
+		return cl.callSequentially(ctx, requests)
+	}
+	if err != nil {
+		return err
+	}
+	for i, req := range requests {
+		resp := responses.GetByID(rpcRequests[i].ID)
+		switch {
+		case resp == nil:
+			req.Error = fmt.Errorf("rpc batch call: no response to request %d (%s)", i, req.Method)
+		case resp.Error != nil:
+			req.Error = resp.Error
+		default:
+			req.Error = resp.GetObject(req.Result)
+		}
+	}
+	return nil
+}
+
+func (cl *Client) callSequentially(ctx context.Context, requests []*BatchRequest) error {
+	for _, req := range requests {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		req.Error = cl.rpcClient.CallForInto(ctx, req.Result, req.Method, req.Params)
+	}
+	return nil
+}
+
+// Batch collects typed requests, to send them with BatchCall.
+type Batch struct {
+	cl       *Client
+	requests []*BatchRequest
+}
+
+// NewBatch creates an empty batch.
+func (cl *Client) NewBatch() *Batch {
+	return &Batch{cl: cl}
+}
+
+// Add adds a request to the batch.
+func (b *Batch) Add(req *BatchRequest) *BatchRequest {
+	b.requests = append(b.requests, req)
+	return req
+}
+
+// Len returns the number of requests of the batch.
+func (b *Batch) Len() int {
+	return len(b.requests)
+}
+
+// Send sends the requests of the batch; see BatchCall.
+func (b *Batch) Send(ctx context.Context) error {
+	return b.cl.BatchCall(ctx, b.requests...)
+}
+
+// BatchGetAccountInfoResult is the result of a getAccountInfo request of a batch.
+type BatchGetAccountInfoResult struct {
+	req *BatchRequest
+	out *GetAccountInfoResult
+}
+
+// GetAccountInfo adds a getAccountInfo request to the batch,
+// whose result is available after the batch is sent.
+func (b *Batch) GetAccountInfo(account solana.PublicKey, opts *GetAccountInfoOpts) *BatchGetAccountInfoResult {
+	res := new(BatchGetAccountInfoResult)
+	params, err := getAccountInfoParams(account, opts)
+	res.req = &BatchRequest{Method: "getAccountInfo", Params: params, Result: &res.out, Error: err}
+	if err == nil {
+		b.Add(res.req)
+	}
+	return res
+}
+
+// Result returns the result of the request, like GetAccountInfoWithOpts.
+func (res *BatchGetAccountInfoResult) Result() (*GetAccountInfoResult, error) {
+	if res.req.Error != nil {
+		return nil, res.req.Error
+	}
+	if res.out == nil || res.out.Value == nil {
+		return nil, ErrNotFound
+	}
+	return res.out, nil
+}
+
+// BatchGetTransactionResult is the result of a getTransaction request of a batch.
+type BatchGetTransactionResult struct {
+	req *BatchRequest
+	out *GetTransactionResult
+}
+
+// GetTransaction adds a getTransaction request to the batch,
+// whose result is available after the batch is sent.
+func (b *Batch) GetTransaction(txSig solana.Signature, opts *GetTransactionOpts) *BatchGetTransactionResult {
+	res := new(BatchGetTransactionResult)
+	params, err := b.cl.getTransactionParams(txSig, opts)
+	res.req = &BatchRequest{Method: "getTransaction", Params: params, Result: &res.out, Error: err}
+	if err == nil {
+		b.Add(res.req)
+	}
+	return res
+}
+
+// Result returns the result of the request, like GetTransaction.
+func (res *BatchGetTransactionResult) Result() (*GetTransactionResult, error) {
+	if res.req.Error != nil {
+		return nil, res.req.Error
+	}
+	if res.out == nil {
+		return nil, ErrNotFound
+	}
+	return res.out, nil
+}
+
+// BatchGetBalanceResult is the result of a getBalance request of a batch.
+type BatchGetBalanceResult struct {
+	req *BatchRequest
+	out *GetBalanceResult
+}
+
+// GetBalance adds a getBalance request to the batch,
+// whose result is available after the batch is sent.
+func (b *Batch) GetBalance(publicKey solana.PublicKey, commitment CommitmentType) *BatchGetBalanceResult {
+	params := []interface{}{publicKey}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
+	res := new(BatchGetBalanceResult)
+	res.req = b.Add(&BatchRequest{Method: "getBalance", Params: params, Result: &res.out})
+	return res
+}
+
+// Result returns the result of the request, like GetBalance.
+func (res *BatchGetBalanceResult) Result() (*GetBalanceResult, error) {
+	if res.req.Error != nil {
+		return nil, res.req.Error
+	}
+	return res.out, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_BatchCall(t *testing.T) {
+	// The responses are not in the order of the requests.
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(`[
+		{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":42},"id":2},
+		{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":{"data":["dGVzdA==","base64"],"executable":false,"lamports":999999,"owner":"11111111111111111111111111111111","rentEpoch":207}},"id":0},
+		{"jsonrpc":"2.0","result":null,"id":1},
+		{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid param"},"id":3}
+	]`))
+	defer closer()
+	client := New(server.URL)
+
+	account := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	batch := client.NewBatch()
+	info := batch.GetAccountInfo(account, nil)
+	tx := batch.GetTransaction(solana.Signature{1}, nil)
+	balance := batch.GetBalance(account, CommitmentFinalized)
+	var slot uint64
+	raw := batch.Add(NewBatchRequest("getSlot", nil, &slot))
+	require.Equal(t, 4, batch.Len())
+	require.NoError(t, batch.Send(context.Background()))
+
+	var body []map[string]interface{}
+	require.NoError(t, stdjson.Unmarshal(server.body, &body))
+	require.Len(t, body, 4)
+	for i, method := range []string{"getAccountInfo", "getTransaction", "getBalance", "getSlot"} {
+		require.Equal(t, method, body[i]["method"])
+		require.Equal(t, float64(i), body[i]["id"])
+	}
+	require.Equal(t, []interface{}{account.String(), map[string]interface{}{"encoding": "base64"}}, body[0]["params"])
+
+	infoOut, err := info.Result()
+	require.NoError(t, err)
+	require.Equal(t, uint64(999999), infoOut.Value.Lamports)
+	require.Equal(t, []byte("test"), infoOut.Value.Data.GetBinary())
+
+	_, err = tx.Result()
+	require.Equal(t, ErrNotFound, err)
+
+	balanceOut, err := balance.Result()
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), balanceOut.Value)
+
+	var rpcErr *jsonrpc.RPCError
+	require.True(t, errors.As(raw.Error, &rpcErr))
+	require.Equal(t, -32602, rpcErr.Code)
+}
+
+func TestClient_BatchCall_InvalidOpts(t *testing.T) {
+	batch := New("http://localhost:0").NewBatch()
+	info := batch.GetAccountInfo(solana.PublicKey{}, &GetAccountInfoOpts{
+		Encoding:  solana.EncodingJSONParsed,
+		DataSlice: &DataSlice{},
+	})
+	require.Equal(t, 0, batch.Len())
+	_, err := info.Result()
+	require.Error(t, err)
+	require.NoError(t, batch.Send(context.Background()))
+}
+
+// sequentialRPC is a JSON-RPC client without batch support.
+type sequentialRPC struct {
+	methods []string
+}
+
+func (s *sequentialRPC) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	s.methods = append(s.methods, method)
+	if method == "getSlot" {
+		return stdjson.Unmarshal([]byte("7"), out)
+	}
+	return fmt.Errorf("unexpected method %s", method)
+}
+
+func (s *sequentialRPC) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return fmt.Errorf("not implemented")
+}
+
+func TestClient_BatchCall_Sequential(t *testing.T) {
+	rpcClient := &sequentialRPC{}
+	client := NewWithCustomRPCClient(rpcClient)
+
+	var slot uint64
+	var height uint64
+	requests := []*BatchRequest{
+		NewBatchRequest("getSlot", nil, &slot),
+		NewBatchRequest("getBlockHeight", nil, &height),
+	}
+	require.NoError(t, client.BatchCall(context.Background(), requests...))
+	require.Equal(t, []string{"getSlot", "getBlockHeight"}, rpcClient.methods)
+	require.NoError(t, requests[0].Error)
+	require.Equal(t, uint64(7), slot)
+	require.EqualError(t, requests[1].Error, "unexpected method getBlockHeight")
+}
+
+func TestClient_BatchCall_SequentialWrapped(t *testing.T) {
+	for name, wrap := range map[string]func(JSONRPCClient) JSONRPCClient{
+		"cache": func(c JSONRPCClient) JSONRPCClient { return NewCachingClient(c, nil) },
+		"circuit breaker": func(c JSONRPCClient) JSONRPCClient {
+			return NewCircuitBreaker(c, &CircuitBreakerOpts{MaxFailures: 1})
+		},
+		"failover": func(c JSONRPCClient) JSONRPCClient { return NewFailoverClient([]JSONRPCClient{c, c}, nil) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			rpcClient := &sequentialRPC{}
+			client := NewWithCustomRPCClient(wrap(rpcClient)).SetRequestCoalescing(true)
+
+			for i := 0; i < 2; i++ {
+				var slot uint64
+				request := NewBatchRequest("getSlot", nil, &slot)
+				require.NoError(t, client.BatchCall(context.Background(), request))
+				require.NoError(t, request.Error)
+				require.Equal(t, uint64(7), slot)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blockhash provides implementations of solana.BlockhashProvider
+// backed by an RPC node: a per-call fetch, a TTL cache, and a provider that
+// refreshes the blockhash proactively as new slots are processed.
+package blockhash
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+// Provider is a solana.BlockhashProvider that also
+// provides the last block height at which the blockhash is valid.
+type Provider interface {
+	solana.BlockhashProvider
+	LatestBlockhash(ctx context.Context) (*rpc.LatestBlockhashResult, error)
+}
+
+var (
+	_ Provider = (*RPCProvider)(nil)
+	_ Provider = (*CachedProvider)(nil)
+	_ Provider = (*SubscriptionProvider)(nil)
+)
+
+// RPCProvider fetches the latest blockhash on every call.
+type RPCProvider struct {
+	client     *rpc.Client
+	commitment rpc.CommitmentType
+}
+
+// NewRPCProvider creates a provider that calls getLatestBlockhash on every call.
+func NewRPCProvider(client *rpc.Client, commitment rpc.CommitmentType) *RPCProvider {
+	return &RPCProvider{
+		client:     client,
+		commitment: commitment,
+	}
+}
+
+func (p *RPCProvider) LatestBlockhash(ctx context.Context) (*rpc.LatestBlockhashResult, error) {
+	out, err := p.client.GetLatestBlockhash(ctx, p.commitment)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil || out.Value == nil {
+		return nil, errors.New("expected a value, got null result")
+	}
+	return out.Value, nil
+}
+
+func (p *RPCProvider) RecentBlockhash(ctx context.Context) (solana.Hash, error) {
+	latest, err := p.LatestBlockhash(ctx)
+	if err != nil {
+		return solana.Hash{}, err
+	}
+	return latest.Blockhash, nil
+}
+
+// CachedProvider caches the blockhash of another provider for a fixed duration.
+type CachedProvider struct {
+	source Provider
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	latest    *rpc.LatestBlockhashResult
+	fetchedAt time.Time
+}
+
+// NewCachedProvider creates a provider that fetches the blockhash from the source
+// at most once every ttl. A blockhash expires after ~60 seconds, so the ttl
+// should be a few seconds (e.g. 5 seconds).
+func NewCachedProvider(source Provider, ttl time.Duration) *CachedProvider {
+	return &CachedProvider{
+		source: source,
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+func (p *CachedProvider) LatestBlockhash(ctx context.Context) (*rpc.LatestBlockhashResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.latest != nil && p.now().Sub(p.fetchedAt) < p.ttl {
+		return p.latest, nil
+	}
+	latest, err := p.source.LatestBlockhash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.latest = latest
+	p.fetchedAt = p.now()
+	return latest, nil
+}
+
+func (p *CachedProvider) RecentBlockhash(ctx context.Context) (solana.Hash, error) {
+	latest, err := p.LatestBlockhash(ctx)
+	if err != nil {
+		return solana.Hash{}, err
+	}
+	return latest.Blockhash, nil
+}
+
+// Invalidate discards the cached blockhash (e.g. after a "blockhash not found" error).
+func (p *CachedProvider) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.latest = nil
+}
+
+// DefaultRefreshSlots is the default number of slots
+// between two refreshes of a SubscriptionProvider.
+const DefaultRefreshSlots = 10
+
+// DefaultMaxAge is the default maximum age of the blockhash of a SubscriptionProvider,
+// well within the validity of a blockhash (150 blocks, about a minute).
+const DefaultMaxAge = 30 * time.Second
+
+// SubscriptionProviderOpts are the options of NewSubscriptionProvider.
+type SubscriptionProviderOpts struct {
+	// Commitment of the getLatestBlockhash requests.
+	Commitment rpc.CommitmentType
+
+	// The number of slots between two refreshes.
+	// If not provided, the default is DefaultRefreshSlots.
+	RefreshSlots uint64
+
+	// Maximum age of the blockhash refreshed in the background; an older
+	// blockhash (e.g. because the refreshes fail) is fetched again on the call.
+	// If not provided, the default is DefaultMaxAge.
+	MaxAge time.Duration
+
+	// Called with the errors of the refreshes made in the background. Optional.
+	// If the slot subscription fails, the blockhash is fetched on every call.
+	OnError func(err error)
+}
+
+// slotSource is implemented by *ws.SlotSubscription.
+type slotSource interface {
+	Recv() (*ws.SlotResult, error)
+	Unsubscribe()
+}
+
+// SubscriptionProvider refreshes the blockhash in the background, every
+// RefreshSlots slots (as notified by a slot subscription), so that
+// RecentBlockhash does not make any RPC call on the hot path.
+// If the subscription fails, or the blockhash gets older than MaxAge,
+// it falls back to fetching the blockhash on the call.
+type SubscriptionProvider struct {
+	source       Provider
+	sub          slotSource
+	refreshSlots uint64
+	maxAge       time.Duration
+	onError      func(err error)
+	now          func() time.Time
+
+	mu        sync.RWMutex
+	latest    *rpc.LatestBlockhashResult
+	fetchedAt time.Time
+	failed    bool
+
+	done chan struct{}
+	once sync.Once
+}
+
+// NewSubscriptionProvider fetches the latest blockhash, then subscribes to the
+// slots with the provided websocket client to keep it fresh.
+// Call Close to stop the subscription.
+func NewSubscriptionProvider(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	wsClient *ws.Client,
+	opts *SubscriptionProviderOpts,
+) (*SubscriptionProvider, error) {
+	if opts == nil {
+		opts = &SubscriptionProviderOpts{}
+	}
+	sub, err := wsClient.SlotSubscribe()
+	if err != nil {
+		return nil, err
+	}
+	p, err := newSubscriptionProvider(ctx, NewRPCProvider(rpcClient, opts.Commitment), sub, opts)
+	if err != nil {
+		sub.Unsubscribe()
+		return nil, err
+	}
+	return p, nil
+}
+
+func newSubscriptionProvider(ctx context.Context, source Provider, sub slotSource, opts *SubscriptionProviderOpts) (*SubscriptionProvider, error) {
+	p := &SubscriptionProvider{
+		source:       source,
+		sub:          sub,
+		refreshSlots: opts.RefreshSlots,
+		maxAge:       opts.MaxAge,
+		onError:      opts.OnError,
+		now:          time.Now,
+		done:         make(chan struct{}),
+	}
+	if p.refreshSlots == 0 {
+		p.refreshSlots = DefaultRefreshSlots
+	}
+	if p.maxAge <= 0 {
+		p.maxAge = DefaultMaxAge
+	}
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+	go p.run()
+	return p, nil
+}
+
+func (p *SubscriptionProvider) refresh(ctx context.Context) error {
+	_, err := p.fetch(ctx)
+	return err
+}
+
+func (p *SubscriptionProvider) fetch(ctx context.Context) (*rpc.LatestBlockhashResult, error) {
+	latest, err := p.source.LatestBlockhash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.latest = latest
+	p.fetchedAt = p.now()
+	p.mu.Unlock()
+	return latest, nil
+}
+
+func (p *SubscriptionProvider) run() {
+	var lastRefresh uint64
+	for {
+		slot, err := p.sub.Recv()
+		select {
+		case <-p.done:
+			return
+		default:
+		}
+		if err != nil {
+			p.mu.Lock()
+			p.failed = true
+			p.mu.Unlock()
+			if p.onError != nil {
+				p.onError(err)
+			}
+			return
+		}
+		if lastRefresh == 0 {
+			lastRefresh = slot.Slot
+			continue
+		}
+		if slot.Slot < lastRefresh+p.refreshSlots {
+			continue
+		}
+		lastRefresh = slot.Slot
+		if err := p.refresh(context.Background()); err != nil && p.onError != nil {
+			p.onError(err)
+		}
+	}
+}
+
+func (p *SubscriptionProvider) LatestBlockhash(ctx context.Context) (*rpc.LatestBlockhashResult, error) {
+	p.mu.RLock()
+	latest, fresh := p.latest, !p.failed && p.now().Sub(p.fetchedAt) < p.maxAge
+	p.mu.RUnlock()
+	if fresh {
+		return latest, nil
+	}
+	return p.fetch(ctx)
+}
+
+func (p *SubscriptionProvider) RecentBlockhash(ctx context.Context) (solana.Hash, error) {
+	latest, err := p.LatestBlockhash(ctx)
+	if err != nil {
+		return solana.Hash{}, err
+	}
+	return latest.Blockhash, nil
+}
+
+// Close stops the slot subscription.
+func (p *SubscriptionProvider) Close() {
+	p.once.Do(func() {
+		close(p.done)
+		p.sub.Unsubscribe()
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockhash
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/stretchr/testify/require"
+)
+
+// countingProvider returns a new blockhash on every call.
+type countingProvider struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (p *countingProvider) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls
+}
+
+func (p *countingProvider) LatestBlockhash(ctx context.Context) (*rpc.LatestBlockhashResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	return &rpc.LatestBlockhashResult{
+		Blockhash:            solana.Hash{byte(p.calls)},
+		LastValidBlockHeight: uint64(p.calls),
+	}, nil
+}
+
+func (p *countingProvider) RecentBlockhash(ctx context.Context) (solana.Hash, error) {
+	latest, err := p.LatestBlockhash(ctx)
+	if err != nil {
+		return solana.Hash{}, err
+	}
+	return latest.Blockhash, nil
+}
+
+type fakeSlots struct {
+	slots chan uint64
+}
+
+func (f *fakeSlots) Recv() (*ws.SlotResult, error) {
+	slot, ok := <-f.slots
+	if !ok {
+		return nil, errors.New("subscription closed")
+	}
+	return &ws.SlotResult{Slot: slot}, nil
+}
+
+func (f *fakeSlots) Unsubscribe() {}
+
+func TestCachedProvider(t *testing.T) {
+	ctx := context.Background()
+	source := &countingProvider{}
+	now := time.Unix(0, 0)
+	p := NewCachedProvider(source, 5*time.Second)
+	p.now = func() time.Time { return now }
+
+	hash, err := p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{1}, hash)
+
+	now = now.Add(4 * time.Second)
+	hash, err = p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{1}, hash)
+
+	now = now.Add(time.Second)
+	hash, err = p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{2}, hash)
+
+	p.Invalidate()
+	hash, err = p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{3}, hash)
+}
+
+func TestSubscriptionProvider(t *testing.T) {
+	ctx := context.Background()
+	source := &countingProvider{}
+	slots := &fakeSlots{slots: make(chan uint64)}
+	errs := make(chan error, 1)
+	p, err := newSubscriptionProvider(ctx, source, slots, &SubscriptionProviderOpts{
+		RefreshSlots: 4,
+		OnError:      func(err error) { errs <- err },
+	})
+	require.NoError(t, err)
+	defer p.Close()
+
+	hash, err := p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{1}, hash)
+
+	for slot := uint64(100); slot < 104; slot++ {
+		slots.slots <- slot
+	}
+	require.Equal(t, 1, source.count())
+	slots.slots <- 104
+	close(slots.slots)
+	require.EqualError(t, <-errs, "subscription closed")
+	require.Equal(t, 2, source.count())
+
+	// Without the subscription, the blockhash is fetched on every call.
+	latest, err := p.LatestBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{3}, latest.Blockhash)
+	require.Equal(t, uint64(3), latest.LastValidBlockHeight)
+	hash, err = p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{4}, hash)
+}
+
+func TestSubscriptionProvider_MaxAge(t *testing.T) {
+	ctx := context.Background()
+	source := &countingProvider{}
+	slots := &fakeSlots{slots: make(chan uint64)}
+	p, err := newSubscriptionProvider(ctx, source, slots, &SubscriptionProviderOpts{
+		MaxAge: time.Minute,
+	})
+	require.NoError(t, err)
+	defer p.Close()
+	now := time.Now()
+	p.mu.Lock()
+	p.now = func() time.Time { return now }
+	p.mu.Unlock()
+
+	hash, err := p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{1}, hash)
+
+	// No slot notifications: the blockhash gets too old and is fetched again.
+	now = now.Add(2 * time.Minute)
+	hash, err = p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{2}, hash)
+	hash, err = p.RecentBlockhash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, solana.Hash{2}, hash)
+	require.Equal(t, 2, source.count())
+}
@@ -0,0 +1,277 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blocktime estimates the time of a slot, and the slot at a time.
+//
+// The estimates interpolate between anchors, slots with a known block time
+// (from getBlockTime, or added by the caller). Outside of the anchors, they
+// extrapolate from the nearest anchor with the slot duration measured by the
+// recent performance samples; restarting from a real block time at every
+// anchor corrects the drift between the nominal and the actual slot duration.
+package blocktime
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// DefaultSlotDuration is the slot duration used
+// before any performance sample is available.
+const DefaultSlotDuration = 400 * time.Millisecond
+
+// ErrNoSamples is returned when estimating without any anchor.
+var ErrNoSamples = errors.New("blocktime: no block time samples")
+
+// Number of consecutive slots tried below a skipped slot when sampling block times.
+const maxSkippedSlots = 8
+
+type Opts struct {
+	// Number of block times sampled by Refresh.
+	// Defaults to 4.
+	Samples int
+	// Number of slots between the block times sampled by Refresh.
+	// Defaults to 1000.
+	Spacing uint64
+	// Number of performance samples (one per minute) averaged
+	// into the slot duration. Defaults to 30; the maximum is 720.
+	PerformanceSamples uint
+	// Maximum number of anchors kept; the oldest are dropped first.
+	// Defaults to 256.
+	MaxAnchors int
+}
+
+// Anchor is a slot with a known block time.
+type Anchor struct {
+	Slot uint64
+	Time time.Time
+}
+
+// Estimator maps slots to times and times to slots.
+type Estimator struct {
+	client *rpc.Client
+	opts   Opts
+
+	mu           sync.RWMutex
+	anchors      []Anchor // sorted by slot
+	slotDuration time.Duration
+}
+
+// NewEstimator creates an estimator sampling with the provided client.
+// The client can be nil if the anchors are only added with AddAnchor.
+func NewEstimator(client *rpc.Client, opts *Opts) *Estimator {
+	est := &Estimator{
+		client:       client,
+		slotDuration: DefaultSlotDuration,
+	}
+	if opts != nil {
+		est.opts = *opts
+	}
+	if est.opts.Samples <= 0 {
+		est.opts.Samples = 4
+	}
+	if est.opts.Spacing == 0 {
+		est.opts.Spacing = 1000
+	}
+	if est.opts.PerformanceSamples == 0 {
+		est.opts.PerformanceSamples = 30
+	}
+	if est.opts.PerformanceSamples > 720 {
+		est.opts.PerformanceSamples = 720
+	}
+	if est.opts.MaxAnchors <= 0 {
+		est.opts.MaxAnchors = 256
+	}
+	return est
+}
+
+// Refresh updates the slot duration from the recent performance samples,
+// and samples the block times of the last finalized slot and of
+// the slots at every Spacing before it.
+func (est *Estimator) Refresh(ctx context.Context) error {
+	samples, err := est.client.GetRecentPerformanceSamples(ctx, &est.opts.PerformanceSamples)
+	if err != nil {
+		return err
+	}
+	est.SetPerformanceSamples(samples)
+
+	slot, err := est.client.GetSlot(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		return err
+	}
+	var lastErr error
+	sampled := 0
+	for i := 0; i < est.opts.Samples; i++ {
+		offset := uint64(i) * est.opts.Spacing
+		if offset > slot {
+			break
+		}
+		anchor, err := est.sampleBlockTime(ctx, slot-offset)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		est.AddAnchor(anchor.Slot, anchor.Time)
+		sampled++
+	}
+	if sampled == 0 && lastErr != nil {
+		return lastErr
+	}
+	return nil
+}
+
+// sampleBlockTime returns the block time of the slot, or of the first slot
+// below it with a block time if it was skipped.
+func (est *Estimator) sampleBlockTime(ctx context.Context, slot uint64) (*Anchor, error) {
+	var lastErr error
+	for i := uint64(0); i < maxSkippedSlots && i <= slot; i++ {
+		blockTime, err := est.client.GetBlockTime(ctx, slot-i)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			lastErr = err
+			continue
+		}
+		if blockTime == nil {
+			continue
+		}
+		return &Anchor{Slot: slot - i, Time: blockTime.Time()}, nil
+	}
+	if lastErr == nil {
+		lastErr = ErrNoSamples
+	}
+	return nil, lastErr
+}
+
+// SetPerformanceSamples sets the slot duration to the average
+// of the performance samples. Empty samples are ignored.
+func (est *Estimator) SetPerformanceSamples(samples []*rpc.GetRecentPerformanceSamplesResult) {
+	var slots, secs uint64
+	for _, sample := range samples {
+		if sample == nil || sample.NumSlots == 0 {
+			continue
+		}
+		slots += sample.NumSlots
+		secs += uint64(sample.SamplePeriodSecs)
+	}
+	if slots == 0 || secs == 0 {
+		return
+	}
+	est.mu.Lock()
+	est.slotDuration = time.Duration(secs) * time.Second / time.Duration(slots)
+	est.mu.Unlock()
+}
+
+// SlotDuration returns the slot duration used to extrapolate.
+func (est *Estimator) SlotDuration() time.Duration {
+	est.mu.RLock()
+	defer est.mu.RUnlock()
+	return est.slotDuration
+}
+
+// AddAnchor adds a slot with a known block time.
+// An anchor that is not in time order with its neighbours
+// (a later slot with an earlier time) replaces them.
+func (est *Estimator) AddAnchor(slot uint64, blockTime time.Time) {
+	est.mu.Lock()
+	defer est.mu.Unlock()
+
+	i := sort.Search(len(est.anchors), func(i int) bool { return est.anchors[i].Slot >= slot })
+	if i < len(est.anchors) && est.anchors[i].Slot == slot {
+		est.anchors[i].Time = blockTime
+	} else {
+		est.anchors = append(est.anchors, Anchor{})
+		copy(est.anchors[i+1:], est.anchors[i:])
+		est.anchors[i] = Anchor{Slot: slot, Time: blockTime}
+	}
+	// Keep the times monotonic, trusting the newest anchor.
+	lo := i
+	for lo > 0 && est.anchors[lo-1].Time.After(blockTime) {
+		lo--
+	}
+	hi := i + 1
+	for hi < len(est.anchors) && est.anchors[hi].Time.Before(blockTime) {
+		hi++
+	}
+	est.anchors = append(est.anchors[:lo], append([]Anchor{est.anchors[i]}, est.anchors[hi:]...)...)
+
+	if over := len(est.anchors) - est.opts.MaxAnchors; over > 0 {
+		est.anchors = append(est.anchors[:0], est.anchors[over:]...)
+	}
+}
+
+// Anchors returns a copy of the anchors, sorted by slot.
+func (est *Estimator) Anchors() []Anchor {
+	est.mu.RLock()
+	defer est.mu.RUnlock()
+	return append([]Anchor(nil), est.anchors...)
+}
+
+// EstimateTimeAt returns the estimated time of the slot.
+func (est *Estimator) EstimateTimeAt(slot uint64) (time.Time, error) {
+	est.mu.RLock()
+	defer est.mu.RUnlock()
+	if len(est.anchors) == 0 {
+		return time.Time{}, ErrNoSamples
+	}
+
+	i := sort.Search(len(est.anchors), func(i int) bool { return est.anchors[i].Slot >= slot })
+	switch {
+	case i < len(est.anchors) && est.anchors[i].Slot == slot:
+		return est.anchors[i].Time, nil
+	case i == 0:
+		first := est.anchors[0]
+		return first.Time.Add(-time.Duration(first.Slot-slot) * est.slotDuration), nil
+	case i == len(est.anchors):
+		last := est.anchors[i-1]
+		return last.Time.Add(time.Duration(slot-last.Slot) * est.slotDuration), nil
+	}
+	lo, hi := est.anchors[i-1], est.anchors[i]
+	elapsed := hi.Time.Sub(lo.Time)
+	return lo.Time.Add(time.Duration(float64(elapsed) * float64(slot-lo.Slot) / float64(hi.Slot-lo.Slot))), nil
+}
+
+// EstimateSlotAt returns the estimated slot at the time.
+func (est *Estimator) EstimateSlotAt(t time.Time) (uint64, error) {
+	est.mu.RLock()
+	defer est.mu.RUnlock()
+	if len(est.anchors) == 0 {
+		return 0, ErrNoSamples
+	}
+
+	// The first anchor at or after the time.
+	i := sort.Search(len(est.anchors), func(i int) bool { return !est.anchors[i].Time.Before(t) })
+	switch {
+	case i < len(est.anchors) && est.anchors[i].Time.Equal(t):
+		return est.anchors[i].Slot, nil
+	case i == 0:
+		first := est.anchors[0]
+		slots := uint64(first.Time.Sub(t) / est.slotDuration)
+		if slots > first.Slot {
+			return 0, nil
+		}
+		return first.Slot - slots, nil
+	case i == len(est.anchors):
+		last := est.anchors[i-1]
+		return last.Slot + uint64(t.Sub(last.Time)/est.slotDuration), nil
+	}
+	lo, hi := est.anchors[i-1], est.anchors[i]
+	elapsed := hi.Time.Sub(lo.Time)
+	return lo.Slot + uint64(float64(hi.Slot-lo.Slot)*float64(t.Sub(lo.Time))/float64(elapsed)), nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blocktime
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type blockTimeNode struct {
+	slot    uint64
+	skipped map[uint64]bool
+	// Block time of a slot.
+	timeAt func(slot uint64) int64
+}
+
+func (node *blockTimeNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	switch method {
+	case "getSlot":
+		return stdjson.Unmarshal([]byte(fmt.Sprint(node.slot)), out)
+	case "getRecentPerformanceSamples":
+		return stdjson.Unmarshal([]byte(`[
+			{"slot":1000,"numTransactions":1,"numSlots":100,"samplePeriodSecs":60},
+			{"slot":900,"numTransactions":1,"numSlots":200,"samplePeriodSecs":60}
+		]`), out)
+	case "getBlockTime":
+		slot := params[0].(uint64)
+		if node.skipped[slot] {
+			return fmt.Errorf("Slot %d was skipped, or missing due to ledger jump to recent snapshot", slot)
+		}
+		return stdjson.Unmarshal([]byte(fmt.Sprint(node.timeAt(slot))), out)
+	}
+	return fmt.Errorf("unexpected method %s", method)
+}
+
+func (node *blockTimeNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return fmt.Errorf("not implemented")
+}
+
+func TestEstimator_Refresh(t *testing.T) {
+	node := &blockTimeNode{
+		slot:    10_000,
+		skipped: map[uint64]bool{9_000: true, 8_999: true},
+		timeAt:  func(slot uint64) int64 { return 1_700_000_000 + int64(slot)/2 },
+	}
+	est := NewEstimator(rpc.NewWithCustomRPCClient(node), &Opts{Samples: 3, Spacing: 1000})
+	require.NoError(t, est.Refresh(context.Background()))
+
+	// 120 seconds over 300 slots.
+	require.Equal(t, 400*time.Millisecond, est.SlotDuration())
+	require.Equal(t, []Anchor{
+		{Slot: 8_000, Time: time.Unix(1_700_004_000, 0)},
+		{Slot: 8_998, Time: time.Unix(1_700_004_499, 0)},
+		{Slot: 10_000, Time: time.Unix(1_700_005_000, 0)},
+	}, est.Anchors())
+}
+
+func TestEstimator(t *testing.T) {
+	est := NewEstimator(nil, nil)
+	_, err := est.EstimateTimeAt(1)
+	require.Equal(t, ErrNoSamples, err)
+	_, err = est.EstimateSlotAt(time.Now())
+	require.Equal(t, ErrNoSamples, err)
+
+	base := time.Unix(1_700_000_000, 0)
+	est.AddAnchor(1_000, base)
+	// The second half runs slower than the nominal slot duration.
+	est.AddAnchor(2_000, base.Add(400*time.Second))
+	est.AddAnchor(3_000, base.Add(1000*time.Second))
+
+	cases := []struct {
+		slot uint64
+		time time.Time
+	}{
+		{1_000, base},
+		{1_500, base.Add(200 * time.Second)},
+		{2_500, base.Add(700 * time.Second)},
+		{3_000, base.Add(1000 * time.Second)},
+		// Extrapolated with the default slot duration.
+		{500, base.Add(-200 * time.Second)},
+		{4_000, base.Add(1400 * time.Second)},
+	}
+	for _, c := range cases {
+		got, err := est.EstimateTimeAt(c.slot)
+		require.NoError(t, err)
+		require.Equal(t, c.time, got, "slot %d", c.slot)
+
+		slot, err := est.EstimateSlotAt(c.time)
+		require.NoError(t, err)
+		require.Equal(t, c.slot, slot, "time %s", c.time)
+	}
+
+	// Before the genesis.
+	slot, err := est.EstimateSlotAt(base.Add(-24 * time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), slot)
+
+	// The performance samples change the extrapolation only.
+	est.SetPerformanceSamples([]*rpc.GetRecentPerformanceSamplesResult{{NumSlots: 120, SamplePeriodSecs: 60}})
+	got, err := est.EstimateTimeAt(4_000)
+	require.NoError(t, err)
+	require.Equal(t, base.Add(1500*time.Second), got)
+	got, err = est.EstimateTimeAt(2_500)
+	require.NoError(t, err)
+	require.Equal(t, base.Add(700*time.Second), got)
+}
+
+func TestEstimator_AddAnchor(t *testing.T) {
+	base := time.Unix(1_700_000_000, 0)
+	est := NewEstimator(nil, &Opts{MaxAnchors: 3})
+	est.AddAnchor(300, base.Add(300*time.Second))
+	est.AddAnchor(100, base.Add(100*time.Second))
+	est.AddAnchor(200, base.Add(200*time.Second))
+	est.AddAnchor(100, base.Add(50*time.Second))
+	require.Equal(t, []Anchor{
+		{Slot: 100, Time: base.Add(50 * time.Second)},
+		{Slot: 200, Time: base.Add(200 * time.Second)},
+		{Slot: 300, Time: base.Add(300 * time.Second)},
+	}, est.Anchors())
+
+	// Out of order with the neighbours, which are dropped.
+	est.AddAnchor(250, base.Add(400*time.Second))
+	require.Equal(t, []Anchor{
+		{Slot: 100, Time: base.Add(50 * time.Second)},
+		{Slot: 200, Time: base.Add(200 * time.Second)},
+		{Slot: 250, Time: base.Add(400 * time.Second)},
+	}, est.Anchors())
+
+	// The oldest are dropped beyond MaxAnchors.
+	est.AddAnchor(500, base.Add(500*time.Second))
+	require.Equal(t, []Anchor{
+		{Slot: 200, Time: base.Add(200 * time.Second)},
+		{Slot: 250, Time: base.Add(400 * time.Second)},
+		{Slot: 500, Time: base.Add(500 * time.Second)},
+	}, est.Anchors())
+}
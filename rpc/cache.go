@@ -0,0 +1,245 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"container/list"
+	"context"
+	stdjson "encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// CacheStore stores the cached responses of a CachingClient.
+// It must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the value of the key, unless missing or expired.
+	Get(key string) ([]byte, bool)
+	// Set sets the value of the key, expiring after the ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// DefaultCacheTTLs returns the default TTLs of the responses of the read-only
+// methods cached by a CachingClient: a few seconds for the state of the
+// accounts, longer for the values changing at most once per epoch.
+func DefaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		"getAccountInfo":                    2 * time.Second,
+		"getMultipleAccounts":               2 * time.Second,
+		"getBalance":                        2 * time.Second,
+		"getTokenAccountBalance":            2 * time.Second,
+		"getTokenSupply":                    2 * time.Second,
+		"getEpochInfo":                      time.Second,
+		"getEpochSchedule":                  time.Hour,
+		"getGenesisHash":                    time.Hour,
+		"getMinimumBalanceForRentExemption": time.Hour,
+		"getInflationGovernor":              time.Minute,
+		"getVersion":                        time.Minute,
+	}
+}
+
+// CacheOpts configures a CachingClient.
+type CacheOpts struct {
+	// Store of the responses; defaults to an in-memory store
+	// of 10000 responses (see NewMemoryCacheStore).
+	Store CacheStore
+
+	// TTLs of the responses of the cached methods; the other methods are not
+	// cached. Defaults to DefaultCacheTTLs.
+	TTLs map[string]time.Duration
+}
+
+// CachingClient is a read-through cache of the responses of the read-only
+// methods of a client. The responses are cached by method and parameters,
+// including the commitment and the other options of the requests: a request
+// at a different commitment is a different entry. The requests with
+// different headers (see WithHeaders, e.g. per-request credentials) are
+// different entries too. The null results (e.g. of a transaction not found
+// yet, or of an account not found: a null value in an RPC context response)
+// and the errors are not cached. The requests made with CallWithCallback and
+// in batches are not cached.
+type CachingClient struct {
+	rpcClient JSONRPCClient
+	store     CacheStore
+	ttls      map[string]time.Duration
+}
+
+// NewWithCache creates a new Solana JSON RPC client
+// caching the responses of the read-only methods (see CachingClient).
+func NewWithCache(rpcEndpoint string, opts *CacheOpts) *Client {
+	rpcClient := jsonrpc.NewClientWithOpts(rpcEndpoint, &jsonrpc.RPCClientOpts{
+		HTTPClient: newHTTP(),
+	})
+	cl := NewWithCustomRPCClient(NewCachingClient(rpcClient, opts))
+	cl.rpcURL = rpcEndpoint
+	return cl
+}
+
+// NewCachingClient wraps the client with a read-through cache.
+func NewCachingClient(rpcClient JSONRPCClient, opts *CacheOpts) *CachingClient {
+	c := &CachingClient{rpcClient: rpcClient}
+	if opts != nil {
+		c.store = opts.Store
+		c.ttls = opts.TTLs
+	}
+	if c.store == nil {
+		c.store = NewMemoryCacheStore(10000)
+	}
+	if c.ttls == nil {
+		c.ttls = DefaultCacheTTLs()
+	}
+	return c
+}
+
+// CacheKey returns the key of the response of the request in the store.
+func CacheKey(method string, params []interface{}) (string, error) {
+	encoded, err := stdjson.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return method + ":" + string(encoded), nil
+}
+
+func (c *CachingClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	ttl, ok := c.ttls[method]
+	if !ok || ttl <= 0 {
+		return c.rpcClient.CallForInto(ctx, out, method, params)
+	}
+	key, err := CacheKey(method, params)
+	if err != nil {
+		return c.rpcClient.CallForInto(ctx, out, method, params)
+	}
+	key += contextHeadersKey(ctx)
+	if cached, ok := c.store.Get(key); ok {
+		return json.Unmarshal(cached, out)
+	}
+
+	var result stdjson.RawMessage
+	if err := c.rpcClient.CallForInto(ctx, &result, method, params); err != nil {
+		return err
+	}
+	if len(result) == 0 {
+		result = stdjson.RawMessage("null")
+	}
+	if !isNullResult(result) {
+		c.store.Set(key, result, ttl)
+	}
+	return json.Unmarshal(result, out)
+}
+
+// isNullResult returns true if the result is null,
+// or an RPC context response with a null value.
+func isNullResult(result stdjson.RawMessage) bool {
+	if string(result) == "null" {
+		return true
+	}
+	var withContext struct {
+		Context stdjson.RawMessage `json:"context"`
+		Value   stdjson.RawMessage `json:"value"`
+	}
+	if len(result) == 0 || result[0] != '{' || stdjson.Unmarshal(result, &withContext) != nil {
+		return false
+	}
+	return withContext.Context != nil && (withContext.Value == nil || string(withContext.Value) == "null")
+}
+
+func (c *CachingClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	return c.rpcClient.CallWithCallback(ctx, method, params, callback)
+}
+
+func (c *CachingClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	batchClient, ok := c.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return nil, ErrBatchNotSupported
+	}
+	return batchClient.CallBatch(ctx, requests)
+}
+
+func (c *CachingClient) Close() error {
+	if closer, ok := c.rpcClient.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// NewMemoryCacheStore returns an in-memory store of up to maxEntries
+// values, evicting the least recently used ones.
+func NewMemoryCacheStore(maxEntries int) CacheStore {
+	return &memoryCacheStore{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+		now:        time.Now,
+	}
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+type memoryCacheStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+	now        func() time.Time
+}
+
+func (s *memoryCacheStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*memoryCacheEntry)
+	if !s.now().Before(entry.expires) {
+		s.lru.Remove(elem)
+		delete(s.entries, key)
+		return nil, false
+	}
+	s.lru.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (s *memoryCacheStore) Set(key string, value []byte, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expires := s.now().Add(ttl)
+	if elem, ok := s.entries[key]; ok {
+		entry := elem.Value.(*memoryCacheEntry)
+		entry.value = value
+		entry.expires = expires
+		s.lru.MoveToFront(elem)
+		return
+	}
+	s.entries[key] = s.lru.PushFront(&memoryCacheEntry{key: key, value: value, expires: expires})
+	for s.maxEntries > 0 && s.lru.Len() > s.maxEntries {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		requests = append(requests, string(body))
+		switch {
+		case strings.Contains(string(body), "getBalance"):
+			rw.Write([]byte(`{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":42},"id":0}`))
+		case strings.Contains(string(body), "getAccountInfo"):
+			rw.Write([]byte(`{"jsonrpc":"2.0","result":{"context":{"slot":1},"value":null},"id":0}`))
+		case strings.Contains(string(body), "getTransaction"):
+			rw.Write([]byte(`{"jsonrpc":"2.0","result":null,"id":0}`))
+		case strings.Contains(string(body), "getVersion"):
+			rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32603,"message":"Internal error"},"id":0}`))
+		default:
+			rw.Write([]byte(`{"jsonrpc":"2.0","result":7,"id":0}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewWithCache(server.URL, nil)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		out, err := client.GetBalance(ctx, solana.SystemProgramID, CommitmentFinalized)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), out.Value)
+	}
+	require.Len(t, requests, 1)
+
+	// Another commitment is another entry.
+	_, err := client.GetBalance(ctx, solana.SystemProgramID, CommitmentConfirmed)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+
+	// Other headers are another entry.
+	for i := 0; i < 2; i++ {
+		_, err = client.GetBalance(WithHeaders(ctx, BearerAuthHeader("token")), solana.SystemProgramID, CommitmentFinalized)
+		require.NoError(t, err)
+	}
+	require.Len(t, requests, 3)
+
+	// Not cached: methods without a TTL, null results and errors.
+	for i := 0; i < 2; i++ {
+		_, err = client.GetSlot(ctx, "")
+		require.NoError(t, err)
+		_, err = client.GetTransaction(ctx, solana.Signature{}, nil)
+		require.Equal(t, ErrNotFound, err)
+		_, err = client.GetVersion(ctx)
+		require.Error(t, err)
+		_, err = client.GetAccountInfo(ctx, solana.SystemProgramID)
+		require.Equal(t, ErrNotFound, err)
+	}
+	require.Len(t, requests, 11)
+}
+
+func TestCachingClient_TTLs(t *testing.T) {
+	var count int
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		count++
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":7,"id":0}`))
+	}))
+	defer server.Close()
+
+	store := NewMemoryCacheStore(0).(*memoryCacheStore)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	client := NewWithCache(server.URL, &CacheOpts{
+		Store: store,
+		TTLs:  map[string]time.Duration{"getSlot": time.Second},
+	})
+
+	for i := 0; i < 2; i++ {
+		slot, err := client.GetSlot(context.Background(), "")
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), slot)
+	}
+	require.Equal(t, 1, count)
+
+	now = now.Add(time.Second)
+	_, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	store := NewMemoryCacheStore(2)
+	store.Set("a", []byte("1"), time.Minute)
+	store.Set("b", []byte("2"), time.Minute)
+	_, ok := store.Get("a")
+	require.True(t, ok)
+	// Evicts b, the least recently used.
+	store.Set("c", []byte("3"), time.Minute)
+	_, ok = store.Get("b")
+	require.False(t, ok)
+	value, ok := store.Get("a")
+	require.True(t, ok)
+	require.Equal(t, []byte("1"), value)
+	_, ok = store.Get("c")
+	require.True(t, ok)
+}
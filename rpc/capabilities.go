@@ -0,0 +1,144 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// JSON-RPC error code returned by nodes that don't know a method.
+const jsonrpcMethodNotFound = -32601
+
+// legacyMethods maps the methods introduced in solana-core v1.7
+// to their deprecated v1.6 equivalents, which accept the same params
+// and return results of the same shape.
+var legacyMethods = map[string]string{
+	"getSignaturesForAddress": "getConfirmedSignaturesForAddress2",
+	"getTransaction":          "getConfirmedTransaction",
+}
+
+// capabilities is what the client has learned about the methods supported by the node.
+type capabilities struct {
+	mu          sync.RWMutex
+	unsupported map[string]bool
+}
+
+func (c *capabilities) isUnsupported(method string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.unsupported[method]
+}
+
+func (c *capabilities) setUnsupported(method string, unsupported bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.unsupported == nil {
+		c.unsupported = make(map[string]bool)
+	}
+	c.unsupported[method] = unsupported
+}
+
+// SupportsMethod returns false if the client has learned
+// (via DetectCapabilities, or a previous call) that the node
+// does not support the provided method.
+func (cl *Client) SupportsMethod(method string) bool {
+	return !cl.caps.isUnsupported(method)
+}
+
+// DetectCapabilities asks the node for its version, and records
+// whether it supports the methods introduced in solana-core v1.7
+// (e.g. getSignaturesForAddress and getTransaction).
+// Calling it is optional: without it, the client will detect the missing
+// methods from the first "Method not found" error, and fall back to
+// the deprecated methods from then on.
+func (cl *Client) DetectCapabilities(ctx context.Context) error {
+	version, err := cl.GetVersion(ctx)
+	if err != nil {
+		return err
+	}
+	major, minor, err := parseMajorMinor(version.SolanaCore)
+	if err != nil {
+		return err
+	}
+	legacy := major < 1 || (major == 1 && minor < 7)
+	for method := range legacyMethods {
+		cl.caps.setUnsupported(method, legacy)
+	}
+	return nil
+}
+
+func parseMajorMinor(version string) (major int, minor int, err error) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, fmt.Errorf("invalid solana-core version: %q", version)
+	}
+	if major, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, fmt.Errorf("invalid solana-core version: %q", version)
+	}
+	if minor, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, fmt.Errorf("invalid solana-core version: %q", version)
+	}
+	return major, minor, nil
+}
+
+func isMethodNotFound(err error) bool {
+	var rpcErr *jsonrpc.RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Code == jsonrpcMethodNotFound
+}
+
+// callWithLegacyFallback calls the provided method, falling back to its
+// deprecated equivalent if the node does not support it.
+func (cl *Client) callWithLegacyFallback(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	legacy, hasLegacy := legacyMethods[method]
+	if !hasLegacy {
+		return cl.rpcClient.CallForInto(ctx, out, method, params)
+	}
+	if cl.caps.isUnsupported(method) {
+		return cl.rpcClient.CallForInto(ctx, out, legacy, legacyParams(params))
+	}
+	err := cl.rpcClient.CallForInto(ctx, out, method, params)
+	if err != nil && isMethodNotFound(err) {
+		cl.caps.setUnsupported(method, true)
+		return cl.rpcClient.CallForInto(ctx, out, legacy, legacyParams(params))
+	}
+	return err
+}
+
+// legacyParams removes from the config object the fields
+// that are unknown to solana-core v1.6 nodes.
+func legacyParams(params []interface{}) []interface{} {
+	out := make([]interface{}, len(params))
+	for i, param := range params {
+		if obj, ok := param.(M); ok {
+			if _, has := obj["maxSupportedTransactionVersion"]; has {
+				cp := make(M, len(obj))
+				for k, v := range obj {
+					cp[k] = v
+				}
+				delete(cp, "maxSupportedTransactionVersion")
+				param = cp
+			}
+		}
+		out[i] = param
+	}
+	return out
+}
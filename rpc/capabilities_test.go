@@ -0,0 +1,91 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyNode is a JSONRPCClient that behaves like a solana-core v1.6 node.
+type legacyNode struct {
+	calls []string
+}
+
+func (node *legacyNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	node.calls = append(node.calls, method)
+	switch method {
+	case "getVersion":
+		return stdjson.Unmarshal([]byte(`{"solana-core": "1.6.28", "feature-set": 1}`), out)
+	case "getConfirmedSignaturesForAddress2":
+		return stdjson.Unmarshal([]byte(`[{"signature": "5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv", "slot": 114}]`), out)
+	default:
+		return &jsonrpc.RPCError{Code: jsonrpcMethodNotFound, Message: "Method not found"}
+	}
+}
+
+func (node *legacyNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestLegacyFallback(t *testing.T) {
+	node := &legacyNode{}
+	client := NewWithCustomRPCClient(node)
+	ctx := context.Background()
+	account := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+
+	require.True(t, client.SupportsMethod("getSignaturesForAddress"))
+	out, err := client.GetSignaturesForAddress(ctx, account)
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	require.Equal(t, uint64(114), out[0].Slot)
+	require.Equal(t, []string{"getSignaturesForAddress", "getConfirmedSignaturesForAddress2"}, node.calls)
+	require.False(t, client.SupportsMethod("getSignaturesForAddress"))
+
+	// The modern method is not tried again.
+	node.calls = nil
+	_, err = client.GetSignaturesForAddress(ctx, account)
+	require.NoError(t, err)
+	require.Equal(t, []string{"getConfirmedSignaturesForAddress2"}, node.calls)
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	node := &legacyNode{}
+	client := NewWithCustomRPCClient(node)
+	require.NoError(t, client.DetectCapabilities(context.Background()))
+	require.False(t, client.SupportsMethod("getTransaction"))
+	require.False(t, client.SupportsMethod("getSignaturesForAddress"))
+	require.True(t, client.SupportsMethod("getBlock"))
+
+	_, err := client.GetTransaction(context.Background(), solana.Signature{}, nil)
+	require.Error(t, err)
+	require.Equal(t, []string{"getVersion", "getConfirmedTransaction"}, node.calls)
+}
+
+func TestParseMajorMinor(t *testing.T) {
+	major, minor, err := parseMajorMinor("1.18.26")
+	require.NoError(t, err)
+	require.Equal(t, 1, major)
+	require.Equal(t, 18, minor)
+
+	_, _, err = parseMajorMinor("unknown")
+	require.Error(t, err)
+}
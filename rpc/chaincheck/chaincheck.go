@@ -0,0 +1,233 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaincheck validates the continuity of a stream of blocks,
+// e.g. of an ingestion pipeline: each block must link to the previous one
+// by its parent slot and blockhash, with a block height one above, and no
+// block may be missing. The slots between a block and its parent are
+// genuinely skipped (no block was produced), and are not gaps.
+//
+//	checker := chaincheck.New(func(report *chaincheck.Report) {
+//		log.Printf("chain check: %s", report)
+//	})
+//	for block := range blocks {
+//		checker.Check(block.Header(slot))
+//	}
+package chaincheck
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// Kind is the kind of a Report.
+type Kind int
+
+const (
+	// Slots skipped by the cluster, between a block and its parent;
+	// informational, the chain is continuous.
+	KindSkippedSlots Kind = iota
+	// Blocks missing from the stream, between the previous block
+	// and the parent of the block.
+	KindMissingBlocks
+	// The block does not build on the previous block: its parent slot
+	// is before the slot of the previous block (e.g. a fork).
+	KindFork
+	// The previous blockhash of the block is not the blockhash of its parent.
+	KindParentHashMismatch
+	// The block height of the block does not follow the one of the previous block.
+	KindBlockHeight
+	// The slot of the block is not after the slot of the previous block
+	// (duplicate or out of order); the block is otherwise ignored.
+	KindOutOfOrder
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindSkippedSlots:
+		return "skipped_slots"
+	case KindMissingBlocks:
+		return "missing_blocks"
+	case KindFork:
+		return "fork"
+	case KindParentHashMismatch:
+		return "parent_hash_mismatch"
+	case KindBlockHeight:
+		return "block_height"
+	case KindOutOfOrder:
+		return "out_of_order"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Report is a discontinuity (or skipped slots) between a block and the previous one.
+type Report struct {
+	Kind Kind
+
+	// The checked block, and the previous block of the stream.
+	Block    *rpc.BlockHeader
+	Previous *rpc.BlockHeader
+
+	// The range of slots (inclusive) skipped by the cluster (KindSkippedSlots),
+	// or possibly holding the missing blocks (KindMissingBlocks).
+	FromSlot uint64
+	ToSlot   uint64
+
+	// The number of missing blocks (KindMissingBlocks), from the block heights;
+	// zero if unknown.
+	MissingBlocks uint64
+}
+
+// IsGap tells whether the report is a discontinuity of the chain,
+// i.e. not just skipped slots.
+func (r *Report) IsGap() bool {
+	return r.Kind != KindSkippedSlots
+}
+
+func (r *Report) String() string {
+	switch r.Kind {
+	case KindSkippedSlots:
+		return fmt.Sprintf("%s: slots %d to %d before block %d", r.Kind, r.FromSlot, r.ToSlot, r.Block.Slot)
+	case KindMissingBlocks:
+		if r.MissingBlocks > 0 {
+			return fmt.Sprintf("%s: %d blocks in slots %d to %d before block %d", r.Kind, r.MissingBlocks, r.FromSlot, r.ToSlot, r.Block.Slot)
+		}
+		return fmt.Sprintf("%s: slots %d to %d before block %d", r.Kind, r.FromSlot, r.ToSlot, r.Block.Slot)
+	case KindFork:
+		return fmt.Sprintf("%s: block %d has parent %d, previous block is %d", r.Kind, r.Block.Slot, r.Block.ParentSlot, r.Previous.Slot)
+	case KindParentHashMismatch:
+		return fmt.Sprintf("%s: block %d has previous blockhash %s, block %d has blockhash %s",
+			r.Kind, r.Block.Slot, r.Block.PreviousBlockhash, r.Previous.Slot, r.Previous.Blockhash)
+	case KindBlockHeight:
+		return fmt.Sprintf("%s: block %d has height %d, block %d has height %d",
+			r.Kind, r.Block.Slot, *r.Block.BlockHeight, r.Previous.Slot, *r.Previous.BlockHeight)
+	default:
+		return fmt.Sprintf("%s: block %d after block %d", r.Kind, r.Block.Slot, r.Previous.Slot)
+	}
+}
+
+// Stats are the counters of a Checker.
+type Stats struct {
+	Blocks        uint64
+	SkippedSlots  uint64
+	MissingBlocks uint64
+	Gaps          uint64
+}
+
+// Checker checks that each block continues the chain of the previous one.
+// It is safe for concurrent use, but the blocks must be checked in order.
+type Checker struct {
+	onReport func(*Report)
+
+	mu    sync.Mutex
+	last  *rpc.BlockHeader
+	stats Stats
+}
+
+// New creates a checker calling onReport (if not nil) with each report.
+func New(onReport func(*Report)) *Checker {
+	return &Checker{onReport: onReport}
+}
+
+// Check checks that the block continues the chain of the previous block,
+// and returns the reports, if any.
+func (c *Checker) Check(block *rpc.BlockHeader) []*Report {
+	c.mu.Lock()
+	reports := c.checkLocked(block)
+	c.mu.Unlock()
+
+	if c.onReport != nil {
+		for _, report := range reports {
+			c.onReport(report)
+		}
+	}
+	return reports
+}
+
+func (c *Checker) checkLocked(block *rpc.BlockHeader) []*Report {
+	prev := c.last
+	if prev == nil {
+		c.last = block
+		c.stats.Blocks++
+		return nil
+	}
+	var reports []*Report
+	report := func(r *Report) {
+		r.Block = block
+		r.Previous = prev
+		if r.IsGap() {
+			c.stats.Gaps++
+		}
+		reports = append(reports, r)
+	}
+
+	if block.Slot <= prev.Slot {
+		report(&Report{Kind: KindOutOfOrder})
+		return reports
+	}
+	c.last = block
+	c.stats.Blocks++
+
+	heightsKnown := block.BlockHeight != nil && prev.BlockHeight != nil
+	switch {
+	case block.ParentSlot == prev.Slot:
+		if block.Slot > block.ParentSlot+1 {
+			c.stats.SkippedSlots += block.Slot - block.ParentSlot - 1
+			report(&Report{Kind: KindSkippedSlots, FromSlot: block.ParentSlot + 1, ToSlot: block.Slot - 1})
+		}
+		if !block.PreviousBlockhash.Equals(prev.Blockhash) {
+			report(&Report{Kind: KindParentHashMismatch})
+		}
+		if heightsKnown && *block.BlockHeight != *prev.BlockHeight+1 {
+			report(&Report{Kind: KindBlockHeight})
+		}
+	case block.ParentSlot > prev.Slot:
+		missing := &Report{Kind: KindMissingBlocks, FromSlot: prev.Slot + 1, ToSlot: block.ParentSlot}
+		if heightsKnown && *block.BlockHeight > *prev.BlockHeight+1 {
+			missing.MissingBlocks = *block.BlockHeight - *prev.BlockHeight - 1
+			c.stats.MissingBlocks += missing.MissingBlocks
+		}
+		report(missing)
+		if heightsKnown && *block.BlockHeight <= *prev.BlockHeight+1 {
+			report(&Report{Kind: KindBlockHeight})
+		}
+	default:
+		report(&Report{Kind: KindFork})
+	}
+	return reports
+}
+
+// Last returns the last block of the chain, nil if none was checked.
+func (c *Checker) Last() *rpc.BlockHeader {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.last
+}
+
+// Reset forgets the previous block, e.g. to resume the stream at another slot.
+func (c *Checker) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.last = nil
+}
+
+// Stats returns the counters of the checker.
+func (c *Checker) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
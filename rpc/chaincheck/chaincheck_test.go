@@ -0,0 +1,77 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaincheck
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func header(slot, parent uint64, prevHash byte, height uint64) *rpc.BlockHeader {
+	return &rpc.BlockHeader{
+		Slot:              slot,
+		ParentSlot:        parent,
+		Blockhash:         solana.Hash{byte(slot)},
+		PreviousBlockhash: solana.Hash{prevHash},
+		BlockHeight:       &height,
+	}
+}
+
+func kinds(reports []*Report) []Kind {
+	var out []Kind
+	for _, report := range reports {
+		out = append(out, report.Kind)
+	}
+	return out
+}
+
+func TestChecker(t *testing.T) {
+	var received []*Report
+	checker := New(func(report *Report) { received = append(received, report) })
+
+	require.Empty(t, checker.Check(header(10, 9, 9, 100)))
+	require.Empty(t, checker.Check(header(11, 10, 10, 101)))
+
+	// Slots 12 and 13 were skipped by the cluster.
+	reports := checker.Check(header(14, 11, 11, 102))
+	require.Equal(t, []Kind{KindSkippedSlots}, kinds(reports))
+	require.False(t, reports[0].IsGap())
+	require.Equal(t, uint64(12), reports[0].FromSlot)
+	require.Equal(t, uint64(13), reports[0].ToSlot)
+
+	// Two blocks, somewhere in slots 15 to 18, are missing.
+	reports = checker.Check(header(19, 18, 18, 105))
+	require.Equal(t, []Kind{KindMissingBlocks}, kinds(reports))
+	require.True(t, reports[0].IsGap())
+	require.Equal(t, uint64(15), reports[0].FromSlot)
+	require.Equal(t, uint64(18), reports[0].ToSlot)
+	require.Equal(t, uint64(2), reports[0].MissingBlocks)
+	require.Equal(t, "missing_blocks: 2 blocks in slots 15 to 18 before block 19", reports[0].String())
+
+	require.Equal(t, []Kind{KindParentHashMismatch, KindBlockHeight}, kinds(checker.Check(header(20, 19, 99, 107))))
+	require.Equal(t, []Kind{KindOutOfOrder}, kinds(checker.Check(header(20, 19, 19, 106))))
+	require.Equal(t, []Kind{KindFork}, kinds(checker.Check(header(21, 17, 17, 106))))
+	require.Equal(t, uint64(21), checker.Last().Slot)
+
+	require.Len(t, received, 6)
+	require.Equal(t, Stats{Blocks: 6, SkippedSlots: 2, MissingBlocks: 2, Gaps: 5}, checker.Stats())
+
+	checker.Reset()
+	require.Nil(t, checker.Last())
+	require.Empty(t, checker.Check(header(5, 4, 4, 50)))
+}
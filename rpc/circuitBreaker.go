@@ -0,0 +1,332 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// ErrCircuitOpen is returned by a CircuitBreaker without fallback
+// while its endpoint is considered unhealthy.
+var ErrCircuitOpen = errors.New("rpc: circuit breaker is open")
+
+type CircuitState int
+
+const (
+	// The calls are sent to the endpoint.
+	CircuitClosed CircuitState = iota
+	// The calls are sent to the fallback, or fail with ErrCircuitOpen.
+	CircuitOpen
+	// A trial call is sent to the endpoint, to decide whether to close the circuit.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+type CircuitBreakerOpts struct {
+	// Number of consecutive failures opening the circuit.
+	// Defaults to 5.
+	MaxFailures int
+	// Duration the circuit stays open before a trial call is let through.
+	// Defaults to 30 seconds.
+	OpenTimeout time.Duration
+
+	// The circuit is opened while the slot of the endpoint lags
+	// the slot of Reference by more than MaxSlotLag slots.
+	// Zero disables the lag checks.
+	MaxSlotLag uint64
+	Reference  JSONRPCClient
+	// Interval of the lag checks.
+	// Defaults to 10 seconds.
+	LagCheckInterval time.Duration
+
+	// Client receiving the calls while the circuit is open;
+	// nil to fail fast with ErrCircuitOpen.
+	Fallback JSONRPCClient
+
+	// Called on every change of state.
+	OnStateChange func(from, to CircuitState)
+}
+
+// CircuitBreaker is a JSONRPCClient that stops sending calls to its endpoint
+// after MaxFailures consecutive failures (transport error, HTTP error,
+// timeout, or node unhealthy error), or while its slot lags the cluster.
+// It sends them to the fallback instead, or fails fast.
+type CircuitBreaker struct {
+	client JSONRPCClient
+	opts   CircuitBreakerOpts
+	now    func() time.Time
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	lagging  bool
+	trial    bool
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewWithCircuitBreaker creates a new Solana JSON RPC client
+// with a circuit breaker on the endpoint (see CircuitBreaker).
+func NewWithCircuitBreaker(rpcEndpoint string, opts *CircuitBreakerOpts) *Client {
+	rpcClient := jsonrpc.NewClientWithOpts(rpcEndpoint, &jsonrpc.RPCClientOpts{
+		HTTPClient: newHTTP(),
+	})
+	cl := NewWithCustomRPCClient(NewCircuitBreaker(rpcClient, opts))
+	cl.rpcURL = rpcEndpoint
+	return cl
+}
+
+// NewCircuitBreaker wraps the client with a circuit breaker.
+// If the lag checks are enabled, they run in the background until Close.
+func NewCircuitBreaker(client JSONRPCClient, opts *CircuitBreakerOpts) *CircuitBreaker {
+	cb := &CircuitBreaker{
+		client: client,
+		now:    time.Now,
+		stop:   make(chan struct{}),
+	}
+	if opts != nil {
+		cb.opts = *opts
+	}
+	if cb.opts.MaxFailures <= 0 {
+		cb.opts.MaxFailures = 5
+	}
+	if cb.opts.OpenTimeout <= 0 {
+		cb.opts.OpenTimeout = 30 * time.Second
+	}
+	if cb.opts.LagCheckInterval <= 0 {
+		cb.opts.LagCheckInterval = 10 * time.Second
+	}
+	if cb.opts.MaxSlotLag > 0 && cb.opts.Reference != nil {
+		cb.wg.Add(1)
+		go cb.checkLagLoop()
+	}
+	return cb
+}
+
+// State returns the current state of the circuit.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == CircuitOpen && !cb.lagging && !cb.now().Before(cb.openedAt.Add(cb.opts.OpenTimeout)) {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
+func (cb *CircuitBreaker) setState(state CircuitState) {
+	if cb.state == state {
+		return
+	}
+	from := cb.state
+	cb.state = state
+	if state != CircuitHalfOpen {
+		cb.trial = false
+	}
+	if state == CircuitOpen {
+		cb.openedAt = cb.now()
+	}
+	if cb.opts.OnStateChange != nil {
+		cb.opts.OnStateChange(from, state)
+	}
+}
+
+// acquire returns true if the call can be sent to the endpoint.
+func (cb *CircuitBreaker) acquire() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case CircuitClosed:
+		return true
+	case CircuitOpen:
+		if cb.lagging || cb.now().Before(cb.openedAt.Add(cb.opts.OpenTimeout)) {
+			return false
+		}
+		cb.setState(CircuitHalfOpen)
+	}
+	// Half-open: only one trial call at a time.
+	if cb.trial {
+		return false
+	}
+	cb.trial = true
+	return true
+}
+
+func (cb *CircuitBreaker) record(err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	failed := err != nil && isFailoverError(err)
+	if cb.state == CircuitHalfOpen {
+		cb.trial = false
+		if failed {
+			cb.setState(CircuitOpen)
+		} else {
+			cb.failures = 0
+			cb.setState(CircuitClosed)
+		}
+		return
+	}
+	if !failed {
+		cb.failures = 0
+		return
+	}
+	cb.failures++
+	if cb.failures >= cb.opts.MaxFailures && cb.state == CircuitClosed {
+		cb.setState(CircuitOpen)
+	}
+}
+
+// release ends a call whose outcome says nothing about the endpoint.
+func (cb *CircuitBreaker) release() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == CircuitHalfOpen {
+		cb.trial = false
+	}
+}
+
+// do sends the call to the endpoint if the circuit allows it,
+// else to the fallback. The calls canceled by the caller
+// (or past its deadline) are not recorded.
+func (cb *CircuitBreaker) do(ctx context.Context, fn func(client JSONRPCClient) error) error {
+	if !cb.acquire() {
+		if cb.opts.Fallback == nil {
+			return ErrCircuitOpen
+		}
+		return fn(cb.opts.Fallback)
+	}
+	err := fn(cb.client)
+	// Neither a canceled call nor a batch the client can't send is a failure of the endpoint.
+	if err != nil && (ctx.Err() != nil || errors.Is(err, ErrBatchNotSupported)) {
+		cb.release()
+		return err
+	}
+	cb.record(err)
+	return err
+}
+
+func (cb *CircuitBreaker) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	return cb.do(ctx, func(client JSONRPCClient) error {
+		return client.CallForInto(ctx, out, method, params)
+	})
+}
+
+func (cb *CircuitBreaker) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	return unwrapCallbackError(cb.do(ctx, func(client JSONRPCClient) error {
+		return callWithEndpointErrors(ctx, client, method, params, callback)
+	}))
+}
+
+func (cb *CircuitBreaker) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (out jsonrpc.RPCResponses, err error) {
+	err = cb.do(ctx, func(client JSONRPCClient) error {
+		batchClient, ok := client.(BatchJSONRPCClient)
+		if !ok {
+			return fmt.Errorf("%w: %T", ErrBatchNotSupported, client)
+		}
+		out, err = batchClient.CallBatch(ctx, requests)
+		return err
+	})
+	return out, err
+}
+
+// CheckLag compares the slot of the endpoint with the slot of the reference:
+// the circuit is opened while the endpoint lags by more than MaxSlotLag slots,
+// and closed as soon as it caught up.
+// It is called periodically if MaxSlotLag and Reference are set.
+func (cb *CircuitBreaker) CheckLag(ctx context.Context) error {
+	if cb.opts.Reference == nil {
+		return errors.New("no reference client")
+	}
+	var reference, slot uint64
+	if err := cb.opts.Reference.CallForInto(ctx, &reference, "getSlot", nil); err != nil {
+		return fmt.Errorf("unable to get the reference slot: %w", err)
+	}
+	err := cb.client.CallForInto(ctx, &slot, "getSlot", nil)
+	if err != nil {
+		cb.record(err)
+		return err
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	lagging := reference > slot && reference-slot > cb.opts.MaxSlotLag
+	switch {
+	case lagging:
+		cb.setState(CircuitOpen)
+	case cb.lagging:
+		cb.failures = 0
+		cb.setState(CircuitClosed)
+	}
+	cb.lagging = lagging
+	return nil
+}
+
+func (cb *CircuitBreaker) checkLagLoop() {
+	defer cb.wg.Done()
+	ticker := time.NewTicker(cb.opts.LagCheckInterval)
+	defer ticker.Stop()
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), cb.opts.LagCheckInterval)
+		cb.CheckLag(ctx)
+		cancel()
+		select {
+		case <-cb.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close stops the lag checks, and closes the client and the fallback.
+func (cb *CircuitBreaker) Close() error {
+	cb.stopOnce.Do(func() { close(cb.stop) })
+	cb.wg.Wait()
+	var err error
+	for _, client := range []JSONRPCClient{cb.client, cb.opts.Fallback} {
+		if c, ok := client.(io.Closer); ok {
+			if closeErr := c.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// circuitTestRPC returns its slot, or err if set.
+type circuitTestRPC struct {
+	slot  uint64
+	err   error
+	calls int
+}
+
+func (c *circuitTestRPC) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	c.calls++
+	if c.err != nil {
+		return c.err
+	}
+	return stdjson.Unmarshal([]byte(fmt.Sprint(c.slot)), out)
+}
+
+func (c *circuitTestRPC) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return errors.New("not implemented")
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	endpoint := &circuitTestRPC{slot: 1, err: jsonrpc.NewHTTPError(http.StatusBadGateway, errors.New("bad gateway"))}
+	now := time.Unix(0, 0)
+	var changes []string
+	cb := NewCircuitBreaker(endpoint, &CircuitBreakerOpts{
+		MaxFailures: 2,
+		OpenTimeout: time.Minute,
+		OnStateChange: func(from, to CircuitState) {
+			changes = append(changes, from.String()+">"+to.String())
+		},
+	})
+	cb.now = func() time.Time { return now }
+	client := NewWithCustomRPCClient(cb)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetSlot(context.Background(), "")
+		require.Error(t, err)
+	}
+	require.Equal(t, CircuitOpen, cb.State())
+
+	// Fails fast while open.
+	_, err := client.GetSlot(context.Background(), "")
+	require.Equal(t, ErrCircuitOpen, err)
+	require.Equal(t, 2, endpoint.calls)
+
+	// A failed trial opens the circuit again.
+	now = now.Add(time.Minute)
+	require.Equal(t, CircuitHalfOpen, cb.State())
+	_, err = client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Equal(t, 3, endpoint.calls)
+	_, err = client.GetSlot(context.Background(), "")
+	require.Equal(t, ErrCircuitOpen, err)
+
+	// A successful trial closes it.
+	now = now.Add(time.Minute)
+	endpoint.err = nil
+	slot, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), slot)
+	require.Equal(t, CircuitClosed, cb.State())
+	require.Equal(t, []string{"closed>open", "open>half-open", "half-open>open", "open>half-open", "half-open>closed"}, changes)
+
+	// Errors in the requests are not failures of the endpoint.
+	endpoint.err = &jsonrpc.RPCError{Code: -32602, Message: "Invalid params"}
+	for i := 0; i < 3; i++ {
+		_, err = client.GetSlot(context.Background(), "")
+		require.Error(t, err)
+	}
+	require.Equal(t, CircuitClosed, cb.State())
+}
+
+func TestCircuitBreaker_Canceled(t *testing.T) {
+	endpoint := &circuitTestRPC{slot: 1, err: jsonrpc.NewHTTPError(http.StatusBadGateway, errors.New("bad gateway"))}
+	now := time.Unix(0, 0)
+	cb := NewCircuitBreaker(endpoint, &CircuitBreakerOpts{
+		MaxFailures: 1,
+		OpenTimeout: time.Minute,
+	})
+	cb.now = func() time.Time { return now }
+	client := NewWithCustomRPCClient(cb)
+
+	_, err := client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Equal(t, CircuitOpen, cb.State())
+
+	// A half-open trial canceled by the caller doesn't open the circuit again,
+	// and lets the next call be a trial.
+	now = now.Add(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	endpoint.err = context.Canceled
+	_, err = client.GetSlot(ctx, "")
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Equal(t, CircuitHalfOpen, cb.State())
+
+	endpoint.err = nil
+	_, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, CircuitClosed, cb.State())
+
+	// Calls past the deadline of the caller are not failures either.
+	ctx, cancel = context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	endpoint.err = context.DeadlineExceeded
+	_, err = client.GetSlot(ctx, "")
+	require.Error(t, err)
+	require.Equal(t, CircuitClosed, cb.State())
+}
+
+func TestCircuitBreaker_Lag(t *testing.T) {
+	endpoint := &circuitTestRPC{slot: 100}
+	reference := &circuitTestRPC{slot: 100}
+	fallback := &circuitTestRPC{slot: 200}
+	cb := NewCircuitBreaker(endpoint, &CircuitBreakerOpts{
+		MaxSlotLag: 50,
+		Fallback:   fallback,
+	})
+	// Set after the creation, to check the lag manually.
+	cb.opts.Reference = reference
+	client := NewWithCustomRPCClient(cb)
+
+	reference.slot = 200
+	require.NoError(t, cb.CheckLag(context.Background()))
+	require.Equal(t, CircuitOpen, cb.State())
+
+	// Routed to the fallback, even after the open timeout.
+	cb.now = func() time.Time { return time.Now().Add(time.Hour) }
+	slot, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), slot)
+
+	endpoint.slot = 180
+	require.NoError(t, cb.CheckLag(context.Background()))
+	require.Equal(t, CircuitClosed, cb.State())
+	slot, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(180), slot)
+}
@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"golang.org/x/time/rate"
+)
+
+var _ JSONRPCClient = &clientWithMethodLimiter{}
+
+// MethodLimit is the rate limit of a RPC method.
+type MethodLimit struct {
+	// Requests per second.
+	Rate rate.Limit
+	// Maximum number of requests sent at once; defaults to 1.
+	Burst int
+}
+
+// MethodRateLimits are the rate limits of the RPC methods,
+// e.g. {Methods: {"getProgramAccounts": {Rate: 2}, "getAccountInfo": {Rate: 50}}}.
+type MethodRateLimits struct {
+	// Limits by method name.
+	Methods map[string]MethodLimit
+	// Limit shared by the methods not in Methods; nil for no limit.
+	Default *MethodLimit
+}
+
+type clientWithMethodLimiter struct {
+	rpcClient JSONRPCClient
+	limiters  map[string]*rate.Limiter
+	fallback  *rate.Limiter
+}
+
+// NewWithMethodLimiter creates a new Solana RPC client rate-limited per method.
+func NewWithMethodLimiter(rpcEndpoint string, limits MethodRateLimits) JSONRPCClient {
+	opts := &jsonrpc.RPCClientOpts{
+		HTTPClient: newHTTP(),
+	}
+	return NewMethodLimiter(jsonrpc.NewClientWithOpts(rpcEndpoint, opts), limits)
+}
+
+// NewMethodLimiter wraps the RPC client to rate-limit each method.
+// The calls wait for their method's limiter, or return when the context is done.
+func NewMethodLimiter(rpcClient JSONRPCClient, limits MethodRateLimits) JSONRPCClient {
+	newLimiter := func(limit MethodLimit) *rate.Limiter {
+		if limit.Burst <= 0 {
+			limit.Burst = 1
+		}
+		return rate.NewLimiter(limit.Rate, limit.Burst)
+	}
+	cl := &clientWithMethodLimiter{
+		rpcClient: rpcClient,
+		limiters:  make(map[string]*rate.Limiter, len(limits.Methods)),
+	}
+	for method, limit := range limits.Methods {
+		cl.limiters[method] = newLimiter(limit)
+	}
+	if limits.Default != nil {
+		cl.fallback = newLimiter(*limits.Default)
+	}
+	return cl
+}
+
+func (wr *clientWithMethodLimiter) wait(ctx context.Context, method string) error {
+	limiter, ok := wr.limiters[method]
+	if !ok {
+		limiter = wr.fallback
+	}
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
+
+func (wr *clientWithMethodLimiter) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	err := wr.wait(ctx, method)
+	if err != nil {
+		return err
+	}
+	return wr.rpcClient.CallForInto(ctx, out, method, params)
+}
+
+func (wr *clientWithMethodLimiter) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	err := wr.wait(ctx, method)
+	if err != nil {
+		return err
+	}
+	return wr.rpcClient.CallWithCallback(ctx, method, params, callback)
+}
+
+// CallBatch sends the requests in a single batch, once each of them got through
+// the limiter of its method.
+func (wr *clientWithMethodLimiter) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	batchClient, ok := wr.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrBatchNotSupported, wr.rpcClient)
+	}
+	for _, req := range requests {
+		if err := wr.wait(ctx, req.Method); err != nil {
+			return nil, err
+		}
+	}
+	return batchClient.CallBatch(ctx, requests)
+}
+
+// Close closes clientWithMethodLimiter.
+func (cl *clientWithMethodLimiter) Close() error {
+	if c, ok := cl.rpcClient.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
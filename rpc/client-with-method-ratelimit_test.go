@@ -0,0 +1,70 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestMethodLimiter(t *testing.T) {
+	rpcClient := &sequentialRPC{}
+	client := NewWithCustomRPCClient(NewMethodLimiter(rpcClient, MethodRateLimits{
+		Methods: map[string]MethodLimit{
+			"getSlot": {Rate: rate.Every(time.Hour)},
+		},
+	}))
+
+	_, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+
+	// The next getSlot must wait an hour: it returns when the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.GetSlot(ctx, "")
+	require.Error(t, err)
+	require.Equal(t, []string{"getSlot"}, rpcClient.methods)
+
+	// The other methods are not limited.
+	for i := 0; i < 10; i++ {
+		_, err = client.GetBlockHeight(context.Background(), "")
+		require.EqualError(t, err, "unexpected method getBlockHeight")
+	}
+}
+
+func TestMethodLimiter_Default(t *testing.T) {
+	rpcClient := &sequentialRPC{}
+	client := NewWithCustomRPCClient(NewMethodLimiter(rpcClient, MethodRateLimits{
+		Methods: map[string]MethodLimit{
+			"getSlot": {Rate: rate.Inf},
+		},
+		Default: &MethodLimit{Rate: rate.Every(time.Hour), Burst: 2},
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		client.GetBlockHeight(ctx, "")
+	}
+	for i := 0; i < 3; i++ {
+		_, err := client.GetSlot(ctx, "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"getBlockHeight", "getBlockHeight", "getSlot", "getSlot", "getSlot"}, rpcClient.methods)
+}
@@ -23,10 +23,10 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
-	"github.com/klauspost/compress/gzhttp"
 )
 
 var ErrNotFound = errors.New("not found")
@@ -35,6 +35,18 @@ var ErrNotConfirmed = errors.New("not confirmed")
 type Client struct {
 	rpcURL    string
 	rpcClient JSONRPCClient
+	caps      capabilities
+
+	// Default maxSupportedTransactionVersion of getBlock and getTransaction.
+	maxSupportedTransactionVersion *uint64
+
+	// When dryRun is true, transactions are simulated instead of sent.
+	dryRun     bool
+	dryRunHook DryRunHook
+
+	// The provider-specific namespaces, by name.
+	extMu      sync.Mutex
+	extensions map[string]interface{}
 }
 
 type JSONRPCClient interface {
@@ -50,7 +62,9 @@ func New(rpcEndpoint string) *Client {
 	}
 
 	rpcClient := jsonrpc.NewClientWithOpts(rpcEndpoint, opts)
-	return NewWithCustomRPCClient(rpcClient)
+	cl := NewWithCustomRPCClient(rpcClient)
+	cl.rpcURL = rpcEndpoint
+	return cl
 }
 
 // New creates a new Solana JSON RPC client with the provided custom headers.
@@ -61,7 +75,9 @@ func NewWithHeaders(rpcEndpoint string, headers map[string]string) *Client {
 		CustomHeaders: headers,
 	}
 	rpcClient := jsonrpc.NewClientWithOpts(rpcEndpoint, opts)
-	return NewWithCustomRPCClient(rpcClient)
+	cl := NewWithCustomRPCClient(rpcClient)
+	cl.rpcURL = rpcEndpoint
+	return cl
 }
 
 // Close closes the client.
@@ -83,6 +99,33 @@ func NewWithCustomRPCClient(rpcClient JSONRPCClient) *Client {
 	}
 }
 
+// SetMaxSupportedTransactionVersion sets the default maxSupportedTransactionVersion
+// of the getBlock and getTransaction requests made by the client;
+// the value provided in the options of a call takes precedence.
+// Use nil to remove the default.
+// It must be called before the client is used.
+func (cl *Client) SetMaxSupportedTransactionVersion(version *uint64) *Client {
+	cl.maxSupportedTransactionVersion = version
+	return cl
+}
+
+// MaxSupportedTransactionVersion returns the default maxSupportedTransactionVersion
+// of the client, or nil if not set.
+func (cl *Client) MaxSupportedTransactionVersion() *uint64 {
+	return cl.maxSupportedTransactionVersion
+}
+
+// applyMaxSupportedTransactionVersion sets the default maxSupportedTransactionVersion
+// of the client in the config object, unless already set.
+func (cl *Client) applyMaxSupportedTransactionVersion(obj M) {
+	if cl.maxSupportedTransactionVersion == nil {
+		return
+	}
+	if _, ok := obj["maxSupportedTransactionVersion"]; !ok {
+		obj["maxSupportedTransactionVersion"] = *cl.maxSupportedTransactionVersion
+	}
+}
+
 var (
 	defaultMaxIdleConnsPerHost = 9
 	defaultTimeout             = 5 * time.Minute
@@ -112,9 +155,14 @@ func newHTTPTransport() *http.Transport {
 func newHTTP() *http.Client {
 	tr := newHTTPTransport()
 
+	var transport http.RoundTripper = &decompressionTransport{transport: tr}
+	transport = &responseSizeTransport{transport: transport}
+	transport = &responseCaptureTransport{transport: transport}
+	transport = &userAgentTransport{transport: transport}
+	transport = &contextHeadersTransport{transport: transport}
 	return &http.Client{
 		Timeout:   defaultTimeout,
-		Transport: gzhttp.Transport(tr),
+		Transport: transport,
 	}
 }
 
@@ -27,6 +27,7 @@ import (
 
 	"github.com/AlekSi/pointer"
 	bin "github.com/gagliardetto/binary"
+	"github.com/mr-tron/base58"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -80,6 +81,32 @@ func TestClient_GetAccountInfo(t *testing.T) {
 		}, out)
 }
 
+func TestClient_GetAccountDataSlice(t *testing.T) {
+	responseBody := `{"context":{"slot":83986105},"value":{"data":["dGVzdA==","base64"],"executable":false,"lamports":999999,"owner":"11111111111111111111111111111111","rentEpoch":207}}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
+	defer closer()
+	client := New(server.URL)
+
+	pubKey := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	data, err := client.GetAccountDataSlice(context.Background(), pubKey, 64, 4)
+	require.NoError(t, err)
+	require.Equal(t, []byte("test"), data)
+
+	assert.Equal(t,
+		[]interface{}{
+			pubKey.String(),
+			map[string]interface{}{
+				"encoding": string(solana.EncodingBase64),
+				"dataSlice": map[string]interface{}{
+					"offset": float64(64),
+					"length": float64(4),
+				},
+			},
+		},
+		server.RequestBody(t)["params"],
+	)
+}
+
 func TestClient_GetAccountInfoWithOpts(t *testing.T) {
 	responseBody := `{"context":{"slot":83986105},"value":{"data":["dGVzdA==","base64"],"executable":true,"lamports":999999,"owner":"11111111111111111111111111111111","rentEpoch":207}}`
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
@@ -131,85 +158,55 @@ func TestClient_GetAccountInfoWithOpts(t *testing.T) {
 	)
 }
 
-func TestClient_GetConfirmedSignaturesForAddress2(t *testing.T) {
-	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","result":[{"err":null,"memo":null,"signature":"mgw5vw4tnbou1wVStKckVcVncbpRwfZPcMNbVBoigbSPXBMa3857CNzhwoCkRzM5K7nG32wcbpVJDHttQeBRaHB","slot":1}],"id":0}`))
+func TestClient_AccountExists(t *testing.T) {
+	responseBody := `{"context":{"slot":83986105},"value":{"data":["","base64"],"executable":false,"lamports":999999,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":207}}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
 	defer closer()
 	client := New(server.URL)
 
-	account := solana.MustPublicKeyFromBase58("H7ATJQGhwG8Uf8sUntUognFpsKixPy2buFnXkvyNbGUb")
-	limit := uint64(1)
-	out, err := client.GetConfirmedSignaturesForAddress2(context.Background(), account, &GetConfirmedSignaturesForAddress2Opts{Limit: &limit})
+	pubkeyString := "7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932"
+	pubKey := solana.MustPublicKeyFromBase58(pubkeyString)
+	exists, err := client.AccountExists(context.Background(), pubKey)
 	require.NoError(t, err)
+	assert.True(t, exists)
 
 	assert.Equal(t,
 		map[string]interface{}{
 			"id":      float64(0),
 			"jsonrpc": "2.0",
-			"method":  "getConfirmedSignaturesForAddress2",
+			"method":  "getAccountInfo",
 			"params": []interface{}{
-				"H7ATJQGhwG8Uf8sUntUognFpsKixPy2buFnXkvyNbGUb",
-				map[string]interface{}{"limit": float64(1)},
+				pubkeyString,
+				map[string]interface{}{
+					"encoding": "base64",
+					"dataSlice": map[string]interface{}{
+						"offset": float64(0),
+						"length": float64(0),
+					},
+				},
 			},
 		},
 		server.RequestBody(t),
 	)
 
-	expected := []*TransactionSignature{
-		{Slot: 1, Signature: solana.MustSignatureFromBase58("mgw5vw4tnbou1wVStKckVcVncbpRwfZPcMNbVBoigbSPXBMa3857CNzhwoCkRzM5K7nG32wcbpVJDHttQeBRaHB")},
-	}
-
-	assert.Equal(t, GetConfirmedSignaturesForAddress2Result(expected), out)
+	owner, err := client.GetAccountOwner(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, solana.TokenProgramID, owner)
 }
 
-func TestClient_GetConfirmedTransaction(t *testing.T) {
-	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","result":{"meta":{"err":null,"fee":5000,"innerInstructions":[],"logMessages":[],"postBalances":[],"preBalances":[],"status":{"Ok":null}},"slot":48291656,"transaction":["AcpmPgtaSCzI2vuOUXduljmnoc1zIqMETzEJ8zmF+\/yy2AABHMNonpVleveVw4a4Fo7LUDWtxo2FkyzFr2x9DQIBAAMB47aX3y9Dfp+\/ycSDXt0Ph3TfZQBqPSXMQYToKtUtr5kNhniVeV7Las6qkeV8d0rksxV9de0GF7p4nzQUVEnrWwEEBAECAwAEdGVzdA==","base64"]},"id":0}`))
+func TestClient_AccountExists_NotFound(t *testing.T) {
+	responseBody := `{"context":{"slot":83986105},"value":null}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
 	defer closer()
 	client := New(server.URL)
 
-	out, err := client.GetConfirmedTransaction(
-		context.Background(),
-		solana.MustSignatureFromBase58("53hoZ98EsCMA6L63GWM65M3Bd3WqA4LxD8bcJkbKoKWhbJFqX9M1WZ4fSjt8bYyZn21NwNnV2A25zirBni9Qk6LR"),
-	)
+	pubKey := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+	exists, err := client.AccountExists(context.Background(), pubKey)
 	require.NoError(t, err)
+	assert.False(t, exists)
 
-	assert.Equal(t,
-		map[string]interface{}{
-			"id":      float64(0),
-			"jsonrpc": "2.0",
-			"method":  "getConfirmedTransaction",
-			"params": []interface{}{
-				"53hoZ98EsCMA6L63GWM65M3Bd3WqA4LxD8bcJkbKoKWhbJFqX9M1WZ4fSjt8bYyZn21NwNnV2A25zirBni9Qk6LR",
-				"json",
-			},
-		},
-		server.RequestBody(t),
-	)
-
-	signature, err := solana.SignatureFromBase58("53hoZ98EsCMA6L63GWM65M3Bd3WqA4LxD8bcJkbKoKWhbJFqX9M1WZ4fSjt8bYyZn21NwNnV2A25zirBni9Qk6LR")
-	require.NoError(t, err)
-
-	assert.Equal(t, &TransactionMeta{
-		Fee:               5000,
-		PreBalances:       []uint64{},
-		PostBalances:      []uint64{},
-		InnerInstructions: []InnerInstruction{},
-		LogMessages:       []string{},
-		Status: DeprecatedTransactionMetaStatus{
-			"Ok": nil,
-		},
-	}, out.Meta)
-
-	assert.Equal(t, &solana.Transaction{
-		Message: solana.Message{
-			Header:          solana.MessageHeader{NumRequiredSignatures: 1, NumReadonlySignedAccounts: 0, NumReadonlyUnsignedAccounts: 3},
-			RecentBlockhash: solana.MustHashFromBase58("uoEAQCWCKjV9ecsBvngctJ7upNBZX7hpN4SfdR6TaUz"),
-			AccountKeys:     []solana.PublicKey{solana.MustPublicKeyFromBase58("GKu2xfGZopa8C9K11wduQWgP4W4H7EEcaNdsUb7mxhyr")},
-			Instructions: []solana.CompiledInstruction{
-				{Accounts: []uint16{1, 2, 3, 0}, Data: solana.Base58([]byte{0x74, 0x65, 0x73, 0x74}), ProgramIDIndex: 4},
-			},
-		},
-		Signatures: []solana.Signature{signature},
-	}, out.MustGetTransaction())
+	_, err = client.GetAccountOwner(context.Background(), pubKey)
+	assert.Equal(t, ErrNotFound, err)
 }
 
 // mustAnyToJSON marshals the provided variable
@@ -252,40 +249,6 @@ func wrapIntoRPC(res string) string {
 	return `{"jsonrpc":"2.0","result":` + res + `,"id":0}`
 }
 
-func TestClient_GetRecentBlockhash(t *testing.T) {
-	responseBody := `{"context":{"slot":83986105},"value":{"blockhash":"DvLEyV2GHk86K5GojpqnRsvhfMF5kdZomKMnhVpvHyqK","feeCalculator":{"lamportsPerSignature":5000}}}`
-	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
-	defer closer()
-
-	client := New(server.URL)
-
-	out, err := client.GetRecentBlockhash(
-		context.Background(),
-		CommitmentMax,
-	)
-	require.NoError(t, err)
-
-	assert.Equal(t,
-		map[string]interface{}{
-			"id":      float64(0),
-			"jsonrpc": "2.0",
-			"method":  "getRecentBlockhash",
-			"params": []interface{}{
-				map[string]interface{}{
-					"commitment": string(CommitmentMax),
-				},
-			},
-		},
-		server.RequestBody(t),
-	)
-
-	expected := mustJSONToInterface([]byte(responseBody))
-
-	got := mustJSONToInterface(mustAnyToJSON(out))
-
-	assert.Equal(t, expected, got, "both deserialized values must be equal")
-}
-
 func TestClient_GetBalance(t *testing.T) {
 	responseBody := `{"context":{"slot":83987501},"value":19039980000}`
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
@@ -326,8 +289,11 @@ func TestClient_GetBalance(t *testing.T) {
 		}, out)
 }
 
+// getBlockResponseBody is a block with two vote transactions, base64-encoded.
+const getBlockResponseBody = `{"blockHeight":69213636,"blockTime":1625227950,"blockhash":"5M77sHdwzH6rckuQwF8HL1w52n7hjrh4GVTFiF6T8QyB","parentSlot":83987983,"previousBlockhash":"Aq9jSXe1jRzfiaBcRFLe4wm7j499vWVEeFQrq5nnXfZN","rewards":[{"lamports":1595000,"postBalance":482032983798,"pubkey":"5rL3AaidKJa4ChSV3ys1SvpDg9L4amKiwYayGR5oL3dq","rewardType":"Fee"}],"transactions":[{"meta":{"err":null,"fee":5000,"innerInstructions":[],"logMessages":["Program Vote111111111111111111111111111111111111111 invoke [1]","Program Vote111111111111111111111111111111111111111 success"],"postBalances":[441866063495,40905918933763,1,1,1],"postTokenBalances":[],"preBalances":[441866068495,40905918933763,1,1,1],"preTokenBalances":[],"rewards":[],"status":{"Ok":null}},"transaction":["AQp2TH1spzjBAVM3alvnpaePFx3YEo9dvRglDuSChZUoTMD\/\/2h0HY5+89LJjCdiGJ7Ph3+Fyvbeiz1uJF8gxw0BAAMFyH0KDkXtjL1xebUYflZxYGlpV+LvjazzZCb\/mF2T67xZmkOUM\/A0iDSEkFzD5m4Ol82vsojigvqxrmp7Z1vrQgan1RcZLwqvxvJl4\/t3zHragsUp0L47E24tAFUgAAAABqfVFxjHdMkoVmOYaR1etoteuKObS21cc1VbIQAAAAAHYUgdNXR0u3xNdiTr072z2DVec9EQQ\/wNo1OAAAAAAAMFYbeqrsxJ9\/vZxtOaFi3rT2w9RF5Xi4jsyu61f3t1AQQEAQIDAAR0ZXN0","base64"]},{"meta":{"err":null,"fee":5000,"innerInstructions":[],"logMessages":["Program Vote111111111111111111111111111111111111111 invoke [1]","Program Vote111111111111111111111111111111111111111 success"],"postBalances":[334759887662,151357332545078,1,1,1],"postTokenBalances":[],"preBalances":[334759892662,151357332545078,1,1,1],"preTokenBalances":[],"rewards":[],"status":{"Ok":null}},"transaction":["ATA7DkBatbe2JB43QV+QRj2yoXSMXXttYFggDxZYOBfsRyYuGtzrbUevivclchxVccRIPlRP9PtS\/9NPXlwmhwwBAAMFSDrhjiNPuNqc4BWwitZz7xJ2NIXtv6XZtwtEOmgLj3n3NQ+OONLFlsu0LoUBSDsp40i9jOjZJBsliMtvTfdV+gan1RcZLwqvxvJl4\/t3zHragsUp0L47E24tAFUgAAAABqfVFxjHdMkoVmOYaR1etoteuKObS21cc1VbIQAAAAAHYUgdNXR0u3xNdiTr072z2DVec9EQQ\/wNo1OAAAAAAAKlcZMqS\/Oh0v+kOq2Ipg73NqbvKBRGQJDK8\/01K+MBAQQEAQIDAAR0ZXN0","base64"]}]}`
+
 func TestClient_GetBlock(t *testing.T) {
-	responseBody := `{"blockHeight":69213636,"blockTime":1625227950,"blockhash":"5M77sHdwzH6rckuQwF8HL1w52n7hjrh4GVTFiF6T8QyB","parentSlot":83987983,"previousBlockhash":"Aq9jSXe1jRzfiaBcRFLe4wm7j499vWVEeFQrq5nnXfZN","rewards":[{"lamports":1595000,"postBalance":482032983798,"pubkey":"5rL3AaidKJa4ChSV3ys1SvpDg9L4amKiwYayGR5oL3dq","rewardType":"Fee"}],"transactions":[{"meta":{"err":null,"fee":5000,"innerInstructions":[],"logMessages":["Program Vote111111111111111111111111111111111111111 invoke [1]","Program Vote111111111111111111111111111111111111111 success"],"postBalances":[441866063495,40905918933763,1,1,1],"postTokenBalances":[],"preBalances":[441866068495,40905918933763,1,1,1],"preTokenBalances":[],"rewards":[],"status":{"Ok":null}},"transaction":["AQp2TH1spzjBAVM3alvnpaePFx3YEo9dvRglDuSChZUoTMD\/\/2h0HY5+89LJjCdiGJ7Ph3+Fyvbeiz1uJF8gxw0BAAMFyH0KDkXtjL1xebUYflZxYGlpV+LvjazzZCb\/mF2T67xZmkOUM\/A0iDSEkFzD5m4Ol82vsojigvqxrmp7Z1vrQgan1RcZLwqvxvJl4\/t3zHragsUp0L47E24tAFUgAAAABqfVFxjHdMkoVmOYaR1etoteuKObS21cc1VbIQAAAAAHYUgdNXR0u3xNdiTr072z2DVec9EQQ\/wNo1OAAAAAAAMFYbeqrsxJ9\/vZxtOaFi3rT2w9RF5Xi4jsyu61f3t1AQQEAQIDAAR0ZXN0","base64"]},{"meta":{"err":null,"fee":5000,"innerInstructions":[],"logMessages":["Program Vote111111111111111111111111111111111111111 invoke [1]","Program Vote111111111111111111111111111111111111111 success"],"postBalances":[334759887662,151357332545078,1,1,1],"postTokenBalances":[],"preBalances":[334759892662,151357332545078,1,1,1],"preTokenBalances":[],"rewards":[],"status":{"Ok":null}},"transaction":["ATA7DkBatbe2JB43QV+QRj2yoXSMXXttYFggDxZYOBfsRyYuGtzrbUevivclchxVccRIPlRP9PtS\/9NPXlwmhwwBAAMFSDrhjiNPuNqc4BWwitZz7xJ2NIXtv6XZtwtEOmgLj3n3NQ+OONLFlsu0LoUBSDsp40i9jOjZJBsliMtvTfdV+gan1RcZLwqvxvJl4\/t3zHragsUp0L47E24tAFUgAAAABqfVFxjHdMkoVmOYaR1etoteuKObS21cc1VbIQAAAAAHYUgdNXR0u3xNdiTr072z2DVec9EQQ\/wNo1OAAAAAAAKlcZMqS\/Oh0v+kOq2Ipg73NqbvKBRGQJDK8\/01K+MBAQQEAQIDAAR0ZXN0","base64"]}]}`
+	responseBody := getBlockResponseBody
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
 	defer closer()
 
@@ -908,101 +874,6 @@ func TestClient_GetEpochSchedule(t *testing.T) {
 	assert.Equal(t, expected, got, "both deserialized values must be equal")
 }
 
-func TestClient_GetFeeCalculatorForBlockhash(t *testing.T) {
-	responseBody := `{"context":{"slot":83994405},"value":{"feeCalculator":{"lamportsPerSignature":5000}}}`
-	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
-	defer closer()
-	client := New(server.URL)
-
-	out, err := client.GetFeeCalculatorForBlockhash(
-		context.Background(),
-		solana.Hash{},
-		CommitmentMax,
-	)
-	require.NoError(t, err)
-
-	assert.Equal(t,
-		map[string]interface{}{
-			"id":      float64(0),
-			"jsonrpc": "2.0",
-			"method":  "getFeeCalculatorForBlockhash",
-			"params": []interface{}{
-				solana.Hash{}.String(),
-				map[string]interface{}{
-					"commitment": string(CommitmentMax),
-				},
-			},
-		},
-		server.RequestBody(t),
-	)
-
-	expected := mustJSONToInterface([]byte(responseBody))
-
-	got := mustJSONToInterface(mustAnyToJSON(out))
-
-	assert.Equal(t, expected, got, "both deserialized values must be equal")
-}
-
-func TestClient_GetFeeRateGovernor(t *testing.T) {
-	responseBody := `{"context":{"slot":83994521},"value":{"feeRateGovernor":{"burnPercent":50,"maxLamportsPerSignature":100000,"minLamportsPerSignature":5000,"targetLamportsPerSignature":10000,"targetSignaturesPerSlot":20000}}}`
-	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
-	defer closer()
-	client := New(server.URL)
-
-	out, err := client.GetFeeRateGovernor(
-		context.Background(),
-	)
-	require.NoError(t, err)
-
-	assert.Equal(t,
-		map[string]interface{}{
-			"id":      float64(0),
-			"jsonrpc": "2.0",
-			"method":  "getFeeRateGovernor",
-		},
-		server.RequestBody(t),
-	)
-
-	expected := mustJSONToInterface([]byte(responseBody))
-
-	got := mustJSONToInterface(mustAnyToJSON(out))
-
-	assert.Equal(t, expected, got, "both deserialized values must be equal")
-}
-
-func TestClient_GetFees(t *testing.T) {
-	responseBody := `{"context":{"slot":83994536},"value":{"blockhash":"HrPVENs6RtqRAxu14o63ZCkhCQR3vsNur1HU7K3GqKxb","feeCalculator":{"lamportsPerSignature":5000},"lastValidBlockHeight":69218886,"lastValidSlot":83994836}}`
-	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
-	defer closer()
-	client := New(server.URL)
-
-	out, err := client.GetFees(
-		context.Background(),
-		CommitmentMax,
-	)
-	require.NoError(t, err)
-
-	assert.Equal(t,
-		map[string]interface{}{
-			"id":      float64(0),
-			"jsonrpc": "2.0",
-			"method":  "getFees",
-			"params": []interface{}{
-				map[string]interface{}{
-					"commitment": string(CommitmentMax),
-				},
-			},
-		},
-		server.RequestBody(t),
-	)
-
-	expected := mustJSONToInterface([]byte(responseBody))
-
-	got := mustJSONToInterface(mustAnyToJSON(out))
-
-	assert.Equal(t, expected, got, "both deserialized values must be equal")
-}
-
 func TestClient_GetFirstAvailableBlock(t *testing.T) {
 	responseBody := `39368303`
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
@@ -1646,33 +1517,6 @@ func TestClient_GetRecentPerformanceSamples(t *testing.T) {
 	assert.Equal(t, expected, got, "both deserialized values must be equal")
 }
 
-func TestClient_GetSnapshotSlot(t *testing.T) {
-	responseBody := `83998606`
-	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
-	defer closer()
-	client := New(server.URL)
-
-	out, err := client.GetSnapshotSlot(
-		context.Background(),
-	)
-	require.NoError(t, err)
-
-	assert.Equal(t,
-		map[string]interface{}{
-			"id":      float64(0),
-			"jsonrpc": "2.0",
-			"method":  "getSnapshotSlot",
-		},
-		server.RequestBody(t),
-	)
-
-	expected := mustJSONToInterface([]byte(responseBody))
-
-	got := mustJSONToInterface(mustAnyToJSON(out))
-
-	assert.Equal(t, expected, got, "both deserialized values must be equal")
-}
-
 func TestClient_GetSignaturesForAddress(t *testing.T) {
 	responseBody := `[{"blockTime":1625231961,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Yig3yd33o2hyZV2qZBJkScDArwVmzurkxhBfKdqJeujTrdKHwrR3U8KR6LrhN5eWNTyugS5rkkYagVXCNnk7pks","slot":83994671},{"blockTime":1625231952,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3oQ7qqpJs5CtH1Xnnn8Ru5MtxkR3SZgshqzXwokuxFRArLihKdvCb9km6gbSiiUaNSHE7zVJqUVUZGfYuEaqWZPV","slot":83994656},{"blockTime":1625231913,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2UyvpGHknssUFJ77vZgUUzhRjMTTttKeMRKvJgmwaW12WLjmhTXJMF7WmVy5DBJtVFbuE25XJH247ma19JFrFb5K","slot":83994591},{"blockTime":1625225568,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2PCayD6PMA5BEkLC5SydWWWong5XPfNZMyH4LwMdRV2cCW7h28hkySmb8Y4RDzjE2YuMHwYMdxnXkvx9mbhGokFt","slot":83984016},{"blockTime":1625225568,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"MqfYQzuJmdCYmwVLSGxvwSEG9kxeB4iudWbUanNrg4DcG8nH267iamAS6dxi4ckYnCPS3H8SANsy5Mo77YbF1ya","slot":83984016},{"blockTime":1625225508,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3RW4i3vVrymSSU32BQGkhDDwBmLnmr4CwFeWBjckddWyGJMLqhjWtY4kCCqbev32cm1WkTX3rvS8Y5mqSN3mWBQe","slot":83983916},{"blockTime":1625225370,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"67kpcDECq6V6VZhwwRnn79XRNW3sS3VvM7UkFA5MmrzEY84wTU8hgSq1Q63UjSn9fprcBYZiNtWsUZepVzVsxGy","slot":83983688},{"blockTime":1625225370,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4n81J2KQjTvjPnS4rbirWJic8D6uoCzoHkjJzVFegApCgdomJ16uLBgGGydZKLsd443ht8iuGCJaDsVaz2pyPXk3","slot":83983686},{"blockTime":1625225365,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Hi4CKo8kYjXw6sQpT6GncYgbH69TKPTm4rHgF5Y8JaLuUvgcyicoBW2CQiaVdoXUVwXVmCLtgWTE38MkbrgdX1g","slot":83983680},{"blockTime":1625225361,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2oGCdmEv8qSrNPwjLy82s8E4jqejEsWUY9DAYFd9xH4pS7ZjwW8NpTScQZk86eMh4nMHs8YSvLiGB8iKYiLpiyPm","slot":83983671},{"blockTime":1625225352,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5oufLDV3eg1BaiUgMXScfqhtU1JszLYdoJHjmKSdJkWdmkRZnVzHXsPCSXji9haAyXxAmzm3De7peBFPVZoDNi3F","slot":83983658},{"blockTime":1625225344,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3zsq9V5asSpgwQiHTjBA6PUBBGh5Tzwuij2pemzmxCEqB9TiRmgpeP7fVYMdiuTNo9RWsjDYjUyAp4ETtPSrGQSR","slot":83983644},{"blockTime":1625225340,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4RQobEstLtiXMMB7XTeFy7HxAJgnivQZyLwkAg4kgDWd4996XVQb7M6JsbGxqzfohTbWz7EHxvJ9Eet8ip1SLDVu","slot":83983638},{"blockTime":1625225340,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5AMjJzE6qPG4cJ9Vck1z2g5tCwwQjZWGreEqK6CfNM9aiFUzCqtZ6sY6r5vfoFUQ9DkJrF9unroHbUdLrEoZ3b32","slot":83983636},{"blockTime":1625225337,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ek35DoRGcWxjQksRmVg1EEv7ZHXraHtfJyCvE84jYYt9roDSQYMjaQEkajFPkJWarJMH87wcxQMHuo4H1D6cstm","slot":83983632},{"blockTime":1625225337,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3SUKt8qG1UwMaN2x5HS6hn1jbYkz9uy9qmR9efSb1W6mxAc2kBQPWGCHRPpaNvUHMPMW9M2bnv8mzpnXw3aL5dY3","slot":83983632},{"blockTime":1625225337,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"GBDLedioeZo8JRQ3BDoQyZFCdCexbuMfH4cxD2A691f9kE9Y2BxpvSdiyudNPechNjbsTZteNstykM8titNAXVd","slot":83983632},{"blockTime":1625225334,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5YJKDebNExQ9BSq8aqwRJHDYdCcWz9dP8TsgZg3RMNXzUno6juKFhM4GRdrKDDnoQLrmyXwQ5T7RB9kbkb1hyHsv","slot":83983627},{"blockTime":1625225332,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Zx3j7CB4xGN1QRfy3P6sKLTxAYuJRhHaDs2GqK1AeyWgBVAoffKWFfNoJxBbkrwqtbpiLZNr1PneaooRC8CmUC8","slot":83983625},{"blockTime":1625225329,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"UqiJtFokGzU654Gxx3c4G9Q7hV7Kswz9UjNCT6Zcp6vcG3GUaHEm9wKfZiWSgGBEYKzHFeseEZSwaT2DQNynaP8","slot":83983619},{"blockTime":1625225328,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3TG4PT63Xti5dxu8nPyHJw7fD8Dqt6eVg73yu2wvCBX47RgPBBLQPUkrPeSzGSkhRGyUB7AnK11cXhF4xL9qJ3uJ","slot":83983618},{"blockTime":1625225326,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2rkrVjr62SHC9jv2xz6T7pqvper7nXHEixr8AArTQW2skGpU6BZhrsAhReb74g2UJuGbX6QPtqgGS8YHUnscc1dx","slot":83983615},{"blockTime":1625225325,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3mRNsyMzjWzm5EeU1jy2JyytVrqYMQnXb5iexmAnN5aba6vM6fFvBfMkj3NByLH8oh5MXneQcSfWjBbfVJ34FP9y","slot":83983612},{"blockTime":1625225325,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"kjJdUU2MVrcDM6oKN379KKeVhZwE7pGr5gzjWFVUoKAvLykzteRaz5uQtByB1KgSprCT7Z4HGbKvGLDHLAZfZ6M","slot":83983612},{"blockTime":1625225325,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"BmDRTZSsJrMrdxVVCZdkT3oezn1WArhMEUXU1KrXxN4qhbRB6sfKzJ4onoa1k3TSvUzhvnrSxjn6Bo7yp8HYyUH","slot":83983611},{"blockTime":1625225320,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3NtPCpCENzbSiohdh7zmzSsSrcC7shk6u7hd7Bc2fw5cxRu3ofnKt13pPb4KDxjUR6QBrgqJKhrB34TzEbSF9snA","slot":83983604},{"blockTime":1625225320,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2J2mz26PYFKtrsM8dATQFG2JsTRJainjh8udss3euMaeFGfkAHqa9MHG7mMA7aftWkJtNbLDryK9pxSRTsc5nLMr","slot":83983604},{"blockTime":1625225319,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"7RTpqBx3MyL6yecVEj6dkF5tF3qLSvPhbEXPgGQNMKHTxGgEhoUJeDosKWVgEK8Dk2XnKCqZCU4uWCkunFEnSHK","slot":83983602},{"blockTime":1625225121,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2ZcrngBZGjRJLEsENL9qepSTCNrcpsmP8K62yVZc41AWh7gghrjPzyN8hX5rAEycEy2CG9qeePdSsssYPy3xK45x","slot":83983272},{"blockTime":1625225121,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2J2QwMgqLsbrphHGi6W6r4xJAA92jWtD87se7tFKAGArAyXYCmvAXK7NkTfdebHc72x5wJLFGSTcULy3BWHhkSgo","slot":83983272},{"blockTime":1625225118,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4TY9qpJoVzj3CBECfSYCy6CQGcttRJaAtZKGzdDLJTvNhRBuaV9ut7gDi8nJku2KEre2AJsBzrDEvynY1T78feUM","slot":83983266},{"blockTime":1625225070,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"X4VS3cnBJ1dTQ5qZczuUhtrHRZ58c7kH1h95EUU44dGRswkyi6p42kEbQV4M9K8Syyxghw7AdznLtW7TETkNdLn","slot":83983188},{"blockTime":1625225068,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Mu5AAPZRDSZe5bwSweE5BCfxxGLyb7vRRbJ68r1tghLtCnj4n7wrxxBALiRVJY62N9GrcoynRdum5VWcUu8jo8w","slot":83983185},{"blockTime":1625225067,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"WSBGXL1NR5A4nwq2cdXoMBasbbZ3f82YNdUfXrZke9qutziw4vcDt4YS9CiB1ZJz1XtpqyBEXrmTf9dmJFLGcW5","slot":83983181},{"blockTime":1625225064,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"243JzPc4HvQzWeHpwkUCBSLzDbxJkpVNfQxVcro52s48Wv2Dr3xMW9nmxWSqu2s4ATAqgfDsPGwHa6o77Gkpkt3X","slot":83983177},{"blockTime":1625225062,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"NpWtDMVy2HNQfHJduywSjztCdwZi9ujy73jniGK8QEwTEA4dexPQe1HwSuo9jz8Xhfwygg66fg8v7KKM724TVy9","slot":83983175},{"blockTime":1625225062,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"27zafwu4iVocp6AE6Mnb4Z87SQjvHFm3YgvG7rCLt4wgL4VwAhiwvNoPbuv6G2QXtqA4QYyJsPgkrEFNkRQxnV4J","slot":83983174},{"blockTime":1625225061,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3a987dZGH8Hac3tHEQ6DWNZMt8SwxcJpGE2dEHGjiC52UFidTmbBkdcaSMedzmpir6F6qpJeAo6zKMakCLXhYbXS","slot":83983172},{"blockTime":1625225058,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4arwtU5RQ2kocBGt6EtUYyJNXszCmsyvDozKSkB3eN4wtzbbEmbVrkDuc5xgmBSDpuh6Jri2e46Lu32Wg2SfuZWW","slot":83983166},{"blockTime":1625225058,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Lq8Z9v6oVr28x1ygHNgjw1PW2pfeendgRpXC5rqv1UWi1nbjaB8QFPXCzo8cT7HKzGSLzJfsD3iLL51QHLUQMxK","slot":83983166},{"blockTime":1625225058,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"31XoJHX5LkA89wsoqh6NEz2ZwR4ib1b9MUcJomrkw5wkocVhsLBvHsDsLiLTARhiavApAyiNqaw4FrQrqZn1fCwt","slot":83983166},{"blockTime":1625225053,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3YGoKoSfxGRZmVSRJSDEMXEqga7o2xvsxLcY1oG1XbDTcT8DxNsoCbTTwMNYeRMSmsLSar2VZn6mPpqkQdZ8svSm","slot":83983160},{"blockTime":1625225053,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"252gzJeEbbvdf1pYrymMFnwewBsTS5GxYdocD5qrFenwrVAk7dmnNv4mDBMuCFCCc4tie4hkZp8DT4Mg8fiooqMH","slot":83983160},{"blockTime":1625225046,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3yGTyh69v35LMp4Wwmrz8hc2wgtRhhfT28v2F1Q3zZCWA31aAhJCbheWNBcHxXj7qcGfqKMtdM4y8FBt6HkekJAr","slot":83983147},{"blockTime":1625225044,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2gxP4vCGF3u8NnVaSXJ1umNmw5SJP3dVV7cDk7RUhP7b8TrH2cT9JZPqLMbF7Soe8EZfMHhddGN9vcMwwPUt5UYA","slot":83983144},{"blockTime":1625225041,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3iAo5e63VCo8b5CCMPMy1dj2kS2b2wTYDRT8gDiYMtzjyEWGxikF4BnWc6bVgNKKemxtXX1WmiRKyfxTdxrF6jcj","slot":83983140},{"blockTime":1625225041,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4NZGvikoRD9FhCj7CXMBz8uLZjCX7WsGbw81irM7qRdfhk7vWUgJ7b5cctrTrt6GPm5vJ3JdECWZxdmZaeuzkPFu","slot":83983139},{"blockTime":1625225038,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"48cHbdw4KsHcXtDmjUTyKftqj7GYvBtLmGQnwUP2kXrQeCb7Gxm5GeQdQQRm16eea7GmePA26EHKLFBNeDiWhnM4","slot":83983134},{"blockTime":1625225035,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"aQ4SqDy8tX1AksDcJsr3yyRrMDzaQa5ZndGwCABVUCTAcc8sk7hSsFfZXwrr1Vr8kxmzSa2yySSQPFUL6SSMhLA","slot":83983130},{"blockTime":1625225034,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"g95qeYrT7wCNpd5eywJuZJTXnLoMWdqkGvkHEBdwXLSkzafsHcFhfCheVP5qrm56wWq2PicFnzyxWXyktxvmHP4","slot":83983128},{"blockTime":1625225031,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"428KFkQVYSNXHiJXzo38Puc5fRTq7m3xFQ3w3JQEUDMtiBYxKCXSDoeU8TjHNN8pJ5PcoDm9voT1KhPBbmWKjPAK","slot":83983123},{"blockTime":1625225031,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"26aXCPbNZQBsPZSLqgF142UPp7uVyDUgYgQF1bw2aWzamdZVTnHuERfrSQzvj3ePv7S2wZYx3fzweQKm9x68PA9s","slot":83983122},{"blockTime":1625225028,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"9oCgCt1XepgpTnm2VD4bgzqnezgYzWhMh1scKjA8UoavKZDK94xAz62DP4TybeCS2sQwig1N4kFgyEfYkVfEhnM","slot":83983118},{"blockTime":1625225025,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5SdibueP5swQU35Kk5M4vYLBbjYi1Bkk4L3S7CGHqToW9nC31KDSci85zGNt4XSTRwSe3fJtRoKkeFEtbSV768zs","slot":83983113},{"blockTime":1625225025,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"LD8BoRfUoxuuLH8BgYpY6UFci2qNUjtW3uWooiN87By5rVzr8TJxB1FFYWoRwEKZPDFf7M8EVFGTQD6PZVojWqX","slot":83983113},{"blockTime":1625224924,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5mx9RvddYkTJfNVS4i4rSLUkL2D6diJGoSgfsPRkg1DyYeRUT1JpN9WxDLvd4RJqYmtJwRbbwtZq2324UsTjqbq1","slot":83982945},{"blockTime":1625224918,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Cpf7bEg1g4sL3WHDFyU4gq9ts7nz8vtxPpNYrkzEUKjKjKjXKA6jmMSs8MjSNR7w74AnCUf9ZACiJuZ7QhgnpPy","slot":83982935},{"blockTime":1625224917,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3UsiPVApcKJ72z3739yXQY1SsjFSoT1YNYLzPX8MtRDRMjNj2BHxx4V9VAtpzv7rwDqaw5yMqjSuNXGtDSsPXb8R","slot":83982933},{"blockTime":1625224917,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3dHSCVpNLySs4E1DMfit1g9zNJhTUFAMHYy5Rw7dJrE9pDMBmfdKxzegqaV51iJ6qiNmFnkcxRL5Br3AGpcmLo8c","slot":83982931},{"blockTime":1625224914,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5St97pkc4Tmzvfudnn1dc817Y1e5evwTkzmmXWYxHZevjRAkmEuxizkS7tzjRsESQCZ8DoLP3KXnp9h7AGjUUZAQ","slot":83982928},{"blockTime":1625224912,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5aMyctNqjodGVqPPV9ZaTC4R2ytSehFoRSNP96xeuAFw1UWGHG3qfVpRPtyzCH7Bj9ZXMaCApWJSaadE3caUsZjb","slot":83982925},{"blockTime":1625224912,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5s3779heozyv5MtWr7ZYJUcGTktfvA99ojChfTvduCyBs8scG2NQJ2vpyk8MWXBsxQxfsseLgZdQBCaQofcRdGG9","slot":83982924},{"blockTime":1625224911,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"26b4Gcew38T2DBk67MQPaVcBxnasJ9x2R8P3keVPBa56DbA1R8DJtv3TvGnYR7fwFgtYJeYNwxSikD96c9cBRJbn","slot":83982921},{"blockTime":1625224905,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2ZxprAEtUBGn31hQFFvHSv1xPu4LZEcHqEUMxCDcyzqTDwYXBU3nXYvFAKk5gVaGGwQb6Bt3zo8iKcz7E5m2N1WY","slot":83982913},{"blockTime":1625224905,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2VKECsjbH3Jo4vvkAGc4udc2YuzH9BxFH6bSgXaYCBP2WXjfSGvcjNwiC4nn2amW4eMmjadMmHSkMg1EDLkxFnG9","slot":83982913},{"blockTime":1625224905,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4p8wS6YU1xNBYdVgkfbCjsviT87JmKsVnw8S7a28TE2LTRP1eAoByrmHrPWkTpQKWyPHQnZGRzr7tpcpVcY4CT6v","slot":83982912},{"blockTime":1625224900,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Ydg7uPkLVkoQBU3wqurbD5aBuPqUvrAFXRvvmCRLsDde4PyLNT9sy8A8WY5aBkB7f49SrELQV1BrzdE5TWYAoCT","slot":83982905},{"blockTime":1625224899,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"1LEp2rhVryBpv8UQg44GaJjGWUK6Z9dkTZaCcDxDsSazRDJovifgi84MnrdqNduD5vvfZQhMAP9hobTzcUGbzam","slot":83982901},{"blockTime":1625224896,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Zd6Vpv56N88j2otiJicJa3w5aUbs7kt1B3yh7mAUGfZzgXpNBHyELgtM92ve9sE6vZiksV3piuDgqZ9dtpseuWc","slot":83982898},{"blockTime":1625224894,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3CE3gGZh3srsTb46DsuJ3kKQRmQdCHKipJPTN1KJQcAfVSiTuvnQ2dpSvcpjzxq8PbpUwD1Z11dXm1LGz3KmT8qU","slot":83982894},{"blockTime":1625224893,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5jn5ScLLrtNp8sz8Rkv7MMQQisR2Rv5sk5YtRqKTA32tNSimmyFEaod4y87D4ZX7Jwd4vY9fwiPDSa1dQtecvJX5","slot":83982893},{"blockTime":1625224893,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Q2FfCSLDF3aFw7zDsDRsSKFuB8XcyrM5nW3gXVX2RcQKGUTPghSdDhhsWgxfKZrsmTwVpfBx9TTCRyDMnPFUD1z","slot":83982893},{"blockTime":1625224890,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3RuArwBU4M13pKhenJZLE1cD26Z9kWKC9zMJkbMc8AtWQoZFQDEVBqWRxLmvC9DWFMvcFHQ1soH7NspczJoYDkf9","slot":83982887},{"blockTime":1625224888,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ve44WDQiyJ9ng7qk6AgcPLSmXYunqk85VCdToyc4vvLJP84PhwxNtmHj7kcrxjF2WZ8Fahxh3honuUs8sg79nSE","slot":83982884},{"blockTime":1625224888,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4QiXj7bWo45dyjSQZAUwaVbzyWWar9PkDPTT6vRPC4mGhGaqFEVZKv9XCyjg6SEWRZVavH2rzdBA28MrEYVpJPaT","slot":83982884},{"blockTime":1625224885,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2jXU95Ap5h2bya8BJyXB5wFGsTL2UMWQiRJmvdvFFGB77joxqZRDB5VbwL9q4zWEiUej3VGXou1B7JGa5zK1tkGS","slot":83982879},{"blockTime":1625224884,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"61J3FCDFRnkDY8Q9bPZniFv39h4UyyAxaUXmttpozTkxs4z2jJ3Q52dJLXLSQsEdpMBUv7Mrz4SFoM11Xht4jbaX","slot":83982877},{"blockTime":1625224884,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5kJy75rpejnt39WL2aTmenNzdgZZVkyHEQPj2cPvZcBNq9H9qt3WPdHqkyyzf2cW9iUvmp5FsvVnTzagMNbMFVKD","slot":83982876},{"blockTime":1625224881,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4phuqBTj7uEx2XNFVFTcuRb8R6CWgj2nDxpkMtkdsYZ4VfJQsGSGxava6ZJ4wfaYGLXfNkNbVbXg3z7pnmEFXBsX","slot":83982872},{"blockTime":1625224881,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5gKb3Z1uWAmyR3kmqYQsTnWfRVhHCMQkGbqKpchydKKHDVm7cemdhs4diEXu9sChmSEQiAPJQ3yLBgsxD1rjRNA6","slot":83982871},{"blockTime":1625224780,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2j3GwHVZ9WCpEwpF2jamAcrQ2nPNJ9zyEMtjrisvW42XokSMbzmsJT2jYVcnMUUkYU6mLFyC2T375xYBnUyjUsXN","slot":83982705},{"blockTime":1625224780,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"37hwAGAyj9Vg6RxWhMUjUnY3ySHDBkTjP8H87uWYs7VvKVV3TYKoNu2hsMGgvn3kYbC3VteqUQs99Sfy9sCAuQjx","slot":83982704},{"blockTime":1625224777,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4m9nR2KfjrjdHYv1qwDJgP2a1PzhhQAm7pE7FaqAX9GfhAuQkNHcdzxghvYNARZGoXxCKwr717CvU93BZBYPxekz","slot":83982700},{"blockTime":1625224777,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"44FykQbp8WSyYi1sesFBFAYMTtrH4vZRmee9j9wmARmKPUi2iDATx1qN8ML6kwWTMsfxuf5srMPeXpiEX1kTmXsZ","slot":83982700},{"blockTime":1625224777,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3nCcUa26Ptw5Tr24vxFCn5W5jxHjnnTSZ4FcoHxFbX32xFparaTsTL2JpySdCk1SpmrzCv3mqUKnn3FNTySHx1fm","slot":83982700},{"blockTime":1625224773,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2SQJdn4ucnE9rYFgWqU9RTeUVVRrRrgS8M9BoiWVNpzKoHqDjhBWV68hoAvdDssz4nb9enPSWXaT7U9yunYUSWfS","slot":83982693},{"blockTime":1625224771,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2cSPQqsrAi7wY3NnMDbBggiupBB2kR5Upns7sxPd4t7TmRV8gruNqKSYF9hKkwDTyd6fPWEo7eNgYP7YEunFLDuS","slot":83982690},{"blockTime":1625224770,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5v7CFR1xyPSxbyKrtzpQonRJ8Bcrnj4KEWqnx9yEPRHCteLDenfJNfcecr7zB6YPPXvnr2y8pdiDYxAD3ZrdcKUE","slot":83982688},{"blockTime":1625224768,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3goynxgFqwoyFyVxAxCNcBdrKASbY8XpeFCHXc6Z94cSC6dD6io1tK4rW8HuNX2Z7pPVdpQSgTvha35biqYaDVKq","slot":83982684},{"blockTime":1625224767,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"PCsRJLujZJjpEwY3hfjFE7vpxTgoEae9hkEZngZ8DAAyMt1gzqF2igMvP9UijtBJoQMHcQzsx4BJPxEGrGXfnN3","slot":83982682},{"blockTime":1625224764,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2i5vuLs9LGMkZvLrRhEBCowNG2DETZUkj6HpBdzGabAHqoHbbZ4qqXWDposfSv61PHKtRtQ8qqNp6t8YaeJq4CMT","slot":83982678},{"blockTime":1625224764,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"mGB3kr8GCwLTcTn6ZEpZNTmkcNx6zswGzh82UwBevzt5ANSRQDqqFQN1TZWMgkm2qh4B1axGQTrBmpvbq4MaZrk","slot":83982677},{"blockTime":1625224764,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2UgPUFenPv1Gv9dYQRPiHdxCMK26nyJyvBRd1TaT5SjPVaKGdk2KAKy7YEPGvCwvsHWmVGhpepFQWfCjzpA3GKrH","slot":83982676},{"blockTime":1625224741,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"NhAXiW37o3DQrP8h6HuYGLDWB84iJ39VGoJygdPYD7ut2cDxz4n6NZEnbZbkqzTK9BxfuWSD4uNVTCrtSU2pX4W","slot":83982640},{"blockTime":1625224584,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ttknQTY7Y27iFCP2Bjxd7YfV1VuGW5BPMpKojEkrdvnqaQkXUZF9q2CEEoVeW1cR9M4wSZYzQpzjFzCQ69KNh2B","slot":83982377},{"blockTime":1625224584,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5173zGU78Bx3Axa4kV8yzcuvSTxPUd8yFWTQ7q3WqzHq27K49bHLcZz3aDgnLvffxHcB9y7umRC3itsiWbwZkMHe","slot":83982377},{"blockTime":1625224584,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4yWxmXbkyP2hF5FaZun7qGq8UD8rX8x1ujJepL8JU8qCN752voHpSvHX5Q2rwptaw39kjYVbVnYB29PCtyzogoVf","slot":83982377},{"blockTime":1625224584,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"27zaiSpXJLRM1Fm19BS3W2CNJF97DNyJQvayUJr2XAnB5YmiqAn6NSdx27mH7LsFMv7tQJCVUTpkY1RW7A9HtoBK","slot":83982376},{"blockTime":1625224578,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4H8p1k1hwyGRwVuEGbbU83sWQuX2jSDg1VHxurxTAN74LsgLvcs5eBiidwzmWjJ7vJ2LM1zko5G7C2iJBsTi6Bsz","slot":83982367},{"blockTime":1625224578,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4NLbY3P41ycuypqEop5iba4k7PtLA9o9b4NQJGVGyRrAAaZcHyfj4DoC4di1VvkCGDZETSMstznjEqi7U7ZScMe","slot":83982366},{"blockTime":1625224576,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3bwnZZHwGijsv1TYCSLcnpBDRkyMcv9gadwpaK5rMDZzPrDajFCGPE2NmDbWv8rSNFxzEFEMEFNocqg1uRM1o5Sd","slot":83982365},{"blockTime":1625224572,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"rGgC3jksLWSx8BteeiRwmFBbm4SmdjaPuEBj3ms2jd2C4kAEphfFvFPvLpxwdMrXvr6Y678VX8xLEpFXEsJzfYz","slot":83982358},{"blockTime":1625224572,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"25wvFKYfopRvm3dtpY7PMQoTszzsMSLqn8E7HDmPB4ePt6td4ZVJMBaoPM9pPs4PJahsNCN1ZthpJrHSLfzY5pdS","slot":83982357},{"blockTime":1625224566,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"55PFw37VJTwvhZRZicoEXGzB2zU9zZ5dGrkJgwrYme2RhZH9dv41A5pmpnPPrkVk7i1Qv8Ae2SQyEXXu1bDGduU8","slot":83982348},{"blockTime":1625224566,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5SWeCmzU8qsBG2nqSJgYzwHFhwjWmFtVkwvSeeibZqT7ziU4D8TT5jQ3CUrBf4AB4oJYKzv3UbvqqtEaYZC5UvWn","slot":83982347},{"blockTime":1625224564,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5kDYmxMA6MyvywDpsjNPLBj5WRYxT9RZEaqWxTumSyqBUgmVC71x44bKoSfxEFa7BTeGqKMaVPv6kknKAPwY5618","slot":83982345},{"blockTime":1625224563,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2jdBiDJr3Pw8K4iVaxKiESxxMpnEkojNDbMADX2y32ce5FFQKwFvpVtb1V4bJit5oGHfREFj1KB6dn4izGTTWKHt","slot":83982342},{"blockTime":1625224561,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3oMS9SspiZQ6sGqid7MdfhG6LNbAyD6SmKmZoySbDqnSqzKWtLcWZwxnhAx1PwWE7P7wbLdXZHvCfnnRxaFBJk6K","slot":83982340},{"blockTime":1625224561,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"sr9zgdcQ6e6xAi48pg1rUPh2o7UNox2adjx4wHMS9DTcirZV3djbt2X5LHWCLY9Ld26BvdNfS2bSUw7A1Fyq287","slot":83982339},{"blockTime":1625224557,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"qrzeGtL2WH3JaRBCwLnQsQPXVbGBoiNTdn9yWLVL3WSJWhgB6GUsy1jjEqFRFjvHS9sV7weD7nkyXnZjZj8aXqJ","slot":83982333},{"blockTime":1625224557,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Ci9AmMTFSB5dJu5Q7LHDYgiVXHv2GDdFfp9ArzKApKpHSKCwUMEboni4pywT7cDTJDuu3SbC1KdUb3D6ikByTQf","slot":83982332},{"blockTime":1625224552,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5dhnUiRbtXtzXec5RTiJMFmEn4FCzr8fJg1fc377cqazq7pt4iAYve395ojrh5e7Stwhxnv9ortzcStonTaofndS","slot":83982324},{"blockTime":1625224552,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"E7RcXT8oiwMboPyUd7xr73WCL6ZBcnNbh6W9aEtVhs2F38zSKDcRGacVzfpMfkDZKUzWpQNiwZhrEDspnCdVWxx","slot":83982324},{"blockTime":1625224548,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2eegxiv9cNifX6o6nSmT2BpJqzNPaKaVUT75WkRTsToKVQgxhVtnrV1QgZdvhmPrhc33Z9ksbgEycprfCLNfHgnQ","slot":83982317},{"blockTime":1625224548,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5FNyZ5ZJEECYXetSBex9xDi3ehkeoPLgf4bNYaQugW8tjRXiyYyjY5VLBkV4bP5VBKn6poXMGWfEFZXULBRgo7xU","slot":83982316},{"blockTime":1625224548,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4qjjQwn7aLJtvr361oTC4aBQsVbMuC26gTS5RctHS9k1RB3vboX34k2vhDYcv5AGKyim784uR9ZkSLmN7Kb55FG4","slot":83982316},{"blockTime":1625224546,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5oQajqeJnVU4qxPnkrSMXaRsP8m9VFsY8C3eBW8mebDLdp9jpByAyGWQWemnej5VTJdbXQst2nfebF4MuyDi4XwB","slot":83982315},{"blockTime":1625224545,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"SuipYCikT1GJTMpCsSs3uuM295WYZSgJQz3vFgnRWudFKzarRdCdCZut54dkGV7xSns9qPFpNtEFVvHrGPfPnNi","slot":83982313},{"blockTime":1625224542,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"avqS2HrkVdzmuVmqWT3hdyucTB1i6HuLMo1nAYkmK8G2uAVRqb6dkE2N2UXKMjaSpP7oURPZf6MM7uDK26EgKre","slot":83982306},{"blockTime":1625224386,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"7m58EXRwyGhcRJrGXASMb9ppRBoqK6PEFWfLJa7vXYbMfSedHkPGEnnnGEcMJJzdeK87sykfzhh5LJBym5bPirN","slot":83982048},{"blockTime":1625224383,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4BFxQoLVZ2576pHVwoGraD3oVqYkn9oyzEXNi7UU4tV4hxHMkfmgoSMdUbQrAeS5KXhBah2DHbGfVYvaEJVUh4eE","slot":83982043},{"blockTime":1625224339,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2w9BKPoiSccMPTJXYQFEii47VMLmQ9j3WtZcFZxuLPmPjYfYoLTKt1fp1f7hxU8gGkWCRN79r6Rm7gLBvwpeEXAA","slot":83981970},{"blockTime":1625224329,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"57fAnMvyEVmRe18vDnLmTAZckf2TcByxUqzoLZc4qKSdE8vGbnELGeBz1kvMj3hpkW2819n3TGAEAQ9oNLdAmV4w","slot":83981953},{"blockTime":1625224329,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4FochZtZSfrtER13yZko6H313vU4WHdmbm3ziWd9PuoXmBQ7VQwVPkr3Zn1EjAbLBdgvZBSh1CGRLTy35N9KnNje","slot":83981953},{"blockTime":1625224324,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4yzFgtwiqxD7WaDwTCDkG7G6vkMuJXvFGLNiH4Hzo93M2yA5o6c6753VsJ2wUEsTYpab586RBienKmkfpyUdfWwZ","slot":83981945},{"blockTime":1625224317,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"ievMuB3qdEv6UaHctpRsPQmDq2rWefpw5MGPET6Ne9YdpyhMZUA8fFkCiAbCEBKXKg7FQYziZ3JKhTsefQierG8","slot":83981932},{"blockTime":1625224315,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4mbt2mns4WCoVY9ga26CU8pKPRovTX43xLPyiQBB2TmvQnoCLRzWX3JJrQYsmNj9vbkU9JFB3efpKnYynpZQRPRQ","slot":83981929},{"blockTime":1625224314,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5nVtg4H9vYsn1gAN29nGvAxrsYnumGAhUHP2jDe73FL6hRCXfLsWNFHGTW66W6SCzfaJZAecdHMQ5oi2DPuZHVMD","slot":83981928},{"blockTime":1625224314,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5x7difuwKJKc8pAyBZVdBrPkNDcyhU1g5Ci6kszJh3pZsxJKbpkGckUs4xSjz3cpe4mWyJg7k8kgF5Wu13rYcTuJ","slot":83981927},{"blockTime":1625224311,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4m5kZ4aYtBMgMp5r9NwWb2phopKo83UVJuefwfNe4jPHkHjWpydgGC6kDrp9mcUodvxz7rawDZJXoUkGii9VcHkN","slot":83981921},{"blockTime":1625224311,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2NCLYFBjhm6bumeVQ1CkecdJqMrGocv8d3XnY9A2ryk2Jz9DUod1MQNFsbyRXBTLz9L82JBEXm2p1ZpGNdmCUBD8","slot":83981921},{"blockTime":1625224309,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3FdD5EUrjkFbPB3YvwpovF7t7NSBXu33b9jajUuSoE8U4HdbX2wnDKroi5CwioxPSjFHxpgedaZRNcrmt3WjNbQ5","slot":83981919},{"blockTime":1625224306,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2WjPxanmrC3eiUcoxcqefPjUBJ2wa1pwFqSmhpR6e1Humv6TJkEqBnhgtNLaiTKqzDFYG5hvL6wUdDfwXw2XeoDN","slot":83981915},{"blockTime":1625224305,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"28yezno52Y1CvmkBsQPP8m6hpR5sK7xCeLh4V4LtrEGmPmQgMWu8vaoEwHFVmXNUrAmSr1UXG5Qj9novjJTwC2Un","slot":83981911},{"blockTime":1625224303,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"59FytoYXkcm5xietGztuMpE13og2fHoAup3SMFQNFp6MeHByM4PNdYR1Ai2X9S32mzoRX1pu4U1bvEXM12N5jcq6","slot":83981909},{"blockTime":1625224300,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"pbgwskcx6mKoNzyCfZdZNoiEVNheBpNfz1dc4iA2KWBaQorRHBXmKkxVcVmcu9iiayJtmYosf51EjKPBAjm5nSk","slot":83981905},{"blockTime":1625224300,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5qdpxPagUM5AVtz2MT2NWvYARjwgXeLMDyQ1WDgeM9c2hCUJR5GRg3mkiggHaNeNy9wYQRqtcuXXEe58XkAECQke","slot":83981904},{"blockTime":1625224300,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"ktvrVrdVqhwjw9F6wu1HBovScdYKNwbpdoPGoQwr8JkjpBkL4RnEV86KJh2qn7yG9YXJFu6t5QMNoEmg8Xj75JA","slot":83981904},{"blockTime":1625224297,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"DA2auvWg2H6E4aJmFrT85TgFCnASMFoLieKeCGAoxwgkJtKkYohCfovGGdPcTMQdaAgZSNqW4ayffRSaVD47irC","slot":83981899},{"blockTime":1625224080,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"kSoykkKXra1EEHNVCzmCbwj8zjsXza9uJ8d15bXcGfuqJJzUatKQEcXyjMLipnTi6rKbtrBxaAXqRdtnbnZ7xdS","slot":83981538},{"blockTime":1625224080,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4DmZezH178ms7874UEUYNFpSSu6JD4t1z1ghQFdLizpNgmMfok6N9G71pe84MVU71scYJWYTeijjnKBN3W2bT8v1","slot":83981537},{"blockTime":1625224072,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2JEhXJCXYHhNDE3ANb3y5Xm43Kw7ndN6bUuthvMYLtio7J6JxsPUzxPXV1Lc3s6N61BCasEXcDPtg2WpS4waTcXq","slot":83981525},{"blockTime":1625224008,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4PSqGiSmzTSN5EkfaQCe5iTkGgFqfFduBAYVFyDByYei6mFnNbVdvs4eEFf5QTDbZjkow9HwJdZFLqjLzVx4KVFK","slot":83981418},{"blockTime":1625224008,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"62MtVqp4wPv4ZsFwAqALtuejHqejwSdByuz7ESqupUuKZoJZJrGpbdwzFNfhcoQs2Jcfvnv1cBeCgDqob9W7oD3s","slot":83981416},{"blockTime":1625224008,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4pgp1BrWT9DKEiM6NG9zLNN9zW3b4tmghrbHyg7V4YmdLCpBZJiXzVPd35GQzXscE8ba2HfgsXV9wyW7iigRrYnt","slot":83981416},{"blockTime":1625224005,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2trTxgB55G4Em48SJHwLW6AxP1Q7i2fsukSXKr5ayR1J6ThHE1Fd3Tvn3rzJjz3thfChdW3Rfoydkmh8Vvbs6aqQ","slot":83981413},{"blockTime":1625224000,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5T4evEsHQed19cBqDrvP5k9XK2gFEFpchCVJeRBvnzeY6PkpxFtiJc4yovhLyTPDLgF9gM31BKDmA45A8gYogRTj","slot":83981404},{"blockTime":1625223999,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3sSixgbRhA4jinSnCwtud75cYWVfSxZWzcPW8heLkGASDrUR4RNDo4QZW3Ei49DBNXVU3UF4XH5SxCoxJwXztTvU","slot":83981403},{"blockTime":1625223999,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"43kA8oMU7TidLPLLGCCk53a8gpSUbQFadG7Hgn13cUCAV74ZJFekrc169E2u95LTj7RmKxiyu3DobuYRBXej2pm7","slot":83981401},{"blockTime":1625223997,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5RjB9LmFTAwbhpdMXprKkHzMVE7x8dwYYSg9NWgX9aVcFLB9bNMYw9Dqv6GeL5v3WnBZdUiiDucuXJoLGgSPnZ26","slot":83981400},{"blockTime":1625223993,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"25yeohM9xxKWYi5X9TqSBmZKKvoVuJ6nxERjJje1CcbCi1ig6woeYdax6MibFZWQQmZ15VioJCexDKgoACZ75Lch","slot":83981393},{"blockTime":1625223993,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4hwEf8miSQ6MBEaT7RN2c4m2h7GXw3sxZSqqAMJFvT3Ynz7PEj1ETFAn71KDYnF3tYmnWbtPxbu627mKKbQ3cbHp","slot":83981391},{"blockTime":1625223990,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5QZB7ck6aSF91NHgu5MQgrDzrSvv3WzBjPcKUSqgX1bc97DPD43o1gLpaTx96bTqmuCCTtJbpXEm2sLxBfk6FAys","slot":83981388},{"blockTime":1625223990,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3auL6eK3KJwtt32yZGsVoH7Vp8spb4ZfuU3u7NNjU73eYgESeAhAwYdtwzBRzwfqdswDWAPSCxYvFTcJmJVeA7WY","slot":83981388},{"blockTime":1625223990,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"rHKYyLacPgeguC6KaZgqMTFYtCBNHnFUg1n4DFv5fbLP84sY8jphGioeC2N2HyBTUExgwMh6di77xDF4cfrVGxA","slot":83981388},{"blockTime":1625223987,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5fMAoXFFUjB35Bm7eFYY7XuHKKopx11Xg18Rrzx12VuBwwyinEFF9PViGNCwkviMSB7E2MwrJLVjD4w27ASyfrbT","slot":83981381},{"blockTime":1625223984,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"nkDRX4f7qYER97bKRgjdDXvarovHM4JXB2B132xUHrP8K8Xvg9mUe6UNmuo68D6baafpaPZhd6hAGdua5C74sbM","slot":83981378},{"blockTime":1625223981,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"HV6zrsM9xB78j6cGBD5YRNQP3zmaRASeoLp7CxkUA8E4tL5HUqDP5veEazYwEXBBs2NQDTRXzrzkfoceUQ4d3L6","slot":83981373},{"blockTime":1625223981,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5dAHvXd2uJYeLvQxeMP8Z114FoBdUyTcrRx8zvKBjpi7DUtqNFFUzDhMSd1Bwq6Ein7mMBjLNK1p7GBQY3QgG6Pv","slot":83981372},{"blockTime":1625223981,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4sTf4LhxpTkgy8TKXosiej9iH1hjXmwEERQwAZVAhqdzHEEnh1BXDfqFHLUYFsDyndB23asXKTBhYXmSF43BaAm5","slot":83981372},{"blockTime":1625223981,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3pN9g5krgA9BfQFxLjFLPvbXLmerj2DFdgcGmJdbkhNt5TugCApjVFjBr8DKLZxV1kdPiYEq9Ng9jzWeKrgBuScr","slot":83981372},{"blockTime":1625223978,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3pceFJkCs9B1uLi5Q3wRRnHqAXLomQTfv3cJvPN2Fd5th22VRgDimpWt2PVAnrGJskhQsuzGu9oNt6uWqnCJoqTB","slot":83981367},{"blockTime":1625223975,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"yioySKmC3Ry1FaWgvbjX462w7PnHUcggwZtKGtxE4Ddb3bpkMcDqPMPcvHuLzU3qp9KQpgmCQpdU5qDk8bQot8j","slot":83981363},{"blockTime":1625223909,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3YbdrRRSdL3Bmmi9N2EPWYsHzmU6ardNAqD2GMJpkuKaSSq5ALPjtGW18DrhpP3Rq4KMAbkjiKF1BpyAcHteVV16","slot":83981252},{"blockTime":1625223906,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"CoRBfVkiUAu3trHjLzxhHpC9E7b22WAiCxrEdqGfZTV1ih1rQWQp9bAeCwFKkzjRyvpj6YtzB24Ck8TiX7JWG7z","slot":83981247},{"blockTime":1625223904,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3VpLHDuLRYF2ajESptDpXbYEbFGW6UBJNV3shutmrpgTp339u7AfeMwBD5huugHxTLNepiyPrEjhwzoJV5ZGgW9b","slot":83981244},{"blockTime":1625223904,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3BhmriCcPjAtDBYLPuhXKQmcsg8ygVVw1DzbvzYGyWjcGYdazvB6PQr8rFkhM8NDrie7TBj2NCofXVZH7mRT5XxT","slot":83981244},{"blockTime":1625223904,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2HdGU7NYKav95oCcgbye4L3AgoY9Cdo5RpfmJbBkXJvQf1PpLeFFqEmezRhXkUoBx2JpSPfz3NBLaEVqe9pgCzgZ","slot":83981244},{"blockTime":1625223904,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"R155XszKti2tSL95Dcw6nt3ZZkoVZkh9BoAZrw46MVXW9YiGycjMFN9NAQex9npKcR3emR5XCXxc8T3TZUryj32","slot":83981244},{"blockTime":1625223900,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2sa4wppQ9f8M3kBwLGExhHn25jzuFumjbKRNC4QXnyo7hwYcdicLbooVrzE8MkdNPA5M8iFdLgjM93UrCw1Xa8gq","slot":83981238},{"blockTime":1625223898,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2SaXSeeQMjaT568ioj6kiKhHhYgcxiqQMHXz8Rd6qF7oTYErrPqxAMRRCivXgQT9X6R1jBayxbGzAY3ngB4zgL97","slot":83981235},{"blockTime":1625223895,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2w2uoSUG6ufaLNPqGUnRPNqq2CNuzrkqsZmsCyS2J9cpYdRGjxX7hHE4EU2bt9M9359oRN2X99h3QyNApaj6D3MD","slot":83981229},{"blockTime":1625223894,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4sALKdUEGU8HVbLPgSnf2qj3SVPTUazjSGMyCJuLBYVWejy5ywLEpZRekq8dU5wwJp1QVYhpw494n7h4u7atmVT3","slot":83981228},{"blockTime":1625223894,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4kh8SoZBX33vXuhLB4h4VgCWfbG33xQDNFH5s6EWt4tcJY7tfXJKFw1PJZrbzvwv5nPdD7F6cPDejXnKHWyCT7hd","slot":83981228},{"blockTime":1625223894,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2YdL5uYW5L8aW2mANSkuovKX1Eo8Q2oZ8npLWmjfWwYjBPVYLdDZx3KNJrfWqtJV19LQsUZHdKdUYiQoUDxDTB56","slot":83981228},{"blockTime":1625223894,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"HsQsRZG29zwLSWFt3hjfurnK69AQHZUiEhumdHKzyAE3jkN28fPcRb4ezUNoJEgsGM7fxtbzo4B747vWpxz9kC8","slot":83981228},{"blockTime":1625223891,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"HsGMj3KmTWiyAmTyJW3nhBMCCn5BqMfvEdjdBFMUBgc2RxBUTx7oYPrsJ6K8SXKfM2KtuQaxhbZJPokQeQxwYfE","slot":83981223},{"blockTime":1625223891,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"M2d1dWLts72xJ4WMf3B65tCXitbsBMAj2RLrFXMyDy8LcSgjUgi5gtbpfGa5uchmWakqaqPuoLW2ZmxJhZ8CUjL","slot":83981221},{"blockTime":1625223889,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2zpVFwnGJNmbmyVFQ7FB4FywUM7ozg7HzszS4Acts6f4mZhpJrcRPhGYJoSK2uvvJug3Ug9mtWxHpuwXjUjuW67q","slot":83981220},{"blockTime":1625223888,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Jf5EfQa1bgqYzFcLwDpza2Bneo3p6yUMQMjQSbcLHYhkJCvYTk5zjhUv7tCT2csyLH7JT7S7B5j5UECJcGFmJQH","slot":83981218},{"blockTime":1625223886,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"SPBSJrLVixQ2LgP51dWL196gAj2FeBfCCh3m8ThTbV5haWZCizVVARkXEmYLDuSHtAWeGBU6RZzSuofgXdyTHSK","slot":83981214},{"blockTime":1625223841,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4iYzpTPeEoWgLDCCc4EL5qiZRmZ5tDCTxZQ8VC5nmqt5Yyfe1BKrM5snKa78PaZAC9qNA7cz5zXseR7rn2S2g6Ht","slot":83981140},{"blockTime":1625223841,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4EMbhk1NYySHRygXt2FLeXf1n4FdSAyWtMbHc1wZASz3nWCyuyzoJjQ4R2sMrWJEGjdt3MCuTmmXdacgfYNTqJMb","slot":83981140},{"blockTime":1625223841,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"v4eA1dT6r9WGLdCeSA5JhzBZ2xsk7hu8xPVgYWXvPkjLUmsywEr5Tc1r1NfjWzcWD8B3sHrEL9d7okakHHZGJbU","slot":83981140},{"blockTime":1625223838,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3zzjXyfk7bowKos3Ex63Phw4bYofQH8eycsVxhficgbrYNvm9xL2UrFbgCtXatPbhSfx8mBSjbWFASDXU8VkoeFD","slot":83981134},{"blockTime":1625223837,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5VX5TBC7S4VStmWAotNigptQXTskNhHAUpDKXFvqvLNgZ6jpB7uVNGBw1XKXFUESSTTmCDFM5HYRo6YHqcm6cmDV","slot":83981132},{"blockTime":1625223837,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4zdgfgtqSmmg6UpiicTSYHoDLvDbRfsnVPmVFgtA1WZLmoWCSrHS8PdistmDNhdGHP2BG5ZPhZN1FFGw2YJvBKWo","slot":83981132},{"blockTime":1625223835,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3EpUazUP8G81nwZARXTPuvXvsH1nrDwDTtYa18ysbwNe8o9uE9jUChjUNzkafwiyEvFowzgmN8U3Vvt4dQE39QxH","slot":83981130},{"blockTime":1625223832,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3rJWs7Av6GVS683e5pYvTfUkmkkmccWQsuYaRnKQWdJhi8o9zptt2NwPzc3wgd9c9bG6whhByAbAinSYanpmzHqc","slot":83981125},{"blockTime":1625223832,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"ajZG3Sqd6zpzMkWQBwv25NJSVZNm4bbkis9bope38jt4Domnd586yL1ULAtkiAzcA8gaT9u31SnQ5cGRFhjzCsW","slot":83981125},{"blockTime":1625223831,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3nxtxPo6J1zGkkfDz1h63RBzP962BFmru6t3tWRtFgbBQ8zfSmdpPKgixHKP3nbCUb9sm2Rvy4GxZs6s39vD98XX","slot":83981123},{"blockTime":1625223829,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5mMbYyFTnmkaCadBAAb2qMwtnfAS1Ta2at1yPe5HsByuHj1pHrouCjfeyYdtQ3UgdqdkUpu1hLJkBbog5KPL6b12","slot":83981120},{"blockTime":1625223829,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2EMXLaPUqNbwoDbBZsymeDuQt2nLJfSHwcG72qXP8hyNdrVMu3JRYpscuuM2k1biRVjxvHXfh5oCv4m9EbxanRvk","slot":83981120},{"blockTime":1625223828,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"22HwrrAua7McsBb44Seqznvw8EaentMig6qcrx64CbwgUUTvxD2av4eQhCNYirwgQ7tQfgaAJLebZGecHQjwbpSL","slot":83981117},{"blockTime":1625223828,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"21taaFWuuYxPX1me9byaiFQZ4Yh2fR2HVvSbhfxtUEN3GCF9Q8Wbtm6KujE74ZgNwVzDj7gBZCfXkcERSYKNz9hY","slot":83981116},{"blockTime":1625223826,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2H7RDJeZmP7ac54p6GZ2acoup5p8VdZ8mbjGvArepSai64AM37RqQQXjPBZYe5amZFsCg4F2536CG5TLceKTcQtc","slot":83981114},{"blockTime":1625223825,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5mYQDVbS7wUX2cWBvRyNoQN1MpCEoxbu84ZXu4Z7L7Pac27weBYLjtAxC2yEkgTJxN1bKEigkzUgvQiYthfo2zk1","slot":83981112},{"blockTime":1625223825,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"RTWQbc8a9Crw656xWz1ZpjTZKzWGnGE2TQMguePv5b93CGKRb6JKqPS94W7Qa9w3LWXgNrq1LopCVpXGLqvPyEx","slot":83981112},{"blockTime":1625223822,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3rP87jupiXp6NLemY1Wv1CSkNDpidoEhMDEKyyvcQ6HR4NdLYZdY5b5AohpjoN4bSASp9XPKuRJ9rqEXP3t1hziV","slot":83981107},{"blockTime":1625223820,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"46wvDnL8k7CntNF8gWzpqS7tBuseHJev6gfK25PbcVQA2MUDbv4PNENhJK6uVojo7DmBwsBHeRa7HhqkUnfJPRfH","slot":83981105},{"blockTime":1625223819,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"39myVXcd5QfgkCSuPu5LrMWtrfZdXF89xneWyLucsb2kVbL28uLaiKHsRXYZnXkWie4GKL9HXovCoFQDnyDPuAw6","slot":83981103},{"blockTime":1625223571,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5jqHkrBgQs2BNyqaw1dPxmFLvkSXHGeK5PX6oDrSYR8ULHL4N1PEN47nq1CX8etU85kRjXma8rgkCLy1Aj7i3Kbi","slot":83980690},{"blockTime":1625223571,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5bVxw2m4G9AQhpRwFqWDThscTK8CyQXH1pwio4MLr9azXQTtBmCJ1VvXVkZ4mAS8FZmQgKWwj6vtAPZP7j5TAVgt","slot":83980689},{"blockTime":1625223567,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3DVFQeUB4Q4JjQLtvcq31Cp7q1G2uoHFiGeVbqMANoLo8hcrzp7TWsxUNcKrWYWj2eor32EdFZdGjHQF36RqvtBN","slot":83980683},{"blockTime":1625223567,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5cAXRiAfznbRMhYyDGCBn12AseFcPhbSukYAH1XKTKVGgQ6eS3Qnm7JRFzDZpmBojY3WZxjKRjrfQAgoMXUDHbCY","slot":83980681},{"blockTime":1625223565,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"6FmrADBYLU4sASWUKFK5AkmpJwSumvmrXwGJp5ybTLUwzffy8128V5wbRvUiz6ionuj6TWvPBGiEyRxDrxLpXCf","slot":83980679},{"blockTime":1625223564,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"pyR4vqwj5qSKuRj9Q47s5V5QL7FZigPA9WjsMJYZq6YFVd8Hw3sq2swLrkMpNEUz1VVLHjHt9S7i7xqpCWFKfCg","slot":83980677},{"blockTime":1625223562,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Rqu1Z9MpNJNhdhyZAdLRiL3inQ7rKinbLg5RLoByEs4fDSzt245vD4c55PGSRkjyWni56s4e4fiE49637zTvF8","slot":83980674},{"blockTime":1625223561,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"42FE8K95qkEDEfWUGBiLPBszKtpz5vxFM6goW58fMKWuqKo29idMe964pivdmppch4L1UsqTqDsnfegGm5Kjv84u","slot":83980673},{"blockTime":1625223561,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2kfWuVZNbkK3X3d2W6wbthnSFZvzCYiKK1a5HmuTGhiJn6KezuGz8n9h1Xtu3HX6aizEqKbZbQGeim7vZp2YQmA","slot":83980672},{"blockTime":1625223558,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"26vQXCbquonBWJ5bhcxsnGHaDx81XvksfmS4kse5bX5UhpyYQNhHbY7q9EVq4uP4D8JVXWSnYCt8JNJRkTkev5v1","slot":83980666},{"blockTime":1625223556,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3TXDJ8dDSeW3YcvcG5CxHGXTXy1rYnfm9dVYALGbHxwCtqb8cSJrjXwVJ1jR4Ci6R6KyEFWjjiZmfdwEfv8ZQBws","slot":83980665},{"blockTime":1625223556,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"FGcUFRVG6uJpToSnNApoPpiP51VS8xjMuDdDBRrtKeQZwX8ZASjNWiFmkWoXppz85SH7CLnYhX6KS5zGS5ekdfF","slot":83980665},{"blockTime":1625223556,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"39zwAQsy4JAhnkeDoC5YtA6yf6BVb6YLYz2dsMPj4Sj1ErBsuLsGmBTn6nsLEAVZFHa8kJkNpUwUwQBmeuD5CrYk","slot":83980664},{"blockTime":1625223555,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"26TcMCKY1mLkoLQcqYZYM6nfcnHVu4u3e6Dj1GAb8bNRevWZ1A8gJqcLgm8G8Z8v8pzeqsGaXZMqsWYhwXXGRsvN","slot":83980662},{"blockTime":1625223555,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3brcoQUDgwxxP67AV5rFrmrEtfzWcHaJdrajJhnKFDLBRVcuXw8mr6W7y1ravJLruZFj14z1ySfxZHyz3pq3kLMv","slot":83980661},{"blockTime":1625223471,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5CXt4cDacfKaJBZE9jimh8SMLwgv3n6DGio6PmPuKurAbRpK491kx7LuK44aPHW8CfX62QRJiTY7cZ6N6wkcjPUk","slot":83980522},{"blockTime":1625223471,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4u9fV3DPNVAt8RNDcLdB9sLWg6SmR3iHCnTyfwFbj7Gr1DwjAbvpaj8xLGHR7u3NJgtbg21ECT9tYVJtKyg51JT","slot":83980522},{"blockTime":1625223469,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4dtbgvuJYDeCWFYmTfF3ffJHa4kLULKbh9AAQ4cqKDjL2m2zdCxh2thkszHiyppdaXvfJDRpoHXCA9TYbU8JyQyj","slot":83980520},{"blockTime":1625223469,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3tSnhFwtzELkegeStQ7piiHKbLbcyFFntPd1nTbjndoaczP52bXLKMNYQHB7RbEdJVJ7mbHHtWxJaTeoojB6aQjN","slot":83980520},{"blockTime":1625223469,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Fz9eemrfZoa2NHUFtcATmL7snQ37ckMEAaTUjCiJGERcLuY28PW9xiznbaGkEJzYWMVRXxP9dXkUkUXe921aspN","slot":83980520},{"blockTime":1625223466,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2tH4NDyLSmAGg9uQRnNbrtLTbMQK6srrVrb2Tk6eZqv1ZrCKRU2AaNszWaudNTDidPCZ2WBzpMxVgoKK3JUzvyAb","slot":83980514},{"blockTime":1625223465,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5QYhxuuj3CQCbYiVPw5KASQicCWUUJsigpzXBhksJ8PFJAzoyKme2dgey7JgkvHfAXYpD7hxpNP9SmhZWUXA1aj","slot":83980513},{"blockTime":1625223465,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"23kD7i4LsSPoX34jWdCQJafYYJXdzUs4gAJqziQKuwsrYWxBcK7oypMUkeBdcmopAkhkstRj7c3cRAsmWfvJPWBF","slot":83980512},{"blockTime":1625223465,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"dChJmip17XUdupNrNG4Cx4v9SpJqrXGyoxxJbXsLpnoq1fXGtds3m5DNBzKpTuSPnr5s8oUxF75vDYz6GqEkfbp","slot":83980512},{"blockTime":1625223463,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3C5tcg6wqA11JJFWnF92GBj6SRK58iXDQMxib3VCyD4Em87pif3GxKaxrvFyXmKybhg3CWn8pn1XvDJuYeRKkt6W","slot":83980510},{"blockTime":1625223462,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3A34bdLchxDjuSCSmWqvvtCTdumwdf41yU7BpeXKcEPqC6TVqPQCjwyu1hJLZbtgTP8P1efDpwKY63HWwwFFVGVT","slot":83980506},{"blockTime":1625223459,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2h68HfVwAKsSEGzE6ddvXympMnrba6mhz8AZHCDw7w3PsH1BzhDXoPut88moPfR4WRMZNmBFNuGPVntVAeveLW4X","slot":83980503},{"blockTime":1625223457,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"vPrLEXcW51QHqj7XaXdi83JgEPBsvgr6gobbTdkTYBiwotR6kkikniocTAvNmv72zrf4jDaNHhCAdD672qd7pNs","slot":83980499},{"blockTime":1625223456,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4TmfnmUG6toVPM9kSm5aepcaocR1nRtGFPSxT1BboF1QytZHq8HVcz3oKQWKf46aHEqsMKaGTQn1Yo8cXZSUc9AL","slot":83980497},{"blockTime":1625223456,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"323ucXJA5qbsmc83KSpDMhmYCjn5Mzjt5vCdKLPQ42PGwy7iRntJeYy9or4U6xoGzuPR5nL8FXdUTCm7pZk7SsUz","slot":83980497},{"blockTime":1625218707,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4iyycoYxC6adBn3TUVYjaXi69gKrvek8jE8X6mqtR2iuptyoNuqmeEHk2wBHciBBQiJXtLTDhh1p2KfG2rvApfby","slot":83972581},{"blockTime":1625218707,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ZdjbjJMVXG9Y39gr7Ly4GGB1z3rEaYEgprxPmx375TMYCjqaTHXB5gvffuQtcYY9zSbdyJ85jVNvyHexJwMvycx","slot":83972581},{"blockTime":1625218699,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"45burhLt9ocU71T6N5EPigY85TL6xDVjCugQrcZGGYWgs1EVU5HuC7MTvSg3cYfH6z3ruVq91nHsbLCotj7UPaxN","slot":83972569},{"blockTime":1625218699,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"vWNd8teWPJWuVUkYXKrfsRDEBTwsLjQmTxkVZbV58qXga3DJdLGX8ex9EQShcqWmdTVtGFK3HBXZFfxt9jT5F5j","slot":83972569},{"blockTime":1625218698,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3YmWTKdb8A2uczrhc4sdQRSsnpzodpwEKGevQA5b31NgZRPqrprriXwJVAiD64FryMY21CTyoD6ZJ5YiDAoyP6wj","slot":83972568},{"blockTime":1625218695,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"48zRwzKVocYRxJXFXzw3dvsQmVky87gge3DSuGYccSG3AKjhDwrnszPWg3D2KFiyyuaiQbcdYZkkRccWYN9X7cj9","slot":83972561},{"blockTime":1625218693,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5gqy32q1MA4J8m7d9cG3hnXMJADmgBZbWd9tbtmxdkvT7QXzNK6JEEXxVLfpxM9BncLBBh7zEDr2XTj7XAoeR3jd","slot":83972560},{"blockTime":1625218693,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3qVKzGSWmrzTy5LpGXFJYRH9rCEEJftLK9uhNiV8NLSUBbFNLAKLTcDdCTXYhbHCBvFN7yrB1kJx5fxHt78hAX3H","slot":83972560},{"blockTime":1625218693,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2wMKb1PZYti4CJbGr946VSXsxU48ptSqxDaLQAiRCzoFJbtGhLXc1W7Gr3ysbi7SBzoLEyYWFAdvLQCfwztWRkFR","slot":83972560},{"blockTime":1625218690,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ps8CcyutqgLjtyXnAoCTjPWyCohqNT1se8z6QPFcT79YxdbzFb7vP7wZoaQUvKCytLDgNJbDi7hUFaCQuSae15Z","slot":83972555},{"blockTime":1625218689,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3GBhXyLajxTQXam9Yiww7hTzVbYecv8BT8RReXSkbAwVF4ZiatmrqQwXtRAgYiU4RYDo2jUhB1WKdwqzkXphWS1Z","slot":83972553},{"blockTime":1625218686,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5y8KbVmNrGyVMtQVxQoXbPYovnmnyimX9jPKctei8Lvmk1CTzAWm1SRetr4xEpnJ4WrskKR9bjqfbTTG5qs9WBng","slot":83972548},{"blockTime":1625218686,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5m972ZujDLWyvaMeDXiQtFiPjvTv7c2PYygRdF55fasqxFNizyE5juQpvodgaMN4z5ymxfFaxjE9m71iYebsvsoo","slot":83972548},{"blockTime":1625218686,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2azhXrkkQfQG1RV4TJTBkjPSNKTw2uo5Tsk2Lj5n9GXT8oUeyR7nNCtPCrse8R43hTFitLSRGpZA7VepQwSe115x","slot":83972548},{"blockTime":1625218686,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5CPefL5q1RT8Q7S3MfJbdBmJeWsaXxaj6wdkV5caMxuqvJUtmVDQnJh1mfXmUhjdeAN7jK92dZMwECdz2K1h7kGm","slot":83972546},{"blockTime":1625218638,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"48w1K6xRVu3wyMFz3FeicezEAu2FYfqnQz6Ce8u2bMv7niyiDGsLzb1qeTJm797ke2Ww5X1RhufSQ8iyYjT3VYJM","slot":83972468},{"blockTime":1625218638,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2HjrxyhBGca5rECbncRaxiVaoVjo8hAQMg7JeQev4GNXhA3EE3FfsneUdLPeYPBzuX2nSR8zsTwwz4nzVY2ccU6E","slot":83972467},{"blockTime":1625218635,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4mUybRz93e5Da7tgxtjPejTjPpfzaPT5vHAy9BvRvaPjZaEU45Af57U1ZU5Aergn1tMrRmY9QZbD7PYa7JT7Hgcw","slot":83972463},{"blockTime":1625218635,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3YsqsKoQXsr4KeEB2YjUVQjuut9oQsy7uRfF7pgAsBCa5RSy3V3DmYkUybKELbSbwjDrVNKmzypw2Vhe3mrfo65u","slot":83972461},{"blockTime":1625218633,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5msYgx34hW9P6PH6PG26t4JErg3vVtK4ui2kqWPJpatBGWAYdqrJz2H3HYVwW2t9yA6eE3iv1BhCXLVnKAUGT5LG","slot":83972459},{"blockTime":1625218524,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ncyTsuPu2LMJ88Hv3UrtdtAFaXBvMvzBUhr27LqHTTX7vSbe8zYPLEYfBdfBYHAwVbgfS16zBwVCNuQbV5LisT2","slot":83972277},{"blockTime":1625218522,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4fwmdwuSm4AGYgyqtWpePjBDBZGAzZ53XSVsKdvDq67ntq4GAH78HRDS5ggLABUCoM33HhTkzHrsd4vW8CEhbiK6","slot":83972275},{"blockTime":1625218522,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Y4pn7QnjJ8MMqftJ6a1XkkDdDCfAAmbyDMFD6fruYW2ij3GtRNwa3tuq1vSnXN9FWZBJPZmAkUuvkEKHPqA6N6Z","slot":83972275},{"blockTime":1625218521,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"7mKkvCJjmdTnPFyEZynLRDjGG4PaHxYSxtDKteVpCkjpo1TJZKxrrc9ogiUahTok6CvoTYzoy8XdeJbuEMapxYR","slot":83972272},{"blockTime":1625218518,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3aTi6gQiGwjYFfq5fGUDKLZT873qskeRgY9DQZQQD9x4tsaKhUjUA48cSb5jB8yw3tTEYDCAsQrsQjfywG9iwBDb","slot":83972266},{"blockTime":1625218516,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"54stjXbCLbartJfbkfmcnJowgoT2ztTmpNu3FPZ1HLn4LPgYRmmvCooZL2SdHzbZcbSokkoT1bDUupWRhvJQEykP","slot":83972265},{"blockTime":1625218516,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4HusDeRxPggsoPL56gEyzonWSjeRDpB385R5zfWENo1S9Nt2DiNdjFuUrgytjosFxxK7RamHR851DtY3EqkontaX","slot":83972264},{"blockTime":1625218516,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"27KQHSytQde2ELoHVo2DBKXp9EasUiiLRznS7xAiscwLNzV39BwXvmhn9Eb1y8Gqp1gjxYS17pkzmMaMvu2N66KD","slot":83972264},{"blockTime":1625218515,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5V2fXqzpiiwSeFsU2vGjNkrqveXoLDEDjK33Dm29Sxnrbzbr7VvGoiY5HkuYvUMamoKggQG6J3zzu84GPbug4WA7","slot":83972262},{"blockTime":1625218515,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5DGai6EB9wwnf43WJzYCwjs6QReKGFwcmCWHVYhZH3PXwvR6iZHB2rDxXW8A1YhPXKtJcwNXJtp36boLWiahCRvM","slot":83972261},{"blockTime":1625218513,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5PnHDQmUdWwSGAPWHLRAwJowoxZ1WxwSiJw5hmcLNm5i5AC3YFncicGDZWc8ji5hubQDT88cqaEpLiLqQNXh5dUx","slot":83972259},{"blockTime":1625218512,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3knxZ69KiArAskUMEpFpqeRKAxAmrbTjjHrhytm6F7n5ipUQm5uLEr4E2DiXdUqscBTK6smJZv47Xw58qyEshbbE","slot":83972256},{"blockTime":1625218509,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4jqiyvAkLy8gWMMUoeHk641Kb43vr1oUpTKBQRumpEcr3wBeqtigk9HktoiacxMTY6yiBdHbfZmDMFBMX21RirqN","slot":83972252},{"blockTime":1625218504,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Rmrds41TgPrpLfFBggGJVN9aEh2cqK1mtv5PuELrjMyQtzTdhFi4BpTc58UvyyYnnVQsb53UnZddSz65b3tQPGA","slot":83972245},{"blockTime":1625218504,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ihtWc3zAQAgjuC8gwsK5F1wp6GfzVWAbD9tT3acjxgohe1JaA1Eh99kyKzCYL4bUccdTrh9nGxLwNioXLFXxcUE","slot":83972244},{"blockTime":1625218450,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"59pRw2aFS217yZxtwHBvL774cYoFguLnfzs3Ewe8281Vt1GeQTxhUuuyMthpGD39maR6JMEmWkFDQr7H8DUMoDeZ","slot":83972154},{"blockTime":1625218437,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3kyt3aJYKA4ALhWNsMgHH9SvvMcJKp8FrUDgzmxunvjE2RrkUNRJqN2jNXA7pTKxmDi3BWXEHwsYFUrTvc2HurrJ","slot":83972132},{"blockTime":1625218395,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3iPE7t6frdmQR1NShbh97zEwB8M2S1kc2vkiCF6xMNzErP1CpWdvkBEU99VXm5CLXtQSZqMVh9pdGDZ3jWHtVQkw","slot":83972062},{"blockTime":1625218107,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Kjj8AiLpqFDqdEdDKMJsjqadtzzB5rFwerk2MvFq1EN4sNS4v7mcG4ZurnPRz2PZaPtAtvmHFskVtPQ6pUamM1m","slot":83971582},{"blockTime":1625218105,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3GTy56tRpR1s4CzZftqCtWSRqKtUjCpCcWk49HfMjc5Jhqn8PtBoVKbuPHMhUJa13N5Ha7UbMPFEeywm6pguQGsc","slot":83971580},{"blockTime":1625218105,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"29xCBKguVCf6Yzaghu2hNB8FMHcW2amodsd8tKQrYSe7zU7C4Qc8spKSCFLs7XnWAZWN2sJ6YxsUHvyYvthixzox","slot":83971580},{"blockTime":1625218105,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Xgdrb4uCKpdoX7uThaBypgedMDLdLw1bNXCqijRQnyrcprFkDRWYKNoohrULcqE2K5G3H7Rd6JTR5nRGvS97oHa","slot":83971580},{"blockTime":1625218105,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4d1khQHL8fFku4FuRsiXy8H3uSaaQgbnMukBcUQNbYtiWChC5UiybTy566pec387kJfLCeBNhdBDCmsHFrFp1Mf","slot":83971580},{"blockTime":1625218101,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5LhKCSy5N63ExBH39PW5XTXXFQGV3QQ4xQCB3KvVz34o1eHPBBJgq5tQwiWFfxAZGimw47SEdeLd5SxhkLDjtJBG","slot":83971573},{"blockTime":1625218101,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ty5menKDXpqaHvic9Sc3WGCdffDpnCzfMzEaVvjAtMiDNnKYCdGBf9MGBvfAa4LMPhx6uWRgfZwDN8qVJ3Jd4Qz","slot":83971573},{"blockTime":1625218101,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"558FPCkku1E2v16TDAVcRikoxsWqZaAC1Sp51HQrCVoPSZktRsA226qzAp67k7HQG5fpS2Z9fKpnn2T9rY5guxc9","slot":83971572},{"blockTime":1625218101,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"43aiYixNnR2KENtNDiqiTWVHQGmGFWK6RbUcsWvXxkPLPQGSnpFD6QocvUEVguoLY5XFRqN3Df7QDsxiv8NyoEhN","slot":83971572},{"blockTime":1625218101,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"59RSi19h6dxyfmJKjYoCiU93i59EiSEXQorWnoHsxXLfpTR9hhZuk9vh4zSHBPPqMACHaAZNpNp5eEH8UYhNMxow","slot":83971571},{"blockTime":1625218051,"confirmationStatus":"finalized","err":{"InstructionError":[0,{"Custom":21}]},"memo":null,"signature":"56GwQaUZWAH3HhxKgUE5NtAgXYA7KRZTjoBSMfEo16fKiYM6yWtcH9EaHY61ccvfZX6b7qjtYwxoGSsL5UWjUiVr","slot":83971490},{"blockTime":1625218050,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5aBWZhu6KPZXq7UTgDQFa6x4sFqEMvJgbGaCovkLUDxkZ2n2WMjur6wsrB8SFTpDKm5p1nin1tvBzBZgKz1fNKaY","slot":83971486},{"blockTime":1625218047,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2HHwRUV8XmhpApqW3xf8NXWwQv9CdDJS3WGKHH3FeqW1howDrzAFGMhbnFHEqBNrEgSSs5cKrCbdEr7oft7bxMXt","slot":83971483},{"blockTime":1625218047,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5MWCeLRWLVYJtxHcQ8TrXZpJVqiUa731Tvmmb1PkxoEmCiuAQc8Fo6xquvvQNKoe8nCcwPJM8qmmyrnb216xgt8d","slot":83971481},{"blockTime":1625218045,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3eKThS1GAXbFuJAZNhp9n4612aeNFNv5dor9utR2pvDy5VRR5jNcUPcLk3yeAv1cdsVAE8YdAJkuoGLxmxuWCHJf","slot":83971480},{"blockTime":1625218045,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2N2QC6MgsqD3B5daaBnvX6sFZFuFAo8DWZzW5hoqbDGb8BMPCa3NkF9eqyHB72AqkCCKqfhBj1d9tjdgghjG3yYZ","slot":83971480},{"blockTime":1625218042,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Tk7BumroQVyULa9YEEGFSJi31U6SJLNvnBU4UFutZDkWAU2hCzryjDs9B85hLnLJjTXwnzf1jE4UEhTEvRiekpz","slot":83971474},{"blockTime":1625218041,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"25e4cS3TvPeKFQBCNMjUqbssdx65EHNuSNjk7KuPkFWbmZLrVjch6uWj8sZ29KzyKcfSsaA7sfELj59sjdDVAwkC","slot":83971473},{"blockTime":1625218041,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"26H78B9j2GaM1g6DTxD9YqPqrf7ewKZicJQj5oXrMmPSmBPKfJqYEM8EVokkqxP8UYrNCU8dDD5otLRjGqYn7ncj","slot":83971472},{"blockTime":1625218041,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"SbuqUvfzdBWfT34dB6paGRjKXQDjrPzQCpC4wtdRUpMEFmgW1Yj2Tmy6rz2nFpsVvxWySAKi4vPJSU9ngs9UnBz","slot":83971472},{"blockTime":1625218021,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5wfeHpxNyjZb5UdZa4rDnWCFmUfH6yNWQ7NwMLRn4Beo9abNjKuRzWLLNYV9xg2gLzvFPBL9ynkdXLwPDHo9KSLX","slot":83971440},{"blockTime":1625217466,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"66nHfoYh8hQuM5Qd49A8PsNVvRRxLpgaHx2TekpisW99sFNX3KE27osBGBsSrgJ5r2QajHs7BQgNPBPehzBSB5yg","slot":83970514},{"blockTime":1625217465,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2LVe1aHXkacNVuj1CPvFSyDHfV17fEYrzyJDG6BE3hpGjyadfBDLsLTxCNq5YuHk6Pf4i345XzQYXy1Eoj39micu","slot":83970512},{"blockTime":1625217462,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5BmhTGJPtu8VpFnDywpet9jnRJE6idq9cHCrDhpyr9HsgMJ6XLsx8zLJtS32RQHFfp7bfonTrEsGwwSfycYN9ogA","slot":83970508},{"blockTime":1625217460,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Yd7zquS3FRuGmjiCZJbvapBQpKsQDZGnmrRN9E4t3fRCTBJKCaBVRko4s7XRX8i7EVj35ngQWrYZ8eZc7CSZ7bG","slot":83970505},{"blockTime":1625217459,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"fX2DeLhg3wzFrTjaRzgmUqczMtLJPecZFDdazk6rwFTzssqXRaQeE5uQSirdfN3EWx2Y16B7kLCMHPPeFAFJc9h","slot":83970502},{"blockTime":1625217457,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3AwVWoT27hTwLqR85tZAbZHz2zt38rnPkvTvWwVgzuneiyVHXq6j6gxCJaUQTC6BFmW1L8UChpXbBtceD6NXeUrT","slot":83970500},{"blockTime":1625217414,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Dds39LroA6pHLfSqeXJWhA5uVisyWgiu8nDSZKKgPMRKuAxKzSZpyTjJH5MLzpkqHyANxXubKajAtGs75dgYkYo","slot":83970427},{"blockTime":1625217414,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2wCMbAhSCDQ7eTNd9gHB1EsB4HWpeL9EDGpY4P15MZvqY33JuEpaoqLRUBFqDNZTcawUGaoFZeA2gcUxPvQYCcDa","slot":83970427},{"blockTime":1625217412,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Q9Aid6EBG9NXA8qLEUhSLFCf9iRPKn9V3W6tGMJHum9TXHtaiHsifL9QxNHtpvu2SRCF6Qh48jaDRoegbbcQQpU","slot":83970424},{"blockTime":1625217412,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2xgwBwRUDU8uq8SZJMHUUaPucBNL1RXYMwCa2SCmkbPWbRcnB7pqKQNFbeZ5WfxXJAY3QkrA5QAjNhtwWpfi59SA","slot":83970424},{"blockTime":1625217412,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2WUSUkszEQEcASdmBcaXdEniFk9nFVaBrtgnpQepx5rUecE9uomV4mGiYP99ZCg3mqRfX9NNwCoeLELDFE3MivQx","slot":83970424},{"blockTime":1625217220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2iQmrfoDZvyuopzr22rYocCfPK7eYnZTDpd1vugkbhCWDZtSXQ6svLH18Wr3GkSJqF35FYwBCjbUeYNPrb2He8H2","slot":83970105},{"blockTime":1625217220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5PCDQA8GYqwiQi6A2aZhc5dT44ixjMgyJ9Tbt6JeGcHa3Y7R52pPydrwuDHY6C4TG6Hk6ZLKhJAppk7B2SUJmb3v","slot":83970104},{"blockTime":1625217220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3A5sgUXHjeLPSPkNRumjG2mWqpB39X1jXbjsxyTFQW3zdW8QbUa5KDiq6Rx4UQXPzRZmkHBHEYfkaotdsikzLevm","slot":83970104},{"blockTime":1625217220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2MeRc5dUfrcsme86xRrjcD5bBVvt7A48636yZKYmyLXcYfYwhwPFPFmdKNNm26euNdfLcQtobJeF5sFpiJWdKHXG","slot":83970104},{"blockTime":1625217220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2C8vq6Vg9tSavEcvGXFmSEUVCHVtHMcLVHLcXFnfiZShuhapwHYuJmrSX7Q5hvUGtgVA9VHB8UyX6ssPAq6A7157","slot":83970104},{"blockTime":1625217220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"c2RW1FGtsomdsTBdqSLJD2LuXjvjJ2QWFfa3PWYLxm1TWwukLpa1dqbzjztc6Cbj64w2Nm7UggPzuib79numZrf","slot":83970104},{"blockTime":1625217216,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4qWDR5WA59kE7H1LseQFC7LyPSwrSYnYvfgrhaA6HUXJzF7A7Lgc7dh5VvSaPoEkiksjGhY6yZjzrpBazbEddsE8","slot":83970097},{"blockTime":1625217130,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"25kMB85xirNRiaWwMF8QXiDLUGx5ibW8MHNd6f5BBAEuWtQodbfpLwoVLEjs1VgPFMKVrQeXTXV5WpvFFUSUQBNp","slot":83969954},{"blockTime":1625217129,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ADL1W4rS7h9bceuM81XLMourHnXErDDDc8ZjYm4uuashaydZSfc5PxVePd37wxYYHx8GynVPQcVBBJuv8kpV6J8","slot":83969952},{"blockTime":1625217129,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5G87xdjm2vnBc5MZHqN86j8KLwdp8XfnHVXRooAu7PiQdYFLqC4bcGqTzwJPAQkv5Z3VqEppBoyMRivNr4spxMFB","slot":83969951},{"blockTime":1625217127,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5McBozwGTnhTmqaEU9n6zeTYHgoB4snycHSEEV23bCXe4E3DAgdmMkDwNqCXSstwsfXkYW25dx6BpUDeino9vprJ","slot":83969950},{"blockTime":1625217127,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5xoZWCZWe4r1jASVVuDr8CPbJ4qYdc2Z96LDE2W2JsJnYXK1MSEXwac1fBtkMgGv5sYbb8Ax2wnuBd5gKdYncvT7","slot":83969949},{"blockTime":1625217127,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5EE1EcS2STLT6coaW8keQWaBPFxCDiC3rYxeAZ17ESA9KpYRWSTj5XJRLRfyZ58HGyPBFTk64nfDbm56zN6LmoCZ","slot":83969949},{"blockTime":1625217006,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"67V1vkGNDzDyWUURMYbL7V98x1eDvC36xFwHgo9AGw1n27iCibBB2Lb1v6wrdJU1wYM4hsZVf6MshhWVNg8L4t3b","slot":83969748},{"blockTime":1625217006,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5HmHmNy7vSitMnYtnz3LPQwkcJH1wEMsSZSsTEUrBx31cJrepeGBo8FfgT23y5EZWHGTZvRVsyc3HJfKrVAbpqCk","slot":83969748},{"blockTime":1625217003,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2sXoGuxVRn8RQie41Nvr1MEGCR6McezHuBgLm4Y3yfH3JL1vgG3d8wjcDt7j8osnnoKF5EbXuhvUUDzsJmRrjd4e","slot":83969742},{"blockTime":1625217003,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5nWLTrysJRF1iSvUSskBZo9CsjERaPz7n88Y3nBQ9xM9fvXRcTLz3T3an6s7LWcJMMnxRin5Xrz4oJWaF5p1Q9gR","slot":83969741},{"blockTime":1625217003,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2y2P57DLTPjUyZtEAPgmY3V6dgftpnKbkkvnUvPYPSB39s5x5PtPJBzuVBeBRR12PQwov9y6DzFkMYUACb1FJuPn","slot":83969741},{"blockTime":1625217000,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3WnpYW4g4qxR5SVdd1XVXmC5ZiXq4BKxtfHUtNbWX61Ebk4BHXEJnDxdBNTsPecMr5axNv6r2JwUUZ7n3BEpudB6","slot":83969737},{"blockTime":1625216820,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2GC3ZR3oLtgBpeBToXWfma1jBgv14WtnAh8hDvS3estbzfzzJ4TjnNSViUibzqX1wG8jpsriyuBTVBbmGFZy69Rq","slot":83969436},{"blockTime":1625216571,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2CorogicrKt5pSLpGWMULiBtEioffmoP2hwEkm7BypJPv3jXnb9LAR45TPo95r4kEzSctAuTJFUCChZ894JC7zEQ","slot":83969022},{"blockTime":1625216569,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"66RDkEUpquzZkkqPd2AGLjCs2vkDiy1AEqEdDwn1KUHvCBXjTFHMb4DfZdVBJPQTTwWDGwswrgs3TmobyoXJ8buK","slot":83969020},{"blockTime":1625216569,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5eikTj5v5ggPZcX5WHKBk7bApdCwB3CB7euo8cbJAmKnS44hFx5R1VpT6yUFnk8BMhnLF8eBhH4WoUWhrYeMAHWo","slot":83969020},{"blockTime":1625216569,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5RPJiURCdiRHwky2dHyvRRD2YcPZWonhA1tLBEpUHRWbaMa6tpWMTiHzR4BochtrdjpYqwRFa9A61Y4CwZ9Ngfrq","slot":83969020},{"blockTime":1625216569,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"32bJypaLiXJmiuqDreSN4XiFaeVYJfuvA8HgbbYfVJpuHbaVEJBoRwDGbVrmBbtcTF8zmBKHUEAyDsf6XXZdLuu","slot":83969020},{"blockTime":1625216565,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5DpzefixEQ3uwuFKZazmqFcjE88zvsGpDAbuHnYAjY96UpM26xf93e2j5qN9KbGgCXP1tEdrhAyY3wnnNX9uyMAu","slot":83969013},{"blockTime":1625216509,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"62hiVNLLGFtUehUXhaPPHES6xavNTeLuu5gc4qAxHRJdDvD7PQe1HN31CChTNSuiDQtfS8Aq2viNAhTuG77C5inA","slot":83968920},{"blockTime":1625216500,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2ohHE1dposikby5cyeu3gu4WKLv93pPJnXYVxmejKb1MV8B3cdrew6JWDec6Cuer96mhL1mu9jJY3bNXAkEE6mJ6","slot":83968904},{"blockTime":1625216499,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3RCQ1bVV8DrVpyeYsXWZZ2SCqugVdp61yP3EdJ5AQyUMWaMmJuPkbrKFA3AnoPUYzN58sowzyDLDn8vdzLXuKHCu","slot":83968902},{"blockTime":1625216499,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ZF2danbeDKuva1KudNkqt9N9jvK9Tgt8dGG5iL252tXobUUHFxj2R8DHGp9pth7HfVnQk4g8DkRKQEvYZbsk1Zw","slot":83968901},{"blockTime":1625216499,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4SxnTiSesLstuL3Vfjafu9W89eJ4S3keawNPHDPXCY2ijpo6tS38iuf14Z33LMj3RBfnJeV5Y8zWAEir1ZJoemtA","slot":83968901},{"blockTime":1625216406,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"22e7fgp1roQr54DnRkUad3yZCisTYfZYy4CbEcp5bkCvM2XooLaZ3nPHbPbQwk1w5pnyPZ1ZzFpir21reeCoRTr1","slot":83968748},{"blockTime":1625216401,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5xmW1D8vgDwRtHf9VYA8ZTt2DofX9LtsxBNwmkHZU8PY8SdDi4p65i7sPmF629Aoz9GXA9x3gQSingfCU8Z6FBSs","slot":83968739},{"blockTime":1625216401,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4NUM2SA6FDLBMmLPk7XekhxBvBamjRgx1mK2Qg5zra2C9Z7G8iyr19qYsT4BvW51KVW3ULuSwbBDBq9VJuDEQhm8","slot":83968739},{"blockTime":1625216400,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2fJdFQK1NJMLUXmVhXBzwQzQ3ea2NGid7oeUCuJCsmmcXnvPVNyxnfrZocFZQhUb9dXEq2ucErxEjGKLVyvBuSCk","slot":83968738},{"blockTime":1625216400,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3shhCyWWwB3EK9xMCY9VGDSYtAYQTKCBjk4vVRyC5F7newxgEWam23BkLqhBEaoC78JKaTEPokJUFBvd2MkQPPtZ","slot":83968737},{"blockTime":1625216400,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"57A9CmcmwjuQThH4GpE5ctS3etbD7bJyHkFhUX69uGq8ywpXYFaWoMtLCkSJdkiR9WnL6qeBW3baXvGd1YEnQuVT","slot":83968736},{"blockTime":1625216400,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3mjvMB4j6xtRvZFYLhXoeffV35HMdMJMzrURLFFaXQ7huKyA6tZineVPPABGMXTh5tpumi1C8y9Wq7c2PpdzC2dn","slot":83968736},{"blockTime":1625216352,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3CXveasYjVry7ubR4XBH7eBD9UUybUCkbc2VjkJWWTJm38ezcDYkuJxGPosi8t73e4ovm7tvPHNGQqYs5VCNN7jr","slot":83968656},{"blockTime":1625216284,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"39YcNh9md58vH4kCppTQ5xdfbK4UAZBuGFaTVRTBBX92ZTpuXiJN22FZjV2h3gjC2zvJ5xLwRk5Zfecpychz3uYC","slot":83968545},{"blockTime":1625216271,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"8j6dMMx4TXb3xFCAXpRQsxmPuBs7A4KmTV48nvx9SfT9XxMnXBTCT89QAfaT7e8XSRScd6Mzhc5J46kTCQXRFZA","slot":83968522},{"blockTime":1625216259,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3nmFWWDeUmRbvxxX3TDZTaPyz6wHYeMbc9UY8wERwEcD9VSNCZr1jhLT8cydPBCYYmb4rAoWXCC5yJgYD3ywVK2A","slot":83968501},{"blockTime":1625216178,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5WaJuPgirKjJHijPGW5KZBRdpUXAqFWAJ7iR1SC2WNJ78qaq1jUoWPsvb4jzvJjZrkNRPHSs4o94Ba43fkpjuB8r","slot":83968368},{"blockTime":1625216178,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"243CSuXZvo4H2hcnpF9onFnx51BBztw3m3rQYs6HkrBDrMzkpd8n2b24JnxHaNXSnPkFBa6AETrAzA5NGuRRU7up","slot":83968368},{"blockTime":1625216175,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ESYzPdoEHZ6jkaULE4zXojPoLYZDLRQLfTbBeYddZgDUA2WAbhPhoK5NM6SPZVhvEMcmqM7BB2HH2NZEzVUEpsW","slot":83968363},{"blockTime":1625216175,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5vsaYabQz6k7TktGVMZqrBRjYHaNNVVdjGb4pMDm9fJ4yVGCBCLP73R85jtzrK7jL8EuU2gz6RpWzuyPrDwUqWM4","slot":83968362},{"blockTime":1625216175,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4kxj7CsLaj7g1cabDWa3JgGXf2qsW2vVE1XrywssANzcvHG1eQQmJgMrSW8XaEdZtoxXitVD4nuuX8Y7Fy1UKuKg","slot":83968361},{"blockTime":1625216173,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"ehTVPDNq1dvcfttfcwyUetpwkuUsKpPt5r9LotoqmYv4yTQBv4D4BGVZni4mLm4hiNiGHLtwAxyStmgRTtKN2ci","slot":83968360},{"blockTime":1625216104,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4rfAAp7JZmc6Czi3HLxAZ22Mkqmt85jZrQt3Qq7VHJaWqnW9Fz9AdS8onz5tVxP9vVNJb4QBS96hYVn13F84Fa1a","slot":83968244},{"blockTime":1625216104,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4nGeSZhTkAVWwX68eRGZXU85hELhDAeugj91AfQKqhGxxcjkYLHVhEYakFcrqjfHWiAXWbB3vgFTRigQeiS86Fwv","slot":83968244},{"blockTime":1625216104,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3nm3uASLXkjkgbNxiPQryfwWSQWzAengZ4hXY7gYYFrtwiYJKEz6x7DDxf4SxZKGS7hJseajEjjUJX32xXZ11Gbz","slot":83968244},{"blockTime":1625216101,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2rGPs7nTVda3ubGkho6SebdHBZ8QSBA1dHrGyzofB5gV4mqJok54CwStUKD46Bt89Mi83cvrJwWzCe1qgPqQNZfn","slot":83968239},{"blockTime":1625216100,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5L2THVDxJvbD5ECoAHvVKAhBs1YJSsqqK3UPVk6sHZ3KagUvfehPakRzSGhr4KMwxhs9P3RSahoYWVwvjwEmx4H6","slot":83968237},{"blockTime":1625216100,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5QzghMKKQSMdxviZ5JAB2cgEdbhjAGAZfW3E9oMidTQEU2F1dgotpQvarXLAfM6kpsbkWAZNAKdCaGst7fZDVTy9","slot":83968236},{"blockTime":1625215365,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"7aWJGbcCzVaWTs5gcqH2HUYYGS3Mi13f4Wzun1gzG6exatJ9HfcYAyRfhtMQaS5fdjEYbsn8urw2pgHFtw9JbGo","slot":83967011},{"blockTime":1625215356,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4eRC2kH8wVQU56qTBLgJeKG995gf3GdNJjPDy955VVgvJZSpaXnpHdemfhWxeVxW5LoM19RpjDdwjKBB39f9tDU6","slot":83966998},{"blockTime":1625215354,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ehewfKvRibQzdwnvAC4W5Ks6VAbxtBmxUssbPk3oLaNsPNeGHaKq7iqR7ULRtoHGFRMpyKFnUDCtQCzuHrryCcZ","slot":83966995},{"blockTime":1625215354,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"33en855JB9trZfrLf7nnXU3f2WYzPaRueaNxNmMpy3nn8VhoXtLdjMtwtmtHT1sCvSPwwQELWZjRsgUyBNXsN1an","slot":83966995},{"blockTime":1625215354,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2BtAXbGQ8DJwD743yyAR8Qghax1Yt8z82i9a6JxnoEEsFZwoF316mnGpfrhhHdV2QtgxqwhrAeJLJ8WowHhQKCn9","slot":83966995},{"blockTime":1625215354,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2a4D5Qg71wrvkqyjeVKiwGn1bXWvmgCL9nm7wSc1t1WPG3vbNi6ZyJfbRfkQT4XCLf2SWfRypATZt7AUK4BeGKDT","slot":83966994},{"blockTime":1625215320,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5H51FrAdLJrFHFHZsCPFLYTWSno1V45uE9KR9XKi1Lvbo84EC8rG3y5WHZfEFEFioiaepBhf7rJmnDFyhKLvHbcc","slot":83966936},{"blockTime":1625215320,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4mJfjVoLtHNxgMCmH92RU9rkSbnz2VcRkdQkZWN86Ja6Li6o8Gmbc1XPUu3XRjnx33pcQ9hH9wx91B5fW4PPNAcj","slot":83966936},{"blockTime":1625215320,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4T1NPWWdxsct9quXCPrwuNayRz33bgKNudpAxSdj1eevzXh7cCJUpWwPXofS42GUPffALT2k8E3useZLLprrnwiu","slot":83966936},{"blockTime":1625215320,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5rV9nW2Ep9cYLH8VQAr2fYY5jnWRzJXeKvASY3wZEj7BcZZTxA9wpERGBGwBUC7t5fEsYh8Ms4XSg3A7MUfu8Mi","slot":83966936},{"blockTime":1625215168,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Py22YLHuxTYPVa3MdPwo9USTXJDt2ugGbtVBoF9zuTFKUYBhr46atcD8witjJgXs1eFgEiWJnYzLu84VtnQDYsd","slot":83966685},{"blockTime":1625215164,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5cBnPQV8VrLgHsJPWiCndtU4kChBVmxXtgc4wh6WmtJZe8FQrnLhQ1WDBbTGTohMscsNpd4LsRsnUX5eECgCN5Sp","slot":83966678},{"blockTime":1625215164,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4QXkYfS8wrewyuZiJzXuuRdKm22MfhTMj2q6iLDdSSKkizobMen5Q34ze1tLAqi5wzgLsUzjvc1QsaKdbQ6gogJw","slot":83966678},{"blockTime":1625215162,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5J1zuKiPeRZt8S8DjqXZWd3aL4XoD5nPS13jMLCUqsBeTfrGT82KfXPWG7TdFNrC9sF8VKFmeBZGuWhDQMNfxrRY","slot":83966674},{"blockTime":1625215162,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4w5JSgXCSUakRFympmroX8turQVJGJRvcpvp1VoyG6D1c7P54bBvmhobr8AFtdFa8hHnLHUofFrUZ3RpvhbLnqrx","slot":83966674},{"blockTime":1625215162,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Y6ydzukBiikb3mxt3BDVdutM6dfUSLg9wEWtRGgS1xqJDpUPVEDWbwxM7SnY9TF1PMXDKtvjhfuunA8LswHZrwg","slot":83966674},{"blockTime":1625215065,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3bueKdHoNYFZ1RimqyBGdnYvjs7VmiYqgyZ62d53hMTbtozz2eseNqWS7VR9gsgTV8CAu1HwQWhnZsWXcQrEiuaY","slot":83966512},{"blockTime":1625215063,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2kBZMi41fymwDGLMqiV3ew6U8U6SJfx5oFnyFry8Dkxc4yfqiaELYjiY6aMXob175xUbdf1qH4MyDQta5m3XG8jN","slot":83966510},{"blockTime":1625215062,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"36qMy6Pn6ehyeNYSS9eMvF45t8s2SdAgCnHeyTVhv8TziwUKWkSGVMcPR8fkVPp9YxLwqpm71zWYAuNt7c5z6p4n","slot":83966506},{"blockTime":1625215060,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"55Y3jzS4CsMzw8a7A75NUUBBzvm4o71VC3cPAtSLU2vbHEAkXw11kD4SoNRUGLqV4MJ7k9HdYTP1obh2kyZJtLkF","slot":83966504},{"blockTime":1625215057,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"rSQrJNBj6dBDcuyaKW6qXwNxwwG7FZ16TenYQuDj7fh5g61KvDnDnLhk3zpB6bcm8RB2C6eRGsYDc8PSM3W4fMa","slot":83966500},{"blockTime":1625215057,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"QbZqk4g94xzKz8GEXypiWrJSEC5vP9CNETksZGBS5B2Pp6zT8L5pBAc3vCZ9VCFa8cDeWwVZdy75t4u8Td3Ks3a","slot":83966500},{"blockTime":1625214924,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"35SHGEBfGmBcoBpcmNwzTUKStLiF86wVY8hFBxDoXndVf3aDs6bS8VkBsXL2Ut2RVnSxhzGi2emAt4HTT31s5YsK","slot":83966277},{"blockTime":1625214922,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Q89zvxRWWNnaM3f6VoK6MdKk3hbCmZssjGmdov5F7hPpBoM54XcjiQC2MFXZeyvtpvgC4T11WLsAVfNcHZFYu3E","slot":83966274},{"blockTime":1625214918,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"a3r2GJqGCBhhnB2vZfkVxuReoACzSMMLrRsu647vWsvJBA2wpgW3kqJowUZp6TGGCocpN5gx7UegaioLpFCoA4r","slot":83966268},{"blockTime":1625214916,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4zqSJzbdr2yiiSvk2sbnTKAAfoi5UFci2iu5XRZGjsbmsvwm3uRwUYZm7DJ8rLF3vHpsytfw8C2BXVVTNio91WJT","slot":83966264},{"blockTime":1625214915,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3FtL6i2AC3PDAcGmZxU1tJuGN6Qzh2hLd1NPL8FNsEZ69cxiV6NKbAjbJ2JbCrPbzdSx6p9aJN4TmMKQ2FSY7Pwn","slot":83966262},{"blockTime":1625214652,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2QDbR5WiyVREuxRTWVh2NMbtv48cGau9Bn7nS11s9K8DUnz4wjFh6VuQrK17rPscYm7dV7iv1RSTdPWwE8eakrXk","slot":83965824},{"blockTime":1625214645,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"jVnafw1FRXGzjSQhaqryW29HgaQgBSd64peMs4P43MtEWDakmBdhYChFifeCJ918WR6FKYMWK4gPTE1WccrAdFr","slot":83965813},{"blockTime":1625214642,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"GPSwpvvGyFBTwpG1vpD4eZeXCF52HcCWuCmrTqbDSTm4Mnye3MuofZ3LShxr1X85SgnpPC3uhn5Pn9QRKUkCmPE","slot":83965807},{"blockTime":1625214637,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"89yWCfdz3W9WeERHDs63Jr8o9Gp6y8fwvBb24DYp7JQbfZGtTtaXdFnbxsA5eiXhzSnPYKESteuebbq337DxuPe","slot":83965800},{"blockTime":1625214633,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"m3LFjZMSmUm8n6vzAhWEGN6b2XvsZyVT44b8x3TV47KdeYnNQcyPWZxgZn2gsZNk1tDQXN65KwHmfkkVicJF9GX","slot":83965793},{"blockTime":1625214207,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2tFpzsb1kkgysedYbAcgkArhAYZSaHvtbJ3pLZwV2ibTP4QYCkeEKvskRq7KH2NAy1ER35HoURshnSvt1JRjkzwt","slot":83965081},{"blockTime":1625214207,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2rMTu36gA3RgCp1gdxREPB6hkXN6FpRN6fhTzheuVEu692gKZjkDnM3tELkWDXsHrPv8e6ZThRXrTxEYTq1T4v7X","slot":83965081},{"blockTime":1625214202,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4sz7udq84QHcNDMDEfwXabdGFbgw9DHJQZ4H7LZJwjrPecfEFdt44prRrfkRyZ3KgfpYzwsEzuSFhKvn52WQztr9","slot":83965075},{"blockTime":1625214201,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3doAfW4XjD3cWvXwfgkPWm5dmjrpYirCt8rtDHnTZCo2EEy6toAsyZmNT2XxCmbs9h7a44qSTxzXVroLyjCc13Pn","slot":83965073},{"blockTime":1625214082,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4DV9Tv2hcd5ZMA4Uz6Joxi4WJxqvAXLeS1wwjVaGMevHE3zsoBJx8DPx5BtzvurKTjBQ2HNSXJwwSkX4GXTy6Aho","slot":83964874},{"blockTime":1625214081,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3W8U8TCYtZZTabFZKiTvhYt9WEMzEq1zDBi2CByebsqkiMvB8b3g8HM1TApEANz8JbePDU49U3sgMCEhkhswLYVv","slot":83964873},{"blockTime":1625214081,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2yfLdpy1yEZLdszAZKcAfcKU5BW22ebuT949KGYPZMSH2fp2wWPskpK9pmn98JFRwj1U4u1aQJH8CtqL6vJ5Dnhg","slot":83964872},{"blockTime":1625214003,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2V4MoSg89KM7aBuVfenTShVcVD4QPcCnDkVJXw4iA7yHn7sVHgPRZq5RsugxkxVZ4cJDDhFCxt6U1EoVQUAn4PHh","slot":83964742},{"blockTime":1625213983,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5tZUAvs1FGaqVgtJvNCkeU1nAfnb2pmUp1wnBZsRVrBJCmUcGTJAFwfmoegYstwwLmDucMobgZEWEQjxfSetGbs2","slot":83964709},{"blockTime":1625213982,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4qZdZkMg1ZFnCs5kzxjXZXVeFwUankf6GBNPnZ1rgyAyRHyzxYqHpscPR9CZwsZtFKsDZvo4CHvEyCx7xhtopKDn","slot":83964707},{"blockTime":1625213979,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5xDZpNtdLtfJp3WT43psyt7Y79QtHW3NtixLQkZZqWuZCQkFxoD29NCiaWDzDp1jsrVyyQ7FWdK2SGkxe68PjfjV","slot":83964702},{"blockTime":1625213737,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Hz3ENce8S3R67YqszmQXhsQFJvKSGunvhWH6BuyRTYNtdFG5n2V3UH9G7VeDwPnfGwME2gFovuXqDAyU9DBQqJz","slot":83964299},{"blockTime":1625213731,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5PG34aZUb1joQh3GmkzPv5p3UcN9HvYJRkd7dbSQ3yK4aViHe6LmpgqPoai91tMmgpAmyqJECsrsMp91hkNEGMFC","slot":83964289},{"blockTime":1625213448,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4cknry4zDwCJvtHuBWLeFrRXCpuWT9hra5cwn2yGRYebDd4Y9PvATKnrTVX4Gn2B3yFsdDhyPhQzvQXNLeFNdfYv","slot":83963816},{"blockTime":1625213442,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4bS6tsgmvGmtot8NBsAACYPWeGKA48aUuL87zE7betdAYCxmV6nm5nvqJP75Z92y44KrZajpvEBW6F54DgSR361k","slot":83963808},{"blockTime":1625213434,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4zmJA3dhG3aVGsx6qd6mhEGtbBqZDdX414s8Ja9cP1cCBqdRNhnDQLzesE7oMPWKRsFH2pp186jBzdLnfnU7Wd5D","slot":83963795},{"blockTime":1625213356,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"L6pncHwho6LTWSYFwvvd3MskGhe3558r8otfq7BnBG5T6mEmoBj64muA2jAG2uxeboAcynXj5aUbDGd8838uq6F","slot":83963664},{"blockTime":1625213349,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"573PzQgF7WHCf9pGJiPHoHqdmCQKuZvFtWG5utEMNEhJrndPs1NAEkHTCEdmjmoYzGuH7WEfABooQi1SaF6koqwB","slot":83963652},{"blockTime":1625213316,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"gSzGoyaywbsW4EZ4XLTKzGrfQoDPq8Yk1W5eGwAhW6Vmq9pQhAPwnGp57GtwhtMLkhR5LhabTDNDdkE5yDFCSVJ","slot":83963596},{"blockTime":1625213310,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2oKnGN6d4bX9ny4QMLuqsSx9x5MArjh9tWVZkBD5t8mVqWgGRi5JoTdZSRw3kTFpAopUKCUV16ykgPXrySBt93bZ","slot":83963588},{"blockTime":1625213211,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4kutykd89sWqSkGkRerXR2TLv9busK6hfrh4nTSwAatz51gqe8up6xKMQxaKZgXHxh3jRUuoMFuRf9xJ5MfF2hWK","slot":83963422},{"blockTime":1625213163,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5T9PvY7fQuFiHaYij3tR9mU9XRHnDx8xSP8avdGdVmAdCmpYZYJrTpB4YUC8cN44cYzL2ULnnz2VquFxTp1vtNY8","slot":83963341},{"blockTime":1625213067,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"24K1Pnxj1iz6L1kHHTdRZTha2quNRi3QRtRs3tiFaQ3CjcqCP6CxSATB7hsEffvrswMaFmBsEDYH2vyZxWWZPpTv","slot":83963183},{"blockTime":1625213061,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4AtUDQ1AJsszvPTtcyMLoqX3KEJNXtYecVtNWWwfv7KBkpK5cYqHhdyKWQMPSHAWPE5BauS3Qi7REraoQBMhGLJq","slot":83963172},{"blockTime":1625213058,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2bhzRka714MonCmYaVAVRdCde73pVTVveY1X9r3r9hjMfiqRUfb1EH8voXWseRhANYisVgR35C3hhKAUV4GNm8yK","slot":83963168},{"blockTime":1625213007,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"57kTp1kqZhCB4XfbRLFTcpTUqtXtjmn9kUCa3KinXxXQMDh5jpgU3uSjezvzqrMUcGhAYSdt3X7N1SZZtsdkR95C","slot":83963083},{"blockTime":1625212830,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3LmX8CTULidiRwTGj1irQ1XEThqEKMbkymhHynpbjRwT39u7Me7Ri5pbxVYEZMxHjhbjpUoBnWhhxnfWniVCnSVn","slot":83962788},{"blockTime":1625211786,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3DKewFqYN7JfxN7YM8VK11ZCKzXLkrpo7N9fD17AEiCwMiyXWLFpkd3xWAeWsqewdi4EjXTNKM3DFNWCE4hRe1C7","slot":83961048},{"blockTime":1625211312,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4j3Fa84HvvWwhC27GMyauwXj5VteDJRMxC6cPQu1jr66Fg96sKTJavUqccijjGfp1ybR5hf9fW2UoaY7zT88tJyr","slot":83960256},{"blockTime":1625211286,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3cmH7MVVacEJGPJ9nzYNxLtPMTX18hmQce9eiiRkCohQDNHUfVMAFzM6rcbxfybrJt2kAopbqs1oVafaq5CJPSYc","slot":83960214},{"blockTime":1625211244,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4dBs9Fyn1h7BCJcbWiswv6uehy9um6rBvUQnBgdDFeTzhqiNb8WssrUZAsfLonHwCv1BFkeARdWCpTbTkrDYHz2T","slot":83960144},{"blockTime":1625211081,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2C8r4r8hzdrmJbCr6ryz7WGYv9JHneik1rCEVrQhPrsSFJvKEbfP33Ja28DHPDkPShYwBAbqj4vvLFLwts4QS8bd","slot":83959871},{"blockTime":1625211069,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"37q49R6o7X7Tj9zbeY3hLschKJJ2xrmdJQJdwbVybiDM8a4UxfNkdGVun5iwouNXhMmpPw4UuS8hq2rzkCQEsfVW","slot":83959853},{"blockTime":1625211024,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4eNns5trom3pDT9wEZfJddU2QHijkdQkS6FymS115Tx2N73TtovaKbWpxdcvTG66Hm762UJRxkRkCBkcdFfNWNJZ","slot":83959776},{"blockTime":1625210506,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5zdqw1Kq2yv95TGBF6MA1AWQqjpaZZe1hkGzHTwdpt1s7nxDiMrWnkMDNncEJwBH12GErNP7c2dQrMVZRSEvtatt","slot":83958915},{"blockTime":1625210506,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"D4kYTxtu2BGqEqihmoo26dF8yQmf9zjJBEFJBWqcUfGN2N878bYSNSSuKciMmXDdyxJ11eKexbF8KpYNsgH19oW","slot":83958915},{"blockTime":1625210298,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3q4eQk9Ao1zHvAidemSjE6njCNXdy4eF17qsHko9cQ3aehTrdYCcZ2CygKqy5ksBHgSCq25a4VDaLgb8nX3Xqs72","slot":83958568},{"blockTime":1625206813,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2pL51GghQqM14gqRTRauSPMNnTvN8BZEK9o6qvBTwqnTKE9uS7T8Vn8m8uPL88mTJsFZjHdPHfbtEgmpxNdc92rL","slot":83952760},{"blockTime":1625206809,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3PcAUt392biA7G5d7zRcKYWjyTc1qi7SL3x2NktYRBDWWRdwUCQzB5TepMA5HkSByQqqhi6ThkKGLdoowFa44pac","slot":83952752},{"blockTime":1625206804,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3QqvTbhDTjx5nHwW97JkWkHnXR8Sr7vFcbCboNjwZvBsgtkPdMcm6b3Kr5LQJANpJrbXkbA9T5us7ogNvSyyweU7","slot":83952745},{"blockTime":1625206542,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4S21Tf8nuhNSm7TiEe8hAxq4Byb2FFwWA8qukqKVXeeT3DSgEmwXnh6GGECmLKveT1AcHFFovTummxpztGrp5iuG","slot":83952308},{"blockTime":1625206542,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Sw3L1Uq1VuvHTZT3JMqBS34rVURwBzQps6jFub5Dhrd3to2cARe3cSeduV6LmnqzqdsR8KK6f2bAZnVjHfzokD","slot":83952308},{"blockTime":1625206533,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"67H68yGURAXMPkxeGSAeUhvgaGw36ktE9srYtVc6QxbQp2bd3RNkbXrCFC1NEbHt4BTTuYcndDPHWBrjuBSDC6fd","slot":83952293},{"blockTime":1625206290,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3qgXDz9rArSVXwVqU4ty3mDSiT8wLHBjeY9HvP73DR12z7RASCjnoNEeDox2FHA6ajTLqzn2Xri1VZGn4cFYQe2J","slot":83951887},{"blockTime":1625206285,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3z7jqpP6iYKfUJjbNf6PagHgnDhLwWZz4KzFxTGhLEdB245mrsvqJXgg7M2SxQ3fFTZggY1PZ39yXjsXQ3t1eEGc","slot":83951880},{"blockTime":1625206281,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"43g27VkaBn39qQNBg8XnZzfCgVH8mAZvWeWj7H5Xz3BGBPzoTwHRKfvzjMHjYQzkD8hGwn44oj14LJbVavi5fBET","slot":83951871},{"blockTime":1625206161,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3JcuYp9W82cp7zMYnCqmseU1ZatHrxPPNF7EbhchHJSMhgZJXMBqq1vBB1FWkc5qFmqCScpCQVCMkANfDsC1uwT5","slot":83951672},{"blockTime":1625206153,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Q31xvmuJvyEBXWrHK1cnGJbFFqm1ngXDFEPxacyte8MJn5QSU3zEnf7FNRqnAjaw9NMxzGdVZDkpbGP8eEHCLWn","slot":83951660},{"blockTime":1625206149,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2RG27drtSHiFPr5Pm1p7pYvQwDBiroMQMrepRP8xacx9m27TDYwM5nZEwmRAtAJKMMGcQhbF42oLQwdm3tih5v1R","slot":83951652},{"blockTime":1625205801,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"FtsZ9wmHJnPX3Tic2TM8eDgPC7Cxdj5pMU7ZhFLoMo9gNpNGPpfTVArVpS83vw14tu2HQSmxjPsyCtf5ESpgBaz","slot":83951073},{"blockTime":1625205745,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3WQxePfaQmPcJK2UuJQFALwDyFaA5C39vxEFGfBCZwETfyQHK8J9GJeX15YLakAqwHWZ9NkAx4pAbtcdNULxdcDz","slot":83950980},{"blockTime":1625205742,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"83JL4awzRS1hf1CEFAxUMwPGyAWBWrDoU2a6MDvGtWRnutWg6qVM2DF6t3wxPgZrMAxX9z8M94tvKMBvVEYVcKM","slot":83950974},{"blockTime":1625205736,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"7LbU2BMLmcSdjM1hXUcvYLDcgVeehu6sDef7Pfd3Xs6PE9vmxhsMD4cpv22hQixaSB7WTDUk6YAHPf1ePQPAx1Y","slot":83950964},{"blockTime":1625205505,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ARhX6JSicYgfbs6N3nqrDafGypDaUwZUX2SVFTvG5XqYjSWaFKkBqBwr68Q4FzuWyKvyf4e7cuppkvkWQnM518p","slot":83950580},{"blockTime":1625205427,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Vn6J1o7doFQAo1hQ8r16HbwSKWJTErPLAbtEaUYxfuYEREXgL6NqKV7LuAGfg87NDYfC7j728huQF2pNmcVNxJ3","slot":83950450},{"blockTime":1625205412,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"d5tyGeeckhWPQidDHzo7Am8rQvjFBKskD8N7MnDb9dMCRkqPjSLG3xXGfs2ACqqkpBwo212Q3GmA7TwVSozQ2yD","slot":83950424},{"blockTime":1625205342,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4HaeVEWPWMTMsaF18rKRJwbTeVSKdpp1s92aYWz1GNThY5vw4XCbbBmeCyh3SDLz8Sk3Wh48TVzbC9GCKNjZJVbw","slot":83950308},{"blockTime":1625205339,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2TPTNjZ3GnUN9xbXwQCytdEGRpAKSXrEmrrVP2brVtMVDdyyDc5eeTSXrNHejr6B71QgXvnZrn52eUBi1bo4KmGo","slot":83950302},{"blockTime":1625205339,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2b8SMTwV4w9wohNLstnwLmScUL9FPx3zuhH8KMtksktjNgTATrkgnvqUZUYudsCBh3fcnzsLWoQDW3bsJ24u8oxh","slot":83950301},{"blockTime":1625205330,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3hKvwv62yXV61dUwPjknnJbkBxoFBtfr75AzChXc2bMDDMWLcNN2b35PH3RXwCCJdSV9dKn1W2CxxFTVRqxz6ddY","slot":83950286},{"blockTime":1625205327,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2zNVA9pEYNdXC5FNK6ntKGgeAp9Rqnb5DoCeUsLmeZBNYiKyfSnpuSDzeRxK8PGUYJuyNkHQNX1kenX1jajSvoFC","slot":83950281},{"blockTime":1625205321,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Cftm4L9XRnuCuEcMM2KZ8TW7YoJbyKe3stcVgx2HLv16Ld3JxXGDhakRHMhZXR5YbkBov62YMDaKTbaj4uzWGeu","slot":83950273},{"blockTime":1625205318,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"CmisddAyHd2br4aENQbymagBxxyUyRK65PmSPTz9cioMig41Abpi9cFLJy7xtoiNs4dZfFqkeFDpuT479PR4b7h","slot":83950268},{"blockTime":1625205315,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"25e2itV4FEBrQP5oH3ZduJFDR9EZqBgVYhBBTuhSkiPXhu3RKY2qA6mYbgTqqk2gMmZBujztQwUYqfBDhcy3sfm3","slot":83950263},{"blockTime":1625205310,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2qHsKE333eNZzAQSWZtneyK731CWwnvR5JNybeGXhNNNzMuvmqi6aXdL2s9sLZEmYK2mfQs1qgQJEjo1r1eHrWHK","slot":83950255},{"blockTime":1625205306,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Qsw1ntUV16Lpdji1AbLdvNQ91MCypRbSMQzAUDwNKaLzuQABvCsan4tR5QLYATn8n8G2u7fEcdj1EnPvCjj5FQW","slot":83950247},{"blockTime":1625205304,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5bCXeoJkSAYQ1m9xT3sMgGkwmPEpLmdckPfZdYtiRs4XBAFmP9x37fmH5MxpxbP6t8rELms4qYX6bmWKjYpTxv6K","slot":83950244},{"blockTime":1625205300,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2oLXKxHBKLv5spTF8TtCpisSjAg8KURcZo9zcqxVsSmTn5DtYQA1JWwTxmND18NoFBQNMWcXUYtMasfGkaehndW5","slot":83950236},{"blockTime":1625205282,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3v2zF7Hygfjy37jc7HaTM6PtczeNMEQPJ2BRbaCTVR1fRKJvNBEx3h4EggNTig7B6DyYKoYD18drGVe4wfJaHu5c","slot":83950208},{"blockTime":1625204880,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5E8gMHourXU7CPcSVz4K34JxqsFrWAB611rUmGNAX1S9oyYgQNc59BRuGUUNBbhXPTg6iXdxagMekSgzUVagGfpV","slot":83949536},{"blockTime":1625204863,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5CVc8gYwQjHvChz3CNhJ2kyo9dnKDFTn13bm3SzNKmnrquPPn6LV95QP4AP6XJzhRJjbtHsJb5U2RajtkxPHn29E","slot":83949509},{"blockTime":1625204859,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4snRQv6EDJE5eK7zF5HEGj83JsrUGoDHeFXySRfFj59pH9CnTLrhsH3VnnNWVKz5AbLEDDHYQX15ARTwFdgEbdLq","slot":83949501},{"blockTime":1625204841,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2B9TeyVUy4G4JFfndbFBJfEoAiQ4RnmjeFWZ5zG56E9YGtADd3uHK2NxUDQuev4EUAxM29RFdCF3uXCsV5dvDreG","slot":83949473},{"blockTime":1625204733,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3h3yYuPNjfYCaV6Pp2J4eL1pjw7oBqdvADHATz5GCRB5oDarKPx4JTiJG6ATYCMw9dzS7S73btfSdTKKDkDS2eNR","slot":83949292},{"blockTime":1625204715,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Pw7c8iDWivYpVJmfd91t9xGr1f6GniYTmgG5Cy1AfB96kw3H2519efAJcGk9tLxZ99EoPBrD55A9f3xUdsdBA6R","slot":83949262},{"blockTime":1625204673,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3XcWSADa5LGFfsj6N77S1EVU1TLqcDT9QWa3rLmE31yt5vm2bb6zzcSwsGGwXENxmpD5jmze2y8TQx3roV6CAGHF","slot":83949191},{"blockTime":1625204668,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3EprfNN1uZyhC3su2rvxAjvngSqHkgR8aYbMAZ2Q5fRRSyxAQxeHXrpb53qSZdGWFXUxBdMq6u97H4Z1Hcq2yJh5","slot":83949184},{"blockTime":1625204649,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"j2eJYtpehksNcVCiZXzu8Jmx7DxYSstw5R6LBEqCX2K6M4Tk5pok1CCJ45Joix2QPq1noxUQiTHLgtL7PiL7LAK","slot":83949153},{"blockTime":1625204632,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2YLziPmReMXsMukYz2yTeBgTPHfqtTKAvtjkDfeR4dh7Hayovzf9ahs1UathizyehSjcpEr7mbYhCxaVFuXzcnDs","slot":83949124},{"blockTime":1625204595,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3sTiUg44RzngH1481jDegsg2AoYpXqAXvFBEtcXKgAnJFxW867TDz6wT8XSo6guzUgtbEpfvxZ8CYwwLJ6Yku7dS","slot":83949063},{"blockTime":1625204487,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2ZfkgCVct8ku3tY4PvoFge5f6ZyT6wx89iWscthmbcbJMbxR5cMyW6WpxZTU8w6J1iRmd3E6Qt7AyMRNiGQq1RvA","slot":83948881},{"blockTime":1625204415,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5M8X9WDDoj3JMgVSo3Eo5Yg5bnKr8b8jKudjaX1XxcT5C76T9QCXY2UwZJogGDdzL6BTfGdGph8G659FgywsjrrP","slot":83948761},{"blockTime":1625204353,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3qm8bo18SDoYQyJ1JuydPoWqcXAXqApCT6iVLDTxuLMPFJQzZ53BnR138bnnoBoW53iuk7Tc3LTcwDu7cWj1Qx8S","slot":83948660},{"blockTime":1625204316,"confirmationStatus":"finalized","err":{"InstructionError":[0,{"Custom":1}]},"memo":null,"signature":"4YDstsaJA7qK3FXaEfznrBEcdhTc7Af6QEH765JFTzNRhPWobrf2ZFMhCypqPFdjUTUgwjfXmyDcMN7pZKaFQpyV","slot":83948597},{"blockTime":1625204316,"confirmationStatus":"finalized","err":{"InstructionError":[0,{"Custom":1}]},"memo":null,"signature":"f7DhSY23PSx6q2uLAuSWP56dJKTaqSiu1rxeXjbHKjXWWDssju4B8bSy6ApZrVJyQVpVivNbyBtBAppEpqqCdXx","slot":83948597},{"blockTime":1625204313,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ygyBMRa9QhW7YYvaghxXwZvSy4V6ECjjmMiphzvoYqvEZeaKj4oGjFCumr6xLdbuGT1hg7LsrN9XqHLUMseCC42","slot":83948592},{"blockTime":1625204308,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"24XkFDdPpBsCZMuShJ1oKZnqCLKiQDDA5bhDJzWZBgeif8DrrBK4uFD8qP57iKQSyd8fujoWHJnm7G95xVM2E992","slot":83948584},{"blockTime":1625204304,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"KWbzRucd1ZPbMVnsG8btpchZsGuZtYsXsRQZPXwT87sJFjajezak7vrH9nWKZGhDVAyJKkdw1qgvmJFYoqjmoPM","slot":83948578},{"blockTime":1625204296,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2A5zuLEY5G4t4K71ryUFQDuLHDJx8AivvUKrDzTVpkbFhcjgzX63xB75Br4xtnPGMueDz8SzYwmnLRWFBhRyJRVj","slot":83948565},{"blockTime":1625204293,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5xkimbvW5GmgBvN38qKqQEhLkEYaqom4HUxVsfThUgesN3dKPCeMFZk89d8eXfYJV21bPgS3diE9e7WGRKxZfmXv","slot":83948560},{"blockTime":1625204289,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4VPwruPSsVFeNbEdaTSzPxYGaHHqTXU19tfHVa2msKQrppiVhaDfCtuWh1qbN6SzNCTXjqN1sdSHY5YT9xB7ts8N","slot":83948552},{"blockTime":1625204286,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3yYiXHkHGb52z45RZhfioAfdph5R5xvnA6WLj7SMdLJdDG6iZ5PhbiEtybFSb7KAEHqgNjrDVdWDfxHNvo2UUhSz","slot":83948547},{"blockTime":1625204284,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2gVz8pxED1xBrKdWv6KL4YQas1VZvvEgwcEvx5PqhvKtpptzCXj9m7F4MANsXQiX6fik8j4c2FUBTETEeNw95MjD","slot":83948545},{"blockTime":1625204280,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4frJPvmyzrBBoUfQWmvEeWovAxFLVPeBj3g1S5am2RUnC9ugQQkMBukxcDVBajZV3CbwRTrXt6XBxYjX4emkLBHP","slot":83948537},{"blockTime":1625204272,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3GfrE5umxCAAiR2aQ9TbarAyNtJhmB3YxF9FRiT7yhHR62R5hf6XjZd5SNnqHoGqYNRCnudc6dCLKwjM8E7eu7Kr","slot":83948524},{"blockTime":1625204266,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"LbW1gT5T9UkrdLG5ruHjMG2hgaYCgubJvFfwgmNaQmmfzYxKA2xfoXstifZtnmaZif8Fs7Z3MToG4UER6JnmzZq","slot":83948514},{"blockTime":1625204257,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2iR1Aj1iRAQS6SsE489ytKvVCr3Ygq5gjvfsdaqexV4ULvhM9sX3eF8xpmcUimWtgGque3kJuZ1xJpTQFrxWEqfq","slot":83948500},{"blockTime":1625204257,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2FRbRcTR4ZTq6DUDfb8DA26WcgYPcunmqDHJo1tzUzPEczxjcACvXBCPuTrFP3oAhAF38eKxDAxTDnTU8KwbJ2dm","slot":83948500},{"blockTime":1625204257,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"eFXtu8PRkrp46nVmYkBf5eoXobzMcavD1s4eDqtZVeACpyvDg17gusyrhEp4kKVtbEz5nsG53fbtbuoYKuBYq6k","slot":83948500},{"blockTime":1625204247,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3QbWUctCyRtEfDnXTmzWqnGjEySXcd2CRNLxaa81oeAKr1zfci6GBmsdo21YMwhUJ236HdzWPmgYG3qX6YUyt7jG","slot":83948481},{"blockTime":1625204232,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2WDaf5Tq6AAVB14qEcLLgvoFhsJywQ5jeiMwNf8Dfma4aQzVvuqG2NALtGbkW9VmGp9NgBq9peznFvp15ee6JWes","slot":83948456},{"blockTime":1625204220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"27rjZV8XvCCcgGfztJzVsUVnwFjx4ipDbCeqeWYfhJtuBxo8mpAfEGB3oufnwuD18nrkoTJDDaaGRT8nmBAcG6oz","slot":83948437},{"blockTime":1625204218,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4LSA6MjihgT3xgcDxEsaAa82RxABVTXtZE2gs5moccgebbN8UBy3TeWZNWFAKLQC3nzpUwQiHVM8h1PUFtr4fyHA","slot":83948435},{"blockTime":1625204217,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3bU47Q7fXmpyErYKWwc89mCJUU1B7SAPq8vje3fc2Ye6nErcxNcuvZwAwezkzA11dwaRGQxpNP1HvsEFXZ1reuT3","slot":83948432},{"blockTime":1625204211,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4uWjjZ6RPLKfmxDWyGQWTrKZsm6yL9wQbFCKzyWFzMtDszGihPjoWpB4MENi8VnhTMGgbig9JB7A9ycF9kfowiVN","slot":83948421},{"blockTime":1625204206,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2569w4tNGYbrkeKytuh1kNGqPbNVtGb88tt4sgzX4y9ixX5keRELHqnX8bNUdoLXxcxSzeg8bFtNR7zvcLuq1qCE","slot":83948414},{"blockTime":1625204203,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ssne9RgRR75PiVJ12KQJ8uftYzXeHjAE4BoYxymner8WDCC9wFsMfHSKSafvvmwGk7NFTUa53vuzsaNHyjYo8jj","slot":83948409},{"blockTime":1625204188,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4gkgURc1UeSk6aPf3jRtYiwf89ggvG6ctEzDJ7TRrrzF4cSK4nKAeLRxNWkKPeyjb6TnjxaqsU7D97BwdESsbo1z","slot":83948384},{"blockTime":1625204157,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5RpS5VW2qk3HYkJRCxoqXZHiM31AUV6sQWYv2GgtuGEMbeakcGSUXgZEJmZfNJ5fnSWkMN9nXTRzmHSA1b3evhET","slot":83948332},{"blockTime":1625204157,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3MSVmMDfL3MjLzmPbsuApsKMmBmp72sWZhDj9Jkd4C3BENtMHjX2PdULq7Pv3w5SXH7SVokLTFBJzH3XStJkE5g5","slot":83948332},{"blockTime":1625204149,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Y1qMYyvZPjP1Kbx3M1f6PXBaisY8dZ6nCFwLLGcXHRaPJEuCYVmEHSeorCuYxMUidZvtMSKoxhwZYKKzVkVhcpJ","slot":83948320},{"blockTime":1625204148,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4k63QDCqGDcEaMa6NDG6CVX939zJD8sPoUJtydymutfcMZ3qyJCcx6xzs5i5vZNezBtKyGxYutcrySjUVPQgaKTa","slot":83948316},{"blockTime":1625204143,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3EYGsKfNKipWFoWab3na3TV8kb94XAyrjGvQyoX81YySHsyYCyvg83v7rAQfmsE37jLCS1PJPSWPsmTLvnnn4YGU","slot":83948309},{"blockTime":1625204142,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"47YbDyZAYPkZxLUmqZrdUgy5y8KEoVKQnnpxGVR8BsUsypYNuui1dSEN1gzRKMDYqP6AzU7FW81y4owH2RSJU9By","slot":83948308},{"blockTime":1625204134,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4eXAKiTnP7FoeFjr21vJuS4po4zTxuY1ZD8qqbuxZE5kPGbb2dKDeCir5H3udh3d9byqsUGotxvVmvpDURdKwWVJ","slot":83948294},{"blockTime":1625204125,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3UuFM7gt9wwC1DFuqobrSqbpZTetgUXf179iVWjCxbSzJVeS6vXXdrZSe8ioQT6gB19JM7L7nYeU2hAhyFSpdhjn","slot":83948280},{"blockTime":1625204125,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2CD33zWJbGcfipmmpXowuCrJHke38qf4bMFPkrx1Nu6awaWJHTpKYc8WGLZArKZx3Lg3w4BnMQykB9zdfXSjXjWP","slot":83948279},{"blockTime":1625204124,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"63rJNFhVMgjmEE1cZceNs1s8axwnpja6cA3Ztn2A9STJywDJYQxRv8kgkVkTAmkywEBSoKq4CNw1TAV1rLmDkX84","slot":83948277},{"blockTime":1625204112,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4PYMp4aMmXL1HN9VUEQotMaDXnhejfqpC6NHvTh5juWYCBMomKHjzRNuH5nCuBoKdaWxhYRwW4pLAZ3be93oZS4q","slot":83948257},{"blockTime":1625204112,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2CjJrYu6xYxoZvK141nHjL6aTNZJkf1V4yLKFfDt56rHNTrSP1Ka5viHHdzJXF6BMENXfYmGFg7KgRXLA3L4pDqK","slot":83948256},{"blockTime":1625204109,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"43N9HJ1FQtRV7K7MLA4WBd1F51EtUwx2PBkeLodK8zq4fjaL25Qt7e7GYdzuri5BDfnfQgqiESoMp61XbeLWhNSv","slot":83948252},{"blockTime":1625204106,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"46Liy6LYx7URZYHgMfZTDV7pddjHmCYVVcsfo7j2dkezwMsNBvvp47xtApGZaJHMxABKbyxAeRTxXmoVm67kKpT3","slot":83948247},{"blockTime":1625204101,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"8q5YCrg8B9gavpREBYBat4bZ5zxiajhYyDQpnsCkqecNBU42zPih6hSjcWwZZqSMUiC3LFvctMzie1dXrvXgQx2","slot":83948239},{"blockTime":1625204100,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2hgA6zU4PVqkUCe4DoVFhFqr7jJUmtJErqKZCKBiL6TmDEK5yyHPqaXAbzphwRKPE44taaKwu7dmTuS8FqKfVzmE","slot":83948236},{"blockTime":1625204097,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5JhPMXm3wv7kRP5i3meGbaD9TisDWkoGU1NX7ZCMZqrozNFEcdTH6br47ZzkLC32yEYyBa11CKbUnB9DPkCr1AAG","slot":83948231},{"blockTime":1625204094,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5eHySeXF3AHjNgvqSExzfiz8mTeSH1WG1HJrsqkFcyxSKw9JgYDJd7h49fbKFBujjdutn77ZYwGjrVMqyJqJ368Y","slot":83948226},{"blockTime":1625204092,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"43KNxgkivnDyJ9gdXKZQu2Rgton4xT3opMjRcXkFiUgTqY71eaVjs1EWutmRZ4w1rNkbY2WNZ3iNAYUZvMCYP6Ri","slot":83948224},{"blockTime":1625204088,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3tVqcwCMw8hGR3cScqtSs3wBHKt5jVnyg3kpHCcto1inkSrnGHNnVDyzV4mYkEZiyCGc8y4NKUhHbZQuU9jZvnfN","slot":83948216},{"blockTime":1625204073,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"EFwrfKvANGaRDKw2pkVrE5ZQUhnhtTWZMbRWeZsTmM5wVS4xyHfFpSUGGSaSyLBYWh1FD5M3mPPuU5fbb5rY1Pk","slot":83948191},{"blockTime":1625204070,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4WZsdnpHtFkqeecLKRZVJ5ChicEpckTLY1jmDT3oD6pAwxP4dCQ9MMdk2MWwMdf43iVp2pF8GjYq87zSTdEgy3eH","slot":83948188},{"blockTime":1625204067,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5aafWFyyg77Zr6jebpZiBkbgxbpEj7E2zZoeRB5tiqZJ6KWkQHELnQLrd9mrqhRYSecKX3hnz2XcNCByH2EUvdWF","slot":83948182},{"blockTime":1625203561,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4t9egLK4e44wHzB8UL6sdbsvxBqah8qc2mWiCzrpXDzK9bP8fKZMq1ZXb5VEegEYQAjNriptaBnFehh8LpY6Fg7F","slot":83947339},{"blockTime":1625203557,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3uYxbH6GqjtxQGyMFYs3iq4JbttVvn7cAEAfyWognf1qAGtCksmujU9DHF6s9Kc5tZk94tz7FhNxHCf3EM17Rr7o","slot":83947332},{"blockTime":1625203551,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4D1wTQpWXCEeYrGGfp52uexFDNFvsnVdUzGDLHdDJkSZoYbFbw5iTQQoZ8pWJhfXTQBAH4kaP66SMStEN3PBDDKC","slot":83947321},{"blockTime":1625203470,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"35NW2dQq2A652v6LcPKCzN8LK71gWuZzZUhZ5xuD84JPpjgp5vPbhWSFtbWqwze69BjxyQkB29ApGw3iwc9LsVsC","slot":83947188},{"blockTime":1625203470,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"pKkX1eqrcyYZNCNPqFTLbwcPEr7EmV6dmGoJErTQn9VRr1gPDyFWT3hS3NGrLf3ffndc8CuYtceFYYRhwSP1jRr","slot":83947188},{"blockTime":1625203464,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4jy4NpYtHpsuTVUndtxhtsUYBpGwMsQrCgxXgmKTJdrNnkKinmie5YbBVE2MTQzvaCPT7E4DDr6r77qxNTH1ah2D","slot":83947176},{"blockTime":1625203117,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"61uT6dHKReCKW5UXdXgMdTtmGv3BirTkArbRtJjxT7NCL7ARyFnrbxZEiqTVZMvS4wnChi2RK6kpyWfzvQtn2hQV","slot":83946600},{"blockTime":1625203111,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4X4dirrsM8EXoi6kMka9Ar6YYHNkVx391fmTDMvJm4BdhLW4LbW84LxtyZUnC4KFBod3VKsCQYwyCog5PmCuEsDe","slot":83946590},{"blockTime":1625203107,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"55qJVLQ94om4v7tpiocw7VZwLNHP7mW8AhKrnzEyJAurdUauwhVrSj3vRSSqqZJQqRLsUU84oTjBV8Kb7jvMEaoC","slot":83946581},{"blockTime":1625202459,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4WydXkKDYDydCKQEWo3SWmdZPZxTxXLBZJwLvDX62k9hfcrimWWEZyHmJcLa821mbjRPGwpWrPH7MAprfX3Pf576","slot":83945501},{"blockTime":1625202438,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4BfCgjiphrCJUUunAtPtJ7vJfXnFoadCAkA95sZiEjgSJrKDrxCfwcNuAizQCwYEqpPKWnLWn9jZ8PTasGgf9oDY","slot":83945467},{"blockTime":1625202436,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5FD3tYL2mQEDaKkosRwNdY3Dpk4L8NwjM8SQjdGKE3AgmbZT1ZsxPXg3pffwBwJWNepr7uT7sp1k7BygA8dEwP9e","slot":83945464},{"blockTime":1625201830,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"37LkeWVyGi4Eo87UmmnuqBE6XpHa1pkkAmnmG9acP2Xg8Kdixsz9TckVPFa7GxsHCoXkiUG63RwfPQjAkCd4UA3Z","slot":83944455},{"blockTime":1625201811,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5612tDdS11BXKBcmLNAWsYZg3zcktyU8yitEjPyPU8zw6KWMabwSQx5TGv8Nur4wwXUt8nZebw7rNqgPJZSd4m9i","slot":83944423},{"blockTime":1625201613,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ce8RmDfmStxJW6tSicuN2GE87FnAkzpEySohqpBDecZRdhadx2yVLvV8fwczetYemNEioTJmp62eukgpAaJDbJU","slot":83944092},{"blockTime":1625200045,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"51LKeeSa71XBRwxGgarTfmUWdRGxaHbKvp2edYPWcchKpkCXF3dKPM5CYvK9CJ3fGdqXF59v8VQmaFiJ6HgJWipa","slot":83941480},{"blockTime":1625200020,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"z1MQDQFUQss9XGzf2t9cwnd6KkyAhTTXf15mpWkN1SzNnKS5eppZpbM7Vfzx9jmhmarBSopC3YWtCv76LkJhPse","slot":83941437},{"blockTime":1625199859,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3We6Av28Tq37bNv628tcAwuGwfLSnAC9JqWAmRsgibwtD5KSwBdFjEndFXyZ3fUPnQ8neVwx81w5CcQMccbz19Hq","slot":83941170},{"blockTime":1625198449,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4U7PfzPnMN6XA1jkMGaEJWfecc8xmL4c8KsoSBY7csvLBKxgxX1wHHG57idBpRB2hqUnv1xJ6WEaLMfStMFSDXmi","slot":83938819},{"blockTime":1625198433,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"tAPEBdxdwDs9k386EMmCDAktMrJttDQTsWbxTAUdzBFd8a8kNLzQkBKqhrGfhio7Lui2UgCNHNen6mTMb4GmHor","slot":83938791},{"blockTime":1625198398,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5mr6uoHQH1aAo1LoTkdYDL4zLmemrVRF2iDfYTLwB6zVbPDW9Vg5DKCMoL7XUzhYqzJggZFtnZWJnenb3WeAY2Eg","slot":83938735},{"blockTime":1625198199,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2v4BT3jffzdhs5mYmpscwspHZCM381Z4MwCdit5MAvgtCt6ok5F71mVWd5qPcvirevT4onUbhRESWuYmVgY7gj7h","slot":83938402},{"blockTime":1625198019,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"aMK6ERjHfYCs9cZKWf8C42T5WjD3twbJQGFaLP7sYzLG57agFEipe33qDfLxGUpvMuFhnZr66kLHv9iAwJQ4UD2","slot":83938101},{"blockTime":1625198013,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"hdu9ZBfHfDVf4pEi4fcz5amRLNzgEafd7YrmQ52j3w5Y1J8kkyj8HZyWp8c2TmA1CzVAkDaCqg7UbfmfZVzYAX9","slot":83938093},{"blockTime":1625198010,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"YUK4DwCazm4T7JuhtUbESUA2xxN4ryDqQ3Ki645eHJ3p7Q4gUuji737nFicqm2dProhyrgp3iQdXXdfYNbeWVYx","slot":83938088},{"blockTime":1625197885,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3XBjm16RTHamQtbvrNKPVRxXseCHssVipSfDr48SRqvTYy14bMnWyV1pz82dbbFDe6De6N624DK43kqq5vN84CKb","slot":83937880},{"blockTime":1625197299,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4siJqk8h1o5fsTDR29j2Dp8PKTUAgr9ho5CZvjxbzknwWnfzjhnAr838iW9tFgEXfrU6Bm9N7fE2EgR8xcSjtxDT","slot":83936901},{"blockTime":1625197299,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"VwoMK4rfoMqwU7crFQL21oxN5AdrMsGFYcYbjwE79ckxBLuyJgBiS87f9JVgwKAofsX63pVca6u4w8HEEibB2Hw","slot":83936901},{"blockTime":1625197290,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3cWwz5to55oZgy7RYN7PqqiA1yrEnFmRi263kbZveLQ2D7AU4AKhbgyMHqNYSDGuSXZCoSRm91F81QVtpK8svNB8","slot":83936888},{"blockTime":1625197204,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"63DQsgcpVPz4xpWckC8jw2nYtKrPSDFfJtESJuAqrjs9h8Tn2C2sXfWc55qzMEgvkB16pDo1sNy6ChDtfABPzD3S","slot":83936745},{"blockTime":1625197204,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3vUCwDSdtHKb3rTYVpGRYuA1Fs7CEgFSBfQwk5UzBeAmvM11hzKDJx36uLWxq5bs1tsPrwaABLYTxTFVLBW9hyUj","slot":83936745},{"blockTime":1625197204,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Kazmzt82Vzz9YfbgnJwHfdUnopRjgcWaFFxgG3obPZz3sk526xZNJbtFrftPnZe7suPbgDLyxVZF4EoJxeaKdjs","slot":83936744},{"blockTime":1625196654,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2SPudDwu2vyzVcpRrngANWWqsG2ar2VMxJmmbJFPumkk2o96c1Hns8yBoHs1PywxfkXcY8VJ4BzD5XGX1GcUqKjn","slot":83935827},{"blockTime":1625196652,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ccpqsA55wM2tbARpgKWmuenWad4GSbJwwHzYptnzJKXkzbDEKduHSQ8KGGoJaJNTTY5womT43dLzt7bsx7ZPJHR","slot":83935824},{"blockTime":1625196652,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"21uzYNCFQTJtZpeGV3a5FxNU4DFuxrE514rRkMimYziix3HotvtiH8EdZqpZqLwgtoWpnuhtJkwozi6wTNDszN6b","slot":83935824},{"blockTime":1625196184,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5HhetzNvxZBVJRRL9xGpdZoJ7NnR74EmNWBhUqkC19q8yNYJNewnDZ36LvsGdpqFkFVuhBAmE4VXL7dbVKABeKqR","slot":83935044},{"blockTime":1625196184,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4bnmGmBmagY4mHLkscofoTgmdf4PnaYp2pvpYjb8uFkCR42BCgpAtPAGNLjW1wZF7tHCc4m4UUU1ruxHuHNUJAi7","slot":83935044},{"blockTime":1625196184,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4bg4JAYHkv4WFjWGk5XronEgnFUpFpW4tDFTcc6BFHdZ8qMdGUr9BNiUKWSYtm5TComv9RNDXct5XoEiVMimDMzB","slot":83935044},{"blockTime":1625196091,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"32kxGH4iXQUVEUZGE1zyJPRKnY686HZW1B7oeGS6STbDqaGmN4Fa4pHxPU6QkYAUUXdFJBwB4pnRuiR8jWGVLXBz","slot":83934889},{"blockTime":1625196090,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2rpG3qnLKLgRHhXxYgCBGJjWrjs3dUNQtmfsNFZBoZwr7ykhoZ2NxLaPpJBbdd9anPEJ7xsUaxWkG23zqksAB4oP","slot":83934886},{"blockTime":1625196087,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4vsPV5Vdsw96kGMToHqgpyZ6kX2eLTBJoEjaiS7XVGAHgmrSvbBSQ6tPQvYpxLfEHyS5VrrSnmSojxUVL3EGcf1h","slot":83934881},{"blockTime":1625195973,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5SpUHcpyoFubmroLpLhUPyqL7Jux4QGiAGD1hwDyg361N3qBAu3RL9wX45ZegfgatYewSqaopUVmDdMBctxBkmNP","slot":83934691},{"blockTime":1625195925,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"8kAYA3f4BDwBgFgUu9Z3ysfHgAeDyge2DphqP9Tjk1biujUq7bgo8mRsS1X7ckqEzGX43ngDxmsCgzYZj5ygzG2","slot":83934613},{"blockTime":1625195467,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Ke2rKtyr9cryhc2NuJZVa7YM2GFaMq9rEXvzGE8JGCWBK55ajzYT4muxgJ2MHbGxiQ9asBNtYxxgrhtYNAEGTya","slot":83933850},{"blockTime":1625195337,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5qBHq98GTjoDrEkRgbUTi31BKYLKYd6M573bnnKUGZFEoxuYekPhcnivbsvBbFtAd4xvSmzcE6pzeWJBTDu2gdQS","slot":83933633},{"blockTime":1625195331,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"GYj4XPDZAV7DJkjGV8QfpLND6uUvPKPuXJneoo2sYgb2XUJTRv4TkZRRLuqM24Zbo7jk6oZXFrBHGfU3cwUwyaD","slot":83933623},{"blockTime":1625195329,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"TQ9Y9x3BWBTCZVnsAxZWm8xNxxaZ7Bp1q9mfU277KPDwirjF1QR7byThUwj8sCJbCcU3CxY6uvPQmmKnBzdfbVM","slot":83933620},{"blockTime":1625195163,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Jqd73haKM2FPzCaxpvZGDxs4gnCHsUiGiRSG9vk35xyiCxBmRZcyQeJtYQN5cx8iTTfs7Q1RgCxCy1Fonm5QQ6E","slot":83933341},{"blockTime":1625195076,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"61NQAyjUiw9YrEbfA3STiNPz1zmMp9V9NNepJBDxs5xAP5v1aP3Gm5HJmy9A5jP7vDEq8P2imRuvKSW6M7N4qQGG","slot":83933197},{"blockTime":1625195076,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"r775jz7vWcfLY5nMP4BEfPqBbpMRh3Ch3gnwGQfBssw6oayWeEaSBQ6mobh7JNLLXGorpyEtSvrbfAZFVYEWG6B","slot":83933197},{"blockTime":1625195068,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4FMaBb3fcb2VbRV3XJVuPHhxWJ5RR4X1bdJQbJiWuBsJNat2KRQeP3pihXk5X8PGVRS3oGZbU2Ws46jn4x9vau5R","slot":83933184},{"blockTime":1625194951,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5arWtk11DwJbfM7XbtBbhsvTWtJdapiGZqHqap7cCpPFQLoLqCZiyFNxFB6sXYgeow6bTJs1Sdp4x2xueALCbPi4","slot":83932989},{"blockTime":1625194899,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"58aEFW2HufRWKaKvnMNMhrCmeYAub8AQ3eoTrXFS2VpMDfSnYBPJta74n6HMuYyvhLLJthQ9ckhNYEDjFH1NSbER","slot":83932903},{"blockTime":1625194845,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4MFR13tzHee9maXRBKWbKseiu7G8tPSK7Md3BPUWSze1mFRKJ175cF3H9BwCo9qTX3D6gkaMBDyuQWoMKwbi6b6B","slot":83932812},{"blockTime":1625194434,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5uVzQ8MzsC914fMznZ1qHgTtb8WC6ug3gFfXm3eDbvx7HPBnWALHU7TsrU9KaVGixHntBbD2TcYsUyUHvjai8jNN","slot":83932128},{"blockTime":1625194374,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3M3T3jxc5Ht6TH9Xk1M33AUbzDWWggy81KbSWNArUjVNbyrwHGbDEBN7b6RXiYLqSrVLgmWvPXDt3Eje9mNKJzEp","slot":83932028},{"blockTime":1625193526,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"YDmBK1UcPfDUX8JCcA4tiG7qBY28SMfbVcyU33Ak7A2gKJ7y332xcj2nUg9Cascfb1QFDMJ2kSmPyWSQq7Wgnst","slot":83930614},{"blockTime":1625193462,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5QUULUgTRECPcb8UNUbEsGiLdFj5VbsKnsLrfiwRLf32aArYd5vdqiCcVQi6WrHXYGoNoMrJV9ghuJ8Fk8i8f8fh","slot":83930507},{"blockTime":1625192206,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3eN3JNDhnJASRs3LXqXiidW7aa4QX5eAGMNfvkXiQQXGMKPpNo9q6u4WGjvuetZdDqsXdr7o76cjp9M8cZqRRaxs","slot":83928414},{"blockTime":1625192080,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2HKf1Zhbr3hd7GSdaNeBvSDPD4jNrQ2ysr8JLnVaRtzkrbv9pkHwX9mJx1fu5nA3DXdHahBxUtfTva59X9hysph8","slot":83928204},{"blockTime":1625191656,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5HeD8mT8AoQsVCmqNgsJeqZLrJ5zfmEdcEDV4AbaDtNQw1FfX6hSRZ2gxyu1WjuFnLWnxpsWEG5qMvUU8F2pResk","slot":83927496},{"blockTime":1625191642,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3DDexgum5WLTxNQdSVAPqpH723N21VT6QbZcYgPFjRrLtNiQqs6vUAsqHhH6abfg8StX8vaNfb3okKfie4pxohAU","slot":83927474},{"blockTime":1625191626,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4QYYgzcbEGd7ZkjJHfSLsnHESbemQdYmpMxUYbW9BCGH2wmYj4SvZpUBsWHXHtDhhDe18LVdLymjdFQCuqXshmSD","slot":83927447},{"blockTime":1625191158,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2az4bnaB45E8Q3Yrji7mdWvyQX1obZ9gpR26kZzVsPaWLegLUyD2xeD1RCgG8BugrUokNFRsCvgTi37yEVkSbdfc","slot":83926668},{"blockTime":1625191113,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Gd3pU9qhrq4HXaef9vERn65tYGSiGFMKQ9yohSww1tABPDd2Xe8ABiZtsJeNHwjEh7e2XK9bQ5ksaFW2BPi85cV","slot":83926591},{"blockTime":1625191110,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"56GU9ff5N368KDL9t1Y1FfEiXv1nNgCtsDaZaJpU2ufo2wHDciKZqZhVSrb2jUdwYBubJYqddyfxvP3KLBR3fPuT","slot":83926588},{"blockTime":1625191086,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"J1xXUMyqyVTdwkZmy4orZzk5f3gJ3zi2rVmZJWz29J2uw9h23p5w6TEqeGTPZFs69FXSnZtYMvFovtpXm7SMQFt","slot":83926547},{"blockTime":1625190784,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5TL1Q6ZTr1AZk5iFhkjsGA9jGFaQQaGQcn7gCtm7T97WM9xG4Lw4Y6X85Ta6PypLDoK6H6gx42WMZhrpXRGpw2Pn","slot":83926044},{"blockTime":1625190777,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4dQmhtEY7rRE14QLxhVc9jr7o4wUobZTN8gZssApfEgHXJmFea4fUDNbf7yCsP5saY3pFBEL2uLt2uHDrjY1ciZi","slot":83926032},{"blockTime":1625190777,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"qF59GT8SwMrWMSvK9bEE6pE54TFtmsPYus3KeyB3x88Rz5rWwhSs9CyLS7quCaX2JowqmtVpoXmJpk5XT71G49y","slot":83926032},{"blockTime":1625190771,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4iLSkgM734UiCG2uXEUFDp48KVeBY9XRY9B3iM5NdFGyyZe5J5DuRb4CnhTEruhKM8MkuiYCLwR9bnhxUsspN4vX","slot":83926023},{"blockTime":1625190768,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"9euhpAEnch2d9KsPjZsfTP3BPn9m1JwGkY4TAzrb4Ap7C4HBnb66rpeNGtTAJ8wbTvNE5FH3P8e23yXEkPY1e54","slot":83926016},{"blockTime":1625190754,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"66gWju5oPa4ovKuMkuFzaDrNzTwxJTZYLXmfveFYsqyRR7iFoUts7LgzQSMhbQaA6rGDxdNBqBL4gmy1iU8xWvcD","slot":83925995},{"blockTime":1625190747,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2EepsMpaj58XQkoSXoc9k3QnZNyFujHsCZTfBJaYzqk8L3FxkzTtqcgmPXT9ocSLLiPMcS32ftKHHL9rkFe9JLJu","slot":83925983},{"blockTime":1625190735,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"22PsToyMp7efQG2CXY8bHowmeVnYkW8mbE91RjYzXoJT1YeTXmp4CQrrUyVGLxDPE7N7jMKXp4y3LThsLuwznrFU","slot":83925961},{"blockTime":1625190732,"confirmationStatus":"finalized","err":{"InstructionError":[0,{"Custom":0}]},"memo":null,"signature":"2SXapfWu5DZSRZVBNyK9pR48NpMdEr5ecsXexHnpycvJSeN2nG22uFujUnPHyP78SD6FXFgDqTCaDP7wcazsAgMa","slot":83925957},{"blockTime":1625190726,"confirmationStatus":"finalized","err":{"InstructionError":[0,{"Custom":0}]},"memo":null,"signature":"4ZevW1kssABvVt3n78FvphHGeG9Wggb3j8866HqG8qmfXfUujKsivJGdRmUXPMbvqEhiYz3R4bWjHGBmGGXDxeGJ","slot":83925948},{"blockTime":1625190717,"confirmationStatus":"finalized","err":{"InstructionError":[0,{"Custom":0}]},"memo":null,"signature":"3sTDJeHzEQGUPgbE4AxNeW5cNUodWnNKRd7HgH2oW1avigBAdUw9hVBWUN3QH8KcaKd3TBijkBmdHwqjjJHZxqQt","slot":83925931},{"blockTime":1625190715,"confirmationStatus":"finalized","err":{"InstructionError":[0,{"Custom":0}]},"memo":null,"signature":"2tD7on9UWL6BYx4QFfoWfJDdptiLTti3zVisPkUzrzQdxL2QCQr52h1rbc5U6G189tyXtuR8wbhqX8v9q1NDKYEC","slot":83925929},{"blockTime":1625190715,"confirmationStatus":"finalized","err":{"InstructionError":[0,{"Custom":0}]},"memo":null,"signature":"rhK1nkGuFdx3SXomKwi66Nyf8K7Jqim81L6ADdUfyqJC6RGHXgn87saxp7XcXGBEnLTznsZTMRodDduELSDn1LH","slot":83925929},{"blockTime":1625190705,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4mRnXB64Fws4jFaagx95Vg5KYQESEcaPPumJg1UKhP1hSs7VzqcLPU8ZfY4BFPpsU6jJup5TWodErCXadwZ5CXwS","slot":83925912},{"blockTime":1625190649,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5iv9NGa8GMpDpgMwBeKAJXsGSa4Lart2HP7PWcPrHHzJLJZuRc5axACZimASqNqsUx6oNft1rRYzAoi73F8yQ7Yq","slot":83925820},{"blockTime":1625184906,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"zPwqGXQ1WdYCrRExA3r6s4hNMtaskJC71DvwT5K3CHagXqgJreLyKF4rChJeqVmbpfAnCY4LZQdDFEZQ8hsx2JP","slot":83916248},{"blockTime":1625184876,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4q91pekdzgDoxd3DSxvejs7fhhm8Z6QHxa2Tufcgfayy2EkxjktfNmuk1ZghXc9dCvufo7KZGwkiPwAJRVa1rZ26","slot":83916196},{"blockTime":1625184859,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4npxr1UcFQSQD8CKp1Nmjx1maGYWLuyFqqEp3dhhxHmZkDM1rmDYTGyxD4922tg8zGUSK41jUnm1Qvj5sddqLzeg","slot":83916170},{"blockTime":1625178234,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"ArnWnAigVij7UAnrTkaUannWNQDbgjoDEB1ovUEAj86nfyDiUHibbbMn1rCtaSFmxsUrdCjYF5oAuYVu98NMkAZ","slot":83905128},{"blockTime":1625178220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5LvmzEqSERcyosvfugzuEp9K51PE2rjmG6sREpbn9qgcAisHzAgNzyZf1xQJXAahCSnLPoAn5TopS98AHXBFej8g","slot":83905104},{"blockTime":1625178175,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"L3dFPntNEQFnwHA3uQG7pGGuTthxrakexY7dzXztEfhxQ6u3XWsUq76egUaob4P42qJZcF62wLDUzMYogsbrqoN","slot":83905029},{"blockTime":1625173068,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"49gJWqytrqURFVKKp7XgH5ZamXUVMtBCcSKumVme1xwTkrjrYSFTyaTf4abVV4Kh4poWAt87QTo2shJSYyWwELZQ","slot":83896517},{"blockTime":1625173051,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"46hajvdjZRuhcXDkTmXAE47zoSG8cAgdtWen2kTbgZ4rzMyu6MGFbCgUhVf92gHfuV9KRvmmYF9rWMxw8SreVd9K","slot":83896490},{"blockTime":1625171497,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"DUxhxKAvcvtxncPMB9J2r32Yg59es8t9d4RZJtVd13iu4MASjJ9RyY1nW1tK7m65Le25tEBh1wyvJc8KyemoN5W","slot":83893900},{"blockTime":1625171461,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"SWujFNsW3pKbVL2V2kvBshfJPwVALWD2JYmzqX3hEzQvXZsZ1ZQ7vMHvBUuo1MVP85aaX24P4Z6jy7Jj723xb2k","slot":83893840},{"blockTime":1625171457,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3qLDK8H8cnCZSnQNHQoMxve3PuNaExpWCSTURSxBAJA9pZE3jB8KLnFJsc6pNSgYdTUuHgd6weyHM35pK4PidCbT","slot":83893832},{"blockTime":1625169264,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"551s4YqVXP8Vq8UzDkoXRS2vXQJYC97BwJsKiTXdjejh1NtEpuNVQsUg7vNUZCnbetaa6Wn6LdJHhVVeecqAnXqR","slot":83890176},{"blockTime":1625165809,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Nctjdqz98WbPwUNkiPBYnmQYFeNfFs2h6AiVKfWKeu14iomPL3gq8S5yybNt6y8834nqfxnPpPA4YAHDrxpJRoB","slot":83884419},{"blockTime":1625165785,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3BpqbQbvDuyZ4CadA9FhQmqajXtdYgfoF6Dt2WSHkmXj3D4hUF9S5iESUoyC5Pa5v5jx3wLyPzA1YFMW24GsLv4u","slot":83884380},{"blockTime":1625165754,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"249XuKhQhwegGDnP6F5xyAJHp24zjQnRKQXTu52pfUDRpL1dZChkBGVUe8DvUVLhHSbSL4EYQpcL3dCExiJQW6Xn","slot":83884328},{"blockTime":1625165325,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5UAANpLLkcTH6cyJc2UDddQFdn7cB6R7EH4vkoyPXCTJrpE84ngYoYx6jKcqByptdvWSaioRtXZvcUMdKa22ZbwS","slot":83883611},{"blockTime":1625165317,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5LLBikpbeVHtwiLsj3RJd1jUuGaTY9Bc16qoc5GjDsT8W5jMjT2yHGZtvozSmWLNEGaoYy34D4VZqgFnRh55wF4q","slot":83883599},{"blockTime":1625165301,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"o3mWtJuEmgAM21mLCvJ1sfuPkdPaKztyN2rR98hUczeuLmNDmvS7a1rHP9csv2kv9YhcFCG9pZBA79Ss832bGRU","slot":83883572},{"blockTime":1625165289,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2EokkZkWUMhHw826JkMdbtm8d62uwxoyzf9vETK4h4YkSXkCL6z7zF2Kx33dbr6TtW1jwbdthBa2fdBzkPjrJtT8","slot":83883551},{"blockTime":1625165007,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"35E2NF9tXaQdEJXABXBqa12feAU3cyeqjZ4oxdiXFcMCr7sYzBh3snjHmr3zYAhn81P4ZFTiW9PphnEqmwHwA2tL","slot":83883081},{"blockTime":1625165004,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"ccQhN6DpJUcXFnRQcNjnfuyb9JCBA2wpB71n5dWUPmngJBmao8JB6mFX9RvLbcRWRi5WXkVfVyr8GKLUG8Y3CMT","slot":83883077},{"blockTime":1625163103,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2JTbwbBgSjLxYLk9GHwSTk9zmBWtk8x1E4eqw2sB8B36gYKH4RHZhVA3R2fhA6caXjGKdm6gm5mjaKztGqmsFsp6","slot":83879909},{"blockTime":1625159988,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Rq3to6ycetWStzZTQJLRF3QvEzb93r85bbKc6NTEZBgpfz5YmiEUKzyCYHaSFAwc72AffWaDkYaQNjFWU532wVA","slot":83874718},{"blockTime":1625159838,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"rqeiciNVS6V4pGibzh3eYK8U7eJ5kDfZ5QDGyLrGHdLWZCLZD9oK3WmFezZ8fGSL5MwBH15B6x58ro8TaE25cPB","slot":83874466},{"blockTime":1625159376,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Y1bHo1xSZHEYXfJANogaWwWJf5xpN8Qbd1rxqEWfCD6oHnfYAcAZbRapjcNqMoW934VKNaDvjaLsBABXdznn5cJ","slot":83873697},{"blockTime":1625159376,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3zAH3SMJcAd1mLRVzmM5M1AuyhyxMbm9WaCM1eD74fBGVjiVoYAsykwTGEe4bJXJUjUxZBJzwLjoVesYUqB1khXY","slot":83873696},{"blockTime":1625155117,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Q6WH92UqCkwADw6r88mNKQ2KDWLDK2xUPEcwLwAJxyBwuEwg6p1ujMWjGF4ceWVC7mQ5z9JmUBTJ4bUdmBvUA9r","slot":83866600},{"blockTime":1625151457,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ACFd6GQXzJ8hEEULusVr2UAzdy1zXRydDjbarYaFTdp4JN3VDpq4oJKJThEApEzLBTyJv8Pk5VEFnguWNFbx5FF","slot":83860500},{"blockTime":1625151193,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3K41kyPxtTGsRzZAjjmvgExZecSSs1A1mRqnDyVHSDWw5HAVtptX8iDGds5wp651avdLpW6ZMjvmQ9dQCaUpL7am","slot":83860060},{"blockTime":1625151124,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3wqgQ44RgJSojZvN7YZXKRWpJ61pm7vpCkWftZZPC4jMc3idqmNLdy1A1kRbbiBGZvhAZr7np9fdTMJcGYf58sU","slot":83859945},{"blockTime":1625148349,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3QAmAcmZQXMK5wY3kiEg8hXS5LGKX95U3GKtwZh8uku4tqvXWmWiyF9C5k6Cg7ciBUVkLz44h1Ns18gRW4zkV4av","slot":83855319},{"blockTime":1625147988,"confirmationStatus":"finalized","err":{"InstructionError":[3,{"Custom":16}]},"memo":null,"signature":"65efMaruKikKUwBjWJ41DMUShk6iKro49WkeP8eEKPBE1ZtVMdC79KX6zv2J8Qw8kfCheohA3hLYNSihcK51qzEE","slot":83854717},{"blockTime":1625147881,"confirmationStatus":"finalized","err":{"InstructionError":[3,{"Custom":16}]},"memo":null,"signature":"3MVMxHJp2NgSeZxu3ifWHkFsZGzt13zQNtRraqyiWmjtGiUt9UBbtmsLCAh1WcvPwmcs7EpsbWkLrw3J6wJwv8Wq","slot":83854540},{"blockTime":1625147860,"confirmationStatus":"finalized","err":{"InstructionError":[3,{"Custom":16}]},"memo":null,"signature":"5Gcx4N7A4ZdbhsFAQEZDyMEZuej5Tv4MMGD44m5nyXcLEwtiRyY7rR7H5rt9X7eCQ5F97ePQLK6gZSrBtMFQmAYh","slot":83854504},{"blockTime":1625147842,"confirmationStatus":"finalized","err":{"InstructionError":[3,{"Custom":16}]},"memo":null,"signature":"NJZ4k85pqWCpBZsp5kcvcwza9jSF1QQEEyxZ4eyJecQ69cVvLZG11GF677nmRYjW5DNqhowyPnxVQVMXvb75hkr","slot":83854474},{"blockTime":1625147799,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"NjSYmqrFwqCSMN8oyRUqVSTrB5nb65yvsDxSqkrUkiyeve52cBxx8PVKd6P65A7khMzBxEzKKBz13wLwebXyhQR","slot":83854401},{"blockTime":1625147755,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2LGazPnMMGVNrVLXUwQPoKMhoSCD1fTuh7pssnUnMCXbjwaMcmmxEGec2QSUjw7CHaXoNuXohw1ySjme1QWpPN9A","slot":83854329},{"blockTime":1625147707,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"TwEgBNtE96BxwFHgpmeayREXNkLJyViZGepEafPMV4vo715xWzdMuW6Dmcpnnj4NnBDYr9feBP5sc7bGHRCG1GS","slot":83854249},{"blockTime":1625147688,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3datmJvRtwzw3NGFmf5oY6Me4rAbaHXHtnX9pWQWrma6nFX4jGXLXCv29aR6DrVzoTpgQhNd6JkAtx1h2Go6M6XK","slot":83854216},{"blockTime":1625147676,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4s7y8vr7wWXZugNtaX9rEtQRXTyNNyai1UUuHqQvcGtsdgYxuZs7dFspAG3U3gYnSvYyToTCqxKgiJjyYynRgzpi","slot":83854196},{"blockTime":1625147671,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5eQiidozAqSqctwdjUPRb76zHxXdVcbfhXfFCJKwkEFii7rzc95Mc1CUg4hvtGjQBjUNie1A8UfD8mTHiwpfziab","slot":83854189},{"blockTime":1625147649,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5wmeGzEDqr3NaJ5J3HGsmGpDtbG5ntR29C4ZAQDBAbWewMzKpz5NXhrJk2kHEPn582jeQ88ssxXcPcZRatEfiEYB","slot":83854152},{"blockTime":1625147622,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"kcod9wstpp1pzT9PdvpKy3tjhBAYAV3sh56ueGtLEFkrpidCakWEheHHsaxPuEapNUrkm4YCwog1yH99NXWjtZz","slot":83854108},{"blockTime":1625147611,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ox75LtFjeWKxgVaia2g5KqWVzjX4US4jZpV9tH2PLSrJcCMQJGwTrF3W6rakirGLtpERyBWuuFZ6wAH9C2FUjWL","slot":83854089},{"blockTime":1625146687,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"7dK9zjdi38HXCjwpMfspqedkwgZM3262w8cwbfjQ9h1pdewjePpmztCaUGWW65bVAKSjJ5EcuqsN9XYiLsHTFRE","slot":83852549},{"blockTime":1625146659,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"LX2iE9qwJaobFErvd1fKnwYCzNrG7Axhppp6W9ydsBKjKvEfdf4YSUBXVJvioNoAzKBXBetU6amcCT3zpPP6b1V","slot":83852502},{"blockTime":1625146284,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"YFzyHhojXL8Fu59FpdXsWkBYn99bXVHcpiDryxKiNnjqyFbU8WbtrBq3hsZBBJXpKDdXHJ1rRXPY343ik7tjKSU","slot":83851876},{"blockTime":1625146275,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"mgfUfRi7DRs5UvJMLq1qWNqiWqFz8YvMZNNZeouS4vvebgKND1uz1e6dGJ6hJgrsWkdUDL4gWFrRckZSRgu3fhj","slot":83851861},{"blockTime":1625146257,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4RMTvXAS65GUrNhZd1eXwyNYKgZP8fbCdEYVNCQ8KB7zETEFUcCr2N2R7RHtZ3Rhd5HRjKAExXzA7xJFT46HktB5","slot":83851832},{"blockTime":1625144371,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"DXo1rWoAjo1etiKzecHW7m9fUfgF38cgHhNQQEbE77aTYB2Rdk81FRXhm8K1u8yU5KK39MWD32Y44YLDsSotRMk","slot":83848689},{"blockTime":1625144308,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3jxiCjn32Y1TJUf2r1UgYTSwSvMbXaLF4tz97t7Y5dLHwSnRbmu32kWvjgJuHHSCuooyHXP6q9Ja6Pp5f8q7uTvc","slot":83848585},{"blockTime":1625144290,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5FY2P1jJCPjMEY6J4CcJSjfdBiDtJntfLmBM94ekLbZns5kPQwNab2URzsFU8S46EtUmjKtNGSQRPjgRWd5Z7EKx","slot":83848554},{"blockTime":1625139489,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"63rcGDkKPnSdfYAv7KvVpKfmgMkGjSi6RiiJRQAigC9kWwE3HPEkRLBS2UTQAbzVmAZmFmntFLf21drNBroS3ffn","slot":83840552},{"blockTime":1625137267,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"8mi9hvpnVXfV6PcJYitiEFQCT38A1sPvQguVJQRtKGJNkHZn2YWqU14ngvYsVmVdzpjFZMVWibwXSd4nVPRb9fz","slot":83836849},{"blockTime":1625137240,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3kcam3LeBq4ZHvTKZaStJ5mdWUKJkQESFeVejw7c97EfUQRLJamqbTm8acwcmXhoFvdNfVtM7mxS863LW51DjyV5","slot":83836804},{"blockTime":1625137200,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5wGQY6URx34tw7TS6oLP5sB2AJQDvTNVLgVkd6RkYnYPCUvdW1VJSnspfeSy3ZBnxkz1Zkozo2ubnBDEo5vXze54","slot":83836736},{"blockTime":1625137090,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"392DLjPfqdeFvAPD9BmFzTmcQ4uYYEDJDNVe2uBDoDdXbKfHbhFg9V7ufm9pLcWGPr2WBUf2MPRpMxSMFp1WzP35","slot":83836554},{"blockTime":1625137068,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2RnMj87PCGtSM7oFK9yi3X5yPMx7LQdd85Et5g8vSwJGJjJzoJHW2Ls94zdBkJrtu7YBck9SykJcoXwUiAv1hC77","slot":83836516},{"blockTime":1625136415,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5k5yuxgSPmMZq7wZNY8tt6ENM287w2ZkspCJwNwU88fXwvHyjs6b3Pr7HVWSWE3m39SMwkEPRffkr2WoYpU4Qgaq","slot":83835429},{"blockTime":1625135226,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2a5tfuiRB4oqcD1gXFbT3o6ejLiTyFpTwZeNLkf51FV7qaTZg72Vvu4XmggJHqATm4gsVXVXJpRLTER8NcavcG6d","slot":83833448},{"blockTime":1625135217,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4miVGeMcdkWbpKGyeHmzCLGWQZo7iRDvWkQah3METMTRksuE13E23ueS8qDEUiesfJFNPtfVBFcRE9EMBTEJBTYW","slot":83833432},{"blockTime":1625135209,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5PuUXiAy8Jy7DZrECTqefB4pbgjtvYhmPefNbK6RydWD3Ng1G5ga6skYMiYZuT1aKtttCcwifvRVAVxD86L1BwUW","slot":83833420},{"blockTime":1625135202,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"63AiTHZWTTT5pi29QhAn7xNroi9MEMbwt6YUxHi796UA7rV22kCh14kYx3a26gPun1HHe7X8ASyoLY9ij8b9yC6V","slot":83833406},{"blockTime":1625135193,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ZaJxbPKPY1bkMKnNY4JYaCrGm48AbKHebwhi35KEJtbpopHif7kAZz5q4pftCg2xSqnx5PSDwrd9FKeNs2uBZTu","slot":83833392},{"blockTime":1625135187,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4dgS9BkGuT9b3CctATCtgyVSPrCEicmeLGemitQybZaZmPFWkrC7N4vUqnmLFoRA3dCmiMKv2b2wVYoEYWB1CafN","slot":83833381},{"blockTime":1625135181,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"21hZuoUvz9Nx3Jp6W3TW9oLG1R9TfmYy3yn3UjTgs9z2UAGiR6LJT26xKmYdCeULU1qaSEBF9aYLeRFvcKXTiS49","slot":83833372},{"blockTime":1625135176,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2QicQoBpQQNWs67S8kxAiESaZ8PdGm9arnqQWbb5RPRLBTJkampshmUKyPUdxgfD3LXEzoqSH1WqnUXT6jod7k5d","slot":83833364},{"blockTime":1625135167,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2cfoZS7jnM5E3rpD82aUXhfpcBC1pBhZwtJzveY5nSug75x1SBoupWLSU9HyvRkXKYECSce5TF65c1qc6cWEJDxE","slot":83833349},{"blockTime":1625135154,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"UApfigmN4SeHdMYqn1GcoQeq875fLh5u25wjQY4WXyXanYYMv3gQzmgG4AoBKR6uJYkT1B4QVcvE9a7VzqbJ2bo","slot":83833328},{"blockTime":1625135148,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"XvXyHmqcy2RUxJiMCpg8YRhzwZjHU48GLuJzYGPQ4SAi4aS4H6U3oniPEUqcTTt1dPoh68Apc6xFA8Dzs6Lcwf8","slot":83833317},{"blockTime":1625135136,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Dk6Armh6zaERYnZCgxc3o3xLNsZRTvuEDTHAkB66YsSMYKVcEhghjFKA3qxsEgwQjW8GAwAfsZ8zLuoGB7PSWDx","slot":83833298},{"blockTime":1625135130,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ZqtC61dfmr3FQUgW1JJtmgnLb388bHYw9dkt1SyLF6Nbi8sejmAM84BSTYLFWq84UzQG4WuaeEvExnxSNziJHAn","slot":83833288},{"blockTime":1625135121,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ChiKqhcEh5SJ3usXXE9yV1iDSZmUCC5AyLCoraB96zEt57knRtiFKgTPPiKT8MZo7AMjmz8ahu2BhcHeG6JAqVS","slot":83833272},{"blockTime":1625135107,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4usGTvtkM82xLnKWUJGxxPBAR65AeJHVhq2dDExdzdRQZRKaCqtk2vt3sv3GPXzReN1JhKrgBquZAW9pd3ydkuZ4","slot":83833250},{"blockTime":1625135101,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5VFXFBBFFgpsJyoU5fmhkpCkeqmWXzPmnMkdN3NSg78yHidMR6HUo3jgbMDX3ivau2aLKkTDNufsGmjf9REsHvuK","slot":83833240},{"blockTime":1625135092,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5vQa9c4ci6by4Q9pfiAfCh2xURNjoR8CWFCFNeYN2R57KT97xFDobfJD2RzxXYC4o4t534pXyvkgNMGGX3tFmGkF","slot":83833224},{"blockTime":1625135082,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3jczBJLAnoKyTWGsr5Nm7nRXEFi2U8Q3Wy1ynS12dySKGQYDnaLsWUdEngzDp7K754egwsNk6zFtnihNLKiBaunb","slot":83833208},{"blockTime":1625135073,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5fzhXaRbGUpDdjB1R92uD3o4h7qQi3hjGxk6bxxPciAUkmcaZtP99SzAyNrNz4SHyUDKdWnwaNpxkuGxrVWDpouG","slot":83833192},{"blockTime":1625135067,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4LvjUTQM5hM3FnRtMrYEyRmDrM23n4JVQVAUMHZyzCRCQVy2WbnbQRoA4Fq8EMHU34yZzfw8xENShEJm1MKv2DCY","slot":83833181},{"blockTime":1625135061,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2qBujfy3XcFzpYRtMJo4wHFxvceBrCQZXvbzhcpiUK1eayFNAsS2KNYrPzykEXvsSBfDk6VWatuSUT7UUZFQcpnE","slot":83833171},{"blockTime":1625135052,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2FW9ohtQ2KYjetDfMdSvff6KFzkQ1QtDLDxCNbSAGmghiUjPpcYCaVtwHGXpRmVseZnjTa36j8GzFTWfcjByMass","slot":83833157},{"blockTime":1625135046,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4jCdGp1RmNVfVizWUj95sKDfAyw245sFAm4SNMRUcWXMEknnijH5m1ZkDHy2nmAtFj2UyLMZjeahS6q5uX9vGgQp","slot":83833146},{"blockTime":1625135040,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ywWKgeKYwVbg4GrJ8xJ7XQTMDA2MuqVmUYnwMyqmXEqxxvYdFnSC2bmsWpjAAt4xQXsut9oaAwdKUhot7qiDD51","slot":83833136},{"blockTime":1625135034,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5FpWdHGQbMztqGYFvpnnuMDcKdiF91zfH8Y2v1Dim52eUCgxppuASPf4YvN3o11EfboL7K2rgfc8zm9PRj3iVF2X","slot":83833128},{"blockTime":1625135028,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4n7WBUvf93PPsjV66JQHyqJfV79bvJP4R7yj8YefzNZbRJcNW2d1ShA2y4xtKMrHqWRtd2ukGwT5Sr8oi6S2jLV1","slot":83833116},{"blockTime":1625135016,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"386HXX5MsMembfYeGpXqQ5vTkqVowWWTVVgsixxftiEDbV2yp4NobzfMSbH75UKh3RxuiwW7vd8oGus6i5QPRiw4","slot":83833098},{"blockTime":1625135013,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"25HeTAj4LrhgxpGgvXjrHDWVbcGMEEMYRSqSHr2JmXFgyhVdrnUmBD9Sx8Fj7xoV2BcMctF2VgrLME2tFDK51YF6","slot":83833092},{"blockTime":1625135007,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Y6rh3EsyNs9DMe4DbtWWZ7e4a1jF1FecAhaxj4fE5XJcXEb6KUD6qpLTiCJoQzJx8ZuKUS9pdgeyVT3BDCHPmjB","slot":83833081},{"blockTime":1625135001,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"63DUcVQfbbmWVLLsXtGB5vC8J3RrjU3uWeRwRk2oifSoDHVdgumtWcjdc4ockq9KurBRyxHtfhZ9c49BUQR4N7C1","slot":83833071},{"blockTime":1625134987,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5LiDQbtvuih6LwCpSynFy7wvtXU9xLhGZiA4kKGvYE967kjfyTBHkUAyL1RDkHLorke36vzHQMExbR5RLvdAHJs6","slot":83833050},{"blockTime":1625134977,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5r8fcR2wqiJ6XNwWvfnJKqZMLgBrdXxtrRCgwvwQq1VsEsP1ZhWAc5jUP82JAmaFBZue8eQ5XB53Ejsnb9b5jV18","slot":83833032},{"blockTime":1625134972,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4rcWVLe1jpSHTPmXvnDqBAekps79ZBzpVo5w2YA5FN9Rbt5i8P1RUv3sddjJxoJCGwVD4HNczuM8Bpf9RFGi2GBB","slot":83833024},{"blockTime":1625134965,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3V9yVdnuRnmzgidVSrMTWr9EjDte4oGUvbGqAMC6oRpa1SCiuEyPbKqxizKHG5LEFQoMcSSLMYJgrUqanE2YrVmi","slot":83833013},{"blockTime":1625134956,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4p2ys2SDrHs9JTk8biERpHvBWvqrcKBME2CCMjgLyGqXiFHcaLiHJJ2u3Dkr417CwmM2FPKBDuXuKWUoKGvXnM7K","slot":83832996},{"blockTime":1625134947,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5vydfM3xWU3NzbyBVep6sRAjxLDRokbRXaC1GYNA9sknwZQnjA7DSwNVMJ68jj3KzHDESYMgEdGuUccTzxLY9AR2","slot":83832981},{"blockTime":1625134941,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2tSj5TjraGABStaVhRrhDg5qfRzZTa5zqtToDezTnChuV9i6EwHzioqTxsDkdm6irpQBcLpaeKbENNQyczY5RgsS","slot":83832972},{"blockTime":1625134933,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"49BnY1bsE7VC4WXmyYmv225vAJEd3AhzKffJoeAs3d1JuLfATk3cF6CgWayCUZMWiT8NaXpFHELDpaPEXryt3mzp","slot":83832960},{"blockTime":1625134926,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3jnGbbAnfhDzZFhiRVHZ1XxH6D9wCqrGqVqiFSponakt3zkQuGSE5eEFT942XpS6A8Kc5ANronLHGs2weyznfWEa","slot":83832948},{"blockTime":1625134914,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"65pqmMMj9X261BpZWgzihG5sP2KSsS8YPFb1CjEK4hxCvUSemvaJXBA8JCrTLKjeHz8FGDt3LGDTE6aepu3AjCes","slot":83832928},{"blockTime":1625134905,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ezTa1nytbCxHGPorqAZBbkGX6BzfWssyhe4yHvRgn1tAdB45bLUeMhNT8BRAHynfX4bxrhbWZqjTCGcewrXFgba","slot":83832911},{"blockTime":1625134900,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"FvVM47ngArvrjbeddoTK1TLvmYQqwo2Ag3zzo144A5FyExgaGkcJRUAD654vnAUaPs5GcdF3tHWugSKX4vNa9M5","slot":83832904},{"blockTime":1625134893,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"22piiNXRJUQVyzRrxuZ17Jts2FwKiaEKbShjjY6Cuude5f5NjY2Qfjssg4Shx3SsiR5aBeEa4UMhquTnxvcuVrJB","slot":83832893},{"blockTime":1625134890,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"smzakmkS6oFaSPPp2GDdBP4kHj7nQwaSAwhgpENuvKozHAr2HB1at2du9Lq9buyayhhqaHwGecVZs9rsvMU5fMP","slot":83832887},{"blockTime":1625134881,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5a2LMf62zCZUa3d2UyX9Fc8rRC4Z8M6gsXLdovEHFJ2Y25g7ujXL4UdGT3jqVzdLHG9UcPbYR6iSMJ79XjNZd33B","slot":83832873},{"blockTime":1625134878,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5qLbT77fWTtQV6QYhBYGYyb8DbX5RR6zUctpnwiSSdwHStu7AMqPJeHPWr6Va6EQkRDAN4nvv5tfo1ErF65Np74X","slot":83832866},{"blockTime":1625134872,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5b4hHoZ8XpbPiBE65xxQE8FQz9hqErLfpHek5WkbaN4Jtvg7p1CUtJTPRuH7vrS5iq4zRtkqdYUrT6rNBsSp5sL6","slot":83832858},{"blockTime":1625134866,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2o85cHoDftHgtFRpCsLcBmXvDhAC5y6VkR8WqH2qU3zzfPCdMy7TCabBomzUk11X41DqVJkKcco2bjUfgvKmvnap","slot":83832847},{"blockTime":1625134864,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2C6xdwP1eLeu97petcaNpjiRvGLaZkbpvDtUJ5zCZWU2bSWyV5SRoHLiWh5QoA6tsU67gFCcXVCB5ZZUdjs6256U","slot":83832844},{"blockTime":1625134854,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2eySV1dEUPiSDVGzPyAbvAKKCWMc4eE8JWpCPSTgESyGjF4JFk6qicAZT8iJoG7AvHdL5yKUSgUjyRv3yRce5t1H","slot":83832827},{"blockTime":1625134843,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"23CotGZHJH6fLUF35bt23TWjJzUis7kY8Trm3y12rN7un1ghry9gYkzaELtXLATpMubhREe5biWdKDsT6YhmKzAk","slot":83832810},{"blockTime":1625134825,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3o134kuaQwBqpeaPTf54BbzGaVn5VozW1dsQF4oS9YfphiEy5NcJn9rtZ4obwS8R55PMihMiuURbhUMNHChNBnqu","slot":83832780},{"blockTime":1625134816,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5egui37EGUrNjRZTYZGc587f5JqZo87sYBSMSXDdPUbj8MuaaVsrWALe9DWNB7XGYi151WJjCAZvZHqaDLocWYoG","slot":83832764},{"blockTime":1625134809,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4jNA3hGGfqaQ7TqEAm4sccUfmJoXUbneDZva49DCPBnF538NdFcaha7SjbgTxG6bC5U33VFdoYgPY5JuHPbB9H5y","slot":83832752},{"blockTime":1625134800,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4aGoW7mjpansTTKCs13J8QhmLRA3KXpkhmKaid3xUT1RqhJKF8WWj9n9ZYMvXodnco5EoPEg5FceSzxnDzif9ovB","slot":83832736},{"blockTime":1625134791,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"28wrJJdrkAZu3qKhqidYWyb6vvf93wFP1RcLKFRnA5nbe1kE79d4sBCQkri1x6RbKVZGx1qzs537PpqQgNESq3N7","slot":83832723},{"blockTime":1625134783,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"fVKLZeBJv5VnoSPEEB3PDGzpM8GkakeAAibFap7cbYzjeET6cY9qWgjXm7NUxUGapvvbqJmQVp8y1JV2QguN6cx","slot":83832710},{"blockTime":1625134777,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Ao1bi78xKNZgC6b78PvBtQox9hs8wSbqTQK4VgZiES9zzuPKt1y4TXFDCvzFonuHXcGakEwWEBNsnCGLt2e6K7g","slot":83832700},{"blockTime":1625134771,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2denXrutfzVh2nEUPjWwxgsG4U5ZtHm9smarJmovsNrfDqCGuaPLkfH2S5MuBNsQMaRJGZiNjG8iR7g4uyxyKN2n","slot":83832689},{"blockTime":1625134753,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5sMF5mPT1wMJBmCi5ypStsgvRiTVo47US8QhNYe7DAGMa8rfTPMtoFUocRUFDuQ3fLHJdFsFrdcdpvpfxKnSn58f","slot":83832659},{"blockTime":1625134746,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4T4usuFPy3yYqBr58AwQdRuM97YWCAj1yQWDY11CnCoKx9XzkFPrTsFkyG9tjVWiYRe2xu5djt39QQ27Qqn5NETf","slot":83832648},{"blockTime":1625134737,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3PwQTTUd4F9ZfG8XvTwrQuPu3zM19XqgvJ9wWJFuoSDWQiuGa9dVLFeFxPmPi3DziRVLK3ZySgSSgLyWu3ujxh6K","slot":83832631},{"blockTime":1625134720,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"44ddT9cmy8ftiHQrJqWGy1hRqPbMhuD1soEsmrEeqGdnPaWYTzYhvGYSstpa3i2VDPkUFSJoqxy56SW7ZbqyQJcY","slot":83832604},{"blockTime":1625134713,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4nNW9sEYTpiNHaMxyJ5iznGjFWaQ2CMoi7RiYLssCato934nD9VwJYYqdBTWg7ycncitHgFmSjwXhZ83pAFh1CeV","slot":83832593},{"blockTime":1625134713,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"qfbKNJrAovkphefhCTDcwdPFTqFWh8tHK28peFDV4XY31VufYFcBN83aUoSw2nmTLkq4sWTJFHRdKrCwJqa4hH7","slot":83832592},{"blockTime":1625134707,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3waoeo4r293HnWHWBaRPxnQMATtcEAvgjx272v4uahrH6x6cWzUBLU8ptiBQbLv1rhVPSnVcU7sb5nQG59ETWg6Z","slot":83832581},{"blockTime":1625134701,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"vfcQAVxr9i5D1i9t7QXMHXHvSsA9RgomdtLUuzcuSB9wfFd6ot6CgF6hzdktkHzc1a6MF9sTfQ8xzDWkvj81pE8","slot":83832573},{"blockTime":1625134695,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3hNsEFW4pR3CA7mchXUqfyovHgRQheTBBDmKb9CxUAVQQFY8RcVRvhCvCB6CmJp6y7GAHN3HhQfg8T7LR9j8oXgT","slot":83832563},{"blockTime":1625134692,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ax26CEb9jdxCM4GL7w3wTHDMHJ3bn6rpay2xPZHEvyCSZemuacNLPohNMtaLPfjvNSqcMUjwAFwCKys4BWitRuq","slot":83832557},{"blockTime":1625134680,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3GHzoPSw7fiK5Anm22e6xPHWDREVsnREciJgSftHAnQXvpnfrcvVL8pnR3Swy3fKkWjuFce3cyoNmSa1f19peWFK","slot":83832536},{"blockTime":1625134672,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"34k65XMpGHPUFP1MFf5PxE1KeZBkBpkgw7BQakHEDa3aDRk6rBMBQkJT6yCEWdkcjUStZQof7cWrp8ZmfcdXVWBq","slot":83832524},{"blockTime":1625134668,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"64r65Ztci5fDHPsC5JLxvGnAqQWtBaxRxWnjgBrVpMxiqB2HBpoG4CKLpMwvj4ZS65xP9sMcq2tsumrWBxLixemJ","slot":83832516},{"blockTime":1625134660,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3VZF155KTwndC1WkV4F9QFjM3SZnrpoMn3FmaDM77Do1xvw738K3RGFgAREqFEyfxErKjCiqVMh2iSDYwcVepZMP","slot":83832504},{"blockTime":1625134654,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5hdi3hx5y3Enxd1cjdmkfJzUFQrZisP9Q7u1Qgo4k4HuqJAZBE41hezKmJfEMYCYPjGu5Tzhkbz9wCECmU9ejFG7","slot":83832495},{"blockTime":1625134645,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2PCSJRKRTqRSA2BQNFwqA72XxRJt6Wp7A6pGHSGk6S9Ha1knnFzNbmiRdzmz5QWwCLCd59dzSyKJ5yiodrjCgVWy","slot":83832479},{"blockTime":1625134638,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"fp2D3h5yDQYnoc12kdQL4wwW2kejWENWiN1JxsA6PnfisegXxdEYxYChyKYCz5QDG62da38BrfCPQQxkHNPPgAL","slot":83832468},{"blockTime":1625134632,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"rtUaGBj388zAuocQD7FP1hg9q9xnUb8UctqdixPQtmQ9ofuhPUEN1aGmyAu9kMgyiRmJukjiptrVNEjmRLymXaQ","slot":83832456},{"blockTime":1625134626,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2J4LbA7w3ravCbB928jEy38nT8awUgAEnuvUwkSzGdi2H2fmV4e5HmwbzWgVoGRgkNRnJ2tKNLKm15UPWUjJZjRW","slot":83832447},{"blockTime":1625134620,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"rvn9tBmViEEi56SG35LBFaHfZkdHbXsVcC4HYNMqPQF4D2bXsMBhkcLZDdvfLNGkXLdGsbEpdbVs6QVX26EHafJ","slot":83832437},{"blockTime":1625134611,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4xN3k79n1uhpDUF4SHWjRxK4qanknJZSgX8Jusey6Cm7GGwrg2gcQoHE5C5UTqF4oUZer58a1ed3cSK2WUZ3m2Ro","slot":83832422},{"blockTime":1625134606,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Wor55x3JPJL5FWviNPj5GbFHJ6hEeFX4vdJRDEfwyj1Q4a9TE8frfr8XCj1XV18SQD5aAPhSXxVJYm9KKMZUSdV","slot":83832414},{"blockTime":1625134596,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"qrZbBgq8iB2MsLc5uoxKT6Fmbw5HMUiRJkpE2gjaetxxoHjB8iBf81Q54Vm1v1Zv5Ls4Emc6Mo3W9VezxU4f9ar","slot":83832397},{"blockTime":1625134588,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3KsocAhbeSEmivB9bMTHFQLgV1QeLthhExdSGjNJfbGARinqUniw9A7G1LBB9y2QQe6r59QdDeVsxm2S4EYcbkiJ","slot":83832385},{"blockTime":1625134584,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5d9GRokeZciRzTWrsvf2YBAjiuS1r1pBXGNzzqwWBq3vY2PgELNLEWRVWqiEteuDV8VGJ4ok97pY1FHUaKMkeyAZ","slot":83832376},{"blockTime":1625134572,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4zCdB1ybgVacQKmtkehUQaGXqNuNx1YVDAoLmoQEhReg4keiHRtSLhp9Y5Ss7QHaRRotbw7xuJfHRkWo1vkn4moC","slot":83832356},{"blockTime":1625134564,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"42dzZzTQvCd9ozBAJYzyTLe9GbpgvSioe2CmL2V9khkuZJzKxMnKivF3vh7Ahk9L4BEVhiGneSprmd9Ps84FYMJD","slot":83832344},{"blockTime":1625134549,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Sr7DGCkucgfRs7FfcEy3GqE3UHXahT1tspA7Sdga6FWoWHtfmMxGtBntL76dzC8NsnBMynnFG5zbEjivJF6m4Xf","slot":83832320},{"blockTime":1625134542,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2vvKSzfcbsqCFpD3BEdff7XZqu6cBrbDgNNPoGH5j4RYmLcyCpTK7afg48qDx7nkuHQ29TkasxfGqvGRGZK3RNVQ","slot":83832308},{"blockTime":1625134537,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3P2tVBuY5EyogufxP8UiisUYYVFwqjdDtAELzEKXf6KYWvnkhm2w9A1TomSguqHDEJpUKnA3pbBhUfVEpMAvKgnM","slot":83832300},{"blockTime":1625134536,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"cULvdVTHg2mZfKoGb5RA4MCRt1KiXRJdqksRP4bCMCkk7Fdp1dUg1pRfnzerKteH6bKg6gyyK9G9VJCBoKpjMgi","slot":83832296},{"blockTime":1625134518,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3YKSgX3iRdfXrTTyaAwaEjPkePqqfCAn5XC59f5CdMFR5fCecmVmorKt2jEUU1MR6LqhrTijQtPiFe2eQqNXrkfw","slot":83832266},{"blockTime":1625134506,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"29nuuN3Xqx4nUvcRqdkiUQrCRMyZPRU4NaCgqWXT2nbSwmpEsCFjrNaKoEMi62raLRGcmo9agBdyPDFBAcSeGXB1","slot":83832248},{"blockTime":1625134498,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"DKFt2w7vgFdLCedX7m9dN4eNt2oQJ7fz5hwdwXB9puojtNwUf1VHQuz5izqBzs8YH5FhummvPs31wRbPzgJ36QZ","slot":83832235},{"blockTime":1625134491,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3uF4LcUBT1ps6zY36UmJtBj8mSR4cKLMqGQziXzBtjPfnawj6Jihx6XAYeBFrWTKFrAmY7FGy9hx4iL6VEPjnvqC","slot":83832222},{"blockTime":1625134486,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5TYGhcQ3oB8uKm4fc85k1ACyKLtNWLmpnnXBKtgAonFMm6xWou6RhXimA8zGTHPVLmvR62eoB2cgmX5GS1EU8q4y","slot":83832214},{"blockTime":1625134477,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"JG5bjyjfnnyYrDv19ajucTRoh1Ew9hUB4T9Zmae2SDD6d4WpoDwRgc3VXHKedV2X55SPu6YxdTeTiQu1VQiAfBN","slot":83832200},{"blockTime":1625134467,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"9yRdoJzd5UZt9sjifcTD1UB16rSURkB3PgqYuXycj7gjcYkj8J9BU3Ygmua2VEtQBVf7dRd7re1LGGoZdMdw6Pb","slot":83832181},{"blockTime":1625134461,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4gfEV3yJKDLtm2R1tvazRpYSze9pr1uD5F55WEKKG9bTsHZJW4p8eg5jgEYJyDvphNhYQnzZqrVHfXJ4WtfSZU42","slot":83832171},{"blockTime":1625134455,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"38DEdgjqGfqk3A3N6e3kas52GyCfwsVv2wQc8gnTYuybhSU4Z8N7rWnSYtuWevUmH7EH5RgKMKiBnVPoGBDcqxh8","slot":83832162},{"blockTime":1625134449,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"54t5dyiaoHYxEsqrQZVX9KyDTDVTQzdmLpJB6Tw4LG21ExW1k7wx6mgheNJ7XxaVRm5WaWVPkDwh87E3LcLTmRcZ","slot":83832152},{"blockTime":1625134440,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5K9YcQ2Efz6vZ8Bo1iLTCe8M4NQ6AFAhW3jHNHkvq3dcmFB4492ZxkpHCetXsurR1xuzioAJKtceP9GqpARRsuWG","slot":83832138},{"blockTime":1625134435,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"T72VLArTVnmmjTz6BAneKY2mGYG53RrLyhzNDTYW4UFco2XcuPGxzrzdXxxSTXiRVHtQBcd3BjSRU7dEosWw1RX","slot":83832130},{"blockTime":1625134428,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4McGRBDYWm2n9BnpC6Z2t7MHaPv3sbWTu9LXBXoPrHggEmP5FkZJdge41e5M22LVwZjvKDXrwR5KrqUSpPJ9J9r7","slot":83832117},{"blockTime":1625134419,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"VzaPNR92R5z498UunMXLjQD91sbh8imva9fM3S6gheTBx2u8uG514nb463PbnbLMZLymdN2C49gcdNXyycgnhTn","slot":83832101},{"blockTime":1625134411,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5RaGLdFwy4myzQiJpiL2umzSZuX1Xw6ch5tWQkyCtfpBCYbndJFHSy6P1161Avck9E52ha9CUzuBpSA3673wrLqp","slot":83832089},{"blockTime":1625134405,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Lab1XqFacN41Cx9uTAHS6YqWpJs8FntPk8FaXKxHK6RJZcX3JoB7fvSwN47Eqs3E73nv8jRLYrF2o1BcYFCB93o","slot":83832079},{"blockTime":1625134398,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"gfbDLXmEUN2YDuXqeYAjNqJRWPLMgQTgdR1D5o9dbAjRx7SPpndoAPeYLmvpY13DCKKE6t8u89Lhws9bJJM45q6","slot":83832068},{"blockTime":1625134392,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"xUcBXFKLcbSQgR2yoHCCcGYMej4w177BUhRpnFvdZxLTquFm9rrSSgTJ6pv29SPidA69UKYdBSv2DGyFv6KALVR","slot":83832058},{"blockTime":1625134389,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4raZnJshYsoLiCanuoRy2vyUmR55zbFPQGVQbXKx2g73E4WTs3dg4yChoS72PSdpYo8xpi8Jbw9qMsn9m1V36F3u","slot":83832052},{"blockTime":1625134381,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"KmXTAaDA7g7DDFQpFxZqj8zH3aEQ1cXcMwc3GkTqFCPQpZbfpjnPvDprsUD8wf39qtWEqtcbugtYvRVyDUbkH1F","slot":83832040},{"blockTime":1625134374,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2V6PhDdfqcVhUbniSnFGczf7h7pd5aGaS54sHZfkqHRTLbg5BreLyR23vwtA75DxUvJsGP6FJ7KcMJ17RZK6vfK","slot":83832028},{"blockTime":1625134365,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4X3itENNt6aPYGLKkMJDAB9fGC9hQHaT87iaPeaFzsY6jtn2odFZVCKKCsKvmECQ1qh3QsrE1hAKpQWX7ZyQDzy1","slot":83832013},{"blockTime":1625134357,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"276KwHPL3pMpb9Xy3X4FSrgrN2spa2ACGcvMDqSRwn1zEUA9X6PKKyBE582Zx2ywbj9Z4dRWNV7UwHy7zLGFytMP","slot":83832000},{"blockTime":1625134351,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"E29JGbxSXqdFfhWoTXvYir8KMHRXDBsMuPjgmomvMGREVAvC7FkhpijxEVR2RmuAEgVoRTSBeWWHF32Xe8rWDKA","slot":83831990},{"blockTime":1625134345,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5DwWppEVHXuktw84dv5VtVQZHgZQTbAihCX1JMTLf1ypfXtQu6RU35mXssA1t44LGevYPd9byF9zMmpqTsYzmCmu","slot":83831980},{"blockTime":1625134338,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"48TQSZQnzzkPNknY3VYz2bnuAjn8cccbePt9z1YQeqXgNkackHMTwuHdGFDMvhnoyWhVkBzYzwsVaBSxLvhTu1Lt","slot":83831967},{"blockTime":1625134324,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"pwU3gsHaSGkNrY1KovzwSnrAmGX8itjegKrsxW6kqgZUgB5VbrbRt8keHWH2Wo7SKvTF9WMwthVLUisA2hjSzfd","slot":83831945},{"blockTime":1625134320,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2PsUUPsX3Bvg4qsJEHThj51UN1gMf9pk22PuKCzoqLdEcLiezB2jzTSDv1UF4n1uYXjkfbDkDADw5qPiRsRrh6xL","slot":83831936},{"blockTime":1625134309,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4cuLdRmy8uqp2Fz6DZeR6TagxNYmgP2HQwqUtcNpxcv1qAEEHrNhig9A4V7dEbfPb3hV6Audptj5m2YAbHDgRRXs","slot":83831920},{"blockTime":1625134299,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3AKhQifHyRyr6wS7ogonAaeT2CXqUg3rEX6v6UgSbbrL8ybFpyPrwgwvwf2B7nRN3noNJSJyHBFzESq15gLiF65C","slot":83831901},{"blockTime":1625134293,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"tjrn4Cab5zsFq4QHHLWVRMz4YvDF2RmqmREfh3LBafotTvKZdS7vwrW85HndwxrEyoy1pWo8j4899aJ9grfdxc6","slot":83831892},{"blockTime":1625134237,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"623dCTk3z4rsfxev92WYs79LYXGZmg3VbxUo7ESjFUpGxaYYAFgtw11gRWiEKVFM4uXqMKfcNNHbTQGqYBPrgGm9","slot":83831800},{"blockTime":1625133996,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"56z3LwxkgGzaoa7839QsFskxTPAkPiZk3JBANkEKFmcgbdMkvGHSNeTTN3ShaE9N1EtRHJJgnKjtEupfXBhvpYxF","slot":83831396},{"blockTime":1625131182,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5xZoZzWyVesiyqZfZgVVQgTq2ks4sp71A4ZgPCnonvHxXxEKZtnSCtrcN2VJQpXuBEL5ihGRJQ6NjYWUAwVjpJYb","slot":83826708},{"blockTime":1625131162,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4tPaxBZZRnGFee3bvQxVTURgvUXRYwM9PrTdtS3eRATvTfLERrx7BjYic9StNfcXZ8YkyMicWD2EN3m9Eu2gGvHJ","slot":83826674},{"blockTime":1625131144,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4eK7f4z96AbcDzT9HNUL4DDwVvmk3FPKpWEoj9fXK5NfQCB9LL3RKaHenHadVegF5rGBZXQRhpccpsKWDXppyq45","slot":83826645},{"blockTime":1625130936,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5PEHwimZaPvmPq7GrztdSXELmnJaJDNujkYWxQe82ragQqzkg3JNd9E22mRJCKeKPkVuGQnz5KCaZZPZ8zv3oXGv","slot":83826296},{"blockTime":1625130903,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Cci1en3tAgJHzV6zVUJnwv4HDDaAoeuoc9Rouk3m6U6VD6m6uzS56UFFcPFST5PuibWvWmabpWcMjXQCQgCc2hE","slot":83826243},{"blockTime":1625130873,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3DzGZ3TgCQ3HLKAj5csafqk7SiT7y9yaesb6NaP9dbwFAuaTDGyzMtU4kKAqrgoGUA2ZF5zxQoAAf2wYEQDNftpt","slot":83826192},{"blockTime":1625128587,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5pzsxcnUS1AFr93sPT3qjN6AsE5ixukLmxGyk3tqaBkvFuUkjfdWSvJxSx2Qiee6xJaMirdfdFFhzwrtN3hYxGVC","slot":83822381},{"blockTime":1625127132,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4gD6DuXPNGNVKrQMQjMUfAJis6zpQsgKbmzeyM6cspbvhyrG8Gfm2FV6YjVkpzGgeKixRJH8eRRxp5BAETNrsRau","slot":83819956},{"blockTime":1625127112,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2rGZFZYiMW538ANnL6UM2n4N1dqQgZvBmSS3KNvAz31h8L4kKqtH5ej7KH5xyzyCaTAtG2NrhhiPefc5e7KjaXX4","slot":83819925},{"blockTime":1625127075,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5swYGq11974cErnCmtjJz2xqHFzJEBthpqWnihGqexZZArjErs8r1GVPyjAhjnhEp2JCNHu5epPEZkMbUtE2iLoY","slot":83819863},{"blockTime":1625125060,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4F9DfmHoYCZm9qFdqDk9fbyA2CaPJEEsbVacYvAEm7yGcRDcR1T3f44bZcipXS2iT7Sqp639DASpH1XXzbHM6WXT","slot":83816504},{"blockTime":1625125056,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5S2XZVkVjECEYGCsMg7kF1tNKZad9V3MbdzPwfYHD1HThGq9FbyL9JgV4M8h7UzRrRNjALPW2d1a7cmfYccaMNHb","slot":83816496},{"blockTime":1625125056,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3mQxcytui1PouR75Ag2U7Gi92kWqoi6FsEq6WZ8gg4WTRvkP9q4tJW3nEGK5A7hZgERi5QppGfUJnXrbPD4xeQte","slot":83816496},{"blockTime":1625124676,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"449mRdoKUbi9aVA4pHUvCTMqJTmqmyfCKhrpcMmdHuEhvvAzpymE8s7ZpEZvKsyigQee5buuedEUEg9GsEdbWmwx","slot":83815864},{"blockTime":1625123928,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"gDQenjdEnYVAJJpkxENrMNHGtK9gUcH2Xur355ofFooViAP9MAcqrojjPYTDaeiDs5zU43vkNWwVHbVZcd6PKis","slot":83814617},{"blockTime":1625123805,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2jFgiov88bBmCgPsru2WLcZURoBL3mnMbAjJhYPh4ZypVxjetXzp9WqfWDz2oX18TmRJGY6NBWDCPqwtsGdPna4C","slot":83814412},{"blockTime":1625123805,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Hp5Vf5UvoGJtY6aKU9hC4pbnYxp3eZGbfWKM6CFdw6dDVHgLspjL3fkLiE5sYa7CaExS2Gwn4A9i1XkZ2f9Q4RM","slot":83814412},{"blockTime":1625117785,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5YV8mkJeJxRfsmGwvRPVU8CYBAKnC77URKZebKshKvjM5R23bUcPGYGutQnMR2wLH3p5dSaC4CL9c5GSPTNEL9a6","slot":83804380},{"blockTime":1625117169,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3dHEay3CJQN38gftyuhQLogFUzvzHw4vtr8AJs2C8N5oCLsfSH1P1ocTw91VBbcKSV3KM3fdAhB2N64V8DtU45E4","slot":83803353},{"blockTime":1625117109,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5FvwcEBAZhRoY3HVHT1WcT1ZXQMsWvUjJtQDSLLKaZJNGNUi4oga9LgByt5xBheRSQDHscU7cbVtWDjEbZjvA8vR","slot":83803252},{"blockTime":1625117100,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"DWZgbGfWrrrSs5woopFEG6LHJFEd1cjyr9HNnYhQQJnqkwz13qjdJJXqHNFNVYyGuvmR2pmvZ2LVxFY2ZyQYf55","slot":83803236},{"blockTime":1625114316,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2ibg7vaLsCqdvcDBE397iPkcpvzRNMTk45Lvmb6cuuDoTPKSX7z3BCG7CZR9YtAhtstVxSvceC5gKbehcJma91eS","slot":83798597},{"blockTime":1625114301,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3isX3NiL2KseBgRFGf6bXpe6S4FMgSURZc2hq7gqNqrZV2nEyT64F2HBmoVhQaHWBD9f2bdZsNZ1WSM5fPKRKkgF","slot":83798573},{"blockTime":1625114220,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5KjmRDhUq8owgYwJXDuiHGkMor876GQq7y7SvSzzjzRx96dhm4dSaiL1jzGu36RGrukMVs3MvAjN6gijKpXG1cpA","slot":83798436},{"blockTime":1625114217,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5qZwsaWHfuM4U2w7WJxry5tJjdk1bU62hHwueXsfTtpnm9o39AFGaDQFW4DdeetH3y2fhpU7QPgGpetbw8xtx7w5","slot":83798432},{"blockTime":1625114053,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3GDYWFzpeKjsqUHVvwgkrytnhzgNoCHodBS4Tx8uPEjuAw6QGJa18zoXP5wWyqoyLTnTJsiZYrqjvoehC2chuDmU","slot":83798160},{"blockTime":1625114029,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4HAYmeK2HEj9foNS91pzHQbRFz2n6yMn7E2Nic6rfknw3FadEtDn6aNpM1YChPA63vn9E53ww1RRa7bPav2Q8TBw","slot":83798120},{"blockTime":1625114014,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"w9zvJ7dJY2ZX2pfZKtXK6RaGEinRxdB6u8xZuZpZL4ZdStkzV9MMwXf1DibcjqyK1TC14Dzpc1N9JQ9zJBpeoXF","slot":83798095},{"blockTime":1625113845,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"38NNZYkVsXyEh3LrMDTy1jxCphs12SyTtLCxVaviyi6RK6qMSW4StwRuzhdisQ6LG2y2aRoBzFbZBXZwBwUjkqGn","slot":83797812},{"blockTime":1625113821,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ZHYwzEDueMTdzqp4YxXGDWuXm4WVWuzgvRcuwAB7YS2BWL22HjKR45QtpAEepPBQoc2CMVRMEMzBKYZDVyZhiEL","slot":83797772},{"blockTime":1625113452,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5MgR3GDGrYcE5Ei7CYtc3DBQouq26aGDXBSmXG7TddtcMYKTqCWWiYsoEcgz7uAsZDxHPTyirLQkVgNAXNByCTfF","slot":83797156},{"blockTime":1625113417,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4YMsHrtzFaSYPdNLECJRq5UM2KtaT5uad5KZuscJ7jQiTv2MuuFf5C7i2jb1y4fZRRXeq2DaegZRCYdWthkh4HG3","slot":83797100},{"blockTime":1625113393,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"XJMDx1La3wPCpySmyBMYPDWyZFTHb29qUCXG6bCSjBBnJPhUkkBhCCLmRyHTedpL54yXRW7VBJ9q2CcDu19T5ua","slot":83797060},{"blockTime":1625113374,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2VgYTachSg8h67iKhuZnEGipnCzh7CEWgbACpyfNvFxs6QBq4pcFZTpnLiz69VUAfvrrFcKYp5QWJvCyQaqJxGRJ","slot":83797028},{"blockTime":1625113339,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3snzvEM6M8MgKgfMYHm7DdgxFW5BQZWYrvufgYz6BABbe7zoYJc2zf27uZgqyPGUjLpHVnQTobdsnBv1zgj2gLfU","slot":83796970},{"blockTime":1625113330,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"59LonPDGRCHWGPCyQ2QQyNy5wYqn83JG1xjCGHQPRQySWk2t5nKhUn95v9qhE6KhJJQGRT4yT1dSKPDGMT2cUHrd","slot":83796955},{"blockTime":1625113329,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2WZZXzoyTEE1F2XFLTyXDc3ZbC8YHhoLQ8K9AfcRUxFUaT3GGeaFxBdfpiNqj71AHYzXJfwsZiTy6qq1sdm11AQC","slot":83796952},{"blockTime":1625113270,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2dj2Js8VCEyZKkBRECE5kV7QzuAB6xhSJCckPKQpHEhsaTpphKKvPuhexLkctKTmB5PAo7Q1KHKYD7oVbyuxivhi","slot":83796855},{"blockTime":1625113266,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5p8ioss8CBjceSRaadkJBpgWXrjmgZjHXShTxB7GUZ6WEQ1ezmExTc3fywmRjvvdadSNRSHzDgr1zP32gpDkaedT","slot":83796847},{"blockTime":1625112997,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4eEZtPp3wdTN4ygHkctETZN2rdjJUVhokwwycixkaFuTXySoNbYoYaEGSibxfRG5Fwma1sqbo2k8G8Ljxc6Yupgn","slot":83796400},{"blockTime":1625112492,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3QGKGRMX6CQRPkb4VFrChpWhHKR2AhqbgC4yWr9cKJ7gPqkZgfXAfAgrPj8nFZ9swhE2PgTVcsVa6FvEWgqa76vD","slot":83795556},{"blockTime":1625112438,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"432FDWE2TRvQvCipJfEMPoRoF5jZxZwVpEoLwFM2UBZJcvYCovaBGkm9vQ83Upt1KHbpxcssQy2LTjwEYG1qvz7Z","slot":83795467},{"blockTime":1625112429,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2k7Z29wewiZUiVgYrg7SoQCk978PiRgUfMrgr4zWg6QFneN3SLJTuWT63h1foMQdcpabjGn26eNA7jrDDsCToMmB","slot":83795452},{"blockTime":1625112297,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3H8hE4W4pRQS42GUcTUVwASkcieNRL47vi5R235gNcvug9sgiUmJqegWKqRCuGogZg5Sui8PQmTEdc8heWM4EsoS","slot":83795232},{"blockTime":1625112270,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3x9WuvfUNdLvdR4rEQ9FXnee8Wja7Yu47XYhrQEAKkbdzn699wYYtYdf7fGkgTsXXK5VFt3juWd6uSNxG2yTRTQ9","slot":83795188},{"blockTime":1625112028,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"55r24oiRshLSUvkvyPs8mHhQ5QoNnqYC4Z1yUdMVTJYyWVjK9DA5rggFiQ1MjGnHD85LMYTYBnHsp3dWaHLphwuH","slot":83794784},{"blockTime":1625111877,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5cVewdahuwRbg3bamN3hUsDF24PmGBmkK5SkL6gb5eMEYW3ZgvLvC8KkfNFb9M2de71qJtZLAS4TuRhGmo8TEpgG","slot":83794532},{"blockTime":1625111826,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"31Zpr1vA135wbTgB36NZzkqB6ibTbBi4cp1AKnsx1dgMVtHB3Avgz953DrBGLDBTe7shKNozbpP2ANPy3B8dnjcA","slot":83794448},{"blockTime":1625111544,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3P7dCp1JozL9dYGxFsciXMT1KuENp9BNP859Hw1ufWdohiA54E4yhDq8poeeyXWA1XM3MAgPFVkHB4K5W453VWpA","slot":83793976},{"blockTime":1625111490,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ZFp9TjaKBH6E5J65hEQaJ2CXz4zEdsmBhGyeq7jrh9ahvaXd5PUgqZu8NMGrQuvLDk8bhSPa5vbsVYb7zGdyiGx","slot":83793887},{"blockTime":1625111040,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"43KuA5sqwqnygLoqtUn7xtBgJaTS8F6R33hFiDX1y8J87yb77UpLj9N5ARNGmDHhW3js3JxHXzjDe8YTQHfUsC48","slot":83793136},{"blockTime":1625110863,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3iEnH95yHVKZzu5ZkJEZnGrMzuyYWEu7NvbD378mRKXTcDRDjubRnfTYWsbVdx4DLnbQnNEyNNBF95v7fmrUZ61B","slot":83792841},{"blockTime":1625110827,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"XH2HgrAkgxp69B58v3pCipQUSoWxksYDvTWPvVKMZXDuJvLhWY4sG3qNKm7BdxGHjr2AAo4SaDTd8q4Cm8gumZc","slot":83792783},{"blockTime":1625110818,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"mRFprjhnxy53hzsvypoMKUaQRVyM7PVkZAC9brxfUBqL3fYBpj2Jhowyck44wCrpMiWntCQqc2PM9NUbYe8aGGN","slot":83792767},{"blockTime":1625110581,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"3GTM95FtSR79VgdgR5Gi4r7TaGnqaY667KEQykwcSG1vQ94Cii9kAKKPNT5nz7Tf9f3ZUvT3cvUnWHREL2w9aj9f","slot":83792371},{"blockTime":1625110362,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"46W8ago6PkmFG3RUYMSEehdS98cz9KpRTQhTazJF8PXBcqk4h2RZnHstqzxYsMC3yGn7VSVSuRKfg665XtZ8sfWb","slot":83792007},{"blockTime":1625109573,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"iDUoLmNNcMgBD535T8By4hnjW3yhdzbsYptJPc3nFhaDipEvfso6E9kpgfiQaevZi9HZjjeroijU8cHzf3n4RAK","slot":83790693},{"blockTime":1625109531,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3D5bcvA7dD8FaiN75jj1eNFxLvxxsJcm5r4m9FsqG8yxi9oFGtrcSXSA3FGNTLsjvtqqMSQqBZwnu4fr1F7hiGfo","slot":83790623},{"blockTime":1625109385,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"YumDKFRs9Y4FCYcFmraixqzyAh8NEbHH9GDS4dN2rhW36rVpKGZbveDrc22b6co7AD68FkhL1unxacC972sJXXb","slot":83790380},{"blockTime":1625109273,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2kng2ipQaNeBpvgpxjt3aZ3UmEKQ8fmpvVK5WqDQRJEFcyvRsTH1X2bs1A2u5akazGudd2zhvssTgvG57AajYd89","slot":83790192},{"blockTime":1625109216,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4TiEb6y2HXrxMJJF9aXXjUdfnTCFkCGPgMa4EdKAifmBijgaox5jVvsFNRBgyyBFBp8Gat4GUncGZPdiwGEQWHXc","slot":83790098},{"blockTime":1625108790,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3oGfTMGGYrL328cWmoMpeoL9z6Qw7EZYZE5skeXMaDGSzUxKmic9TSy6pkigAZHfZaoc8WXsQvhVrysTDR9EeXdP","slot":83789388},{"blockTime":1625107108,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"RyBXXzGs7SWbfgzBvyWH6WKTUg57Ub839RP5VzK13BwYnt7qW1ZLL7fdhavHFh62XxFjyVfVLwWdPKoyoHBJEZh","slot":83786584},{"blockTime":1625106708,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3Z9eP5N7s84rftmXierPVpjv5xqAWEEz1BvqKoLkDevDZYUndJ1EFUWCisMCgLPmbYHBfmrVQizdcjBqLPAAnKUq","slot":83785917},{"blockTime":1625106705,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":2}]},"memo":null,"signature":"3isxtKq5unhu2khHawuyNZq2B8NTnHWEsvRjQ1i3jNGZSncP5HRybMYxpP75WLsfvu1FocG5okAygRBkmdhrTyY5","slot":83785913},{"blockTime":1625106705,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":2}]},"memo":null,"signature":"5XAr2hb1FtN8Z74DXZXAKyt89bFaJJP4WTr2T3szQNPi3K4seh7s3hPjJvhUzYmqkpf47ouYiheWjEBDX6sjnjgZ","slot":83785912},{"blockTime":1625106624,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"3WwVwwJ8XNaSbXmHoV7ocGTKzReMpECntcoBAgrSAqisMMm7z82VZgKz6w1WrjqYN3F4v37wq3yRFS3QMSQzqsL8","slot":83785777},{"blockTime":1625106385,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"5iU7CiVxRkvAMi2BTx5Di79HC5ywjuhCtZZULYJntaKxZnrNETUBrDdnFSmcBQr6KCdKoryY6TCU9URixYYgBVsc","slot":83785379},{"blockTime":1625106364,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"5MAdES8T6ggyKLCh491D4FUB8QSnWN37CUZYeYyjdM9DE1gSFtUPtHJAxsAxqscQziEUAXXtpi8ca6UvnmZFnwqm","slot":83785345},{"blockTime":1625106345,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"4rTinjXws3mi6NK8FwXJomu3Tn9MLV64QcxSEFjFSMMKtE9To7Tr7X38T4tHUHmtCBfgBhGUtAHN4Y567JAR4oHJ","slot":83785311},{"blockTime":1625106072,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"3vC4QyEUdXgCwxwxKwpb7fVdAKdBLuSzxn8hMz1KT35wffAwvLoKoDw21s9pS1oC7AGyrqxsh4YiPkdwbqjuwtT9","slot":83784856},{"blockTime":1625106001,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"5t9GpqopA1dQXYEuRK7dSPQbTP5dmrNkw7HQTNvsuunoRANdfHQYcpsGJCkt2qTuWdX91WPpKxv6kpTeTrq5frpM","slot":83784739},{"blockTime":1625105988,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"26s8TRV4jqTY9GitioAmepDvZsVW2LPrKn6V7KgAXcnJwv3ftMbtYTsRXoa5ydQYMi8STU4zZSx2mY1KPUs62Etk","slot":83784717},{"blockTime":1625105968,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"5afsY4DGNRFECe1ojgWPtNjMpfn7Tz4ykm5qMrG2ZVGFhD2ocsGvjrzmEsYUQCKmEFiMdLYyhyaemLPyAFnrCtXc","slot":83784684},{"blockTime":1625103759,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"z554QbETzMKzMq3BCRpkQ74u5eJ3e4rCxJjkEqf7DwmmPfWfdsPUXkkLacya9ykLBPyiFwkw4vbHJPAuufeTuab","slot":83781001},{"blockTime":1625103753,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"hQx1Qvc1UyY9WgA7fVU9hRV4CbAqzo5NDXGFiV1xJgTWY5j2a8uGr1LaPqWPhJ7kNYousQp3wGQSXtkso11oh4D","slot":83780993},{"blockTime":1625103706,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3cFmu98Jt67zaFt7T1L1t2yzAFp43dW5nSr91YDkQ4cGZk1AGJF51GiFHB3MbZK443DQfpte5Q9WPyxpcn9fJPcv","slot":83780914},{"blockTime":1625100342,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3uD6yQhhjNnkpvvgY3AQVaSDW7vC7y8rKvuZjvG68NmaurLDAMJTEfakt61LbNpBRkUrJ88ALsMcjEYxxVF6gZXM","slot":83775308},{"blockTime":1625100121,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5sfxTMc6HsgbtrtyfdF5iBBTmdVCEbLvfHfUUiXocokCVFmfPbcV1XipVHWEdjvsygdU1JyEu81FrGWVELgHkJ6e","slot":83774940},{"blockTime":1625100030,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Sv2ir1Kr7ap5Mz7hdNFPLWs7Ruo31SCVbWHwvqSKRCPrBPyv7PwLi2JK5Zrav6kfyQAdseDEdq2B4zLNGCs5vCp","slot":83774788},{"blockTime":1625097301,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"aMZk9EW1iREhVRD1GktZ93cVFmTvzwr611GbjLyJzcbqmAwan2RvBFhRudxqEasMFXMnNW4FoLVyCtReUpTAH1R","slot":83770240},{"blockTime":1625097291,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5hcK47KMv9XecFCQB9AFpRKwk4SXQHnfbWAMEYdYHxjJQYBoFYcog8RUFp53A47TLEMpnPdAZWwzSacG5ywfyYDD","slot":83770221},{"blockTime":1625097244,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3LFvZk3AUEwCxUTHm8AST5D3BwGpMHjdKY1pBNuJZJvTZLWEr3frt2vyfiQ1ibdpMEqBwH6dth2GHRF9pksPHVtk","slot":83770145},{"blockTime":1625097169,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"JNfRwLDypLxi8CUHuANEY1knBQv8Ct9ubKFyh9gYY7aSU8ZZhZ14gJakxoSoX7HjUAQLtnNwCyBnrdH2SRm6Dcb","slot":83770020},{"blockTime":1625090365,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"A2vsY66Ui324BjjffCbVSBVBAVL2pZpBXdZSS8KGkwywoSb6NzQg4Ki3bjXLMEwtiLSUXm4MFeeNhV8FWKHRa1c","slot":83758680},{"blockTime":1625090334,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3vJBz5dDezibaSko4VFCDpoY9dRHg42eM8eScNR9WT34XuL829soBsrLtuQmr73iNVSRoh1rHTAfrMUn6pekipNz","slot":83758628},{"blockTime":1625090296,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3zRM3ojzJYyuGPMSSEudeppWuXBBDMRCCdXGdhHNW183YwGvwuwD5pfd8c6oHBpdTVogngTwytL4UrJqQ7mQnNzm","slot":83758564},{"blockTime":1625088585,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Fk7tuqVqKJJxoxnd83kHMPDrdvgdNLGE54pB25h1yRifiqXMXjJc1NALxwsTyXbxJmL1Nh7izH3udoz8N3TpJMW","slot":83755713},{"blockTime":1625083842,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5G5rfMvmzmNkPRgba8jeNJqCTH8jfsWAAP8FJgWs8vrkSqaJRe3fXWknA94Humy34532X9qcETbRjyK6tMqMVwnZ","slot":83747808},{"blockTime":1625083803,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4F9YATTyM7pp8eKrjzMapM4HKRtgTu7HCZAia5xd6HK511GvdBb3ZMnJGqvUYKqqRhhRWmMvaELZz7KKQd56FWLc","slot":83747743},{"blockTime":1625083789,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5TFQCB1cEizjBwGPq1SUkwa6K9fZVwQa19nSBYFvRe44hFC5RHwuYsatxbqVnH8DibHdhYXc95szovuDzHfyXpJW","slot":83747720},{"blockTime":1625082450,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"4PRGr5LdoESkUZA49kKkoDiJQKSVpKBMhPFtsDuMwJs7etw3wfFb6PMjuiCPKUCS1jBdfe2KM6F3gfshWTuJqgis","slot":83745488},{"blockTime":1625082415,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"5zdWyZWy81EvxQDRyB2g9wUfX72aqe6ywai4bVnKTktk2wz58uTp8whVLbeKzcS3RaPQmiHUowBfc3pyoFW9P8RE","slot":83745430},{"blockTime":1625082378,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"4QMPtdBV38xnL5Tudv6hELNkJLwEVYa3rRn7Qx27UMCSGVVh4wegeJiqnFpErFAkwm4scMvrt5ARpdhh4TsbkdJ5","slot":83745367},{"blockTime":1625082352,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"5Y21bUMfsJz9qVCoxDRYdu7xvHpViv1yASS6t5rs3jRJY8ozfZgqkKBd3Dz59wuQUrtmKvvbzt5Jgb6N21CdL2Fc","slot":83745324},{"blockTime":1625082334,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3yC7tJUr92VrC8Xxtkxz9YcoEaU2cTTzPgPUop89g4vnzwegWEFAfTg773GjCnN8fpVp53EGkK2oEKrBHMCYGWHk","slot":83745295},{"blockTime":1625082324,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"3PAjXGGyKSvWEdDrmV9wpagx4NvDAcv4V6yvVKtjXc51JdEv35WvwFFytUu11se1nnHZZFYzJ32NoGUtu8Dyfjny","slot":83745276},{"blockTime":1625082307,"confirmationStatus":"finalized","err":{"InstructionError":[1,{"Custom":16}]},"memo":null,"signature":"3i388n2cpKQVmJmY2jEw6waHW6kgz4917ibTfh39bkhKoNSy7eHota6ZkJ3ySCP5HaUpso2L5nLopGfEvCpW3i7i","slot":83745250},{"blockTime":1625082282,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2HtnG7EBunoP6mqpeTxspm1vHBgyoi6yjUWd8zesWwSxdaruEgULar4kCWATZ9rHTRQEAf5FG13dPnkbzzFgG4QQ","slot":83745206},{"blockTime":1625082258,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2YqXbrsmgpJ1eqtp9EaYQZeTy5aCo6yzhy48wcSYpDjk1zwYv7GUuF5s9Dyi8923WoGLNj4EvfJdxoMZ9mtCQEcW","slot":83745168},{"blockTime":1625082231,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3k2gZ3zCecL45wvN9w3pct44av8tuBLoiqeYf83CFn7sLf7Y3kJpJ9RqTX3k5nZtj4dWWTRMW5nwtMfKMNqhuk1S","slot":83745121},{"blockTime":1625082219,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4nHDuNKsAtCASsq9i4R5BaVTrPz9aX9rt3Jf9eDyti7yJ3p25KsTJ3Bk5aGUXxXNFBgP8bjMWPjqSVcpxqKBVvDM","slot":83745102},{"blockTime":1625082198,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"24U9Zj92Fq2vsAo5RzzanPp26nrxiQBsf5APZSXPBWtZTD7XVbnefYtAGEKFxSfQ6YgSsYd7ztuJuEnaZMQvLBur","slot":83745066},{"blockTime":1625082060,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4gZnVi1V7K5J9U8SrApTRzkMYnBy5bWye8RFsPC6NYtvLuXRgTqGGp7yFXTtyEKSfmiPq5onSKnUzKsthMLGaVr8","slot":83744836},{"blockTime":1625081566,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4bowonddAK8pMSiKVRspzYpvYoF5gfGZR53GXBsni4mJnF9tFVse8bW9eEQ3mhuzBmPogxo1NXBHJisoH4PE8k3c","slot":83744014},{"blockTime":1625081130,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Qv2gzJUJbvBquECu87Zj7KNPfaAPLCUW7UNnMmaB3pzg7LekrGijVtApXL4ipprvhGVnCMcjxxkpQWS9VLrrncs","slot":83743286},{"blockTime":1625081085,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2yZnS6ceZK764YdGTNNS33hNDko3UUj6YmZw3EJfdHGX4Yhve9Zm8VRht7kRfWzU25wdobTCi1BQrV8wXXwpL5i4","slot":83743212},{"blockTime":1625081043,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4tNoN5sHBCXDs8QfSRV1stBUprfEN89sHDGiNWh843PzZmCsNd6XMSNtAsBqHUj9P26Skhqk5RPWNxxAPH81orej","slot":83743142},{"blockTime":1625080938,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"23q63DeXspDFwcnHVtr8EjNNXzaej3Lgh3AzW4MTgsDwFUSZEGS4xaZoEhMrJQpQg1BpvLeALGeJwBSBZXmYyTy3","slot":83742967},{"blockTime":1625080909,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2NzECcGnz8r3BUJNBfJjy7aggpVRqUxqRt3W1oK55Tn3hdM3GD6kfbi9ZaJ3rBNw4KfipXfrcZoc1oir97GFSyx7","slot":83742920},{"blockTime":1625080890,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5cwFojjEA1NfCVKtaCP7RZ43VHAmsLFM6rbkst5fDgdLs74jaas9sjhmw7eKR1FT4v6s1sqU9A27DKPqjCoZTxyG","slot":83742886},{"blockTime":1625080818,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2F1ruC7MhdXUwQommGnGXc5CQc8D14AoEyru96CYwKJzdwbS2UJekdhiCZ7XxS2Wv1kGDnQ2DmTZz9RufJ9GEJwu","slot":83742768},{"blockTime":1625080752,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"XK1RtXnGvzJy5h3pDFSFZQJ2vGSVW3nf7pxVrKdCoQcX4d1Q1JEu626dZWVdTCX4Um9oC9ogPHoqdphRVXCxff5","slot":83742656},{"blockTime":1625080725,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4nft5Yeq8768ZMPEX7rQHwEpwc78H7ANq2knqR6cDm28MZ6Q4Jp1bJ4K58DMgjarfo9x9LYdroseDsv2opbhUc2g","slot":83742612},{"blockTime":1625080572,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"A91WL3BPgvdrbh13Q2HgceYb9XLvjWfpY1KXoaRr7oTmm6Nsqi5nbgHowgxt4zhnv8d2BJGr3toTiUHGYoNCoej","slot":83742358},{"blockTime":1625080569,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4hLpwbaVcPiAAD8ZvE8AjCZEb7WdodRme3i7nCG1hme7gMfYqxE2dJxDT4Lfac412uwV2jLaBt1bRcbV8xiTY3e3","slot":83742351},{"blockTime":1625080566,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3h6hSvG8qX5QiAUCzP3ZCwGpYvKgQryDz5UJy892KSN3iT3D1Z6Bh3mnGagiXX8FnbxMkTNuDFQWHEtbkJT1kR56","slot":83742346},{"blockTime":1625080563,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"ywnRQcnstKTEhrJ5GecSy4rUMtqkHjdo6sCBLeYLGArgb5LmpCVL1YHdfe14oM8vXuYBKLR1LA4awRbu1BGLTKa","slot":83742342},{"blockTime":1625080561,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5ffMhjrdMF2sRLtC4LXkQU44pWqSe2C9fv5orMaKtXjzotxmsAGdqce44vNFaFhrd49KAAKT6qQmbmGJrvJp32dN","slot":83742340},{"blockTime":1625080557,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"8fBNbdUmuthNVLsEhz5B9XXChWqJJw2xr5VpBif5m4gPJeQbXUcpynnT3R25CMXxG3i4aNkddYRBvf99MjFpqyS","slot":83742333},{"blockTime":1625080555,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4TdQDac79eFZp7SkzRtwmo193mkExS9ANJ4Fm85RTGHEP1CQmYssqxCQQHfr9qstPG8rLDE7ii5E7WBRpsMSMowg","slot":83742330},{"blockTime":1625080552,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4HM3VFTx5aVP2c9cpeBvU7oHdrp4Jserc2cz2cfYZ6JSXjEY1smhmaFCSd1t6TZuEei4nYjBRHF1djJQXWETgXD7","slot":83742324},{"blockTime":1625080549,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3XGGDhdjbi8HsXNiBebYq4RCq7QqMEm1eGZ69Wqjm5peo7rPVHKG8kDDyMkuqBMqjbBMDXZimrxAzpvXCQvWoWaN","slot":83742320},{"blockTime":1625080546,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4UbZySyaFQGvkBS9bREh3FAGrnrKBR2THBbvurQ3xjEyvJmTeR9dT27PeAdmu3sBJiAe8FxNhbJHgU9MSgWmcKug","slot":83742314},{"blockTime":1625080545,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3i9Waf4jTNG5ZjWuZhjP346duTVFk4TpANj4sthtyfQ2i8tBTqCznij2JyNdepVrzmbgXVJrmFtsYpCAoACBbnm","slot":83742311},{"blockTime":1625080542,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4RsMngsNSTkMZDnLoqCGmDLwDkxHj2ft6KB1iGMxVW93JnFFHGcAw727zWMb6eHTfNf7QC1ecXVj7FBiMHJJNg3Z","slot":83742307},{"blockTime":1625080540,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"26p7xwzMDF8VHZwHEY8jsJ8RXvWP6sgLE81jnECe1DSWcSuvrhCAWEJyMePr9nSuPoiNoVCtnrxLBTYsAMjqY8sb","slot":83742304},{"blockTime":1625080536,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"9NqRc3yH3UJsHz9yqvmJXr7BBBfmq8k2SKvAPToMMyXeSYqHGnwYnW5jZLCk7egB9vDXaWgbJ2NbA4eSGWGRbdG","slot":83742296},{"blockTime":1625080533,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3aiaagpawRNauAuH8x9K6RSgBaN5nRvfcEWCdEJ7KCC9HqgRjEz2wQwrnv7U9P497rhzHAnEsWFyXhwzsaw1K9YH","slot":83742293},{"blockTime":1625080533,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5h2hh3RUvjEbsLTwEXWLzCuMKK5NLCD1fXxhXQcmyov1uXkPWzwX5efZ6orJ8BvW9KuqscfsCEXVVJ3mndcsgGrs","slot":83742291},{"blockTime":1625080530,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5QBGEG1Qdqd8QFzgDVDaKHrao8GaEhVb6FSV4ngFDA2DNf7h4upJKhyykHirFHiQWexpSDi3gNcAWiTtrjRRHWz9","slot":83742286},{"blockTime":1625080525,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2JXKnuY81S9fW7Bn6kmBZvrP2X2bD7yHFui4QVbvjNnvNyateM3P9CLvcGr2kCrS7Yh11fvefrHca1mSYSrSX5ka","slot":83742280},{"blockTime":1625080524,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"29Hf86a6uAkDTr7xBtv5MyWDmdCgKcytEUce6DtY3ULciVPTswmUvmPKrwnMD76nuoKJ9anUktbGMieaTaWcDsjG","slot":83742278},{"blockTime":1625080522,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3mgmoCrFC5BAAfa4ptg1FwS1G17iwVVuNX7GRM2xLw7p3Uuu3Hvv6qdQPLYY6vzPF1yqmUNPV18z2wTZHgLKyDkW","slot":83742275},{"blockTime":1625080510,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"23jvRF5ueMPDw4vwUvA39ron8a4ypMa8mz1Zgj7Kum8eA8wctyNodsSRQi3w2wTTgWW1zDsNS7hNDy5JqcWkktaR","slot":83742254},{"blockTime":1625080509,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"66mJywxGRwnMy8SzvEcAAvxo1HuobPysRSRMNgqkLBAABVVJH1EyszW8zpTUgLZXuDj57fcU8knEFXWL5fDch1jS","slot":83742251},{"blockTime":1625080507,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"a6Qt68zLpua9fEmY6TsGoB4JZMmtv3UwFYDJvW2jdXyXx4XoUMPPp1r6vHcvGNrNzo5FfSv4oake1xBVypT9p6J","slot":83742249},{"blockTime":1625080506,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"28xBx8jMC6qQ2EhooDvb22jbZ5p3GECVmgdeBdU6NYLALDaAckuCsnLXFmGkUntHoetsY1NRc5SaAoCrSq6TDgwu","slot":83742247},{"blockTime":1625080504,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"t6ppd7EMwLBoiTnNJreJG3bH9AKvcMSfLQzHApLYnFKx1SrUPBPxebXndJhcrNKkkrAqmCw3akpAr5cZQoZLwJa","slot":83742244},{"blockTime":1625080498,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3yRumNAfQAZYRSJzDvbyeaLkT8EWJNh8Td33dMHYGCybCViRPQCDA4jGm8zGdsU5jZTrR4QocaLiJtbqWP6hDfYj","slot":83742235},{"blockTime":1625080495,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"oUyEcbHJyxRS8ZdKRAxgFDFjUGWyiSddwi7L9J9YxreHGtFxHUdYQEDALYgX9igJ8qJDwzoWM5JXtwfYQdgEeoh","slot":83742230},{"blockTime":1625080492,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2Y6ryJCWvHWn6yHFtgC3wtTyr3Q1gLmDFfnRLYHxZBE7msUnx8rhwkHp3JWChqEPRfMkt7Z8Enw1NHWhj5ZnR9ZH","slot":83742224},{"blockTime":1625080489,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"51ea1fSvh1wC5iBf2ZZuupJXiSkgSa9tGfxxeAkEMGMw8VSXjpxvHxCMkEQctd4xHm95zshqP2z8YAkJKffJmLYf","slot":83742220},{"blockTime":1625080488,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"56KZVESQBoup2TncS6i6KnsQsnVRuEVbaWZFHXeeC8tTK8dZKwJ3CbTZUpj57HN3wQAo6uYWX5fdLKxG4tF7e98m","slot":83742218},{"blockTime":1625080486,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4TsQrrNH3ArwMCAM8WVspCpjURAB78wPnnJDFafP7FAUuBC2Epfyn55YegZLNiLbjL5sPDVsfcLMn2uaAwLkXRQw","slot":83742214},{"blockTime":1625080485,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"22CraUwLFci4q25uM8YWqExkCEQFQ6jdpA7fsvVJsUbRbqSNLkHoch7dkhLCzsG3t7qR4jzrvTzDJn4kXWToLWjH","slot":83742212},{"blockTime":1625080482,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2cL8NPoLZHWkzRoSGoqVYf4iHZKSkVz76qfQ1rcGNuihynqxQ9mbD4XrhTFWRhutwhMuFbZdknDkTEfGPEMMKMFu","slot":83742207},{"blockTime":1625080480,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"Yxzhd9LqfZdYsEYMdi2zpYdzqjpK3GCVwaxirv7sprR9fb6Vme7J1JT4NJnyUdjY1wDEuxCCiwdxYmwGvMiw4Fi","slot":83742204},{"blockTime":1625080476,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"52qnUasupgtAnnTABtnbooacmmRTctXAMijF4rYkhbVptSxgFBptPgfbF9rmaLqJxzmAM3qkNF9jvQFHA5i7qKip","slot":83742196},{"blockTime":1625080470,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"LU4RYMnpk9zeBM42ScnXacM1MPFWp22uYZghHJdTKnszvLda7eCd5cbAsQUfyT5RgrAp2M1MnF3QWeZmQJV78Xw","slot":83742188},{"blockTime":1625080468,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"uiXbRZwJtHQ1FnsZKQqEe6D7fmwHL5Wh3jVTHeHEKwNp4kJVXwXZQYZRSVBZFBL2vvPYSSj1AAttCPZVTGfnJBM","slot":83742184},{"blockTime":1625080465,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"25s3tKEKFDHbffhyoXs8VLuLuKRFo8oxNQHfD1Bk8noJo54DrkfDZqgQ6wrQSKaFkrfRX7AaXSB7kAberMTmJMcT","slot":83742179},{"blockTime":1625080462,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2NT9pVA99VPGfZE9ppdMpjP2J5M9YkGh3ju9dvsp4aXWainMT7JVwN9HMtreD5X65fo5L5LP29Yr58BqQM7A1Zju","slot":83742174},{"blockTime":1625080458,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5b6MCYCPsbQMS3fHTJDg1ChKfvwzWEKCYkm51a1WUVhKPNuPHhPGkS9m1Y3btWMX8R8KpyRUsLYScTKzdGN3DLoH","slot":83742168},{"blockTime":1625080449,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3HmVF5UBdZFYggu9jH19Sf26K6eyK5vSJAz4E6ubYMPf8vvhDFysYaX9Bm2mfz5BE7jemVnQ1yfuLtd6iWdue6zb","slot":83742153},{"blockTime":1625080447,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5i1E4PJ1DdfH8vWBwqeRZeeu1r4oNz9twgb6tJjZ63Q8UXgvp2mqBLqs9P2jYxGn7mji9W9nhRaNj8zd7XX9Mv8W","slot":83742150},{"blockTime":1625080444,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5k9mhA8JmgwKbXBLqfazxgdKoSGu2Eny1M2bYLmKqkzGRSFmxXXMj1JgJFTqraos1sSUxn79oxkznvRYwHmynHN6","slot":83742145},{"blockTime":1625080443,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3MFoBBdm7twMjSUswtgQXtXeNLaa1kau8r2euLfQmSP5qHYoZPbGnqRCqRviuUUwjxgntZJ7nqyT8VmQmqZFTfSN","slot":83742141},{"blockTime":1625080432,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2CzRr6MjHfwaPSxH6YPjhwRTKhqxYrQrUui2XCFXvCcizfDUNkuWbrFCrspmhdiSXzv6xtDApZkzoa6uMGqW5DUF","slot":83742124},{"blockTime":1625078812,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5dUeCiEdUJkDRfvsVRSDLVD3Crd3ntoRxN3B5kJ9MNkFA3nXdi2kpu1LLJJVN84aqqkxZ9wLvXd9QwEqsfEktJng","slot":83739424},{"blockTime":1625077260,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Z8oQzvS517n27wAeG1zX7Utwpvf7NUBdRb2jVRFeCj3G7hzFmWJ75r6DmhVZ9tAcmwE6GfSPnEmWxvASAoiDGga","slot":83736838},{"blockTime":1625077260,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2vnnCW6oe6hGNE2e7tW3qzMZawroCKP6jSY4nxmQX4q9D5BaxMEyeMmSZMhAcoaC5sPUF2DBV5KgL32fMRLsazDV","slot":83736836},{"blockTime":1625077260,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"21VZZ3aBWCcq3M2i35niFDf2MFNxw9dV6WTsTfuD6pbsBJQfSw6QeHuGYcV7Wwth2b85ojKkqUtdoSLD3afvoMHL","slot":83736836},{"blockTime":1625077255,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Et7W5WhdWevzYkmYup9tK3YyPCRjqQjaRDRKL2HsCcTEULVMmSGEA7EXrvi2scSyLbBxH1cca4t59Lo396oHpJD","slot":83736830},{"blockTime":1625077254,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"67SxZmi8fXUXrYxKmVWSjdVxusEjjKqwyy7bE5Xm76iwDDSCz2ADUxbs1iftcDDQ6cQwGzeab1Tmr3ACoZfBXRoi","slot":83736826},{"blockTime":1625077251,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"FLRY7QeB3p14pPTonjN42eBcQHBaWQKyknj8mHW1A5igqFj2CAYUXjTWBZJnTSt6daUZdvTrv7rZKDtvxQaPfVx","slot":83736822},{"blockTime":1625077248,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ZyEzChUetYkK9odn4u41ARZfFXPNZpPKMzyTYej6koCXM3Q7ukQEVWbJueGt6z4SfZrZFDZbLteCjBZHJCGqvXs","slot":83736817},{"blockTime":1625077239,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4BjjGVBPwNdeSd7mSKJa1K1jNkQj9wsaDvf55FKp2CE2cnkEUAJuHRU8AZYFu3MRWmsQkurnrWe7jFwHPtRBSghn","slot":83736802},{"blockTime":1625077237,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3AwRoeWEjSBA3wK4CiTMr27RxRQhvSGymYXoYDUQcLR6GnrPhzqa9RQgBwzrokrWQ3q9wv8FJci3JwXJrowJxj8U","slot":83736799},{"blockTime":1625077236,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2wZbn8Ex1UYYfqLeoPWjLwYmWSiSfuCEywAtLFkKV2XcDW8qPhcC9yVaCLsFHtirjSLZtRhn4abRLZSuSYfRYG7s","slot":83736796},{"blockTime":1625077218,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3qDcSkUoFMjQa6xr1YMhQrfBCPm1pdi7scd6VHJZBQwJcaQoWEW48yfxHaxb7PtEboYMVgTr9HpHC8DD8ApkRB5f","slot":83736766},{"blockTime":1625077209,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"62pxS1a7u7L3PMrW7vbNKV5xHMbhoRMzkkBcXJAVxXd6Try7sTDYxqZL6gwwKPKsi1aWAuH1k4forn3bPPpJTxnN","slot":83736752},{"blockTime":1625076786,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2NSmkMj1XRQr6iHzRXE4F2otgVQj2N9QttfTP9wX2xryFPk794XK5AJwohUL74natHAt3R335ubazTieZ6nh7xeK","slot":83736048},{"blockTime":1625076784,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4uxNVxkXrnDtnpnPpEw4CR1BaNoH16Fk8LC6frcaQGXcHym129zyGb5eKJf317v5SXkgoqcVc171TgCVRm9qBoEN","slot":83736045},{"blockTime":1625076774,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3trijXRfh8Cb2BDUcP2wFyx2fYmtZAdDoAM4MMbeb2z5s9LXQLTeAVdNikqkCNgkAPrPTmRakmoRVyZKgwe8NKWL","slot":83736028},{"blockTime":1625076772,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"SK4z4KaiMoUVMkJMAa6z2kMziUEuB1t9jw83fcmy8ERx9rnMHM5mQYBwn1ANMu5LaZHaBLCLB5VhVQ4wCJXg6P2","slot":83736024},{"blockTime":1625076759,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ChPfmvLbeG7hbWmmuHADwNrXsRN7NsKfzneqPUeBBQmpJZP26SNag95i49LKqvubdVzNfAAnThbzPyZXFFfj8yk","slot":83736001},{"blockTime":1625076756,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3svPmAri9wHTGbbXd9hcnztPELHYRDGvZdidQv3FCYi1hMCRV8VPxujLaCRreVfMEaKV1LVmmKeQ89XakViHv2oD","slot":83735998},{"blockTime":1625076753,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"TDf8yvHRbdZKgkiuLE89eEmx4c3BayFFKWp4EnCnFePCXJUyjpTS5xSvqNFP3cVa7nNAkeMhQHpEMBcspfbaqaE","slot":83735992},{"blockTime":1625076747,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"35LmRSyUkRXkz1TwX8hW37TFYVnN7adnz6scFdT43gp9XNJfx7xynUaQSAtA7YBtZckdG6osBwL7QKdSMoF5TgNs","slot":83735983},{"blockTime":1625076744,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5Gfyu5QdRhvUQ7frhZUHaDCCeP1z1GdSBKeF36pdaP7ZhgxqbtoBRyfTDuYY9V7uQCYH7b6cbpL2j6yVY3Es6Ngp","slot":83735978},{"blockTime":1625076537,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3ktJesFoFTsWBihjBUtCa6bpQxncpzMJbkkaVgsVkTSgy8QFS3DcaYhkp8i5Eu7FQ7HHejPhCdZ3cW55ppXYXnPT","slot":83735631},{"blockTime":1625076534,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"537d9T6SAMo3HqUoxKLuEtQ8VnAMDYswCzjDbGZBqkxU3znHhcG8LUZv6K2sGccStKyvwo8SazNox38B9zmrYP6b","slot":83735627},{"blockTime":1625076531,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3NqcoKpUH35QgVBPh1yycVxAYj4hxf5AYAQjpQsPCXy8DnqZtp9Js5Wd46pU4HNBBhVStTru3CBY4BHpYqWQjuAJ","slot":83735623},{"blockTime":1625076529,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4jPcNxgzrNMohYF4f7obDKw1TUkdFcq5MvQ9vK62DsFvkXunJBXVF6AKc8jabkAvDB7M26TtAV3zxPveFn5WAXps","slot":83735620},{"blockTime":1625076528,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4LyrcDjE43uVzF5uCYrQ8SAkNuYAccc8dEyJR4cdmTFnkSwhs81zHYTundmPKP8iqKZ1wd5udUPfxuiLQdkHtpzk","slot":83735618},{"blockTime":1625076526,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3UgoibtBrbbUUxXqHgtHqW8X63sSGQejEm1Eg8H7xuVD1YyMPdkcu23m1GSbNgD1FPqn4DcD2FaLN122zMt9MG51","slot":83735615},{"blockTime":1625076525,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Q1dXq1AhJqeJjoEsmJaY22Z8xNEBCKGE4umrYVbyKgATA6riEHhu2otWzDYZgibJeQGt1yP3vnydQ8kkiLvSPJy","slot":83735612},{"blockTime":1625076520,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5pCieFTqV61ti5jznoAAQsVdV958t6h5whQdxQVXtZYkQrjxf3zm5Fjx2VmQFLMzhiVK6NXZWJgQJmZ3A9ULcYxh","slot":83735605},{"blockTime":1625076517,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5NMhKqZNK1WcdnyKLv59iGXaS7ruGEes2h4NBo1cMneQoiN3CqtF4CiF5arGH5nuMmkrjCjVxRSnU5irJj97cKou","slot":83735600},{"blockTime":1625076427,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5xnRayHgj9RJ1beTdZ58yPQikTo9b88xKvSJq26dBGBNPBW9oLqoTXRvUvZ1YASJq5pHRYRTBfPzPWUYroiVQMYw","slot":83735449},{"blockTime":1625076420,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2QFHUoJTWeHciqZV2bT7pLXsAsHfGiUnocxtojVL6H96EQjgvetgPdEtkohwVgUMHu929Jnq9UigG7xNrNykcSMJ","slot":83735436},{"blockTime":1625076415,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"o2uvrLGMPmAhY3Y8uyHNZuj1m8jXb37eiLUFEHWPHy2MnKzy6BRr3QHgsH7yrdBjHYXWgujra3y1wumDDWFgWJ9","slot":83735430},{"blockTime":1625076414,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"54Uh53PVJMrEBDAWXaGZybAtJR5YpAen9TcvqQPgQoPizFaqEjTfvk5C4w6D4nLaTaukEDufQsFRxe5g6MAUMD2n","slot":83735426},{"blockTime":1625076408,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4SJJHpzvQhwPG4w4rHZEXFmDQszqDYJWKGhWBTGawWeKvKv3KiRMdfiELqewDJVugG2SbMSq4XRznpAhnSABskYe","slot":83735417},{"blockTime":1625076405,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4ps21mg8hd6QxdjEWcjn5p1pUaqKXJ6A2mJzmWkWHkLn3ubd1zydSfnVcNGJFBYpYbLeYpXHVYMWoj8JjaNWbF3W","slot":83735412},{"blockTime":1625076402,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"8fHSb2who8mVjxaTDacFDgGC7FWS9ptWxmMtSVtZva6hQbGnKNZEqgBTpLPCUUEXFwWs5nGjab6RsqVNLYqcGkM","slot":83735408},{"blockTime":1625076402,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"25qu8LAxKf9DAmxYiFDahE3r4dEouvmDtUcnret2q5D8EraJLQ1jpTivGqXfAnaRiHc4EBevj8vBM17QSLiHE1zw","slot":83735406},{"blockTime":1625076396,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2wogh59NbZkuNfekk1C3gX1JjnMwvmQQtehgvY6CdhqnApqotb4ApJHB2N5NY3Bfn3zReHUJTSJCCW8hPmTtb9mi","slot":83735398},{"blockTime":1625076345,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"mcx6w62cFL7AHEDmcM8XiKGxceUR8u5BU6eb6majm71hPKv39VVQugxpJxfUTgHLd7Ggap6tus72uuBoqJsoDeA","slot":83735312},{"blockTime":1625076342,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5GoCvMJzT9iWHuYdZy9tZwrbKzrhj1vV43A2NrjyKHM73KMimZ6fiqVZ2T5eGYqcds7gcRRmKaT7Bk9zFLFMMu4c","slot":83735307},{"blockTime":1625076340,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4TxUBUYMRVNXp9S5cvyQjH6jSV79jJE6z74KuPUPD2F1ev2JjwRk7rWNCBDnzxdNupYBnbbr5jWbCcUUEGPYYqLy","slot":83735304},{"blockTime":1625076334,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3YnVUk287ve3wusgMvPd8cuWYz3Y7PjpEDZEghjxdc2dcgDMASnrdZgEidn71urjUx6dzY9SBYcDD7SD3caBHhNY","slot":83735294},{"blockTime":1625076328,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"rimzuWjV28QXou3Hp7yUSmY4J7RXqQzjEtEELAKFZXi4FaXFAGPnqUFbqutbkSWAERqro4jCdu88boD9X6NT1v9","slot":83735284},{"blockTime":1625076327,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5xxAYW5T545m9uityzzH8tHCLftVMbA1CdSxHhM3zJ6zM3URnePnPsMqQLpaJvXTSDMYhTLKzWJUgRCPNmSXS5xT","slot":83735281},{"blockTime":1625076324,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"2veJJbWLer5LjoJowt8qPMc2BVczBksC8SMFT47daptjajXaNZH9MFCheqetxhzy3hr2uiAvj4mweDGyd2mr8cK","slot":83735277},{"blockTime":1625076321,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4MSMekrScr8T4L3CbDe8XeShUUojMLW69inws8ToBCU2DnnC2cUL4uaSXnGKX3MUCR7enuKUqFKo5xLAfMSM4FpN","slot":83735272},{"blockTime":1625076319,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4HyshAQoEfcCNcGQXG2ajLKFm1W16DgPbWxGZZoX6BtS1R6UBMWKb1BbR65Nf3WaFDWUW8eXLdwGHtots8NfeWDy","slot":83735269},{"blockTime":1625076001,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3TYpN4Ce7HeiJaWFvWXPe9WWFK16XJhrvosgLFPxu79zeC5JfrGYBkyuVQgC6NURueQh2bFBBzfgMagGtSqUL189","slot":83734740},{"blockTime":1625076000,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4Yngcx43VayhKhHuQxCm6fY6xEMCSoCZTE8TVuzPSy4TPph7GzcxX5TSrm462DfBp1GzWvqXvDfxKi4rSB6Lnph5","slot":83734738},{"blockTime":1625075997,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5U9uqrGrTyG3GLXcMyipQG3UxYCjgPyYunEQCkJHtMhsUvjmnVRwdFvCoFoUk3wxmQhJ6jMVBBUbDXGBnzG7GyFd","slot":83734733},{"blockTime":1625075997,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"3jnZ3CDMME59YSGXHZdc3GvRpHFiatzDDpADFx1Fr6rHEfsUcuFbTRLUMHmZPPEk8E81SXWAp5KTx8cJZfaEGr98","slot":83734731},{"blockTime":1625075994,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"AVHS4gMuaKdUQTPSCXXjJ4bXKYHxaYgxkPEA31GF1rV2RKvfgW6W4Ho2p4dRXjhvSogSUQwYoPnNRsbA7HWw5Q8","slot":83734726},{"blockTime":1625075992,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5u3HZDSPcQ83S2GFw2dxriaqCo1ZdwLpssAFa8TfkefPmbCqS3psQXNKg98pQVts5PpemeACR3ZKEZGFC8QAE28F","slot":83734724},{"blockTime":1625075989,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"hrvEYRU9hWzac51jMFEHeVAsgqZqkr6Mci39NYvcGKeWjvG9awRGWo6nS1yzgBxeou9VUBc21G8pfRbKwaX2owd","slot":83734719},{"blockTime":1625075988,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"4AoESqGfx7V8hLbfTeXnYoFzMLXZjxFEnhShGWmd3CKoFpyVgDJ9keVnjZ8LYFK44rFNnMC3Atjv3CiMxsKESdv1","slot":83734716},{"blockTime":1625075983,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"5PqbJFNVTLR1WHk1DPpmL89qDoTuRRPWx8FRmUzC4n9Fghua62tP9tHvSQABHANZFbe2f1YQh43zJKoZYvts5K63","slot":83734710},{"blockTime":1625075940,"confirmationStatus":"finalized","err":null,"memo":null,"signature":"63jD1uq6iQJmjUxgo3cDB9AvJGd22WhXA8xjaoJyiuUSaABzbRQi1G7CVFbxoCZZDGA9v9Gy3166rApdSswV51Xb","slot":83734637}]`
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
@@ -2163,7 +2007,7 @@ func TestClient_GetTransaction(t *testing.T) {
 }
 
 func TestClient_GetParsedTransaction(t *testing.T) {
-	responseBody := `{"blockTime":1660570006,"meta":{"err":null,"fee":10000,"innerInstructions":[{"index":2,"instructions":[{"parsed":{"info":{"account":"BMnsyyG6S6zkaE3K5X3nbRMKdvBS5dT6HhcMozBVL7Ly","amount":"47444666","authority":"7oPa2PHQdZmjSPqvpZN7MQxnC7Dcf3uL4oLqknGLk2S3","mint":"E942z7FnS7GpswTvF5Vggvo7cMTbvZojjLbFgsrDVff1"},"type":"burn"},"program":"spl-token","programId":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"},{"parsed":{"info":{"destination":"9bFNrXNb2WTx8fMHXCheaZqkLZ3YCCaiqTftHxeintHy","lamports":100,"source":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo"},"type":"transfer"},"program":"system","programId":"11111111111111111111111111111111"},{"accounts":["2yVjuQwpsvdsrywzsJJVs9Ueh4zayyo5DYJbBNc3DDpn","3KEmPDRc6WEvhomG8awhfv2k33HgeqfGJmE1dptFmzhR"],"data":"2Af7uakYAFq8MGzDZQhLpcgRrAP9WHnAaA61z8nFafM8rFGNsKkksFcD6dDnAebHD6LCZBXqP6iyo8mX8XnteCsiEagZSqRLbe1QTRBpzZmwtFBVwY4SLyqBMxXKX35SM7zKVA7GYiTa2UDCaDvqQ3SQdHvRNaF5AED3HcJpYC1eFGhPpSjESVZHPN2rYYZXwma","programId":"worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth"}]}],"loadedAddresses":{"readonly":[],"writable":[]},"logMessages":["Program 11111111111111111111111111111111 invoke [1]","Program 11111111111111111111111111111111 success"],"postBalances":[72226420],"postTokenBalances":[{"accountIndex":4,"mint":"E942z7FnS7GpswTvF5Vggvo7cMTbvZojjLbFgsrDVff1","owner":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo","programId":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","uiTokenAmount":{"amount":"0","decimals":6,"uiAmount":null,"uiAmountString":"0"}}],"preBalances":[74714380],"preTokenBalances":[{"accountIndex":4,"mint":"E942z7FnS7GpswTvF5Vggvo7cMTbvZojjLbFgsrDVff1","owner":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo","programId":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","uiTokenAmount":{"amount":"47444666","decimals":6,"uiAmount":47.444666,"uiAmountString":"47.444666"}}],"rewards":[],"status":{"Ok":null}},"slot":146099091,"transaction":{"message":{"accountKeys":[{"pubkey":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo","signer":true,"writable":true}],"addressTableLookups":null,"instructions":[{"parsed":{"info":{"destination":"9bFNrXNb2WTx8fMHXCheaZqkLZ3YCCaiqTftHxeintHy","lamports":100,"source":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo"},"type":"transfer"},"program":"system","programId":"11111111111111111111111111111111"},{"parsed":{"info":{"amount":"47444666","delegate":"7oPa2PHQdZmjSPqvpZN7MQxnC7Dcf3uL4oLqknGLk2S3","owner":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo","source":"BMnsyyG6S6zkaE3K5X3nbRMKdvBS5dT6HhcMozBVL7Ly"},"type":"approve"},"program":"spl-token","programId":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"},{"accounts":["G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo"],"data":"2dmnzvSCNoP8bNbUnUtk7FTYod5czhUfk4E7LSPNMtK4V1FHgQVYeQ2GnsEtCKZCyLLHXvnkReP","programId":"wormDTUJ6AWPNvk59vGQbDvGJmqbDTdgWgAqcLBCgUb"}],"recentBlockhash":"9L8FEB81LfZ67ejxpMaaZmC9EmXBpV38dhNaiF9UbzZi"},"signatures":["2x1QBpfcEQetAx7zETLEmvVvjue9311s9AWroEvMAboFkqaHZVp1sUpTFXroc5Q6tkPmZK5pYfmPFteoZPVRLF89"]}}`
+	responseBody := `{"blockTime":1660570006,"meta":{"err":null,"fee":10000,"innerInstructions":[{"index":2,"instructions":[{"parsed":{"info":{"account":"BMnsyyG6S6zkaE3K5X3nbRMKdvBS5dT6HhcMozBVL7Ly","amount":"47444666","authority":"7oPa2PHQdZmjSPqvpZN7MQxnC7Dcf3uL4oLqknGLk2S3","mint":"E942z7FnS7GpswTvF5Vggvo7cMTbvZojjLbFgsrDVff1"},"type":"burn"},"program":"spl-token","programId":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"},{"parsed":{"info":{"destination":"9bFNrXNb2WTx8fMHXCheaZqkLZ3YCCaiqTftHxeintHy","lamports":100,"source":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo"},"type":"transfer"},"program":"system","programId":"11111111111111111111111111111111"},{"accounts":["2yVjuQwpsvdsrywzsJJVs9Ueh4zayyo5DYJbBNc3DDpn","3KEmPDRc6WEvhomG8awhfv2k33HgeqfGJmE1dptFmzhR"],"data":"2Af7uakYAFq8MGzDZQhLpcgRrAP9WHnAaA61z8nFafM8rFGNsKkksFcD6dDnAebHD6LCZBXqP6iyo8mX8XnteCsiEagZSqRLbe1QTRBpzZmwtFBVwY4SLyqBMxXKX35SM7zKVA7GYiTa2UDCaDvqQ3SQdHvRNaF5AED3HcJpYC1eFGhPpSjESVZHPN2rYYZXwma","programId":"worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth"}]}],"loadedAddresses":{"readonly":[],"writable":[]},"logMessages":["Program 11111111111111111111111111111111 invoke [1]","Program 11111111111111111111111111111111 success"],"postBalances":[72226420],"postTokenBalances":[{"accountIndex":4,"mint":"E942z7FnS7GpswTvF5Vggvo7cMTbvZojjLbFgsrDVff1","owner":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo","programId":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","uiTokenAmount":{"amount":"0","decimals":6,"uiAmount":null,"uiAmountString":"0"}}],"preBalances":[74714380],"preTokenBalances":[{"accountIndex":4,"mint":"E942z7FnS7GpswTvF5Vggvo7cMTbvZojjLbFgsrDVff1","owner":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo","programId":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","uiTokenAmount":{"amount":"47444666","decimals":6,"uiAmount":47.444666,"uiAmountString":"47.444666"}}],"rewards":[],"status":{"Ok":null}},"slot":146099091,"version":"legacy","transaction":{"message":{"accountKeys":[{"pubkey":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo","signer":true,"writable":true}],"addressTableLookups":null,"instructions":[{"parsed":{"info":{"destination":"9bFNrXNb2WTx8fMHXCheaZqkLZ3YCCaiqTftHxeintHy","lamports":100,"source":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo"},"type":"transfer"},"program":"system","programId":"11111111111111111111111111111111"},{"parsed":{"info":{"amount":"47444666","delegate":"7oPa2PHQdZmjSPqvpZN7MQxnC7Dcf3uL4oLqknGLk2S3","owner":"G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo","source":"BMnsyyG6S6zkaE3K5X3nbRMKdvBS5dT6HhcMozBVL7Ly"},"type":"approve"},"program":"spl-token","programId":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"},{"accounts":["G7Hf2J55BAkHtbbXPh94UTGRCQioKPpnb5oKQMBteXo"],"data":"2dmnzvSCNoP8bNbUnUtk7FTYod5czhUfk4E7LSPNMtK4V1FHgQVYeQ2GnsEtCKZCyLLHXvnkReP","programId":"wormDTUJ6AWPNvk59vGQbDvGJmqbDTdgWgAqcLBCgUb"}],"recentBlockhash":"9L8FEB81LfZ67ejxpMaaZmC9EmXBpV38dhNaiF9UbzZi"},"signatures":["2x1QBpfcEQetAx7zETLEmvVvjue9311s9AWroEvMAboFkqaHZVp1sUpTFXroc5Q6tkPmZK5pYfmPFteoZPVRLF89"]}}`
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
 	defer closer()
 	client := New(server.URL)
@@ -2196,6 +2040,7 @@ func TestClient_GetParsedTransaction(t *testing.T) {
 		server.RequestBody(t),
 	)
 
+	assert.Equal(t, LegacyTransactionVersion, out.Version)
 	assert.Equal(t, uint64(2), out.Meta.InnerInstructions[0].Index)
 	assert.Equal(t, &InstructionInfo{
 		Info: map[string]interface{}{
@@ -2628,6 +2473,58 @@ func TestClient_SendRawTransaction(t *testing.T) {
 	assert.Equal(t, expected, got, "both deserialized values must be equal")
 }
 
+func TestClient_SendTransaction_V0(t *testing.T) {
+	responseBody := fmt.Sprintf(`"%s"`, txSignatureString)
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
+	defer closer()
+	client := New(server.URL)
+
+	payer := solana.NewWallet()
+	table := solana.NewWallet().PublicKey()
+	writable := solana.NewWallet().PublicKey()
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			solana.NewInstruction(
+				solana.SystemProgramID,
+				solana.AccountMetaSlice{solana.Meta(payer.PublicKey()).WRITE().SIGNER(), solana.Meta(writable).WRITE()},
+				[]byte{1},
+			),
+		},
+		solana.Hash{1},
+		solana.TransactionPayer(payer.PublicKey()),
+		solana.TransactionAddressTables(map[solana.PublicKey]solana.PublicKeySlice{table: {writable}}),
+	)
+	require.NoError(t, err)
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey { return &payer.PrivateKey })
+	require.NoError(t, err)
+	rawTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	_, err = client.SendTransaction(context.Background(), tx)
+	require.NoError(t, err)
+	params := server.RequestBody(t)["params"].([]interface{})
+	sent, err := base64.StdEncoding.DecodeString(params[0].(string))
+	require.NoError(t, err)
+	require.Equal(t, rawTx, sent)
+	decoded, err := solana.TransactionFromDecoder(bin.NewBinDecoder(sent))
+	require.NoError(t, err)
+	require.Equal(t, solana.MessageVersionV0, decoded.Message.GetVersion())
+	lookups := decoded.Message.GetAddressTableLookups()
+	require.Len(t, lookups, 1)
+	require.Equal(t, table, lookups[0].AccountKey)
+	require.Equal(t, []uint8{0}, []uint8(lookups[0].WritableIndexes))
+
+	// The raw bytes are sent unchanged, with the requested encoding.
+	_, err = client.SendRawTransactionWithOpts(context.Background(), rawTx, TransactionOpts{Encoding: solana.EncodingBase58})
+	require.NoError(t, err)
+	params = server.RequestBody(t)["params"].([]interface{})
+	require.Equal(t, base58.Encode(rawTx), params[0])
+	require.Equal(t, string(solana.EncodingBase58), params[1].(map[string]interface{})["encoding"])
+
+	_, err = client.SendRawTransactionWithOpts(context.Background(), rawTx, TransactionOpts{Encoding: solana.EncodingJSON})
+	require.Error(t, err)
+}
+
 func TestClient_IsBlockhashValid(t *testing.T) {
 	responseBody := `{"context":{"slot":100688709},"value":true}`
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
@@ -2707,6 +2604,45 @@ func TestClient_GetFeeForMessage(t *testing.T) {
 	assert.Equal(t, expected, got, "both deserialized values must be equal")
 }
 
+func TestClient_FeeForMessage(t *testing.T) {
+	responseBody := `{"context":{"slot":5068},"value":5000}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
+	defer closer()
+	client := New(server.URL)
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			solana.NewInstruction(solana.MemoProgramID, solana.AccountMetaSlice{solana.Meta(solana.PublicKey{1}).SIGNER().WRITE()}, []byte("memo")),
+		},
+		solana.Hash{2},
+	)
+	require.NoError(t, err)
+	fee, err := tx.Fee(context.Background(), client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), fee)
+
+	assert.Equal(t,
+		map[string]interface{}{
+			"id":      float64(0),
+			"jsonrpc": "2.0",
+			"method":  "getFeeForMessage",
+			"params": []interface{}{
+				tx.Message.ToBase64(),
+			},
+		},
+		server.RequestBody(t),
+	)
+}
+
+func TestClient_FeeForMessage_Unavailable(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(`{"context":{"slot":5068},"value":null}`)))
+	defer closer()
+	client := New(server.URL)
+
+	_, err := client.FeeForMessage(context.Background(), &solana.Message{})
+	require.ErrorIs(t, err, ErrFeeUnavailable)
+}
+
 func TestClient_GetHighestSnapshotSlot(t *testing.T) {
 	responseBody := `{"full":100,"incremental":110}`
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
@@ -2734,6 +2670,40 @@ func TestClient_GetHighestSnapshotSlot(t *testing.T) {
 	assert.Equal(t, expected, got, "both deserialized values must be equal")
 }
 
+func TestClient_GetRecentPrioritizationFees(t *testing.T) {
+	responseBody := `[{"slot":348125,"prioritizationFee":0},{"slot":348126,"prioritizationFee":1000}]`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
+	defer closer()
+	client := New(server.URL)
+
+	account := solana.MustPublicKeyFromBase58("CM78CPUeXjn8o3yroDHxUtKsZZgoy4GPkPPXfouKNH12")
+	out, err := client.GetRecentPrioritizationFees(
+		context.Background(),
+		solana.PublicKeySlice{account},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		map[string]interface{}{
+			"id":      float64(0),
+			"jsonrpc": "2.0",
+			"method":  "getRecentPrioritizationFees",
+			"params": []interface{}{
+				[]interface{}{account.String()},
+			},
+		},
+		server.RequestBody(t),
+	)
+	assert.Equal(t, []PrioritizationFee{
+		{Slot: 348125, PrioritizationFee: 0},
+		{Slot: 348126, PrioritizationFee: 1000},
+	}, out)
+
+	_, err = client.GetRecentPrioritizationFees(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]interface{}{}}, server.RequestBody(t)["params"])
+}
+
 func TestClient_GetLatestBlockhash(t *testing.T) {
 	responseBody := `{"context":{"slot":2792},"value":{"blockhash":"EkSnNWid2cvwEVnVx9aBqawnmiCNiDgp3gUdkDPTKN1N","lastValidBlockHeight":3090}}`
 	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
@@ -0,0 +1,213 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// SetRequestCoalescing enables (or disables) the coalescing of the identical
+// calls of the client: while a call is in flight, the calls of the same method
+// with the same parameters (and the same headers, see WithHeaders) wait for
+// its response instead of being sent.
+// Only the read-only methods are coalesced (not e.g. sendTransaction or
+// requestAirdrop). The shared call is canceled when all its callers are
+// (e.g. by their contexts); the requests made with CallWithCallback and
+// in batches are not coalesced.
+// It must be called before the client is used.
+func (cl *Client) SetRequestCoalescing(enabled bool) *Client {
+	if coalescing, ok := cl.rpcClient.(*coalescingClient); ok {
+		cl.rpcClient = coalescing.rpcClient
+	}
+	if enabled {
+		cl.rpcClient = &coalescingClient{
+			rpcClient: cl.rpcClient,
+			calls:     make(map[string]*flight),
+		}
+	}
+	return cl
+}
+
+// coalescedMethods are the read-only methods coalesced by a coalescingClient.
+var coalescedMethods = map[string]bool{
+	"getAccountInfo":                    true,
+	"getBalance":                        true,
+	"getBlock":                          true,
+	"getBlockCommitment":                true,
+	"getBlockHeight":                    true,
+	"getBlockProduction":                true,
+	"getBlockTime":                      true,
+	"getBlocks":                         true,
+	"getBlocksWithLimit":                true,
+	"getClusterNodes":                   true,
+	"getEpochInfo":                      true,
+	"getEpochSchedule":                  true,
+	"getFeeForMessage":                  true,
+	"getFirstAvailableBlock":            true,
+	"getGenesisHash":                    true,
+	"getHealth":                         true,
+	"getHighestSnapshotSlot":            true,
+	"getIdentity":                       true,
+	"getInflationGovernor":              true,
+	"getInflationRate":                  true,
+	"getInflationReward":                true,
+	"getLargestAccounts":                true,
+	"getLatestBlockhash":                true,
+	"getLeaderSchedule":                 true,
+	"getMaxRetransmitSlot":              true,
+	"getMaxShredInsertSlot":             true,
+	"getMinimumBalanceForRentExemption": true,
+	"getMultipleAccounts":               true,
+	"getProgramAccounts":                true,
+	"getRecentPerformanceSamples":       true,
+	"getRecentPrioritizationFees":       true,
+	"getSignatureStatuses":              true,
+	"getSignaturesForAddress":           true,
+	"getSlot":                           true,
+	"getSlotLeader":                     true,
+	"getSlotLeaders":                    true,
+	"getStakeActivation":                true,
+	"getStakeMinimumDelegation":         true,
+	"getSupply":                         true,
+	"getTokenAccountBalance":            true,
+	"getTokenAccountsByDelegate":        true,
+	"getTokenAccountsByOwner":           true,
+	"getTokenLargestAccounts":           true,
+	"getTokenSupply":                    true,
+	"getTransaction":                    true,
+	"getTransactionCount":               true,
+	"getVersion":                        true,
+	"getVoteAccounts":                   true,
+	"isBlockhashValid":                  true,
+}
+
+type coalescingClient struct {
+	rpcClient JSONRPCClient
+
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+// flight is a call in flight, shared by its waiters.
+type flight struct {
+	done    chan struct{}
+	result  stdjson.RawMessage
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+func (cc *coalescingClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if !coalescedMethods[method] {
+		return cc.rpcClient.CallForInto(ctx, out, method, params)
+	}
+	key, err := CacheKey(method, params)
+	if err != nil {
+		return cc.rpcClient.CallForInto(ctx, out, method, params)
+	}
+	// The callers with different headers (e.g. credentials) don't share calls.
+	key += contextHeadersKey(ctx)
+
+	cc.mu.Lock()
+	call, ok := cc.calls[key]
+	if !ok {
+		// The call is not canceled with the context of its first caller,
+		// but once all its waiters are gone.
+		callCtx, cancel := context.WithCancel(detachedContext{ctx})
+		call = &flight{done: make(chan struct{}), cancel: cancel}
+		cc.calls[key] = call
+		go func() {
+			defer cancel()
+			var result stdjson.RawMessage
+			err := cc.rpcClient.CallForInto(callCtx, &result, method, params)
+			cc.mu.Lock()
+			if cc.calls[key] == call {
+				delete(cc.calls, key)
+			}
+			cc.mu.Unlock()
+			call.result, call.err = result, err
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	cc.mu.Unlock()
+
+	select {
+	case <-call.done:
+		if call.err != nil {
+			return call.err
+		}
+		if len(call.result) == 0 {
+			return json.Unmarshal([]byte("null"), out)
+		}
+		return json.Unmarshal(call.result, out)
+	case <-ctx.Done():
+		cc.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// The next callers start a new call.
+			if cc.calls[key] == call {
+				delete(cc.calls, key)
+			}
+			call.cancel()
+		}
+		cc.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (cc *coalescingClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	return cc.rpcClient.CallWithCallback(ctx, method, params, callback)
+}
+
+func (cc *coalescingClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	batchClient, ok := cc.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return nil, ErrBatchNotSupported
+	}
+	return batchClient.CallBatch(ctx, requests)
+}
+
+func (cc *coalescingClient) Close() error {
+	if c, ok := cc.rpcClient.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// detachedContext holds the values of its parent, without its cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetRequestCoalescing(t *testing.T) {
+	var count int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&count, 1)
+		<-release
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL).SetRequestCoalescing(true)
+
+	var wg sync.WaitGroup
+	slots := make([]uint64, 10)
+	errs := make([]error, 10)
+	for i := range slots {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			slots[i], errs[i] = client.GetSlot(context.Background(), CommitmentFinalized)
+		}(i)
+	}
+	// A canceled caller does not cancel the others.
+	ctx, cancel := context.WithCancel(context.Background())
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := client.GetSlot(ctx, CommitmentFinalized)
+		require.Equal(t, context.Canceled, err)
+	}()
+	require.Eventually(t, func() bool {
+		client.rpcClient.(*coalescingClient).mu.Lock()
+		defer client.rpcClient.(*coalescingClient).mu.Unlock()
+		for _, call := range client.rpcClient.(*coalescingClient).calls {
+			return call.waiters == 11
+		}
+		return false
+	}, time.Second, time.Millisecond)
+	cancel()
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&count))
+	for i := range slots {
+		require.NoError(t, errs[i])
+		require.Equal(t, uint64(42), slots[i])
+	}
+
+	// Not in flight anymore.
+	_, err := client.GetSlot(context.Background(), CommitmentFinalized)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&count))
+
+	// Other parameters.
+	_, err = client.GetSlot(context.Background(), CommitmentConfirmed)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), atomic.LoadInt32(&count))
+
+	client.SetRequestCoalescing(false)
+	_, ok := client.rpcClient.(*coalescingClient)
+	require.False(t, ok)
+}
+
+func TestClient_SetRequestCoalescing_Canceled(t *testing.T) {
+	canceled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		// The server notices that the client is gone once the body is read.
+		ioutil.ReadAll(req.Body)
+		<-req.Context().Done()
+		close(canceled)
+	}))
+	defer server.Close()
+
+	client := New(server.URL).SetRequestCoalescing(true)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.GetSlot(ctx, "")
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	// The shared call is canceled once all its callers are gone.
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("shared call not canceled")
+	}
+}
+
+func TestClient_SetRequestCoalescing_NotCoalesced(t *testing.T) {
+	var count int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&count, 1)
+		<-release
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL).SetRequestCoalescing(true)
+
+	var wg sync.WaitGroup
+	calls := []func() error{
+		// Not read-only.
+		func() error {
+			var out uint64
+			return client.RPCCallForInto(context.Background(), &out, "requestAirdrop", []interface{}{"x", 1})
+		},
+		func() error {
+			var out uint64
+			return client.RPCCallForInto(context.Background(), &out, "requestAirdrop", []interface{}{"x", 1})
+		},
+		// Other headers.
+		func() error {
+			_, err := client.GetSlot(WithHeaders(context.Background(), BearerAuthHeader("a")), "")
+			return err
+		},
+		func() error {
+			_, err := client.GetSlot(WithHeaders(context.Background(), BearerAuthHeader("b")), "")
+			return err
+		},
+	}
+	for _, call := range calls {
+		wg.Add(1)
+		go func(call func() error) {
+			defer wg.Done()
+			require.NoError(t, call())
+		}(call)
+	}
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&count) == int32(len(calls))
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Normalize maps the commitment levels deprecated as of v1.5.5
+// to their current equivalents; other values are returned as-is.
+func (c CommitmentType) Normalize() CommitmentType {
+	switch c {
+	case CommitmentMax, CommitmentRoot:
+		return CommitmentFinalized
+	case CommitmentSingle, CommitmentSingleGossip:
+		return CommitmentConfirmed
+	case CommitmentRecent:
+		return CommitmentProcessed
+	default:
+		return c
+	}
+}
+
+// rank orders the commitment levels from the least (processed)
+// to the most (finalized) final; returns 0 for unknown levels.
+func (c CommitmentType) rank() int {
+	switch c.Normalize() {
+	case CommitmentProcessed:
+		return 1
+	case CommitmentConfirmed:
+		return 2
+	case CommitmentFinalized:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Validate returns an error if the commitment is not a known commitment level.
+// The empty commitment (i.e. the node default) is valid.
+func (c CommitmentType) Validate() error {
+	if c == "" || c.rank() > 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid commitment: %q", string(c))
+}
+
+// AtLeast returns true if the commitment is as final as,
+// or more final than, the provided one
+// (e.g. CommitmentFinalized.AtLeast(CommitmentConfirmed) is true).
+// Unknown commitment levels are never at least anything.
+func (c CommitmentType) AtLeast(other CommitmentType) bool {
+	rank := c.rank()
+	return rank > 0 && rank >= other.rank()
+}
+
+// InvalidCommitmentError is returned (before sending the request)
+// when the commitment is not supported by the RPC method.
+type InvalidCommitmentError struct {
+	Method     string
+	Commitment CommitmentType
+	Allowed    []CommitmentType
+}
+
+func (e *InvalidCommitmentError) Error() string {
+	allowed := make([]string, len(e.Allowed))
+	for i, c := range e.Allowed {
+		allowed[i] = string(c)
+	}
+	return fmt.Sprintf(
+		"commitment %q is not supported by %s; supported: %s",
+		string(e.Commitment),
+		e.Method,
+		strings.Join(allowed, ", "),
+	)
+}
+
+var confirmedOrFinalized = []CommitmentType{CommitmentConfirmed, CommitmentFinalized}
+
+// methodCommitments lists the methods that only support
+// a subset of the commitment levels.
+var methodCommitments = map[string][]CommitmentType{
+	"getBlock":                          confirmedOrFinalized,
+	"getBlocks":                         confirmedOrFinalized,
+	"getBlocksWithLimit":                confirmedOrFinalized,
+	"getTransaction":                    confirmedOrFinalized,
+	"getSignaturesForAddress":           confirmedOrFinalized,
+	"getInflationReward":                confirmedOrFinalized,
+	"getConfirmedBlock":                 confirmedOrFinalized,
+	"getConfirmedBlocks":                confirmedOrFinalized,
+	"getConfirmedBlocksWithLimit":       confirmedOrFinalized,
+	"getConfirmedTransaction":           confirmedOrFinalized,
+	"getConfirmedSignaturesForAddress2": confirmedOrFinalized,
+}
+
+// AllowedCommitments returns the commitment levels supported by the provided
+// RPC method, or nil if the method supports all of them.
+func AllowedCommitments(method string) []CommitmentType {
+	return methodCommitments[method]
+}
+
+// ValidateCommitmentForMethod returns an error if the commitment is invalid,
+// or is not supported by the provided RPC method (e.g. getBlock does not
+// support "processed"). The empty commitment (i.e. the node default) is always valid.
+func ValidateCommitmentForMethod(method string, commitment CommitmentType) error {
+	if commitment == "" {
+		return nil
+	}
+	if err := commitment.Validate(); err != nil {
+		return err
+	}
+	allowed, ok := methodCommitments[method]
+	if !ok {
+		return nil
+	}
+	normalized := commitment.Normalize()
+	for _, c := range allowed {
+		if c == normalized {
+			return nil
+		}
+	}
+	return &InvalidCommitmentError{
+		Method:     method,
+		Commitment: commitment,
+		Allowed:    allowed,
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitmentType_AtLeast(t *testing.T) {
+	require.True(t, CommitmentFinalized.AtLeast(CommitmentConfirmed))
+	require.True(t, CommitmentConfirmed.AtLeast(CommitmentConfirmed))
+	require.True(t, CommitmentConfirmed.AtLeast(CommitmentProcessed))
+	require.False(t, CommitmentProcessed.AtLeast(CommitmentConfirmed))
+	require.False(t, CommitmentConfirmed.AtLeast(CommitmentFinalized))
+
+	// Deprecated levels are mapped to their current equivalents.
+	require.True(t, CommitmentMax.AtLeast(CommitmentFinalized))
+	require.True(t, CommitmentSingleGossip.AtLeast(CommitmentConfirmed))
+	require.False(t, CommitmentRecent.AtLeast(CommitmentConfirmed))
+
+	require.False(t, CommitmentType("bogus").AtLeast(CommitmentProcessed))
+}
+
+func TestCommitmentType_Validate(t *testing.T) {
+	require.NoError(t, CommitmentType("").Validate())
+	require.NoError(t, CommitmentProcessed.Validate())
+	require.NoError(t, CommitmentRoot.Validate())
+	require.Error(t, CommitmentType("Finalized").Validate())
+}
+
+func TestValidateCommitmentForMethod(t *testing.T) {
+	require.NoError(t, ValidateCommitmentForMethod("getBlock", ""))
+	require.NoError(t, ValidateCommitmentForMethod("getBlock", CommitmentConfirmed))
+	require.NoError(t, ValidateCommitmentForMethod("getBlock", CommitmentMax))
+	require.NoError(t, ValidateCommitmentForMethod("getAccountInfo", CommitmentProcessed))
+	require.Error(t, ValidateCommitmentForMethod("getAccountInfo", "bogus"))
+
+	err := ValidateCommitmentForMethod("getBlock", CommitmentProcessed)
+	var commitmentErr *InvalidCommitmentError
+	require.True(t, errors.As(err, &commitmentErr))
+	require.Equal(t, "getBlock", commitmentErr.Method)
+	require.Equal(t, `commitment "processed" is not supported by getBlock; supported: confirmed, finalized`, err.Error())
+
+	require.Equal(t, []CommitmentType{CommitmentConfirmed, CommitmentFinalized}, AllowedCommitments("getTransaction"))
+	require.Nil(t, AllowedCommitments("getSlot"))
+}
+
+func TestClient_GetBlock_ProcessedCommitment(t *testing.T) {
+	node := &legacyNode{}
+	client := NewWithCustomRPCClient(node)
+	_, err := client.GetBlockWithOpts(context.Background(), 1, &GetBlockOpts{Commitment: CommitmentProcessed})
+	var commitmentErr *InvalidCommitmentError
+	require.True(t, errors.As(err, &commitmentErr))
+	// Nothing was sent.
+	require.Empty(t, node.calls)
+}
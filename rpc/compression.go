@@ -0,0 +1,177 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Content codings supported by the clients, for the requests and the responses.
+const (
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// acceptEncoding is the Accept-Encoding header sent by the clients;
+// the responses of getBlock and getProgramAccounts are large and
+// compress very well.
+const acceptEncoding = "gzip, zstd"
+
+// decompressionTransport requests compressed responses,
+// and transparently decompresses them.
+// It leaves alone the requests that already set Accept-Encoding,
+// whose callers handle the response encoding themselves.
+type decompressionTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *decompressionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Encoding") != "" || req.Header.Get("Range") != "" || req.Method == http.MethodHead {
+		return t.transport.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", acceptEncoding)
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	switch encoding {
+	case CompressionGzip, CompressionZstd:
+		resp.Body = &decompressingReader{body: resp.Body, encoding: encoding}
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+	}
+	return resp, nil
+}
+
+// decompressingReader decompresses a response body,
+// creating the decoder at the first read.
+type decompressingReader struct {
+	body     io.ReadCloser
+	encoding string
+
+	reader io.Reader
+	gzip   *gzip.Reader
+	zstd   *zstd.Decoder
+	err    error
+}
+
+func (r *decompressingReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	if r.reader == nil {
+		switch r.encoding {
+		case CompressionGzip:
+			r.gzip, r.err = gzip.NewReader(r.body)
+			r.reader = r.gzip
+		case CompressionZstd:
+			r.zstd, r.err = zstd.NewReader(r.body, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+			r.reader = r.zstd
+		}
+		if r.err != nil {
+			r.err = fmt.Errorf("unable to decompress %s response: %w", r.encoding, r.err)
+			return 0, r.err
+		}
+	}
+	return r.reader.Read(p)
+}
+
+// Close closes the decoder, if any, and the response body.
+func (r *decompressingReader) Close() error {
+	var err error
+	if r.gzip != nil {
+		err = r.gzip.Close()
+	}
+	if r.zstd != nil {
+		r.zstd.Close()
+	}
+	if closeErr := r.body.Close(); closeErr != nil {
+		return closeErr
+	}
+	return err
+}
+
+// CompressRequestsMiddleware returns a middleware compressing the bodies of
+// the requests with the encoding (CompressionGzip or CompressionZstd).
+// Only use it with providers that accept compressed requests.
+func CompressRequestsMiddleware(encoding string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Body == nil || req.Body == http.NoBody || req.Header.Get("Content-Encoding") != "" {
+				return next.RoundTrip(req)
+			}
+			body, err := ioutil.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			compressed, err := compressBody(encoding, body)
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Header.Set("Content-Encoding", encoding)
+			req.ContentLength = int64(len(compressed))
+			req.GetBody = func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(bytes.NewReader(compressed)), nil
+			}
+			req.Body, _ = req.GetBody()
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func compressBody(encoding string, body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case CompressionGzip:
+		w = gzip.NewWriter(&buf)
+	case CompressionZstd:
+		enc, err := zstd.NewWriter(&buf, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		w = enc
+	default:
+		return nil, fmt.Errorf("unsupported request compression %q", encoding)
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// NewWithCompression creates a new Solana JSON RPC client
+// compressing its requests with the encoding (CompressionGzip or CompressionZstd);
+// the responses are decompressed by all the clients.
+func NewWithCompression(rpcEndpoint string, encoding string) *Client {
+	return NewWithMiddlewares(rpcEndpoint, CompressRequestsMiddleware(encoding))
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+const getSlotResponse = `{"jsonrpc":"2.0","result":83886080,"id":0}`
+
+func TestClient_CompressedResponses(t *testing.T) {
+	for _, encoding := range []string{CompressionGzip, CompressionZstd, ""} {
+		t.Run(encoding, func(t *testing.T) {
+			var acceptEncodingHeader string
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				acceptEncodingHeader = req.Header.Get("Accept-Encoding")
+				body := []byte(getSlotResponse)
+				if encoding != "" {
+					var err error
+					body, err = compressBody(encoding, body)
+					require.NoError(t, err)
+					rw.Header().Set("Content-Encoding", encoding)
+				}
+				rw.Write(body)
+			}))
+			defer server.Close()
+
+			slot, err := New(server.URL).GetSlot(context.Background(), "")
+			require.NoError(t, err)
+			require.Equal(t, uint64(83886080), slot)
+			require.Equal(t, "gzip, zstd", acceptEncodingHeader)
+		})
+	}
+}
+
+func TestClient_CompressedRequests(t *testing.T) {
+	for _, encoding := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(encoding, func(t *testing.T) {
+			var body []byte
+			var contentEncoding string
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				contentEncoding = req.Header.Get("Content-Encoding")
+				raw, err := ioutil.ReadAll(req.Body)
+				require.NoError(t, err)
+				switch contentEncoding {
+				case CompressionGzip:
+					r, err := gzip.NewReader(bytes.NewReader(raw))
+					require.NoError(t, err)
+					body, err = ioutil.ReadAll(r)
+					require.NoError(t, err)
+				case CompressionZstd:
+					r, err := zstd.NewReader(bytes.NewReader(raw))
+					require.NoError(t, err)
+					defer r.Close()
+					body, err = ioutil.ReadAll(r)
+					require.NoError(t, err)
+				}
+				rw.Write([]byte(getSlotResponse))
+			}))
+			defer server.Close()
+
+			slot, err := NewWithCompression(server.URL, encoding).GetSlot(context.Background(), "")
+			require.NoError(t, err)
+			require.Equal(t, uint64(83886080), slot)
+			require.Equal(t, encoding, contentEncoding)
+			require.JSONEq(t, `{"id":0,"jsonrpc":"2.0","method":"getSlot","params":[]}`, string(body))
+		})
+	}
+
+	_, err := NewWithCompression("http://localhost:1", "br").GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unsupported request compression "br"`)
+}
+
+func TestClient_CustomAcceptEncoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "identity", req.Header.Get("Accept-Encoding"))
+		rw.Write([]byte(getSlotResponse))
+	}))
+	defer server.Close()
+
+	slot, err := NewWithHeaders(server.URL, map[string]string{"Accept-Encoding": "identity"}).GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(83886080), slot)
+}
+
+type closeRecorder struct {
+	*bytes.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestDecompressingReader_Close(t *testing.T) {
+	for _, encoding := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(encoding, func(t *testing.T) {
+			compressed, err := compressBody(encoding, []byte(getSlotResponse))
+			require.NoError(t, err)
+			body := &closeRecorder{Reader: bytes.NewReader(compressed)}
+			r := &decompressingReader{body: body, encoding: encoding}
+
+			data, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, getSlotResponse, string(data))
+			require.NoError(t, r.Close())
+			require.True(t, body.closed)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+// DryRunHook is called with the result of each transaction
+// simulated instead of being sent, in dry-run mode.
+type DryRunHook func(signature solana.Signature, result *SimulateTransactionResult)
+
+// DryRunError is returned in dry-run mode when the simulation of the transaction failed.
+type DryRunError struct {
+	Signature solana.Signature
+	Result    *SimulateTransactionResult
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: transaction %s failed: %v", e.Signature, e.Result.Err)
+}
+
+// SetDryRun enables or disables the dry-run mode: when enabled, the
+// sendTransaction calls (SendTransaction, SendRawTransaction, SendEncodedTransaction
+// and their WithOpts variants) simulate the transaction instead of broadcasting it,
+// without verifying the signatures (see signer.NoopSigner), and return
+// the first signature of the transaction, or a *DryRunError if the simulation failed.
+// It must be called before the client is used.
+func (cl *Client) SetDryRun(enabled bool) *Client {
+	cl.dryRun = enabled
+	return cl
+}
+
+// IsDryRun returns true if the dry-run mode is enabled.
+func (cl *Client) IsDryRun() bool {
+	return cl.dryRun
+}
+
+// SetDryRunHook sets the function called with the result of
+// each simulation in dry-run mode. Optional.
+// It must be called before the client is used.
+func (cl *Client) SetDryRunHook(hook DryRunHook) *Client {
+	cl.dryRunHook = hook
+	return cl
+}
+
+// dryRunEncodedTransaction simulates the base64-encoded transaction
+// in place of sending it.
+func (cl *Client) dryRunEncodedTransaction(
+	ctx context.Context,
+	encodedTx string,
+	opts TransactionOpts,
+) (signature solana.Signature, err error) {
+	rawTx, err := decodeTransaction(encodedTx, opts.Encoding)
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("dry run: decode transaction: %w", err)
+	}
+	signature, err = firstSignature(rawTx)
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("dry run: %w", err)
+	}
+
+	obj := (&SimulateTransactionOpts{Commitment: opts.PreflightCommitment}).ToMap()
+	obj["encoding"] = solana.EncodingBase64
+	if opts.Encoding != "" {
+		obj["encoding"] = opts.Encoding
+	}
+	var out *SimulateTransactionResponse
+	err = cl.rpcClient.CallForInto(ctx, &out, "simulateTransaction", []interface{}{encodedTx, obj})
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("dry run: %w", err)
+	}
+	if out == nil || out.Value == nil {
+		return solana.Signature{}, fmt.Errorf("dry run: empty simulation result")
+	}
+	if cl.dryRunHook != nil {
+		cl.dryRunHook(signature, out.Value)
+	}
+	if out.Value.Err != nil {
+		return signature, &DryRunError{
+			Signature: signature,
+			Result:    out.Value,
+		}
+	}
+	return signature, nil
+}
+
+// firstSignature returns the first signature of a transaction in wire format.
+func firstSignature(rawTx []byte) (solana.Signature, error) {
+	numSignatures, size, err := bin.DecodeCompactU16(rawTx)
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("decode signatures: %w", err)
+	}
+	if numSignatures == 0 || len(rawTx) < size+64 {
+		return solana.Signature{}, fmt.Errorf("transaction has no signatures")
+	}
+	return solana.SignatureFromBytes(rawTx[size : size+64]), nil
+}
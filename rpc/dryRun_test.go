@@ -0,0 +1,90 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+// simulatingNode is a JSONRPCClient that only supports simulateTransaction.
+type simulatingNode struct {
+	methods []string
+	params  [][]interface{}
+	result  string
+}
+
+func (node *simulatingNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	node.methods = append(node.methods, method)
+	node.params = append(node.params, params)
+	if method != "simulateTransaction" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	return stdjson.Unmarshal([]byte(node.result), out)
+}
+
+func (node *simulatingNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestClient_DryRun(t *testing.T) {
+	node := &simulatingNode{
+		result: `{"context":{"slot":10},"value":{"err":null,"logs":["Program log: ok"]}}`,
+	}
+	client := NewWithCustomRPCClient(node)
+	require.False(t, client.IsDryRun())
+
+	var simulated []*SimulateTransactionResult
+	client.SetDryRun(true).SetDryRunHook(func(signature solana.Signature, result *SimulateTransactionResult) {
+		simulated = append(simulated, result)
+	})
+	require.True(t, client.IsDryRun())
+
+	payer := solana.NewWallet().PrivateKey
+	tx := &solana.Transaction{
+		Message: solana.Message{
+			Header:      solana.MessageHeader{NumRequiredSignatures: 1, NumReadonlyUnsignedAccounts: 1},
+			AccountKeys: []solana.PublicKey{payer.PublicKey(), solana.SystemProgramID},
+			Instructions: []solana.CompiledInstruction{
+				{ProgramIDIndex: 1},
+			},
+		},
+	}
+	_, err := tx.SignWithSigners(payer)
+	require.NoError(t, err)
+
+	sig, err := client.SendTransaction(context.Background(), tx)
+	require.NoError(t, err)
+	require.Equal(t, tx.Signatures[0], sig)
+	require.Equal(t, []string{"simulateTransaction"}, node.methods)
+	require.Equal(t, M{"encoding": solana.EncodingBase64}, node.params[0][1])
+	require.Len(t, simulated, 1)
+	require.Equal(t, []string{"Program log: ok"}, simulated[0].Logs)
+
+	node.result = `{"context":{"slot":10},"value":{"err":{"InstructionError":[0,"InvalidArgument"]}}}`
+	sig, err = client.SendTransactionWithOpts(context.Background(), tx, TransactionOpts{PreflightCommitment: CommitmentConfirmed})
+	var dryRunErr *DryRunError
+	require.True(t, errors.As(err, &dryRunErr))
+	require.Equal(t, tx.Signatures[0], sig)
+	require.Equal(t, tx.Signatures[0], dryRunErr.Signature)
+	require.Equal(t, M{"encoding": solana.EncodingBase64, "commitment": CommitmentConfirmed}, node.params[1][1])
+}
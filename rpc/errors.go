@@ -23,3 +23,185 @@ package rpc
 
 // instruction error
 // - https://github.com/solana-labs/solana/blob/f6371cce176d481b4132e5061262ca015db0f8b1/sdk/program/src/instruction.rs
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// Codes of the JSON-RPC errors specific to solana nodes.
+const (
+	ErrorCodeBlockCleanedUp                           = -32001
+	ErrorCodeSendTransactionPreflightFailure          = -32002
+	ErrorCodeTransactionSignatureVerificationFailure  = -32003
+	ErrorCodeBlockNotAvailable                        = -32004
+	ErrorCodeNodeUnhealthy                            = -32005
+	ErrorCodeTransactionPrecompileVerificationFailure = -32006
+	ErrorCodeSlotSkipped                              = -32007
+	ErrorCodeNoSnapshot                               = -32008
+	ErrorCodeLongTermStorageSlotSkipped               = -32009
+	ErrorCodeKeyExcludedFromSecondaryIndex            = -32010
+	ErrorCodeTransactionHistoryNotAvailable           = -32011
+	ErrorCodeScanError                                = -32012
+	ErrorCodeTransactionSignatureLenMismatch          = -32013
+	ErrorCodeBlockStatusNotAvailableYet               = -32014
+	ErrorCodeUnsupportedTransactionVersion            = -32015
+	ErrorCodeMinContextSlotNotReached                 = -32016
+)
+
+// ErrorCode returns the code of the JSON-RPC error in the chain of err.
+func ErrorCode(err error) (code int, ok bool) {
+	var rpcErr *jsonrpc.RPCError
+	if !errors.As(err, &rpcErr) {
+		return 0, false
+	}
+	return rpcErr.Code, true
+}
+
+// IsErrorCode returns true if err is (or wraps) a JSON-RPC error with the code.
+func IsErrorCode(err error, code int) bool {
+	c, ok := ErrorCode(err)
+	return ok && c == code
+}
+
+// TransactionError is the error of a transaction, as returned in the "err"
+// field of transaction statuses and simulations, e.g.
+// "AccountNotFound" or {"InstructionError":[0,{"Custom":1}]}.
+type TransactionError struct {
+	// The variant of the error, e.g. "InstructionError".
+	Kind string
+
+	// The error of the instruction, if Kind is "InstructionError".
+	InstructionError *InstructionError
+
+	// The value of the variant, if any (e.g. {"account_index": 2}
+	// for InsufficientFundsForRent).
+	Value interface{}
+}
+
+func (e *TransactionError) Error() string {
+	if e.InstructionError != nil {
+		return e.InstructionError.Error()
+	}
+	if e.Value != nil {
+		return fmt.Sprintf("%s: %v", e.Kind, e.Value)
+	}
+	return e.Kind
+}
+
+func (e *TransactionError) Unwrap() error {
+	if e.InstructionError == nil {
+		return nil
+	}
+	return e.InstructionError
+}
+
+func (e *TransactionError) UnmarshalJSON(data []byte) error {
+	kind, value, err := decodeEnum(data)
+	if err != nil {
+		return fmt.Errorf("invalid transaction error: %w", err)
+	}
+	*e = TransactionError{Kind: kind}
+	if value == nil {
+		return nil
+	}
+	if kind != "InstructionError" {
+		return json.Unmarshal(value, &e.Value)
+	}
+	var pair []stdjson.RawMessage
+	if err := json.Unmarshal(value, &pair); err != nil || len(pair) != 2 {
+		return fmt.Errorf("invalid instruction error: %s", value)
+	}
+	e.InstructionError = new(InstructionError)
+	if err := json.Unmarshal(pair[0], &e.InstructionError.Index); err != nil {
+		return fmt.Errorf("invalid instruction index: %w", err)
+	}
+	return e.InstructionError.unmarshalKind(pair[1])
+}
+
+// InstructionError is the error of an instruction of a transaction.
+type InstructionError struct {
+	// The index of the failed instruction in the transaction.
+	Index int
+
+	// The variant of the error, e.g. "Custom" or "InvalidAccountData".
+	Kind string
+
+	// The error code of the program, if Kind is "Custom".
+	Custom *uint32
+
+	// The message of the error, if Kind is "BorshIoError".
+	Message string
+}
+
+func (e *InstructionError) Error() string {
+	switch {
+	case e.Custom != nil:
+		return fmt.Sprintf("Error processing Instruction %d: custom program error: 0x%x", e.Index, *e.Custom)
+	case e.Message != "":
+		return fmt.Sprintf("Error processing Instruction %d: %s: %s", e.Index, e.Kind, e.Message)
+	default:
+		return fmt.Sprintf("Error processing Instruction %d: %s", e.Index, e.Kind)
+	}
+}
+
+func (e *InstructionError) unmarshalKind(data []byte) error {
+	kind, value, err := decodeEnum(data)
+	if err != nil {
+		return fmt.Errorf("invalid instruction error: %w", err)
+	}
+	e.Kind = kind
+	if value == nil {
+		return nil
+	}
+	switch kind {
+	case "Custom":
+		e.Custom = new(uint32)
+		return json.Unmarshal(value, e.Custom)
+	default:
+		var msg string
+		if json.Unmarshal(value, &msg) == nil {
+			e.Message = msg
+		}
+		return nil
+	}
+}
+
+// decodeEnum decodes a serialized rust enum, that is either
+// a string (unit variant) or an object with a single key.
+func decodeEnum(data []byte) (kind string, value stdjson.RawMessage, err error) {
+	if err := json.Unmarshal(data, &kind); err == nil {
+		return kind, nil, nil
+	}
+	var obj map[string]stdjson.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return "", nil, err
+	}
+	if len(obj) != 1 {
+		return "", nil, fmt.Errorf("expected a single variant, got %s", data)
+	}
+	for kind, value = range obj {
+		break
+	}
+	return kind, value, nil
+}
+
+// ParseTransactionError parses the "err" field of a transaction status
+// or simulation; it returns nil if the transaction succeeded.
+func ParseTransactionError(v interface{}) (*TransactionError, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	out := new(TransactionError)
+	if err := json.Unmarshal(data, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
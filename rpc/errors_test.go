@@ -0,0 +1,83 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransactionError(t *testing.T) {
+	parse := func(s string) *TransactionError {
+		var v interface{}
+		require.NoError(t, stdjson.Unmarshal([]byte(s), &v))
+		out, err := ParseTransactionError(v)
+		require.NoError(t, err)
+		return out
+	}
+
+	out, err := ParseTransactionError(nil)
+	require.NoError(t, err)
+	require.Nil(t, out)
+
+	txErr := parse(`"AccountNotFound"`)
+	require.Equal(t, "AccountNotFound", txErr.Kind)
+	require.Nil(t, txErr.InstructionError)
+	require.Equal(t, "AccountNotFound", txErr.Error())
+
+	txErr = parse(`{"InstructionError":[2,{"Custom":6001}]}`)
+	require.Equal(t, "InstructionError", txErr.Kind)
+	require.Equal(t, 2, txErr.InstructionError.Index)
+	require.Equal(t, "Custom", txErr.InstructionError.Kind)
+	require.Equal(t, uint32(6001), *txErr.InstructionError.Custom)
+	require.Equal(t, "Error processing Instruction 2: custom program error: 0x1771", txErr.Error())
+
+	txErr = parse(`{"InstructionError":[0,"InvalidAccountData"]}`)
+	require.Equal(t, "InvalidAccountData", txErr.InstructionError.Kind)
+	require.Nil(t, txErr.InstructionError.Custom)
+
+	txErr = parse(`{"InstructionError":[1,{"BorshIoError":"Unexpected length of input"}]}`)
+	require.Equal(t, "BorshIoError", txErr.InstructionError.Kind)
+	require.Equal(t, "Unexpected length of input", txErr.InstructionError.Message)
+
+	txErr = parse(`{"InsufficientFundsForRent":{"account_index":2}}`)
+	require.Equal(t, "InsufficientFundsForRent", txErr.Kind)
+	require.Equal(t, map[string]interface{}{"account_index": float64(2)}, txErr.Value)
+
+	// The instruction error is in the chain.
+	var instrErr *InstructionError
+	require.True(t, errors.As(fmt.Errorf("send: %w", parse(`{"InstructionError":[0,{"Custom":1}]}`)), &instrErr))
+	require.Equal(t, uint32(1), *instrErr.Custom)
+
+	_, err = ParseTransactionError(map[string]interface{}{"A": 1, "B": 2})
+	require.Error(t, err)
+}
+
+func TestErrorCode(t *testing.T) {
+	err := fmt.Errorf("getBlock: %w", &jsonrpc.RPCError{Code: ErrorCodeSlotSkipped, Message: "Slot 5 was skipped"})
+	code, ok := ErrorCode(err)
+	require.True(t, ok)
+	require.Equal(t, ErrorCodeSlotSkipped, code)
+	require.True(t, IsErrorCode(err, ErrorCodeSlotSkipped))
+	require.False(t, IsErrorCode(err, ErrorCodeBlockNotAvailable))
+
+	_, ok = ErrorCode(errors.New("connection refused"))
+	require.False(t, ok)
+}
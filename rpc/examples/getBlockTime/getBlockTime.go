@@ -26,7 +26,7 @@ func main() {
 	endpoint := rpc.TestNet_RPC
 	client := rpc.New(endpoint)
 
-	example, err := client.GetRecentBlockhash(
+	example, err := client.GetLatestBlockhash(
 		context.TODO(),
 		rpc.CommitmentFinalized,
 	)
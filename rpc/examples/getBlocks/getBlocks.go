@@ -25,7 +25,7 @@ func main() {
 	endpoint := rpc.TestNet_RPC
 	client := rpc.New(endpoint)
 
-	example, err := client.GetRecentBlockhash(
+	example, err := client.GetLatestBlockhash(
 		context.TODO(),
 		rpc.CommitmentFinalized,
 	)
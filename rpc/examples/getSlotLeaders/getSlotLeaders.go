@@ -25,7 +25,7 @@ func main() {
 	endpoint := rpc.TestNet_RPC
 	client := rpc.New(endpoint)
 
-	recent, err := client.GetRecentBlockhash(
+	recent, err := client.GetLatestBlockhash(
 		context.TODO(),
 		rpc.CommitmentFinalized,
 	)
@@ -0,0 +1,133 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package helius provides the Helius-specific RPC methods,
+// as an extension of the rpc.Client (see rpc.Client.Extension).
+package helius
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// Name is the name of the extension.
+const Name = "helius"
+
+// Namespace holds the Helius-specific RPC methods.
+type Namespace struct {
+	client *rpc.Client
+}
+
+// Ext returns the Helius namespace of the client.
+func Ext(client *rpc.Client) *Namespace {
+	return client.Extension(Name, func(cl *rpc.Client) interface{} {
+		return &Namespace{client: cl}
+	}).(*Namespace)
+}
+
+type PriorityLevel string
+
+const (
+	PriorityMin       PriorityLevel = "Min"
+	PriorityLow       PriorityLevel = "Low"
+	PriorityMedium    PriorityLevel = "Medium"
+	PriorityHigh      PriorityLevel = "High"
+	PriorityVeryHigh  PriorityLevel = "VeryHigh"
+	PriorityUnsafeMax PriorityLevel = "UnsafeMax"
+)
+
+// PriorityFeeEstimateRequest is the request of GetPriorityFeeEstimate;
+// either the transaction or the account keys must be provided.
+type PriorityFeeEstimateRequest struct {
+	Transaction *solana.Transaction
+	AccountKeys []solana.PublicKey
+
+	PriorityLevel               PriorityLevel
+	IncludeAllPriorityFeeLevels bool
+	LookbackSlots               *uint64
+	Recommended                 bool
+}
+
+// PriorityFeeLevels are the estimates of each priority level,
+// in micro-lamports per compute unit.
+type PriorityFeeLevels struct {
+	Min       float64 `json:"min"`
+	Low       float64 `json:"low"`
+	Medium    float64 `json:"medium"`
+	High      float64 `json:"high"`
+	VeryHigh  float64 `json:"veryHigh"`
+	UnsafeMax float64 `json:"unsafeMax"`
+}
+
+type PriorityFeeEstimateResult struct {
+	// The estimate of the requested priority level,
+	// in micro-lamports per compute unit.
+	PriorityFeeEstimate float64 `json:"priorityFeeEstimate"`
+
+	// Set if IncludeAllPriorityFeeLevels is true.
+	PriorityFeeLevels *PriorityFeeLevels `json:"priorityFeeLevels,omitempty"`
+}
+
+// GetPriorityFeeEstimate returns the priority fee estimate of a transaction,
+// or of a set of accounts.
+func (ns *Namespace) GetPriorityFeeEstimate(
+	ctx context.Context,
+	req *PriorityFeeEstimateRequest,
+) (out *PriorityFeeEstimateResult, err error) {
+	obj := rpc.M{}
+	switch {
+	case req.Transaction != nil:
+		encoded, err := req.Transaction.ToBase64()
+		if err != nil {
+			return nil, err
+		}
+		obj["transaction"] = encoded
+	case len(req.AccountKeys) > 0:
+		obj["accountKeys"] = req.AccountKeys
+	default:
+		return nil, errors.New("either the transaction or the account keys are required")
+	}
+
+	options := rpc.M{}
+	if req.Transaction != nil {
+		options["transactionEncoding"] = solana.EncodingBase64
+	}
+	if req.PriorityLevel != "" {
+		options["priorityLevel"] = req.PriorityLevel
+	}
+	if req.IncludeAllPriorityFeeLevels {
+		options["includeAllPriorityFeeLevels"] = true
+	}
+	if req.LookbackSlots != nil {
+		options["lookbackSlots"] = *req.LookbackSlots
+	}
+	if req.Recommended {
+		options["recommended"] = true
+	}
+	if len(options) > 0 {
+		obj["options"] = options
+	}
+
+	err = ns.client.RPCCallForInto(ctx, &out, "getPriorityFeeEstimate", []interface{}{obj})
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, errors.New("expected a value, got null result")
+	}
+	return out, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helius
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriorityFeeEstimate(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &request))
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"priorityFeeEstimate":1200.5,"priorityFeeLevels":{"min":0,"low":10,"medium":100,"high":1200.5,"veryHigh":5000,"unsafeMax":90000}}}`))
+	}))
+	defer server.Close()
+	client := rpc.New(server.URL)
+	require.True(t, Ext(client) == Ext(client))
+
+	account := solana.PublicKey{1}
+	out, err := Ext(client).GetPriorityFeeEstimate(context.Background(), &PriorityFeeEstimateRequest{
+		AccountKeys:                 []solana.PublicKey{account},
+		PriorityLevel:               PriorityHigh,
+		IncludeAllPriorityFeeLevels: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1200.5, out.PriorityFeeEstimate)
+	require.Equal(t, 5000.0, out.PriorityFeeLevels.VeryHigh)
+
+	require.Equal(t, "getPriorityFeeEstimate", request["method"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"accountKeys": []interface{}{account.String()},
+			"options": map[string]interface{}{
+				"priorityLevel":               "High",
+				"includeAllPriorityFeeLevels": true,
+			},
+		},
+	}, request["params"])
+
+	_, err = Ext(client).GetPriorityFeeEstimate(context.Background(), &PriorityFeeEstimateRequest{})
+	require.EqualError(t, err, "either the transaction or the account keys are required")
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package triton provides the Triton-specific RPC methods,
+// as an extension of the rpc.Client (see rpc.Client.Extension).
+package triton
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// Name is the name of the extension.
+const Name = "triton"
+
+// Namespace holds the Triton-specific RPC methods.
+type Namespace struct {
+	client *rpc.Client
+}
+
+// Ext returns the Triton namespace of the client.
+func Ext(client *rpc.Client) *Namespace {
+	return client.Extension(Name, func(cl *rpc.Client) interface{} {
+		return &Namespace{client: cl}
+	}).(*Namespace)
+}
+
+type PrioritizationFee struct {
+	Slot uint64 `json:"slot"`
+
+	// The prioritization fee, in micro-lamports per compute unit.
+	PrioritizationFee uint64 `json:"prioritizationFee"`
+}
+
+// GetRecentPrioritizationFees is the Triton variant of getRecentPrioritizationFees,
+// which returns, for each recent slot, the provided percentile (in basis points,
+// from 0 to 10000; e.g. 5000 is the median) of the prioritization fees of the
+// transactions that lock the provided accounts, instead of the minimum.
+func (ns *Namespace) GetRecentPrioritizationFees(
+	ctx context.Context,
+	accounts []solana.PublicKey,
+	percentile uint16,
+) (out []PrioritizationFee, err error) {
+	if percentile > 10000 {
+		return nil, fmt.Errorf("invalid percentile: %d", percentile)
+	}
+	if accounts == nil {
+		accounts = []solana.PublicKey{}
+	}
+	params := []interface{}{
+		accounts,
+		rpc.M{"percentile": percentile},
+	}
+	err = ns.client.RPCCallForInto(ctx, &out, "getRecentPrioritizationFees", params)
+	return
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triton
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRecentPrioritizationFees(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &request))
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":[{"slot":100,"prioritizationFee":0},{"slot":101,"prioritizationFee":2500}]}`))
+	}))
+	defer server.Close()
+	client := rpc.New(server.URL)
+
+	account := solana.PublicKey{1}
+	out, err := Ext(client).GetRecentPrioritizationFees(context.Background(), []solana.PublicKey{account}, 5000)
+	require.NoError(t, err)
+	require.Equal(t, []PrioritizationFee{{Slot: 100}, {Slot: 101, PrioritizationFee: 2500}}, out)
+	require.Equal(t, "getRecentPrioritizationFees", request["method"])
+	require.Equal(t, []interface{}{
+		[]interface{}{account.String()},
+		map[string]interface{}{"percentile": 5000.0},
+	}, request["params"])
+
+	_, err = Ext(client).GetRecentPrioritizationFees(context.Background(), nil, 10001)
+	require.EqualError(t, err, "invalid percentile: 10001")
+}
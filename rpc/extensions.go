@@ -0,0 +1,60 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+// ExtensionFactory creates the namespace of a provider-specific extension
+// (e.g. the custom RPC methods of a provider) for the provided client.
+type ExtensionFactory func(cl *Client) interface{}
+
+// Extension returns the namespace registered with the provided name,
+// creating it with the factory on first use; the namespace is
+// created once per client.
+//
+// Extensions live in optional subpackages (see rpc/ext), which expose a typed
+// accessor, so that the provider-specific methods don't pollute the core API:
+//
+//	fees, err := helius.Ext(client).GetPriorityFeeEstimate(ctx, req)
+func (cl *Client) Extension(name string, factory ExtensionFactory) interface{} {
+	cl.extMu.Lock()
+	defer cl.extMu.Unlock()
+	if ext, ok := cl.extensions[name]; ok {
+		return ext
+	}
+	if cl.extensions == nil {
+		cl.extensions = make(map[string]interface{})
+	}
+	ext := factory(cl)
+	cl.extensions[name] = ext
+	return ext
+}
+
+// RegisterExtension registers (or replaces) the namespace with the provided name,
+// e.g. to configure an extension before its first use.
+func (cl *Client) RegisterExtension(name string, ext interface{}) {
+	cl.extMu.Lock()
+	defer cl.extMu.Unlock()
+	if cl.extensions == nil {
+		cl.extensions = make(map[string]interface{})
+	}
+	cl.extensions[name] = ext
+}
+
+// HasExtension returns true if a namespace with the provided name was registered.
+func (cl *Client) HasExtension(name string) bool {
+	cl.extMu.Lock()
+	defer cl.extMu.Unlock()
+	_, ok := cl.extensions[name]
+	return ok
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtension(t *testing.T) {
+	type namespace struct {
+		client *Client
+	}
+	client := New("http://localhost")
+	require.False(t, client.HasExtension("test"))
+
+	created := 0
+	factory := func(cl *Client) interface{} {
+		created++
+		return &namespace{client: cl}
+	}
+	first := client.Extension("test", factory).(*namespace)
+	require.Equal(t, client, first.client)
+	second := client.Extension("test", factory).(*namespace)
+	require.True(t, first == second)
+	require.Equal(t, 1, created)
+	require.True(t, client.HasExtension("test"))
+
+	// Each client has its own namespaces.
+	other := New("http://localhost")
+	require.False(t, other.Extension("test", factory).(*namespace) == first)
+
+	replaced := &namespace{}
+	client.RegisterExtension("test", replaced)
+	require.True(t, client.Extension("test", factory).(*namespace) == replaced)
+}
@@ -0,0 +1,329 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// FailoverOpts are the options of a FailoverClient.
+type FailoverOpts struct {
+	// Spread the requests over the healthy endpoints in turn,
+	// instead of always using the first healthy one.
+	LoadBalance bool
+
+	// Duration an endpoint is skipped after a failure.
+	// Defaults to 30 seconds.
+	Cooldown time.Duration
+
+	// Timeout of a request to an endpoint, after which the next endpoint is tried.
+	// Optional.
+	Timeout time.Duration
+
+	// Interval of the getHealth checks of the endpoints; an unhealthy endpoint
+	// is skipped until it is healthy again. Zero disables the checks.
+	HealthCheckInterval time.Duration
+}
+
+type failoverEndpoint struct {
+	client JSONRPCClient
+
+	mu        sync.Mutex
+	downUntil time.Time
+	unhealthy bool
+}
+
+func (ep *failoverEndpoint) available(now time.Time) bool {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	return !ep.unhealthy && !now.Before(ep.downUntil)
+}
+
+// FailoverClient is a JSONRPCClient sending the requests to the first available
+// of several endpoints: an endpoint is skipped for a while after it fails
+// (transport error, 5xx response, timeout, or node unhealthy error), and while it
+// reports being unhealthy. A rate limited request (429) is sent to the next
+// endpoint too, without skipping the current one afterwards.
+// If no endpoint is available, all of them are tried.
+type FailoverClient struct {
+	endpoints []*failoverEndpoint
+	opts      FailoverOpts
+	next      uint32
+	now       func() time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewWithFailover creates a new Solana JSON RPC client failing over
+// between the provided endpoints (see FailoverClient).
+func NewWithFailover(rpcEndpoints []string, opts *FailoverOpts) *Client {
+	clients := make([]JSONRPCClient, len(rpcEndpoints))
+	for i, endpoint := range rpcEndpoints {
+		clients[i] = jsonrpc.NewClientWithOpts(endpoint, &jsonrpc.RPCClientOpts{
+			HTTPClient: newHTTP(),
+		})
+	}
+	return NewWithCustomRPCClient(NewFailoverClient(clients, opts))
+}
+
+// NewFailoverClient creates a client failing over between the provided
+// clients, in order of priority. Close stops the health checks, and closes the clients.
+func NewFailoverClient(clients []JSONRPCClient, opts *FailoverOpts) *FailoverClient {
+	fc := &FailoverClient{
+		now:  time.Now,
+		stop: make(chan struct{}),
+	}
+	if opts != nil {
+		fc.opts = *opts
+	}
+	if fc.opts.Cooldown <= 0 {
+		fc.opts.Cooldown = 30 * time.Second
+	}
+	for _, client := range clients {
+		fc.endpoints = append(fc.endpoints, &failoverEndpoint{client: client})
+	}
+	if fc.opts.HealthCheckInterval > 0 {
+		fc.wg.Add(1)
+		go fc.checkHealthLoop()
+	}
+	return fc
+}
+
+// order returns the endpoints in the order they must be tried:
+// the available ones first, then the others.
+func (fc *FailoverClient) order() []*failoverEndpoint {
+	start := 0
+	if fc.opts.LoadBalance && len(fc.endpoints) > 0 {
+		start = int((atomic.AddUint32(&fc.next, 1) - 1) % uint32(len(fc.endpoints)))
+	}
+	now := fc.now()
+	available := make([]*failoverEndpoint, 0, len(fc.endpoints))
+	var others []*failoverEndpoint
+	for i := range fc.endpoints {
+		ep := fc.endpoints[(start+i)%len(fc.endpoints)]
+		if ep.available(now) {
+			available = append(available, ep)
+		} else {
+			others = append(others, ep)
+		}
+	}
+	return append(available, others...)
+}
+
+// do calls fn with each endpoint until it succeeds, or fails with an error
+// that another endpoint would not fix.
+func (fc *FailoverClient) do(ctx context.Context, fn func(ctx context.Context, client JSONRPCClient) error) error {
+	if len(fc.endpoints) == 0 {
+		return errors.New("no rpc endpoint")
+	}
+	var err error
+	for _, ep := range fc.order() {
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if fc.opts.Timeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, fc.opts.Timeout)
+		}
+		err = fn(attemptCtx, ep.client)
+		cancel()
+		if errors.Is(err, ErrBatchNotSupported) || isRateLimitedError(err) {
+			// Try the next endpoint, without marking this one down.
+			continue
+		}
+		if err == nil || ctx.Err() != nil || !isFailoverError(err) {
+			return err
+		}
+		ep.mu.Lock()
+		ep.downUntil = fc.now().Add(fc.opts.Cooldown)
+		ep.mu.Unlock()
+	}
+	return err
+}
+
+// errCallbackFailed wraps the errors returned after the callback of CallWithCallback
+// was called: the response was (at least partially) processed, so it is not retried.
+type errCallbackFailed struct{ err error }
+
+func (e *errCallbackFailed) Error() string { return e.err.Error() }
+
+// isFailoverError returns true if the error comes from the endpoint:
+// a transport error (e.g. unreachable or timed out), a 5xx response
+// or an unhealthy node. The other errors, like those decoding the result
+// into the type of the caller, come from the request.
+func isFailoverError(err error) bool {
+	var cbErr *errCallbackFailed
+	if errors.As(err, &cbErr) {
+		return false
+	}
+	var rpcErr *jsonrpc.RPCError
+	if errors.As(err, &rpcErr) {
+		// Node is unhealthy (e.g. behind).
+		return rpcErr.Code == ErrorCodeNodeUnhealthy
+	}
+	var httpErr *jsonrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code >= 500
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// isRateLimitedError returns true if the endpoint rate limited the request:
+// another endpoint can handle it, but this one is not failing.
+func isRateLimitedError(err error) bool {
+	var httpErr *jsonrpc.HTTPError
+	return errors.As(err, &httpErr) && httpErr.Code == http.StatusTooManyRequests
+}
+
+func (fc *FailoverClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	return fc.do(ctx, func(ctx context.Context, client JSONRPCClient) error {
+		return client.CallForInto(ctx, out, method, params)
+	})
+}
+
+func (fc *FailoverClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	return unwrapCallbackError(fc.do(ctx, func(ctx context.Context, client JSONRPCClient) error {
+		return callWithEndpointErrors(ctx, client, method, params, callback)
+	}))
+}
+
+// callWithEndpointErrors calls CallWithCallback, returning the 429 and 5xx
+// responses as HTTP errors instead of passing them to the callback (nothing
+// was processed yet, so another endpoint can handle the request), and
+// wrapping the errors returned once the callback was called in errCallbackFailed.
+func callWithEndpointErrors(
+	ctx context.Context,
+	client JSONRPCClient,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	called := false
+	err := client.CallWithCallback(ctx, method, params, func(req *http.Request, resp *http.Response) error {
+		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+			return jsonrpc.NewHTTPError(resp.StatusCode, fmt.Errorf("rpc call %s() on %s: status code %d", method, req.URL, resp.StatusCode))
+		}
+		called = true
+		return callback(req, resp)
+	})
+	if err != nil && called {
+		return &errCallbackFailed{err: err}
+	}
+	return err
+}
+
+func unwrapCallbackError(err error) error {
+	if cbErr, ok := err.(*errCallbackFailed); ok {
+		return cbErr.err
+	}
+	return err
+}
+
+// CallBatch sends the batch to the first available endpoint supporting batches.
+func (fc *FailoverClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (out jsonrpc.RPCResponses, err error) {
+	err = fc.do(ctx, func(ctx context.Context, client JSONRPCClient) error {
+		batchClient, ok := client.(BatchJSONRPCClient)
+		if !ok {
+			return fmt.Errorf("%w: %T", ErrBatchNotSupported, client)
+		}
+		out, err = batchClient.CallBatch(ctx, requests)
+		return err
+	})
+	return out, err
+}
+
+func (fc *FailoverClient) checkHealthLoop() {
+	defer fc.wg.Done()
+	ticker := time.NewTicker(fc.opts.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		fc.CheckHealth(context.Background())
+		select {
+		case <-fc.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckHealth checks the health of all the endpoints with getHealth.
+// It is called periodically if FailoverOpts.HealthCheckInterval is set.
+func (fc *FailoverClient) CheckHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, ep := range fc.endpoints {
+		wg.Add(1)
+		go func(ep *failoverEndpoint) {
+			defer wg.Done()
+			checkCtx, cancel := ctx, context.CancelFunc(func() {})
+			if fc.opts.Timeout > 0 {
+				checkCtx, cancel = context.WithTimeout(ctx, fc.opts.Timeout)
+			}
+			defer cancel()
+			var health string
+			err := ep.client.CallForInto(checkCtx, &health, "getHealth", nil)
+			ep.mu.Lock()
+			ep.unhealthy = err != nil || health != HealthOk
+			ep.mu.Unlock()
+		}(ep)
+	}
+	wg.Wait()
+}
+
+// Healthy returns the number of endpoints currently available.
+func (fc *FailoverClient) Healthy() int {
+	now := fc.now()
+	count := 0
+	for _, ep := range fc.endpoints {
+		if ep.available(now) {
+			count++
+		}
+	}
+	return count
+}
+
+// Close stops the health checks, and closes the clients.
+func (fc *FailoverClient) Close() error {
+	fc.stopOnce.Do(func() { close(fc.stop) })
+	fc.wg.Wait()
+	var err error
+	for _, ep := range fc.endpoints {
+		if c, ok := ep.client.(io.Closer); ok {
+			if closeErr := c.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,217 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+type failoverTestServer struct {
+	*httptest.Server
+	calls int32
+}
+
+func newFailoverTestServer(handle func(rw http.ResponseWriter, req *http.Request)) *failoverTestServer {
+	s := &failoverTestServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&s.calls, 1)
+		handle(rw, req)
+	}))
+	return s
+}
+
+func (s *failoverTestServer) Calls() int {
+	return int(atomic.LoadInt32(&s.calls))
+}
+
+func failoverOK(rw http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	if bytes.Contains(body, []byte(`"getHealth"`)) {
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":"ok","id":0}`))
+		return
+	}
+	rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+}
+
+func TestFailoverClient(t *testing.T) {
+	down := newFailoverTestServer(func(rw http.ResponseWriter, req *http.Request) { rw.WriteHeader(http.StatusBadGateway) })
+	defer down.Close()
+	unhealthy := newFailoverTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32005,"message":"Node is behind by 42 slots"},"id":0}`))
+	})
+	defer unhealthy.Close()
+	up := newFailoverTestServer(failoverOK)
+	defer up.Close()
+
+	client := NewWithFailover([]string{down.URL, unhealthy.URL, up.URL}, nil)
+	defer client.Close()
+
+	slot, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), slot)
+	require.Equal(t, []int{1, 1, 1}, []int{down.Calls(), unhealthy.Calls(), up.Calls()})
+
+	// The failed endpoints are skipped during the cooldown.
+	_, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 1, 2}, []int{down.Calls(), unhealthy.Calls(), up.Calls()})
+}
+
+func TestFailoverClient_RequestErrors(t *testing.T) {
+	invalid := newFailoverTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":0}`))
+	})
+	defer invalid.Close()
+	up := newFailoverTestServer(failoverOK)
+	defer up.Close()
+
+	client := NewWithFailover([]string{invalid.URL, up.URL}, nil)
+	defer client.Close()
+
+	// An error in the request is not retried on the other endpoints.
+	_, err := client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Equal(t, []int{1, 0}, []int{invalid.Calls(), up.Calls()})
+}
+
+func TestFailoverClient_DecodeErrors(t *testing.T) {
+	up := newFailoverTestServer(failoverOK)
+	defer up.Close()
+	other := newFailoverTestServer(failoverOK)
+	defer other.Close()
+
+	client := NewWithFailover([]string{up.URL, other.URL}, nil)
+	defer client.Close()
+
+	// The result does not fit the type of the caller:
+	// not retried, and the endpoint is not marked down.
+	var out string
+	err := client.RPCCallForInto(context.Background(), &out, "getSlot", nil)
+	require.Error(t, err)
+	require.Equal(t, []int{1, 0}, []int{up.Calls(), other.Calls()})
+
+	_, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 0}, []int{up.Calls(), other.Calls()})
+}
+
+func TestFailoverClient_RateLimited(t *testing.T) {
+	limited := newFailoverTestServer(func(rw http.ResponseWriter, req *http.Request) { rw.WriteHeader(http.StatusTooManyRequests) })
+	defer limited.Close()
+	up := newFailoverTestServer(failoverOK)
+	defer up.Close()
+
+	client := NewWithFailover([]string{limited.URL, up.URL}, nil)
+	defer client.Close()
+
+	// Handled by the next endpoint, without marking the rate limited one down.
+	for i := 0; i < 2; i++ {
+		_, err := client.GetSlot(context.Background(), "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int{2, 2}, []int{limited.Calls(), up.Calls()})
+}
+
+func TestFailoverClient_AllDown(t *testing.T) {
+	var fail int32 = 1
+	flaky := newFailoverTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.LoadInt32(&fail) == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		failoverOK(rw, req)
+	})
+	defer flaky.Close()
+
+	client := NewWithFailover([]string{flaky.URL}, nil)
+	defer client.Close()
+
+	_, err := client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+
+	// Endpoints in cooldown are still tried when no other is available.
+	atomic.StoreInt32(&fail, 0)
+	_, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, 2, flaky.Calls())
+}
+
+func TestFailoverClient_Timeout(t *testing.T) {
+	slow := newFailoverTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		failoverOK(rw, req)
+	})
+	defer slow.Close()
+	up := newFailoverTestServer(failoverOK)
+	defer up.Close()
+
+	client := NewWithFailover([]string{slow.URL, up.URL}, &FailoverOpts{Timeout: 20 * time.Millisecond})
+	defer client.Close()
+
+	_, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, 1, up.Calls())
+}
+
+func TestFailoverClient_LoadBalance(t *testing.T) {
+	a := newFailoverTestServer(failoverOK)
+	defer a.Close()
+	b := newFailoverTestServer(failoverOK)
+	defer b.Close()
+
+	client := NewWithFailover([]string{a.URL, b.URL}, &FailoverOpts{LoadBalance: true})
+	defer client.Close()
+
+	for i := 0; i < 4; i++ {
+		_, err := client.GetSlot(context.Background(), "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int{2, 2}, []int{a.Calls(), b.Calls()})
+}
+
+func TestFailoverClient_CheckHealth(t *testing.T) {
+	behind := newFailoverTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32005,"message":"Node is behind by 42 slots"},"id":0}`))
+	})
+	defer behind.Close()
+	up := newFailoverTestServer(failoverOK)
+	defer up.Close()
+
+	fc := NewFailoverClient([]JSONRPCClient{
+		jsonrpc.NewClient(behind.URL),
+		jsonrpc.NewClient(up.URL),
+	}, nil)
+	defer fc.Close()
+	require.Equal(t, 2, fc.Healthy())
+
+	fc.CheckHealth(context.Background())
+	require.Equal(t, 1, fc.Healthy())
+	require.Equal(t, 1, behind.Calls())
+
+	client := NewWithCustomRPCClient(fc)
+	_, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, 1, behind.Calls())
+}
@@ -0,0 +1,173 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/mr-tron/base58"
+)
+
+// MaxProgramAccountsFilters is the maximum number of filters
+// accepted by getProgramAccounts.
+const MaxProgramAccountsFilters = 4
+
+// maxBase58MemcmpBytes is the maximum length of the data of a
+// base58-encoded memcmp filter.
+const maxBase58MemcmpBytes = 128
+
+// The layout of the accounts of the Token program
+// (the rpc package can't import programs/token).
+const (
+	tokenAccountSize        = 165
+	tokenAccountMintOffset  = 0
+	tokenAccountOwnerOffset = 32
+	mintSize                = 82
+)
+
+// FilterBuilder builds the filters of getProgramAccounts
+// (and of programSubscribe); the errors are reported by Build:
+//
+//	filters, err := rpc.NewFilterBuilder().
+//		TokenAccountsForMint(mint).
+//		MemcmpPublicKey(32, owner).
+//		Build()
+type FilterBuilder struct {
+	filters []RPCFilter
+	err     error
+}
+
+// NewFilterBuilder returns an empty FilterBuilder.
+func NewFilterBuilder() *FilterBuilder {
+	return &FilterBuilder{}
+}
+
+func (fb *FilterBuilder) fail(err error) *FilterBuilder {
+	if fb.err == nil {
+		fb.err = err
+	}
+	return fb
+}
+
+// DataSize selects the accounts whose data is exactly size bytes long.
+func (fb *FilterBuilder) DataSize(size uint64) *FilterBuilder {
+	if size == 0 {
+		return fb.fail(errors.New("dataSize filter: size must not be zero"))
+	}
+	for _, filter := range fb.filters {
+		if filter.DataSize != 0 && filter.DataSize != size {
+			return fb.fail(fmt.Errorf("dataSize filter: conflicting sizes %d and %d", filter.DataSize, size))
+		}
+	}
+	fb.filters = append(fb.filters, RPCFilter{DataSize: size})
+	return fb
+}
+
+// Memcmp selects the accounts whose data contains the bytes at offset;
+// the bytes are sent base58-encoded, or base64-encoded if they are
+// too long for base58.
+func (fb *FilterBuilder) Memcmp(offset uint64, data []byte) *FilterBuilder {
+	if len(data) == 0 {
+		return fb.fail(fmt.Errorf("memcmp filter at offset %d: no bytes", offset))
+	}
+	memcmp := &RPCFilterMemcmp{
+		Offset: offset,
+		Bytes:  append(solana.Base58(nil), data...),
+	}
+	if len(data) > maxBase58MemcmpBytes {
+		memcmp.Encoding = solana.EncodingBase64
+	}
+	fb.filters = append(fb.filters, RPCFilter{Memcmp: memcmp})
+	return fb
+}
+
+// MemcmpBase58 is like Memcmp, with the bytes as a base58 string.
+func (fb *FilterBuilder) MemcmpBase58(offset uint64, data string) *FilterBuilder {
+	decoded, err := base58.Decode(data)
+	if err != nil {
+		return fb.fail(fmt.Errorf("memcmp filter at offset %d: invalid base58: %w", offset, err))
+	}
+	return fb.Memcmp(offset, decoded)
+}
+
+// MemcmpBase64 is like Memcmp, with the bytes as a base64 string.
+func (fb *FilterBuilder) MemcmpBase64(offset uint64, data string) *FilterBuilder {
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return fb.fail(fmt.Errorf("memcmp filter at offset %d: invalid base64: %w", offset, err))
+	}
+	return fb.Memcmp(offset, decoded)
+}
+
+// MemcmpPublicKey selects the accounts whose data contains the public key at offset.
+func (fb *FilterBuilder) MemcmpPublicKey(offset uint64, key solana.PublicKey) *FilterBuilder {
+	return fb.Memcmp(offset, key[:])
+}
+
+// MemcmpUint8 selects the accounts whose data contains the byte at offset
+// (e.g. an account discriminator).
+func (fb *FilterBuilder) MemcmpUint8(offset uint64, v uint8) *FilterBuilder {
+	return fb.Memcmp(offset, []byte{v})
+}
+
+// MemcmpUint64 selects the accounts whose data contains
+// the little-endian encoded value at offset.
+func (fb *FilterBuilder) MemcmpUint64(offset uint64, v uint64) *FilterBuilder {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, v)
+	return fb.Memcmp(offset, data)
+}
+
+// TokenAccountsForMint selects the token accounts (of the Token program) of the mint.
+func (fb *FilterBuilder) TokenAccountsForMint(mint solana.PublicKey) *FilterBuilder {
+	return fb.DataSize(tokenAccountSize).MemcmpPublicKey(tokenAccountMintOffset, mint)
+}
+
+// TokenAccountsForOwner selects the token accounts (of the Token program) of the owner.
+func (fb *FilterBuilder) TokenAccountsForOwner(owner solana.PublicKey) *FilterBuilder {
+	return fb.DataSize(tokenAccountSize).MemcmpPublicKey(tokenAccountOwnerOffset, owner)
+}
+
+// Mints selects the mint accounts (of the Token program).
+func (fb *FilterBuilder) Mints() *FilterBuilder {
+	return fb.DataSize(mintSize)
+}
+
+// Build returns the filters, or the first error; the duplicate
+// dataSize filters are merged.
+func (fb *FilterBuilder) Build() ([]RPCFilter, error) {
+	if fb.err != nil {
+		return nil, fb.err
+	}
+	var out []RPCFilter
+	hasDataSize := false
+	for _, filter := range fb.filters {
+		if filter.DataSize != 0 {
+			if hasDataSize {
+				continue
+			}
+			hasDataSize = true
+		}
+		out = append(out, filter)
+	}
+	if len(out) > MaxProgramAccountsFilters {
+		return nil, fmt.Errorf("too many filters: %d (max %d)", len(out), MaxProgramAccountsFilters)
+	}
+	return out, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterBuilder(t *testing.T) {
+	mint := solana.MustPublicKeyFromBase58("So11111111111111111111111111111111111111112")
+	owner := solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932")
+
+	filters, err := NewFilterBuilder().
+		TokenAccountsForMint(mint).
+		TokenAccountsForOwner(owner).
+		Build()
+	require.NoError(t, err)
+	out, err := json.Marshal(filters)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"dataSize":165},
+		{"memcmp":{"offset":0,"bytes":"So11111111111111111111111111111111111111112"}},
+		{"memcmp":{"offset":32,"bytes":"7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932"}}
+	]`, string(out))
+
+	filters, err = NewFilterBuilder().
+		MemcmpBase64(8, "AQID").
+		MemcmpUint64(16, 1).
+		MemcmpBase58(24, "2").
+		Build()
+	require.NoError(t, err)
+	out, err = json.Marshal(filters)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"memcmp":{"offset":8,"bytes":"Ldp"}},
+		{"memcmp":{"offset":16,"bytes":"Ahg1opVcGX"}},
+		{"memcmp":{"offset":24,"bytes":"2"}}
+	]`, string(out))
+
+	// Long data is base64-encoded.
+	long := bytes.Repeat([]byte{1}, 129)
+	filters, err = NewFilterBuilder().Memcmp(0, long).Build()
+	require.NoError(t, err)
+	out, err = json.Marshal(filters)
+	require.NoError(t, err)
+	var decoded []RPCFilter
+	require.NoError(t, json.Unmarshal(out, &decoded))
+	require.Equal(t, solana.EncodingBase64, decoded[0].Memcmp.Encoding)
+	require.Equal(t, solana.Base58(long), decoded[0].Memcmp.Bytes)
+
+	for _, fb := range []*FilterBuilder{
+		NewFilterBuilder().MemcmpBase58(0, "0OIl"),
+		NewFilterBuilder().MemcmpBase64(0, "!!"),
+		NewFilterBuilder().Memcmp(0, nil),
+		NewFilterBuilder().DataSize(0),
+		NewFilterBuilder().Mints().TokenAccountsForMint(mint),
+		NewFilterBuilder().MemcmpUint8(0, 1).MemcmpUint8(1, 1).MemcmpUint8(2, 1).MemcmpUint8(3, 1).MemcmpUint8(4, 1),
+	} {
+		_, err := fb.Build()
+		require.Error(t, err)
+	}
+}
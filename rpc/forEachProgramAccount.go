@@ -0,0 +1,161 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// ForEachProgramAccount fetches the accounts owned by the provided program
+// like GetProgramAccountsWithOpts, but decodes them one at a time while the
+// response is read, and passes each of them to fn; the peak memory usage is
+// bounded by the size of one account instead of the whole result set
+// (e.g. when scanning the accounts of the Token program).
+// The iteration stops at the first error returned by fn
+// (ErrStopIteration stops it without an error).
+func (cl *Client) ForEachProgramAccount(
+	ctx context.Context,
+	publicKey solana.PublicKey,
+	opts *GetProgramAccountsOpts,
+	fn func(account *KeyedAccount) error,
+) error {
+	obj := M{}
+	if opts != nil {
+		obj = opts.ToMap()
+	}
+	if _, ok := obj["encoding"]; !ok {
+		obj["encoding"] = "base64"
+	}
+
+	params := []interface{}{publicKey, obj}
+
+	// Set when fn fails, to tell its errors apart from the decoding errors.
+	var fnErr error
+	callback := func(account *KeyedAccount) error {
+		fnErr = fn(account)
+		return fnErr
+	}
+	err := cl.rpcClient.CallWithCallback(ctx, "getProgramAccounts", params, func(req *http.Request, resp *http.Response) error {
+		err := streamResponse(stdjson.NewDecoder(resp.Body), func(dec *stdjson.Decoder) error {
+			return streamKeyedAccounts(dec, callback)
+		})
+		if err != nil && fnErr == nil && resp.StatusCode >= 400 {
+			if _, ok := err.(*jsonrpc.RPCError); !ok {
+				return jsonrpc.NewHTTPError(resp.StatusCode, fmt.Errorf("rpc call getProgramAccounts(): unable to decode response: %w", err))
+			}
+		}
+		return err
+	})
+	if fnErr != nil {
+		if errors.Is(fnErr, ErrStopIteration) {
+			return nil
+		}
+		return fnErr
+	}
+	return err
+}
+
+func streamKeyedAccounts(dec *stdjson.Decoder, fn func(account *KeyedAccount) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != stdjson.Delim('[') {
+		return fmt.Errorf("unexpected token %v, expected an array of accounts", tok)
+	}
+	for index := 0; dec.More(); index++ {
+		account := new(KeyedAccount)
+		if err := dec.Decode(account); err != nil {
+			return fmt.Errorf("unable to decode account %d: %w", index, err)
+		}
+		if err := fn(account); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+// ProgramAccountsStream delivers the accounts of a StreamProgramAccounts call.
+type ProgramAccountsStream struct {
+	accounts chan *KeyedAccount
+	cancel   context.CancelFunc
+	err      error
+}
+
+// StreamProgramAccounts is like ForEachProgramAccount, but delivers the
+// accounts through a channel, which buffers up to bufferSize accounts
+// while the response is read.
+// The stream must be drained or closed to release the request.
+func (cl *Client) StreamProgramAccounts(
+	ctx context.Context,
+	publicKey solana.PublicKey,
+	opts *GetProgramAccountsOpts,
+	bufferSize int,
+) *ProgramAccountsStream {
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	stream := &ProgramAccountsStream{
+		accounts: make(chan *KeyedAccount, bufferSize),
+		cancel:   cancel,
+	}
+	go func() {
+		defer close(stream.accounts)
+		// Written before the channel is closed,
+		// so it is visible to whoever sees the channel closed.
+		stream.err = cl.ForEachProgramAccount(ctx, publicKey, opts, func(account *KeyedAccount) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			select {
+			case stream.accounts <- account:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return stream
+}
+
+// Accounts returns the channel of the accounts;
+// it is closed at the end of the response, or when the stream fails.
+func (s *ProgramAccountsStream) Accounts() <-chan *KeyedAccount {
+	return s.accounts
+}
+
+// Err returns the error that ended the stream, if any;
+// it must be called after the Accounts channel is closed.
+func (s *ProgramAccountsStream) Err() error {
+	return s.err
+}
+
+// Close stops the stream and waits for it to end.
+func (s *ProgramAccountsStream) Close() {
+	s.cancel()
+	for range s.accounts {
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+const getProgramAccountsResponseBody = `[
+	{"account":{"data":["dGVzdA==","base64"],"executable":false,"lamports":2039280,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":206},"pubkey":"7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932"},
+	{"account":{"data":["","base64"],"executable":false,"lamports":1,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":206},"pubkey":"SysvarC1ock11111111111111111111111111111111"},
+	{"account":{"data":["AQID","base64"],"executable":false,"lamports":2,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":206},"pubkey":"SysvarRent111111111111111111111111111111111"}
+]`
+
+func TestClient_ForEachProgramAccount(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(getProgramAccountsResponseBody)))
+	defer closer()
+	client := New(server.URL)
+
+	expected, err := client.GetProgramAccounts(context.Background(), solana.TokenProgramID)
+	require.NoError(t, err)
+	require.Len(t, expected, 3)
+
+	var accounts GetProgramAccountsResult
+	err = client.ForEachProgramAccount(context.Background(), solana.TokenProgramID, nil, func(account *KeyedAccount) error {
+		accounts = append(accounts, account)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, accounts)
+	require.Equal(t,
+		map[string]interface{}{
+			"id":      float64(0),
+			"jsonrpc": "2.0",
+			"method":  "getProgramAccounts",
+			"params": []interface{}{
+				solana.TokenProgramID.String(),
+				map[string]interface{}{
+					"encoding": "base64",
+				},
+			},
+		},
+		server.RequestBody(t),
+	)
+
+	// Stop early.
+	count := 0
+	err = client.ForEachProgramAccount(context.Background(), solana.TokenProgramID, nil, func(account *KeyedAccount) error {
+		count++
+		if count == 2 {
+			return ErrStopIteration
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// Errors of the callback are returned as is.
+	failure := errors.New("failure")
+	err = client.ForEachProgramAccount(context.Background(), solana.TokenProgramID, nil, func(account *KeyedAccount) error {
+		return failure
+	})
+	require.Equal(t, failure, err)
+}
+
+func TestClient_ForEachProgramAccount_Errors(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","error":{"code":-32010,"message":"excluded from account secondary indexes"},"id":0}`))
+	defer closer()
+	err := New(server.URL).ForEachProgramAccount(context.Background(), solana.TokenProgramID, nil, func(*KeyedAccount) error {
+		return nil
+	})
+	var rpcErr *jsonrpc.RPCError
+	require.True(t, errors.As(err, &rpcErr))
+	require.Equal(t, -32010, rpcErr.Code)
+
+	server, closer = mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(`[{"pubkey":1}]`)))
+	defer closer()
+	err = New(server.URL).ForEachProgramAccount(context.Background(), solana.TokenProgramID, nil, func(*KeyedAccount) error {
+		return nil
+	})
+	require.Error(t, err)
+}
+
+func TestClient_StreamProgramAccounts(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(getProgramAccountsResponseBody)))
+	defer closer()
+	client := New(server.URL)
+
+	expected, err := client.GetProgramAccounts(context.Background(), solana.TokenProgramID)
+	require.NoError(t, err)
+
+	stream := client.StreamProgramAccounts(context.Background(), solana.TokenProgramID, nil, 1)
+	var accounts GetProgramAccountsResult
+	for account := range stream.Accounts() {
+		accounts = append(accounts, account)
+	}
+	require.NoError(t, stream.Err())
+	require.Equal(t, expected, accounts)
+
+	// Close before draining.
+	stream = client.StreamProgramAccounts(context.Background(), solana.TokenProgramID, nil, 0)
+	first := <-stream.Accounts()
+	require.Equal(t, expected[0], first)
+	stream.Close()
+	_, ok := <-stream.Accounts()
+	require.False(t, ok)
+	require.ErrorIs(t, stream.Err(), context.Canceled)
+}
@@ -0,0 +1,217 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// ErrStopIteration can be returned by the callbacks of ForEachTransactionInBlock
+// and ForEachProgramAccount to stop the iteration early without an error.
+var ErrStopIteration = errors.New("stop iteration")
+
+// ForEachTransactionInBlock fetches a block like GetBlockWithOpts, but decodes
+// its transactions one at a time while the response is read, and passes each of
+// them to fn; the whole block is never held in memory, which keeps the memory
+// usage of indexers low on large blocks.
+// The transaction passed to fn must not be retained after fn returns if the
+// memory savings matter; the iteration stops at the first error returned by fn
+// (ErrStopIteration stops it without an error).
+// The returned result has all the fields of the block except Transactions.
+func (cl *Client) ForEachTransactionInBlock(
+	ctx context.Context,
+	slot uint64,
+	opts *GetBlockOpts,
+	fn func(index int, tx *TransactionWithMeta) error,
+) (out *GetBlockResult, err error) {
+	obj := M{}
+	if opts != nil {
+		if err := opts.validate(); err != nil {
+			return nil, err
+		}
+		if opts.TransactionDetails != "" && opts.TransactionDetails != TransactionDetailsFull {
+			return nil, fmt.Errorf("ForEachTransactionInBlock requires %q transaction details", TransactionDetailsFull)
+		}
+		obj = opts.ToMap()
+	}
+	if _, ok := obj["encoding"]; !ok {
+		obj["encoding"] = solana.EncodingBase64
+	}
+	cl.applyMaxSupportedTransactionVersion(obj)
+
+	params := []interface{}{slot, obj}
+
+	// Set when fn fails, to tell its errors apart from the decoding errors.
+	var fnErr error
+	callback := func(index int, tx *TransactionWithMeta) error {
+		fnErr = fn(index, tx)
+		return fnErr
+	}
+	err = cl.rpcClient.CallWithCallback(ctx, "getBlock", params, func(req *http.Request, resp *http.Response) error {
+		out, err = streamBlock(stdjson.NewDecoder(resp.Body), callback)
+		if err != nil && fnErr == nil && resp.StatusCode >= 400 {
+			if _, ok := err.(*jsonrpc.RPCError); !ok {
+				return jsonrpc.NewHTTPError(resp.StatusCode, fmt.Errorf("rpc call getBlock(): unable to decode response: %w", err))
+			}
+		}
+		return err
+	})
+	if fnErr != nil {
+		if errors.Is(fnErr, ErrStopIteration) {
+			return out, nil
+		}
+		return out, fnErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		// Block is not confirmed.
+		return nil, ErrNotConfirmed
+	}
+	return out, nil
+}
+
+// streamBlock reads a getBlock JSON-RPC response, calling fn for each transaction.
+func streamBlock(dec *stdjson.Decoder, fn func(index int, tx *TransactionWithMeta) error) (out *GetBlockResult, err error) {
+	err = streamResponse(dec, func(dec *stdjson.Decoder) (err error) {
+		out, err = streamBlockResult(dec, fn)
+		return err
+	})
+	return out, err
+}
+
+// streamResponse reads a JSON-RPC response, returning its error
+// if any, and calling readResult to read the result.
+func streamResponse(dec *stdjson.Decoder, readResult func(dec *stdjson.Decoder) error) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "error":
+			var rpcErr *jsonrpc.RPCError
+			if err := dec.Decode(&rpcErr); err != nil {
+				return err
+			}
+			if rpcErr != nil {
+				return rpcErr
+			}
+		case "result":
+			if err := readResult(dec); err != nil {
+				return err
+			}
+		default:
+			var skip stdjson.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func streamBlockResult(dec *stdjson.Decoder, fn func(index int, tx *TransactionWithMeta) error) (*GetBlockResult, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return nil, nil
+	}
+	if tok != stdjson.Delim('{') {
+		return nil, fmt.Errorf("unexpected token %v, expected a block object", tok)
+	}
+	// All the fields but the transactions are small; they are
+	// collected and decoded at the end.
+	fields := make(map[string]stdjson.RawMessage)
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, _ := key.(string)
+		if name != "transactions" {
+			var raw stdjson.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, err
+			}
+			fields[name] = raw
+			continue
+		}
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if tok == nil {
+			continue
+		}
+		if tok != stdjson.Delim('[') {
+			return nil, fmt.Errorf("unexpected token %v, expected the transactions array", tok)
+		}
+		for index := 0; dec.More(); index++ {
+			tx := new(TransactionWithMeta)
+			if err := dec.Decode(tx); err != nil {
+				return nil, fmt.Errorf("unable to decode transaction %d: %w", index, err)
+			}
+			if err := fn(index, tx); err != nil {
+				// The fields after the transactions are not read.
+				out, _ := decodeBlockFields(fields)
+				return out, err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return nil, err
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	return decodeBlockFields(fields)
+}
+
+func decodeBlockFields(fields map[string]stdjson.RawMessage) (*GetBlockResult, error) {
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	out := new(GetBlockResult)
+	if err := json.Unmarshal(raw, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func expectDelim(dec *stdjson.Decoder, delim stdjson.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("unexpected token %v, expected %v", tok, delim)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ForEachTransactionInBlock(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(getBlockResponseBody)))
+	defer closer()
+	client := New(server.URL)
+
+	expected, err := client.GetBlock(context.Background(), 33)
+	require.NoError(t, err)
+
+	var txs []*TransactionWithMeta
+	out, err := client.ForEachTransactionInBlock(context.Background(), 33, nil, func(index int, tx *TransactionWithMeta) error {
+		require.Equal(t, len(txs), index)
+		txs = append(txs, tx)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	for i := range txs {
+		require.Equal(t, expected.Transactions[i], *txs[i])
+	}
+	require.Nil(t, out.Transactions)
+	expected.Transactions = nil
+	require.Equal(t, expected, out)
+
+	// Stop early.
+	count := 0
+	_, err = client.ForEachTransactionInBlock(context.Background(), 33, nil, func(index int, tx *TransactionWithMeta) error {
+		count++
+		return ErrStopIteration
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// Errors of the callback are returned as is.
+	failure := errors.New("failure")
+	_, err = client.ForEachTransactionInBlock(context.Background(), 33, nil, func(index int, tx *TransactionWithMeta) error {
+		return failure
+	})
+	require.Equal(t, failure, err)
+
+	_, err = client.ForEachTransactionInBlock(context.Background(), 33, &GetBlockOpts{
+		TransactionDetails: TransactionDetailsSignatures,
+	}, nil)
+	require.Error(t, err)
+}
+
+func TestClient_ForEachTransactionInBlock_Errors(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC("null")))
+	defer closer()
+	_, err := New(server.URL).ForEachTransactionInBlock(context.Background(), 33, nil, func(int, *TransactionWithMeta) error {
+		return nil
+	})
+	require.Equal(t, ErrNotConfirmed, err)
+
+	server, closer = mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","error":{"code":-32007,"message":"Slot 33 was skipped"},"id":0}`))
+	defer closer()
+	_, err = New(server.URL).ForEachTransactionInBlock(context.Background(), 33, nil, func(int, *TransactionWithMeta) error {
+		return nil
+	})
+	var rpcErr *jsonrpc.RPCError
+	require.True(t, errors.As(err, &rpcErr))
+	require.Equal(t, -32007, rpcErr.Code)
+}
@@ -56,6 +56,56 @@ func (cl *Client) GetAccountDataBorshInto(ctx context.Context, account solana.Pu
 	return bin.NewBorshDecoder(resp.Value.Data.GetBinary()).Decode(inVar)
 }
 
+// AccountExists returns true if the account of provided publicKey exists.
+// It does not transfer the data of the account.
+func (cl *Client) AccountExists(ctx context.Context, account solana.PublicKey) (bool, error) {
+	_, err := cl.getAccountWithoutData(ctx, account)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetAccountOwner returns the program owning the account of provided publicKey,
+// or ErrNotFound if the account does not exist.
+// It does not transfer the data of the account.
+func (cl *Client) GetAccountOwner(ctx context.Context, account solana.PublicKey) (solana.PublicKey, error) {
+	out, err := cl.getAccountWithoutData(ctx, account)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	return out.Value.Owner, nil
+}
+
+// GetAccountDataSlice returns length bytes of the data of the account
+// of provided publicKey, starting at offset, or ErrNotFound if the account
+// does not exist; only the requested bytes are transferred.
+// For example, the amount of a token account is:
+//
+//	data, err := client.GetAccountDataSlice(ctx, tokenAccount, 64, 8)
+//	amount := binary.LittleEndian.Uint64(data)
+func (cl *Client) GetAccountDataSlice(ctx context.Context, account solana.PublicKey, offset uint64, length uint64) ([]byte, error) {
+	out, err := cl.GetAccountInfoWithOpts(ctx, account, &GetAccountInfoOpts{
+		Encoding:  solana.EncodingBase64,
+		DataSlice: NewDataSlice(offset, length),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Value.Data.GetBinary(), nil
+}
+
+func (cl *Client) getAccountWithoutData(ctx context.Context, account solana.PublicKey) (*GetAccountInfoResult, error) {
+	var offset, length uint64
+	return cl.GetAccountInfoWithOpts(ctx, account, &GetAccountInfoOpts{
+		Encoding:  solana.EncodingBase64,
+		DataSlice: &DataSlice{Offset: &offset, Length: &length},
+	})
+}
+
 type GetAccountInfoOpts struct {
 	// Encoding for Account data.
 	// Either "base58" (slow), "base64", "base64+zstd", or "jsonParsed".
@@ -87,6 +137,24 @@ type GetAccountInfoOpts struct {
 	MinContextSlot *uint64
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetAccountInfoOpts) ToMap() M {
+	obj := M{}
+	if opts.Encoding != "" {
+		obj["encoding"] = opts.Encoding
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.DataSlice != nil {
+		obj["dataSlice"] = opts.DataSlice.ToMap()
+	}
+	if opts.MinContextSlot != nil {
+		obj["minContextSlot"] = *opts.MinContextSlot
+	}
+	return obj
+}
+
 // GetAccountInfoWithOpts returns all information associated with the account of provided publicKey.
 // You can specify the encoding of the returned data with the encoding parameter.
 // You can limit the returned account data with the offset and length parameters.
@@ -110,36 +178,9 @@ func (cl *Client) getAccountInfoWithOpts(
 	account solana.PublicKey,
 	opts *GetAccountInfoOpts,
 ) (out *GetAccountInfoResult, err error) {
-
-	obj := M{
-		// default encoding:
-		"encoding": solana.EncodingBase64,
-	}
-
-	if opts != nil {
-		if opts.Encoding != "" {
-			obj["encoding"] = opts.Encoding
-		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.DataSlice != nil {
-			obj["dataSlice"] = M{
-				"offset": opts.DataSlice.Offset,
-				"length": opts.DataSlice.Length,
-			}
-			if opts.Encoding == solana.EncodingJSONParsed {
-				return nil, errors.New("cannot use dataSlice with EncodingJSONParsed")
-			}
-		}
-		if opts.MinContextSlot != nil {
-			obj["minContextSlot"] = *opts.MinContextSlot
-		}
-	}
-
-	params := []interface{}{account}
-	if len(obj) > 0 {
-		params = append(params, obj)
+	params, err := getAccountInfoParams(account, opts)
+	if err != nil {
+		return nil, err
 	}
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getAccountInfo", params)
@@ -151,3 +192,18 @@ func (cl *Client) getAccountInfoWithOpts(
 	}
 	return out, nil
 }
+
+func getAccountInfoParams(account solana.PublicKey, opts *GetAccountInfoOpts) ([]interface{}, error) {
+	obj := M{}
+	if opts != nil {
+		if err := validateDataSlice(opts.Encoding, opts.DataSlice); err != nil {
+			return nil, err
+		}
+		obj = opts.ToMap()
+	}
+	if _, ok := obj["encoding"]; !ok {
+		// default encoding:
+		obj["encoding"] = solana.EncodingBase64
+	}
+	return []interface{}{account, obj}, nil
+}
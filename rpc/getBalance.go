@@ -33,10 +33,19 @@ func (cl *Client) GetBalance(
 	commitment CommitmentType,
 ) (out *GetBalanceResult, err error) {
 	params := []interface{}{publicKey}
-	if commitment != "" {
-		params = append(params, M{"commitment": string(commitment)})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getBalance", params)
 	return
 }
+
+// GetBalanceWithOpts is like GetBalance, with the minimum context slot.
+func (cl *Client) GetBalanceWithOpts(
+	ctx context.Context,
+	publicKey solana.PublicKey,
+	opts *ContextOpts,
+) (out *GetBalanceResult, err error) {
+	params := opts.appendTo([]interface{}{publicKey})
+	err = cl.rpcClient.CallForInto(ctx, &out, "getBalance", params)
+	return
+}
@@ -16,7 +16,6 @@ package rpc
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/gagliardetto/solana-go"
 )
@@ -63,6 +62,34 @@ type GetBlockOpts struct {
 	MaxSupportedTransactionVersion *uint64
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetBlockOpts) ToMap() M {
+	obj := M{}
+	if opts.TransactionDetails != "" {
+		obj["transactionDetails"] = opts.TransactionDetails
+	}
+	if opts.Rewards != nil {
+		obj["rewards"] = opts.Rewards
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.Encoding != "" {
+		obj["encoding"] = opts.Encoding
+	}
+	if opts.MaxSupportedTransactionVersion != nil {
+		obj["maxSupportedTransactionVersion"] = *opts.MaxSupportedTransactionVersion
+	}
+	return obj
+}
+
+func (opts *GetBlockOpts) validate() error {
+	if err := ValidateCommitmentForMethod("getBlock", opts.Commitment); err != nil {
+		return err
+	}
+	return validateTransactionEncoding(opts.Encoding)
+}
+
 // GetBlock returns identity and transaction information about a confirmed block in the ledger.
 func (cl *Client) GetBlock(
 	ctx context.Context,
@@ -85,38 +112,17 @@ func (cl *Client) GetBlockWithOpts(
 	opts *GetBlockOpts,
 ) (out *GetBlockResult, err error) {
 
-	obj := M{
-		"encoding": solana.EncodingBase64,
-	}
-
+	obj := M{}
 	if opts != nil {
-		if opts.TransactionDetails != "" {
-			obj["transactionDetails"] = opts.TransactionDetails
-		}
-		if opts.Rewards != nil {
-			obj["rewards"] = opts.Rewards
-		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.Encoding != "" {
-			if !solana.IsAnyOfEncodingType(
-				opts.Encoding,
-				// Valid encodings:
-				// solana.EncodingJSON, // TODO
-				// solana.EncodingJSONParsed, // TODO
-				solana.EncodingBase58,
-				solana.EncodingBase64,
-				solana.EncodingBase64Zstd,
-			) {
-				return nil, fmt.Errorf("provided encoding is not supported: %s", opts.Encoding)
-			}
-			obj["encoding"] = opts.Encoding
-		}
-		if opts.MaxSupportedTransactionVersion != nil {
-			obj["maxSupportedTransactionVersion"] = *opts.MaxSupportedTransactionVersion
+		if err := opts.validate(); err != nil {
+			return nil, err
 		}
+		obj = opts.ToMap()
+	}
+	if _, ok := obj["encoding"]; !ok {
+		obj["encoding"] = solana.EncodingBase64
 	}
+	cl.applyMaxSupportedTransactionVersion(obj)
 
 	params := []interface{}{slot, obj}
 
@@ -24,9 +24,17 @@ func (cl *Client) GetBlockHeight(
 	commitment CommitmentType, // optional
 ) (out uint64, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
+	err = cl.rpcClient.CallForInto(ctx, &out, "getBlockHeight", params)
+	return
+}
+
+// GetBlockHeightWithOpts is like GetBlockHeight, with the minimum context slot.
+func (cl *Client) GetBlockHeightWithOpts(
+	ctx context.Context,
+	opts *ContextOpts,
+) (out uint64, err error) {
+	params := opts.appendTo([]interface{}{})
 	err = cl.rpcClient.CallForInto(ctx, &out, "getBlockHeight", params)
 	return
 }
@@ -40,6 +40,33 @@ type GetBlockProductionOpts struct {
 	//
 	// This parameter is optional.
 	Identity *solana.PublicKey `json:"identity,omitempty"`
+
+	// The minimum slot that the request can be evaluated at.
+	// This parameter is optional.
+	MinContextSlot *uint64 `json:"minContextSlot,omitempty"`
+}
+
+// ToMap encodes the options as the config object of the request.
+func (opts *GetBlockProductionOpts) ToMap() M {
+	obj := M{}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.Range != nil {
+		rngObj := M{}
+		rngObj["firstSlot"] = opts.Range.FirstSlot
+		if opts.Range.LastSlot != nil {
+			rngObj["lastSlot"] = opts.Range.LastSlot
+		}
+		obj["range"] = rngObj
+	}
+	if opts.Identity != nil {
+		obj["identity"] = opts.Identity
+	}
+	if opts.MinContextSlot != nil {
+		obj["minContextSlot"] = *opts.MinContextSlot
+	}
+	return obj
 }
 
 type SlotRangeRequest struct {
@@ -71,22 +98,7 @@ func (cl *Client) GetBlockProductionWithOpts(
 	params := []interface{}{}
 
 	if opts != nil {
-		obj := M{}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.Range != nil {
-			rngObj := M{}
-			rngObj["firstSlot"] = opts.Range.FirstSlot
-			if opts.Range.LastSlot != nil {
-				rngObj["lastSlot"] = opts.Range.LastSlot
-			}
-			obj["range"] = rngObj
-		}
-		if opts.Identity != nil {
-			obj["identity"] = opts.Identity
-		}
-		if len(obj) != 0 {
+		if obj := opts.ToMap(); len(obj) != 0 {
 			params = append(params, obj)
 		}
 	}
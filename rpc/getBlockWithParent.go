@@ -0,0 +1,125 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// BlockHeader is the header of a block, without its transactions.
+type BlockHeader struct {
+	Slot              uint64                  `json:"slot"`
+	ParentSlot        uint64                  `json:"parentSlot"`
+	Blockhash         solana.Hash             `json:"blockhash"`
+	PreviousBlockhash solana.Hash             `json:"previousBlockhash"`
+	BlockTime         *solana.UnixTimeSeconds `json:"blockTime"`
+	BlockHeight       *uint64                 `json:"blockHeight"`
+}
+
+// Header returns the header of the block of the slot.
+func (res *GetBlockResult) Header(slot uint64) *BlockHeader {
+	return &BlockHeader{
+		Slot:              slot,
+		ParentSlot:        res.ParentSlot,
+		Blockhash:         res.Blockhash,
+		PreviousBlockhash: res.PreviousBlockhash,
+		BlockTime:         res.BlockTime,
+		BlockHeight:       res.BlockHeight,
+	}
+}
+
+// GetBlockWithParentResult is a block and the header of its parent.
+type GetBlockWithParentResult struct {
+	Slot uint64
+	*GetBlockResult
+
+	// The header of the parent block; nil if the parent
+	// is not available due to ledger cleanup.
+	Parent *BlockHeader
+
+	// Whether the PreviousBlockhash of the block is the blockhash of the parent
+	// (and its block height follows the one of the parent, if both are known).
+	Linked bool
+}
+
+// ParentMismatchError is returned by GetBlockWithParentResult.Verify
+// when a block does not link to its parent.
+type ParentMismatchError struct {
+	Slot              uint64
+	PreviousBlockhash solana.Hash
+	ParentSlot        uint64
+	ParentBlockhash   solana.Hash
+}
+
+func (e *ParentMismatchError) Error() string {
+	return fmt.Sprintf("block %d: previous blockhash %s does not match blockhash %s of parent block %d",
+		e.Slot, e.PreviousBlockhash, e.ParentBlockhash, e.ParentSlot)
+}
+
+// Verify returns a *ParentMismatchError if the block does not link to its
+// parent, nil otherwise (or if the parent is not available).
+func (res *GetBlockWithParentResult) Verify() error {
+	if res.Parent == nil || res.Linked {
+		return nil
+	}
+	return &ParentMismatchError{
+		Slot:              res.Slot,
+		PreviousBlockhash: res.PreviousBlockhash,
+		ParentSlot:        res.Parent.Slot,
+		ParentBlockhash:   res.Parent.Blockhash,
+	}
+}
+
+// GetBlockWithParent returns the block, like GetBlockWithOpts, along with
+// the header of its parent block, fetched with the same commitment, to check
+// that the block links to it (see GetBlockWithParentResult.Linked and Verify),
+// e.g. for an indexer validating the chain it follows.
+func (cl *Client) GetBlockWithParent(
+	ctx context.Context,
+	slot uint64,
+	opts *GetBlockOpts,
+) (*GetBlockWithParentResult, error) {
+	block, err := cl.GetBlockWithOpts(ctx, slot, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &GetBlockWithParentResult{Slot: slot, GetBlockResult: block}
+	if block.PreviousBlockhash.IsZero() {
+		// The parent is not available.
+		return out, nil
+	}
+
+	rewards := false
+	parentOpts := &GetBlockOpts{
+		TransactionDetails: TransactionDetailsNone,
+		Rewards:            &rewards,
+	}
+	if opts != nil {
+		parentOpts.Commitment = opts.Commitment
+	}
+	parent, err := cl.GetBlockWithOpts(ctx, block.ParentSlot, parentOpts)
+	if err != nil {
+		return nil, fmt.Errorf("get parent block %d: %w", block.ParentSlot, err)
+	}
+	out.Parent = parent.Header(block.ParentSlot)
+	out.Linked = block.PreviousBlockhash.Equals(parent.Blockhash)
+	if block.BlockHeight != nil && parent.BlockHeight != nil && *block.BlockHeight != *parent.BlockHeight+1 {
+		out.Linked = false
+	}
+	return out, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetBlockWithParent(t *testing.T) {
+	server := ledgerRPC(t, testLedger(true))
+	defer server.Close()
+	client := New(server.URL)
+
+	block, err := client.GetBlockWithParent(context.Background(), 11, nil)
+	require.NoError(t, err)
+	require.True(t, block.Linked)
+	require.NoError(t, block.Verify())
+	require.Equal(t, solana.Hash{11}, block.Blockhash)
+	require.Equal(t, &BlockHeader{Slot: 10, ParentSlot: 9, Blockhash: solana.Hash{10}, PreviousBlockhash: solana.Hash{9}}, block.Parent)
+
+	block, err = client.GetBlockWithParent(context.Background(), 13, nil)
+	require.NoError(t, err)
+	require.False(t, block.Linked)
+	err = block.Verify()
+	var mismatch *ParentMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	require.Equal(t, &ParentMismatchError{
+		Slot:              13,
+		PreviousBlockhash: solana.Hash{99},
+		ParentSlot:        11,
+		ParentBlockhash:   solana.Hash{11},
+	}, mismatch)
+
+	// The parent of a block is not available after the ledger cleanup.
+	cleaned := ledgerRPC(t, map[uint64]stdjson.RawMessage{
+		5: stdjson.RawMessage(fmt.Sprintf(`{"blockhash":%q,"previousBlockhash":"11111111111111111111111111111111","parentSlot":4}`, solana.Hash{5})),
+	})
+	defer cleaned.Close()
+	block, err = New(cleaned.URL).GetBlockWithParent(context.Background(), 5, nil)
+	require.NoError(t, err)
+	require.Nil(t, block.Parent)
+	require.False(t, block.Linked)
+	require.NoError(t, block.Verify())
+}
@@ -33,11 +33,11 @@ func (cl *Client) GetBlocks(
 		params = append(params, endSlot)
 	}
 	if commitment != "" {
-		params = append(params,
-			// TODO: provide commitment as string instead of object?
-			M{"commitment": commitment},
-		)
+		if err := ValidateCommitmentForMethod("getBlocks", commitment); err != nil {
+			return nil, err
+		}
 	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getBlocks", params)
 
 	return
@@ -29,11 +29,11 @@ func (cl *Client) GetBlocksWithLimit(
 ) (out *BlocksResult, err error) {
 	params := []interface{}{startSlot, limit}
 	if commitment != "" {
-		params = append(params,
-			// TODO: provide commitment as string instead of object?
-			M{"commitment": commitment},
-		)
+		if err := ValidateCommitmentForMethod("getBlocksWithLimit", commitment); err != nil {
+			return nil, err
+		}
 	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getBlocksWithLimit", params)
 	return
 }
@@ -24,9 +24,17 @@ func (cl *Client) GetEpochInfo(
 	commitment CommitmentType, // optional
 ) (out *GetEpochInfoResult, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
+	err = cl.rpcClient.CallForInto(ctx, &out, "getEpochInfo", params)
+	return
+}
+
+// GetEpochInfoWithOpts is like GetEpochInfo, with the minimum context slot.
+func (cl *Client) GetEpochInfoWithOpts(
+	ctx context.Context,
+	opts *ContextOpts,
+) (out *GetEpochInfoResult, err error) {
+	params := opts.appendTo([]interface{}{})
 	err = cl.rpcClient.CallForInto(ctx, &out, "getEpochInfo", params)
 	return
 }
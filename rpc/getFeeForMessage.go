@@ -16,6 +16,9 @@ package rpc
 
 import (
 	"context"
+	"errors"
+
+	"github.com/gagliardetto/solana-go"
 )
 
 // Get the fee the network will charge for a particular Message.
@@ -28,9 +31,18 @@ func (cl *Client) GetFeeForMessage(
 	commitment CommitmentType, // optional
 ) (out *GetFeeForMessageResult, err error) {
 	params := []interface{}{message}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
+	err = cl.rpcClient.CallForInto(ctx, &out, "getFeeForMessage", params)
+	return
+}
+
+// GetFeeForMessageWithOpts is like GetFeeForMessage, with the minimum context slot.
+func (cl *Client) GetFeeForMessageWithOpts(
+	ctx context.Context,
+	message string,
+	opts *ContextOpts,
+) (out *GetFeeForMessageResult, err error) {
+	params := opts.appendTo([]interface{}{message})
 	err = cl.rpcClient.CallForInto(ctx, &out, "getFeeForMessage", params)
 	return
 }
@@ -41,3 +53,23 @@ type GetFeeForMessageResult struct {
 	// Fee corresponding to the message at the specified blockhash.
 	Value *uint64 `json:"value"`
 }
+
+// ErrFeeUnavailable is returned by FeeForMessage when the node cannot compute
+// the fee of the message, i.e. its blockhash is not found (e.g. expired).
+var ErrFeeUnavailable = errors.New("fee unavailable: blockhash not found")
+
+var _ solana.FeeCalculator = (*Client)(nil)
+
+// FeeForMessage returns the fee, in lamports, the network will charge for
+// the message, with getFeeForMessage; it implements solana.FeeCalculator
+// (see solana.Transaction.Fee).
+func (cl *Client) FeeForMessage(ctx context.Context, message *solana.Message) (uint64, error) {
+	out, err := cl.GetFeeForMessage(ctx, message.ToBase64(), "")
+	if err != nil {
+		return 0, err
+	}
+	if out == nil || out.Value == nil {
+		return 0, ErrFeeUnavailable
+	}
+	return *out.Value, nil
+}
@@ -24,11 +24,7 @@ func (cl *Client) GetInflationGovernor(
 	commitment CommitmentType, // optional
 ) (out *GetInflationGovernorResult, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params,
-			M{"commitment": commitment},
-		)
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getInflationGovernor", params)
 	return
 }
@@ -26,6 +26,25 @@ type GetInflationRewardOpts struct {
 	// An epoch for which the reward occurs.
 	// If omitted, the previous epoch will be used.
 	Epoch *uint64
+
+	// The minimum slot that the request can be evaluated at.
+	// This parameter is optional.
+	MinContextSlot *uint64
+}
+
+// ToMap encodes the options as the config object of the request.
+func (opts *GetInflationRewardOpts) ToMap() M {
+	obj := M{}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.Epoch != nil {
+		obj["epoch"] = opts.Epoch
+	}
+	if opts.MinContextSlot != nil {
+		obj["minContextSlot"] = *opts.MinContextSlot
+	}
+	return obj
 }
 
 // GetInflationReward returns the inflation / staking reward for a list of addresses for an epoch.
@@ -40,14 +59,10 @@ func (cl *Client) GetInflationReward(
 ) (out []*GetInflationRewardResult, err error) {
 	params := []interface{}{addresses}
 	if opts != nil {
-		obj := M{}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.Epoch != nil {
-			obj["epoch"] = opts.Epoch
+		if err := ValidateCommitmentForMethod("getInflationReward", opts.Commitment); err != nil {
+			return nil, err
 		}
-		if len(obj) > 0 {
+		if obj := opts.ToMap(); len(obj) > 0 {
 			params = append(params, obj)
 		}
 	}
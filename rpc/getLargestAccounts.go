@@ -34,16 +34,41 @@ func (cl *Client) GetLargestAccounts(
 	commitment CommitmentType,
 	filter LargestAccountsFilterType, // filter results by account type; currently supported: circulating|nonCirculating
 ) (out *GetLargestAccountsResult, err error) {
-	params := []interface{}{}
+	return cl.GetLargestAccountsWithOpts(ctx, &GetLargestAccountsOpts{
+		Commitment: commitment,
+		Filter:     filter,
+	})
+}
+
+type GetLargestAccountsOpts struct {
+	Commitment CommitmentType `json:"commitment,omitempty"`
+
+	// Filter results by account type; currently supported: circulating|nonCirculating
+	Filter LargestAccountsFilterType `json:"filter,omitempty"`
+}
+
+// ToMap encodes the options as the config object of the request.
+func (opts *GetLargestAccountsOpts) ToMap() M {
 	obj := M{}
-	if commitment != "" {
-		obj["commitment"] = commitment
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
 	}
-	if filter != "" {
-		obj["filter"] = filter
+	if opts.Filter != "" {
+		obj["filter"] = opts.Filter
 	}
-	if len(obj) > 0 {
-		params = append(params, obj)
+	return obj
+}
+
+// GetLargestAccountsWithOpts is like GetLargestAccounts, with the options as a struct.
+func (cl *Client) GetLargestAccountsWithOpts(
+	ctx context.Context,
+	opts *GetLargestAccountsOpts,
+) (out *GetLargestAccountsResult, err error) {
+	params := []interface{}{}
+	if opts != nil {
+		if obj := opts.ToMap(); len(obj) > 0 {
+			params = append(params, obj)
+		}
 	}
 	err = cl.rpcClient.CallForInto(ctx, &out, "getLargestAccounts", params)
 	return
@@ -31,14 +31,22 @@ func (cl *Client) GetLatestBlockhash(
 	commitment CommitmentType, // optional
 ) (out *GetLatestBlockhashResult, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getLatestBlockhash", params)
 	return
 }
 
+// GetLatestBlockhashWithOpts is like GetLatestBlockhash, with the minimum context slot.
+func (cl *Client) GetLatestBlockhashWithOpts(
+	ctx context.Context,
+	opts *ContextOpts,
+) (out *GetLatestBlockhashResult, err error) {
+	params := opts.appendTo([]interface{}{})
+	err = cl.rpcClient.CallForInto(ctx, &out, "getLatestBlockhash", params)
+	return
+}
+
 type GetLatestBlockhashResult struct {
 	RPCContext
 	Value *LatestBlockhashResult `json:"value"`
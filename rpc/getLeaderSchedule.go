@@ -42,6 +42,19 @@ type GetLeaderScheduleOpts struct {
 	Identity *solana.PublicKey // Only return results for this validator identity
 }
 
+// ToMap encodes the options as the config object of the request;
+// the epoch is a separate positional param.
+func (opts *GetLeaderScheduleOpts) ToMap() M {
+	obj := M{}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.Identity != nil {
+		obj["identity"] = opts.Identity
+	}
+	return obj
+}
+
 // GetLeaderScheduleWithOpts returns the leader schedule for an epoch.
 func (cl *Client) GetLeaderScheduleWithOpts(
 	ctx context.Context,
@@ -52,14 +65,7 @@ func (cl *Client) GetLeaderScheduleWithOpts(
 		if opts.Epoch != nil {
 			params = append(params, opts.Epoch)
 		}
-		obj := M{}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.Identity != nil {
-			obj["identity"] = opts.Identity
-		}
-		if len(obj) > 0 {
+		if obj := opts.ToMap(); len(obj) > 0 {
 			params = append(params, obj)
 		}
 	}
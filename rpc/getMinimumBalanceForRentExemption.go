@@ -27,9 +27,7 @@ func (cl *Client) GetMinimumBalanceForRentExemption(
 	commitment CommitmentType, // optional
 ) (lamport uint64, err error) {
 	params := []interface{}{dataSize}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &lamport, "getMinimumBalanceForRentExemption", params)
 	return
 }
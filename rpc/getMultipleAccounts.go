@@ -16,7 +16,6 @@ package rpc
 
 import (
 	"context"
-	"errors"
 
 	"github.com/gagliardetto/solana-go"
 )
@@ -40,6 +39,11 @@ func (cl *Client) GetMultipleAccounts(
 
 type GetMultipleAccountsOpts GetAccountInfoOpts
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetMultipleAccountsOpts) ToMap() M {
+	return (*GetAccountInfoOpts)(opts).ToMap()
+}
+
 // GetMultipleAccountsWithOpts returns the account information for a list of Pubkeys.
 func (cl *Client) GetMultipleAccountsWithOpts(
 	ctx context.Context,
@@ -49,23 +53,10 @@ func (cl *Client) GetMultipleAccountsWithOpts(
 	params := []interface{}{accounts}
 
 	if opts != nil {
-		obj := M{}
-		if opts.Encoding != "" {
-			obj["encoding"] = opts.Encoding
-		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.DataSlice != nil {
-			obj["dataSlice"] = M{
-				"offset": opts.DataSlice.Offset,
-				"length": opts.DataSlice.Length,
-			}
-			if opts.Encoding == solana.EncodingJSONParsed {
-				return nil, errors.New("cannot use dataSlice with EncodingJSONParsed")
-			}
+		if err := validateDataSlice(opts.Encoding, opts.DataSlice); err != nil {
+			return nil, err
 		}
-		if len(obj) > 0 {
+		if obj := opts.ToMap(); len(obj) > 0 {
 			params = append(params, obj)
 		}
 	}
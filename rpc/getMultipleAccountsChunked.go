@@ -0,0 +1,149 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// MaxMultipleAccounts is the maximum number of accounts
+// accepted by getMultipleAccounts.
+const MaxMultipleAccounts = 100
+
+// GetMultipleAccountsChunkedOpts are the options of GetMultipleAccountsChunked.
+type GetMultipleAccountsChunkedOpts struct {
+	// The options of every request.
+	GetMultipleAccountsOpts
+
+	// The number of accounts of each request.
+	// If not provided (or greater), the default is MaxMultipleAccounts.
+	ChunkSize int
+
+	// The number of requests in flight.
+	// If not provided, the default is 1 (sequential).
+	Concurrency int
+}
+
+// GetMultipleAccountsChunked is like GetMultipleAccountsWithOpts, but accepts
+// any number of accounts: they are split into chunks of at most ChunkSize
+// accounts, and the results are reassembled in the order of the accounts.
+//
+// The first chunk is fetched alone, and the following ones are fetched with
+// its context slot as minContextSlot (unless a greater one was requested),
+// so that no chunk observes an older state; the context slot of the result
+// is the lowest of the chunks.
+func (cl *Client) GetMultipleAccountsChunked(
+	ctx context.Context,
+	accounts []solana.PublicKey,
+	opts *GetMultipleAccountsChunkedOpts,
+) (*GetMultipleAccountsResult, error) {
+	if opts == nil {
+		opts = &GetMultipleAccountsChunkedOpts{}
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 || chunkSize > MaxMultipleAccounts {
+		chunkSize = MaxMultipleAccounts
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	if len(accounts) <= chunkSize {
+		return cl.GetMultipleAccountsWithOpts(ctx, accounts, &opts.GetMultipleAccountsOpts)
+	}
+
+	out := &GetMultipleAccountsResult{
+		Value: make([]*Account, len(accounts)),
+	}
+	first, err := cl.getMultipleAccountsChunk(ctx, accounts[:chunkSize], &opts.GetMultipleAccountsOpts)
+	if err != nil {
+		return nil, err
+	}
+	copy(out.Value, first.Value)
+	out.RPCContext = first.RPCContext
+
+	chunkOpts := opts.GetMultipleAccountsOpts
+	if minContextSlot := first.Context.Slot; chunkOpts.MinContextSlot == nil || *chunkOpts.MinContextSlot < minContextSlot {
+		chunkOpts.MinContextSlot = &minContextSlot
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu       sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, concurrency)
+	for start := chunkSize; start < len(accounts); start += chunkSize {
+		end := start + chunkSize
+		if end > len(accounts) {
+			end = len(accounts)
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res, err := cl.getMultipleAccountsChunk(ctx, accounts[start:end], &chunkOpts)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			copy(out.Value[start:end], res.Value)
+			if res.Context.Slot < out.Context.Slot {
+				out.RPCContext = res.RPCContext
+			}
+		}(start, end)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (cl *Client) getMultipleAccountsChunk(
+	ctx context.Context,
+	accounts []solana.PublicKey,
+	opts *GetMultipleAccountsOpts,
+) (*GetMultipleAccountsResult, error) {
+	out, err := cl.GetMultipleAccountsWithOpts(ctx, accounts, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Value) != len(accounts) {
+		return nil, fmt.Errorf("requested %d accounts, got %d", len(accounts), len(out.Value))
+	}
+	return out, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+// accountsNode is a JSONRPCClient that serves getMultipleAccounts,
+// with the lamports of each account encoded in its public key.
+type accountsNode struct {
+	mu              sync.Mutex
+	slot            uint64
+	calls           int
+	minContextSlots []interface{}
+	failAt          int
+}
+
+func (node *accountsNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.calls++
+	if node.calls == node.failAt {
+		return errors.New("boom")
+	}
+	node.slot++
+	if len(params) > 1 {
+		node.minContextSlots = append(node.minContextSlots, params[1].(M)["minContextSlot"])
+	}
+	res := &GetMultipleAccountsResult{RPCContext: RPCContext{Context: Context{Slot: node.slot}}}
+	for _, key := range params[0].([]solana.PublicKey) {
+		res.Value = append(res.Value, &Account{Lamports: binary.LittleEndian.Uint64(key[:8])})
+	}
+	*out.(**GetMultipleAccountsResult) = res
+	return nil
+}
+
+func (node *accountsNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestClient_GetMultipleAccountsChunked(t *testing.T) {
+	accounts := make([]solana.PublicKey, 250)
+	for i := range accounts {
+		binary.LittleEndian.PutUint64(accounts[i][:8], uint64(i))
+	}
+	requireOrdered := func(res *GetMultipleAccountsResult) {
+		require.Len(t, res.Value, len(accounts))
+		for i, account := range res.Value {
+			require.Equal(t, uint64(i), account.Lamports)
+		}
+	}
+
+	for _, concurrency := range []int{0, 3} {
+		node := &accountsNode{slot: 10}
+		res, err := NewWithCustomRPCClient(node).GetMultipleAccountsChunked(context.Background(), accounts, &GetMultipleAccountsChunkedOpts{
+			Concurrency: concurrency,
+		})
+		require.NoError(t, err)
+		requireOrdered(res)
+		require.Equal(t, 3, node.calls)
+		// The following chunks are not older than the first one.
+		require.Equal(t, []interface{}{uint64(11), uint64(11)}, node.minContextSlots)
+		require.Equal(t, uint64(11), res.Context.Slot)
+	}
+
+	{
+		node := &accountsNode{}
+		res, err := NewWithCustomRPCClient(node).GetMultipleAccountsChunked(context.Background(), accounts, &GetMultipleAccountsChunkedOpts{
+			ChunkSize: 50,
+		})
+		require.NoError(t, err)
+		requireOrdered(res)
+		require.Equal(t, 5, node.calls)
+	}
+
+	// A single chunk is a single request.
+	{
+		node := &accountsNode{}
+		res, err := NewWithCustomRPCClient(node).GetMultipleAccountsChunked(context.Background(), accounts[:100], nil)
+		require.NoError(t, err)
+		require.Len(t, res.Value, 100)
+		require.Equal(t, 1, node.calls)
+	}
+
+	{
+		node := &accountsNode{failAt: 2}
+		_, err := NewWithCustomRPCClient(node).GetMultipleAccountsChunked(context.Background(), accounts, &GetMultipleAccountsChunkedOpts{
+			Concurrency: 2,
+		})
+		require.EqualError(t, err, "boom")
+	}
+}
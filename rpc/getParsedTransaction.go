@@ -10,6 +10,24 @@ import (
 
 type GetParsedTransactionOpts struct {
 	Commitment CommitmentType `json:"commitment,omitempty"`
+
+	// Max transaction version to return in responses.
+	// If the requested transaction is a higher version, an error will be returned.
+	MaxSupportedTransactionVersion *uint64
+}
+
+// ToMap encodes the options as the config object of the request;
+// the encoding is always "jsonParsed".
+func (opts *GetParsedTransactionOpts) ToMap() M {
+	obj := M{}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.MaxSupportedTransactionVersion != nil {
+		obj["maxSupportedTransactionVersion"] = *opts.MaxSupportedTransactionVersion
+	}
+	obj["encoding"] = solana.EncodingJSONParsed
+	return obj
 }
 
 type GetParsedTransactionResult struct {
@@ -17,6 +35,7 @@ type GetParsedTransactionResult struct {
 	BlockTime   *solana.UnixTimeSeconds
 	Transaction *ParsedTransaction
 	Meta        *ParsedTransactionMeta
+	Version     TransactionVersion
 }
 
 func (cl *Client) GetParsedTransaction(
@@ -25,13 +44,14 @@ func (cl *Client) GetParsedTransaction(
 	opts *GetParsedTransactionOpts,
 ) (out *GetParsedTransactionResult, err error) {
 	params := []interface{}{txSig}
-	obj := M{}
-	if opts != nil {
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
+	if opts == nil {
+		opts = &GetParsedTransactionOpts{}
 	}
-	obj["encoding"] = solana.EncodingJSONParsed
+	if err := ValidateCommitmentForMethod("getTransaction", opts.Commitment); err != nil {
+		return nil, err
+	}
+	obj := opts.ToMap()
+	cl.applyMaxSupportedTransactionVersion(obj)
 	params = append(params, obj)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getTransaction", params)
 	if err != nil {
@@ -40,25 +40,15 @@ func (cl *Client) GetProgramAccountsWithOpts(
 	publicKey solana.PublicKey,
 	opts *GetProgramAccountsOpts,
 ) (out GetProgramAccountsResult, err error) {
-	obj := M{
-		"encoding": "base64",
-	}
+	obj := M{}
 	if opts != nil {
-		if opts.Commitment != "" {
-			obj["commitment"] = string(opts.Commitment)
-		}
-		if len(opts.Filters) != 0 {
-			obj["filters"] = opts.Filters
-		}
-		if opts.Encoding != "" {
-			obj["encoding"] = opts.Encoding
-		}
-		if opts.DataSlice != nil {
-			obj["dataSlice"] = M{
-				"offset": opts.DataSlice.Offset,
-				"length": opts.DataSlice.Length,
-			}
+		if err := validateDataSlice(opts.Encoding, opts.DataSlice); err != nil {
+			return nil, err
 		}
+		obj = opts.ToMap()
+	}
+	if _, ok := obj["encoding"]; !ok {
+		obj["encoding"] = "base64"
 	}
 
 	params := []interface{}{publicKey, obj}
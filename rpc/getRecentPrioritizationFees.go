@@ -0,0 +1,52 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// MaxPrioritizationFeesAccounts is the maximum number of accounts
+// of a getRecentPrioritizationFees request.
+const MaxPrioritizationFeesAccounts = 128
+
+// Returns a list of prioritization fees from recent blocks (up to 150).
+// If accounts are provided (at most MaxPrioritizationFeesAccounts), the fee of
+// each slot is the minimum fee paid by the transactions locking all of them
+// as writable; otherwise, it is the minimum fee paid by the transactions of the block.
+//
+// **NEW**: This method is only available in solana-core v1.14 or newer.
+func (cl *Client) GetRecentPrioritizationFees(
+	ctx context.Context,
+	accounts solana.PublicKeySlice, // optional
+) (out []PrioritizationFee, err error) {
+	if accounts == nil {
+		accounts = solana.PublicKeySlice{}
+	}
+	params := []interface{}{accounts}
+	err = cl.rpcClient.CallForInto(ctx, &out, "getRecentPrioritizationFees", params)
+	return
+}
+
+type PrioritizationFee struct {
+	// Slot in which the fee was observed.
+	Slot uint64 `json:"slot"`
+
+	// The per-compute-unit fee paid by at least one successfully landed
+	// transaction, specified in increments of micro-lamports.
+	PrioritizationFee uint64 `json:"prioritizationFee"`
+}
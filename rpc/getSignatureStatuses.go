@@ -32,12 +32,42 @@ func (cl *Client) GetSignatureStatuses(
 	// cache for any signatures not found in the recent status cache.
 	searchTransactionHistory bool,
 
+	// Transaction signatures to confirm.
+	transactionSignatures ...solana.Signature,
+) (out *GetSignatureStatusesResult, err error) {
+	return cl.GetSignatureStatusesWithOpts(ctx, &GetSignatureStatusesOpts{
+		SearchTransactionHistory: searchTransactionHistory,
+	}, transactionSignatures...)
+}
+
+type GetSignatureStatusesOpts struct {
+	// If true, a Solana node will search its ledger
+	// cache for any signatures not found in the recent status cache.
+	SearchTransactionHistory bool `json:"searchTransactionHistory,omitempty"`
+}
+
+// ToMap encodes the options as the config object of the request.
+func (opts *GetSignatureStatusesOpts) ToMap() M {
+	obj := M{}
+	if opts.SearchTransactionHistory {
+		obj["searchTransactionHistory"] = opts.SearchTransactionHistory
+	}
+	return obj
+}
+
+// GetSignatureStatusesWithOpts is like GetSignatureStatuses, with the options as a struct.
+func (cl *Client) GetSignatureStatusesWithOpts(
+	ctx context.Context,
+	opts *GetSignatureStatusesOpts,
+
 	// Transaction signatures to confirm.
 	transactionSignatures ...solana.Signature,
 ) (out *GetSignatureStatusesResult, err error) {
 	params := []interface{}{transactionSignatures}
-	if searchTransactionHistory {
-		params = append(params, M{"searchTransactionHistory": searchTransactionHistory})
+	if opts != nil {
+		if obj := opts.ToMap(); len(obj) > 0 {
+			params = append(params, obj)
+		}
 	}
 	err = cl.rpcClient.CallForInto(ctx, &out, "getSignatureStatuses", params)
 	if err != nil {
@@ -40,12 +40,34 @@ type GetSignaturesForAddressOpts struct {
 	MinContextSlot *uint64
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetSignaturesForAddressOpts) ToMap() M {
+	obj := M{}
+	if opts.Limit != nil {
+		obj["limit"] = opts.Limit
+	}
+	if !opts.Before.IsZero() {
+		obj["before"] = opts.Before
+	}
+	if !opts.Until.IsZero() {
+		obj["until"] = opts.Until
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.MinContextSlot != nil {
+		obj["minContextSlot"] = *opts.MinContextSlot
+	}
+	return obj
+}
+
 // GetSignaturesForAddress returns confirmed signatures for transactions
 // involving an address backwards in time from the provided signature
 // or most recent confirmed block.
 //
 // NEW: This method is only available in solana-core v1.7 or newer.
-// Please use `getConfirmedSignaturesForAddress2` for solana-core v1.6
+// On solana-core v1.6 nodes, the client falls back to `getConfirmedSignaturesForAddress2`
+// (see DetectCapabilities).
 func (cl *Client) GetSignaturesForAddress(
 	ctx context.Context,
 	account solana.PublicKey,
@@ -62,7 +84,8 @@ func (cl *Client) GetSignaturesForAddress(
 // or most recent confirmed block.
 //
 // NEW: This method is only available in solana-core v1.7 or newer.
-// Please use `getConfirmedSignaturesForAddress2` for solana-core v1.6
+// On solana-core v1.6 nodes, the client falls back to `getConfirmedSignaturesForAddress2`
+// (see DetectCapabilities).
 func (cl *Client) GetSignaturesForAddressWithOpts(
 	ctx context.Context,
 	account solana.PublicKey,
@@ -70,27 +93,14 @@ func (cl *Client) GetSignaturesForAddressWithOpts(
 ) (out []*TransactionSignature, err error) {
 	params := []interface{}{account}
 	if opts != nil {
-		obj := M{}
-		if opts.Limit != nil {
-			obj["limit"] = opts.Limit
-		}
-		if !opts.Before.IsZero() {
-			obj["before"] = opts.Before
-		}
-		if !opts.Until.IsZero() {
-			obj["until"] = opts.Until
-		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.MinContextSlot != nil {
-			obj["minContextSlot"] = *opts.MinContextSlot
+		if err := ValidateCommitmentForMethod("getSignaturesForAddress", opts.Commitment); err != nil {
+			return nil, err
 		}
-		if len(obj) > 0 {
+		if obj := opts.ToMap(); len(obj) > 0 {
 			params = append(params, obj)
 		}
 	}
 
-	err = cl.rpcClient.CallForInto(ctx, &out, "getSignaturesForAddress", params)
+	err = cl.callWithLegacyFallback(ctx, &out, "getSignaturesForAddress", params)
 	return
 }
@@ -26,10 +26,18 @@ func (cl *Client) GetSlot(
 	commitment CommitmentType, // optional
 ) (out uint64, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getSlot", params)
 	return
 }
+
+// GetSlotWithOpts is like GetSlot, with the minimum context slot.
+func (cl *Client) GetSlotWithOpts(
+	ctx context.Context,
+	opts *ContextOpts,
+) (out uint64, err error) {
+	params := opts.appendTo([]interface{}{})
+	err = cl.rpcClient.CallForInto(ctx, &out, "getSlot", params)
+	return
+}
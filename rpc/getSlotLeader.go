@@ -26,9 +26,17 @@ func (cl *Client) GetSlotLeader(
 	commitment CommitmentType, // optional
 ) (out solana.PublicKey, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
+	err = cl.rpcClient.CallForInto(ctx, &out, "getSlotLeader", params)
+	return
+}
+
+// GetSlotLeaderWithOpts is like GetSlotLeader, with the minimum context slot.
+func (cl *Client) GetSlotLeaderWithOpts(
+	ctx context.Context,
+	opts *ContextOpts,
+) (out solana.PublicKey, err error) {
+	params := opts.appendTo([]interface{}{})
 	err = cl.rpcClient.CallForInto(ctx, &out, "getSlotLeader", params)
 	return
 }
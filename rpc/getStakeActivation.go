@@ -31,17 +31,43 @@ func (cl *Client) GetStakeActivation(
 	// epoch for which to calculate activation details.
 	// If parameter not provided, defaults to current epoch.
 	epoch *uint64,
+) (out *GetStakeActivationResult, err error) {
+	return cl.GetStakeActivationWithOpts(ctx, account, &GetStakeActivationOpts{
+		Commitment: commitment,
+		Epoch:      epoch,
+	})
+}
+
+type GetStakeActivationOpts struct {
+	Commitment CommitmentType `json:"commitment,omitempty"`
+
+	// Epoch for which to calculate activation details.
+	// If parameter not provided, defaults to current epoch.
+	Epoch *uint64 `json:"epoch,omitempty"`
+}
+
+// ToMap encodes the options as the config object of the request.
+func (opts *GetStakeActivationOpts) ToMap() M {
+	obj := M{}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.Epoch != nil {
+		obj["epoch"] = opts.Epoch
+	}
+	return obj
+}
+
+// GetStakeActivationWithOpts is like GetStakeActivation, with the options as a struct.
+func (cl *Client) GetStakeActivationWithOpts(
+	ctx context.Context,
+	// Pubkey of stake account to query
+	account solana.PublicKey,
+	opts *GetStakeActivationOpts,
 ) (out *GetStakeActivationResult, err error) {
 	params := []interface{}{account}
-	{
-		obj := M{}
-		if commitment != "" {
-			obj["commitment"] = commitment
-		}
-		if epoch != nil {
-			obj["epoch"] = epoch
-		}
-		if len(obj) > 0 {
+	if opts != nil {
+		if obj := opts.ToMap(); len(obj) > 0 {
 			params = append(params, obj)
 		}
 	}
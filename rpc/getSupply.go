@@ -30,14 +30,12 @@ func (cl *Client) GetSupplyWithOpts(
 	ctx context.Context,
 	opts *GetSupplyOpts,
 ) (out *GetSupplyResult, err error) {
-	obj := M{
-		"commitment": CommitmentConfirmed,
-	}
+	obj := M{}
 	if opts != nil {
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		obj["excludeNonCirculatingAccountsList"] = opts.ExcludeNonCirculatingAccountsList
+		obj = opts.ToMap()
+	}
+	if _, ok := obj["commitment"]; !ok {
+		obj["commitment"] = CommitmentConfirmed
 	}
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getSupply", []interface{}{obj})
@@ -50,6 +48,17 @@ type GetSupplyOpts struct {
 	ExcludeNonCirculatingAccountsList bool `json:"excludeNonCirculatingAccountsList,omitempty"` // exclude non circulating accounts list from response
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetSupplyOpts) ToMap() M {
+	obj := M{
+		"excludeNonCirculatingAccountsList": opts.ExcludeNonCirculatingAccountsList,
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	return obj
+}
+
 type GetSupplyResult struct {
 	RPCContext
 	Value *SupplyResult `json:"value"`
@@ -27,11 +27,7 @@ func (cl *Client) GetTokenAccountBalance(
 	commitment CommitmentType, // optional
 ) (out *GetTokenAccountBalanceResult, err error) {
 	params := []interface{}{account}
-	if commitment != "" {
-		params = append(params,
-			M{"commitment": commitment},
-		)
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getTokenAccountBalance", params)
 	return
 }
@@ -37,6 +37,77 @@ type GetTokenAccountsOpts struct {
 	Encoding solana.EncodingType `json:"encoding,omitempty"`
 
 	DataSlice *DataSlice `json:"dataSlice,omitempty"`
+
+	// The minimum slot that the request can be evaluated at.
+	// This parameter is optional.
+	MinContextSlot *uint64 `json:"minContextSlot,omitempty"`
+}
+
+// ToMap encodes the config as the filter object of the request.
+func (conf *GetTokenAccountsConfig) ToMap() M {
+	obj := M{}
+	if conf.Mint != nil {
+		obj["mint"] = conf.Mint
+	}
+	if conf.ProgramId != nil {
+		obj["programId"] = conf.ProgramId
+	}
+	return obj
+}
+
+func (conf *GetTokenAccountsConfig) validate() error {
+	if conf == nil {
+		return errors.New("conf is nil")
+	}
+	if conf.Mint != nil && conf.ProgramId != nil {
+		return errors.New("conf.Mint and conf.ProgramId are both set; must be just one of them")
+	}
+	return nil
+}
+
+// ToMap encodes the options as the config object of the request;
+// the encoding defaults to base64.
+func (opts *GetTokenAccountsOpts) ToMap() M {
+	obj := M{
+		"encoding": solana.EncodingBase64,
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.Encoding != "" {
+		obj["encoding"] = opts.Encoding
+	}
+	if opts.DataSlice != nil {
+		obj["dataSlice"] = opts.DataSlice.ToMap()
+	}
+	if opts.MinContextSlot != nil {
+		obj["minContextSlot"] = *opts.MinContextSlot
+	}
+	return obj
+}
+
+// getTokenAccountsParams builds the params shared by getTokenAccountsByOwner
+// and getTokenAccountsByDelegate.
+func getTokenAccountsParams(
+	account solana.PublicKey,
+	conf *GetTokenAccountsConfig,
+	opts *GetTokenAccountsOpts,
+) ([]interface{}, error) {
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &GetTokenAccountsOpts{}
+	}
+	if err := validateDataSlice(opts.Encoding, opts.DataSlice); err != nil {
+		return nil, err
+	}
+	params := []interface{}{account}
+	if confObj := conf.ToMap(); len(confObj) > 0 {
+		params = append(params, confObj)
+	}
+	params = append(params, opts.ToMap())
+	return params, nil
 }
 
 // GetTokenAccountsByDelegate returns all SPL Token accounts by approved Delegate.
@@ -46,53 +117,9 @@ func (cl *Client) GetTokenAccountsByDelegate(
 	conf *GetTokenAccountsConfig,
 	opts *GetTokenAccountsOpts,
 ) (out *GetTokenAccountsResult, err error) {
-	params := []interface{}{account}
-	if conf == nil {
-		return nil, errors.New("conf is nil")
-	}
-	if conf.Mint != nil && conf.ProgramId != nil {
-		return nil, errors.New("conf.Mint and conf.ProgramId are both set; must be just one of them")
-	}
-
-	{
-		confObj := M{}
-		if conf.Mint != nil {
-			confObj["mint"] = conf.Mint
-		}
-		if conf.ProgramId != nil {
-			confObj["programId"] = conf.ProgramId
-		}
-		if len(confObj) > 0 {
-			params = append(params, confObj)
-		}
-	}
-	defaultEncoding := solana.EncodingBase64
-	{
-		optsObj := M{}
-		if opts != nil {
-			if opts.Commitment != "" {
-				optsObj["commitment"] = opts.Commitment
-			}
-			if opts.Encoding != "" {
-				optsObj["encoding"] = opts.Encoding
-			} else {
-				optsObj["encoding"] = defaultEncoding
-			}
-			if opts.DataSlice != nil {
-				optsObj["dataSlice"] = M{
-					"offset": opts.DataSlice.Offset,
-					"length": opts.DataSlice.Length,
-				}
-				if opts.Encoding == solana.EncodingJSONParsed {
-					return nil, errors.New("cannot use dataSlice with EncodingJSONParsed")
-				}
-			}
-			if len(optsObj) > 0 {
-				params = append(params, optsObj)
-			}
-		} else {
-			params = append(params, M{"encoding": defaultEncoding})
-		}
+	params, err := getTokenAccountsParams(account, conf, opts)
+	if err != nil {
+		return nil, err
 	}
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getTokenAccountsByDelegate", params)
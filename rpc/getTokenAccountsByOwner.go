@@ -16,7 +16,6 @@ package rpc
 
 import (
 	"context"
-	"errors"
 
 	"github.com/gagliardetto/solana-go"
 )
@@ -28,53 +27,9 @@ func (cl *Client) GetTokenAccountsByOwner(
 	conf *GetTokenAccountsConfig,
 	opts *GetTokenAccountsOpts,
 ) (out *GetTokenAccountsResult, err error) {
-	params := []interface{}{owner}
-	if conf == nil {
-		return nil, errors.New("conf is nil")
-	}
-	if conf.Mint != nil && conf.ProgramId != nil {
-		return nil, errors.New("conf.Mint and conf.ProgramId are both set; must be just one of them")
-	}
-
-	{
-		confObj := M{}
-		if conf.Mint != nil {
-			confObj["mint"] = conf.Mint
-		}
-		if conf.ProgramId != nil {
-			confObj["programId"] = conf.ProgramId
-		}
-		if len(confObj) > 0 {
-			params = append(params, confObj)
-		}
-	}
-	defaultEncoding := solana.EncodingBase64
-	{
-		optsObj := M{}
-		if opts != nil {
-			if opts.Commitment != "" {
-				optsObj["commitment"] = opts.Commitment
-			}
-			if opts.Encoding != "" {
-				optsObj["encoding"] = opts.Encoding
-			} else {
-				optsObj["encoding"] = defaultEncoding
-			}
-			if opts.DataSlice != nil {
-				optsObj["dataSlice"] = M{
-					"offset": opts.DataSlice.Offset,
-					"length": opts.DataSlice.Length,
-				}
-				if opts.Encoding == solana.EncodingJSONParsed {
-					return nil, errors.New("cannot use dataSlice with EncodingJSONParsed")
-				}
-			}
-			if len(optsObj) > 0 {
-				params = append(params, optsObj)
-			}
-		} else {
-			params = append(params, M{"encoding": defaultEncoding})
-		}
+	params, err := getTokenAccountsParams(owner, conf, opts)
+	if err != nil {
+		return nil, err
 	}
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getTokenAccountsByOwner", params)
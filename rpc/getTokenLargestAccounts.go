@@ -27,11 +27,7 @@ func (cl *Client) GetTokenLargestAccounts(
 	commitment CommitmentType, // optional
 ) (out *GetTokenLargestAccountsResult, err error) {
 	params := []interface{}{tokenMint}
-	if commitment != "" {
-		params = append(params,
-			M{"commitment": commitment},
-		)
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getTokenLargestAccounts", params)
 	return
 }
@@ -44,3 +40,17 @@ type TokenLargestAccountsResult struct {
 	Address solana.PublicKey `json:"address"` // the address of the token account
 	UiTokenAmount
 }
+
+type tokenLargestAccountsResultJSON struct {
+	Address solana.PublicKey `json:"address"`
+	uiTokenAmountJSON
+}
+
+func (res *TokenLargestAccountsResult) UnmarshalJSON(data []byte) error {
+	var decoded tokenLargestAccountsResultJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	res.Address = decoded.Address
+	return res.UiTokenAmount.fromJSON(&decoded.uiTokenAmountJSON)
+}
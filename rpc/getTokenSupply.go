@@ -27,11 +27,7 @@ func (cl *Client) GetTokenSupply(
 	commitment CommitmentType, // optional
 ) (out *GetTokenSupplyResult, err error) {
 	params := []interface{}{tokenMint}
-	if commitment != "" {
-		params = append(params,
-			M{"commitment": commitment},
-		)
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getTokenSupply", params)
 	return
 }
@@ -33,43 +33,43 @@ type GetTransactionOpts struct {
 	MaxSupportedTransactionVersion *uint64
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetTransactionOpts) ToMap() M {
+	obj := M{}
+	if opts.Encoding != "" {
+		obj["encoding"] = opts.Encoding
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.MaxSupportedTransactionVersion != nil {
+		obj["maxSupportedTransactionVersion"] = *opts.MaxSupportedTransactionVersion
+	}
+	return obj
+}
+
+func (opts *GetTransactionOpts) validate(method string) error {
+	if err := ValidateCommitmentForMethod(method, opts.Commitment); err != nil {
+		return err
+	}
+	return validateTransactionEncoding(opts.Encoding)
+}
+
 // GetTransaction returns transaction details for a confirmed transaction.
 //
 // NEW: This method is only available in solana-core v1.7 or newer.
-// Please use `getConfirmedTransaction` for solana-core v1.6
+// On solana-core v1.6 nodes, the client falls back to `getConfirmedTransaction`
+// (see DetectCapabilities).
 func (cl *Client) GetTransaction(
 	ctx context.Context,
 	txSig solana.Signature, // transaction signature
 	opts *GetTransactionOpts,
 ) (out *GetTransactionResult, err error) {
-	params := []interface{}{txSig}
-	if opts != nil {
-		obj := M{}
-		if opts.Encoding != "" {
-			if !solana.IsAnyOfEncodingType(
-				opts.Encoding,
-				// Valid encodings:
-				// solana.EncodingJSON, // TODO
-				// solana.EncodingJSONParsed, // TODO
-				solana.EncodingBase58,
-				solana.EncodingBase64,
-				solana.EncodingBase64Zstd,
-			) {
-				return nil, fmt.Errorf("provided encoding is not supported: %s", opts.Encoding)
-			}
-			obj["encoding"] = opts.Encoding
-		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.MaxSupportedTransactionVersion != nil {
-			obj["maxSupportedTransactionVersion"] = *opts.MaxSupportedTransactionVersion
-		}
-		if len(obj) > 0 {
-			params = append(params, obj)
-		}
+	params, err := cl.getTransactionParams(txSig, opts)
+	if err != nil {
+		return nil, err
 	}
-	err = cl.rpcClient.CallForInto(ctx, &out, "getTransaction", params)
+	err = cl.callWithLegacyFallback(ctx, &out, "getTransaction", params)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +79,22 @@ func (cl *Client) GetTransaction(
 	return
 }
 
+func (cl *Client) getTransactionParams(txSig solana.Signature, opts *GetTransactionOpts) ([]interface{}, error) {
+	params := []interface{}{txSig}
+	obj := M{}
+	if opts != nil {
+		if err := opts.validate("getTransaction"); err != nil {
+			return nil, err
+		}
+		obj = opts.ToMap()
+	}
+	cl.applyMaxSupportedTransactionVersion(obj)
+	if len(obj) > 0 {
+		params = append(params, obj)
+	}
+	return params, nil
+}
+
 type GetTransactionResult struct {
 	// The slot this transaction was processed in.
 	Slot uint64 `json:"slot"`
@@ -24,11 +24,17 @@ func (cl *Client) GetTransactionCount(
 	commitment CommitmentType, // optional
 ) (out uint64, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params,
-			M{"commitment": commitment},
-		)
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
+	err = cl.rpcClient.CallForInto(ctx, &out, "getTransactionCount", params)
+	return
+}
+
+// GetTransactionCountWithOpts is like GetTransactionCount, with the minimum context slot.
+func (cl *Client) GetTransactionCountWithOpts(
+	ctx context.Context,
+	opts *ContextOpts,
+) (out uint64, err error) {
+	params := opts.appendTo([]interface{}{})
 	err = cl.rpcClient.CallForInto(ctx, &out, "getTransactionCount", params)
 	return
 }
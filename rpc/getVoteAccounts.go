@@ -36,6 +36,24 @@ type GetVoteAccountsOpts struct {
 	DelinquentSlotDistance *uint64 `json:"delinquentSlotDistance,omitempty"`
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetVoteAccountsOpts) ToMap() M {
+	obj := M{}
+	if opts.Commitment != "" {
+		obj["commitment"] = string(opts.Commitment)
+	}
+	if opts.VotePubkey != nil {
+		obj["votePubkey"] = opts.VotePubkey.String()
+	}
+	if opts.KeepUnstakedDelinquents != nil {
+		obj["keepUnstakedDelinquents"] = opts.KeepUnstakedDelinquents
+	}
+	if opts.DelinquentSlotDistance != nil {
+		obj["delinquentSlotDistance"] = opts.DelinquentSlotDistance
+	}
+	return obj
+}
+
 // GetVoteAccounts returns the account info and associated
 // stake for all the voting accounts in the current bank.
 func (cl *Client) GetVoteAccounts(
@@ -44,20 +62,7 @@ func (cl *Client) GetVoteAccounts(
 ) (out *GetVoteAccountsResult, err error) {
 	params := []interface{}{}
 	if opts != nil {
-		obj := M{}
-		if opts.Commitment != "" {
-			obj["commitment"] = string(opts.Commitment)
-		}
-		if opts.VotePubkey != nil {
-			obj["votePubkey"] = opts.VotePubkey.String()
-		}
-		if opts.KeepUnstakedDelinquents != nil {
-			obj["keepUnstakedDelinquents"] = opts.KeepUnstakedDelinquents
-		}
-		if opts.DelinquentSlotDistance != nil {
-			obj["delinquentSlotDistance"] = opts.DelinquentSlotDistance
-		}
-		if len(obj) > 0 {
+		if obj := opts.ToMap(); len(obj) > 0 {
 			params = append(params, obj)
 		}
 	}
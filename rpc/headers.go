@@ -0,0 +1,91 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"net/http"
+)
+
+// BearerAuthHeader returns the Authorization header of the bearer token,
+// to be used as default headers of a client (see HeadersMiddleware), or of
+// a WebSocket client (see ws.Options).
+func BearerAuthHeader(token string) http.Header {
+	return http.Header{"Authorization": {"Bearer " + token}}
+}
+
+type headersKey struct{}
+
+// WithHeaders returns a copy of the context holding the headers, added to
+// those already held by ctx, e.g. to set a request id:
+//
+//	ctx = rpc.WithHeaders(ctx, http.Header{"X-Request-Id": {id}})
+//
+// They are set on the requests made with the context by the clients created
+// by this package, overriding the default headers of the clients, and on the
+// handshake of the WebSocket connections (see ws.ConnectWithOptions).
+func WithHeaders(ctx context.Context, headers http.Header) context.Context {
+	merged := HeadersFromContext(ctx)
+	if merged == nil {
+		merged = make(http.Header, len(headers))
+	}
+	for key, values := range headers {
+		merged[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+	}
+	return context.WithValue(ctx, headersKey{}, merged)
+}
+
+// HeadersFromContext returns a copy of the headers held by the context
+// (see WithHeaders), nil if none.
+func HeadersFromContext(ctx context.Context) http.Header {
+	headers, ok := ctx.Value(headersKey{}).(http.Header)
+	if !ok {
+		return nil
+	}
+	return headers.Clone()
+}
+
+// contextHeadersKey returns the headers held by the context (see WithHeaders)
+// encoded as a suffix of the key of a request; empty if none.
+func contextHeadersKey(ctx context.Context) string {
+	headers, ok := ctx.Value(headersKey{}).(http.Header)
+	if !ok || len(headers) == 0 {
+		return ""
+	}
+	encoded, err := stdjson.Marshal(headers)
+	if err != nil {
+		return ""
+	}
+	return ":" + string(encoded)
+}
+
+// contextHeadersTransport sets the headers held by
+// the context of the requests (see WithHeaders).
+type contextHeadersTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *contextHeadersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	headers, ok := req.Context().Value(headersKey{}).(http.Header)
+	if !ok || len(headers) == 0 {
+		return t.transport.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	for key, values := range headers {
+		req.Header[key] = values
+	}
+	return t.transport.RoundTrip(req)
+}
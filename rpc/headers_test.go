@@ -0,0 +1,59 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithHeaders(t *testing.T) {
+	var received []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received = append(received, req.Header.Clone())
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+	}))
+	defer server.Close()
+
+	client := NewWithHeaders(server.URL, map[string]string{
+		"x-api-key":     "secret",
+		"Authorization": "Bearer default",
+	})
+
+	ctx := WithHeaders(context.Background(), http.Header{"x-request-id": {"first"}})
+	ctx = WithHeaders(ctx, BearerAuthHeader("token"))
+	_, err := client.GetSlot(ctx, "")
+	require.NoError(t, err)
+	_, err = client.GetSlot(WithHeaders(ctx, http.Header{"X-Request-Id": {"second"}}), "")
+	require.NoError(t, err)
+	_, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Len(t, received, 3)
+	require.Equal(t, "secret", received[0].Get("X-Api-Key"))
+	require.Equal(t, "Bearer token", received[0].Get("Authorization"))
+	require.Equal(t, "first", received[0].Get("X-Request-Id"))
+	require.Equal(t, "second", received[1].Get("X-Request-Id"))
+	require.Equal(t, "Bearer default", received[2].Get("Authorization"))
+	require.Empty(t, received[2].Get("X-Request-Id"))
+
+	// The headers of ctx are unchanged.
+	require.Equal(t, "first", HeadersFromContext(ctx).Get("X-Request-Id"))
+	require.Nil(t, HeadersFromContext(context.Background()))
+}
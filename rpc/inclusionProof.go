@@ -0,0 +1,209 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// BlockLink is a block of the chain of an InclusionProof.
+type BlockLink struct {
+	Slot              uint64      `json:"slot"`
+	ParentSlot        uint64      `json:"parentSlot"`
+	Blockhash         solana.Hash `json:"blockhash"`
+	PreviousBlockhash solana.Hash `json:"previousBlockhash"`
+}
+
+// InclusionProof records that a transaction is in a block, chained by its
+// blockhashes to a trusted block: each block of Chain is the parent of the next
+// one, and the last one is the trusted block.
+//
+// The proof checks the consistency of the responses of the nodes, not the
+// cryptographic commitment of the blockhash to the transactions (which requires
+// the entries of the block); cross-checking it with independent nodes (see
+// CrossCheck) detects a single node lying about the ledger.
+type InclusionProof struct {
+	Signature solana.Signature `json:"signature"`
+	// Index of the transaction in the block of the first link.
+	Index int `json:"index"`
+	// Signatures of the transactions of the block of the first link, in order.
+	BlockSignatures []solana.Signature `json:"blockSignatures"`
+	// Blocks from the block of the transaction to the trusted block.
+	Chain            []BlockLink `json:"chain"`
+	TrustedBlockhash solana.Hash `json:"trustedBlockhash"`
+}
+
+// InclusionProofOpts configures BuildInclusionProof.
+type InclusionProofOpts struct {
+	// Maximum number of blocks between the block of the transaction
+	// and the trusted block, inclusive. Defaults to 150.
+	MaxBlocks int
+	// Commitment of the blocks; defaults to finalized.
+	Commitment CommitmentType
+}
+
+// BuildInclusionProof builds the proof that the transaction is in a block
+// chained to the trusted block (e.g. a recent blockhash obtained from a
+// trusted node), which must not precede the block of the transaction.
+func (cl *Client) BuildInclusionProof(
+	ctx context.Context,
+	signature solana.Signature,
+	trustedSlot uint64,
+	trustedBlockhash solana.Hash,
+	opts *InclusionProofOpts,
+) (*InclusionProof, error) {
+	maxBlocks := 150
+	commitment := CommitmentFinalized
+	if opts != nil {
+		if opts.MaxBlocks > 0 {
+			maxBlocks = opts.MaxBlocks
+		}
+		if opts.Commitment != "" {
+			commitment = opts.Commitment
+		}
+	}
+
+	statuses, err := cl.GetSignatureStatuses(ctx, true, signature)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the status of the transaction: %w", err)
+	}
+	if len(statuses.Value) == 0 || statuses.Value[0] == nil {
+		return nil, ErrNotFound
+	}
+	slot := statuses.Value[0].Slot
+	if slot > trustedSlot {
+		return nil, fmt.Errorf("transaction in slot %d, after the trusted slot %d", slot, trustedSlot)
+	}
+
+	slots, err := cl.GetBlocks(ctx, slot, &trustedSlot, commitment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the blocks: %w", err)
+	}
+	if len(slots) > maxBlocks {
+		return nil, fmt.Errorf("%d blocks between the transaction and the trusted block, more than %d", len(slots), maxBlocks)
+	}
+	if len(slots) == 0 || slots[0] != slot || slots[len(slots)-1] != trustedSlot {
+		return nil, fmt.Errorf("blocks of slots %d and %d not available", slot, trustedSlot)
+	}
+
+	proof := &InclusionProof{
+		Signature:        signature,
+		Chain:            make([]BlockLink, len(slots)),
+		TrustedBlockhash: trustedBlockhash,
+	}
+	rewards := false
+	for i, s := range slots {
+		details := TransactionDetailsNone
+		if i == 0 {
+			details = TransactionDetailsSignatures
+		}
+		block, err := cl.GetBlockWithOpts(ctx, s, &GetBlockOpts{
+			TransactionDetails: details,
+			Rewards:            &rewards,
+			Commitment:         commitment,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the block of slot %d: %w", s, err)
+		}
+		proof.Chain[i] = BlockLink{
+			Slot:              s,
+			ParentSlot:        block.ParentSlot,
+			Blockhash:         block.Blockhash,
+			PreviousBlockhash: block.PreviousBlockhash,
+		}
+		if i == 0 {
+			proof.BlockSignatures = block.Signatures
+		}
+	}
+
+	proof.Index = -1
+	for i, sig := range proof.BlockSignatures {
+		if sig == signature {
+			proof.Index = i
+			break
+		}
+	}
+	if err := proof.Verify(); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// Verify checks the consistency of the proof: the transaction is at its index
+// in the first block, each block is the parent of the next one, and the last
+// block is the trusted one.
+func (proof *InclusionProof) Verify() error {
+	if len(proof.Chain) == 0 {
+		return errors.New("empty chain")
+	}
+	if proof.Index < 0 || proof.Index >= len(proof.BlockSignatures) || proof.BlockSignatures[proof.Index] != proof.Signature {
+		return fmt.Errorf("transaction %s not in the block of slot %d", proof.Signature, proof.Chain[0].Slot)
+	}
+	for i := 1; i < len(proof.Chain); i++ {
+		parent, child := proof.Chain[i-1], proof.Chain[i]
+		if child.ParentSlot != parent.Slot || child.PreviousBlockhash != parent.Blockhash {
+			return fmt.Errorf("block of slot %d is not the child of the block of slot %d", child.Slot, parent.Slot)
+		}
+	}
+	if last := proof.Chain[len(proof.Chain)-1]; last.Blockhash != proof.TrustedBlockhash {
+		return fmt.Errorf("block of slot %d is not the trusted block %s", last.Slot, proof.TrustedBlockhash)
+	}
+	return nil
+}
+
+// CrossCheck checks the proof against the blocks returned by the clients
+// (e.g. of independent providers): the blockhashes and the parents of the
+// blocks, and the signatures of the block of the transaction.
+func (proof *InclusionProof) CrossCheck(ctx context.Context, clients ...*Client) error {
+	if err := proof.Verify(); err != nil {
+		return err
+	}
+	rewards := false
+	for _, client := range clients {
+		for i, link := range proof.Chain {
+			details := TransactionDetailsNone
+			if i == 0 {
+				details = TransactionDetailsSignatures
+			}
+			block, err := client.GetBlockWithOpts(ctx, link.Slot, &GetBlockOpts{
+				TransactionDetails: details,
+				Rewards:            &rewards,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to get the block of slot %d: %w", link.Slot, err)
+			}
+			if block.Blockhash != link.Blockhash ||
+				block.PreviousBlockhash != link.PreviousBlockhash ||
+				block.ParentSlot != link.ParentSlot {
+				return fmt.Errorf("mismatch of the block of slot %d", link.Slot)
+			}
+			if i == 0 {
+				if len(block.Signatures) != len(proof.BlockSignatures) {
+					return fmt.Errorf("mismatch of the signatures of the block of slot %d", link.Slot)
+				}
+				for j := range block.Signatures {
+					if block.Signatures[j] != proof.BlockSignatures[j] {
+						return fmt.Errorf("mismatch of the signatures of the block of slot %d", link.Slot)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
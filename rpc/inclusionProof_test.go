@@ -0,0 +1,97 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func ledgerRPC(t *testing.T, blocks map[uint64]stdjson.RawMessage) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		var request struct {
+			ID     int                  `json:"id"`
+			Method string               `json:"method"`
+			Params []stdjson.RawMessage `json:"params"`
+		}
+		require.NoError(t, stdjson.Unmarshal(body, &request))
+
+		var result string
+		switch request.Method {
+		case "getSignatureStatuses":
+			result = `{"context":{"slot":20},"value":[{"slot":10,"confirmations":null,"err":null,"confirmationStatus":"finalized"}]}`
+		case "getBlocks":
+			result = `[10,11,13]`
+		case "getBlock":
+			var slot uint64
+			require.NoError(t, stdjson.Unmarshal(request.Params[0], &slot))
+			result = string(blocks[slot])
+		}
+		fmt.Fprintf(rw, `{"jsonrpc":"2.0","id":%d,"result":%s}`, request.ID, result)
+	}))
+}
+
+func testLedger(tamper bool) map[uint64]stdjson.RawMessage {
+	hash := func(b byte) string { return solana.Hash{b}.String() }
+	sig := func(b byte) string { return solana.Signature{b}.String() }
+	prev := hash(11)
+	if tamper {
+		prev = hash(99)
+	}
+	return map[uint64]stdjson.RawMessage{
+		10: stdjson.RawMessage(fmt.Sprintf(`{"blockhash":%q,"previousBlockhash":%q,"parentSlot":9,"signatures":[%q,%q]}`, hash(10), hash(9), sig(1), sig(2))),
+		11: stdjson.RawMessage(fmt.Sprintf(`{"blockhash":%q,"previousBlockhash":%q,"parentSlot":10}`, hash(11), hash(10))),
+		13: stdjson.RawMessage(fmt.Sprintf(`{"blockhash":%q,"previousBlockhash":%q,"parentSlot":11}`, hash(13), prev)),
+	}
+}
+
+func TestClient_BuildInclusionProof(t *testing.T) {
+	server := ledgerRPC(t, testLedger(false))
+	defer server.Close()
+	client := New(server.URL)
+
+	proof, err := client.BuildInclusionProof(context.Background(), solana.Signature{2}, 13, solana.Hash{13}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, proof.Index)
+	require.Len(t, proof.Chain, 3)
+	require.Equal(t, BlockLink{Slot: 11, ParentSlot: 10, Blockhash: solana.Hash{11}, PreviousBlockhash: solana.Hash{10}}, proof.Chain[1])
+	require.NoError(t, proof.Verify())
+	require.NoError(t, proof.CrossCheck(context.Background(), client))
+
+	_, err = client.BuildInclusionProof(context.Background(), solana.Signature{2}, 13, solana.Hash{14}, nil)
+	require.Error(t, err)
+	_, err = client.BuildInclusionProof(context.Background(), solana.Signature{2}, 13, solana.Hash{13}, &InclusionProofOpts{MaxBlocks: 2})
+	require.Error(t, err)
+
+	tampered := *proof
+	tampered.Signature = solana.Signature{3}
+	require.Error(t, tampered.Verify())
+
+	other := ledgerRPC(t, testLedger(true))
+	defer other.Close()
+	_, err = New(other.URL).BuildInclusionProof(context.Background(), solana.Signature{2}, 13, solana.Hash{13}, nil)
+	require.Error(t, err)
+	require.Error(t, proof.CrossCheck(context.Background(), New(other.URL)))
+}
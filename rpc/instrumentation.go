@@ -0,0 +1,228 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// CallStatus is the outcome of a RPC call.
+type CallStatus string
+
+const (
+	CallStatusOK CallStatus = "ok"
+	// The node returned a JSON-RPC error.
+	CallStatusRPCError CallStatus = "rpc_error"
+	// The node returned an HTTP error status.
+	CallStatusHTTPError CallStatus = "http_error"
+	// The context of the call was canceled, or its deadline exceeded.
+	CallStatusCanceled CallStatus = "canceled"
+	// Any other error (connection, decoding, ...).
+	CallStatusError CallStatus = "error"
+)
+
+// CallInfo describes a finished RPC call.
+type CallInfo struct {
+	Method   string
+	Duration time.Duration
+	Status   CallStatus
+	Err      error
+	// Size in bytes of the (decompressed) response body; -1 if unknown, i.e. if
+	// the client was not created by this package, or for the requests of a batch.
+	ResponseSize int64
+	// True if the call was a request of a batch; all the requests of
+	// a batch have the duration of the batch.
+	Batch bool
+}
+
+// Instrumentation observes the RPC calls of a client, e.g. to export metrics.
+// ObserveCall is called after each call, and must be safe for concurrent use.
+type Instrumentation interface {
+	ObserveCall(call *CallInfo)
+}
+
+// InstrumentationFunc is a function implementing the Instrumentation interface.
+type InstrumentationFunc func(call *CallInfo)
+
+func (f InstrumentationFunc) ObserveCall(call *CallInfo) {
+	f(call)
+}
+
+// SetInstrumentation sets the instrumentation observing the calls of the client;
+// nil removes it. It must be called before the client is used.
+func (cl *Client) SetInstrumentation(instrumentation Instrumentation) *Client {
+	if instrumented, ok := cl.rpcClient.(*instrumentedClient); ok {
+		cl.rpcClient = instrumented.rpcClient
+	}
+	if instrumentation != nil {
+		cl.rpcClient = &instrumentedClient{
+			rpcClient:       cl.rpcClient,
+			instrumentation: instrumentation,
+		}
+	}
+	return cl
+}
+
+type instrumentedClient struct {
+	rpcClient       JSONRPCClient
+	instrumentation Instrumentation
+}
+
+func (ic *instrumentedClient) observe(ctx context.Context, method string, start time.Time, size int64, err error) {
+	ic.instrumentation.ObserveCall(&CallInfo{
+		Method:       method,
+		Duration:     time.Since(start),
+		Status:       callStatus(ctx, err),
+		Err:          err,
+		ResponseSize: size,
+	})
+}
+
+func (ic *instrumentedClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	ctx, size := withResponseSize(ctx)
+	start := time.Now()
+	err := ic.rpcClient.CallForInto(ctx, out, method, params)
+	ic.observe(ctx, method, start, size.get(), err)
+	return err
+}
+
+func (ic *instrumentedClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	ctx, size := withResponseSize(ctx)
+	start := time.Now()
+	err := ic.rpcClient.CallWithCallback(ctx, method, params, callback)
+	ic.observe(ctx, method, start, size.get(), err)
+	return err
+}
+
+func (ic *instrumentedClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	batchClient, ok := ic.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return nil, ErrBatchNotSupported
+	}
+	start := time.Now()
+	responses, err := batchClient.CallBatch(ctx, requests)
+	duration := time.Since(start)
+
+	byID := make(map[int]*jsonrpc.RPCResponse, len(responses))
+	for _, response := range responses {
+		if response != nil {
+			byID[response.ID] = response
+		}
+	}
+	for _, request := range requests {
+		reqErr := err
+		if reqErr == nil {
+			if response, ok := byID[request.ID]; ok && response.Error != nil {
+				reqErr = response.Error
+			}
+		}
+		ic.instrumentation.ObserveCall(&CallInfo{
+			Method:       request.Method,
+			Duration:     duration,
+			Status:       callStatus(ctx, reqErr),
+			Err:          reqErr,
+			ResponseSize: -1,
+			Batch:        true,
+		})
+	}
+	return responses, err
+}
+
+func (ic *instrumentedClient) Close() error {
+	if c, ok := ic.rpcClient.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func callStatus(ctx context.Context, err error) CallStatus {
+	if err == nil {
+		return CallStatusOK
+	}
+	var rpcErr *jsonrpc.RPCError
+	if errors.As(err, &rpcErr) {
+		return CallStatusRPCError
+	}
+	var httpErr *jsonrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return CallStatusHTTPError
+	}
+	if ctx.Err() != nil {
+		return CallStatusCanceled
+	}
+	return CallStatusError
+}
+
+type responseSizeKey struct{}
+
+// responseSize counts the bytes of the response bodies read by the
+// transport of the clients created by this package; -1 if not counted.
+type responseSize struct {
+	counted int32
+	bytes   int64
+}
+
+func withResponseSize(ctx context.Context) (context.Context, *responseSize) {
+	size := new(responseSize)
+	return context.WithValue(ctx, responseSizeKey{}, size), size
+}
+
+func (size *responseSize) get() int64 {
+	if atomic.LoadInt32(&size.counted) == 0 {
+		return -1
+	}
+	return atomic.LoadInt64(&size.bytes)
+}
+
+// responseSizeTransport counts the bytes of the response bodies
+// for the requests whose context holds a responseSize.
+type responseSizeTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *responseSizeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if size, ok := req.Context().Value(responseSizeKey{}).(*responseSize); ok {
+		atomic.StoreInt32(&size.counted, 1)
+		resp.Body = &countingReadCloser{ReadCloser: resp.Body, size: size}
+	}
+	return resp, nil
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	size *responseSize
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	atomic.AddInt64(&r.size.bytes, int64(n))
+	return n, err
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetInstrumentation(t *testing.T) {
+	body := `{"jsonrpc":"2.0","result":42,"id":0}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(body))
+	defer closer()
+
+	var calls []*CallInfo
+	client := New(server.URL).SetInstrumentation(InstrumentationFunc(func(call *CallInfo) {
+		calls = append(calls, call)
+	}))
+	// Replaces the previous instrumentation.
+	client.SetInstrumentation(InstrumentationFunc(func(call *CallInfo) {
+		calls = append(calls, call)
+	}))
+
+	_, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, calls, 1)
+	require.Equal(t, "getSlot", calls[0].Method)
+	require.Equal(t, CallStatusOK, calls[0].Status)
+	require.Equal(t, int64(len(body)), calls[0].ResponseSize)
+	require.NotZero(t, calls[0].Duration)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GetSlot(ctx, "")
+	require.Error(t, err)
+	require.Equal(t, CallStatusCanceled, calls[1].Status)
+
+	client.SetInstrumentation(nil)
+	_, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+}
+
+func TestClient_SetInstrumentation_Errors(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":0}`))
+	defer closer()
+
+	var calls []*CallInfo
+	client := NewWithCustomRPCClient(&sequentialRPC{}).SetInstrumentation(InstrumentationFunc(func(call *CallInfo) {
+		calls = append(calls, call)
+	}))
+	_, err := client.GetBlockHeight(context.Background(), "")
+	require.Error(t, err)
+	require.Equal(t, CallStatusError, calls[0].Status)
+	// Not counted by custom clients.
+	require.Equal(t, int64(-1), calls[0].ResponseSize)
+
+	client = New(server.URL).SetInstrumentation(InstrumentationFunc(func(call *CallInfo) {
+		calls = append(calls, call)
+	}))
+	_, err = client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Equal(t, CallStatusRPCError, calls[1].Status)
+
+	// Batches are observed per request.
+	batch := client.NewBatch()
+	batch.GetBalance(solana.PublicKey{}, "")
+	batch.GetBalance(solana.PublicKey{}, "")
+	batch.Send(context.Background())
+	require.Len(t, calls, 4)
+	require.Equal(t, "getBalance", calls[3].Method)
+	require.True(t, calls[3].Batch)
+	require.Equal(t, int64(-1), calls[3].ResponseSize)
+}
@@ -6,7 +6,10 @@ import (
 	"github.com/gagliardetto/solana-go"
 )
 
-// Returns whether a blockhash is still valid or not
+// Returns whether a blockhash is still valid or not,
+// e.g. to check that a cached blockhash is still usable before
+// re-signing and resubmitting a transaction, rather than waiting
+// for the "blockhash not found" error of the send.
 //
 // **NEW: This method is only available in solana-core v1.9 or newer. Please use
 // `getFeeCalculatorForBlockhash` for solana-core v1.8**
@@ -19,10 +22,19 @@ func (cl *Client) IsBlockhashValid(
 	commitment CommitmentType,
 ) (out *IsValidBlockhashResult, err error) {
 	params := []interface{}{blockHash}
-	if commitment != "" {
-		params = append(params, M{"commitment": string(commitment)})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "isBlockhashValid", params)
 	return
 }
+
+// IsBlockhashValidWithOpts is like IsBlockhashValid, with the minimum context slot.
+func (cl *Client) IsBlockhashValidWithOpts(
+	ctx context.Context,
+	blockHash solana.Hash,
+	opts *ContextOpts,
+) (out *IsValidBlockhashResult, err error) {
+	params := opts.appendTo([]interface{}{blockHash})
+	err = cl.rpcClient.CallForInto(ctx, &out, "isBlockhashValid", params)
+	return
+}
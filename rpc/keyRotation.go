@@ -0,0 +1,257 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrAPIKeysExhausted is returned by the transport of a KeyRotator
+// when all of its keys have exhausted their budget.
+var ErrAPIKeysExhausted = errors.New("rpc: all api keys exhausted their budget")
+
+// ErrAPIKeysRateLimited is returned by the transport of a KeyRotator when
+// all of its keys with budget left are rate limited by the provider.
+var ErrAPIKeysRateLimited = errors.New("rpc: all api keys are rate limited")
+
+// DefaultKeyRateLimitCooldown is the default time during which a KeyRotator
+// skips a key rate limited by the provider.
+const DefaultKeyRateLimitCooldown = time.Second
+
+// APIKey is an API key of a provider, and its request budget.
+type APIKey struct {
+	Key string
+	// Number of requests the key is allowed per period;
+	// zero means unlimited.
+	Budget int64
+}
+
+type KeyRotatorOpts struct {
+	// Period after which the budgets of the keys are replenished,
+	// e.g. 24 hours for a daily quota; zero means never.
+	Period time.Duration
+
+	// Sets the key on the request.
+	// Defaults to setting it as bearer token of the Authorization header
+	// (see BearerAuthHeader); providers expecting the key in the URL
+	// can set it as query parameter instead.
+	Apply func(req *http.Request, key string)
+
+	// Fraction of the budget left at which OnLowBudget is called.
+	// Defaults to 0.1.
+	LowBudgetThreshold float64
+	// Called once per period when the budget left of a key
+	// falls to LowBudgetThreshold.
+	OnLowBudget func(key string, remaining int64)
+	// Called once per period when a key exhausts its budget.
+	OnExhausted func(key string)
+
+	// Time during which a key rate limited by the provider (HTTP 429) is
+	// skipped, unless the response has a Retry-After header.
+	// Defaults to DefaultKeyRateLimitCooldown.
+	RateLimitCooldown time.Duration
+	// Called when a key is rate limited by the provider,
+	// with the time during which it is skipped.
+	OnRateLimited func(key string, cooldown time.Duration)
+}
+
+// KeyRotator cycles through the API keys of a provider, counting
+// the requests made with each of them against their budget: each request
+// uses the next key with budget left, so that the load is spread
+// across the keys and exhausted keys are skipped until the next period.
+// A key rate limited by the provider (HTTP 429) is skipped for a while
+// (as requested by the Retry-After header, or RateLimitCooldown),
+// keeping its budget.
+//
+// A batch is a single request. Use one KeyRotator per provider:
+//
+//	rotator := rpc.NewKeyRotator(keys, &rpc.KeyRotatorOpts{Period: 24 * time.Hour})
+//	client := rpc.NewWithMiddlewares(endpoint, rotator.Middleware())
+type KeyRotator struct {
+	opts KeyRotatorOpts
+	now  func() time.Time
+
+	mu          sync.Mutex
+	keys        []*rotatedKey
+	next        int
+	periodStart time.Time
+}
+
+type rotatedKey struct {
+	APIKey
+	used      int64
+	exhausted bool
+	warned    bool
+	// The key is skipped until then, being rate limited by the provider.
+	coolUntil time.Time
+}
+
+// NewKeyRotator creates a rotator over the keys.
+func NewKeyRotator(keys []APIKey, opts *KeyRotatorOpts) *KeyRotator {
+	r := &KeyRotator{
+		now: time.Now,
+	}
+	if opts != nil {
+		r.opts = *opts
+	}
+	if r.opts.Apply == nil {
+		r.opts.Apply = func(req *http.Request, key string) {
+			req.Header.Set("Authorization", "Bearer "+key)
+		}
+	}
+	if r.opts.LowBudgetThreshold <= 0 {
+		r.opts.LowBudgetThreshold = 0.1
+	}
+	if r.opts.RateLimitCooldown <= 0 {
+		r.opts.RateLimitCooldown = DefaultKeyRateLimitCooldown
+	}
+	for _, key := range keys {
+		r.keys = append(r.keys, &rotatedKey{APIKey: key})
+	}
+	r.periodStart = r.now()
+	return r
+}
+
+// Remaining returns the number of requests left in the current period
+// for the key, -1 if its budget is unlimited, 0 if it is unknown.
+func (r *KeyRotator) Remaining(key string) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.replenishLocked()
+	for _, k := range r.keys {
+		if k.Key != key {
+			continue
+		}
+		if k.exhausted {
+			return 0
+		}
+		if k.Budget <= 0 {
+			return -1
+		}
+		return k.Budget - k.used
+	}
+	return 0
+}
+
+// Reset replenishes the budgets of all keys, starting a new period.
+func (r *KeyRotator) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resetLocked()
+}
+
+func (r *KeyRotator) resetLocked() {
+	r.periodStart = r.now()
+	for _, k := range r.keys {
+		k.used = 0
+		k.exhausted = false
+		k.warned = false
+		k.coolUntil = time.Time{}
+	}
+}
+
+func (r *KeyRotator) replenishLocked() {
+	if r.opts.Period > 0 && r.now().Sub(r.periodStart) >= r.opts.Period {
+		r.resetLocked()
+	}
+}
+
+// acquire picks the next key with budget left and counts a request against it.
+func (r *KeyRotator) acquire() (*rotatedKey, error) {
+	r.mu.Lock()
+	r.replenishLocked()
+	now := r.now()
+	var picked *rotatedKey
+	var cooling bool
+	for i := 0; i < len(r.keys); i++ {
+		k := r.keys[(r.next+i)%len(r.keys)]
+		if k.exhausted {
+			continue
+		}
+		if now.Before(k.coolUntil) {
+			cooling = true
+			continue
+		}
+		picked = k
+		r.next = (r.next + i + 1) % len(r.keys)
+		break
+	}
+	if picked == nil {
+		r.mu.Unlock()
+		if cooling {
+			return nil, ErrAPIKeysRateLimited
+		}
+		return nil, ErrAPIKeysExhausted
+	}
+	picked.used++
+	var onLow, onExhausted bool
+	var remaining int64
+	if picked.Budget > 0 {
+		remaining = picked.Budget - picked.used
+		if remaining <= 0 {
+			picked.exhausted = true
+			onExhausted = true
+		} else if !picked.warned && float64(remaining) <= float64(picked.Budget)*r.opts.LowBudgetThreshold {
+			picked.warned = true
+			onLow = true
+		}
+	}
+	r.mu.Unlock()
+
+	if onLow && r.opts.OnLowBudget != nil {
+		r.opts.OnLowBudget(picked.Key, remaining)
+	}
+	if onExhausted && r.opts.OnExhausted != nil {
+		r.opts.OnExhausted(picked.Key)
+	}
+	return picked, nil
+}
+
+// rateLimited skips the key for the cooldown
+// (the Retry-After delay of the response, if any).
+func (r *KeyRotator) rateLimited(key *rotatedKey, resp *http.Response) {
+	cooldown, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+	if !ok {
+		cooldown = r.opts.RateLimitCooldown
+	}
+	r.mu.Lock()
+	key.coolUntil = r.now().Add(cooldown)
+	r.mu.Unlock()
+	if r.opts.OnRateLimited != nil {
+		r.opts.OnRateLimited(key.Key, cooldown)
+	}
+}
+
+// Middleware returns a middleware setting the next key on each request.
+func (r *KeyRotator) Middleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			key, err := r.acquire()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			r.opts.Apply(req, key.Key)
+			resp, err := next.RoundTrip(req)
+			if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+				r.rateLimited(key, resp)
+			}
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRotator(t *testing.T) {
+	var used []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		key := req.URL.Query().Get("api-key")
+		used = append(used, key)
+		if key == "limited" {
+			rw.Header().Set("Retry-After", "5")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+	}))
+	defer server.Close()
+
+	var low []string
+	var exhausted []string
+	var limited []time.Duration
+	rotator := NewKeyRotator(
+		[]APIKey{
+			{Key: "a", Budget: 10},
+			{Key: "b", Budget: 2},
+			{Key: "limited"},
+		},
+		&KeyRotatorOpts{
+			Period: time.Hour,
+			Apply: func(req *http.Request, key string) {
+				query := req.URL.Query()
+				query.Set("api-key", key)
+				req.URL.RawQuery = query.Encode()
+			},
+			LowBudgetThreshold: 0.5,
+			OnLowBudget: func(key string, remaining int64) {
+				low = append(low, key)
+			},
+			OnExhausted: func(key string) {
+				exhausted = append(exhausted, key)
+			},
+			OnRateLimited: func(key string, cooldown time.Duration) {
+				require.Equal(t, "limited", key)
+				limited = append(limited, cooldown)
+			},
+		},
+	)
+	now := time.Unix(0, 0)
+	rotator.now = func() time.Time { return now }
+	rotator.Reset()
+	client := NewWithMiddlewares(server.URL, rotator.Middleware())
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetSlot(context.Background(), "")
+		if i == 2 {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	require.Equal(t, []string{"a", "b", "limited"}, used)
+	require.Equal(t, []string{"b"}, low)
+	require.Empty(t, exhausted)
+	// The rate limited key is skipped for the Retry-After delay.
+	require.Equal(t, []time.Duration{5 * time.Second}, limited)
+
+	used = nil
+	for i := 0; i < 3; i++ {
+		_, err := client.GetSlot(context.Background(), "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"a", "b", "a"}, used)
+	require.Equal(t, []string{"b"}, exhausted)
+	require.Equal(t, int64(7), rotator.Remaining("a"))
+	require.Equal(t, int64(0), rotator.Remaining("b"))
+	require.Equal(t, int64(-1), rotator.Remaining("limited"))
+
+	for i := 0; i < 7; i++ {
+		_, err := client.GetSlot(context.Background(), "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"b", "a", "b", "a"}, append(low, exhausted...))
+	_, err := client.GetSlot(context.Background(), "")
+	require.ErrorIs(t, err, ErrAPIKeysRateLimited)
+
+	// The cooldown is over: the key keeps its (unlimited) budget.
+	now = now.Add(5 * time.Second)
+	used = nil
+	_, err = client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Equal(t, []string{"limited"}, used)
+	require.Len(t, limited, 2)
+
+	now = now.Add(time.Hour)
+	require.Equal(t, int64(10), rotator.Remaining("a"))
+	require.Equal(t, int64(-1), rotator.Remaining("limited"))
+	used = nil
+	_, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, used)
+}
+
+func TestKeyRotator_AllExhausted(t *testing.T) {
+	rotator := NewKeyRotator([]APIKey{{Key: "a", Budget: 1}}, nil)
+	_, err := rotator.acquire()
+	require.NoError(t, err)
+	_, err = rotator.acquire()
+	require.ErrorIs(t, err, ErrAPIKeysExhausted)
+}
@@ -0,0 +1,285 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// maxSignatureStatuses is the maximum number of signatures
+// of a single getSignatureStatuses request.
+const maxSignatureStatuses = 256
+
+// SubmittedTransaction is a transaction sent to the cluster.
+type SubmittedTransaction struct {
+	Signature solana.Signature
+
+	// The slot at which the transaction was sent
+	// (e.g. from GetSlot with "processed" commitment).
+	SentSlot uint64
+}
+
+// AnalyzeLandingOpts are the options of AnalyzeLanding.
+type AnalyzeLandingOpts struct {
+	// Commitment of the getBlock requests; "processed" is not supported.
+	// If not provided, the default is "confirmed".
+	Commitment CommitmentType
+
+	// If true, the landing blocks are not fetched,
+	// and the BlockFees of the transactions are not set.
+	SkipBlocks bool
+}
+
+// BlockFees are the compute unit prices (in micro-lamports)
+// of the non-vote transactions of a block, excluding the analyzed ones.
+type BlockFees struct {
+	Slot uint64
+
+	// The number of competing (i.e. non-vote) transactions,
+	// and how many of them set a compute unit price.
+	NumTransactions int
+	NumPrioritized  int
+	MinPrice        uint64
+	MedianPrice     uint64
+	P75Price        uint64
+	P90Price        uint64
+	MaxPrice        uint64
+	sortedPrices    []uint64
+}
+
+// Rank returns the fraction (from 0 to 1) of the competing
+// transactions that paid a lower compute unit price.
+func (fees *BlockFees) Rank(price uint64) float64 {
+	if len(fees.sortedPrices) == 0 {
+		return 1
+	}
+	lower := sort.Search(len(fees.sortedPrices), func(i int) bool {
+		return fees.sortedPrices[i] >= price
+	})
+	return float64(lower) / float64(len(fees.sortedPrices))
+}
+
+// TransactionLanding is the outcome of a submitted transaction.
+type TransactionLanding struct {
+	SubmittedTransaction
+
+	// Whether the transaction was included in a block (even if it failed).
+	Landed bool
+
+	// The slot of the landing block, and the number of slots
+	// between the submission and the landing.
+	Slot        uint64
+	SlotsToLand uint64
+
+	// The error of the transaction, if it landed but failed.
+	Err interface{}
+
+	// The compute unit price of the transaction, in micro-lamports;
+	// only set if the landing block was fetched.
+	ComputeUnitPrice uint64
+
+	// The fees of the competing transactions of the landing block;
+	// nil if the transaction did not land, or the block was not fetched.
+	BlockFees *BlockFees
+}
+
+// LandingReport is the result of AnalyzeLanding.
+type LandingReport struct {
+	Transactions []*TransactionLanding
+
+	Submitted int
+	Landed    int
+	Failed    int
+
+	// Landed / Submitted.
+	LandingRate float64
+
+	// Statistics of the slots-to-land of the landed transactions.
+	MinSlotsToLand    uint64
+	MedianSlotsToLand uint64
+	MaxSlotsToLand    uint64
+}
+
+// AnalyzeLanding reports which of the submitted transactions landed, how many
+// slots they took to land, and how their compute unit price compares to the
+// ones of the competing transactions of the landing block, to help tune
+// the priority fees empirically.
+// The transactions not found in the ledger are considered dropped; call it once
+// the blockhashes of the transactions have expired, to not count as dropped
+// the transactions that could still land.
+func (cl *Client) AnalyzeLanding(
+	ctx context.Context,
+	submitted []SubmittedTransaction,
+	opts *AnalyzeLandingOpts,
+) (*LandingReport, error) {
+	if opts == nil {
+		opts = &AnalyzeLandingOpts{}
+	}
+	commitment := opts.Commitment
+	if commitment == "" {
+		commitment = CommitmentConfirmed
+	}
+
+	report := &LandingReport{
+		Transactions: make([]*TransactionLanding, len(submitted)),
+		Submitted:    len(submitted),
+	}
+	ours := make(map[solana.Signature]*TransactionLanding, len(submitted))
+	bySlot := make(map[uint64][]*TransactionLanding)
+	var slotsToLand []uint64
+	for start := 0; start < len(submitted); start += maxSignatureStatuses {
+		end := start + maxSignatureStatuses
+		if end > len(submitted) {
+			end = len(submitted)
+		}
+		sigs := make([]solana.Signature, end-start)
+		for i := range sigs {
+			sigs[i] = submitted[start+i].Signature
+		}
+		statuses, err := cl.GetSignatureStatuses(ctx, true, sigs...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get signature statuses: %w", err)
+		}
+		if len(statuses.Value) != len(sigs) {
+			return nil, fmt.Errorf("expected %d signature statuses, got %d", len(sigs), len(statuses.Value))
+		}
+		for i, status := range statuses.Value {
+			landing := &TransactionLanding{SubmittedTransaction: submitted[start+i]}
+			report.Transactions[start+i] = landing
+			ours[landing.Signature] = landing
+			if status == nil {
+				continue
+			}
+			landing.Landed = true
+			landing.Slot = status.Slot
+			landing.Err = status.Err
+			if status.Slot > landing.SentSlot {
+				landing.SlotsToLand = status.Slot - landing.SentSlot
+			}
+			report.Landed++
+			if status.Err != nil {
+				report.Failed++
+			}
+			slotsToLand = append(slotsToLand, landing.SlotsToLand)
+			bySlot[status.Slot] = append(bySlot[status.Slot], landing)
+		}
+	}
+	if report.Submitted > 0 {
+		report.LandingRate = float64(report.Landed) / float64(report.Submitted)
+	}
+	if len(slotsToLand) > 0 {
+		sortUint64s(slotsToLand)
+		report.MinSlotsToLand = slotsToLand[0]
+		report.MedianSlotsToLand = percentile(slotsToLand, 50)
+		report.MaxSlotsToLand = slotsToLand[len(slotsToLand)-1]
+	}
+	if opts.SkipBlocks {
+		return report, nil
+	}
+
+	version := uint64(0)
+	rewards := false
+	for slot, landings := range bySlot {
+		block, err := cl.GetBlockWithOpts(ctx, slot, &GetBlockOpts{
+			Encoding:                       solana.EncodingBase64,
+			TransactionDetails:             TransactionDetailsFull,
+			Rewards:                        &rewards,
+			Commitment:                     commitment,
+			MaxSupportedTransactionVersion: &version,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get block %d: %w", slot, err)
+		}
+		fees := &BlockFees{Slot: slot}
+		for i := range block.Transactions {
+			tx, err := block.Transactions[i].GetTransaction()
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode transaction %d of block %d: %w", i, slot, err)
+			}
+			if len(tx.Signatures) == 0 || isVoteTransaction(&tx.Message) {
+				continue
+			}
+			price := computeUnitPrice(&tx.Message)
+			if landing, ok := ours[tx.Signatures[0]]; ok {
+				landing.ComputeUnitPrice = price
+				continue
+			}
+			fees.NumTransactions++
+			if price > 0 {
+				fees.NumPrioritized++
+			}
+			fees.sortedPrices = append(fees.sortedPrices, price)
+		}
+		if len(fees.sortedPrices) > 0 {
+			sortUint64s(fees.sortedPrices)
+			fees.MinPrice = fees.sortedPrices[0]
+			fees.MedianPrice = percentile(fees.sortedPrices, 50)
+			fees.P75Price = percentile(fees.sortedPrices, 75)
+			fees.P90Price = percentile(fees.sortedPrices, 90)
+			fees.MaxPrice = fees.sortedPrices[len(fees.sortedPrices)-1]
+		}
+		for _, landing := range landings {
+			landing.BlockFees = fees
+		}
+	}
+	return report, nil
+}
+
+func isVoteTransaction(msg *solana.Message) bool {
+	for _, inst := range msg.Instructions {
+		programID, err := msg.Program(inst.ProgramIDIndex)
+		if err == nil && programID.Equals(solana.VoteProgramID) {
+			return true
+		}
+	}
+	return false
+}
+
+// computeUnitPrice returns the compute unit price set by the
+// SetComputeUnitPrice instruction of the message, or zero.
+func computeUnitPrice(msg *solana.Message) uint64 {
+	for _, inst := range msg.Instructions {
+		programID, err := msg.Program(inst.ProgramIDIndex)
+		if err != nil || !programID.Equals(solana.ComputeBudget) {
+			continue
+		}
+		// SetComputeUnitPrice: discriminator 3, followed by the price as u64.
+		if len(inst.Data) == 9 && inst.Data[0] == 3 {
+			return binary.LittleEndian.Uint64(inst.Data[1:])
+		}
+	}
+	return 0
+}
+
+func sortUint64s(values []uint64) {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+}
+
+// percentile returns the nearest-rank percentile of the sorted values.
+func percentile(sorted []uint64, p int) uint64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,141 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+// landingNode is a JSONRPCClient that serves fixed signature statuses and blocks.
+type landingNode struct {
+	statuses map[solana.Signature]string
+	blocks   map[uint64]string
+}
+
+func (node *landingNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	switch method {
+	case "getSignatureStatuses":
+		var values []string
+		for _, sig := range params[0].([]solana.Signature) {
+			status, ok := node.statuses[sig]
+			if !ok {
+				status = "null"
+			}
+			values = append(values, status)
+		}
+		return stdjson.Unmarshal([]byte(`{"context":{"slot":200},"value":[`+strings.Join(values, ",")+`]}`), out)
+	case "getBlock":
+		return stdjson.Unmarshal([]byte(node.blocks[params[0].(uint64)]), out)
+	}
+	return fmt.Errorf("unexpected method %s", method)
+}
+
+func (node *landingNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func landingTestTransaction(t *testing.T, sig solana.Signature, program solana.PublicKey, price uint64) string {
+	keys := []solana.PublicKey{{byte(sig[0])}, program}
+	instructions := []solana.CompiledInstruction{{ProgramIDIndex: 1}}
+	if price > 0 {
+		data := make([]byte, 9)
+		data[0] = 3
+		binary.LittleEndian.PutUint64(data[1:], price)
+		keys = append(keys, solana.ComputeBudget)
+		instructions = append(instructions, solana.CompiledInstruction{ProgramIDIndex: 2, Data: data})
+	}
+	tx := solana.Transaction{
+		Signatures: []solana.Signature{sig},
+		Message: solana.Message{
+			Header: solana.MessageHeader{
+				NumRequiredSignatures:       1,
+				NumReadonlyUnsignedAccounts: uint8(len(keys) - 1),
+			},
+			AccountKeys:  keys,
+			Instructions: instructions,
+		},
+	}
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return fmt.Sprintf(`{"transaction":[%q,"base64"],"meta":{"err":null,"fee":5000}}`, base64.StdEncoding.EncodeToString(raw))
+}
+
+func TestAnalyzeLanding(t *testing.T) {
+	landed := solana.Signature{1}
+	failed := solana.Signature{2}
+	dropped := solana.Signature{3}
+
+	var txs []string
+	txs = append(txs, landingTestTransaction(t, landed, solana.MemoProgramID, 500))
+	txs = append(txs, landingTestTransaction(t, solana.Signature{10}, solana.VoteProgramID, 0))
+	for i, price := range []uint64{0, 100, 200, 1000} {
+		txs = append(txs, landingTestTransaction(t, solana.Signature{byte(20 + i)}, solana.MemoProgramID, price))
+	}
+
+	node := &landingNode{
+		statuses: map[solana.Signature]string{
+			landed: `{"slot":105,"confirmations":null,"err":null,"confirmationStatus":"finalized"}`,
+			failed: `{"slot":102,"confirmations":null,"err":{"InstructionError":[0,"Custom"]},"confirmationStatus":"finalized"}`,
+		},
+		blocks: map[uint64]string{
+			105: `{"blockhash":"11111111111111111111111111111111","previousBlockhash":"11111111111111111111111111111111","parentSlot":104,"transactions":[` + strings.Join(txs, ",") + `]}`,
+			102: `{"blockhash":"11111111111111111111111111111111","previousBlockhash":"11111111111111111111111111111111","parentSlot":101,"transactions":[]}`,
+		},
+	}
+	client := NewWithCustomRPCClient(node)
+
+	report, err := client.AnalyzeLanding(context.Background(), []SubmittedTransaction{
+		{Signature: landed, SentSlot: 100},
+		{Signature: failed, SentSlot: 100},
+		{Signature: dropped, SentSlot: 100},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.Submitted)
+	require.Equal(t, 2, report.Landed)
+	require.Equal(t, 1, report.Failed)
+	require.InDelta(t, 2.0/3.0, report.LandingRate, 1e-9)
+	require.Equal(t, uint64(2), report.MinSlotsToLand)
+	require.Equal(t, uint64(2), report.MedianSlotsToLand)
+	require.Equal(t, uint64(5), report.MaxSlotsToLand)
+
+	first := report.Transactions[0]
+	require.True(t, first.Landed)
+	require.Equal(t, uint64(5), first.SlotsToLand)
+	require.Equal(t, uint64(500), first.ComputeUnitPrice)
+	fees := first.BlockFees
+	require.NotNil(t, fees)
+	require.Equal(t, 4, fees.NumTransactions)
+	require.Equal(t, 3, fees.NumPrioritized)
+	require.Equal(t, uint64(0), fees.MinPrice)
+	require.Equal(t, uint64(100), fees.MedianPrice)
+	require.Equal(t, uint64(1000), fees.P90Price)
+	require.Equal(t, uint64(1000), fees.MaxPrice)
+	require.Equal(t, 0.75, fees.Rank(first.ComputeUnitPrice))
+
+	require.NotNil(t, report.Transactions[1].Err)
+	require.Equal(t, 0, report.Transactions[1].BlockFees.NumTransactions)
+	require.False(t, report.Transactions[2].Landed)
+	require.Nil(t, report.Transactions[2].BlockFees)
+}
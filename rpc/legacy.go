@@ -0,0 +1,33 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+// The deprecated RPC methods (getConfirmedBlock, getConfirmedBlocks,
+// getConfirmedBlocksWithLimit, getConfirmedSignaturesForAddress2,
+// getConfirmedTransaction, getRecentBlockhash, getFees,
+// getFeeCalculatorForBlockhash, getFeeRateGovernor and getSnapshotSlot)
+// live in the legacy_*.go files, which are excluded when building with
+// the `nolegacy` build tag (go build -tags nolegacy), so that modern
+// binaries don't ship them; archival tooling keeps them by default.
+//
+// The client itself never depends on those wrappers: the fallbacks
+// to the solana-core v1.6 methods (see DetectCapabilities) call the
+// deprecated methods by name, and work in both builds.
+
+// HasLegacyMethods returns false if the deprecated RPC methods
+// were excluded with the `nolegacy` build tag.
+func HasLegacyMethods() bool {
+	return legacyMethodsIncluded
+}
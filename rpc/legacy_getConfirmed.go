@@ -15,11 +15,13 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !nolegacy
+// +build !nolegacy
+
 package rpc
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/gagliardetto/solana-go"
 )
@@ -53,6 +55,24 @@ type GetConfirmedBlockOpts struct {
 	Commitment CommitmentType
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetConfirmedBlockOpts) ToMap() M {
+	obj := M{}
+	if opts.Encoding != "" {
+		obj["encoding"] = opts.Encoding
+	}
+	if opts.TransactionDetails != "" {
+		obj["transactionDetails"] = opts.TransactionDetails
+	}
+	if opts.Rewards != nil {
+		obj["rewards"] = opts.Rewards
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	return obj
+}
+
 // GetConfirmedBlock returns identity and transaction information about a confirmed block in the ledger.
 //
 // DEPRECATED: Please use `getBlock` instead.
@@ -65,20 +85,10 @@ func (cl *Client) GetConfirmedBlockWithOpts(
 
 	params := []interface{}{slot}
 	if opts != nil {
-		obj := M{}
-		if opts.Encoding != "" {
-			obj["encoding"] = opts.Encoding
-		}
-		if opts.TransactionDetails != "" {
-			obj["transactionDetails"] = opts.TransactionDetails
-		}
-		if opts.Rewards != nil {
-			obj["rewards"] = opts.Rewards
+		if err := ValidateCommitmentForMethod("getConfirmedBlock", opts.Commitment); err != nil {
+			return nil, err
 		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if len(obj) != 0 {
+		if obj := opts.ToMap(); len(obj) != 0 {
 			params = append(params, obj)
 		}
 	}
@@ -107,8 +117,11 @@ func (cl *Client) GetConfirmedBlocks(
 		params = append(params, endSlot)
 	}
 	if commitment != "" {
-		params = append(params, M{"commitment": string(commitment)})
+		if err := ValidateCommitmentForMethod("getConfirmedBlocks", commitment); err != nil {
+			return nil, err
+		}
 	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getConfirmedBlocks", params)
 	return
@@ -127,8 +140,11 @@ func (cl *Client) GetConfirmedBlocksWithLimit(
 
 	params := []interface{}{startSlot, limit}
 	if commitment != "" {
-		params = append(params, M{"commitment": string(commitment)})
+		if err := ValidateCommitmentForMethod("getConfirmedBlocksWithLimit", commitment); err != nil {
+			return nil, err
+		}
 	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getConfirmedBlocksWithLimit", params)
 	return
@@ -148,20 +164,10 @@ func (cl *Client) GetConfirmedSignaturesForAddress2(
 	params := []interface{}{address}
 
 	if opts != nil {
-		obj := M{}
-		if opts.Limit != nil {
-			obj["limit"] = opts.Limit
-		}
-		if !opts.Before.IsZero() {
-			obj["before"] = opts.Before
+		if err := ValidateCommitmentForMethod("getConfirmedSignaturesForAddress2", opts.Commitment); err != nil {
+			return nil, err
 		}
-		if !opts.Until.IsZero() {
-			obj["until"] = opts.Until
-		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if len(obj) > 0 {
+		if obj := opts.ToMap(); len(obj) > 0 {
 			params = append(params, obj)
 		}
 	}
@@ -195,24 +201,12 @@ func (cl *Client) GetConfirmedTransactionWithOpts(
 ) (out *TransactionWithMeta, err error) {
 	params := []interface{}{signature}
 	if opts != nil {
-		obj := M{}
-		if opts.Encoding != "" {
-			if !solana.IsAnyOfEncodingType(
-				opts.Encoding,
-				// Valid encodings:
-				// solana.EncodingJSON, // TODO
-				// solana.EncodingJSONParsed, // TODO
-				solana.EncodingBase58,
-				solana.EncodingBase64,
-				solana.EncodingBase64Zstd,
-			) {
-				return nil, fmt.Errorf("provided encoding is not supported: %s", opts.Encoding)
-			}
-			obj["encoding"] = opts.Encoding
-		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
+		if err := opts.validate("getConfirmedTransaction"); err != nil {
+			return nil, err
 		}
+		obj := opts.ToMap()
+		// Not supported by the deprecated method.
+		delete(obj, "maxSupportedTransactionVersion")
 		if len(obj) > 0 {
 			params = append(params, obj)
 		}
@@ -12,6 +12,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !nolegacy
+// +build !nolegacy
+
 package rpc
 
 import (
@@ -30,9 +33,7 @@ func (cl *Client) GetFeeCalculatorForBlockhash(
 	commitment CommitmentType, // optional
 ) (out *GetFeeCalculatorForBlockhashResult, err error) {
 	params := []interface{}{hash}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getFeeCalculatorForBlockhash", params)
 	return
 }
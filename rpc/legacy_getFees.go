@@ -12,6 +12,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !nolegacy
+// +build !nolegacy
+
 package rpc
 
 import (
@@ -29,9 +32,7 @@ func (cl *Client) GetFees(
 	commitment CommitmentType, // optional
 ) (out *GetFeesResult, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &out, "getFees", params)
 	return
 }
@@ -14,6 +14,10 @@
 // WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 // See the License for the specific language governing permissions and
 // limitations under the License.
+
+//go:build !nolegacy
+// +build !nolegacy
+
 package rpc
 
 import (
@@ -27,9 +31,7 @@ func (cl *Client) GetRecentBlockhash(
 	commitment CommitmentType, // optional
 ) (out *GetRecentBlockhashResult, err error) {
 	params := []interface{}{}
-	if commitment != "" {
-		params = append(params, M{"commitment": commitment})
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 
 	err = cl.rpcClient.CallForInto(ctx, &out, "getRecentBlockhash", params)
 	return
@@ -0,0 +1,354 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	stdjson "encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"go.uber.org/zap"
+)
+
+// LogEvent is the kind of a LogEntry.
+type LogEvent string
+
+const (
+	// A request is sent; Payload holds the request.
+	LogEventRequest LogEvent = "request"
+	// A response was received; Payload holds the response, if captured.
+	LogEventResponse LogEvent = "response"
+	// A request is retried after a transient failure (see RetryPolicy).
+	LogEventRetry LogEvent = "retry"
+	// A request failed.
+	LogEventError LogEvent = "error"
+)
+
+// LogEntry is an event of a client, passed to its Logger.
+type LogEntry struct {
+	Event LogEvent
+	// Method of the request; "batch" for the batches.
+	Method string
+	// Host of the endpoint, without the credentials,
+	// the path or the query (which may contain an API key).
+	Endpoint string
+	// Request or response payload, truncated to LoggerOpts.MaxPayloadSize.
+	Payload []byte
+	// True if the payload was truncated.
+	Truncated bool
+	// Duration of the call, for the response and error events.
+	Duration time.Duration
+	// Attempt of the request (starting at 1) and delay before it, for the retry events.
+	Attempt int
+	Delay   time.Duration
+	// HTTP status of the failed attempt, for the retry events; 0 if none.
+	StatusCode int
+	Err        error
+}
+
+// Logger receives the events of a client, e.g. to debug malformed
+// parameters or the quirks of a provider without a proxy.
+// Log must be safe for concurrent use.
+type Logger interface {
+	Log(entry *LogEntry)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(entry *LogEntry)
+
+func (f LoggerFunc) Log(entry *LogEntry) {
+	f(entry)
+}
+
+// NewZapLogger returns a Logger writing the events to the zap logger:
+// the requests and responses at the debug level, the retries at the
+// info level, and the errors at the warn level.
+func NewZapLogger(logger *zap.Logger) Logger {
+	return LoggerFunc(func(entry *LogEntry) {
+		fields := []zap.Field{
+			zap.String("method", entry.Method),
+			zap.String("endpoint", entry.Endpoint),
+		}
+		if entry.Payload != nil {
+			fields = append(fields, zap.ByteString("payload", entry.Payload), zap.Bool("truncated", entry.Truncated))
+		}
+		if entry.Duration != 0 {
+			fields = append(fields, zap.Duration("duration", entry.Duration))
+		}
+		switch entry.Event {
+		case LogEventRequest:
+			logger.Debug("rpc request", fields...)
+		case LogEventResponse:
+			logger.Debug("rpc response", fields...)
+		case LogEventRetry:
+			fields = append(fields, zap.Int("attempt", entry.Attempt), zap.Duration("delay", entry.Delay))
+			if entry.StatusCode != 0 {
+				fields = append(fields, zap.Int("status_code", entry.StatusCode))
+			}
+			if entry.Err != nil {
+				fields = append(fields, zap.Error(entry.Err))
+			}
+			logger.Info("rpc retry", fields...)
+		case LogEventError:
+			logger.Warn("rpc error", append(fields, zap.Error(entry.Err))...)
+		}
+	})
+}
+
+// LoggerOpts configures the logging of a client.
+type LoggerOpts struct {
+	// Maximum size in bytes of the payloads; defaults to 2048.
+	// A negative value omits the payloads.
+	MaxPayloadSize int
+}
+
+func (opts *LoggerOpts) maxPayloadSize() int {
+	if opts == nil || opts.MaxPayloadSize == 0 {
+		return 2048
+	}
+	return opts.MaxPayloadSize
+}
+
+// Truncate returns the payload truncated to MaxPayloadSize (opts nil for
+// the defaults), and true if it was truncated; nil if the payloads are omitted.
+func (opts *LoggerOpts) Truncate(payload []byte) ([]byte, bool) {
+	max := opts.maxPayloadSize()
+	if max < 0 {
+		return nil, false
+	}
+	if len(payload) > max {
+		return payload[:max], true
+	}
+	return payload, false
+}
+
+// SetLogger sets the logger receiving the requests, responses, retries and
+// errors of the client (opts nil for the defaults); nil removes it.
+// The response payloads and the retries are only logged by the clients
+// created by this package. It must be called before the client is used.
+func (cl *Client) SetLogger(logger Logger, opts *LoggerOpts) *Client {
+	if logged, ok := cl.rpcClient.(*loggedClient); ok {
+		cl.rpcClient = logged.rpcClient
+	}
+	if logger != nil {
+		cl.rpcClient = &loggedClient{
+			rpcClient: cl.rpcClient,
+			logger:    logger,
+			endpoint:  ServerAddress(cl.rpcURL),
+			opts:      opts,
+		}
+	}
+	return cl
+}
+
+type loggedClient struct {
+	rpcClient JSONRPCClient
+	logger    Logger
+	endpoint  string
+	opts      *LoggerOpts
+}
+
+func (lc *loggedClient) logRequest(method string, request interface{}) {
+	entry := &LogEntry{
+		Event:    LogEventRequest,
+		Method:   method,
+		Endpoint: lc.endpoint,
+	}
+	if lc.opts.maxPayloadSize() >= 0 {
+		if payload, err := stdjson.Marshal(request); err == nil {
+			entry.Payload, entry.Truncated = lc.opts.Truncate(payload)
+		}
+	}
+	lc.logger.Log(entry)
+}
+
+func (lc *loggedClient) logResult(method string, start time.Time, capture *responseCapture, err error) {
+	entry := &LogEntry{
+		Event:    LogEventResponse,
+		Method:   method,
+		Endpoint: lc.endpoint,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if err != nil {
+		entry.Event = LogEventError
+	}
+	entry.Payload, entry.Truncated = capture.get()
+	lc.logger.Log(entry)
+}
+
+func newLoggedRequest(method string, params []interface{}) *jsonrpc.RPCRequest {
+	request := &jsonrpc.RPCRequest{
+		Method:  method,
+		JSONRPC: "2.0",
+	}
+	if params != nil {
+		request.Params = params
+	}
+	return request
+}
+
+func (lc *loggedClient) withLogging(ctx context.Context, method string) (context.Context, *responseCapture) {
+	capture := &responseCapture{limit: lc.opts.maxPayloadSize()}
+	ctx = context.WithValue(ctx, responseCaptureKey{}, capture)
+	ctx = context.WithValue(ctx, retryLoggerKey{}, func(entry *LogEntry) {
+		entry.Method = method
+		entry.Endpoint = lc.endpoint
+		lc.logger.Log(entry)
+	})
+	return ctx, capture
+}
+
+func (lc *loggedClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	lc.logRequest(method, newLoggedRequest(method, params))
+	ctx, capture := lc.withLogging(ctx, method)
+	start := time.Now()
+	err := lc.rpcClient.CallForInto(ctx, out, method, params)
+	lc.logResult(method, start, capture, err)
+	return err
+}
+
+func (lc *loggedClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	lc.logRequest(method, newLoggedRequest(method, params))
+	ctx, capture := lc.withLogging(ctx, method)
+	start := time.Now()
+	err := lc.rpcClient.CallWithCallback(ctx, method, params, callback)
+	lc.logResult(method, start, capture, err)
+	return err
+}
+
+func (lc *loggedClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	batchClient, ok := lc.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return nil, ErrBatchNotSupported
+	}
+	lc.logRequest("batch", requests)
+	ctx, capture := lc.withLogging(ctx, "batch")
+	start := time.Now()
+	responses, err := batchClient.CallBatch(ctx, requests)
+	lc.logResult("batch", start, capture, err)
+	return responses, err
+}
+
+func (lc *loggedClient) Close() error {
+	if c, ok := lc.rpcClient.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+type responseCaptureKey struct{}
+
+// responseCapture holds the beginning of the last response body read by
+// the transport of the clients created by this package.
+type responseCapture struct {
+	mu        sync.Mutex
+	limit     int
+	buf       bytes.Buffer
+	truncated bool
+	captured  bool
+}
+
+func (c *responseCapture) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.buf.Reset()
+	c.truncated = false
+	c.captured = true
+}
+
+func (c *responseCapture) write(p []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if room := c.limit - c.buf.Len(); room < len(p) {
+		if room > 0 {
+			c.buf.Write(p[:room])
+		}
+		c.truncated = true
+		return
+	}
+	c.buf.Write(p)
+}
+
+// get returns the captured response, nil if none was captured.
+func (c *responseCapture) get() (payload []byte, truncated bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.captured {
+		return nil, false
+	}
+	return append([]byte{}, c.buf.Bytes()...), c.truncated
+}
+
+// responseCaptureTransport captures the response bodies
+// for the requests whose context holds a responseCapture.
+type responseCaptureTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *responseCaptureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if capture, ok := req.Context().Value(responseCaptureKey{}).(*responseCapture); ok && capture.limit >= 0 {
+		capture.reset()
+		resp.Body = &capturingReadCloser{ReadCloser: resp.Body, capture: capture}
+	}
+	return resp, nil
+}
+
+type capturingReadCloser struct {
+	io.ReadCloser
+	capture *responseCapture
+}
+
+func (r *capturingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.capture.write(p[:n])
+	}
+	return n, err
+}
+
+type retryLoggerKey struct{}
+
+// logRetry logs the retry of the request, if its context holds a logger.
+func logRetry(req *http.Request, attempt int, delay time.Duration, resp *http.Response, err error) {
+	log, ok := req.Context().Value(retryLoggerKey{}).(func(*LogEntry))
+	if !ok {
+		return
+	}
+	entry := &LogEntry{
+		Event:   LogEventRetry,
+		Attempt: attempt,
+		Delay:   delay,
+		Err:     err,
+	}
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+	}
+	log(entry)
+}
@@ -0,0 +1,141 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestClient_SetLogger(t *testing.T) {
+	body := `{"jsonrpc":"2.0","result":42,"id":0}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(body))
+	defer closer()
+
+	var entries []*LogEntry
+	client := New(server.URL+"/?api-key=secret").SetLogger(LoggerFunc(func(entry *LogEntry) {
+		entries = append(entries, entry)
+	}), nil)
+
+	_, err := client.GetSlot(context.Background(), CommitmentFinalized)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	require.Equal(t, LogEventRequest, entries[0].Event)
+	require.Equal(t, "getSlot", entries[0].Method)
+	require.Equal(t, server.Listener.Addr().String(), entries[0].Endpoint)
+	require.JSONEq(t, `{"jsonrpc":"2.0","method":"getSlot","params":[{"commitment":"finalized"}],"id":0}`, string(entries[0].Payload))
+
+	require.Equal(t, LogEventResponse, entries[1].Event)
+	require.Equal(t, body, string(entries[1].Payload))
+	require.False(t, entries[1].Truncated)
+	require.NotZero(t, entries[1].Duration)
+
+	client.SetLogger(nil, nil)
+	_, err = client.GetSlot(context.Background(), CommitmentFinalized)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestClient_SetLogger_Truncated(t *testing.T) {
+	body := `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":0}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(body))
+	defer closer()
+
+	var entries []*LogEntry
+	client := New(server.URL).SetLogger(LoggerFunc(func(entry *LogEntry) {
+		entries = append(entries, entry)
+	}), &LoggerOpts{MaxPayloadSize: 10})
+
+	_, err := client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, `{"method":`, string(entries[0].Payload))
+	require.True(t, entries[0].Truncated)
+	require.Equal(t, LogEventError, entries[1].Event)
+	require.Equal(t, body[:10], string(entries[1].Payload))
+	require.True(t, entries[1].Truncated)
+	require.Equal(t, err, entries[1].Err)
+
+	// Omitted payloads.
+	entries = nil
+	client.SetLogger(LoggerFunc(func(entry *LogEntry) {
+		entries = append(entries, entry)
+	}), &LoggerOpts{MaxPayloadSize: -1})
+	_, err = client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Len(t, entries, 2)
+	require.Nil(t, entries[0].Payload)
+	require.Nil(t, entries[1].Payload)
+}
+
+func TestClient_SetLogger_Retry(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			rw.Write([]byte("unavailable"))
+			return
+		}
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+	}))
+	defer server.Close()
+
+	var entries []*LogEntry
+	client := NewWithRetry(server.URL, &RetryPolicy{MinBackoff: time.Millisecond}).SetLogger(LoggerFunc(func(entry *LogEntry) {
+		entries = append(entries, entry)
+	}), nil)
+	_, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Len(t, entries, 3)
+	require.Equal(t, LogEventRetry, entries[1].Event)
+	require.Equal(t, "getSlot", entries[1].Method)
+	require.Equal(t, 2, entries[1].Attempt)
+	require.Equal(t, http.StatusServiceUnavailable, entries[1].StatusCode)
+	require.Equal(t, LogEventResponse, entries[2].Event)
+	// Only the last response is captured.
+	require.Equal(t, `{"jsonrpc":"2.0","result":42,"id":0}`, string(entries[2].Payload))
+}
+
+func TestNewZapLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := NewZapLogger(zap.New(core))
+
+	logger.Log(&LogEntry{Event: LogEventRequest, Method: "getSlot", Payload: []byte("{}")})
+	logger.Log(&LogEntry{Event: LogEventRetry, Method: "getSlot", Attempt: 2, StatusCode: 429})
+	logger.Log(&LogEntry{Event: LogEventError, Method: "getSlot", Err: errors.New("boom")})
+
+	entries := logs.All()
+	require.Len(t, entries, 3)
+	require.Equal(t, zapcore.DebugLevel, entries[0].Level)
+	require.Equal(t, "{}", entries[0].ContextMap()["payload"])
+	require.Equal(t, zapcore.InfoLevel, entries[1].Level)
+	require.Equal(t, int64(429), entries[1].ContextMap()["status_code"])
+	require.Equal(t, zapcore.WarnLevel, entries[2].Level)
+	require.True(t, strings.Contains(entries[2].ContextMap()["error"].(string), "boom"))
+}
@@ -0,0 +1,212 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// ResponseMeta is the metadata of a JSON-RPC response,
+// useful to debug discrepancies between RPC providers.
+type ResponseMeta struct {
+	// The HTTP status code and headers of the response.
+	StatusCode int
+	Header     http.Header
+
+	// The context of the response; only set for the methods that
+	// return a `{context, value}` result.
+	Context *Context
+
+	// The error returned by the node, including its raw data.
+	Error *jsonrpc.RPCError
+
+	// The raw `result` of the response.
+	RawResult stdjson.RawMessage
+}
+
+// Slot returns the slot at which the request was evaluated,
+// or zero if the method does not return a context.
+func (meta *ResponseMeta) Slot() uint64 {
+	if meta.Context == nil {
+		return 0
+	}
+	return meta.Context.Slot
+}
+
+// APIVersion returns the RPC API version reported by the node, if any.
+func (meta *ResponseMeta) APIVersion() string {
+	if meta.Context == nil {
+		return ""
+	}
+	return meta.Context.APIVersion
+}
+
+type responseWithMeta struct {
+	Result stdjson.RawMessage `json:"result"`
+	Error  *jsonrpc.RPCError  `json:"error"`
+}
+
+// CallWithMeta calls the provided method and decodes the result into out,
+// like RPCCallForInto, but also returns the metadata of the response.
+// The metadata is returned (when available) even if the call fails.
+func (cl *Client) CallWithMeta(
+	ctx context.Context,
+	out interface{},
+	method string,
+	params []interface{},
+) (*ResponseMeta, error) {
+	var meta *ResponseMeta
+	err := cl.rpcClient.CallWithCallback(ctx, method, params, func(req *http.Request, resp *http.Response) error {
+		meta = &ResponseMeta{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("rpc call %v(): unable to read response: %w", method, err)
+		}
+		var decoded responseWithMeta
+		if err := json.Unmarshal(body, &decoded); err != nil {
+			return fmt.Errorf("rpc call %v(): unable to decode response (status %d): %w", method, resp.StatusCode, err)
+		}
+		meta.Error = decoded.Error
+		meta.RawResult = decoded.Result
+		if decoded.Error != nil {
+			return decoded.Error
+		}
+
+		var withContext struct {
+			Context *Context `json:"context"`
+		}
+		// Not all the results are objects; ignore the error.
+		if json.Unmarshal(decoded.Result, &withContext) == nil {
+			meta.Context = withContext.Context
+		}
+		if out == nil {
+			return nil
+		}
+		return json.Unmarshal(decoded.Result, out)
+	})
+	return meta, err
+}
+
+// GetAccountInfoWithMeta is like GetAccountInfoWithOpts,
+// but also returns the metadata of the response.
+func (cl *Client) GetAccountInfoWithMeta(
+	ctx context.Context,
+	account solana.PublicKey,
+	opts *GetAccountInfoOpts,
+) (out *GetAccountInfoResult, meta *ResponseMeta, err error) {
+	obj := M{}
+	if opts != nil {
+		if err := validateDataSlice(opts.Encoding, opts.DataSlice); err != nil {
+			return nil, nil, err
+		}
+		obj = opts.ToMap()
+	}
+	if _, ok := obj["encoding"]; !ok {
+		obj["encoding"] = solana.EncodingBase64
+	}
+	meta, err = cl.CallWithMeta(ctx, &out, "getAccountInfo", []interface{}{account, obj})
+	if err != nil {
+		return nil, meta, err
+	}
+	if out == nil || out.Value == nil {
+		return nil, meta, ErrNotFound
+	}
+	return out, meta, nil
+}
+
+// GetMultipleAccountsWithMeta is like GetMultipleAccountsWithOpts,
+// but also returns the metadata of the response.
+func (cl *Client) GetMultipleAccountsWithMeta(
+	ctx context.Context,
+	accounts []solana.PublicKey,
+	opts *GetMultipleAccountsOpts,
+) (out *GetMultipleAccountsResult, meta *ResponseMeta, err error) {
+	params := []interface{}{accounts}
+	if opts != nil {
+		if err := validateDataSlice(opts.Encoding, opts.DataSlice); err != nil {
+			return nil, nil, err
+		}
+		if obj := opts.ToMap(); len(obj) > 0 {
+			params = append(params, obj)
+		}
+	}
+	meta, err = cl.CallWithMeta(ctx, &out, "getMultipleAccounts", params)
+	if err != nil {
+		return nil, meta, err
+	}
+	if out == nil || out.Value == nil {
+		return nil, meta, ErrNotFound
+	}
+	return out, meta, nil
+}
+
+// GetBalanceWithMeta is like GetBalance,
+// but also returns the metadata of the response.
+func (cl *Client) GetBalanceWithMeta(
+	ctx context.Context,
+	account solana.PublicKey,
+	commitment CommitmentType,
+) (out *GetBalanceResult, meta *ResponseMeta, err error) {
+	params := []interface{}{account}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
+	meta, err = cl.CallWithMeta(ctx, &out, "getBalance", params)
+	if err != nil {
+		return nil, meta, err
+	}
+	if out == nil {
+		return nil, meta, errors.New("expected a value, got null result")
+	}
+	return out, meta, nil
+}
+
+// GetTransactionWithMeta is like GetTransaction,
+// but also returns the metadata of the response.
+func (cl *Client) GetTransactionWithMeta(
+	ctx context.Context,
+	txSig solana.Signature,
+	opts *GetTransactionOpts,
+) (out *GetTransactionResult, meta *ResponseMeta, err error) {
+	params := []interface{}{txSig}
+	obj := M{}
+	if opts != nil {
+		if err := opts.validate("getTransaction"); err != nil {
+			return nil, nil, err
+		}
+		obj = opts.ToMap()
+	}
+	cl.applyMaxSupportedTransactionVersion(obj)
+	if len(obj) > 0 {
+		params = append(params, obj)
+	}
+	meta, err = cl.CallWithMeta(ctx, &out, "getTransaction", params)
+	if err != nil {
+		return nil, meta, err
+	}
+	if out == nil {
+		return nil, meta, ErrNotFound
+	}
+	return out, meta, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetBalanceWithMeta(t *testing.T) {
+	responseBody := `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.14.17","slot":83986105},"value":19039980000},"id":0}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(responseBody))
+	defer closer()
+	client := New(server.URL)
+
+	out, meta, err := client.GetBalanceWithMeta(
+		context.Background(),
+		solana.MustPublicKeyFromBase58("7xLk17EQQ5KLDLDe44wCmupJKJjTGd8hs3eSVVhCx932"),
+		CommitmentFinalized,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(19039980000), out.Value)
+	require.Equal(t, "1.14.17", out.Context.APIVersion)
+
+	require.Equal(t, 200, meta.StatusCode)
+	require.Equal(t, uint64(83986105), meta.Slot())
+	require.Equal(t, "1.14.17", meta.APIVersion())
+	require.Nil(t, meta.Error)
+	require.JSONEq(t, `{"context":{"apiVersion":"1.14.17","slot":83986105},"value":19039980000}`, string(meta.RawResult))
+}
+
+func TestClient_CallWithMeta_error(t *testing.T) {
+	responseBody := `{"jsonrpc":"2.0","error":{"code":-32002,"message":"Transaction simulation failed","data":{"logs":["Program log: boom"]}},"id":0}`
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(responseBody))
+	defer closer()
+	client := New(server.URL)
+
+	var out interface{}
+	meta, err := client.CallWithMeta(context.Background(), &out, "sendTransaction", []interface{}{"abc"})
+	require.Error(t, err)
+
+	var rpcErr *jsonrpc.RPCError
+	require.True(t, errors.As(err, &rpcErr))
+	require.Equal(t, -32002, rpcErr.Code)
+
+	require.NotNil(t, meta)
+	require.Equal(t, rpcErr, meta.Error)
+	require.Equal(t,
+		map[string]interface{}{"logs": []interface{}{"Program log: boom"}},
+		meta.Error.Data,
+	)
+	require.Equal(t, uint64(0), meta.Slot())
+}
+
+func TestClient_CallWithMeta_noContext(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","result":12345,"id":0}`))
+	defer closer()
+	client := New(server.URL)
+
+	var slot uint64
+	meta, err := client.CallWithMeta(context.Background(), &slot, "getSlot", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12345), slot)
+	require.Nil(t, meta.Context)
+	require.Equal(t, "", meta.APIVersion())
+}
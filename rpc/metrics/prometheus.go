@@ -0,0 +1,317 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics exports the metrics of the RPC calls of a client
+// in the Prometheus text format, without depending on the Prometheus client:
+//
+//	exporter := metrics.NewPrometheusExporter(nil)
+//	client := rpc.New(rpc.MainNetBeta_RPC).SetInstrumentation(exporter)
+//	http.Handle("/metrics", exporter)
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+// DefaultDurationBuckets are the default buckets (in seconds)
+// of the histogram of the durations of the calls.
+var DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// DefaultSizeBuckets are the default buckets (in bytes)
+// of the histogram of the sizes of the responses.
+var DefaultSizeBuckets = []float64{256, 1024, 4096, 16384, 65536, 262144, 1048576, 4194304, 16777216}
+
+type PrometheusOpts struct {
+	// Prefix of the names of the metrics.
+	// Defaults to "solana_rpc".
+	Namespace string
+	// Defaults to DefaultDurationBuckets.
+	DurationBuckets []float64
+	// Defaults to DefaultSizeBuckets.
+	SizeBuckets []float64
+	// Constant labels added to all the metrics, e.g. {"endpoint": "mainnet"}.
+	ConstLabels map[string]string
+}
+
+// PrometheusExporter is a rpc.Instrumentation aggregating the calls
+// by method and status, and an http.Handler serving the metrics:
+//
+//   - <namespace>_requests_total{method,status}: counter of the calls;
+//   - <namespace>_request_duration_seconds{method}: histogram of the durations;
+//   - <namespace>_response_size_bytes{method}: histogram of the sizes of the responses
+//     (the calls of unknown response size are not counted).
+//
+// With WatchSubscriptions, it also serves the lag gauges
+// of the WebSocket subscriptions (see ws.SubscriptionStats):
+//
+//   - <namespace>_ws_latest_slot: the latest slot known by the WebSocket clients;
+//   - <namespace>_ws_subscription_slot_lag{method,subscription}: slot delta
+//     between the last notification of the subscription and the latest slot;
+//   - <namespace>_ws_subscription_delivery_latency_seconds{method,subscription}:
+//     time the last consumed notification waited to be consumed;
+//   - <namespace>_ws_subscription_pending{method,subscription}: notifications
+//     waiting to be consumed;
+//   - <namespace>_ws_subscription_notifications_total{method,subscription}.
+type PrometheusExporter struct {
+	opts        PrometheusOpts
+	constLabels string
+
+	mu        sync.Mutex
+	requests  map[requestKey]uint64
+	durations map[string]*histogram
+	sizes     map[string]*histogram
+	wsClients []*ws.Client
+}
+
+var _ rpc.Instrumentation = &PrometheusExporter{}
+
+type requestKey struct {
+	method string
+	status rpc.CallStatus
+}
+
+type histogram struct {
+	counts []uint64 // by bucket, not cumulative; the last one is +Inf.
+	sum    float64
+	count  uint64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{counts: make([]uint64, len(buckets)+1)}
+}
+
+func (h *histogram) observe(buckets []float64, value float64) {
+	h.counts[sort.SearchFloat64s(buckets, value)]++
+	h.sum += value
+	h.count++
+}
+
+// NewPrometheusExporter creates an exporter.
+func NewPrometheusExporter(opts *PrometheusOpts) *PrometheusExporter {
+	exporter := &PrometheusExporter{
+		requests:  make(map[requestKey]uint64),
+		durations: make(map[string]*histogram),
+		sizes:     make(map[string]*histogram),
+	}
+	if opts != nil {
+		exporter.opts = *opts
+	}
+	if exporter.opts.Namespace == "" {
+		exporter.opts.Namespace = "solana_rpc"
+	}
+	if exporter.opts.DurationBuckets == nil {
+		exporter.opts.DurationBuckets = DefaultDurationBuckets
+	}
+	if exporter.opts.SizeBuckets == nil {
+		exporter.opts.SizeBuckets = DefaultSizeBuckets
+	}
+	exporter.opts.DurationBuckets = sortedCopy(exporter.opts.DurationBuckets)
+	exporter.opts.SizeBuckets = sortedCopy(exporter.opts.SizeBuckets)
+
+	var names []string
+	for name := range exporter.opts.ConstLabels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		exporter.constLabels += fmt.Sprintf(`%s="%s",`, name, escapeLabel(exporter.opts.ConstLabels[name]))
+	}
+	return exporter
+}
+
+func sortedCopy(values []float64) []float64 {
+	out := append([]float64(nil), values...)
+	sort.Float64s(out)
+	return out
+}
+
+// ObserveCall implements rpc.Instrumentation.
+func (exporter *PrometheusExporter) ObserveCall(call *rpc.CallInfo) {
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+	exporter.requests[requestKey{method: call.Method, status: call.Status}]++
+
+	duration, ok := exporter.durations[call.Method]
+	if !ok {
+		duration = newHistogram(exporter.opts.DurationBuckets)
+		exporter.durations[call.Method] = duration
+	}
+	duration.observe(exporter.opts.DurationBuckets, call.Duration.Seconds())
+
+	if call.ResponseSize >= 0 {
+		size, ok := exporter.sizes[call.Method]
+		if !ok {
+			size = newHistogram(exporter.opts.SizeBuckets)
+			exporter.sizes[call.Method] = size
+		}
+		size.observe(exporter.opts.SizeBuckets, float64(call.ResponseSize))
+	}
+}
+
+// WatchSubscriptions adds the lag gauges of the subscriptions
+// of the WebSocket client to the metrics.
+func (exporter *PrometheusExporter) WatchSubscriptions(client *ws.Client) {
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+	exporter.wsClients = append(exporter.wsClients, client)
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format.
+func (exporter *PrometheusExporter) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	exporter.WriteTo(rw)
+}
+
+// WriteTo writes the metrics in the Prometheus text format.
+func (exporter *PrometheusExporter) WriteTo(w io.Writer) (int64, error) {
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+
+	out := &countingWriter{w: bufio.NewWriter(w)}
+	ns := exporter.opts.Namespace
+
+	fmt.Fprintf(out, "# HELP %s_requests_total Number of RPC calls.\n", ns)
+	fmt.Fprintf(out, "# TYPE %s_requests_total counter\n", ns)
+	keys := make([]requestKey, 0, len(exporter.requests))
+	for key := range exporter.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].status < keys[j].status
+	})
+	for _, key := range keys {
+		fmt.Fprintf(out, "%s_requests_total{%smethod=\"%s\",status=\"%s\"} %d\n",
+			ns, exporter.constLabels, escapeLabel(key.method), key.status, exporter.requests[key])
+	}
+
+	exporter.writeHistograms(out, ns+"_request_duration_seconds", "Duration of the RPC calls in seconds.", exporter.durations, exporter.opts.DurationBuckets)
+	exporter.writeHistograms(out, ns+"_response_size_bytes", "Size of the responses of the RPC calls in bytes.", exporter.sizes, exporter.opts.SizeBuckets)
+	if len(exporter.wsClients) > 0 {
+		exporter.writeSubscriptions(out, ns)
+	}
+
+	if err := out.w.Flush(); err != nil {
+		return out.n, err
+	}
+	return out.n, out.err
+}
+
+func (exporter *PrometheusExporter) writeHistograms(out io.Writer, name, help string, histograms map[string]*histogram, buckets []float64) {
+	fmt.Fprintf(out, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(out, "# TYPE %s histogram\n", name)
+	methods := make([]string, 0, len(histograms))
+	for method := range histograms {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	for _, method := range methods {
+		h := histograms[method]
+		labels := fmt.Sprintf(`%smethod="%s"`, exporter.constLabels, escapeLabel(method))
+		var cumulative uint64
+		for i, count := range h.counts {
+			bound := math.Inf(1)
+			if i < len(buckets) {
+				bound = buckets[i]
+			}
+			cumulative += count
+			fmt.Fprintf(out, "%s_bucket{%s,le=\"%s\"} %d\n", name, labels, formatFloat(bound), cumulative)
+		}
+		fmt.Fprintf(out, "%s_sum{%s} %s\n", name, labels, formatFloat(h.sum))
+		fmt.Fprintf(out, "%s_count{%s} %d\n", name, labels, h.count)
+	}
+}
+
+func (exporter *PrometheusExporter) writeSubscriptions(out io.Writer, ns string) {
+	var latest uint64
+	var stats []ws.SubscriptionStats
+	for _, client := range exporter.wsClients {
+		if slot := client.LatestSlot(); slot > latest {
+			latest = slot
+		}
+		stats = append(stats, client.Stats()...)
+	}
+	fmt.Fprintf(out, "# HELP %s_ws_latest_slot Latest slot known by the WebSocket clients.\n", ns)
+	fmt.Fprintf(out, "# TYPE %s_ws_latest_slot gauge\n", ns)
+	labels := strings.TrimSuffix(exporter.constLabels, ",")
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	fmt.Fprintf(out, "%s_ws_latest_slot%s %d\n", ns, labels, latest)
+
+	gauges := []struct {
+		name, help, kind string
+		value            func(stats *ws.SubscriptionStats) string
+	}{
+		{"slot_lag", "Slots between the last notification of the subscription and the latest slot.", "gauge",
+			func(stats *ws.SubscriptionStats) string { return strconv.FormatUint(stats.SlotLag, 10) }},
+		{"delivery_latency_seconds", "Time the last consumed notification waited to be consumed in seconds.", "gauge",
+			func(stats *ws.SubscriptionStats) string { return formatFloat(stats.DeliveryLatency.Seconds()) }},
+		{"pending", "Notifications waiting to be consumed.", "gauge",
+			func(stats *ws.SubscriptionStats) string { return strconv.Itoa(stats.Pending) }},
+		{"notifications_total", "Number of notifications received.", "counter",
+			func(stats *ws.SubscriptionStats) string { return strconv.FormatUint(stats.Notifications, 10) }},
+	}
+	for _, gauge := range gauges {
+		name := ns + "_ws_subscription_" + gauge.name
+		fmt.Fprintf(out, "# HELP %s %s\n", name, gauge.help)
+		fmt.Fprintf(out, "# TYPE %s %s\n", name, gauge.kind)
+		for i := range stats {
+			fmt.Fprintf(out, "%s{%smethod=\"%s\",subscription=\"%d\"} %s\n",
+				name, exporter.constLabels, escapeLabel(stats[i].Method), stats[i].ID, gauge.value(&stats[i]))
+		}
+	}
+}
+
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeLabel(value string) string {
+	return labelEscaper.Replace(value)
+}
+
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusExporter(t *testing.T) {
+	exporter := NewPrometheusExporter(&PrometheusOpts{
+		DurationBuckets: []float64{1, 0.1},
+		SizeBuckets:     []float64{100},
+		ConstLabels:     map[string]string{"endpoint": `main"net`},
+	})
+	exporter.ObserveCall(&rpc.CallInfo{Method: "getSlot", Duration: 50 * time.Millisecond, Status: rpc.CallStatusOK, ResponseSize: 40})
+	exporter.ObserveCall(&rpc.CallInfo{Method: "getSlot", Duration: 2 * time.Second, Status: rpc.CallStatusHTTPError, ResponseSize: 500})
+	exporter.ObserveCall(&rpc.CallInfo{Method: "getBalance", Duration: 500 * time.Millisecond, Status: rpc.CallStatusOK, ResponseSize: -1, Batch: true})
+
+	rec := httptest.NewRecorder()
+	exporter.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, `# HELP solana_rpc_requests_total Number of RPC calls.
+# TYPE solana_rpc_requests_total counter
+solana_rpc_requests_total{endpoint="main\"net",method="getBalance",status="ok"} 1
+solana_rpc_requests_total{endpoint="main\"net",method="getSlot",status="http_error"} 1
+solana_rpc_requests_total{endpoint="main\"net",method="getSlot",status="ok"} 1
+# HELP solana_rpc_request_duration_seconds Duration of the RPC calls in seconds.
+# TYPE solana_rpc_request_duration_seconds histogram
+solana_rpc_request_duration_seconds_bucket{endpoint="main\"net",method="getBalance",le="0.1"} 0
+solana_rpc_request_duration_seconds_bucket{endpoint="main\"net",method="getBalance",le="1"} 1
+solana_rpc_request_duration_seconds_bucket{endpoint="main\"net",method="getBalance",le="+Inf"} 1
+solana_rpc_request_duration_seconds_sum{endpoint="main\"net",method="getBalance"} 0.5
+solana_rpc_request_duration_seconds_count{endpoint="main\"net",method="getBalance"} 1
+solana_rpc_request_duration_seconds_bucket{endpoint="main\"net",method="getSlot",le="0.1"} 1
+solana_rpc_request_duration_seconds_bucket{endpoint="main\"net",method="getSlot",le="1"} 1
+solana_rpc_request_duration_seconds_bucket{endpoint="main\"net",method="getSlot",le="+Inf"} 2
+solana_rpc_request_duration_seconds_sum{endpoint="main\"net",method="getSlot"} 2.05
+solana_rpc_request_duration_seconds_count{endpoint="main\"net",method="getSlot"} 2
+# HELP solana_rpc_response_size_bytes Size of the responses of the RPC calls in bytes.
+# TYPE solana_rpc_response_size_bytes histogram
+solana_rpc_response_size_bytes_bucket{endpoint="main\"net",method="getSlot",le="100"} 1
+solana_rpc_response_size_bytes_bucket{endpoint="main\"net",method="getSlot",le="+Inf"} 2
+solana_rpc_response_size_bytes_sum{endpoint="main\"net",method="getSlot"} 540
+solana_rpc_response_size_bytes_count{endpoint="main\"net",method="getSlot"} 2
+`, rec.Body.String())
+}
+
+func TestPrometheusExporter_WatchSubscriptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(rw, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var request struct {
+				ID     uint64 `json:"id"`
+				Method string `json:"method"`
+			}
+			if err := conn.ReadJSON(&request); err != nil {
+				return
+			}
+			if request.Method != "slotSubscribe" {
+				continue
+			}
+			conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","result":7,"id":%d}`, request.ID)))
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","method":"slotNotification","params":{"result":{"parent":75,"root":44,"slot":76},"subscription":7}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := ws.Connect(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http"))
+	require.NoError(t, err)
+	defer client.Close()
+	sub, err := client.SlotSubscribe()
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	require.Eventually(t, func() bool { return client.LatestSlot() == 76 }, time.Second, time.Millisecond)
+
+	exporter := NewPrometheusExporter(nil)
+	exporter.WatchSubscriptions(client)
+	var buf bytes.Buffer
+	_, err = exporter.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), `# TYPE solana_rpc_ws_latest_slot gauge
+solana_rpc_ws_latest_slot 76
+# HELP solana_rpc_ws_subscription_slot_lag Slots between the last notification of the subscription and the latest slot.
+# TYPE solana_rpc_ws_subscription_slot_lag gauge
+solana_rpc_ws_subscription_slot_lag{method="slotSubscribe",subscription="7"} 0
+`)
+	require.Contains(t, buf.String(), `solana_rpc_ws_subscription_pending{method="slotSubscribe",subscription="7"} 1
+`)
+	require.Contains(t, buf.String(), `solana_rpc_ws_subscription_notifications_total{method="slotSubscribe",subscription="7"} 1
+`)
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"net/http"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// Middleware wraps the HTTP transport of a client, to inspect or modify
+// its requests and responses (e.g. to sign the requests for a provider-specific
+// authentication scheme, or to audit them).
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc is a function implementing http.RoundTripper.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (fn RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+// ChainMiddlewares wraps the transport with the middlewares; the first
+// middleware is the outermost one, seeing the requests first.
+func ChainMiddlewares(transport http.RoundTripper, middlewares ...Middleware) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		transport = middlewares[i](transport)
+	}
+	return transport
+}
+
+// RetryMiddleware returns a middleware retrying the transient
+// failures according to the policy; see NewRetryTransport.
+func RetryMiddleware(policy *RetryPolicy) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return NewRetryTransport(next, policy)
+	}
+}
+
+// HeadersMiddleware returns a middleware setting the headers on each request.
+func HeadersMiddleware(headers http.Header) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			for key, values := range headers {
+				req.Header[http.CanonicalHeaderKey(key)] = values
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// NewWithMiddlewares creates a new Solana JSON RPC client whose
+// HTTP transport is wrapped with the middlewares (see ChainMiddlewares).
+func NewWithMiddlewares(rpcEndpoint string, middlewares ...Middleware) *Client {
+	httpClient := newHTTP()
+	httpClient.Transport = ChainMiddlewares(httpClient.Transport, middlewares...)
+	opts := &jsonrpc.RPCClientOpts{
+		HTTPClient: httpClient,
+	}
+	rpcClient := jsonrpc.NewClientWithOpts(rpcEndpoint, opts)
+	cl := NewWithCustomRPCClient(rpcClient)
+	cl.rpcURL = rpcEndpoint
+	return cl
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWithMiddlewares(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "first,second", req.Header.Get("X-Order"))
+		require.Equal(t, "secret", req.Header.Get("X-Api-Key"))
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	var audited []string
+	mark := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				req = req.Clone(req.Context())
+				if prev := req.Header.Get("X-Order"); prev != "" {
+					name = prev + "," + name
+				}
+				req.Header.Set("X-Order", name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	audit := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			body, err := req.GetBody()
+			require.NoError(t, err)
+			raw, err := ioutil.ReadAll(body)
+			require.NoError(t, err)
+			audited = append(audited, string(raw))
+			return next.RoundTrip(req)
+		})
+	}
+
+	client := NewWithMiddlewares(server.URL,
+		audit,
+		mark("first"),
+		mark("second"),
+		HeadersMiddleware(http.Header{"x-api-key": {"secret"}}),
+	)
+	slot, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), slot)
+	require.Equal(t, []string{"first", "second"}, order)
+	require.Len(t, audited, 1)
+	require.Contains(t, audited[0], `"method":"getSlot"`)
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpts_ToMap(t *testing.T) {
+	offset := uint64(64)
+	length := uint64(8)
+	slot := uint64(100)
+
+	require.Equal(t,
+		M{
+			"encoding":       solana.EncodingBase64,
+			"commitment":     CommitmentConfirmed,
+			"dataSlice":      M{"offset": &offset, "length": &length},
+			"minContextSlot": slot,
+		},
+		(&GetAccountInfoOpts{
+			Encoding:       solana.EncodingBase64,
+			Commitment:     CommitmentConfirmed,
+			DataSlice:      &DataSlice{Offset: &offset, Length: &length},
+			MinContextSlot: &slot,
+		}).ToMap(),
+	)
+	require.Equal(t, M{}, (&GetAccountInfoOpts{}).ToMap())
+
+	// The offset defaults to zero.
+	zero := uint64(0)
+	require.Equal(t, M{"offset": &zero, "length": &length}, (&DataSlice{Length: &length}).ToMap())
+	require.Equal(t, &DataSlice{Offset: &offset, Length: &length}, NewDataSlice(64, 8))
+
+	// The token accounts methods default to base64.
+	require.Equal(t, M{"encoding": solana.EncodingBase64}, (&GetTokenAccountsOpts{}).ToMap())
+	require.Equal(t,
+		M{"encoding": solana.EncodingJSONParsed, "commitment": CommitmentFinalized},
+		(&GetTokenAccountsOpts{Encoding: solana.EncodingJSONParsed, Commitment: CommitmentFinalized}).ToMap(),
+	)
+
+	require.Equal(t,
+		M{"encoding": solana.EncodingJSONParsed},
+		(&GetParsedTransactionOpts{}).ToMap(),
+	)
+
+	require.Equal(t, M{"excludeNonCirculatingAccountsList": false}, (&GetSupplyOpts{}).ToMap())
+	require.Equal(t,
+		M{"commitment": CommitmentFinalized, "filter": LargestAccountsFilterCirculating},
+		(&GetLargestAccountsOpts{Commitment: CommitmentFinalized, Filter: LargestAccountsFilterCirculating}).ToMap(),
+	)
+	require.Equal(t, M{"epoch": &slot}, (&GetStakeActivationOpts{Epoch: &slot}).ToMap())
+	require.Equal(t, M{}, (&GetSignatureStatusesOpts{}).ToMap())
+	require.Equal(t,
+		[]interface{}{uint64(1), M{"commitment": CommitmentConfirmed}},
+		(&ContextOpts{Commitment: CommitmentConfirmed}).appendTo([]interface{}{uint64(1)}),
+	)
+	require.Equal(t, []interface{}{uint64(1)}, (&ContextOpts{}).appendTo([]interface{}{uint64(1)}))
+	version := uint64(0)
+	require.Equal(t,
+		M{"encoding": solana.EncodingJSONParsed, "maxSupportedTransactionVersion": uint64(0)},
+		(&GetParsedTransactionOpts{MaxSupportedTransactionVersion: &version}).ToMap(),
+	)
+}
+
+func TestOpts_Validate(t *testing.T) {
+	length := uint64(8)
+	require.Equal(t, errDataSliceJSONParsed, validateDataSlice(solana.EncodingJSONParsed, &DataSlice{Length: &length}))
+	require.NoError(t, validateDataSlice(solana.EncodingJSONParsed, nil))
+	_, err := NewWithCustomRPCClient(&recordingNode{}).GetProgramAccountsWithOpts(context.Background(), solana.SystemProgramID, &GetProgramAccountsOpts{
+		Encoding:  solana.EncodingJSONParsed,
+		DataSlice: NewDataSlice(0, 8),
+	})
+	require.Equal(t, errDataSliceJSONParsed, err)
+
+	require.NoError(t, (&GetBlockOpts{Encoding: solana.EncodingBase64}).validate())
+	require.Error(t, (&GetBlockOpts{Encoding: solana.EncodingJSONParsed}).validate())
+	require.Error(t, (&GetBlockOpts{Commitment: CommitmentProcessed}).validate())
+
+	_, err = getTokenAccountsParams(solana.PublicKey{}, nil, nil)
+	require.Error(t, err)
+	params, err := getTokenAccountsParams(
+		solana.PublicKey{},
+		&GetTokenAccountsConfig{ProgramId: solana.TokenProgramID.ToPointer()},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		[]interface{}{
+			solana.PublicKey{},
+			M{"programId": solana.TokenProgramID.ToPointer()},
+			M{"encoding": solana.EncodingBase64},
+		},
+		params,
+	)
+}
+
+// recordingNode is a JSONRPCClient that records the params of the calls,
+// and returns null results.
+type recordingNode struct {
+	params [][]interface{}
+}
+
+func (node *recordingNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	node.params = append(node.params, params)
+	return nil
+}
+
+func (node *recordingNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestClient_MaxSupportedTransactionVersion(t *testing.T) {
+	ctx := context.Background()
+	node := &recordingNode{}
+	client := NewWithCustomRPCClient(node)
+	require.Nil(t, client.MaxSupportedTransactionVersion())
+
+	// No default.
+	client.GetTransaction(ctx, solana.Signature{}, nil)
+	require.Len(t, node.params[0], 1)
+
+	zero := uint64(0)
+	client.SetMaxSupportedTransactionVersion(&zero)
+	require.Equal(t, &zero, client.MaxSupportedTransactionVersion())
+
+	client.GetTransaction(ctx, solana.Signature{}, nil)
+	require.Equal(t, M{"maxSupportedTransactionVersion": uint64(0)}, node.params[1][1])
+
+	client.GetBlockWithOpts(ctx, 1, nil)
+	require.Equal(t, M{"encoding": solana.EncodingBase64, "maxSupportedTransactionVersion": uint64(0)}, node.params[2][1])
+
+	client.GetParsedTransaction(ctx, solana.Signature{}, nil)
+	require.Equal(t, M{"encoding": solana.EncodingJSONParsed, "maxSupportedTransactionVersion": uint64(0)}, node.params[3][1])
+
+	// The value in the options takes precedence.
+	one := uint64(1)
+	client.GetTransaction(ctx, solana.Signature{}, &GetTransactionOpts{MaxSupportedTransactionVersion: &one})
+	require.Equal(t, M{"maxSupportedTransactionVersion": uint64(1)}, node.params[4][1])
+
+	// The legacy methods don't support it.
+	require.Equal(t,
+		[]interface{}{"sig", M{"encoding": solana.EncodingBase64}},
+		legacyParams([]interface{}{"sig", M{"encoding": solana.EncodingBase64, "maxSupportedTransactionVersion": uint64(0)}}),
+	)
+}
+
+func TestClient_MinContextSlot(t *testing.T) {
+	ctx := context.Background()
+	node := &recordingNode{}
+	client := NewWithCustomRPCClient(node)
+	slot := uint64(100)
+	opts := &ContextOpts{Commitment: CommitmentConfirmed, MinContextSlot: &slot}
+	expected := M{"commitment": CommitmentConfirmed, "minContextSlot": slot}
+
+	client.GetSlotWithOpts(ctx, opts)
+	require.Equal(t, []interface{}{expected}, node.params[0])
+
+	client.GetBalanceWithOpts(ctx, solana.SystemProgramID, opts)
+	require.Equal(t, []interface{}{solana.SystemProgramID, expected}, node.params[1])
+
+	client.IsBlockhashValidWithOpts(ctx, solana.Hash{1}, opts)
+	require.Equal(t, []interface{}{solana.Hash{1}, expected}, node.params[2])
+
+	// Empty options are omitted.
+	client.GetLatestBlockhashWithOpts(ctx, &ContextOpts{})
+	require.Empty(t, node.params[3])
+	client.GetEpochInfoWithOpts(ctx, nil)
+	require.Empty(t, node.params[4])
+
+	require.Equal(t, slot, (&GetProgramAccountsOpts{MinContextSlot: &slot}).ToMap()["minContextSlot"])
+	require.Equal(t, slot, (&GetTokenAccountsOpts{MinContextSlot: &slot}).ToMap()["minContextSlot"])
+	require.Equal(t, slot, (&SimulateTransactionOpts{MinContextSlot: &slot}).ToMap()["minContextSlot"])
+	require.Equal(t, slot, (&GetBlockProductionOpts{MinContextSlot: &slot}).ToMap()["minContextSlot"])
+	require.Equal(t, slot, (&GetInflationRewardOpts{MinContextSlot: &slot}).ToMap()["minContextSlot"])
+	require.Equal(t, slot, (&TransactionOpts{MinContextSlot: &slot}).ToMap()["minContextSlot"])
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// PreflightError is returned by the sendTransaction calls when the preflight
+// simulation of the transaction failed; it carries the result of the
+// simulation (notably the program logs), which the node returns in
+// the data of the JSON-RPC error.
+// It unwraps to the *jsonrpc.RPCError returned by the node: use errors.As
+// rather than a type assertion to get it.
+type PreflightError struct {
+	// The JSON-RPC error code (ErrorCodeSendTransactionPreflightFailure).
+	Code int
+
+	// The message of the node.
+	Message string
+
+	// The error of the transaction, as returned by the node.
+	Err interface{}
+
+	// The error of the transaction, decoded; nil if it couldn't be decoded.
+	TransactionError *TransactionError
+
+	// The log messages of the simulation; nil if the simulation failed
+	// before the transaction was executed (e.g. because of an invalid blockhash).
+	Logs []string
+
+	// The compute units consumed by the simulation.
+	UnitsConsumed *uint64
+
+	rpcErr *jsonrpc.RPCError
+}
+
+func (e *PreflightError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = "transaction simulation failed"
+	}
+	return fmt.Sprintf("%s (code %d)", msg, e.Code)
+}
+
+// InstructionError returns the error of the failed instruction, if any.
+func (e *PreflightError) InstructionError() *InstructionError {
+	if e.TransactionError == nil {
+		return nil
+	}
+	return e.TransactionError.InstructionError
+}
+
+func (e *PreflightError) Unwrap() error {
+	return e.rpcErr
+}
+
+// asPreflightError returns a *PreflightError if err is a preflight
+// failure of sendTransaction, or err otherwise.
+func asPreflightError(err error) error {
+	var rpcErr *jsonrpc.RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Code != ErrorCodeSendTransactionPreflightFailure {
+		return err
+	}
+	out := &PreflightError{
+		Code:    rpcErr.Code,
+		Message: rpcErr.Message,
+		rpcErr:  rpcErr,
+	}
+	if rpcErr.Data != nil {
+		var result SimulateTransactionResult
+		data, err := json.Marshal(rpcErr.Data)
+		if err == nil && json.Unmarshal(data, &result) == nil {
+			out.Err = result.Err
+			out.TransactionError, _ = ParseTransactionError(result.Err)
+			out.Logs = result.Logs
+			out.UnitsConsumed = result.UnitsConsumed
+		}
+	}
+	return out
+}
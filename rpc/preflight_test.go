@@ -0,0 +1,61 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SendTransaction_PreflightError(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","error":{"code":-32002,"message":"Transaction simulation failed: Error processing Instruction 0: custom program error: 0x1","data":{"accounts":null,"err":{"InstructionError":[0,{"Custom":1}]},"logs":["Program 11111111111111111111111111111111 invoke [1]","Transfer: insufficient lamports 0, need 1","Program 11111111111111111111111111111111 failed: custom program error: 0x1"],"unitsConsumed":150}},"id":0}`))
+	defer closer()
+	client := New(server.URL)
+
+	_, err := client.SendEncodedTransaction(context.Background(), encodedTx)
+	var preflightErr *PreflightError
+	require.True(t, errors.As(err, &preflightErr))
+	require.Equal(t, "Transaction simulation failed: Error processing Instruction 0: custom program error: 0x1 (code -32002)", err.Error())
+	require.Len(t, preflightErr.Logs, 3)
+	require.Equal(t, "Transfer: insufficient lamports 0, need 1", preflightErr.Logs[1])
+	require.Equal(t, uint64(150), *preflightErr.UnitsConsumed)
+	require.NotNil(t, preflightErr.Err)
+	instrErr := preflightErr.InstructionError()
+	require.NotNil(t, instrErr)
+	require.Equal(t, 0, instrErr.Index)
+	require.Equal(t, uint32(1), *instrErr.Custom)
+
+	// It is still a JSON-RPC error.
+	var rpcErr *jsonrpc.RPCError
+	require.True(t, errors.As(err, &rpcErr))
+	require.Equal(t, ErrorCodeSendTransactionPreflightFailure, rpcErr.Code)
+}
+
+func TestClient_SendTransaction_OtherError(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","error":{"code":-32003,"message":"Transaction signature verification failure"},"id":0}`))
+	defer closer()
+	client := New(server.URL)
+
+	_, err := client.SendEncodedTransaction(context.Background(), encodedTx)
+	var preflightErr *PreflightError
+	require.False(t, errors.As(err, &preflightErr))
+	var rpcErr *jsonrpc.RPCError
+	require.True(t, errors.As(err, &rpcErr))
+}
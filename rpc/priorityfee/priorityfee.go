@@ -0,0 +1,151 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package priorityfee estimates the compute unit price (the priority fee)
+// a transaction should pay to land, from the prioritization fees recently
+// paid by the transactions locking the same writable accounts.
+package priorityfee
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+type EstimatorOpts struct {
+	// Percentile (from 0 to 100) of the recent prioritization fees
+	// recommended. Defaults to 75.
+	Percentile int
+
+	// Number of most recent slots sampled; zero samples
+	// all the slots returned by the node (up to 150).
+	Slots int
+
+	// Bounds of the recommended compute unit price, in micro-lamports;
+	// zero Max means no upper bound.
+	Min uint64
+	Max uint64
+}
+
+// Estimator recommends compute unit prices by sampling
+// getRecentPrioritizationFees.
+type Estimator struct {
+	client *rpc.Client
+	opts   EstimatorOpts
+}
+
+// NewEstimator creates a priority fee estimator.
+func NewEstimator(client *rpc.Client, opts *EstimatorOpts) *Estimator {
+	e := &Estimator{
+		client: client,
+	}
+	if opts != nil {
+		e.opts = *opts
+	}
+	if e.opts.Percentile <= 0 || e.opts.Percentile > 100 {
+		e.opts.Percentile = 75
+	}
+	return e
+}
+
+// Estimate returns the recommended compute unit price, in micro-lamports,
+// of a transaction writing the accounts (only the first
+// rpc.MaxPrioritizationFeesAccounts are sampled); with no accounts,
+// it is based on the fees of the whole cluster.
+func (e *Estimator) Estimate(ctx context.Context, accounts []solana.PublicKey) (uint64, error) {
+	if len(accounts) > rpc.MaxPrioritizationFeesAccounts {
+		accounts = accounts[:rpc.MaxPrioritizationFeesAccounts]
+	}
+	fees, err := e.client.GetRecentPrioritizationFees(ctx, accounts)
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(fees, func(i, j int) bool { return fees[i].Slot > fees[j].Slot })
+	if e.opts.Slots > 0 && len(fees) > e.opts.Slots {
+		fees = fees[:e.opts.Slots]
+	}
+	prices := make([]uint64, len(fees))
+	for i, fee := range fees {
+		prices[i] = fee.PrioritizationFee
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i] < prices[j] })
+
+	price := percentile(prices, e.opts.Percentile)
+	if price < e.opts.Min {
+		price = e.opts.Min
+	}
+	if e.opts.Max > 0 && price > e.opts.Max {
+		price = e.opts.Max
+	}
+	return price, nil
+}
+
+// EstimateForTransaction returns the recommended compute unit price of the
+// transaction, from its writable accounts; the address table lookups of
+// a versioned transaction must be resolved (see solana.Message.SetAddressTables).
+func (e *Estimator) EstimateForTransaction(ctx context.Context, tx *solana.Transaction) (uint64, error) {
+	writable, err := tx.Message.Writable()
+	if err != nil {
+		return 0, err
+	}
+	return e.Estimate(ctx, writable)
+}
+
+// Apply estimates the compute unit price of the instructions, from their
+// writable accounts, and returns them with a SetComputeUnitPrice instruction
+// of that price, in place of any they already had, and the price.
+func (e *Estimator) Apply(ctx context.Context, instructions []solana.Instruction) ([]solana.Instruction, uint64, error) {
+	var writable solana.PublicKeySlice
+	out := make([]solana.Instruction, 0, len(instructions)+1)
+	for _, inst := range instructions {
+		if isSetComputeUnitPrice(inst) {
+			continue
+		}
+		for _, account := range inst.Accounts() {
+			if account.IsWritable {
+				writable.UniqueAppend(account.PublicKey)
+			}
+		}
+		out = append(out, inst)
+	}
+	price, err := e.Estimate(ctx, writable)
+	if err != nil {
+		return nil, 0, err
+	}
+	out = append([]solana.Instruction{computebudget.NewSetComputeUnitPriceInstruction(price).Build()}, out...)
+	return out, price, nil
+}
+
+func isSetComputeUnitPrice(inst solana.Instruction) bool {
+	if !inst.ProgramID().Equals(solana.ComputeBudget) {
+		return false
+	}
+	data, err := inst.Data()
+	return err == nil && len(data) > 0 && data[0] == computebudget.Instruction_SetComputeUnitPrice
+}
+
+// percentile returns the nearest-rank percentile of the sorted values.
+func percentile(sorted []uint64, p int) uint64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
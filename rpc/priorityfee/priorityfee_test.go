@@ -0,0 +1,104 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorityfee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func newFeesServer(t *testing.T, accounts *[]solana.PublicKey) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var body struct {
+			Method string               `json:"method"`
+			Params [][]solana.PublicKey `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		require.Equal(t, "getRecentPrioritizationFees", body.Method)
+		*accounts = body.Params[0]
+		rw.Write([]byte(`{"jsonrpc":"2.0","id":0,"result":[
+			{"slot":10,"prioritizationFee":0},
+			{"slot":14,"prioritizationFee":400},
+			{"slot":11,"prioritizationFee":100},
+			{"slot":13,"prioritizationFee":300},
+			{"slot":12,"prioritizationFee":200}
+		]}`))
+	}))
+}
+
+func TestEstimator_Estimate(t *testing.T) {
+	var accounts []solana.PublicKey
+	server := newFeesServer(t, &accounts)
+	defer server.Close()
+	client := rpc.New(server.URL)
+
+	price, err := NewEstimator(client, nil).Estimate(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), price)
+	require.Equal(t, []solana.PublicKey{}, accounts)
+
+	price, err = NewEstimator(client, &EstimatorOpts{Percentile: 50, Slots: 2}).Estimate(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), price)
+
+	price, err = NewEstimator(client, &EstimatorOpts{Percentile: 10, Min: 50}).Estimate(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), price)
+
+	price, err = NewEstimator(client, &EstimatorOpts{Percentile: 100, Max: 350}).Estimate(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(350), price)
+}
+
+func TestEstimator_Apply(t *testing.T) {
+	var accounts []solana.PublicKey
+	server := newFeesServer(t, &accounts)
+	defer server.Close()
+	estimator := NewEstimator(rpc.New(server.URL), nil)
+
+	from := solana.PublicKey{1}
+	to := solana.PublicKey{2}
+	instructions := []solana.Instruction{
+		computebudget.NewSetComputeUnitLimitInstruction(1000).Build(),
+		computebudget.NewSetComputeUnitPriceInstruction(1).Build(),
+		system.NewTransferInstruction(1, from, to).Build(),
+	}
+	out, price, err := estimator.Apply(context.Background(), instructions)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), price)
+	require.Equal(t, []solana.PublicKey{from, to}, accounts)
+	require.Len(t, out, 3)
+	data, err := out[0].Data()
+	require.NoError(t, err)
+	require.Equal(t, []byte{3, 0x2c, 0x01, 0, 0, 0, 0, 0, 0}, data)
+	require.Equal(t, instructions[0], out[1])
+	require.Equal(t, instructions[2], out[2])
+
+	tx, err := solana.NewTransaction(out, solana.Hash{})
+	require.NoError(t, err)
+	price, err = estimator.EstimateForTransaction(context.Background(), tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), price)
+	require.Equal(t, []solana.PublicKey{from, to}, accounts)
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// RentState is the rent state of an account.
+type RentState string
+
+const (
+	// The account has no lamports (i.e. it does not exist).
+	RentStateUninitialized RentState = "uninitialized"
+
+	// The balance of the account is above the minimum balance for rent exemption.
+	RentStateExempt RentState = "exempt"
+
+	// The balance of the account is below the minimum balance for rent exemption:
+	// a legacy account, created before rent exemption was mandatory. Rent is not
+	// collected anymore, but a transaction can only modify the account if it
+	// leaves it rent exempt or closes it.
+	RentStatePaying RentState = "paying"
+)
+
+// AccountRent is the rent status of an account.
+type AccountRent struct {
+	State RentState
+
+	DataLen        uint64
+	Lamports       uint64
+	MinimumBalance uint64
+
+	// Lamports missing to be rent exempt (zero if exempt).
+	Deficit uint64
+	// Lamports above the minimum balance (zero if not exempt).
+	Excess uint64
+
+	RentEpoch uint64
+	// Whether the runtime marked the account as rent exempt (see solana.RentExemptRentEpoch).
+	// Otherwise the account was not written since the marking started, and RentEpoch
+	// is the epoch after its last rent collection, which hints at its age.
+	MarkedExempt bool
+}
+
+// AtRisk returns true for the rent paying accounts, which can not be
+// modified without topping them up to the minimum balance.
+func (r *AccountRent) AtRisk() bool {
+	return r.State == RentStatePaying
+}
+
+// ErrUnknownDataLen is returned by AccountRentStatus when the data length
+// of the account is unknown (jsonParsed or sliced data, without the space field).
+var ErrUnknownDataLen = errors.New("unknown account data length")
+
+// DataLen returns the data length of the account: its space if provided by the node,
+// otherwise the length of its binary data, unless the data is jsonParsed.
+// With sliced data, only the space is accurate.
+func (a *Account) DataLen() (uint64, bool) {
+	if a.Space != nil {
+		return *a.Space, true
+	}
+	if a.Data == nil {
+		return 0, true
+	}
+	if a.Data.GetRawJSON() != nil {
+		return 0, false
+	}
+	return uint64(len(a.Data.GetBinary())), true
+}
+
+// AccountRentStatus returns the rent status of the account, with the provided
+// rent parameters (solana.DefaultRent() if nil).
+func AccountRentStatus(account *Account, rent *solana.Rent) (*AccountRent, error) {
+	if account == nil {
+		return nil, ErrNilAccount
+	}
+	if rent == nil {
+		rent = solana.DefaultRent()
+	}
+	dataLen, ok := account.DataLen()
+	if !ok {
+		return nil, ErrUnknownDataLen
+	}
+	out := &AccountRent{
+		DataLen:        dataLen,
+		Lamports:       account.Lamports,
+		MinimumBalance: rent.MinimumBalance(dataLen),
+		RentEpoch:      account.RentEpoch,
+		MarkedExempt:   account.RentEpoch == solana.RentExemptRentEpoch,
+	}
+	switch {
+	case account.Lamports == 0:
+		out.State = RentStateUninitialized
+		out.Deficit = out.MinimumBalance
+	case account.Lamports >= out.MinimumBalance:
+		out.State = RentStateExempt
+		out.Excess = account.Lamports - out.MinimumBalance
+	default:
+		out.State = RentStatePaying
+		out.Deficit = out.MinimumBalance - account.Lamports
+	}
+	return out, nil
+}
+
+// FilterRentPaying returns the rent paying accounts, the ones at risk,
+// among the provided accounts (e.g. the result of GetProgramAccountsWithOpts).
+func FilterRentPaying(accounts []*KeyedAccount, rent *solana.Rent) ([]*KeyedAccount, error) {
+	var out []*KeyedAccount
+	for _, account := range accounts {
+		status, err := AccountRentStatus(account.Account, rent)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", account.Pubkey, err)
+		}
+		if status.AtRisk() {
+			out = append(out, account)
+		}
+	}
+	return out, nil
+}
+
+// GetRent fetches and decodes the Rent sysvar.
+func (cl *Client) GetRent(ctx context.Context, commitment CommitmentType) (*solana.Rent, error) {
+	out, err := cl.GetAccountInfoWithOpts(ctx, solana.SysVarRentPubkey, &GetAccountInfoOpts{
+		Commitment: commitment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return solana.DecodeRent(out.Value.Data.GetBinary())
+}
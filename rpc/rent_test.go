@@ -0,0 +1,104 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"fmt"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountRentStatus(t *testing.T) {
+	account := func(lamports uint64, dataLen int, rentEpoch uint64) *Account {
+		return &Account{
+			Lamports:  lamports,
+			Data:      DataBytesOrJSONFromBytes(make([]byte, dataLen)),
+			RentEpoch: rentEpoch,
+		}
+	}
+
+	status, err := AccountRentStatus(account(2039280+10, 165, solana.RentExemptRentEpoch), nil)
+	require.NoError(t, err)
+	require.Equal(t, &AccountRent{
+		State:          RentStateExempt,
+		DataLen:        165,
+		Lamports:       2039290,
+		MinimumBalance: 2039280,
+		Excess:         10,
+		RentEpoch:      solana.RentExemptRentEpoch,
+		MarkedExempt:   true,
+	}, status)
+	require.False(t, status.AtRisk())
+
+	status, err = AccountRentStatus(account(1000000, 165, 300), nil)
+	require.NoError(t, err)
+	require.Equal(t, RentStatePaying, status.State)
+	require.Equal(t, uint64(1039280), status.Deficit)
+	require.False(t, status.MarkedExempt)
+	require.True(t, status.AtRisk())
+
+	status, err = AccountRentStatus(account(0, 0, 0), nil)
+	require.NoError(t, err)
+	require.Equal(t, RentStateUninitialized, status.State)
+
+	// With the data sliced away, the space is used.
+	space := uint64(165)
+	sliced := account(1000000, 0, 300)
+	sliced.Space = &space
+	status, err = AccountRentStatus(sliced, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(165), status.DataLen)
+	require.True(t, status.AtRisk())
+
+	// Custom rent parameters.
+	status, err = AccountRentStatus(account(1000000, 165, 300), &solana.Rent{LamportsPerByteYear: 1, ExemptionThreshold: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(586), status.MinimumBalance)
+	require.Equal(t, RentStateExempt, status.State)
+
+	parsed := &Account{Lamports: 1, Data: &DataBytesOrJSON{rawDataEncoding: solana.EncodingJSONParsed, asJSON: stdjson.RawMessage(`{}`)}}
+	_, err = AccountRentStatus(parsed, nil)
+	require.Equal(t, ErrUnknownDataLen, err)
+	_, err = AccountRentStatus(nil, nil)
+	require.Equal(t, ErrNilAccount, err)
+
+	atRisk, err := FilterRentPaying([]*KeyedAccount{
+		{Pubkey: solana.PublicKey{1}, Account: account(2039280, 165, solana.RentExemptRentEpoch)},
+		{Pubkey: solana.PublicKey{2}, Account: account(1000000, 165, 300)},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, atRisk, 1)
+	require.Equal(t, solana.PublicKey{2}, atRisk[0].Pubkey)
+}
+
+func TestClient_GetRent(t *testing.T) {
+	buf := new(bytes.Buffer)
+	require.NoError(t, bin.NewBinEncoder(buf).Encode(solana.DefaultRent()))
+	responseBody := fmt.Sprintf(`{"context":{"slot":1},"value":{"data":[%q,"base64"],"executable":false,"lamports":1009200,"owner":"Sysvar1111111111111111111111111111111111111","rentEpoch":18446744073709551615,"space":17}}`,
+		base64.StdEncoding.EncodeToString(buf.Bytes()))
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
+	defer closer()
+
+	rent, err := New(server.URL).GetRent(context.Background(), CommitmentFinalized)
+	require.NoError(t, err)
+	require.Equal(t, solana.DefaultRent(), rent)
+}
@@ -34,11 +34,7 @@ func (cl *Client) RequestAirdrop(
 		account,
 		lamports,
 	}
-	if commitment != "" {
-		params = append(params,
-			M{"commitment": commitment},
-		)
-	}
+	params = (&ContextOpts{Commitment: commitment}).appendTo(params)
 	err = cl.rpcClient.CallForInto(ctx, &signature, "requestAirdrop", params)
 	return
 }
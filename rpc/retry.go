@@ -0,0 +1,176 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures the retries of the transient failures:
+// 429 Too Many Requests, 5xx responses and connection resets.
+type RetryPolicy struct {
+	// Maximum number of retries of a request.
+	// Defaults to 3; a negative value disables the retries.
+	MaxRetries int
+
+	// Delay before the first retry, doubled at each retry (with jitter).
+	// Defaults to 250ms.
+	MinBackoff time.Duration
+
+	// Maximum delay between two attempts, including the delays
+	// requested by the Retry-After header. Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+func (policy RetryPolicy) withDefaults() RetryPolicy {
+	if policy.MaxRetries == 0 {
+		policy.MaxRetries = 3
+	}
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = 250 * time.Millisecond
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = 30 * time.Second
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		policy.MaxBackoff = policy.MinBackoff
+	}
+	return policy
+}
+
+// backoff returns the delay before the retry number attempt (starting at 0):
+// an exponential backoff with a random jitter of up to half of it.
+func (policy RetryPolicy) backoff(attempt int) time.Duration {
+	delay := policy.MaxBackoff
+	if attempt < 32 {
+		if d := policy.MinBackoff << uint(attempt); d > 0 && d < policy.MaxBackoff {
+			delay = d
+		}
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// NewWithRetry creates a new Solana JSON RPC client
+// retrying the transient failures according to the policy.
+func NewWithRetry(rpcEndpoint string, policy *RetryPolicy) *Client {
+	return NewWithMiddlewares(rpcEndpoint, RetryMiddleware(policy))
+}
+
+// NewRetryTransport wraps an HTTP transport to retry the transient failures
+// according to the policy (nil for the default policy), with exponential backoff
+// and jitter, honoring the Retry-After header of the responses.
+// Use it in the HTTP client of a custom JSON-RPC client.
+func NewRetryTransport(transport http.RoundTripper, policy *RetryPolicy) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	var p RetryPolicy
+	if policy != nil {
+		p = *policy
+	}
+	return &retryTransport{
+		transport: transport,
+		policy:    p.withDefaults(),
+	}
+}
+
+type retryTransport struct {
+	transport http.RoundTripper
+	policy    RetryPolicy
+}
+
+func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := rt.transport.RoundTrip(req)
+		if attempt >= rt.policy.MaxRetries || !isRetryable(resp, err) {
+			return resp, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			// The body cannot be sent again.
+			return resp, err
+		}
+
+		delay := rt.policy.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				delay = retryAfter
+			}
+			if delay > rt.policy.MaxBackoff {
+				delay = rt.policy.MaxBackoff
+			}
+			// Drain the body to reuse the connection.
+			io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+
+		logRetry(req, attempt+2, delay, resp, err)
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// isRetryable returns true if the request failed with a transient error.
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, io.EOF) ||
+			errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// parseRetryAfter parses the value of a Retry-After header,
+// either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
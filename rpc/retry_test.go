@@ -0,0 +1,133 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryTestClient(url string, policy *RetryPolicy) *Client {
+	return NewWithCustomRPCClient(jsonrpc.NewClientWithOpts(url, &jsonrpc.RPCClientOpts{
+		HTTPClient: &http.Client{Transport: NewRetryTransport(nil, policy)},
+	}))
+}
+
+func TestRetryTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), `"method":"getSlot"`)
+
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			rw.WriteHeader(http.StatusBadGateway)
+		case 3:
+			// Connection reset.
+			conn, _, err := rw.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+		default:
+			rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+		}
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL, &RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})
+	slot, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), slot)
+	require.Equal(t, int32(4), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransport_GiveUp(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL, &RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond})
+	_, err := client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	httpErr, ok := err.(*jsonrpc.HTTPError)
+	require.True(t, ok, "%T", err)
+	require.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// Not retried.
+	atomic.StoreInt32(&calls, 0)
+	client = newRetryTestClient(server.URL, &RetryPolicy{MaxRetries: -1})
+	_, err = client.GetSlot(context.Background(), "")
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransport_Context(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Retry-After", "3600")
+		rw.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	client := newRetryTestClient(server.URL, &RetryPolicy{MaxBackoff: time.Hour})
+	_, err := client.GetSlot(ctx, "")
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	delay, ok := parseRetryAfter("120")
+	require.True(t, ok)
+	require.Equal(t, 2*time.Minute, delay)
+
+	delay, ok = parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	require.True(t, ok)
+	require.InDelta(t, float64(time.Hour), float64(delay), float64(2*time.Second))
+
+	_, ok = parseRetryAfter("")
+	require.False(t, ok)
+	_, ok = parseRetryAfter("soon")
+	require.False(t, ok)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}.withDefaults()
+	for attempt, max := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max *= time.Millisecond
+		for i := 0; i < 20; i++ {
+			delay := policy.backoff(attempt)
+			require.True(t, delay >= max/2 && delay <= max, "attempt %d: %s", attempt, delay)
+		}
+	}
+	// No overflow on large attempts.
+	delay := policy.backoff(100)
+	require.True(t, delay >= time.Second/2 && delay <= time.Second, delay.String())
+}
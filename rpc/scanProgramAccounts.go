@@ -0,0 +1,175 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+	"golang.org/x/time/rate"
+)
+
+// ScanProgramAccountsOpts are the options of ScanProgramAccounts.
+type ScanProgramAccountsOpts struct {
+	// The options of every sub-query; the filters of each bucket
+	// are added to the filters of the options.
+	GetProgramAccountsOpts
+
+	// Each bucket is a set of filters that selects a part of the accounts;
+	// one getProgramAccounts request is made for each bucket.
+	// See DataSizeBuckets and MemcmpPrefixBuckets.
+	// If empty, a single request is made.
+	Buckets [][]RPCFilter
+
+	// Limits the rate of the sub-queries. Optional.
+	// See ProgramLimiters to share a limiter between the scans of the same program.
+	Limiter *rate.Limiter
+
+	// Called after each sub-query with the bucket index and the number of
+	// accounts it returned (before deduplication). Optional.
+	OnBucket func(bucket int, count int)
+}
+
+// ScanProgramAccounts scans the accounts owned by the provided program
+// by splitting the scan in buckets, which are fetched sequentially
+// (optionally rate limited), and merges the results, removing duplicates
+// (e.g. in case of overlapping buckets).
+// Use it instead of GetProgramAccountsWithOpts when a single request times out
+// (e.g. for the Token program).
+func (cl *Client) ScanProgramAccounts(
+	ctx context.Context,
+	programID solana.PublicKey,
+	opts *ScanProgramAccountsOpts,
+) (out GetProgramAccountsResult, err error) {
+	if opts == nil {
+		opts = &ScanProgramAccountsOpts{}
+	}
+	buckets := opts.Buckets
+	if len(buckets) == 0 {
+		buckets = [][]RPCFilter{nil}
+	}
+
+	seen := make(map[solana.PublicKey]struct{})
+	for i, bucket := range buckets {
+		if opts.Limiter != nil {
+			if err := opts.Limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		subOpts := opts.GetProgramAccountsOpts
+		subOpts.Filters = append(append([]RPCFilter{}, opts.Filters...), bucket...)
+
+		accounts, err := cl.GetProgramAccountsWithOpts(ctx, programID, &subOpts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to scan bucket %d: %w", i, err)
+		}
+		if opts.OnBucket != nil {
+			opts.OnBucket(i, len(accounts))
+		}
+		for _, account := range accounts {
+			if account == nil {
+				continue
+			}
+			if _, ok := seen[account.Pubkey]; ok {
+				continue
+			}
+			seen[account.Pubkey] = struct{}{}
+			out = append(out, account)
+		}
+	}
+	return out, nil
+}
+
+// DataSizeBuckets returns one bucket for each of the provided account sizes.
+func DataSizeBuckets(sizes ...uint64) [][]RPCFilter {
+	buckets := make([][]RPCFilter, len(sizes))
+	for i, size := range sizes {
+		buckets[i] = []RPCFilter{{DataSize: size}}
+	}
+	return buckets
+}
+
+// MemcmpPrefixBuckets returns 256^prefixLen buckets, one for each possible
+// prefix of prefixLen bytes at the provided offset of the account data
+// (e.g. the first byte of the mint, at offset 0, for token accounts).
+// The buckets cover all the accounts with at least offset+prefixLen bytes of data.
+// prefixLen must be 1 or 2.
+func MemcmpPrefixBuckets(offset uint64, prefixLen int) [][]RPCFilter {
+	if prefixLen < 1 || prefixLen > 2 {
+		panic(fmt.Sprintf("invalid prefix length: %d", prefixLen))
+	}
+	count := 1 << (8 * prefixLen)
+	buckets := make([][]RPCFilter, count)
+	for i := 0; i < count; i++ {
+		prefix := []byte{byte(i)}
+		if prefixLen == 2 {
+			prefix = []byte{byte(i >> 8), byte(i)}
+		}
+		buckets[i] = []RPCFilter{
+			{
+				Memcmp: &RPCFilterMemcmp{
+					Offset: offset,
+					Bytes:  solana.Base58(prefix),
+				},
+			},
+		}
+	}
+	return buckets
+}
+
+// CombineBuckets returns the cartesian product of the provided buckets
+// (e.g. to split each dataSize bucket by memcmp prefixes).
+func CombineBuckets(a [][]RPCFilter, b [][]RPCFilter) [][]RPCFilter {
+	out := make([][]RPCFilter, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			out = append(out, append(append([]RPCFilter{}, x...), y...))
+		}
+	}
+	return out
+}
+
+// ProgramLimiters holds one rate limiter for each program,
+// so that concurrent scans of the same program share their rate limit.
+type ProgramLimiters struct {
+	limit    rate.Limit
+	burst    int
+	mu       sync.Mutex
+	limiters map[solana.PublicKey]*rate.Limiter
+}
+
+// NewProgramLimiters creates a new set of per-program limiters.
+// Example: NewProgramLimiters(rate.Every(time.Second), 1)
+func NewProgramLimiters(limit rate.Limit, burst int) *ProgramLimiters {
+	return &ProgramLimiters{
+		limit:    limit,
+		burst:    burst,
+		limiters: make(map[solana.PublicKey]*rate.Limiter),
+	}
+}
+
+// Get returns the limiter of the provided program.
+func (pl *ProgramLimiters) Get(programID solana.PublicKey) *rate.Limiter {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	limiter, ok := pl.limiters[programID]
+	if !ok {
+		limiter = rate.NewLimiter(pl.limit, pl.burst)
+		pl.limiters[programID] = limiter
+	}
+	return limiter
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+// gpaNode is a JSONRPCClient that returns the accounts of a program by dataSize.
+type gpaNode struct {
+	bySize  map[uint64][]solana.PublicKey
+	filters [][]RPCFilter
+}
+
+func (node *gpaNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if method != "getProgramAccounts" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	filters, _ := params[1].(M)["filters"].([]RPCFilter)
+	node.filters = append(node.filters, filters)
+	var accounts []string
+	for _, filter := range filters {
+		for _, key := range node.bySize[filter.DataSize] {
+			accounts = append(accounts, fmt.Sprintf(
+				`{"pubkey":%q,"account":{"lamports":1,"owner":%q,"data":["","base64"],"executable":false,"rentEpoch":0}}`,
+				key, solana.TokenProgramID,
+			))
+		}
+	}
+	body := "["
+	for i, account := range accounts {
+		if i > 0 {
+			body += ","
+		}
+		body += account
+	}
+	return stdjson.Unmarshal([]byte(body+"]"), out)
+}
+
+func (node *gpaNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestScanProgramAccounts(t *testing.T) {
+	a, b, c := solana.PublicKey{1}, solana.PublicKey{2}, solana.PublicKey{3}
+	node := &gpaNode{
+		bySize: map[uint64][]solana.PublicKey{
+			165: {a, b},
+			82:  {c, a}, // a is duplicated
+		},
+	}
+	client := NewWithCustomRPCClient(node)
+
+	var counts []int
+	out, err := client.ScanProgramAccounts(context.Background(), solana.TokenProgramID, &ScanProgramAccountsOpts{
+		GetProgramAccountsOpts: GetProgramAccountsOpts{
+			Filters: []RPCFilter{{Memcmp: &RPCFilterMemcmp{Offset: 32, Bytes: solana.Base58{9}}}},
+		},
+		Buckets:  DataSizeBuckets(165, 82),
+		Limiter:  NewProgramLimiters(1000, 1).Get(solana.TokenProgramID),
+		OnBucket: func(bucket int, count int) { counts = append(counts, count) },
+	})
+	require.NoError(t, err)
+	require.Len(t, out, 3)
+	require.Equal(t, a, out[0].Pubkey)
+	require.Equal(t, b, out[1].Pubkey)
+	require.Equal(t, c, out[2].Pubkey)
+	require.Equal(t, []int{2, 2}, counts)
+
+	// The base filters are kept in every sub-query.
+	require.Len(t, node.filters, 2)
+	for _, filters := range node.filters {
+		require.Len(t, filters, 2)
+		require.Equal(t, uint64(32), filters[0].Memcmp.Offset)
+	}
+}
+
+func TestMemcmpPrefixBuckets(t *testing.T) {
+	buckets := MemcmpPrefixBuckets(0, 1)
+	require.Len(t, buckets, 256)
+	require.Equal(t, solana.Base58{0xff}, buckets[255][0].Memcmp.Bytes)
+
+	buckets = MemcmpPrefixBuckets(32, 2)
+	require.Len(t, buckets, 65536)
+	require.Equal(t, solana.Base58{0x01, 0x02}, buckets[0x0102][0].Memcmp.Bytes)
+
+	combined := CombineBuckets(DataSizeBuckets(165, 82), MemcmpPrefixBuckets(0, 1))
+	require.Len(t, combined, 512)
+	require.Equal(t, uint64(82), combined[256][0].DataSize)
+
+	limiters := NewProgramLimiters(1, 1)
+	require.True(t, limiters.Get(solana.TokenProgramID) == limiters.Get(solana.TokenProgramID))
+	require.False(t, limiters.Get(solana.TokenProgramID) == limiters.Get(solana.Token2022ProgramID))
+}
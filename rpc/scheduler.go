@@ -0,0 +1,269 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// Priority is the class of a request waiting on a Scheduler;
+// the requests of a class are sent before those of the classes below.
+type Priority int
+
+const (
+	// Requests a user is waiting for.
+	PriorityInteractive Priority = iota
+	PriorityNormal
+	// Bulk requests, e.g. of a backfill job, sent with the quota left.
+	PriorityBackfill
+
+	numPriorities = int(PriorityBackfill) + 1
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityInteractive:
+		return "interactive"
+	case PriorityNormal:
+		return "normal"
+	case PriorityBackfill:
+		return "backfill"
+	default:
+		return fmt.Sprintf("Priority(%d)", int(p))
+	}
+}
+
+type priorityKey struct{}
+
+// WithPriority returns a copy of the context holding the priority of the
+// requests made with it through a scheduled client (see NewScheduledClient),
+// overriding the priority of the client.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the priority held by the context
+// (see WithPriority), and whether there is one.
+func PriorityFromContext(ctx context.Context) (Priority, bool) {
+	priority, ok := ctx.Value(priorityKey{}).(Priority)
+	return priority, ok
+}
+
+// Scheduler caps the requests per second of all the clients sharing it,
+// e.g. all the clients of a process using the quota of a provider.
+// The quota is a bucket of burst requests, refilled at the rate; when it is
+// empty, the requests wait in line by priority: a queued interactive request
+// goes before all queued backfill requests, whatever their arrival order.
+type Scheduler struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	queues [numPriorities][]*scheduledWaiter
+	timer  *time.Timer
+}
+
+type scheduledWaiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+// NewScheduler creates a scheduler allowing rps requests per second,
+// and bursts of up to burst requests (at least 1).
+func NewScheduler(rps float64, burst int) *Scheduler {
+	if burst < 1 {
+		burst = 1
+	}
+	s := &Scheduler{
+		rate:   rps,
+		burst:  float64(burst),
+		now:    time.Now,
+		tokens: float64(burst),
+	}
+	s.last = s.now()
+	return s
+}
+
+// Wait waits for the turn of a request of the priority,
+// or returns the error of the context if it is done first.
+func (s *Scheduler) Wait(ctx context.Context, priority Priority) error {
+	if priority < 0 {
+		priority = 0
+	}
+	if int(priority) >= numPriorities {
+		priority = Priority(numPriorities - 1)
+	}
+	waiter := &scheduledWaiter{ready: make(chan struct{})}
+	s.mu.Lock()
+	s.queues[priority] = append(s.queues[priority], waiter)
+	s.dispatchLocked()
+	s.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-ctx.Done():
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if waiter.granted {
+		// Give the turn back to the next request.
+		s.tokens++
+		s.dispatchLocked()
+		return ctx.Err()
+	}
+	queue := s.queues[priority]
+	for i, w := range queue {
+		if w == waiter {
+			s.queues[priority] = append(queue[:i:i], queue[i+1:]...)
+			break
+		}
+	}
+	return ctx.Err()
+}
+
+// Pending returns the number of requests of the priority waiting for their turn.
+func (s *Scheduler) Pending(priority Priority) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if priority < 0 || int(priority) >= numPriorities {
+		return 0
+	}
+	return len(s.queues[priority])
+}
+
+// dispatchLocked refills the bucket, lets through the waiters in order of
+// priority while there are tokens, and arms the timer for the next token
+// if some are left waiting.
+func (s *Scheduler) dispatchLocked() {
+	now := s.now()
+	s.tokens += now.Sub(s.last).Seconds() * s.rate
+	if s.tokens > s.burst {
+		s.tokens = s.burst
+	}
+	s.last = now
+
+	waiting := false
+	for p := range s.queues {
+		for len(s.queues[p]) > 0 && s.tokens >= 1 {
+			waiter := s.queues[p][0]
+			s.queues[p] = s.queues[p][1:]
+			s.tokens--
+			waiter.granted = true
+			close(waiter.ready)
+		}
+		if len(s.queues[p]) > 0 {
+			waiting = true
+		}
+	}
+	if !waiting || s.timer != nil || s.rate <= 0 {
+		return
+	}
+	delay := time.Duration((1 - s.tokens) / s.rate * float64(time.Second))
+	s.timer = time.AfterFunc(delay, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.timer = nil
+		s.dispatchLocked()
+	})
+}
+
+type clientWithScheduler struct {
+	rpcClient JSONRPCClient
+	scheduler *Scheduler
+	priority  Priority
+}
+
+// NewWithScheduler creates a new Solana RPC client whose requests
+// are scheduled with the priority by the shared scheduler.
+func NewWithScheduler(rpcEndpoint string, scheduler *Scheduler, priority Priority) JSONRPCClient {
+	opts := &jsonrpc.RPCClientOpts{
+		HTTPClient: newHTTP(),
+	}
+	return NewScheduledClient(jsonrpc.NewClientWithOpts(rpcEndpoint, opts), scheduler, priority)
+}
+
+// NewScheduledClient wraps the RPC client to wait for the turn of each
+// request on the scheduler, with the priority held by the context of the
+// request (see WithPriority), or else the priority of the client.
+func NewScheduledClient(rpcClient JSONRPCClient, scheduler *Scheduler, priority Priority) JSONRPCClient {
+	return &clientWithScheduler{
+		rpcClient: rpcClient,
+		scheduler: scheduler,
+		priority:  priority,
+	}
+}
+
+func (wr *clientWithScheduler) wait(ctx context.Context) error {
+	priority, ok := PriorityFromContext(ctx)
+	if !ok {
+		priority = wr.priority
+	}
+	return wr.scheduler.Wait(ctx, priority)
+}
+
+func (wr *clientWithScheduler) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	err := wr.wait(ctx)
+	if err != nil {
+		return err
+	}
+	return wr.rpcClient.CallForInto(ctx, out, method, params)
+}
+
+func (wr *clientWithScheduler) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	err := wr.wait(ctx)
+	if err != nil {
+		return err
+	}
+	return wr.rpcClient.CallWithCallback(ctx, method, params, callback)
+}
+
+// CallBatch sends the requests in a single batch, once each of them got its turn,
+// as the providers count the requests of a batch against the quota.
+func (wr *clientWithScheduler) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	batchClient, ok := wr.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrBatchNotSupported, wr.rpcClient)
+	}
+	for range requests {
+		if err := wr.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return batchClient.CallBatch(ctx, requests)
+}
+
+// Close closes clientWithScheduler.
+func (cl *clientWithScheduler) Close() error {
+	if c, ok := cl.rpcClient.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
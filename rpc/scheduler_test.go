@@ -0,0 +1,80 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Priority(t *testing.T) {
+	scheduler := NewScheduler(20, 1)
+	require.NoError(t, scheduler.Wait(context.Background(), PriorityBackfill))
+
+	done := make(chan Priority, 4)
+	wait := func(priority Priority) {
+		go func() {
+			require.NoError(t, scheduler.Wait(context.Background(), priority))
+			done <- priority
+		}()
+	}
+	for i := 0; i < 3; i++ {
+		wait(PriorityBackfill)
+	}
+	require.Eventually(t, func() bool { return scheduler.Pending(PriorityBackfill) == 3 }, time.Second, time.Millisecond)
+	wait(PriorityInteractive)
+	require.Eventually(t, func() bool { return scheduler.Pending(PriorityInteractive) == 1 }, time.Second, time.Millisecond)
+
+	// The interactive request goes before the queued backfill requests.
+	var order []Priority
+	for i := 0; i < 4; i++ {
+		order = append(order, <-done)
+	}
+	require.Equal(t, []Priority{PriorityInteractive, PriorityBackfill, PriorityBackfill, PriorityBackfill}, order)
+}
+
+func TestScheduler_Canceled(t *testing.T) {
+	scheduler := NewScheduler(0.001, 1)
+	require.NoError(t, scheduler.Wait(context.Background(), PriorityNormal))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, scheduler.Wait(ctx, PriorityNormal), context.DeadlineExceeded)
+	require.Zero(t, scheduler.Pending(PriorityNormal))
+}
+
+func TestScheduledClient(t *testing.T) {
+	scheduler := NewScheduler(0.001, 2)
+	first := &sequentialRPC{}
+	second := &sequentialRPC{}
+	backfill := NewWithCustomRPCClient(NewScheduledClient(first, scheduler, PriorityBackfill))
+	interactive := NewWithCustomRPCClient(NewScheduledClient(second, scheduler, PriorityBackfill))
+
+	_, err := backfill.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	_, err = interactive.GetSlot(WithPriority(context.Background(), PriorityInteractive), "")
+	require.NoError(t, err)
+
+	// The quota is shared by the clients.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = backfill.GetSlot(ctx, "")
+	require.Error(t, err)
+	require.Equal(t, []string{"getSlot"}, first.methods)
+	require.Equal(t, []string{"getSlot"}, second.methods)
+}
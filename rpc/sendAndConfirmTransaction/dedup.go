@@ -0,0 +1,130 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sendandconfirmtransaction
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ErrDuplicateTransaction is wrapped by the errors returned
+// when a DedupGuard refuses to send a transaction.
+var ErrDuplicateTransaction = errors.New("duplicate transaction")
+
+// DuplicateTransactionError is returned when an identical transaction
+// was sent within the window of a DedupGuard.
+type DuplicateTransactionError struct {
+	// Signature of the previous submission.
+	Signature solana.Signature
+	// Time of the previous submission.
+	SentAt time.Time
+}
+
+func (e *DuplicateTransactionError) Error() string {
+	return fmt.Sprintf("%s: identical transaction %s sent at %s", ErrDuplicateTransaction, e.Signature, e.SentAt.Format(time.RFC3339))
+}
+
+func (e *DuplicateTransactionError) Unwrap() error {
+	return ErrDuplicateTransaction
+}
+
+// DedupGuard remembers the transactions sent in the last window and
+// refuses to send an identical one again, e.g. a transfer rebuilt with a
+// fresh blockhash by a retry loop that didn't notice the first one landed.
+// Two transactions are identical if their messages only differ by the blockhash.
+// DedupGuard is safe for concurrent use.
+type DedupGuard struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu   sync.Mutex
+	sent map[[32]byte]dedupEntry
+}
+
+type dedupEntry struct {
+	signature solana.Signature
+	sentAt    time.Time
+}
+
+// NewDedupGuard creates a guard that refuses identical transactions
+// for the window after they are sent.
+func NewDedupGuard(window time.Duration) *DedupGuard {
+	return &DedupGuard{
+		window: window,
+		now:    time.Now,
+		sent:   make(map[[32]byte]dedupEntry),
+	}
+}
+
+// MessageKey returns the key of the transaction in a DedupGuard: the hash
+// of its message without the recent blockhash.
+func MessageKey(tx *solana.Transaction) ([32]byte, error) {
+	msg := tx.Message
+	msg.RecentBlockhash = solana.Hash{}
+	content, err := msg.MarshalBinary()
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("unable to encode message: %w", err)
+	}
+	return sha256.Sum256(content), nil
+}
+
+// Acquire records the transaction as sent, or returns a *DuplicateTransactionError
+// if an identical transaction was sent within the window.
+// If force is true, the transaction is recorded even if it is a duplicate.
+func (g *DedupGuard) Acquire(tx *solana.Transaction, force bool) error {
+	key, err := MessageKey(tx)
+	if err != nil {
+		return err
+	}
+	var sig solana.Signature
+	if len(tx.Signatures) > 0 {
+		sig = tx.Signatures[0]
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+	for k, entry := range g.sent {
+		if now.Sub(entry.sentAt) >= g.window {
+			delete(g.sent, k)
+		}
+	}
+	if prev, ok := g.sent[key]; ok && !force {
+		return &DuplicateTransactionError{
+			Signature: prev.signature,
+			SentAt:    prev.sentAt,
+		}
+	}
+	g.sent[key] = dedupEntry{signature: sig, sentAt: now}
+	return nil
+}
+
+// Release forgets the transaction, e.g. because it could not be sent,
+// or its blockhash expired before it landed.
+func (g *DedupGuard) Release(tx *solana.Transaction) error {
+	key, err := MessageKey(tx)
+	if err != nil {
+		return err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.sent, key)
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sendandconfirmtransaction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupGuard(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	now := time.Unix(1700000000, 0)
+	guard := NewDedupGuard(time.Minute)
+	guard.now = func() time.Time { return now }
+
+	tx := newTransferTx(t, payer, solana.Hash{1})
+	require.NoError(t, guard.Acquire(tx, false))
+
+	// Same transfer, rebuilt with another blockhash.
+	retry := newTransferTx(t, payer, solana.Hash{2})
+	retry.Message.Instructions = tx.Message.Instructions
+	retry.Message.AccountKeys = tx.Message.AccountKeys
+	err := guard.Acquire(retry, false)
+	require.True(t, errors.Is(err, ErrDuplicateTransaction))
+	var dupErr *DuplicateTransactionError
+	require.True(t, errors.As(err, &dupErr))
+	require.Equal(t, tx.Signatures[0], dupErr.Signature)
+	require.Equal(t, now, dupErr.SentAt)
+
+	// Forced.
+	require.NoError(t, guard.Acquire(retry, true))
+
+	// Another transfer.
+	require.NoError(t, guard.Acquire(newTransferTx(t, payer, solana.Hash{1}), false))
+
+	// After the window.
+	now = now.Add(time.Minute)
+	require.NoError(t, guard.Acquire(retry, false))
+
+	// Released.
+	require.NoError(t, guard.Release(retry))
+	require.NoError(t, guard.Acquire(tx, false))
+}
+
+func TestSendAndConfirmTransactionWithRefresh_Guard(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	node := &expiringNode{latest: solana.Hash{2}}
+	client := rpc.NewWithCustomRPCClient(node)
+	guard := NewDedupGuard(time.Minute)
+
+	tx := newTransferTx(t, payer, node.latest)
+	opts := &RefreshOpts{
+		PollInterval: time.Millisecond,
+		Guard:        guard,
+	}
+	_, err := SendAndConfirmTransactionWithRefresh(context.Background(), client, tx, opts)
+	require.NoError(t, err)
+
+	_, err = SendAndConfirmTransactionWithRefresh(context.Background(), client, tx, opts)
+	require.True(t, errors.Is(err, ErrDuplicateTransaction))
+	require.Len(t, node.sent, 1)
+
+	opts.Force = true
+	_, err = SendAndConfirmTransactionWithRefresh(context.Background(), client, tx, opts)
+	require.NoError(t, err)
+	require.Len(t, node.sent, 2)
+
+	// A transaction that expired is released.
+	expired := newTransferTx(t, payer, solana.Hash{1})
+	opts.Force = false
+	_, err = SendAndConfirmTransactionWithRefresh(context.Background(), client, expired, opts)
+	require.True(t, errors.Is(err, ErrBlockhashExpired))
+	require.NoError(t, guard.Acquire(expired, false))
+}
+
+func TestSendAndConfirmTransactionWithRefresh_GuardKeptWhileSeen(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	guard := NewDedupGuard(time.Minute)
+	opts := &RefreshOpts{
+		PollInterval: time.Millisecond,
+		Guard:        guard,
+	}
+
+	// Rejected, but processed by the node: it may still land.
+	node := &expiringNode{
+		latest:     solana.Hash{2},
+		landing:    100,
+		rejectSend: &jsonrpc.RPCError{Code: -32002, Message: "Transaction simulation failed: This transaction has already been processed"},
+	}
+	client := rpc.NewWithCustomRPCClient(node)
+	tx := newTransferTx(t, payer, solana.Hash{1})
+	_, err := SendAndConfirmTransactionWithRefresh(context.Background(), client, tx, opts)
+	var rpcErr *jsonrpc.RPCError
+	require.True(t, errors.As(err, &rpcErr))
+	require.True(t, errors.Is(guard.Acquire(tx, false), ErrDuplicateTransaction))
+
+	// Rejected and unknown to the node: released.
+	node.landing = 0
+	other := newTransferTx(t, payer, solana.Hash{1})
+	_, err = SendAndConfirmTransactionWithRefresh(context.Background(), client, other, opts)
+	require.True(t, errors.As(err, &rpcErr))
+	require.NoError(t, guard.Acquire(other, false))
+}
@@ -0,0 +1,295 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sendandconfirmtransaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// ErrBlockhashExpired is returned when the blockhash of a transaction
+// expired before the transaction was confirmed, and the transaction
+// could not be (or was not allowed to be) rebuilt with a fresh blockhash.
+var ErrBlockhashExpired = errors.New("blockhash expired before confirmation")
+
+// RefreshOpts configures SendAndConfirmTransactionWithRefresh.
+type RefreshOpts struct {
+	// Options of the sendTransaction requests.
+	TransactionOpts rpc.TransactionOpts
+
+	// Commitment the transaction must reach to be considered confirmed.
+	// Defaults to confirmed.
+	Commitment rpc.CommitmentType
+
+	// Signers used to re-sign the transaction after a blockhash refresh.
+	// The transaction is only refreshed if these include all the signers
+	// required by its message.
+	Signers []solana.Signer
+
+	// Maximum number of blockhash refreshes; defaults to 3.
+	// Set to a negative value to never refresh.
+	MaxRefreshes int
+
+	// Interval between status polls; defaults to 2 seconds.
+	PollInterval time.Duration
+
+	// Guard against sending a transaction identical to one sent recently. Optional.
+	// The transaction is released from the guard if it was rejected
+	// by the node, or if its blockhash expired, and the node has no
+	// status for any of its submissions.
+	Guard *DedupGuard
+
+	// Send the transaction even if the Guard has seen it.
+	Force bool
+}
+
+// SendResult is the outcome of SendAndConfirmTransactionWithRefresh.
+type SendResult struct {
+	// Signature of the transaction that was confirmed.
+	Signature solana.Signature
+
+	// Signatures of the submissions that expired and were replaced,
+	// in the order they were sent.
+	Replaced []solana.Signature
+
+	// Slot in which the transaction was processed.
+	Slot uint64
+}
+
+// Refreshed reports whether the confirmed transaction is a replacement.
+func (res *SendResult) Refreshed() bool {
+	return len(res.Replaced) > 0
+}
+
+// SendAndConfirmTransactionWithRefresh sends the transaction and polls for
+// its confirmation. If the blockhash of the transaction expires before it is
+// confirmed and all its signers are in opts.Signers, the transaction is
+// rebuilt with a fresh blockhash, re-signed, and resubmitted.
+// The transaction is modified in place.
+//
+// The transaction is only refreshed once its blockhash expired at the
+// commitment and the node has no status for it: it can no longer land,
+// so the replacement can't be executed twice. A submission that was
+// processed is polled until it is confirmed, and is never replaced.
+func SendAndConfirmTransactionWithRefresh(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	transaction *solana.Transaction,
+	opts *RefreshOpts,
+) (*SendResult, error) {
+	if opts == nil {
+		opts = &RefreshOpts{}
+	}
+	commitment := opts.Commitment
+	if commitment == "" {
+		commitment = rpc.CommitmentConfirmed
+	}
+	maxRefreshes := opts.MaxRefreshes
+	if maxRefreshes == 0 {
+		maxRefreshes = 3
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	canRefresh := hasAllSigners(transaction, opts.Signers)
+
+	if opts.Guard != nil {
+		if err := opts.Guard.Acquire(transaction, opts.Force); err != nil {
+			return nil, err
+		}
+	}
+	return sendWithRefresh(ctx, rpcClient, transaction, opts, commitment, maxRefreshes, interval, canRefresh)
+}
+
+func sendWithRefresh(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	transaction *solana.Transaction,
+	opts *RefreshOpts,
+	commitment rpc.CommitmentType,
+	maxRefreshes int,
+	interval time.Duration,
+	canRefresh bool,
+) (*SendResult, error) {
+	res := &SendResult{}
+	for {
+		sig, err := rpcClient.SendTransactionWithOpts(ctx, transaction, opts.TransactionOpts)
+		if err != nil {
+			var rpcErr *jsonrpc.RPCError
+			if errors.As(err, &rpcErr) {
+				// Rejected by the node (and a previous submission, if any, expired).
+				releaseGuard(ctx, rpcClient, opts, transaction, res.Replaced)
+			}
+			return res, err
+		}
+		res.Signature = sig
+
+		confirmed, err := pollConfirmation(ctx, rpcClient, res, transaction.Message.RecentBlockhash, commitment, interval)
+		if confirmed || err != nil {
+			return res, err
+		}
+
+		// The blockhash expired.
+		if !canRefresh || len(res.Replaced) >= maxRefreshes {
+			releaseGuard(ctx, rpcClient, opts, transaction, res.Replaced)
+			return res, ErrBlockhashExpired
+		}
+		latest, err := rpcClient.GetLatestBlockhash(ctx, commitment)
+		if err != nil {
+			return res, fmt.Errorf("failed to get a fresh blockhash: %w", err)
+		}
+		transaction.Message.RecentBlockhash = latest.Value.Blockhash
+		if _, err := transaction.SignWithSigners(opts.Signers...); err != nil {
+			return res, fmt.Errorf("failed to re-sign transaction: %w", err)
+		}
+		res.Replaced = append(res.Replaced, sig)
+	}
+}
+
+// releaseGuard releases the transaction from the guard, if any, after
+// a final check that none of its submissions (the transaction, and the
+// replaced ones) has a status: a submission that was seen may still land,
+// and the guard must keep refusing identical transactions then.
+func releaseGuard(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	opts *RefreshOpts,
+	transaction *solana.Transaction,
+	replaced []solana.Signature,
+) {
+	if opts.Guard == nil {
+		return
+	}
+	signatures := append([]solana.Signature{}, replaced...)
+	if len(transaction.Signatures) > 0 {
+		signatures = append(signatures, transaction.Signatures[0])
+	}
+	statuses, err := rpcClient.GetSignatureStatuses(ctx, true, signatures...)
+	if err != nil || len(statuses.Value) != len(signatures) {
+		return
+	}
+	for _, status := range statuses.Value {
+		if status != nil {
+			return
+		}
+	}
+	opts.Guard.Release(transaction)
+}
+
+// pollConfirmation polls the status of res.Signature until it reaches the commitment
+// (returning true), or until the blockhash is no longer valid and the
+// signature has no status at all (returning false): a transaction that was
+// processed may still be confirmed, so it is never considered expired.
+func pollConfirmation(
+	ctx context.Context,
+	rpcClient *rpc.Client,
+	res *SendResult,
+	blockhash solana.Hash,
+	commitment rpc.CommitmentType,
+	interval time.Duration,
+) (bool, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-ticker.C:
+		}
+
+		status, err := getStatus(ctx, rpcClient, res.Signature)
+		if err != nil {
+			return false, err
+		}
+		if status != nil {
+			if !reachedCommitment(status.ConfirmationStatus, commitment) {
+				// Processed: it may still land.
+				continue
+			}
+			res.Slot = status.Slot
+			if status.Err != nil {
+				return true, fmt.Errorf("confirmed transaction with execution error: %v", status.Err)
+			}
+			return true, nil
+		}
+
+		valid, err := rpcClient.IsBlockhashValid(ctx, blockhash, commitment)
+		if err != nil {
+			return false, err
+		}
+		if !valid.Value {
+			// Check once more: the transaction may have landed
+			// in the last slots of the blockhash.
+			status, err := getStatus(ctx, rpcClient, res.Signature)
+			if err != nil {
+				return false, err
+			}
+			if status == nil {
+				return false, nil
+			}
+			// Seen: keep polling until it is confirmed.
+		}
+	}
+}
+
+// getStatus returns the status of the signature, or nil if the node doesn't know it.
+func getStatus(ctx context.Context, rpcClient *rpc.Client, sig solana.Signature) (*rpc.SignatureStatusesResult, error) {
+	statuses, err := rpcClient.GetSignatureStatuses(ctx, false, sig)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses.Value) == 0 {
+		return nil, nil
+	}
+	return statuses.Value[0], nil
+}
+
+func reachedCommitment(status rpc.ConfirmationStatusType, commitment rpc.CommitmentType) bool {
+	switch commitment {
+	case rpc.CommitmentFinalized:
+		return status == rpc.ConfirmationStatusFinalized
+	case rpc.CommitmentConfirmed:
+		return status == rpc.ConfirmationStatusConfirmed || status == rpc.ConfirmationStatusFinalized
+	default:
+		return status != ""
+	}
+}
+
+func hasAllSigners(tx *solana.Transaction, signers []solana.Signer) bool {
+	n := int(tx.Message.Header.NumRequiredSignatures)
+	if n > len(tx.Message.AccountKeys) {
+		return false
+	}
+	for _, key := range tx.Message.AccountKeys[:n] {
+		found := false
+		for _, s := range signers {
+			if s.PublicKey().Equals(key) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,187 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sendandconfirmtransaction
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// expiringNode is a JSONRPCClient where every blockhash but the latest is expired,
+// and only transactions with the latest blockhash get confirmed.
+// If landing is set, the transactions with an expired blockhash are
+// processed, and get confirmed after landing status requests.
+type expiringNode struct {
+	latest  solana.Hash
+	sent    []*solana.Transaction
+	landing int
+
+	// Error of the sendTransaction requests, if set.
+	rejectSend error
+}
+
+func (node *expiringNode) status(sig solana.Signature) interface{} {
+	for _, tx := range node.sent {
+		if !tx.Signatures[0].Equals(sig) {
+			continue
+		}
+		switch {
+		case tx.Message.RecentBlockhash.Equals(node.latest):
+			return rpc.M{"slot": 42, "confirmationStatus": "confirmed", "err": nil}
+		case node.landing > 0:
+			node.landing--
+			if node.landing == 0 {
+				return rpc.M{"slot": 41, "confirmationStatus": "confirmed", "err": nil}
+			}
+			return rpc.M{"slot": 41, "confirmationStatus": "processed", "err": nil}
+		}
+	}
+	return nil
+}
+
+func (node *expiringNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	var body interface{}
+	switch method {
+	case "sendTransaction":
+		tx := new(solana.Transaction)
+		if err := tx.UnmarshalBase64(params[0].(string)); err != nil {
+			return err
+		}
+		node.sent = append(node.sent, tx)
+		if node.rejectSend != nil {
+			return node.rejectSend
+		}
+		body = tx.Signatures[0].String()
+	case "isBlockhashValid":
+		body = rpc.M{"context": rpc.M{"slot": 1}, "value": params[0].(solana.Hash).Equals(node.latest)}
+	case "getLatestBlockhash":
+		body = rpc.M{"context": rpc.M{"slot": 1}, "value": rpc.M{"blockhash": node.latest, "lastValidBlockHeight": 150}}
+	case "getSignatureStatuses":
+		var statuses []interface{}
+		for _, sig := range params[0].([]solana.Signature) {
+			statuses = append(statuses, node.status(sig))
+		}
+		body = rpc.M{"context": rpc.M{"slot": 42}, "value": statuses}
+	default:
+		return fmt.Errorf("unexpected method %q", method)
+	}
+	raw, err := stdjson.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return stdjson.Unmarshal(raw, out)
+}
+
+func (node *expiringNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func newTransferTx(t *testing.T, payer solana.PrivateKey, blockhash solana.Hash) *solana.Transaction {
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(1, payer.PublicKey(), solana.NewWallet().PublicKey()).Build(),
+		},
+		blockhash,
+		solana.TransactionPayer(payer.PublicKey()),
+	)
+	require.NoError(t, err)
+	_, err = tx.SignWithSigners(payer)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestSendAndConfirmTransactionWithRefresh(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	stale := solana.Hash{1}
+	node := &expiringNode{latest: solana.Hash{2}}
+	client := rpc.NewWithCustomRPCClient(node)
+
+	tx := newTransferTx(t, payer, stale)
+	original := tx.Signatures[0]
+
+	res, err := SendAndConfirmTransactionWithRefresh(context.Background(), client, tx, &RefreshOpts{
+		Signers:      []solana.Signer{payer},
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Refreshed())
+	require.Equal(t, []solana.Signature{original}, res.Replaced)
+	require.Equal(t, tx.Signatures[0], res.Signature)
+	require.NotEqual(t, original, res.Signature)
+	require.Equal(t, uint64(42), res.Slot)
+	require.Equal(t, node.latest, tx.Message.RecentBlockhash)
+	require.Len(t, node.sent, 2)
+}
+
+func TestSendAndConfirmTransactionWithRefresh_NotLocalSigners(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	node := &expiringNode{latest: solana.Hash{2}}
+	client := rpc.NewWithCustomRPCClient(node)
+
+	tx := newTransferTx(t, payer, solana.Hash{1})
+	original := tx.Signatures[0]
+
+	res, err := SendAndConfirmTransactionWithRefresh(context.Background(), client, tx, &RefreshOpts{
+		PollInterval: time.Millisecond,
+	})
+	require.ErrorIs(t, err, ErrBlockhashExpired)
+	require.False(t, res.Refreshed())
+	require.Equal(t, original, res.Signature)
+	require.Len(t, node.sent, 1)
+}
+
+func TestSendAndConfirmTransactionWithRefresh_NoRefreshNeeded(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	node := &expiringNode{latest: solana.Hash{2}}
+	client := rpc.NewWithCustomRPCClient(node)
+
+	tx := newTransferTx(t, payer, node.latest)
+	res, err := SendAndConfirmTransactionWithRefresh(context.Background(), client, tx, &RefreshOpts{
+		Signers:      []solana.Signer{payer},
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Refreshed())
+	require.Equal(t, tx.Signatures[0], res.Signature)
+}
+
+func TestSendAndConfirmTransactionWithRefresh_ProcessedNotReplaced(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	// Processed with an expired blockhash, confirmed later.
+	node := &expiringNode{latest: solana.Hash{2}, landing: 5}
+	client := rpc.NewWithCustomRPCClient(node)
+
+	tx := newTransferTx(t, payer, solana.Hash{1})
+	original := tx.Signatures[0]
+
+	res, err := SendAndConfirmTransactionWithRefresh(context.Background(), client, tx, &RefreshOpts{
+		Signers:      []solana.Signer{payer},
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Refreshed())
+	require.Equal(t, original, res.Signature)
+	require.Equal(t, uint64(41), res.Slot)
+	require.Len(t, node.sent, 1)
+}
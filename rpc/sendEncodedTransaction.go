@@ -41,11 +41,17 @@ func (cl *Client) SendEncodedTransaction(
 }
 
 // SendEncodedTransactionWithOpts submits a signed base64 encoded transaction to the cluster for processing.
+// If the preflight simulation fails, the error is a *PreflightError with the program logs
+// (previously a *jsonrpc.RPCError, which it unwraps to: use errors.As rather than a type assertion).
+// In dry-run mode (see SetDryRun), the transaction is simulated instead.
 func (cl *Client) SendEncodedTransactionWithOpts(
 	ctx context.Context,
 	encodedTx string,
 	opts TransactionOpts,
 ) (signature solana.Signature, err error) {
+	if cl.dryRun {
+		return cl.dryRunEncodedTransaction(ctx, encodedTx, opts)
+	}
 	obj := opts.ToMap()
 	params := []interface{}{
 		encodedTx,
@@ -53,5 +59,8 @@ func (cl *Client) SendEncodedTransactionWithOpts(
 	}
 
 	err = cl.rpcClient.CallForInto(ctx, &signature, "sendTransaction", params)
+	if err != nil {
+		return solana.Signature{}, asPreflightError(err)
+	}
 	return
 }
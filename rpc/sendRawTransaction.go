@@ -19,8 +19,10 @@ package rpc
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 
 	"github.com/gagliardetto/solana-go"
+	"github.com/mr-tron/base58"
 )
 
 // SendRawTransaction submits a signed transaction to the cluster for processing.
@@ -42,14 +44,46 @@ func (cl *Client) SendRawTransaction(
 }
 
 // SendRawTransactionWithOpts submits a raw encoded transaction as a byte array to the cluster for processing.
+// The bytes are sent unchanged (encoded as requested by opts.Encoding, base64 by default),
+// so that the transactions serialized and signed elsewhere (e.g. by a wallet or an HSM),
+// legacy or versioned, can be submitted as they are.
 func (cl *Client) SendRawTransactionWithOpts(
 	ctx context.Context,
 	rawTx []byte,
 	opts TransactionOpts,
 ) (signature solana.Signature, err error) {
+	encodedTx, err := encodeTransaction(rawTx, opts.Encoding)
+	if err != nil {
+		return solana.Signature{}, err
+	}
 	return cl.SendEncodedTransactionWithOpts(
 		ctx,
-		base64.StdEncoding.EncodeToString(rawTx),
+		encodedTx,
 		opts,
 	)
 }
+
+// encodeTransaction encodes the wire transaction with the encoding
+// of the sendTransaction options.
+func encodeTransaction(rawTx []byte, encoding solana.EncodingType) (string, error) {
+	switch encoding {
+	case "", solana.EncodingBase64:
+		return base64.StdEncoding.EncodeToString(rawTx), nil
+	case solana.EncodingBase58:
+		return base58.Encode(rawTx), nil
+	default:
+		return "", fmt.Errorf("unsupported transaction encoding %q", encoding)
+	}
+}
+
+// decodeTransaction is the inverse of encodeTransaction.
+func decodeTransaction(encodedTx string, encoding solana.EncodingType) ([]byte, error) {
+	switch encoding {
+	case "", solana.EncodingBase64:
+		return base64.StdEncoding.DecodeString(encodedTx)
+	case solana.EncodingBase58:
+		return base58.Decode(encodedTx)
+	default:
+		return nil, fmt.Errorf("unsupported transaction encoding %q", encoding)
+	}
+}
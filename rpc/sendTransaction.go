@@ -18,7 +18,6 @@ package rpc
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 
 	"github.com/gagliardetto/solana-go"
@@ -76,9 +75,9 @@ func (cl *Client) SendTransactionWithOpts(
 		return solana.Signature{}, fmt.Errorf("send transaction: encode transaction: %w", err)
 	}
 
-	return cl.SendEncodedTransactionWithOpts(
+	return cl.SendRawTransactionWithOpts(
 		ctx,
-		base64.StdEncoding.EncodeToString(txData),
+		txData,
 		opts,
 	)
 }
@@ -0,0 +1,185 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gagliardetto/solana-go"
+	"golang.org/x/time/rate"
+)
+
+// MaxSignaturesPageSize is the maximum number of signatures
+// returned by a getSignaturesForAddress request.
+const MaxSignaturesPageSize = 1000
+
+// SignaturesIteratorOpts are the options of a SignaturesIterator.
+type SignaturesIteratorOpts struct {
+	// Start from this signature (excluded), e.g. the Cursor of a previous
+	// iteration; if not provided, the iteration starts from the newest transaction.
+	Before solana.Signature
+
+	// Stop at this signature (excluded); if not provided,
+	// the iteration goes back to the oldest transaction.
+	Until solana.Signature
+
+	// Commitment of the requests; "processed" is not supported.
+	// If not provided, the default is "finalized".
+	Commitment CommitmentType
+
+	// The number of signatures of each request.
+	// If not provided (or greater), the default is MaxSignaturesPageSize.
+	PageSize int
+
+	// Limits the rate of the requests. Optional.
+	Limiter *rate.Limiter
+}
+
+// SignaturesIterator walks the history of an address, newest first,
+// by repeatedly calling getSignaturesForAddress; the pages are fetched
+// when needed, and the signatures repeated across page boundaries are skipped.
+//
+//	it := client.NewSignaturesIterator(address, nil)
+//	for {
+//		sig, err := it.Next(ctx)
+//		if err == io.EOF {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+type SignaturesIterator struct {
+	client  *Client
+	account solana.PublicKey
+	opts    SignaturesIteratorOpts
+
+	page     []*TransactionSignature
+	lastPage map[solana.Signature]struct{}
+	before   solana.Signature
+	cursor   solana.Signature
+	done     bool
+}
+
+// NewSignaturesIterator returns an iterator over the signatures
+// of the transactions involving the account.
+func (cl *Client) NewSignaturesIterator(account solana.PublicKey, opts *SignaturesIteratorOpts) *SignaturesIterator {
+	it := &SignaturesIterator{
+		client:  cl,
+		account: account,
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.PageSize <= 0 || it.opts.PageSize > MaxSignaturesPageSize {
+		it.opts.PageSize = MaxSignaturesPageSize
+	}
+	it.before = it.opts.Before
+	it.cursor = it.opts.Before
+	return it
+}
+
+// Next returns the next (older) signature, or io.EOF at the end of the history.
+func (it *SignaturesIterator) Next(ctx context.Context) (*TransactionSignature, error) {
+	for len(it.page) == 0 {
+		if it.done {
+			return nil, io.EOF
+		}
+		if err := it.fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+	sig := it.page[0]
+	it.page = it.page[1:]
+	it.cursor = sig.Signature
+	return sig, nil
+}
+
+// Cursor returns the last signature returned by Next (or the Before option);
+// pass it as Before to resume the iteration later.
+func (it *SignaturesIterator) Cursor() solana.Signature {
+	return it.cursor
+}
+
+func (it *SignaturesIterator) fetch(ctx context.Context) error {
+	if it.opts.Limiter != nil {
+		if err := it.opts.Limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	limit := it.opts.PageSize
+	page, err := it.client.GetSignaturesForAddressWithOpts(ctx, it.account, &GetSignaturesForAddressOpts{
+		Limit:      &limit,
+		Before:     it.before,
+		Until:      it.opts.Until,
+		Commitment: it.opts.Commitment,
+	})
+	if err != nil {
+		return err
+	}
+	seen := make(map[solana.Signature]struct{}, len(page))
+	for _, sig := range page {
+		if sig == nil {
+			continue
+		}
+		if _, ok := it.lastPage[sig.Signature]; ok {
+			continue
+		}
+		if _, ok := seen[sig.Signature]; ok {
+			continue
+		}
+		seen[sig.Signature] = struct{}{}
+		it.page = append(it.page, sig)
+	}
+	// Stop when the node has nothing older (or only repeats itself).
+	if len(it.page) == 0 {
+		it.done = true
+		return nil
+	}
+	it.lastPage = seen
+	it.before = it.page[len(it.page)-1].Signature
+	return nil
+}
+
+// ForEachSignatureForAddress calls fn for each signature of the transactions
+// involving the account, newest first (see SignaturesIterator);
+// the iteration stops at the first error returned by fn
+// (ErrStopIteration stops it without an error).
+func (cl *Client) ForEachSignatureForAddress(
+	ctx context.Context,
+	account solana.PublicKey,
+	opts *SignaturesIteratorOpts,
+	fn func(sig *TransactionSignature) error,
+) error {
+	it := cl.NewSignaturesIterator(account, opts)
+	for {
+		sig, err := it.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(sig); err != nil {
+			if errors.Is(err, ErrStopIteration) {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+// historyNode is a JSONRPCClient that serves getSignaturesForAddress
+// from a history (newest first).
+type historyNode struct {
+	history []solana.Signature
+	// When set, every page also repeats the "before" signature.
+	inclusive bool
+	calls     int
+}
+
+func (node *historyNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	node.calls++
+	obj := params[1].(M)
+	limit := *obj["limit"].(*int)
+	start := 0
+	if before, ok := obj["before"].(solana.Signature); ok {
+		for i, sig := range node.history {
+			if sig == before {
+				start = i + 1
+				if node.inclusive {
+					start = i
+				}
+			}
+		}
+	}
+	var page []*TransactionSignature
+	for _, sig := range node.history[start:] {
+		if until, ok := obj["until"].(solana.Signature); ok && sig == until {
+			break
+		}
+		if len(page) == limit {
+			break
+		}
+		page = append(page, &TransactionSignature{Signature: sig})
+	}
+	*out.(*[]*TransactionSignature) = page
+	return nil
+}
+
+func (node *historyNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestSignaturesIterator(t *testing.T) {
+	ctx := context.Background()
+	history := make([]solana.Signature, 25)
+	for i := range history {
+		history[i][0] = byte(i + 1)
+	}
+	collect := func(it *SignaturesIterator) []solana.Signature {
+		var out []solana.Signature
+		for {
+			sig, err := it.Next(ctx)
+			if err == io.EOF {
+				return out
+			}
+			require.NoError(t, err)
+			out = append(out, sig.Signature)
+		}
+	}
+
+	for _, inclusive := range []bool{false, true} {
+		node := &historyNode{history: history, inclusive: inclusive}
+		it := NewWithCustomRPCClient(node).NewSignaturesIterator(solana.PublicKey{}, &SignaturesIteratorOpts{PageSize: 10})
+		require.Equal(t, history, collect(it))
+		require.Equal(t, history[24], it.Cursor())
+		// 3 pages, and an empty one.
+		require.Equal(t, 4, node.calls)
+	}
+
+	// Resume from a cursor, until a signature.
+	node := &historyNode{history: history}
+	it := NewWithCustomRPCClient(node).NewSignaturesIterator(solana.PublicKey{}, &SignaturesIteratorOpts{
+		Before: history[4],
+		Until:  history[20],
+	})
+	require.Equal(t, history[5:20], collect(it))
+
+	// Stop early.
+	var count int
+	err := NewWithCustomRPCClient(&historyNode{history: history}).ForEachSignatureForAddress(ctx, solana.PublicKey{}, nil, func(sig *TransactionSignature) error {
+		count++
+		if count == 3 {
+			return ErrStopIteration
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+}
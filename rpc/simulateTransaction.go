@@ -71,6 +71,10 @@ type SimulateTransactionOpts struct {
 	ReplaceRecentBlockhash bool
 
 	Accounts *SimulateTransactionAccountsOpts
+
+	// The minimum slot that the request can be evaluated at.
+	// This parameter is optional.
+	MinContextSlot *uint64
 }
 
 type SimulateTransactionAccountsOpts struct {
@@ -87,6 +91,30 @@ type SimulateTransactionAccountsOpts struct {
 	Addresses []solana.PublicKey
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *SimulateTransactionOpts) ToMap() M {
+	obj := M{}
+	if opts.SigVerify {
+		obj["sigVerify"] = opts.SigVerify
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.ReplaceRecentBlockhash {
+		obj["replaceRecentBlockhash"] = opts.ReplaceRecentBlockhash
+	}
+	if opts.Accounts != nil {
+		obj["accounts"] = M{
+			"encoding":  opts.Accounts.Encoding,
+			"addresses": opts.Accounts.Addresses,
+		}
+	}
+	if opts.MinContextSlot != nil {
+		obj["minContextSlot"] = *opts.MinContextSlot
+	}
+	return obj
+}
+
 // SimulateTransaction simulates sending a transaction.
 func (cl *Client) SimulateTransactionWithOpts(
 	ctx context.Context,
@@ -98,26 +126,11 @@ func (cl *Client) SimulateTransactionWithOpts(
 		return nil, fmt.Errorf("send transaction: encode transaction: %w", err)
 	}
 
-	obj := M{
-		"encoding": "base64",
-	}
+	obj := M{}
 	if opts != nil {
-		if opts.SigVerify {
-			obj["sigVerify"] = opts.SigVerify
-		}
-		if opts.Commitment != "" {
-			obj["commitment"] = opts.Commitment
-		}
-		if opts.ReplaceRecentBlockhash {
-			obj["replaceRecentBlockhash"] = opts.ReplaceRecentBlockhash
-		}
-		if opts.Accounts != nil {
-			obj["accounts"] = M{
-				"encoding":  opts.Accounts.Encoding,
-				"addresses": opts.Accounts.Addresses,
-			}
-		}
+		obj = opts.ToMap()
 	}
+	obj["encoding"] = "base64"
 
 	b64Data := base64.StdEncoding.EncodeToString(txData)
 	params := []interface{}{
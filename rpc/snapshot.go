@@ -0,0 +1,269 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// DefaultSnapshotMaxSignatures is the default maximum number of
+// transactions that GetAccountsAtSlot will inspect for each account.
+const DefaultSnapshotMaxSignatures = 10000
+
+// GetAccountsAtSlotOpts are the options of GetAccountsAtSlot.
+type GetAccountsAtSlotOpts struct {
+	// Commitment of the requests; "processed" is not supported.
+	// If not provided, the default is "finalized".
+	Commitment CommitmentType
+
+	// Encoding of the current account data.
+	// If not provided, the default is "base64".
+	Encoding solana.EncodingType
+
+	// Maximum number of transactions to inspect for each account;
+	// if an account was referenced by more transactions after the
+	// target slot, GetAccountsAtSlot returns an error.
+	// If not provided, the default is DefaultSnapshotMaxSignatures.
+	MaxSignatures int
+}
+
+// AccountAtSlot is the state of an account at the target slot of a snapshot.
+type AccountAtSlot struct {
+	Address solana.PublicKey
+
+	// The current state of the account (i.e. at the ContextSlot of the snapshot);
+	// nil if the account does not exist at the ContextSlot.
+	Current *Account
+
+	// The lamports of the account at the target slot.
+	Lamports uint64
+
+	// The token balance of the account at the target slot,
+	// reconstructed from the transaction history.
+	// Only set when the account is a token account that was
+	// written after the target slot; when Exact is true,
+	// decode the Current account data instead.
+	TokenBalance *TokenBalance
+
+	// Exact is true when no transaction wrote to the account
+	// between the target slot and the ContextSlot, i.e. the
+	// Current account is the state at the target slot.
+	// When false, only Lamports (and TokenBalance) are reliable.
+	Exact bool
+
+	// The transactions that wrote to the account after the target slot,
+	// newest first.
+	WrittenBy []solana.Signature
+}
+
+// AccountsSnapshot is the result of GetAccountsAtSlot.
+type AccountsSnapshot struct {
+	// The requested slot.
+	TargetSlot uint64
+
+	// The slot at which the current state of the accounts was fetched.
+	ContextSlot uint64
+
+	Accounts []*AccountAtSlot
+}
+
+// GetAccountsAtSlot fetches (on a best-effort basis) the state of the provided
+// accounts at the end of targetSlot. The node must keep the transaction
+// history since targetSlot (e.g. an archival node).
+//
+// Consistency model:
+//   - the current state of the accounts is fetched with a single getMultipleAccounts
+//     request, evaluated at some ContextSlot >= targetSlot (via minContextSlot);
+//   - the transactions that reference each account in (targetSlot, ContextSlot] are
+//     replayed backwards: the lamports (and token balance) of the account at
+//     targetSlot are the pre-balances of the oldest of them;
+//   - the account data cannot be reconstructed from the history: when a transaction
+//     wrote to the account after targetSlot, the result is not Exact, and only
+//     the lamports and the token balance are reliable;
+//   - skipped slots and transactions that failed are accounted for, since
+//     the pre-balances come from the confirmed transaction metadata;
+//   - writes that don't come from transactions (e.g. rent collection and
+//     epoch rewards) are not detected.
+func (cl *Client) GetAccountsAtSlot(
+	ctx context.Context,
+	accounts []solana.PublicKey,
+	targetSlot uint64,
+	opts *GetAccountsAtSlotOpts,
+) (*AccountsSnapshot, error) {
+	if opts == nil {
+		opts = &GetAccountsAtSlotOpts{}
+	}
+	maxSignatures := opts.MaxSignatures
+	if maxSignatures <= 0 {
+		maxSignatures = DefaultSnapshotMaxSignatures
+	}
+	encoding := opts.Encoding
+	if encoding == "" {
+		encoding = solana.EncodingBase64
+	}
+
+	current, err := cl.GetMultipleAccountsWithOpts(ctx, accounts, &GetMultipleAccountsOpts{
+		Encoding:       encoding,
+		Commitment:     opts.Commitment,
+		MinContextSlot: &targetSlot,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get accounts: %w", err)
+	}
+	if len(current.Value) != len(accounts) {
+		return nil, fmt.Errorf("expected %d accounts, got %d", len(accounts), len(current.Value))
+	}
+
+	snapshot := &AccountsSnapshot{
+		TargetSlot:  targetSlot,
+		ContextSlot: current.Context.Slot,
+		Accounts:    make([]*AccountAtSlot, len(accounts)),
+	}
+	for i, address := range accounts {
+		account := &AccountAtSlot{
+			Address: address,
+			Current: current.Value[i],
+			Exact:   true,
+		}
+		if account.Current != nil {
+			account.Lamports = account.Current.Lamports
+		}
+		if err := cl.replayAccountHistory(ctx, account, targetSlot, snapshot.ContextSlot, opts.Commitment, maxSignatures); err != nil {
+			return nil, fmt.Errorf("unable to replay history of %s: %w", address, err)
+		}
+		snapshot.Accounts[i] = account
+	}
+	return snapshot, nil
+}
+
+// replayAccountHistory updates the account with the pre-balances of the oldest
+// transaction that referenced it in (targetSlot, contextSlot].
+func (cl *Client) replayAccountHistory(
+	ctx context.Context,
+	account *AccountAtSlot,
+	targetSlot uint64,
+	contextSlot uint64,
+	commitment CommitmentType,
+	maxSignatures int,
+) error {
+	var after []*TransactionSignature
+	var before solana.Signature
+	for done := false; !done; {
+		limit := 1000
+		sigs, err := cl.GetSignaturesForAddressWithOpts(ctx, account.Address, &GetSignaturesForAddressOpts{
+			Limit:          &limit,
+			Before:         before,
+			Commitment:     commitment,
+			MinContextSlot: &contextSlot,
+		})
+		if err != nil {
+			return err
+		}
+		if len(sigs) < limit {
+			done = true
+		}
+		for _, sig := range sigs {
+			if sig.Slot <= targetSlot {
+				done = true
+				break
+			}
+			// Written after the current state was fetched.
+			if sig.Slot > contextSlot {
+				continue
+			}
+			after = append(after, sig)
+			if len(after) > maxSignatures {
+				return fmt.Errorf("more than %d transactions after slot %d", maxSignatures, targetSlot)
+			}
+		}
+		if len(sigs) > 0 {
+			before = sigs[len(sigs)-1].Signature
+		}
+	}
+
+	version := uint64(0)
+	for i := len(after) - 1; i >= 0; i-- {
+		tx, err := cl.GetTransaction(ctx, after[i].Signature, &GetTransactionOpts{
+			Encoding:                       solana.EncodingBase64,
+			Commitment:                     commitment,
+			MaxSupportedTransactionVersion: &version,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to get transaction %s: %w", after[i].Signature, err)
+		}
+		index, writable, err := accountIndexInTransaction(tx, account.Address)
+		if err != nil {
+			return fmt.Errorf("unable to decode transaction %s: %w", after[i].Signature, err)
+		}
+		if index < 0 || !writable {
+			continue
+		}
+		if account.Exact {
+			// This is the oldest write after the target slot.
+			account.Exact = false
+			if index < len(tx.Meta.PreBalances) {
+				account.Lamports = tx.Meta.PreBalances[index]
+			}
+			for j := range tx.Meta.PreTokenBalances {
+				if int(tx.Meta.PreTokenBalances[j].AccountIndex) == index {
+					account.TokenBalance = &tx.Meta.PreTokenBalances[j]
+					break
+				}
+			}
+		}
+		account.WrittenBy = append([]solana.Signature{after[i].Signature}, account.WrittenBy...)
+	}
+	return nil
+}
+
+// accountIndexInTransaction returns the index of the account in the balances
+// of the transaction metadata (i.e. static keys, then the writable and the
+// readonly loaded addresses), and whether the transaction could write to it.
+// The index is -1 if the transaction does not reference the account.
+func accountIndexInTransaction(tx *GetTransactionResult, address solana.PublicKey) (int, bool, error) {
+	if tx.Transaction == nil || tx.Meta == nil {
+		return -1, false, fmt.Errorf("transaction or metadata is missing")
+	}
+	parsed, err := tx.Transaction.GetTransaction()
+	if err != nil {
+		return -1, false, err
+	}
+	msg := parsed.Message
+	header := msg.Header
+	numStatic := len(msg.AccountKeys)
+	for i, key := range msg.AccountKeys {
+		if !key.Equals(address) {
+			continue
+		}
+		if i < int(header.NumRequiredSignatures) {
+			return i, i < int(header.NumRequiredSignatures-header.NumReadonlySignedAccounts), nil
+		}
+		return i, i < numStatic-int(header.NumReadonlyUnsignedAccounts), nil
+	}
+	for i, key := range tx.Meta.LoadedAddresses.Writable {
+		if key.Equals(address) {
+			return numStatic + i, true, nil
+		}
+	}
+	for i, key := range tx.Meta.LoadedAddresses.ReadOnly {
+		if key.Equals(address) {
+			return numStatic + len(tx.Meta.LoadedAddresses.Writable) + i, false, nil
+		}
+	}
+	return -1, false, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+// archiveNode is a JSONRPCClient that serves a fixed history.
+type archiveNode struct {
+	accounts   string
+	signatures map[string]string
+	txs        map[string]string
+}
+
+func (node *archiveNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	switch method {
+	case "getMultipleAccounts":
+		return stdjson.Unmarshal([]byte(node.accounts), out)
+	case "getSignaturesForAddress":
+		return stdjson.Unmarshal([]byte(node.signatures[params[0].(solana.PublicKey).String()]), out)
+	case "getTransaction":
+		return stdjson.Unmarshal([]byte(node.txs[params[0].(solana.Signature).String()]), out)
+	}
+	return fmt.Errorf("unexpected method %s", method)
+}
+
+func (node *archiveNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return nil
+}
+
+func TestGetAccountsAtSlot(t *testing.T) {
+	payer := solana.PublicKey{1}
+	written := solana.PublicKey{2}
+	readOnly := solana.PublicKey{3}
+	program := solana.PublicKey{4}
+
+	tx := solana.Transaction{
+		Signatures: []solana.Signature{{}},
+		Message: solana.Message{
+			Header: solana.MessageHeader{
+				NumRequiredSignatures:       1,
+				NumReadonlyUnsignedAccounts: 2,
+			},
+			AccountKeys: []solana.PublicKey{payer, written, readOnly, program},
+			Instructions: []solana.CompiledInstruction{
+				{ProgramIDIndex: 3, Accounts: []uint16{0, 1, 2}},
+			},
+		},
+	}
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	encoded := base64.StdEncoding.EncodeToString(raw)
+	txJSON := func(slot uint64, preBalance uint64) string {
+		return fmt.Sprintf(
+			`{"slot":%d,"transaction":[%q,"base64"],"meta":{"err":null,"fee":5000,"preBalances":[1000000,%d,7,1],"postBalances":[995000,%d,7,1],"loadedAddresses":{"readonly":[],"writable":[]}}}`,
+			slot, encoded, preBalance, preBalance+20,
+		)
+	}
+
+	sig := func(b byte) solana.Signature { return solana.Signature{b} }
+	node := &archiveNode{
+		accounts: fmt.Sprintf(
+			`{"context":{"slot":200},"value":[{"lamports":90,"owner":%q,"data":["","base64"],"executable":false,"rentEpoch":0},{"lamports":7,"owner":%q,"data":["","base64"],"executable":false,"rentEpoch":0}]}`,
+			program, program,
+		),
+		signatures: map[string]string{
+			written.String(): fmt.Sprintf(
+				`[{"signature":%q,"slot":250},{"signature":%q,"slot":180},{"signature":%q,"slot":150},{"signature":%q,"slot":90}]`,
+				sig(3), sig(2), sig(1), sig(0),
+			),
+			readOnly.String(): fmt.Sprintf(`[{"signature":%q,"slot":150}]`, sig(1)),
+		},
+		txs: map[string]string{
+			sig(2).String(): txJSON(180, 70),
+			sig(1).String(): txJSON(150, 50),
+		},
+	}
+
+	client := NewWithCustomRPCClient(node)
+	snapshot, err := client.GetAccountsAtSlot(context.Background(), []solana.PublicKey{written, readOnly}, 100, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), snapshot.TargetSlot)
+	require.Equal(t, uint64(200), snapshot.ContextSlot)
+	require.Len(t, snapshot.Accounts, 2)
+
+	{
+		account := snapshot.Accounts[0]
+		require.Equal(t, written, account.Address)
+		require.False(t, account.Exact)
+		require.Equal(t, uint64(50), account.Lamports)
+		require.Equal(t, uint64(90), account.Current.Lamports)
+		require.Equal(t, []solana.Signature{sig(2), sig(1)}, account.WrittenBy)
+	}
+	{
+		account := snapshot.Accounts[1]
+		require.Equal(t, readOnly, account.Address)
+		require.True(t, account.Exact)
+		require.Equal(t, uint64(7), account.Lamports)
+		require.Empty(t, account.WrittenBy)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// SpamReason is a reason for a transaction to be considered spam.
+type SpamReason string
+
+const (
+	// The transaction only sends dust to the wallet, or involves it
+	// without changing its balances (e.g. address poisoning).
+	SpamReasonDust SpamReason = "dust"
+	// The transaction sends to the wallet tokens of a mint that is not trusted.
+	SpamReasonUnsolicitedToken SpamReason = "unsolicited_token"
+	// The transaction invokes a known spam program.
+	SpamReasonSpamProgram SpamReason = "spam_program"
+)
+
+// SpamFilter detects the spam in the history of a wallet, i.e. in the
+// transactions returned by getSignaturesForAddress and getTransaction.
+// The transactions signed by the wallet are never spam.
+// The zero value detects nothing; each heuristic is enabled by its fields.
+type SpamFilter struct {
+	// Incoming transfers of less than DustLamports lamports are dust.
+	DustLamports uint64
+	// Incoming transfers of less than the raw amount of their mint are dust.
+	DustTokenAmounts map[solana.PublicKey]uint64
+
+	// Flag the incoming tokens of the mints not in TrustedMints.
+	FlagUnsolicitedTokens bool
+	TrustedMints          map[solana.PublicKey]bool
+
+	// Programs known to be used by spammers.
+	SpamPrograms map[solana.PublicKey]bool
+}
+
+// Check returns the reasons for the transaction to be spam
+// in the history of the wallet; none if it is not spam.
+func (f *SpamFilter) Check(wallet solana.PublicKey, tx *solana.Transaction, meta *TransactionMeta) ([]SpamReason, error) {
+	if meta == nil {
+		return nil, fmt.Errorf("transaction metadata is missing")
+	}
+	if tx.Message.IsSigner(wallet) {
+		return nil, nil
+	}
+	keys := TransactionAccountKeys(tx, meta)
+
+	var reasons []SpamReason
+	if len(f.SpamPrograms) > 0 && f.invokesSpamProgram(keys, tx, meta) {
+		reasons = append(reasons, SpamReasonSpamProgram)
+	}
+
+	// The owners are only read from the token balances.
+	changes, err := NewTokenBalanceResolver(nil).Changes(context.Background(), keys, meta)
+	if err != nil {
+		return nil, err
+	}
+	var walletChanges []*TokenBalanceChange
+	for _, change := range changes {
+		if change.Owner.Equals(wallet) && change.Delta.Sign() != 0 {
+			walletChanges = append(walletChanges, change)
+		}
+	}
+
+	if f.FlagUnsolicitedTokens {
+		for _, change := range walletChanges {
+			if change.Delta.Sign() > 0 && !f.TrustedMints[change.Mint] {
+				reasons = append(reasons, SpamReasonUnsolicitedToken)
+				break
+			}
+		}
+	}
+
+	if f.DustLamports > 0 || len(f.DustTokenAmounts) > 0 {
+		if f.isDust(f.lamportsDelta(wallet, keys, meta), walletChanges) {
+			reasons = append(reasons, SpamReasonDust)
+		}
+	}
+	return reasons, nil
+}
+
+func (f *SpamFilter) invokesSpamProgram(keys solana.PublicKeySlice, tx *solana.Transaction, meta *TransactionMeta) bool {
+	isSpam := func(programIDIndex uint16) bool {
+		return int(programIDIndex) < len(keys) && f.SpamPrograms[keys[programIDIndex]]
+	}
+	for _, inst := range tx.Message.Instructions {
+		if isSpam(inst.ProgramIDIndex) {
+			return true
+		}
+	}
+	for _, inner := range meta.InnerInstructions {
+		for _, inst := range inner.Instructions {
+			if isSpam(inst.ProgramIDIndex) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lamportsDelta returns the change of the lamports of the wallet.
+func (f *SpamFilter) lamportsDelta(wallet solana.PublicKey, keys solana.PublicKeySlice, meta *TransactionMeta) int64 {
+	for i, key := range keys {
+		if key.Equals(wallet) && i < len(meta.PreBalances) && i < len(meta.PostBalances) {
+			return int64(meta.PostBalances[i] - meta.PreBalances[i])
+		}
+	}
+	return 0
+}
+
+// isDust returns true if all the changes of the balances of the wallet
+// are incoming amounts below the dust thresholds, or if there are none.
+func (f *SpamFilter) isDust(lamportsDelta int64, tokenChanges []*TokenBalanceChange) bool {
+	if lamportsDelta < 0 || (lamportsDelta > 0 && uint64(lamportsDelta) >= f.DustLamports) {
+		return false
+	}
+	for _, change := range tokenChanges {
+		threshold, ok := f.DustTokenAmounts[change.Mint]
+		if !ok || change.Delta.Sign() < 0 || change.Delta.Cmp(new(big.Int).SetUint64(threshold)) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSpam returns true if the transaction is spam in the history of the wallet.
+func (f *SpamFilter) IsSpam(wallet solana.PublicKey, tx *solana.Transaction, meta *TransactionMeta) (bool, error) {
+	reasons, err := f.Check(wallet, tx, meta)
+	return len(reasons) > 0, err
+}
+
+// FilterTransactions splits the transactions of the history
+// of the wallet into the legitimate ones and the spam.
+func (f *SpamFilter) FilterTransactions(wallet solana.PublicKey, txs []*GetTransactionResult) (kept, spam []*GetTransactionResult, err error) {
+	for _, result := range txs {
+		if result.Transaction == nil {
+			return nil, nil, fmt.Errorf("transaction of slot %d is missing", result.Slot)
+		}
+		tx, err := result.Transaction.GetTransaction()
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode transaction of slot %d: %w", result.Slot, err)
+		}
+		isSpam, err := f.IsSpam(wallet, tx, result.Meta)
+		if err != nil {
+			return nil, nil, fmt.Errorf("transaction of slot %d: %w", result.Slot, err)
+		}
+		if isSpam {
+			spam = append(spam, result)
+		} else {
+			kept = append(kept, result)
+		}
+	}
+	return kept, spam, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpamFilter(t *testing.T) {
+	wallet := solana.PublicKey{1}
+	sender := solana.PublicKey{2}
+	walletATA := solana.PublicKey{3}
+	senderATA := solana.PublicKey{4}
+	trustedMint := solana.PublicKey{5}
+	spamMint := solana.PublicKey{6}
+	spamProgram := solana.PublicKey{7}
+
+	// The keys are: sender (signer), wallet, walletATA, senderATA, program.
+	newTx := func(signer solana.PublicKey, program solana.PublicKey) *solana.Transaction {
+		return &solana.Transaction{
+			Signatures: []solana.Signature{{}},
+			Message: solana.Message{
+				Header:       solana.MessageHeader{NumRequiredSignatures: 1, NumReadonlyUnsignedAccounts: 1},
+				AccountKeys:  solana.PublicKeySlice{signer, wallet, walletATA, senderATA, program},
+				Instructions: []solana.CompiledInstruction{{ProgramIDIndex: 4}},
+			},
+		}
+	}
+	tokenBalance := func(index uint16, owner, mint solana.PublicKey, amount int64) TokenBalance {
+		return TokenBalance{AccountIndex: index, Owner: &owner, Mint: mint, UiTokenAmount: NewUiTokenAmount(big.NewInt(amount), 6)}
+	}
+	lamports := func(walletPre, walletPost uint64) *TransactionMeta {
+		return &TransactionMeta{
+			PreBalances:  []uint64{1e9, walletPre, 0, 0, 1},
+			PostBalances: []uint64{1e9, walletPost, 0, 0, 1},
+		}
+	}
+	tokens := func(mint solana.PublicKey, walletPre, walletPost int64) *TransactionMeta {
+		meta := lamports(1e9, 1e9)
+		meta.PreTokenBalances = []TokenBalance{tokenBalance(2, wallet, mint, walletPre), tokenBalance(3, sender, mint, 1e9)}
+		meta.PostTokenBalances = []TokenBalance{tokenBalance(2, wallet, mint, walletPost), tokenBalance(3, sender, mint, 1e9-(walletPost-walletPre))}
+		return meta
+	}
+
+	filter := &SpamFilter{
+		DustLamports:          1000,
+		DustTokenAmounts:      map[solana.PublicKey]uint64{trustedMint: 10},
+		FlagUnsolicitedTokens: true,
+		TrustedMints:          map[solana.PublicKey]bool{trustedMint: true},
+		SpamPrograms:          map[solana.PublicKey]bool{spamProgram: true},
+	}
+	tests := []struct {
+		name    string
+		tx      *solana.Transaction
+		meta    *TransactionMeta
+		reasons []SpamReason
+	}{
+		{"transfer", newTx(sender, solana.SystemProgramID), lamports(0, 1e6), nil},
+		{"lamports dust", newTx(sender, solana.SystemProgramID), lamports(1e6, 1e6+1), []SpamReason{SpamReasonDust}},
+		{"outgoing", newTx(sender, solana.SystemProgramID), lamports(1e6, 1e6-1), nil},
+		{"poisoning", newTx(sender, solana.TokenProgramID), tokens(trustedMint, 5, 5), []SpamReason{SpamReasonDust}},
+		{"trusted tokens", newTx(sender, solana.TokenProgramID), tokens(trustedMint, 5, 500), nil},
+		{"trusted tokens dust", newTx(sender, solana.TokenProgramID), tokens(trustedMint, 5, 6), []SpamReason{SpamReasonDust}},
+		{"unsolicited tokens", newTx(sender, solana.TokenProgramID), tokens(spamMint, 0, 1e6), []SpamReason{SpamReasonUnsolicitedToken}},
+		{"spam program", newTx(sender, spamProgram), lamports(0, 1e6), []SpamReason{SpamReasonSpamProgram}},
+		{"signed by the wallet", newTx(wallet, spamProgram), lamports(1e6, 1e6+1), nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reasons, err := filter.Check(wallet, test.tx, test.meta)
+			require.NoError(t, err)
+			require.Equal(t, test.reasons, reasons)
+		})
+	}
+
+	t.Run("inner instructions", func(t *testing.T) {
+		meta := lamports(0, 1e6)
+		meta.InnerInstructions = []InnerInstruction{{Index: 0, Instructions: []solana.CompiledInstruction{{ProgramIDIndex: 4}}}}
+		isSpam, err := filter.IsSpam(wallet, newTx(sender, spamProgram), meta)
+		require.NoError(t, err)
+		require.True(t, isSpam)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		isSpam, err := (&SpamFilter{}).IsSpam(wallet, newTx(sender, spamProgram), tokens(spamMint, 0, 1))
+		require.NoError(t, err)
+		require.False(t, isSpam)
+	})
+}
+
+func TestSpamFilter_FilterTransactions(t *testing.T) {
+	wallet := solana.PublicKey{1}
+	sender := solana.PublicKey{2}
+	newResult := func(slot uint64, received uint64) *GetTransactionResult {
+		tx := &solana.Transaction{
+			Signatures: []solana.Signature{{}},
+			Message: solana.Message{
+				Header:       solana.MessageHeader{NumRequiredSignatures: 1, NumReadonlyUnsignedAccounts: 1},
+				AccountKeys:  solana.PublicKeySlice{sender, wallet, solana.SystemProgramID},
+				Instructions: []solana.CompiledInstruction{{ProgramIDIndex: 2}},
+			},
+		}
+		return &GetTransactionResult{
+			Slot:        slot,
+			Transaction: &TransactionResultEnvelope{asParsedTransaction: tx},
+			Meta: &TransactionMeta{
+				PreBalances:  []uint64{1e9, 0, 1},
+				PostBalances: []uint64{1e9 - received, received, 1},
+			},
+		}
+	}
+	legit, dust := newResult(1, 1e6), newResult(2, 1)
+
+	kept, spam, err := (&SpamFilter{DustLamports: 1000}).FilterTransactions(wallet, []*GetTransactionResult{legit, dust})
+	require.NoError(t, err)
+	require.Equal(t, []*GetTransactionResult{legit}, kept)
+	require.Equal(t, []*GetTransactionResult{dust}, spam)
+}
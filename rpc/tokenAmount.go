@@ -0,0 +1,140 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// UiTokenAmount is an amount of tokens as returned by the RPC
+// (token balances, supplies, largest accounts).
+// Use Raw to get the raw amount as an exact big.Int, and String to get the
+// amount accounting for decimals as returned by the node.
+type UiTokenAmount struct {
+	// Raw amount of tokens as a string, ignoring decimals.
+	Amount string `json:"amount"`
+
+	// Number of decimals configured for token's mint.
+	Decimals uint8 `json:"decimals"`
+
+	// Token amount as a float, accounting for decimals.
+	//
+	// Deprecated: a float64 cannot represent all the token amounts exactly;
+	// use UiAmountString or Raw instead.
+	UiAmount *float64 `json:"uiAmount"`
+
+	// Token amount as a string, accounting for decimals (and for the interest
+	// accrued by the Token-2022 interest-bearing mints), as returned by the node.
+	UiAmountString string `json:"uiAmountString"`
+}
+
+// NewUiTokenAmount creates an amount from a raw amount of tokens
+// (ignoring decimals) and the decimals of the mint.
+func NewUiTokenAmount(raw *big.Int, decimals uint8) *UiTokenAmount {
+	amount := &UiTokenAmount{
+		Amount:   raw.String(),
+		Decimals: decimals,
+	}
+	amount.UiAmountString = amount.ExactString()
+	uiAmount, _ := strconv.ParseFloat(amount.UiAmountString, 64)
+	amount.UiAmount = &uiAmount
+	return amount
+}
+
+// NewUiTokenAmountFromUint64 is like NewUiTokenAmount for a uint64 raw amount.
+func NewUiTokenAmountFromUint64(raw uint64, decimals uint8) *UiTokenAmount {
+	return NewUiTokenAmount(new(big.Int).SetUint64(raw), decimals)
+}
+
+// Raw returns the raw amount of tokens, ignoring decimals;
+// zero if Amount is not a valid integer.
+func (a *UiTokenAmount) Raw() *big.Int {
+	raw, ok := new(big.Int).SetString(a.Amount, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return raw
+}
+
+// Uint64 returns the raw amount of tokens, ignoring decimals.
+// It fails if the amount does not fit in a uint64.
+func (a *UiTokenAmount) Uint64() (uint64, error) {
+	raw := a.Raw()
+	if !raw.IsUint64() {
+		return 0, fmt.Errorf("token amount %s does not fit in a uint64", raw)
+	}
+	return raw.Uint64(), nil
+}
+
+// String returns the amount of tokens accounting for decimals, as returned
+// by the node (UiAmountString); if missing, it is formatted with ExactString.
+func (a *UiTokenAmount) String() string {
+	if a.UiAmountString != "" {
+		return a.UiAmountString
+	}
+	return a.ExactString()
+}
+
+// ExactString returns the raw amount of tokens formatted with the decimals
+// of the mint, without any loss of precision and without trailing zeros
+// (e.g. "98.64" for a raw amount of 9864 with 2 decimals).
+// Unlike UiAmountString, it does not include the interest accrued
+// by the Token-2022 interest-bearing mints.
+func (a *UiTokenAmount) ExactString() string {
+	return formatTokenAmount(a.Raw(), a.Decimals)
+}
+
+func formatTokenAmount(raw *big.Int, decimals uint8) string {
+	digits := new(big.Int).Abs(raw).String()
+	sign := ""
+	if raw.Sign() < 0 {
+		sign = "-"
+	}
+	if decimals == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(decimals)
+	fraction := strings.TrimRight(digits[point:], "0")
+	if fraction == "" {
+		return sign + digits[:point]
+	}
+	return sign + digits[:point] + "." + fraction
+}
+
+// uiTokenAmountJSON is UiTokenAmount without its JSON methods.
+type uiTokenAmountJSON UiTokenAmount
+
+func (a *UiTokenAmount) UnmarshalJSON(data []byte) error {
+	var decoded uiTokenAmountJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	return a.fromJSON(&decoded)
+}
+
+func (a *UiTokenAmount) fromJSON(decoded *uiTokenAmountJSON) error {
+	if _, ok := new(big.Int).SetString(decoded.Amount, 10); !ok {
+		return errors.New("invalid token amount: " + strconv.Quote(decoded.Amount))
+	}
+	*a = UiTokenAmount(*decoded)
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUiTokenAmount(t *testing.T) {
+	for _, tt := range []struct {
+		raw      string
+		decimals uint8
+		expected string
+	}{
+		{"0", 0, "0"},
+		{"0", 6, "0"},
+		{"100", 0, "100"},
+		{"9864", 2, "98.64"},
+		{"1", 1, "0.1"},
+		{"1", 9, "0.000000001"},
+		{"1500000", 6, "1.5"},
+		{"1000000000", 9, "1"},
+		{"340282366920938463463374607431768211455", 9, "340282366920938463463374607431.768211455"},
+	} {
+		raw, _ := new(big.Int).SetString(tt.raw, 10)
+		amount := NewUiTokenAmount(raw, tt.decimals)
+		require.Equal(t, tt.expected, amount.String(), tt.raw)
+		require.Equal(t, tt.raw, amount.Raw().String())
+		require.Equal(t, tt.decimals, amount.Decimals)
+	}
+
+	var amount UiTokenAmount
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"18446744073709551616","decimals":2,"uiAmount":184467440737095516.16,"uiAmountString":"184467440737095516.16"}`), &amount))
+	require.Equal(t, "184467440737095516.16", amount.String())
+	_, err := amount.Uint64()
+	require.Error(t, err)
+
+	// Raw returns a copy.
+	amount.Raw().SetInt64(0)
+	require.Equal(t, "18446744073709551616", amount.Raw().String())
+
+	small := NewUiTokenAmountFromUint64(47444666, 6)
+	value, err := small.Uint64()
+	require.NoError(t, err)
+	require.Equal(t, uint64(47444666), value)
+	require.Equal(t, 47.444666, *small.UiAmount)
+	encoded, err := json.Marshal(small)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"47444666","decimals":6,"uiAmount":47.444666,"uiAmountString":"47.444666"}`, string(encoded))
+
+	require.Error(t, json.Unmarshal([]byte(`{"amount":"1.5","decimals":0}`), &amount))
+
+	// The UI amount of an interest-bearing mint includes the accrued interest.
+	var interest UiTokenAmount
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"1000000","decimals":6,"uiAmount":1.05,"uiAmountString":"1.05"}`), &interest))
+	require.Equal(t, "1.05", interest.String())
+	require.Equal(t, "1", interest.ExactString())
+	encoded, err = json.Marshal(interest)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"1000000","decimals":6,"uiAmount":1.05,"uiAmountString":"1.05"}`, string(encoded))
+
+	var largest TokenLargestAccountsResult
+	require.NoError(t, json.Unmarshal([]byte(`{"address":"11111111111111111111111111111111","amount":"1000000","decimals":6,"uiAmount":1.05,"uiAmountString":"1.05"}`), &largest))
+	require.Equal(t, "1.05", largest.String())
+	encoded, err = json.Marshal(largest)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"address":"11111111111111111111111111111111","amount":"1000000","decimals":6,"uiAmount":1.05,"uiAmountString":"1.05"}`, string(encoded))
+}
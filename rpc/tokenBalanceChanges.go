@@ -0,0 +1,234 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// TokenBalanceChange is the change of the balance of
+// a token account in a transaction.
+type TokenBalanceChange struct {
+	Account      solana.PublicKey
+	AccountIndex uint16
+
+	// The owner of the token account; zero if it could not be resolved.
+	Owner    solana.PublicKey
+	Mint     solana.PublicKey
+	Decimals uint8
+
+	// Raw amounts before and after the transaction; zero for an account
+	// created (Pre) or closed (Post) by the transaction.
+	Pre   *big.Int
+	Post  *big.Int
+	Delta *big.Int
+}
+
+// TokenOwnerLookup returns the owner of a token account,
+// for the accounts whose owner is not in the token balances.
+type TokenOwnerLookup func(ctx context.Context, account solana.PublicKey) (solana.PublicKey, error)
+
+// TokenOwnerLookup returns a TokenOwnerLookup reading the owner from the
+// token account with getAccountInfo (only the 32 bytes of the owner are fetched).
+// It fails for the accounts closed since.
+func (cl *Client) TokenOwnerLookup(commitment CommitmentType) TokenOwnerLookup {
+	return func(ctx context.Context, account solana.PublicKey) (solana.PublicKey, error) {
+		offset, length := uint64(32), uint64(32)
+		out, err := cl.GetAccountInfoWithOpts(ctx, account, &GetAccountInfoOpts{
+			Encoding:   solana.EncodingBase64,
+			Commitment: commitment,
+			DataSlice:  &DataSlice{Offset: &offset, Length: &length},
+		})
+		if err != nil {
+			return solana.PublicKey{}, err
+		}
+		data := out.Value.Data.GetBinary()
+		if len(data) != 32 {
+			return solana.PublicKey{}, fmt.Errorf("%s is not a token account", account)
+		}
+		return solana.PublicKeyFromBytes(data), nil
+	}
+}
+
+// TokenBalanceResolver joins the pre and post token balances of the
+// transactions, and resolves the owners of the token accounts.
+//
+// The owners found in the token balances are cached, so that the owner of an
+// account is known in every transaction of a block as soon as one of them
+// reports it: this covers the accounts created and closed in the same block,
+// which the lookup cannot find anymore, and the nodes not reporting the owners.
+// Use a resolver per block, or call Reset between blocks.
+type TokenBalanceResolver struct {
+	lookup TokenOwnerLookup
+
+	mu     sync.Mutex
+	owners map[solana.PublicKey]solana.PublicKey
+}
+
+// NewTokenBalanceResolver creates a resolver.
+// lookup is optional; it is called for the owners missing from the balances of the block.
+func NewTokenBalanceResolver(lookup TokenOwnerLookup) *TokenBalanceResolver {
+	return &TokenBalanceResolver{
+		lookup: lookup,
+		owners: make(map[solana.PublicKey]solana.PublicKey),
+	}
+}
+
+// Reset clears the cache of the owners.
+func (r *TokenBalanceResolver) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.owners = make(map[solana.PublicKey]solana.PublicKey)
+}
+
+// Learn caches the owners found in the token balances of the transaction.
+// The accountKeys are the keys of the transaction, including the loaded addresses
+// (see TransactionAccountKeys).
+func (r *TokenBalanceResolver) Learn(accountKeys solana.PublicKeySlice, meta *TransactionMeta) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, balances := range [][]TokenBalance{meta.PreTokenBalances, meta.PostTokenBalances} {
+		for _, balance := range balances {
+			if balance.Owner == nil || int(balance.AccountIndex) >= len(accountKeys) {
+				continue
+			}
+			r.owners[accountKeys[balance.AccountIndex]] = *balance.Owner
+		}
+	}
+}
+
+// Changes returns the changes of the token balances of the transaction,
+// in the order of the account indexes; the unchanged balances are included.
+// The accountKeys are the keys of the transaction, including the loaded addresses
+// (see TransactionAccountKeys).
+func (r *TokenBalanceResolver) Changes(ctx context.Context, accountKeys solana.PublicKeySlice, meta *TransactionMeta) ([]*TokenBalanceChange, error) {
+	if meta == nil {
+		return nil, fmt.Errorf("transaction metadata is missing")
+	}
+	r.Learn(accountKeys, meta)
+
+	var changes []*TokenBalanceChange
+	byIndex := make(map[uint16]*TokenBalanceChange)
+	join := func(balance *TokenBalance, post bool) error {
+		if int(balance.AccountIndex) >= len(accountKeys) {
+			return fmt.Errorf("token balance account index %d out of range (%d accounts)", balance.AccountIndex, len(accountKeys))
+		}
+		change, ok := byIndex[balance.AccountIndex]
+		if !ok {
+			change = &TokenBalanceChange{
+				Account:      accountKeys[balance.AccountIndex],
+				AccountIndex: balance.AccountIndex,
+				Mint:         balance.Mint,
+				Pre:          new(big.Int),
+				Post:         new(big.Int),
+			}
+			byIndex[balance.AccountIndex] = change
+			changes = append(changes, change)
+		}
+		if balance.UiTokenAmount != nil {
+			change.Decimals = balance.UiTokenAmount.Decimals
+			if post {
+				change.Post = balance.UiTokenAmount.Raw()
+			} else {
+				change.Pre = balance.UiTokenAmount.Raw()
+			}
+		}
+		return nil
+	}
+	for i := range meta.PreTokenBalances {
+		if err := join(&meta.PreTokenBalances[i], false); err != nil {
+			return nil, err
+		}
+	}
+	for i := range meta.PostTokenBalances {
+		if err := join(&meta.PostTokenBalances[i], true); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].AccountIndex < changes[j].AccountIndex })
+
+	for _, change := range changes {
+		change.Delta = new(big.Int).Sub(change.Post, change.Pre)
+		owner, err := r.owner(ctx, change.Account)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve the owner of %s: %w", change.Account, err)
+		}
+		change.Owner = owner
+	}
+	return changes, nil
+}
+
+func (r *TokenBalanceResolver) owner(ctx context.Context, account solana.PublicKey) (solana.PublicKey, error) {
+	r.mu.Lock()
+	owner, ok := r.owners[account]
+	r.mu.Unlock()
+	if ok || r.lookup == nil {
+		return owner, nil
+	}
+	owner, err := r.lookup(ctx, account)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	r.mu.Lock()
+	r.owners[account] = owner
+	r.mu.Unlock()
+	return owner, nil
+}
+
+// BlockChanges returns the changes of the token balances of each transaction of the
+// block. The owners found in all the transactions are cached before any lookup.
+func (r *TokenBalanceResolver) BlockChanges(ctx context.Context, block *GetBlockResult) ([][]*TokenBalanceChange, error) {
+	keys := make([]solana.PublicKeySlice, len(block.Transactions))
+	for i := range block.Transactions {
+		tx := &block.Transactions[i]
+		if tx.Meta == nil {
+			continue
+		}
+		parsed, err := tx.GetTransaction()
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode transaction %d: %w", i, err)
+		}
+		keys[i] = TransactionAccountKeys(parsed, tx.Meta)
+		r.Learn(keys[i], tx.Meta)
+	}
+	out := make([][]*TokenBalanceChange, len(block.Transactions))
+	for i := range block.Transactions {
+		if block.Transactions[i].Meta == nil {
+			continue
+		}
+		changes, err := r.Changes(ctx, keys[i], block.Transactions[i].Meta)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		out[i] = changes
+	}
+	return out, nil
+}
+
+// TransactionAccountKeys returns the keys of the accounts of the transaction,
+// in the order of the indexes of its metadata: the static keys,
+// then the writable and the readonly loaded addresses.
+func TransactionAccountKeys(tx *solana.Transaction, meta *TransactionMeta) solana.PublicKeySlice {
+	keys := make(solana.PublicKeySlice, 0, len(tx.Message.AccountKeys)+len(meta.LoadedAddresses.Writable)+len(meta.LoadedAddresses.ReadOnly))
+	keys = append(keys, tx.Message.AccountKeys...)
+	keys = append(keys, meta.LoadedAddresses.Writable...)
+	return append(keys, meta.LoadedAddresses.ReadOnly...)
+}
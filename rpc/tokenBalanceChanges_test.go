@@ -0,0 +1,119 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBalanceResolver_BlockChanges(t *testing.T) {
+	payer := solana.PublicKey{1}
+	program := solana.PublicKey{2}
+	// Created in the first transaction, and closed in the second one.
+	closed := solana.PublicKey{3}
+	other := solana.PublicKey{4}
+	mint := solana.PublicKey{5}
+	closedOwner := solana.PublicKey{6}
+	otherOwner := solana.PublicKey{7}
+
+	encode := func(keys ...solana.PublicKey) string {
+		tx := solana.Transaction{
+			Signatures: []solana.Signature{{}},
+			Message: solana.Message{
+				Header:       solana.MessageHeader{NumRequiredSignatures: 1, NumReadonlyUnsignedAccounts: 1},
+				AccountKeys:  keys,
+				Instructions: []solana.CompiledInstruction{{ProgramIDIndex: uint16(len(keys) - 1)}},
+			},
+		}
+		raw, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(raw)
+	}
+	amount := func(raw string) string {
+		return fmt.Sprintf(`{"amount":%q,"decimals":6,"uiAmount":null,"uiAmountString":"0"}`, raw)
+	}
+	block := fmt.Sprintf(`{"blockhash":%q,"previousBlockhash":%q,"parentSlot":1,"transactions":[
+		{"transaction":[%q,"base64"],"meta":{"err":null,"fee":5000,"preBalances":[],"postBalances":[],
+			"preTokenBalances":[],
+			"postTokenBalances":[{"accountIndex":1,"mint":%q,"owner":%q,"uiTokenAmount":%s}],
+			"loadedAddresses":{"readonly":[],"writable":[]}}},
+		{"transaction":[%q,"base64"],"meta":{"err":null,"fee":5000,"preBalances":[],"postBalances":[],
+			"preTokenBalances":[{"accountIndex":3,"mint":%q,"uiTokenAmount":%s},{"accountIndex":2,"mint":%q,"uiTokenAmount":%s}],
+			"postTokenBalances":[{"accountIndex":3,"mint":%q,"uiTokenAmount":%s}],
+			"loadedAddresses":{"readonly":[],"writable":[%q,%q]}}}
+	]}`,
+		solana.Hash{}, solana.Hash{},
+		encode(payer, closed, program), mint, closedOwner, amount("100"),
+		encode(payer, program), mint, amount("5"), mint, amount("100"), mint, amount("7"), closed, other,
+	)
+	var result GetBlockResult
+	require.NoError(t, stdjson.Unmarshal([]byte(block), &result))
+
+	var lookups []solana.PublicKey
+	resolver := NewTokenBalanceResolver(func(ctx context.Context, account solana.PublicKey) (solana.PublicKey, error) {
+		lookups = append(lookups, account)
+		if account.Equals(other) {
+			return otherOwner, nil
+		}
+		return solana.PublicKey{}, ErrNotFound
+	})
+	changes, err := resolver.BlockChanges(context.Background(), &result)
+	require.NoError(t, err)
+	require.Equal(t, [][]*TokenBalanceChange{
+		{
+			{Account: closed, AccountIndex: 1, Owner: closedOwner, Mint: mint, Decimals: 6, Pre: big.NewInt(0), Post: big.NewInt(100), Delta: big.NewInt(100)},
+		},
+		{
+			{Account: closed, AccountIndex: 2, Owner: closedOwner, Mint: mint, Decimals: 6, Pre: big.NewInt(100), Post: big.NewInt(0), Delta: big.NewInt(-100)},
+			{Account: other, AccountIndex: 3, Owner: otherOwner, Mint: mint, Decimals: 6, Pre: big.NewInt(5), Post: big.NewInt(7), Delta: big.NewInt(2)},
+		},
+	}, changes)
+	// The owner of the closed account comes from the block.
+	require.Equal(t, []solana.PublicKey{other}, lookups)
+
+	// Without the first transaction, the owner of the closed account is unknown.
+	resolver = NewTokenBalanceResolver(nil)
+	tx, err := result.Transactions[1].GetTransaction()
+	require.NoError(t, err)
+	single, err := resolver.Changes(context.Background(), TransactionAccountKeys(tx, result.Transactions[1].Meta), result.Transactions[1].Meta)
+	require.NoError(t, err)
+	require.Len(t, single, 2)
+	require.True(t, single[0].Owner.IsZero())
+
+	_, err = resolver.Changes(context.Background(), solana.PublicKeySlice{payer}, result.Transactions[1].Meta)
+	require.Error(t, err)
+}
+
+func TestClient_TokenOwnerLookup(t *testing.T) {
+	owner := solana.PublicKey{9}
+	responseBody := fmt.Sprintf(`{"context":{"slot":1},"value":{"data":[%q,"base64"],"executable":false,"lamports":2039280,"owner":%q,"rentEpoch":0}}`,
+		base64.StdEncoding.EncodeToString(owner[:]), solana.TokenProgramID)
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(wrapIntoRPC(responseBody)))
+	defer closer()
+
+	got, err := New(server.URL).TokenOwnerLookup(CommitmentConfirmed)(context.Background(), solana.PublicKey{8})
+	require.NoError(t, err)
+	require.Equal(t, owner, got)
+	params := server.RequestBody(t)["params"].([]interface{})
+	require.Equal(t, map[string]interface{}{"offset": float64(32), "length": float64(32)}, params[1].(map[string]interface{})["dataSlice"])
+}
@@ -0,0 +1,251 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// Keys of the attributes of the spans.
+const (
+	AttributeRPCSystem     = "rpc.system"
+	AttributeRPCMethod     = "rpc.method"
+	AttributeRPCErrorCode  = "rpc.jsonrpc.error_code"
+	AttributeServerAddress = "server.address"
+	// Commitment of the request.
+	AttributeCommitment = "solana.commitment"
+	// Slot requested (e.g. by getBlock).
+	AttributeSlot = "solana.slot"
+	// minContextSlot of the request.
+	AttributeMinContextSlot = "solana.min_context_slot"
+	// Slot of the context of the response.
+	AttributeContextSlot = "solana.context_slot"
+	// Id of the subscription of a WebSocket notification.
+	AttributeSubscriptionID = "solana.subscription_id"
+)
+
+// Attribute is an attribute of a span; Value is a string, an int64, a uint64 or a bool.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	// End ends the span; err is the error of the traced operation, if any.
+	End(err error)
+}
+
+// Tracer starts the spans of the RPC calls (and of the WebSocket
+// subscriptions, see ws.Options). An adapter for OpenTelemetry is a few lines:
+//
+//	type otelTracer struct{ trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string, attributes ...rpc.Attribute) (context.Context, rpc.Span) {
+//		ctx, span := t.Tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		s := otelSpan{span}
+//		s.SetAttributes(attributes...)
+//		return ctx, s
+//	}
+//
+//	type otelSpan struct{ trace.Span }
+//
+//	func (s otelSpan) SetAttributes(attributes ...rpc.Attribute) {
+//		for _, attr := range attributes {
+//			switch v := attr.Value.(type) {
+//			case string:
+//				s.Span.SetAttributes(attribute.String(attr.Key, v))
+//			case int64:
+//				s.Span.SetAttributes(attribute.Int64(attr.Key, v))
+//			case uint64:
+//				s.Span.SetAttributes(attribute.Int64(attr.Key, int64(v)))
+//			case bool:
+//				s.Span.SetAttributes(attribute.Bool(attr.Key, v))
+//			}
+//		}
+//	}
+//
+//	func (s otelSpan) End(err error) {
+//		if err != nil {
+//			s.Span.RecordError(err)
+//			s.Span.SetStatus(codes.Error, err.Error())
+//		}
+//		s.Span.End()
+//	}
+type Tracer interface {
+	Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+// SetTracer sets the tracer starting a span for each call of the client,
+// named after the method; nil removes it. It must be called before the client is used.
+func (cl *Client) SetTracer(tracer Tracer) *Client {
+	if traced, ok := cl.rpcClient.(*tracedClient); ok {
+		cl.rpcClient = traced.rpcClient
+	}
+	if tracer != nil {
+		cl.rpcClient = &tracedClient{
+			rpcClient: cl.rpcClient,
+			tracer:    tracer,
+			address:   ServerAddress(cl.rpcURL),
+		}
+	}
+	return cl
+}
+
+// ServerAddress returns the host of the endpoint, without the
+// credentials, the path or the query (which may contain an API key).
+func ServerAddress(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+type tracedClient struct {
+	rpcClient JSONRPCClient
+	tracer    Tracer
+	address   string
+}
+
+func (tc *tracedClient) start(ctx context.Context, method string, params []interface{}) (context.Context, Span) {
+	attributes := append([]Attribute{
+		{Key: AttributeRPCSystem, Value: "jsonrpc"},
+		{Key: AttributeRPCMethod, Value: method},
+	}, RequestAttributes(params)...)
+	if tc.address != "" {
+		attributes = append(attributes, Attribute{Key: AttributeServerAddress, Value: tc.address})
+	}
+	return tc.tracer.Start(ctx, method, attributes...)
+}
+
+func endSpan(span Span, err error) {
+	var rpcErr *jsonrpc.RPCError
+	if errors.As(err, &rpcErr) {
+		span.SetAttributes(Attribute{Key: AttributeRPCErrorCode, Value: int64(rpcErr.Code)})
+	}
+	span.End(err)
+}
+
+func (tc *tracedClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	ctx, span := tc.start(ctx, method, params)
+	err := tc.rpcClient.CallForInto(ctx, out, method, params)
+	if slot, ok := contextSlot(out); ok && err == nil {
+		span.SetAttributes(Attribute{Key: AttributeContextSlot, Value: slot})
+	}
+	endSpan(span, err)
+	return err
+}
+
+func (tc *tracedClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	ctx, span := tc.start(ctx, method, params)
+	err := tc.rpcClient.CallWithCallback(ctx, method, params, callback)
+	endSpan(span, err)
+	return err
+}
+
+// CallBatch starts a span named "batch" for the batch.
+func (tc *tracedClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	batchClient, ok := tc.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return nil, ErrBatchNotSupported
+	}
+	attributes := []Attribute{
+		{Key: AttributeRPCSystem, Value: "jsonrpc"},
+		{Key: "rpc.batch_size", Value: int64(len(requests))},
+	}
+	if tc.address != "" {
+		attributes = append(attributes, Attribute{Key: AttributeServerAddress, Value: tc.address})
+	}
+	ctx, span := tc.tracer.Start(ctx, "batch", attributes...)
+	responses, err := batchClient.CallBatch(ctx, requests)
+	span.End(err)
+	return responses, err
+}
+
+func (tc *tracedClient) Close() error {
+	if c, ok := tc.rpcClient.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// RequestAttributes returns the attributes of the parameters of a request:
+// the commitment and the minContextSlot of its config object, and the slot
+// of the methods taking a slot as first parameter.
+func RequestAttributes(params []interface{}) []Attribute {
+	var attributes []Attribute
+	if len(params) > 0 {
+		if slot, ok := params[0].(uint64); ok {
+			attributes = append(attributes, Attribute{Key: AttributeSlot, Value: slot})
+		}
+		var config map[string]interface{}
+		switch c := params[len(params)-1].(type) {
+		case M:
+			config = c
+		case map[string]interface{}:
+			config = c
+		}
+		if config != nil {
+			if commitment, ok := config["commitment"]; ok {
+				if s, ok := commitment.(string); ok {
+					attributes = append(attributes, Attribute{Key: AttributeCommitment, Value: s})
+				} else if c, ok := commitment.(CommitmentType); ok {
+					attributes = append(attributes, Attribute{Key: AttributeCommitment, Value: string(c)})
+				}
+			}
+			if slot, ok := config["minContextSlot"].(uint64); ok {
+				attributes = append(attributes, Attribute{Key: AttributeMinContextSlot, Value: slot})
+			} else if slot, ok := config["minContextSlot"].(*uint64); ok && slot != nil {
+				attributes = append(attributes, Attribute{Key: AttributeMinContextSlot, Value: *slot})
+			}
+		}
+	}
+	return attributes
+}
+
+var rpcContextType = reflect.TypeOf(RPCContext{})
+
+// contextSlot returns the slot of the context of a result embedding RPCContext.
+func contextSlot(out interface{}) (uint64, bool) {
+	v := reflect.ValueOf(out)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return 0, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+	field, ok := v.Type().FieldByName("RPCContext")
+	if !ok || !field.Anonymous || field.Type != rpcContextType {
+		return 0, false
+	}
+	return v.FieldByIndex(field.Index).Interface().(RPCContext).Context.Slot, true
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedSpan struct {
+	name       string
+	attributes map[string]interface{}
+	ended      bool
+	err        error
+}
+
+func (s *recordedSpan) SetAttributes(attributes ...Attribute) {
+	for _, attr := range attributes {
+		s.attributes[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordedSpan) End(err error) {
+	s.ended = true
+	s.err = err
+}
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	span := &recordedSpan{name: name, attributes: map[string]interface{}{}}
+	span.SetAttributes(attributes...)
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func TestClient_SetTracer(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","result":{"context":{"slot":123},"value":42},"id":0}`))
+	defer closer()
+
+	tracer := &recordingTracer{}
+	client := New(server.URL + "/?api-key=secret").SetTracer(tracer)
+	_, err := client.GetBalance(context.Background(), solana.SystemProgramID, CommitmentFinalized)
+	require.NoError(t, err)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	require.Equal(t, "getBalance", span.name)
+	require.True(t, span.ended)
+	require.NoError(t, span.err)
+	require.Equal(t, map[string]interface{}{
+		AttributeRPCSystem:     "jsonrpc",
+		AttributeRPCMethod:     "getBalance",
+		AttributeServerAddress: server.Listener.Addr().String(),
+		AttributeCommitment:    "finalized",
+		AttributeContextSlot:   uint64(123),
+	}, span.attributes)
+
+	client.SetTracer(nil)
+	_, err = client.GetBalance(context.Background(), solana.SystemProgramID, CommitmentFinalized)
+	require.NoError(t, err)
+	require.Len(t, tracer.spans, 1)
+}
+
+func TestClient_SetTracer_Error(t *testing.T) {
+	server, closer := mockJSONRPC(t, stdjson.RawMessage(`{"jsonrpc":"2.0","error":{"code":-32007,"message":"Slot 5 was skipped"},"id":0}`))
+	defer closer()
+
+	tracer := &recordingTracer{}
+	client := New(server.URL).SetTracer(tracer)
+	_, err := client.GetBlockTime(context.Background(), 5)
+	require.Error(t, err)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	require.Equal(t, "getBlockTime", span.name)
+	require.Equal(t, err, span.err)
+	require.Equal(t, uint64(5), span.attributes[AttributeSlot])
+	require.Equal(t, int64(-32007), span.attributes[AttributeRPCErrorCode])
+}
+
+func TestRequestAttributes(t *testing.T) {
+	minContextSlot := uint64(9)
+	require.Equal(t, []Attribute{
+		{Key: AttributeCommitment, Value: "confirmed"},
+		{Key: AttributeMinContextSlot, Value: uint64(9)},
+	}, RequestAttributes([]interface{}{"address", M{"commitment": CommitmentConfirmed, "minContextSlot": &minContextSlot}}))
+	require.Equal(t, []Attribute{
+		{Key: AttributeSlot, Value: uint64(7)},
+	}, RequestAttributes([]interface{}{uint64(7)}))
+	require.Empty(t, RequestAttributes(nil))
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txevents
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultSubject is the subject (NATS) or topic (Kafka)
+// events are published to when none is configured.
+const DefaultSubject = "solana.transactions"
+
+// Message is a message to be published on the bus.
+type Message struct {
+	// Subject or topic of the message.
+	Subject string
+	// Key orders the messages: messages with the same key
+	// are published one at a time, in the order they were enqueued.
+	Key string
+	// ID uniquely identifies the message, for deduplication by the bus or the consumers.
+	ID string
+	// Data is the payload of the message.
+	Data []byte
+}
+
+// Publisher publishes a message on a message bus.
+// Publish must return only once the bus acknowledged the message.
+type Publisher interface {
+	Publish(ctx context.Context, msg *Message) error
+}
+
+// PublisherFunc is a function implementing the Publisher interface.
+type PublisherFunc func(ctx context.Context, msg *Message) error
+
+func (f PublisherFunc) Publish(ctx context.Context, msg *Message) error {
+	return f(ctx, msg)
+}
+
+type ForwarderOpts struct {
+	// Subject of the published events.
+	// Defaults to DefaultSubject.
+	Subject string
+	// Delay before the first retry of a failed publish; it doubles
+	// on each retry up to MaxBackoff.
+	// Defaults to 100 milliseconds.
+	MinBackoff time.Duration
+	// Defaults to 30 seconds.
+	MaxBackoff time.Duration
+	// OnError is called when a publish fails, before it is retried.
+	OnError func(msg *Message, err error)
+}
+
+// Forwarder publishes events with at-least-once delivery:
+// a message is retried until the publisher accepts it.
+// Messages with the same key are published in order; a message
+// that keeps failing holds back the following messages of its key,
+// but not the messages of other keys.
+type Forwarder struct {
+	publisher Publisher
+	opts      ForwarderOpts
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	queues map[string][]*Message
+	queued int
+	idle   chan struct{}
+}
+
+// NewForwarder creates a forwarder publishing to the provided publisher.
+func NewForwarder(publisher Publisher, opts *ForwarderOpts) *Forwarder {
+	forwarder := &Forwarder{
+		publisher: publisher,
+		queues:    make(map[string][]*Message),
+		idle:      make(chan struct{}),
+	}
+	close(forwarder.idle)
+	if opts != nil {
+		forwarder.opts = *opts
+	}
+	if forwarder.opts.Subject == "" {
+		forwarder.opts.Subject = DefaultSubject
+	}
+	if forwarder.opts.MinBackoff <= 0 {
+		forwarder.opts.MinBackoff = 100 * time.Millisecond
+	}
+	if forwarder.opts.MaxBackoff <= 0 {
+		forwarder.opts.MaxBackoff = 30 * time.Second
+	}
+	forwarder.ctx, forwarder.cancel = context.WithCancel(context.Background())
+	return forwarder
+}
+
+// Handle enqueues an event for publishing, keyed by its signature.
+// It can be used directly as the Handler of a Tracker.
+func (forwarder *Forwarder) Handle(event *Event) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		// Only an unsupported Err value can fail to marshal.
+		event = &Event{
+			Signature: event.Signature,
+			Sequence:  event.Sequence,
+			Slot:      event.Slot,
+			Status:    event.Status,
+			Err:       err.Error(),
+			Expired:   event.Expired,
+		}
+		data, _ = json.Marshal(event)
+	}
+	sig := event.Signature.String()
+	forwarder.Enqueue(&Message{
+		Subject: forwarder.opts.Subject,
+		Key:     sig,
+		ID:      sig + "-" + strconv.FormatUint(event.Sequence, 10),
+		Data:    data,
+	})
+}
+
+// Enqueue adds a message to the queue of its key.
+func (forwarder *Forwarder) Enqueue(msg *Message) {
+	forwarder.mu.Lock()
+	defer forwarder.mu.Unlock()
+	if forwarder.ctx.Err() != nil {
+		return
+	}
+	if forwarder.queued == 0 {
+		forwarder.idle = make(chan struct{})
+	}
+	forwarder.queued++
+	queue, running := forwarder.queues[msg.Key]
+	forwarder.queues[msg.Key] = append(queue, msg)
+	if !running {
+		go forwarder.drain(msg.Key)
+	}
+}
+
+// Pending returns the number of messages not yet published.
+func (forwarder *Forwarder) Pending() int {
+	forwarder.mu.Lock()
+	defer forwarder.mu.Unlock()
+	return forwarder.queued
+}
+
+// Flush waits until all the enqueued messages are published.
+func (forwarder *Forwarder) Flush(ctx context.Context) error {
+	forwarder.mu.Lock()
+	idle := forwarder.idle
+	forwarder.mu.Unlock()
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops the forwarder; messages not yet published are dropped.
+// Call Flush first to deliver them.
+func (forwarder *Forwarder) Close() {
+	forwarder.cancel()
+}
+
+// drain publishes the messages of a key until its queue is empty.
+func (forwarder *Forwarder) drain(key string) {
+	for {
+		forwarder.mu.Lock()
+		queue := forwarder.queues[key]
+		if len(queue) == 0 || forwarder.ctx.Err() != nil {
+			delete(forwarder.queues, key)
+			forwarder.mu.Unlock()
+			return
+		}
+		msg := queue[0]
+		forwarder.mu.Unlock()
+
+		if !forwarder.publish(msg) {
+			return
+		}
+
+		forwarder.mu.Lock()
+		forwarder.queues[key] = forwarder.queues[key][1:]
+		forwarder.queued--
+		if forwarder.queued == 0 {
+			close(forwarder.idle)
+		}
+		forwarder.mu.Unlock()
+	}
+}
+
+// publish publishes a message, retrying with backoff until it succeeds.
+// It returns false if the forwarder was closed in the meantime.
+func (forwarder *Forwarder) publish(msg *Message) bool {
+	backoff := forwarder.opts.MinBackoff
+	for {
+		err := forwarder.publisher.Publish(forwarder.ctx, msg)
+		if err == nil {
+			return true
+		}
+		if forwarder.ctx.Err() != nil {
+			return false
+		}
+		if forwarder.opts.OnError != nil {
+			forwarder.opts.OnError(msg, err)
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-forwarder.ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > forwarder.opts.MaxBackoff {
+			backoff = forwarder.opts.MaxBackoff
+		}
+	}
+}
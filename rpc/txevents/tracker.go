@@ -0,0 +1,258 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package txevents tracks the confirmation status of submitted transactions
+// and forwards the status changes to a message bus (NATS, Kafka, ...) so that
+// event-driven backends can react to them.
+//
+// The bus itself is abstracted by the Publisher interface; an adapter is
+// usually a few lines, e.g. for NATS JetStream:
+//
+//	publisher := txevents.PublisherFunc(func(ctx context.Context, msg *txevents.Message) error {
+//		_, err := js.Publish(msg.Subject, msg.Data, nats.MsgId(msg.ID), nats.Context(ctx))
+//		return err
+//	})
+//
+// and for Kafka, msg.Key is used as the record key so that all the events of
+// a transaction land on the same partition.
+package txevents
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// maxSignatureStatuses is the maximum number of signatures
+// accepted by a single getSignatureStatuses call.
+const maxSignatureStatuses = 256
+
+// Event is a change of the confirmation status of a transaction.
+type Event struct {
+	Signature solana.Signature `json:"signature"`
+	// Sequence is incremented for every event of the same signature, starting at 1.
+	// Consumers can use it to discard the duplicates of an at-least-once delivery.
+	Sequence uint64 `json:"sequence"`
+	// The slot the transaction was processed in.
+	Slot uint64 `json:"slot,omitempty"`
+	// The confirmation status reached; empty if the event is an expiry.
+	Status rpc.ConfirmationStatusType `json:"status,omitempty"`
+	// Error if the transaction failed, nil if it succeeded.
+	Err interface{} `json:"err,omitempty"`
+	// Expired is set when the transaction did not reach the
+	// finalized status within the tracking timeout.
+	Expired bool `json:"expired,omitempty"`
+}
+
+// Handler receives the events of a Tracker.
+// The events of a given signature are delivered in order.
+type Handler func(event *Event)
+
+type TrackerOpts struct {
+	// Interval between two getSignatureStatuses polls.
+	// Defaults to 2 seconds.
+	PollInterval time.Duration
+	// Duration after which a transaction that is not finalized
+	// is dropped and an expired event emitted.
+	// Defaults to 2 minutes.
+	Timeout time.Duration
+	// OnError is called when a poll fails; the poll is retried on the next tick.
+	OnError func(err error)
+}
+
+type trackedSignature struct {
+	added    time.Time
+	status   rpc.ConfirmationStatusType
+	sequence uint64
+}
+
+// Tracker polls the status of a set of signatures and emits an Event
+// every time one of them reaches a higher confirmation status.
+// Signatures are dropped from the tracker once finalized or expired.
+type Tracker struct {
+	client  *rpc.Client
+	handler Handler
+	opts    TrackerOpts
+	now     func() time.Time
+
+	mu      sync.Mutex
+	pending map[solana.Signature]*trackedSignature
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewTracker creates a tracker and starts polling in the background.
+// Call Close to stop it.
+func NewTracker(client *rpc.Client, handler Handler, opts *TrackerOpts) *Tracker {
+	tracker := newTracker(client, handler, opts)
+	go tracker.run()
+	return tracker
+}
+
+func newTracker(client *rpc.Client, handler Handler, opts *TrackerOpts) *Tracker {
+	tracker := &Tracker{
+		client:  client,
+		handler: handler,
+		now:     time.Now,
+		pending: make(map[solana.Signature]*trackedSignature),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if opts != nil {
+		tracker.opts = *opts
+	}
+	if tracker.opts.PollInterval <= 0 {
+		tracker.opts.PollInterval = 2 * time.Second
+	}
+	if tracker.opts.Timeout <= 0 {
+		tracker.opts.Timeout = 2 * time.Minute
+	}
+	return tracker
+}
+
+// Track adds signatures to the tracker.
+// Signatures that are already tracked are ignored.
+func (tracker *Tracker) Track(signatures ...solana.Signature) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	for _, sig := range signatures {
+		if _, ok := tracker.pending[sig]; !ok {
+			tracker.pending[sig] = &trackedSignature{added: tracker.now()}
+		}
+	}
+}
+
+// Pending returns the number of signatures being tracked.
+func (tracker *Tracker) Pending() int {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	return len(tracker.pending)
+}
+
+// Close stops the tracker.
+func (tracker *Tracker) Close() {
+	tracker.closeOnce.Do(func() {
+		close(tracker.stop)
+	})
+	<-tracker.done
+}
+
+func (tracker *Tracker) run() {
+	defer close(tracker.done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-tracker.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(tracker.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-tracker.stop:
+			return
+		case <-ticker.C:
+			if err := tracker.poll(ctx); err != nil && tracker.opts.OnError != nil && ctx.Err() == nil {
+				tracker.opts.OnError(err)
+			}
+		}
+	}
+}
+
+func statusRank(status rpc.ConfirmationStatusType) int {
+	switch status {
+	case rpc.ConfirmationStatusProcessed:
+		return 1
+	case rpc.ConfirmationStatusConfirmed:
+		return 2
+	case rpc.ConfirmationStatusFinalized:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// poll fetches the statuses of all the tracked signatures
+// and emits the resulting events.
+func (tracker *Tracker) poll(ctx context.Context) error {
+	tracker.mu.Lock()
+	signatures := make([]solana.Signature, 0, len(tracker.pending))
+	for sig := range tracker.pending {
+		signatures = append(signatures, sig)
+	}
+	tracker.mu.Unlock()
+
+	for start := 0; start < len(signatures); start += maxSignatureStatuses {
+		end := start + maxSignatureStatuses
+		if end > len(signatures) {
+			end = len(signatures)
+		}
+		chunk := signatures[start:end]
+		out, err := tracker.client.GetSignatureStatuses(ctx, false, chunk...)
+		if err != nil {
+			return err
+		}
+		var events []*Event
+		tracker.mu.Lock()
+		for i, sig := range chunk {
+			tracked, ok := tracker.pending[sig]
+			if !ok {
+				continue
+			}
+			var status *rpc.SignatureStatusesResult
+			if out != nil && i < len(out.Value) {
+				status = out.Value[i]
+			}
+			if status != nil && statusRank(status.ConfirmationStatus) > statusRank(tracked.status) {
+				tracked.status = status.ConfirmationStatus
+				tracked.sequence++
+				events = append(events, &Event{
+					Signature: sig,
+					Sequence:  tracked.sequence,
+					Slot:      status.Slot,
+					Status:    status.ConfirmationStatus,
+					Err:       status.Err,
+				})
+				if status.ConfirmationStatus == rpc.ConfirmationStatusFinalized {
+					delete(tracker.pending, sig)
+				}
+				continue
+			}
+			if tracker.now().Sub(tracked.added) >= tracker.opts.Timeout {
+				tracked.sequence++
+				events = append(events, &Event{
+					Signature: sig,
+					Sequence:  tracked.sequence,
+					Expired:   true,
+				})
+				delete(tracker.pending, sig)
+			}
+		}
+		tracker.mu.Unlock()
+
+		for _, event := range events {
+			tracker.handler(event)
+		}
+	}
+	return nil
+}
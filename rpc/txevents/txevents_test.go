@@ -0,0 +1,160 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txevents
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type statusNode struct {
+	mu       sync.Mutex
+	statuses map[solana.Signature]string
+	calls    int
+}
+
+func (node *statusNode) set(sig solana.Signature, status string) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.statuses[sig] = status
+}
+
+func (node *statusNode) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	if method != "getSignatureStatuses" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.calls++
+	var values []string
+	for _, sig := range params[0].([]solana.Signature) {
+		status, ok := node.statuses[sig]
+		if !ok {
+			status = "null"
+		}
+		values = append(values, status)
+	}
+	return stdjson.Unmarshal([]byte(`{"context":{"slot":200},"value":[`+strings.Join(values, ",")+`]}`), out)
+}
+
+func (node *statusNode) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return fmt.Errorf("not implemented")
+}
+
+func TestTrackerPoll(t *testing.T) {
+	landed := solana.Signature{1}
+	failed := solana.Signature{2}
+	dropped := solana.Signature{3}
+	node := &statusNode{statuses: map[solana.Signature]string{}}
+
+	var events []*Event
+	now := time.Unix(1000, 0)
+	tracker := newTracker(rpc.NewWithCustomRPCClient(node), func(event *Event) {
+		events = append(events, event)
+	}, &TrackerOpts{Timeout: time.Minute})
+	tracker.now = func() time.Time { return now }
+	tracker.Track(landed, failed, dropped)
+	tracker.Track(landed)
+	require.Equal(t, 3, tracker.Pending())
+
+	ctx := context.Background()
+	require.NoError(t, tracker.poll(ctx))
+	require.Empty(t, events)
+
+	node.set(landed, `{"slot":100,"confirmations":0,"err":null,"confirmationStatus":"processed"}`)
+	node.set(failed, `{"slot":101,"confirmations":null,"err":{"InstructionError":[0,{"Custom":1}]},"confirmationStatus":"finalized"}`)
+	require.NoError(t, tracker.poll(ctx))
+	require.Len(t, events, 2)
+	byStatus := map[solana.Signature]*Event{}
+	for _, event := range events {
+		byStatus[event.Signature] = event
+	}
+	require.Equal(t, &Event{Signature: landed, Sequence: 1, Slot: 100, Status: rpc.ConfirmationStatusProcessed}, byStatus[landed])
+	require.Equal(t, uint64(1), byStatus[failed].Sequence)
+	require.Equal(t, rpc.ConfirmationStatusFinalized, byStatus[failed].Status)
+	require.NotNil(t, byStatus[failed].Err)
+	require.Equal(t, 2, tracker.Pending())
+
+	// No change, no event.
+	events = nil
+	require.NoError(t, tracker.poll(ctx))
+	require.Empty(t, events)
+
+	node.set(landed, `{"slot":100,"confirmations":null,"err":null,"confirmationStatus":"finalized"}`)
+	now = now.Add(time.Minute)
+	require.NoError(t, tracker.poll(ctx))
+	require.Len(t, events, 2)
+	byStatus = map[solana.Signature]*Event{}
+	for _, event := range events {
+		byStatus[event.Signature] = event
+	}
+	require.Equal(t, &Event{Signature: landed, Sequence: 2, Slot: 100, Status: rpc.ConfirmationStatusFinalized}, byStatus[landed])
+	require.Equal(t, &Event{Signature: dropped, Sequence: 1, Expired: true}, byStatus[dropped])
+	require.Equal(t, 0, tracker.Pending())
+}
+
+func TestForwarder(t *testing.T) {
+	var mu sync.Mutex
+	var published []*Message
+	failures := map[string]int{"a-2": 2}
+	forwarder := NewForwarder(PublisherFunc(func(ctx context.Context, msg *Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if failures[msg.ID] > 0 {
+			failures[msg.ID]--
+			return fmt.Errorf("unavailable")
+		}
+		published = append(published, msg)
+		return nil
+	}), &ForwarderOpts{Subject: "txs", MinBackoff: time.Millisecond})
+	defer forwarder.Close()
+
+	for i := 1; i <= 3; i++ {
+		forwarder.Enqueue(&Message{Key: "a", ID: fmt.Sprintf("a-%d", i)})
+		forwarder.Enqueue(&Message{Key: "b", ID: fmt.Sprintf("b-%d", i)})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, forwarder.Flush(ctx))
+	require.Equal(t, 0, forwarder.Pending())
+
+	perKey := map[string][]string{}
+	for _, msg := range published {
+		perKey[msg.Key] = append(perKey[msg.Key], msg.ID)
+	}
+	require.Equal(t, []string{"a-1", "a-2", "a-3"}, perKey["a"])
+	require.Equal(t, []string{"b-1", "b-2", "b-3"}, perKey["b"])
+
+	sig := solana.Signature{7}
+	forwarder.Handle(&Event{Signature: sig, Sequence: 2, Slot: 5, Status: rpc.ConfirmationStatusConfirmed})
+	require.NoError(t, forwarder.Flush(ctx))
+	last := published[len(published)-1]
+	require.Equal(t, "txs", last.Subject)
+	require.Equal(t, sig.String(), last.Key)
+	require.Equal(t, sig.String()+"-2", last.ID)
+	var event Event
+	require.NoError(t, stdjson.Unmarshal(last.Data, &event))
+	require.Equal(t, Event{Signature: sig, Sequence: 2, Slot: 5, Status: rpc.ConfirmationStatusConfirmed}, event)
+}
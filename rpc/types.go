@@ -20,15 +20,20 @@ package rpc
 import (
 	"encoding/base64"
 	stdjson "encoding/json"
+	"errors"
 	"fmt"
 
 	bin "github.com/gagliardetto/binary"
+	"github.com/mr-tron/base58"
 
 	"github.com/gagliardetto/solana-go"
 )
 
 type Context struct {
 	Slot uint64 `json:"slot"`
+
+	// The RPC API version of the node (solana-core v1.8+).
+	APIVersion string `json:"apiVersion,omitempty"`
 }
 
 type RPCContext struct {
@@ -158,21 +163,6 @@ type TokenBalance struct {
 	UiTokenAmount *UiTokenAmount   `json:"uiTokenAmount"`
 }
 
-type UiTokenAmount struct {
-	// Raw amount of tokens as a string, ignoring decimals.
-	Amount string `json:"amount"`
-
-	// TODO: <number> == int64 ???
-	// Number of decimals configured for token's mint.
-	Decimals uint8 `json:"decimals"`
-
-	// DEPRECATED: Token amount as a float, accounting for decimals.
-	UiAmount *float64 `json:"uiAmount"`
-
-	// Token amount as a string, accounting for decimals.
-	UiAmountString string `json:"uiAmountString"`
-}
-
 type LoadedAddresses struct {
 	ReadOnly solana.PublicKeySlice `json:"readonly"`
 	Writable solana.PublicKeySlice `json:"writable"`
@@ -291,8 +281,14 @@ type Account struct {
 	// Boolean indicating if the account contains a program (and is strictly read-only)
 	Executable bool `json:"executable"`
 
-	// The epoch at which this account will next owe rent
+	// The epoch at which this account will next owe rent.
+	// solana.RentExemptRentEpoch for the accounts marked as rent exempt
+	// by the runtime; see AccountRentStatus.
 	RentEpoch uint64 `json:"rentEpoch"`
+
+	// The data size of the account, even if the data was sliced.
+	// Only returned by recent nodes.
+	Space *uint64 `json:"space,omitempty"`
 }
 
 type DataBytesOrJSON struct {
@@ -373,10 +369,62 @@ func (dt *DataBytesOrJSON) GetRawJSON() stdjson.RawMessage {
 	return dt.asJSON
 }
 
+// DataSlice limits the returned account data to length bytes starting at offset;
+// it is only available for the binary encodings ("base58", "base64", "base64+zstd").
 type DataSlice struct {
 	Offset *uint64 `json:"offset,omitempty"`
 	Length *uint64 `json:"length,omitempty"`
 }
+
+// NewDataSlice returns the DataSlice of length bytes starting at offset.
+func NewDataSlice(offset uint64, length uint64) *DataSlice {
+	return &DataSlice{Offset: &offset, Length: &length}
+}
+
+// ToMap encodes the slice as the dataSlice object of the request;
+// the offset defaults to zero.
+func (ds *DataSlice) ToMap() M {
+	offset := ds.Offset
+	if offset == nil {
+		offset = new(uint64)
+	}
+	return M{
+		"offset": offset,
+		"length": ds.Length,
+	}
+}
+
+// errDataSliceJSONParsed is returned when dataSlice is used with the jsonParsed encoding,
+// which the nodes don't support.
+var errDataSliceJSONParsed = errors.New("cannot use dataSlice with EncodingJSONParsed")
+
+// validateTransactionEncoding returns an error if the encoding
+// is not supported for the transactions returned by the client.
+func validateTransactionEncoding(encoding solana.EncodingType) error {
+	if encoding == "" {
+		return nil
+	}
+	if !solana.IsAnyOfEncodingType(
+		encoding,
+		// Valid encodings:
+		// solana.EncodingJSON, // TODO
+		// solana.EncodingJSONParsed, // TODO
+		solana.EncodingBase58,
+		solana.EncodingBase64,
+		solana.EncodingBase64Zstd,
+	) {
+		return fmt.Errorf("provided encoding is not supported: %s", encoding)
+	}
+	return nil
+}
+
+func validateDataSlice(encoding solana.EncodingType, dataSlice *DataSlice) error {
+	if dataSlice != nil && encoding == solana.EncodingJSONParsed {
+		return errDataSliceJSONParsed
+	}
+	return nil
+}
+
 type GetProgramAccountsOpts struct {
 	Commitment CommitmentType `json:"commitment,omitempty"`
 
@@ -389,6 +437,31 @@ type GetProgramAccountsOpts struct {
 	// Filter results using various filter objects;
 	// account must meet all filter criteria to be included in results.
 	Filters []RPCFilter `json:"filters,omitempty"`
+
+	// The minimum slot that the request can be evaluated at.
+	// This parameter is optional.
+	MinContextSlot *uint64 `json:"minContextSlot,omitempty"`
+}
+
+// ToMap encodes the options as the config object of the request.
+func (opts *GetProgramAccountsOpts) ToMap() M {
+	obj := M{}
+	if opts.Commitment != "" {
+		obj["commitment"] = string(opts.Commitment)
+	}
+	if len(opts.Filters) != 0 {
+		obj["filters"] = opts.Filters
+	}
+	if opts.Encoding != "" {
+		obj["encoding"] = opts.Encoding
+	}
+	if opts.DataSlice != nil {
+		obj["dataSlice"] = opts.DataSlice.ToMap()
+	}
+	if opts.MinContextSlot != nil {
+		obj["minContextSlot"] = *opts.MinContextSlot
+	}
+	return obj
 }
 
 type GetProgramAccountsResult []*KeyedAccount
@@ -405,6 +478,24 @@ type GetConfirmedSignaturesForAddress2Opts struct {
 	Commitment CommitmentType   `json:"commitment,omitempty"`
 }
 
+// ToMap encodes the options as the config object of the request.
+func (opts *GetConfirmedSignaturesForAddress2Opts) ToMap() M {
+	obj := M{}
+	if opts.Limit != nil {
+		obj["limit"] = opts.Limit
+	}
+	if !opts.Before.IsZero() {
+		obj["before"] = opts.Before
+	}
+	if !opts.Until.IsZero() {
+		obj["until"] = opts.Until
+	}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	return obj
+}
+
 type GetConfirmedSignaturesForAddress2Result []*TransactionSignature
 
 type RPCFilter struct {
@@ -415,6 +506,52 @@ type RPCFilter struct {
 type RPCFilterMemcmp struct {
 	Offset uint64        `json:"offset"`
 	Bytes  solana.Base58 `json:"bytes"`
+
+	// The encoding of the bytes in the request: solana.EncodingBase58 (default)
+	// or solana.EncodingBase64. The nodes reject base58 data longer than 128 bytes.
+	Encoding solana.EncodingType `json:"encoding,omitempty"`
+}
+
+func (m RPCFilterMemcmp) MarshalJSON() ([]byte, error) {
+	obj := struct {
+		Offset   uint64              `json:"offset"`
+		Bytes    string              `json:"bytes"`
+		Encoding solana.EncodingType `json:"encoding,omitempty"`
+	}{
+		Offset:   m.Offset,
+		Encoding: m.Encoding,
+	}
+	switch m.Encoding {
+	case "", solana.EncodingBase58:
+		obj.Bytes = base58.Encode(m.Bytes)
+	case solana.EncodingBase64:
+		obj.Bytes = base64.StdEncoding.EncodeToString(m.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported memcmp encoding %q", m.Encoding)
+	}
+	return json.Marshal(obj)
+}
+
+func (m *RPCFilterMemcmp) UnmarshalJSON(data []byte) (err error) {
+	var obj struct {
+		Offset   uint64              `json:"offset"`
+		Bytes    string              `json:"bytes"`
+		Encoding solana.EncodingType `json:"encoding"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	m.Offset = obj.Offset
+	m.Encoding = obj.Encoding
+	switch obj.Encoding {
+	case "", solana.EncodingBase58:
+		m.Bytes, err = base58.Decode(obj.Bytes)
+	case solana.EncodingBase64:
+		m.Bytes, err = base64.StdEncoding.DecodeString(obj.Bytes)
+	default:
+		err = fmt.Errorf("unsupported memcmp encoding %q", obj.Encoding)
+	}
+	return err
 }
 
 type CommitmentType string
@@ -475,6 +612,9 @@ type ParsedTransactionMeta struct {
 	// Array of string log messages or omitted if log message
 	// recording was not yet enabled during this transaction
 	LogMessages []string `json:"logMessages"`
+
+	// Addresses loaded from the address lookup tables of a versioned transaction.
+	LoadedAddresses LoadedAddresses `json:"loadedAddresses"`
 }
 
 type ParsedInnerInstruction struct {
@@ -486,12 +626,16 @@ type ParsedMessageAccount struct {
 	PublicKey solana.PublicKey `json:"pubkey"`
 	Signer    bool             `json:"signer"`
 	Writable  bool             `json:"writable"`
+	// "transaction" for the static keys of the message,
+	// "lookupTable" for the addresses loaded from the address lookup tables.
+	Source string `json:"source,omitempty"`
 }
 
 type ParsedMessage struct {
-	AccountKeys     []ParsedMessageAccount `json:"accountKeys"`
-	Instructions    []*ParsedInstruction   `json:"instructions"`
-	RecentBlockHash string                 `json:"recentBlockhash"`
+	AccountKeys         []ParsedMessageAccount                `json:"accountKeys"`
+	Instructions        []*ParsedInstruction                  `json:"instructions"`
+	RecentBlockHash     string                                `json:"recentBlockhash"`
+	AddressTableLookups solana.MessageAddressTableLookupSlice `json:"addressTableLookups,omitempty"`
 }
 
 type ParsedInstruction struct {
@@ -547,4 +691,41 @@ func (opts *TransactionOpts) ToMap() M {
 	return obj
 }
 
+// ContextOpts are the options of the methods whose config object
+// only accepts the commitment and the minimum context slot,
+// or only the commitment.
+type ContextOpts struct {
+	// Commitment requirement.
+	// This parameter is optional.
+	Commitment CommitmentType
+
+	// The minimum slot that the request can be evaluated at;
+	// use it to read your own writes when switching between RPC nodes.
+	// This parameter is optional.
+	MinContextSlot *uint64
+}
+
+// ToMap encodes the options as the config object of the request.
+func (opts *ContextOpts) ToMap() M {
+	obj := M{}
+	if opts.Commitment != "" {
+		obj["commitment"] = opts.Commitment
+	}
+	if opts.MinContextSlot != nil {
+		obj["minContextSlot"] = *opts.MinContextSlot
+	}
+	return obj
+}
+
+// appendTo appends the config object to the params, if not empty.
+func (opts *ContextOpts) appendTo(params []interface{}) []interface{} {
+	if opts == nil {
+		return params
+	}
+	if obj := opts.ToMap(); len(obj) > 0 {
+		params = append(params, obj)
+	}
+	return params
+}
+
 type M map[string]interface{}
@@ -0,0 +1,157 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+const modulePath = "github.com/gagliardetto/solana-go"
+
+var defaultUA = defaultUserAgent("")
+
+// moduleVersion returns the version of this module
+// in the build, "devel" if unknown.
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	if info.Main.Path == modulePath && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != modulePath {
+			continue
+		}
+		if dep.Replace != nil && dep.Replace.Version != "" {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "devel"
+}
+
+func defaultUserAgent(app string) string {
+	ua := "solana-go/" + moduleVersion() + " (" + runtime.Version() + "; " + runtime.GOOS + "/" + runtime.GOARCH + ")"
+	if app != "" {
+		ua = app + " " + ua
+	}
+	return ua
+}
+
+// UserAgent returns the default User-Agent header identifying the clients
+// created by this package, and the WebSocket clients (see ws.Connect),
+// e.g. "solana-go/v1.8.0 (go1.21.0; linux/amd64)".
+func UserAgent() string {
+	return defaultUA
+}
+
+// AppUserAgent returns the default User-Agent prefixed with the name
+// (and version, optional) of the application,
+// e.g. "indexer/1.2 solana-go/v1.8.0 (go1.21.0; linux/amd64)".
+func AppUserAgent(name, version string) string {
+	if version != "" {
+		name += "/" + version
+	}
+	return defaultUserAgent(name)
+}
+
+// SetAppName prefixes the User-Agent of the client with the name (and version,
+// optional) of the application (see AppUserAgent), so that the providers,
+// and whoever debugs a shared endpoint, can tell its requests apart.
+// It must be called before the client is used.
+func (cl *Client) SetAppName(name, version string) *Client {
+	return cl.SetUserAgent(AppUserAgent(name, version))
+}
+
+// SetUserAgent replaces the User-Agent of the client;
+// an empty one disables it, leaving the default of net/http.
+// The User-Agent set with the headers of the client (e.g. with NewWithHeaders)
+// or a context (see WithHeaders) takes precedence.
+// It only applies to the HTTP clients created by this package.
+// It must be called before the client is used.
+func (cl *Client) SetUserAgent(ua string) *Client {
+	if identified, ok := cl.rpcClient.(*userAgentClient); ok {
+		cl.rpcClient = identified.rpcClient
+	}
+	cl.rpcClient = &userAgentClient{
+		rpcClient: cl.rpcClient,
+		userAgent: ua,
+	}
+	return cl
+}
+
+type userAgentKey struct{}
+
+// userAgentClient passes the User-Agent of the client
+// to the transport with the context of the requests.
+type userAgentClient struct {
+	rpcClient JSONRPCClient
+	userAgent string
+}
+
+func (uc *userAgentClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	return uc.rpcClient.CallForInto(context.WithValue(ctx, userAgentKey{}, uc.userAgent), out, method, params)
+}
+
+func (uc *userAgentClient) CallWithCallback(
+	ctx context.Context,
+	method string,
+	params []interface{},
+	callback func(*http.Request, *http.Response) error,
+) error {
+	return uc.rpcClient.CallWithCallback(context.WithValue(ctx, userAgentKey{}, uc.userAgent), method, params, callback)
+}
+
+func (uc *userAgentClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	batchClient, ok := uc.rpcClient.(BatchJSONRPCClient)
+	if !ok {
+		return nil, ErrBatchNotSupported
+	}
+	return batchClient.CallBatch(context.WithValue(ctx, userAgentKey{}, uc.userAgent), requests)
+}
+
+func (uc *userAgentClient) Close() error {
+	if c, ok := uc.rpcClient.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// userAgentTransport sets the User-Agent of the requests not having one:
+// the one of the client (see Client.SetUserAgent), or the default one.
+type userAgentTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ua, ok := req.Context().Value(userAgentKey{}).(string)
+	if !ok {
+		ua = defaultUA
+	}
+	if ua == "" || req.Header.Get("User-Agent") != "" {
+		return t.transport.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", ua)
+	return t.transport.RoundTrip(req)
+}
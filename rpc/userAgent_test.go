@@ -0,0 +1,62 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAgent(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received = append(received, req.Header.Get("User-Agent"))
+		rw.Write([]byte(`{"jsonrpc":"2.0","result":42,"id":0}`))
+	}))
+	defer server.Close()
+	client := New(server.URL)
+
+	require.True(t, strings.HasPrefix(UserAgent(), "solana-go/"), UserAgent())
+	_, err := client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+
+	// Set on another client only.
+	indexer := New(server.URL).SetAppName("indexer", "1.2")
+	require.True(t, strings.HasPrefix(AppUserAgent("indexer", "1.2"), "indexer/1.2 solana-go/"), AppUserAgent("indexer", "1.2"))
+	_, err = indexer.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	_, err = client.GetSlot(context.Background(), "")
+	require.NoError(t, err)
+	_, err = indexer.GetSlot(WithHeaders(context.Background(), http.Header{"User-Agent": {"custom"}}), "")
+	require.NoError(t, err)
+	_, err = NewWithHeaders(server.URL, map[string]string{"User-Agent": "headers"}).SetAppName("indexer", "").GetSlot(context.Background(), "")
+	require.NoError(t, err)
+
+	_, err = New(server.URL).SetUserAgent("").GetSlot(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Len(t, received, 6)
+	require.Equal(t, UserAgent(), received[0])
+	require.Equal(t, AppUserAgent("indexer", "1.2"), received[1])
+	require.Equal(t, UserAgent(), received[2])
+	require.Equal(t, "custom", received[3])
+	require.Equal(t, "headers", received[4])
+	require.True(t, strings.HasPrefix(received[5], "Go-http-client/"), received[5])
+}
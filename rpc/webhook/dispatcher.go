@@ -0,0 +1,193 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/txevents"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+// Sender delivers messages to a webhook endpoint.
+// It implements txevents.Publisher.
+type Sender struct {
+	url        string
+	secret     []byte
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+var _ txevents.Publisher = (*Sender)(nil)
+
+// NewSender creates a sender posting to url, signing with secret.
+// If httpClient is nil, a client with a 10 seconds timeout is used.
+func NewSender(url string, secret []byte, httpClient *http.Client) *Sender {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Sender{
+		url:        url,
+		secret:     secret,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+// Publish posts a message; it fails unless the endpoint answers with a 2xx status.
+func (sender *Sender) Publish(ctx context.Context, msg *txevents.Message) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sender.url, bytes.NewReader(msg.Data))
+	if err != nil {
+		return err
+	}
+	timestamp := sender.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, msg.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(sender.secret, timestamp, msg.Data))
+
+	resp, err := sender.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type DispatcherOpts struct {
+	// HTTP client used for the deliveries.
+	HTTPClient *http.Client
+	// Delay before the first retry of a failed delivery; it doubles
+	// on each retry up to MaxBackoff.
+	// Defaults to 1 second.
+	MinBackoff time.Duration
+	// Defaults to 5 minutes.
+	MaxBackoff time.Duration
+	// OnError is called when a delivery fails, before it is retried.
+	OnError func(event *Event, err error)
+}
+
+// Dispatcher queues events and delivers them to a webhook endpoint,
+// retrying each delivery until it succeeds. Events with the same key
+// are delivered one at a time, in the order they were dispatched.
+type Dispatcher struct {
+	forwarder *txevents.Forwarder
+}
+
+// NewDispatcher creates a dispatcher delivering to url, signing with secret.
+func NewDispatcher(url string, secret []byte, opts *DispatcherOpts) *Dispatcher {
+	if opts == nil {
+		opts = &DispatcherOpts{}
+	}
+	minBackoff := opts.MinBackoff
+	if minBackoff <= 0 {
+		minBackoff = time.Second
+	}
+	maxBackoff := opts.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 5 * time.Minute
+	}
+	forwarderOpts := &txevents.ForwarderOpts{
+		MinBackoff: minBackoff,
+		MaxBackoff: maxBackoff,
+	}
+	if onError := opts.OnError; onError != nil {
+		forwarderOpts.OnError = func(msg *txevents.Message, err error) {
+			var event Event
+			json.Unmarshal(msg.Data, &event)
+			onError(&event, err)
+		}
+	}
+	return &Dispatcher{
+		forwarder: txevents.NewForwarder(NewSender(url, secret, opts.HTTPClient), forwarderOpts),
+	}
+}
+
+// Dispatch queues an event for delivery.
+func (dispatcher *Dispatcher) Dispatch(event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	dispatcher.forwarder.Enqueue(&txevents.Message{
+		Key:  event.Key,
+		ID:   event.ID,
+		Data: data,
+	})
+	return nil
+}
+
+// HandleTransaction dispatches a transaction status change;
+// it can be used as the Handler of a txevents.Tracker.
+func (dispatcher *Dispatcher) HandleTransaction(event *txevents.Event) {
+	dispatcher.Dispatch(NewTransactionEvent(event))
+}
+
+// ForwardAccount dispatches the notifications of an account subscription
+// until the subscription fails or is unsubscribed.
+func (dispatcher *Dispatcher) ForwardAccount(pubkey solana.PublicKey, sub *ws.AccountSubscription) error {
+	for {
+		result, err := sub.Recv()
+		if err != nil {
+			return err
+		}
+		if err := dispatcher.Dispatch(NewAccountEvent(pubkey, result)); err != nil {
+			return err
+		}
+	}
+}
+
+// ForwardLogs dispatches the notifications of a logs subscription
+// until the subscription fails or is unsubscribed.
+func (dispatcher *Dispatcher) ForwardLogs(sub *ws.LogSubscription) error {
+	for {
+		result, err := sub.Recv()
+		if err != nil {
+			return err
+		}
+		if err := dispatcher.Dispatch(NewLogsEvent(result)); err != nil {
+			return err
+		}
+	}
+}
+
+// Pending returns the number of events not yet delivered.
+func (dispatcher *Dispatcher) Pending() int {
+	return dispatcher.forwarder.Pending()
+}
+
+// Flush waits until all the dispatched events are delivered.
+func (dispatcher *Dispatcher) Flush(ctx context.Context) error {
+	return dispatcher.forwarder.Flush(ctx)
+}
+
+// Close stops the dispatcher; events not yet delivered are dropped.
+// Call Flush first to deliver them.
+func (dispatcher *Dispatcher) Close() {
+	dispatcher.forwarder.Close()
+}
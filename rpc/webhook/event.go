@@ -0,0 +1,135 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook delivers subscription events (account changes, matched logs,
+// confirmed deposits, transaction statuses) to HTTP endpoints, so that systems
+// without any Solana knowledge can consume them.
+//
+// Deliveries are JSON POST requests signed with HMAC-SHA256; they are retried
+// until the endpoint answers with a 2xx status, and the events sharing the
+// same key (the account, or the transaction signature) are delivered in order.
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/txevents"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+type EventType string
+
+const (
+	EventTypeAccount     EventType = "account"
+	EventTypeLogs        EventType = "logs"
+	EventTypeDeposit     EventType = "deposit"
+	EventTypeTransaction EventType = "transaction"
+)
+
+// Event is the body of a webhook delivery.
+type Event struct {
+	// ID uniquely identifies the event; receivers should use it
+	// to discard duplicated deliveries.
+	ID   string    `json:"id"`
+	Type EventType `json:"type"`
+	// Key orders the deliveries: events with the same key are delivered in order.
+	Key  string      `json:"key"`
+	Slot uint64      `json:"slot"`
+	Data interface{} `json:"data"`
+}
+
+// AccountData is the data of an account event.
+type AccountData struct {
+	Pubkey  solana.PublicKey `json:"pubkey"`
+	Account *rpc.Account     `json:"account"`
+}
+
+// NewAccountEvent creates an event from an account subscription notification.
+func NewAccountEvent(pubkey solana.PublicKey, result *ws.AccountResult) *Event {
+	key := pubkey.String()
+	account := result.Value.Account
+	return &Event{
+		ID:   fmt.Sprintf("%s:%s:%d", EventTypeAccount, key, result.Context.Slot),
+		Type: EventTypeAccount,
+		Key:  key,
+		Slot: result.Context.Slot,
+		Data: &AccountData{
+			Pubkey:  pubkey,
+			Account: &account,
+		},
+	}
+}
+
+// LogsData is the data of a logs event.
+type LogsData struct {
+	Signature solana.Signature `json:"signature"`
+	Err       interface{}      `json:"err"`
+	Logs      []string         `json:"logs"`
+}
+
+// NewLogsEvent creates an event from a logs subscription notification.
+func NewLogsEvent(result *ws.LogResult) *Event {
+	key := result.Value.Signature.String()
+	return &Event{
+		ID:   fmt.Sprintf("%s:%s", EventTypeLogs, key),
+		Type: EventTypeLogs,
+		Key:  key,
+		Slot: result.Context.Slot,
+		Data: &LogsData{
+			Signature: result.Value.Signature,
+			Err:       result.Value.Err,
+			Logs:      result.Value.Logs,
+		},
+	}
+}
+
+// Deposit is a confirmed transfer to a watched account.
+type Deposit struct {
+	Signature solana.Signature `json:"signature"`
+	Slot      uint64           `json:"slot"`
+	// The account credited.
+	Account solana.PublicKey `json:"account"`
+	// The token mint; nil for SOL deposits.
+	Mint *solana.PublicKey `json:"mint,omitempty"`
+	// The amount credited, in lamports or in base token units.
+	Amount uint64 `json:"amount"`
+}
+
+// NewDepositEvent creates an event from a confirmed deposit.
+// Deposits are keyed by the credited account.
+func NewDepositEvent(deposit *Deposit) *Event {
+	key := deposit.Account.String()
+	return &Event{
+		ID:   fmt.Sprintf("%s:%s:%s", EventTypeDeposit, deposit.Signature, key),
+		Type: EventTypeDeposit,
+		Key:  key,
+		Slot: deposit.Slot,
+		Data: deposit,
+	}
+}
+
+// NewTransactionEvent creates an event from a status change
+// emitted by a txevents.Tracker.
+func NewTransactionEvent(event *txevents.Event) *Event {
+	key := event.Signature.String()
+	return &Event{
+		ID:   fmt.Sprintf("%s:%s:%d", EventTypeTransaction, key, event.Sequence),
+		Type: EventTypeTransaction,
+		Key:  key,
+		Slot: event.Slot,
+		Data: event,
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderID carries the ID of the event.
+	HeaderID = "Webhook-Id"
+	// HeaderTimestamp carries the unix time of the delivery attempt, in seconds.
+	HeaderTimestamp = "Webhook-Timestamp"
+	// HeaderSignature carries the signature of the delivery, as "sha256=<hex>".
+	HeaderSignature = "Webhook-Signature"
+)
+
+const signaturePrefix = "sha256="
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrExpiredTimestamp = errors.New("webhook timestamp outside of tolerance")
+)
+
+// Sign returns the signature of a delivery: the hex-encoded
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the secret.
+func Sign(secret []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a delivery in constant time.
+func Verify(secret []byte, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// VerifyRequest reads the body of a webhook request and checks its signature.
+// The timestamp of the request must be within tolerance of the current time,
+// so that a captured request cannot be replayed later; a zero tolerance
+// disables the check.
+func VerifyRequest(r *http.Request, secret []byte, tolerance time.Duration) ([]byte, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	if tolerance > 0 {
+		age := time.Since(time.Unix(timestamp, 0))
+		if age > tolerance || age < -tolerance {
+			return nil, ErrExpiredTimestamp
+		}
+	}
+	if !strings.HasPrefix(r.Header.Get(HeaderSignature), signaturePrefix) ||
+		!Verify(secret, timestamp, body, r.Header.Get(HeaderSignature)) {
+		return nil, ErrInvalidSignature
+	}
+	return body, nil
+}
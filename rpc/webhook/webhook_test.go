@@ -0,0 +1,122 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/txevents"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"id":"1"}`)
+	signature := Sign(secret, 1700000000, body)
+	require.Equal(t, "sha256=", signature[:7])
+	require.Len(t, signature, 7+64)
+	require.True(t, Verify(secret, 1700000000, body, signature))
+	require.False(t, Verify(secret, 1700000001, body, signature))
+	require.False(t, Verify([]byte("other"), 1700000000, body, signature))
+	require.False(t, Verify(secret, 1700000000, []byte(`{"id":"2"}`), signature))
+}
+
+func TestDispatcher(t *testing.T) {
+	secret := []byte("secret")
+	var mu sync.Mutex
+	var received []*Event
+	failures := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := VerifyRequest(r, secret, time.Minute)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var event Event
+		require.NoError(t, json.Unmarshal(body, &event))
+		require.Equal(t, event.ID, r.Header.Get(HeaderID))
+
+		mu.Lock()
+		defer mu.Unlock()
+		if event.Type == EventTypeDeposit && failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received = append(received, &event)
+	}))
+	defer server.Close()
+
+	var errs int
+	dispatcher := NewDispatcher(server.URL, secret, &DispatcherOpts{
+		MinBackoff: time.Millisecond,
+		OnError: func(event *Event, err error) {
+			require.Equal(t, EventTypeDeposit, event.Type)
+			errs++
+		},
+	})
+	defer dispatcher.Close()
+
+	account := solana.PublicKey{1}
+	sig := solana.Signature{2}
+	require.NoError(t, dispatcher.Dispatch(NewDepositEvent(&Deposit{Signature: sig, Slot: 10, Account: account, Amount: 500})))
+	require.NoError(t, dispatcher.Dispatch(&Event{ID: "next", Type: EventTypeAccount, Key: account.String(), Slot: 11}))
+	dispatcher.HandleTransaction(&txevents.Event{Signature: sig, Sequence: 1, Slot: 10, Status: rpc.ConfirmationStatusConfirmed})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, dispatcher.Flush(ctx))
+	require.Equal(t, 2, errs)
+	require.Len(t, received, 3)
+
+	// The events of the account are delivered in order, despite the failures.
+	var accountEvents []string
+	for _, event := range received {
+		if event.Key == account.String() {
+			accountEvents = append(accountEvents, event.ID)
+		}
+	}
+	require.Equal(t, []string{"deposit:" + sig.String() + ":" + account.String(), "next"}, accountEvents)
+}
+
+func TestVerifyRequest(t *testing.T) {
+	secret := []byte("secret")
+	newRequest := func(timestamp int64, body string, signature string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(HeaderSignature, signature)
+		return req
+	}
+	now := time.Now().Unix()
+	_, err := VerifyRequest(newRequest(now, "{}", Sign(secret, now, []byte("{}"))), secret, time.Minute)
+	require.NoError(t, err)
+	_, err = VerifyRequest(newRequest(now, "{}", Sign(secret, now, []byte("[]"))), secret, time.Minute)
+	require.Equal(t, ErrInvalidSignature, err)
+	old := now - 3600
+	_, err = VerifyRequest(newRequest(old, "{}", Sign(secret, old, []byte("{}"))), secret, time.Minute)
+	require.Equal(t, ErrExpiredTimestamp, err)
+	_, err = VerifyRequest(newRequest(old, "{}", Sign(secret, old, []byte("{}"))), secret, 0)
+	require.NoError(t, err)
+}
@@ -86,6 +86,7 @@ type AccountSubscription struct {
 func (sw *AccountSubscription) Recv() (*AccountResult, error) {
 	select {
 	case d := <-sw.sub.stream:
+		sw.sub.delivered()
 		return d.(*AccountResult), nil
 	case err := <-sw.sub.err:
 		return nil, err
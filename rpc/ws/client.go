@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/buger/jsonparser"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/gorilla/rpc/v2/json2"
 	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
@@ -35,12 +36,19 @@ import (
 type result interface{}
 
 type Client struct {
+	// The highest slot of the notifications (see LatestSlot);
+	// first for the alignment of its atomic operations.
+	latestSlot uint64
+
 	rpcURL                  string
 	conn                    *websocket.Conn
 	lock                    sync.RWMutex
 	subscriptionByRequestID map[uint64]*Subscription
 	subscriptionByWSSubID   map[uint64]*Subscription
 	reconnectOnErr          bool
+	tracer                  rpc.Tracer
+	logger                  rpc.Logger
+	loggerOpts              *rpc.LoggerOpts
 }
 
 const (
@@ -61,6 +69,8 @@ func Connect(ctx context.Context, rpcEndpoint string) (c *Client, err error) {
 // endpoint with a http header if available The http header can be helpful to
 // pass basic authentication params as prescribed
 // ref https://github.com/gorilla/websocket/issues/209
+// The headers held by ctx (see rpc.WithHeaders) are also sent,
+// and the User-Agent of the rpc package (see rpc.UserAgent) if none is set.
 func ConnectWithOptions(ctx context.Context, rpcEndpoint string, opt *Options) (c *Client, err error) {
 	c = &Client{
 		rpcURL:                  rpcEndpoint,
@@ -78,6 +88,27 @@ func ConnectWithOptions(ctx context.Context, rpcEndpoint string, opt *Options) (
 	if opt != nil && opt.HttpHeader != nil && len(opt.HttpHeader) > 0 {
 		httpHeader = opt.HttpHeader
 	}
+	if headers := rpc.HeadersFromContext(ctx); headers != nil {
+		// Added to (and overriding) the headers of the options.
+		for key, values := range httpHeader {
+			if _, ok := headers[http.CanonicalHeaderKey(key)]; !ok {
+				headers[key] = values
+			}
+		}
+		httpHeader = headers
+	}
+	if ua := rpc.UserAgent(); ua != "" && httpHeader.Get("User-Agent") == "" {
+		httpHeader = httpHeader.Clone()
+		if httpHeader == nil {
+			httpHeader = make(http.Header)
+		}
+		httpHeader.Set("User-Agent", ua)
+	}
+	if opt != nil {
+		c.tracer = opt.Tracer
+		c.logger = opt.Logger
+		c.loggerOpts = opt.LoggerOpts
+	}
 	c.conn, _, err = dialer.DialContext(ctx, rpcEndpoint, httpHeader)
 	if err != nil {
 		return nil, fmt.Errorf("new ws client: dial: %w", err)
@@ -118,6 +149,7 @@ func (c *Client) receiveMessages() {
 	for {
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
+			c.log(rpc.LogEventError, "", nil, err)
 			c.closeAllSubscription(err)
 			return
 		}
@@ -153,7 +185,7 @@ func (c *Client) handleMessage(message []byte) {
 	requestID, ok := getUint64WithOk(message, "id")
 	if ok {
 		subID, _ := getUint64WithOk(message, "result")
-		c.handleNewSubscriptionMessage(requestID, subID)
+		c.handleNewSubscriptionMessage(requestID, subID, message)
 		return
 	}
 
@@ -161,7 +193,7 @@ func (c *Client) handleMessage(message []byte) {
 	c.handleSubscriptionMessage(subID, message)
 }
 
-func (c *Client) handleNewSubscriptionMessage(requestID, subID uint64) {
+func (c *Client) handleNewSubscriptionMessage(requestID, subID uint64, message []byte) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -182,6 +214,7 @@ func (c *Client) handleNewSubscriptionMessage(requestID, subID uint64) {
 	}
 	callBack.subID = subID
 	c.subscriptionByWSSubID[subID] = callBack
+	c.log(rpc.LogEventResponse, callBack.req.Method, message, nil)
 
 	zlog.Debug("registered ws subscription",
 		zap.Uint64("subscription_id", subID),
@@ -207,7 +240,22 @@ func (c *Client) handleSubscriptionMessage(subID uint64, message []byte) {
 	}
 
 	// Decode the message using the subscription-provided decoderFunc.
+	var span rpc.Span
+	if c.tracer != nil {
+		_, span = c.startNotificationSpan(subID, message)
+	}
 	result, err := sub.decoderFunc(message)
+	if span != nil {
+		span.End(err)
+	}
+	if c.logger != nil {
+		method, _ := jsonparser.GetString(message, "method")
+		if err != nil {
+			c.log(rpc.LogEventError, method, message, err)
+		} else {
+			c.log(rpc.LogEventResponse, method, message, nil)
+		}
+	}
 	if err != nil {
 		fmt.Println("*****************************")
 		c.closeSubscription(sub.req.ID, fmt.Errorf("unable to decode client response: %w", err))
@@ -224,6 +272,9 @@ func (c *Client) handleSubscriptionMessage(subID uint64, message []byte) {
 		return
 	}
 
+	slot := notificationSlot(message)
+	c.observeSlot(slot)
+	sub.received(slot, time.Now())
 	sub.stream <- result
 	return
 }
@@ -268,6 +319,7 @@ func (c *Client) unsubscribe(subID uint64, method string) error {
 	if err != nil {
 		return fmt.Errorf("unable to encode unsubscription message for subID %d and method %s", subID, method)
 	}
+	c.log(rpc.LogEventRequest, method, data, nil)
 
 	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 	err = c.conn.WriteMessage(websocket.TextMessage, data)
@@ -292,6 +344,7 @@ func (c *Client) subscribe(
 	if err != nil {
 		return nil, fmt.Errorf("subscribe: unable to encode subsciption request: %w", err)
 	}
+	c.log(rpc.LogEventRequest, subscriptionMethod, data, nil)
 
 	sub := newSubscription(
 		req,
@@ -301,13 +354,23 @@ func (c *Client) subscribe(
 		unsubscribeMethod,
 		decoderFunc,
 	)
+	sub.client = c
 
 	c.subscriptionByRequestID[req.ID] = sub
 	zlog.Info("added new subscription to websocket client", zap.Int("count", len(c.subscriptionByRequestID)))
 
 	zlog.Debug("writing data to conn", zap.String("data", string(data)))
+	var span rpc.Span
+	if c.tracer != nil {
+		_, span = c.tracer.Start(context.Background(), subscriptionMethod,
+			c.spanAttributes(subscriptionMethod, rpc.RequestAttributes(append(params[:len(params):len(params)], conf))...)...,
+		)
+	}
 	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 	err = c.conn.WriteMessage(websocket.TextMessage, data)
+	if span != nil {
+		span.End(err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to write request: %w", err)
 	}
@@ -362,3 +425,44 @@ func decodeResponseFromMessage(r []byte, reply interface{}) (err error) {
 
 	return json.Unmarshal(*c.Params.Result, &reply)
 }
+
+func (c *Client) spanAttributes(method string, attributes ...rpc.Attribute) []rpc.Attribute {
+	attributes = append([]rpc.Attribute{
+		{Key: rpc.AttributeRPCSystem, Value: "jsonrpc"},
+		{Key: rpc.AttributeRPCMethod, Value: method},
+	}, attributes...)
+	if address := rpc.ServerAddress(c.rpcURL); address != "" {
+		attributes = append(attributes, rpc.Attribute{Key: rpc.AttributeServerAddress, Value: address})
+	}
+	return attributes
+}
+
+// startNotificationSpan starts the span of a notification, named after its
+// method, with the slot of its context (or of its result, e.g. for slotNotification).
+func (c *Client) startNotificationSpan(subID uint64, message []byte) (context.Context, rpc.Span) {
+	method, _ := jsonparser.GetString(message, "method")
+	attributes := []rpc.Attribute{{Key: rpc.AttributeSubscriptionID, Value: subID}}
+	if slot, ok := getUint64WithOk(message, "params", "result", "context", "slot"); ok {
+		attributes = append(attributes, rpc.Attribute{Key: rpc.AttributeContextSlot, Value: slot})
+	} else if slot, ok := getUint64WithOk(message, "params", "result", "slot"); ok {
+		attributes = append(attributes, rpc.Attribute{Key: rpc.AttributeSlot, Value: slot})
+	}
+	return c.tracer.Start(context.Background(), method, c.spanAttributes(method, attributes...)...)
+}
+
+// log passes the event to the logger, if any.
+func (c *Client) log(event rpc.LogEvent, method string, payload []byte, err error) {
+	if c.logger == nil {
+		return
+	}
+	entry := &rpc.LogEntry{
+		Event:    event,
+		Method:   method,
+		Endpoint: rpc.ServerAddress(c.rpcURL),
+		Err:      err,
+	}
+	if payload != nil {
+		entry.Payload, entry.Truncated = c.loggerOpts.Truncate(payload)
+	}
+	c.logger.Log(entry)
+}
@@ -22,12 +22,16 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/gagliardetto/solana-go/text"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -156,3 +160,177 @@ func Test_SlotSubscribe(t *testing.T) {
 	fmt.Println("data received: ", data.Parent)
 	return
 }
+
+type recordingTracer struct {
+	names      []string
+	attributes []map[string]interface{}
+	errs       []error
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, attributes ...rpc.Attribute) (context.Context, rpc.Span) {
+	attrs := map[string]interface{}{}
+	for _, attr := range attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	t.names = append(t.names, name)
+	t.attributes = append(t.attributes, attrs)
+	t.errs = append(t.errs, nil)
+	return ctx, &recordedSpan{tracer: t, index: len(t.names) - 1}
+}
+
+type recordedSpan struct {
+	tracer *recordingTracer
+	index  int
+}
+
+func (s *recordedSpan) SetAttributes(attributes ...rpc.Attribute) {
+	for _, attr := range attributes {
+		s.tracer.attributes[s.index][attr.Key] = attr.Value
+	}
+}
+
+func (s *recordedSpan) End(err error) {
+	s.tracer.errs[s.index] = err
+}
+
+func Test_NotificationSpan(t *testing.T) {
+	tracer := &recordingTracer{}
+	c := &Client{
+		rpcURL:                  "wss://api.mainnet-beta.solana.com/?api-key=secret",
+		subscriptionByRequestID: map[uint64]*Subscription{},
+		subscriptionByWSSubID:   map[uint64]*Subscription{},
+		tracer:                  tracer,
+	}
+	sub := newSubscription(&request{ID: 1}, nil, "accountUnsubscribe", func(msg []byte) (interface{}, error) {
+		var res AccountResult
+		err := decodeResponseFromMessage(msg, &res)
+		return &res, err
+	})
+	c.subscriptionByRequestID[1] = sub
+	c.subscriptionByWSSubID[23784] = sub
+
+	c.handleSubscriptionMessage(23784, []byte(`{"jsonrpc":"2.0","method":"accountNotification","params":{"result":{"context":{"slot":5199307},"value":{"data":["","base64"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":635}},"subscription":23784}}`))
+
+	require.Equal(t, []string{"accountNotification"}, tracer.names)
+	require.NoError(t, tracer.errs[0])
+	require.Equal(t, map[string]interface{}{
+		rpc.AttributeRPCSystem:      "jsonrpc",
+		rpc.AttributeRPCMethod:      "accountNotification",
+		rpc.AttributeServerAddress:  "api.mainnet-beta.solana.com",
+		rpc.AttributeSubscriptionID: uint64(23784),
+		rpc.AttributeContextSlot:    uint64(5199307),
+	}, tracer.attributes[0])
+	require.Len(t, sub.stream, 1)
+}
+
+func Test_NotificationLog(t *testing.T) {
+	var entries []*rpc.LogEntry
+	c := &Client{
+		rpcURL:                  "wss://api.mainnet-beta.solana.com/?api-key=secret",
+		subscriptionByRequestID: map[uint64]*Subscription{},
+		subscriptionByWSSubID:   map[uint64]*Subscription{},
+		logger: rpc.LoggerFunc(func(entry *rpc.LogEntry) {
+			entries = append(entries, entry)
+		}),
+		loggerOpts: &rpc.LoggerOpts{MaxPayloadSize: 20},
+	}
+	sub := newSubscription(&request{ID: 1, Method: "slotSubscribe"}, nil, "slotUnsubscribe", func(msg []byte) (interface{}, error) {
+		var res SlotResult
+		err := decodeResponseFromMessage(msg, &res)
+		return &res, err
+	})
+	c.subscriptionByRequestID[1] = sub
+
+	c.handleMessage([]byte(`{"jsonrpc":"2.0","result":7,"id":1}`))
+	message := []byte(`{"jsonrpc":"2.0","method":"slotNotification","params":{"result":{"parent":75,"root":44,"slot":76},"subscription":7}}`)
+	c.handleMessage(message)
+
+	require.Len(t, entries, 2)
+	require.Equal(t, rpc.LogEventResponse, entries[0].Event)
+	require.Equal(t, "slotSubscribe", entries[0].Method)
+	require.Equal(t, "api.mainnet-beta.solana.com", entries[0].Endpoint)
+	require.Equal(t, rpc.LogEventResponse, entries[1].Event)
+	require.Equal(t, "slotNotification", entries[1].Method)
+	require.Equal(t, message[:20], entries[1].Payload)
+	require.True(t, entries[1].Truncated)
+	require.Len(t, sub.stream, 1)
+}
+
+func Test_ConnectWithContextHeaders(t *testing.T) {
+	received := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received <- req.Header.Clone()
+		conn, err := (&websocket.Upgrader{}).Upgrade(rw, req, nil)
+		if err != nil {
+			return
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	ctx := rpc.WithHeaders(context.Background(), http.Header{
+		"X-Request-Id":  {"id"},
+		"Authorization": {"Bearer token"},
+	})
+	c, err := ConnectWithOptions(ctx, "ws"+strings.TrimPrefix(server.URL, "http"), &Options{
+		HttpHeader: http.Header{
+			"x-api-key":     {"secret"},
+			"Authorization": {"Bearer default"},
+		},
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	headers := <-received
+	require.Equal(t, "id", headers.Get("X-Request-Id"))
+	require.Equal(t, "secret", headers.Get("X-Api-Key"))
+	require.Equal(t, []string{"Bearer token"}, headers["Authorization"])
+}
+
+func Test_SubscriptionStats(t *testing.T) {
+	c := &Client{
+		subscriptionByRequestID: map[uint64]*Subscription{},
+		subscriptionByWSSubID:   map[uint64]*Subscription{},
+	}
+	newSub := func(id uint64, method string) *Subscription {
+		sub := newSubscription(&request{ID: id, Method: method}, nil, "", func(msg []byte) (interface{}, error) {
+			return msg, nil
+		})
+		sub.client = c
+		c.subscriptionByRequestID[id] = sub
+		return sub
+	}
+	account := newSub(1, "accountSubscribe")
+	slot := newSub(2, "slotSubscribe")
+	c.handleMessage([]byte(`{"jsonrpc":"2.0","result":10,"id":1}`))
+	c.handleMessage([]byte(`{"jsonrpc":"2.0","result":20,"id":2}`))
+
+	c.handleMessage([]byte(`{"jsonrpc":"2.0","method":"accountNotification","params":{"result":{"context":{"slot":100},"value":null},"subscription":10}}`))
+	c.handleMessage([]byte(`{"jsonrpc":"2.0","method":"slotNotification","params":{"result":{"parent":104,"root":70,"slot":105},"subscription":20}}`))
+	require.Equal(t, uint64(105), c.LatestSlot())
+
+	stats := account.Stats()
+	require.Equal(t, "accountSubscribe", stats.Method)
+	require.Equal(t, uint64(10), stats.ID)
+	require.Equal(t, uint64(1), stats.Notifications)
+	require.Equal(t, uint64(100), stats.LastContextSlot)
+	require.Equal(t, uint64(5), stats.SlotLag)
+	require.Equal(t, 1, stats.Pending)
+	require.False(t, stats.LastNotification.IsZero())
+
+	time.Sleep(5 * time.Millisecond)
+	_, err := account.Recv()
+	require.NoError(t, err)
+	stats = account.Stats()
+	require.Equal(t, 0, stats.Pending)
+	require.GreaterOrEqual(t, int64(stats.DeliveryLatency), int64(5*time.Millisecond))
+	require.Equal(t, stats.DeliveryLatency, stats.MaxDeliveryLatency)
+
+	all := c.Stats()
+	require.Len(t, all, 2)
+	require.Equal(t, uint64(10), all[0].ID)
+	require.Equal(t, uint64(20), all[1].ID)
+	require.Equal(t, uint64(105), all[1].LastContextSlot)
+	require.Zero(t, all[1].SlotLag)
+	require.Equal(t, 1, slot.Stats().Pending)
+}
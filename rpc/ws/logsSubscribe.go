@@ -110,6 +110,7 @@ type LogSubscription struct {
 func (sw *LogSubscription) Recv() (*LogResult, error) {
 	select {
 	case d := <-sw.sub.stream:
+		sw.sub.delivered()
 		return d.(*LogResult), nil
 	case err := <-sw.sub.err:
 		return nil, err
@@ -89,6 +89,7 @@ type ProgramSubscription struct {
 func (sw *ProgramSubscription) Recv() (*ProgramResult, error) {
 	select {
 	case d := <-sw.sub.stream:
+		sw.sub.delivered()
 		return d.(*ProgramResult), nil
 	case err := <-sw.sub.err:
 		return nil, err
@@ -45,6 +45,7 @@ type RootSubscription struct {
 func (sw *RootSubscription) Recv() (*RootResult, error) {
 	select {
 	case d := <-sw.sub.stream:
+		sw.sub.delivered()
 		return d.(*RootResult), nil
 	case err := <-sw.sub.err:
 		return nil, err
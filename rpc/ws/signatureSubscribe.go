@@ -70,6 +70,7 @@ type SignatureSubscription struct {
 func (sw *SignatureSubscription) Recv() (*SignatureResult, error) {
 	select {
 	case d := <-sw.sub.stream:
+		sw.sub.delivered()
 		return d.(*SignatureResult), nil
 	case err := <-sw.sub.err:
 		return nil, err
@@ -88,6 +89,7 @@ func (sw *SignatureSubscription) Response() <-chan *SignatureResult {
 		if !ok {
 			return
 		}
+		sw.sub.delivered()
 		ch <- d.(*SignatureResult)
 	}(typedChan)
 	return typedChan
@@ -100,6 +102,7 @@ func (sw *SignatureSubscription) RecvWithTimeout(timeout time.Duration) (*Signat
 	case <-time.After(timeout):
 		return nil, ErrTimeout
 	case d := <-sw.sub.stream:
+		sw.sub.delivered()
 		return d.(*SignatureResult), nil
 	case err := <-sw.sub.err:
 		return nil, err
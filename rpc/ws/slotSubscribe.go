@@ -48,6 +48,7 @@ type SlotSubscription struct {
 func (sw *SlotSubscription) Recv() (*SlotResult, error) {
 	select {
 	case d := <-sw.sub.stream:
+		sw.sub.delivered()
 		return d.(*SlotResult), nil
 	case err := <-sw.sub.err:
 		return nil, err
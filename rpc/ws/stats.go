@@ -0,0 +1,165 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// SubscriptionStats are the lag metrics of a subscription, to detect
+// the subscriptions falling behind before their data goes stale.
+type SubscriptionStats struct {
+	// The subscription method, e.g. "accountSubscribe",
+	// and the subscription id assigned by the node.
+	Method string
+	ID     uint64
+
+	// Number of notifications received.
+	Notifications uint64
+	// Time the last notification was received.
+	LastNotification time.Time
+
+	// Context slot of the last notification (the slot of the
+	// notification for the slot and root subscriptions).
+	LastContextSlot uint64
+	// Number of slots between LastContextSlot and the latest slot
+	// known by the client (see Client.LatestSlot): a growing lag means the
+	// subscription is not notified while the others are.
+	SlotLag uint64
+
+	// Number of notifications received but not consumed yet.
+	Pending int
+	// Time the last consumed notification waited to be consumed (e.g. with
+	// Recv) after it was received, and the maximum of those times.
+	DeliveryLatency    time.Duration
+	MaxDeliveryLatency time.Duration
+}
+
+type subscriptionStats struct {
+	notifications      uint64
+	lastNotification   time.Time
+	lastContextSlot    uint64
+	deliveryLatency    time.Duration
+	maxDeliveryLatency time.Duration
+	// The times the pending notifications were received, in order.
+	received []time.Time
+}
+
+// received records a notification of the context slot, about to be queued.
+func (s *Subscription) received(contextSlot uint64, now time.Time) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	s.stats.notifications++
+	s.stats.lastNotification = now
+	if contextSlot > 0 {
+		s.stats.lastContextSlot = contextSlot
+	}
+	s.stats.received = append(s.stats.received, now)
+}
+
+// delivered records that a queued notification was consumed.
+func (s *Subscription) delivered() {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	if len(s.stats.received) == 0 {
+		return
+	}
+	latency := time.Since(s.stats.received[0])
+	s.stats.received = s.stats.received[1:]
+	s.stats.deliveryLatency = latency
+	if latency > s.stats.maxDeliveryLatency {
+		s.stats.maxDeliveryLatency = latency
+	}
+}
+
+// Stats returns the lag metrics of the subscription.
+func (s *Subscription) Stats() SubscriptionStats {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	stats := SubscriptionStats{
+		Method:             s.req.Method,
+		ID:                 s.subID,
+		Notifications:      s.stats.notifications,
+		LastNotification:   s.stats.lastNotification,
+		LastContextSlot:    s.stats.lastContextSlot,
+		Pending:            len(s.stream),
+		DeliveryLatency:    s.stats.deliveryLatency,
+		MaxDeliveryLatency: s.stats.maxDeliveryLatency,
+	}
+	if s.client != nil && stats.LastContextSlot > 0 {
+		if latest := s.client.LatestSlot(); latest > stats.LastContextSlot {
+			stats.SlotLag = latest - stats.LastContextSlot
+		}
+	}
+	return stats
+}
+
+func (sw *AccountSubscription) Stats() SubscriptionStats      { return sw.sub.Stats() }
+func (sw *BlockSubscription) Stats() SubscriptionStats        { return sw.sub.Stats() }
+func (sw *LogSubscription) Stats() SubscriptionStats          { return sw.sub.Stats() }
+func (sw *ProgramSubscription) Stats() SubscriptionStats      { return sw.sub.Stats() }
+func (sw *RootSubscription) Stats() SubscriptionStats         { return sw.sub.Stats() }
+func (sw *SignatureSubscription) Stats() SubscriptionStats    { return sw.sub.Stats() }
+func (sw *SlotSubscription) Stats() SubscriptionStats         { return sw.sub.Stats() }
+func (sw *SlotsUpdatesSubscription) Stats() SubscriptionStats { return sw.sub.Stats() }
+func (sw *VoteSubscription) Stats() SubscriptionStats         { return sw.sub.Stats() }
+
+// LatestSlot returns the latest slot known by the client: the highest
+// slot of the notifications received by all its subscriptions.
+func (c *Client) LatestSlot() uint64 {
+	return atomic.LoadUint64(&c.latestSlot)
+}
+
+func (c *Client) observeSlot(slot uint64) {
+	for {
+		latest := atomic.LoadUint64(&c.latestSlot)
+		if slot <= latest || atomic.CompareAndSwapUint64(&c.latestSlot, latest, slot) {
+			return
+		}
+	}
+}
+
+// Stats returns the lag metrics of the active subscriptions of the client,
+// by subscription id.
+func (c *Client) Stats() []SubscriptionStats {
+	c.lock.RLock()
+	subs := make([]*Subscription, 0, len(c.subscriptionByWSSubID))
+	for _, sub := range c.subscriptionByWSSubID {
+		subs = append(subs, sub)
+	}
+	c.lock.RUnlock()
+
+	out := make([]SubscriptionStats, len(subs))
+	for i, sub := range subs {
+		out[i] = sub.Stats()
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// notificationSlot returns the context slot of the notification,
+// or the slot it notifies (slot, slotsUpdates and root notifications).
+func notificationSlot(message []byte) uint64 {
+	if slot, ok := getUint64WithOk(message, "params", "result", "context", "slot"); ok {
+		return slot
+	}
+	if slot, ok := getUint64WithOk(message, "params", "result", "slot"); ok {
+		return slot
+	}
+	slot, _ := getUint64WithOk(message, "params", "result")
+	return slot
+}
@@ -17,6 +17,10 @@
 
 package ws
 
+import (
+	"sync"
+)
+
 type Subscription struct {
 	req               *request
 	subID             uint64
@@ -25,6 +29,11 @@ type Subscription struct {
 	closeFunc         func(err error)
 	unsubscribeMethod string
 	decoderFunc       decoderFunc
+	// The client of the subscription, nil in tests.
+	client *Client
+
+	statsMu sync.Mutex
+	stats   subscriptionStats
 }
 
 type decoderFunc func([]byte) (interface{}, error)
@@ -49,6 +58,7 @@ func newSubscription(
 func (s *Subscription) Recv() (interface{}, error) {
 	select {
 	case d := <-s.stream:
+		s.delivered()
 		return d, nil
 	case err := <-s.err:
 		return nil, err
@@ -0,0 +1,113 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws
+
+import (
+	"context"
+	"sync"
+)
+
+// GroupSubscription is implemented by the subscriptions of this package
+// (AccountSubscription, LogSubscription, ...), to be added to a SubscriptionGroup.
+type GroupSubscription interface {
+	Unsubscribe()
+	base() *Subscription
+}
+
+func (s *Subscription) base() *Subscription              { return s }
+func (sw *AccountSubscription) base() *Subscription      { return sw.sub }
+func (sw *BlockSubscription) base() *Subscription        { return sw.sub }
+func (sw *LogSubscription) base() *Subscription          { return sw.sub }
+func (sw *ProgramSubscription) base() *Subscription      { return sw.sub }
+func (sw *RootSubscription) base() *Subscription         { return sw.sub }
+func (sw *SignatureSubscription) base() *Subscription    { return sw.sub }
+func (sw *SlotSubscription) base() *Subscription         { return sw.sub }
+func (sw *SlotsUpdatesSubscription) base() *Subscription { return sw.sub }
+func (sw *VoteSubscription) base() *Subscription         { return sw.sub }
+
+// SubscriptionGroup runs the handlers of several subscriptions, and manages
+// them as a whole, like an errgroup: if a subscription fails (e.g. the
+// connection is lost) or a handler returns an error, all the subscriptions
+// of the group are unsubscribed, and Wait returns the first error.
+type SubscriptionGroup struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	errOnce sync.Once
+	err     error
+}
+
+// NewSubscriptionGroup creates a group, and a context derived from ctx
+// that is canceled when the group stops. Canceling ctx stops the group.
+func NewSubscriptionGroup(ctx context.Context) (*SubscriptionGroup, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &SubscriptionGroup{ctx: ctx, cancel: cancel}, ctx
+}
+
+// Go adds the subscription to the group, and calls handle with each of its
+// notifications, in a dedicated goroutine, until the group stops.
+// The notifications are the values returned by the Recv method of the
+// subscription (e.g. *AccountResult for an AccountSubscription).
+//
+// If the subscription is unsubscribed on its own, it leaves the group
+// without stopping the others.
+func (g *SubscriptionGroup) Go(sub GroupSubscription, handle func(notification interface{}) error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer sub.Unsubscribe()
+		base := sub.base()
+		for {
+			select {
+			case <-g.ctx.Done():
+				return
+			case notification := <-base.stream:
+				base.delivered()
+				if err := handle(notification); err != nil {
+					g.fail(err)
+					return
+				}
+			case err := <-base.err:
+				// A nil error means the subscription was unsubscribed.
+				if err != nil {
+					g.fail(err)
+				}
+				return
+			}
+		}
+	}()
+}
+
+func (g *SubscriptionGroup) fail(err error) {
+	g.errOnce.Do(func() {
+		g.err = err
+		g.cancel()
+	})
+}
+
+// Stop unsubscribes all the subscriptions of the group, and waits for their handlers to return.
+func (g *SubscriptionGroup) Stop() error {
+	g.cancel()
+	return g.Wait()
+}
+
+// Wait waits for all the subscriptions of the group to end, and
+// returns the first error of a subscription or a handler, if any.
+func (g *SubscriptionGroup) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+	return g.err
+}
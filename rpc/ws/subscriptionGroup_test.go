@@ -0,0 +1,96 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSubscription creates a subscription not backed by a connection,
+// counting its unsubscriptions.
+func newTestSubscription(unsubscribed *int32) *Subscription {
+	var sub *Subscription
+	sub = newSubscription(&request{}, func(err error) {
+		atomic.AddInt32(unsubscribed, 1)
+		sub.err <- err
+	}, "", nil)
+	return sub
+}
+
+func TestSubscriptionGroup(t *testing.T) {
+	var unsubscribed int32
+	account := &AccountSubscription{sub: newTestSubscription(&unsubscribed)}
+	slot := &SlotSubscription{sub: newTestSubscription(&unsubscribed)}
+
+	group, ctx := NewSubscriptionGroup(context.Background())
+	received := make(chan interface{}, 10)
+	group.Go(account, func(notification interface{}) error {
+		received <- notification
+		return nil
+	})
+	group.Go(slot, func(notification interface{}) error {
+		received <- notification
+		return nil
+	})
+
+	account.sub.stream <- &AccountResult{}
+	require.IsType(t, &AccountResult{}, <-received)
+	slot.sub.stream <- &SlotResult{Slot: 10}
+	require.Equal(t, &SlotResult{Slot: 10}, <-received)
+
+	// A subscription failing stops the group.
+	failure := errors.New("connection lost")
+	slot.sub.err <- failure
+	require.Equal(t, failure, group.Wait())
+	require.Error(t, ctx.Err())
+	require.Equal(t, int32(2), atomic.LoadInt32(&unsubscribed))
+}
+
+func TestSubscriptionGroup_HandlerError(t *testing.T) {
+	var unsubscribed int32
+	first := newTestSubscription(&unsubscribed)
+	second := newTestSubscription(&unsubscribed)
+
+	group, _ := NewSubscriptionGroup(context.Background())
+	failure := errors.New("failure")
+	group.Go(first, func(interface{}) error { return failure })
+	group.Go(second, func(interface{}) error { return nil })
+
+	first.stream <- 1
+	require.Equal(t, failure, group.Wait())
+	require.Equal(t, int32(2), atomic.LoadInt32(&unsubscribed))
+}
+
+func TestSubscriptionGroup_Stop(t *testing.T) {
+	var unsubscribed int32
+	first := newTestSubscription(&unsubscribed)
+	second := newTestSubscription(&unsubscribed)
+
+	group, _ := NewSubscriptionGroup(context.Background())
+	group.Go(first, func(interface{}) error { return nil })
+	group.Go(second, func(interface{}) error { return nil })
+
+	// Unsubscribing a subscription on its own does not stop the others.
+	first.Unsubscribe()
+	second.stream <- 1
+
+	require.NoError(t, group.Stop())
+	require.Equal(t, int32(3), atomic.LoadInt32(&unsubscribed))
+}
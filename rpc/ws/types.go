@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+
+	"github.com/gagliardetto/solana-go/rpc"
 )
 
 type request struct {
@@ -64,4 +66,11 @@ type params struct {
 
 type Options struct {
 	HttpHeader http.Header
+	// Tracer, if set, starts a span for each subscription request
+	// and for each notification received.
+	Tracer rpc.Tracer
+	// Logger, if set, receives the messages sent and received, and the errors.
+	Logger rpc.Logger
+	// LoggerOpts configures the Logger; nil for the defaults.
+	LoggerOpts *rpc.LoggerOpts
 }
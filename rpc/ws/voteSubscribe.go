@@ -62,6 +62,7 @@ type VoteSubscription struct {
 func (sw *VoteSubscription) Recv() (*VoteResult, error) {
 	select {
 	case d := <-sw.sub.stream:
+		sw.sub.delivered()
 		return d.(*VoteResult), nil
 	case err := <-sw.sub.err:
 		return nil, err
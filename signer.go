@@ -0,0 +1,153 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Signer signs messages on behalf of a public key.
+// PrivateKey implements Signer; other implementations can keep the
+// private key elsewhere (e.g. in a KMS, or in a file that is reloaded on change).
+type Signer interface {
+	PublicKey() PublicKey
+	Sign(message []byte) (Signature, error)
+}
+
+var _ Signer = PrivateKey(nil)
+
+// SignWithSigners signs the transaction with the provided signers,
+// placing each signature at the index of its signer in the message.
+// All the signers required by the message must be provided;
+// signers that are not required are ignored.
+func (tx *Transaction) SignWithSigners(signers ...Signer) (out []Signature, err error) {
+	messageContent, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode message for signing: %w", err)
+	}
+	signerKeys := tx.Message.signerKeys()
+
+	signatures := make([]Signature, len(signerKeys))
+	for i, key := range signerKeys {
+		var signer Signer
+		for _, s := range signers {
+			if s.PublicKey().Equals(key) {
+				signer = s
+				break
+			}
+		}
+		if signer == nil {
+			return nil, fmt.Errorf("signer key %q not found", key.String())
+		}
+		signatures[i], err = signer.Sign(messageContent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign with key %q: %w", key.String(), err)
+		}
+	}
+	tx.Signatures = signatures
+	return tx.Signatures, nil
+}
+
+// SignerResolver resolves the signers of a transaction when it is signed,
+// so that the transaction can be built by a component that doesn't own the keys.
+type SignerResolver interface {
+	// ResolveSigner returns the signer of the key,
+	// or nil if the resolver doesn't own it.
+	ResolveSigner(ctx context.Context, key PublicKey) (Signer, error)
+}
+
+// SignerResolverFunc adapts a function to a SignerResolver.
+type SignerResolverFunc func(ctx context.Context, key PublicKey) (Signer, error)
+
+func (f SignerResolverFunc) ResolveSigner(ctx context.Context, key PublicKey) (Signer, error) {
+	return f(ctx, key)
+}
+
+// StaticSigners returns a SignerResolver that resolves the provided signers.
+func StaticSigners(signers ...Signer) SignerResolver {
+	return SignerResolverFunc(func(ctx context.Context, key PublicKey) (Signer, error) {
+		for _, signer := range signers {
+			if signer.PublicKey().Equals(key) {
+				return signer, nil
+			}
+		}
+		return nil, nil
+	})
+}
+
+// MissingSignersError is returned when some of the signers
+// required by a transaction could not be resolved.
+type MissingSignersError struct {
+	Missing []PublicKey
+}
+
+func (e *MissingSignersError) Error() string {
+	keys := make([]string, len(e.Missing))
+	for i, key := range e.Missing {
+		keys[i] = key.String()
+	}
+	return fmt.Sprintf("missing %d required signer(s): %s", len(e.Missing), strings.Join(keys, ", "))
+}
+
+// SignWithResolvers signs the transaction with the signers returned by the
+// resolvers (the first resolver owning a key wins).
+// If some of the required signers can't be resolved, the transaction is not
+// signed, and a *MissingSignersError listing all of them is returned.
+func (tx *Transaction) SignWithResolvers(ctx context.Context, resolvers ...SignerResolver) (out []Signature, err error) {
+	var signers []Signer
+	var missing []PublicKey
+	for _, key := range tx.Message.signerKeys() {
+		var signer Signer
+		for _, resolver := range resolvers {
+			signer, err = resolver.ResolveSigner(ctx, key)
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve signer %q: %w", key.String(), err)
+			}
+			if signer != nil {
+				break
+			}
+		}
+		if signer == nil {
+			missing = append(missing, key)
+			continue
+		}
+		signers = append(signers, signer)
+	}
+	if len(missing) > 0 {
+		return nil, &MissingSignersError{Missing: missing}
+	}
+	return tx.SignWithSigners(signers...)
+}
+
+// AddSignerResolver adds a resolver of the signers, used by BuildAndSign.
+func (builder *TransactionBuilder) AddSignerResolver(resolver SignerResolver) *TransactionBuilder {
+	builder.signerResolvers = append(builder.signerResolvers, resolver)
+	return builder
+}
+
+// BuildAndSign builds the transaction (see BuildWithContext) and signs it
+// with the signers returned by the resolvers (see SignWithResolvers).
+func (builder *TransactionBuilder) BuildAndSign(ctx context.Context) (*Transaction, error) {
+	tx, err := builder.BuildWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.SignWithResolvers(ctx, builder.signerResolvers...); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
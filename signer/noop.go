@@ -0,0 +1,44 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signer
+
+import (
+	"github.com/gagliardetto/solana-go"
+)
+
+// NoopSigner is a read-only solana.Signer: it has a public key
+// but no private key, and returns a zero signature.
+// Use it to build transactions that are only simulated
+// (without signature verification), e.g. with the dry-run mode
+// of the rpc client.
+type NoopSigner struct {
+	pubkey solana.PublicKey
+}
+
+var _ solana.Signer = (*NoopSigner)(nil)
+
+func NewNoopSigner(pubkey solana.PublicKey) *NoopSigner {
+	return &NoopSigner{
+		pubkey: pubkey,
+	}
+}
+
+func (s *NoopSigner) PublicKey() solana.PublicKey {
+	return s.pubkey
+}
+
+func (s *NoopSigner) Sign(message []byte) (solana.Signature, error) {
+	return solana.Signature{}, nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signer provides solana.Signer implementations that keep
+// the private key outside of the application code.
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/gagliardetto/solana-go"
+)
+
+// KeySource loads a private key.
+type KeySource func() (solana.PrivateKey, error)
+
+// FromFile returns a KeySource that reads a keypair file in the
+// solana-keygen format (a JSON array of 64 bytes).
+func FromFile(path string) KeySource {
+	return func() (solana.PrivateKey, error) {
+		key, err := solana.PrivateKeyFromSolanaKeygenFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPrivateKey(key); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return key, nil
+	}
+}
+
+// FromEnv returns a KeySource that reads the private key from the
+// provided environment variable, either base58-encoded or as a JSON array of bytes.
+func FromEnv(name string) KeySource {
+	return func() (solana.PrivateKey, error) {
+		value := strings.TrimSpace(os.Getenv(name))
+		if value == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", name)
+		}
+		return parsePrivateKey(value)
+	}
+}
+
+func parsePrivateKey(value string) (solana.PrivateKey, error) {
+	var key solana.PrivateKey
+	if strings.HasPrefix(value, "[") {
+		var values []byte
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, fmt.Errorf("decode private key: %w", err)
+		}
+		key = values
+	} else {
+		var err error
+		key, err = solana.PrivateKeyFromBase58(value)
+		if err != nil {
+			return nil, fmt.Errorf("decode private key: %w", err)
+		}
+	}
+	if err := checkPrivateKey(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// checkPrivateKey checks that the key is an ed25519 private key:
+// 64 bytes, the seed followed by its public key.
+func checkPrivateKey(key solana.PrivateKey) error {
+	if len(key) != ed25519.PrivateKeySize {
+		return fmt.Errorf("invalid private key length: %d", len(key))
+	}
+	expected := ed25519.NewKeyFromSeed(key[:ed25519.SeedSize])
+	if !bytes.Equal(expected[ed25519.SeedSize:], key[ed25519.SeedSize:]) {
+		return errors.New("invalid private key: the public key does not match the seed")
+	}
+	return nil
+}
+
+// ReloadingSigner is a solana.Signer whose private key can be reloaded
+// from its source (e.g. on SIGHUP, or when the keypair file changes),
+// to rotate the key of a long-running service without restarting it.
+// If a reload fails, the previous key is kept.
+// ReloadingSigner is safe for concurrent use.
+type ReloadingSigner struct {
+	source KeySource
+
+	mu  sync.RWMutex
+	key solana.PrivateKey
+
+	// Called after each successful reload, with the new public key. Optional.
+	OnReload func(pubkey solana.PublicKey)
+	// Called when a reload triggered by a watcher fails. Optional.
+	OnError func(err error)
+}
+
+var _ solana.Signer = (*ReloadingSigner)(nil)
+
+// NewReloadingSigner loads the key from the source.
+func NewReloadingSigner(source KeySource) (*ReloadingSigner, error) {
+	s := &ReloadingSigner{
+		source: source,
+	}
+	key, err := source()
+	if err != nil {
+		return nil, err
+	}
+	s.key = key
+	return s, nil
+}
+
+// NewFileSigner loads the keypair file at the provided path.
+func NewFileSigner(path string) (*ReloadingSigner, error) {
+	return NewReloadingSigner(FromFile(path))
+}
+
+// NewEnvSigner loads the private key from the provided environment variable.
+func NewEnvSigner(name string) (*ReloadingSigner, error) {
+	return NewReloadingSigner(FromEnv(name))
+}
+
+func (s *ReloadingSigner) PublicKey() solana.PublicKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.key.PublicKey()
+}
+
+func (s *ReloadingSigner) Sign(message []byte) (solana.Signature, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.key.Sign(message)
+}
+
+// Reload loads the key from the source again.
+func (s *ReloadingSigner) Reload() error {
+	key, err := s.source()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.key = key
+	s.mu.Unlock()
+	if s.OnReload != nil {
+		s.OnReload(key.PublicKey())
+	}
+	return nil
+}
+
+func (s *ReloadingSigner) reloadFromWatcher() {
+	if err := s.Reload(); err != nil && s.OnError != nil {
+		s.OnError(err)
+	}
+}
+
+// WatchSignals reloads the key each time the process receives one of the
+// provided signals (SIGHUP if none), until the context is done.
+func (s *ReloadingSigner) WatchSignals(ctx context.Context, signals ...os.Signal) {
+	if len(signals) == 0 {
+		signals = []os.Signal{syscall.SIGHUP}
+	}
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	go func() {
+		defer signal.Stop(ch)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				s.reloadFromWatcher()
+			}
+		}
+	}()
+}
+
+// WatchFile reloads the key each time the file at the provided path
+// is written, created or replaced (e.g. by an atomic rename),
+// until the context is done.
+func (s *ReloadingSigner) WatchFile(ctx context.Context, path string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	path = filepath.Clean(path)
+	// Watch the directory, since editors and secret managers
+	// often replace the file instead of writing it.
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return err
+	}
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != path {
+					continue
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+					s.reloadFromWatcher()
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				if s.OnError != nil {
+					s.OnError(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// WriteKeygenFile writes the private key to the provided path in the
+// solana-keygen format, atomically (via a temporary file and a rename).
+func WriteKeygenFile(path string, key solana.PrivateKey) error {
+	if len(key) != 64 {
+		return errors.New("invalid private key length")
+	}
+	values := make([]int, len(key))
+	for i, b := range key {
+		values[i] = int(b)
+	}
+	content, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
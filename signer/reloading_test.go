@@ -0,0 +1,98 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadingSigner_file(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "id.json")
+
+	first := solana.NewWallet().PrivateKey
+	require.NoError(t, WriteKeygenFile(path, first))
+
+	s, err := NewFileSigner(path)
+	require.NoError(t, err)
+	require.Equal(t, first.PublicKey(), s.PublicKey())
+
+	sig, err := s.Sign([]byte("hello"))
+	require.NoError(t, err)
+	require.True(t, first.PublicKey().Verify([]byte("hello"), sig))
+
+	reloaded := make(chan solana.PublicKey, 1)
+	s.OnReload = func(pubkey solana.PublicKey) {
+		select {
+		case reloaded <- pubkey:
+		default:
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.WatchFile(ctx, path))
+
+	second := solana.NewWallet().PrivateKey
+	require.NoError(t, WriteKeygenFile(path, second))
+	select {
+	case pubkey := <-reloaded:
+		require.Equal(t, second.PublicKey(), pubkey)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the key was not reloaded")
+	}
+	require.Equal(t, second.PublicKey(), s.PublicKey())
+
+	// A failed reload keeps the previous key.
+	require.NoError(t, os.WriteFile(path, []byte("garbage"), 0600))
+	require.Error(t, s.Reload())
+	require.Equal(t, second.PublicKey(), s.PublicKey())
+
+	// So does a truncated or corrupt keypair file.
+	require.NoError(t, os.WriteFile(path, []byte("[1,2,3]"), 0600))
+	require.EqualError(t, s.Reload(), path+": invalid private key length: 3")
+	require.Equal(t, second.PublicKey(), s.PublicKey())
+	corrupt := append(solana.PrivateKey(nil), second...)
+	corrupt[63] ^= 1
+	require.NoError(t, WriteKeygenFile(path, corrupt))
+	require.Error(t, s.Reload())
+	require.Equal(t, second.PublicKey(), s.PublicKey())
+}
+
+func TestReloadingSigner_env(t *testing.T) {
+	key := solana.NewWallet().PrivateKey
+	os.Setenv("SOLANA_TEST_SIGNER_KEY", key.Base58())
+	defer os.Unsetenv("SOLANA_TEST_SIGNER_KEY")
+
+	s, err := NewEnvSigner("SOLANA_TEST_SIGNER_KEY")
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey(), s.PublicKey())
+
+	rotated := solana.NewWallet().PrivateKey
+	os.Setenv("SOLANA_TEST_SIGNER_KEY", "[1,2,3]")
+	require.Error(t, s.Reload())
+	os.Setenv("SOLANA_TEST_SIGNER_KEY", rotated.Base58())
+	require.NoError(t, s.Reload())
+	require.Equal(t, rotated.PublicKey(), s.PublicKey())
+
+	_, err = NewEnvSigner("SOLANA_TEST_SIGNER_MISSING")
+	require.Error(t, err)
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+type VaultTransitOpts struct {
+	// The address of the Vault server (e.g. "https://vault.example.com:8200").
+	Address string
+	// The Vault token.
+	Token string
+	// The Vault Enterprise namespace. Optional.
+	Namespace string
+	// The mount path of the transit engine; defaults to "transit".
+	Mount string
+	// The name of the ed25519 transit key.
+	KeyName string
+	// The version of the key; zero pins the latest version
+	// at the time the signer is created.
+	KeyVersion int
+	// The HTTP client; defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// VaultTransitSigner is a solana.Signer backed by an ed25519 key
+// of the HashiCorp Vault transit secrets engine.
+// The key version is pinned (so that the public key doesn't change
+// if the key is rotated in Vault), and its public key is cached.
+type VaultTransitSigner struct {
+	opts   VaultTransitOpts
+	pubkey solana.PublicKey
+}
+
+var _ solana.Signer = (*VaultTransitSigner)(nil)
+
+// NewVaultTransitSigner fetches the public key of the transit key from Vault.
+func NewVaultTransitSigner(ctx context.Context, opts VaultTransitOpts) (*VaultTransitSigner, error) {
+	if opts.Address == "" || opts.KeyName == "" {
+		return nil, fmt.Errorf("vault address and key name are required")
+	}
+	if opts.Mount == "" {
+		opts.Mount = "transit"
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	s := &VaultTransitSigner{
+		opts: opts,
+	}
+	if err := s.loadPublicKey(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *VaultTransitSigner) PublicKey() solana.PublicKey {
+	return s.pubkey
+}
+
+// KeyVersion returns the pinned version of the transit key.
+func (s *VaultTransitSigner) KeyVersion() int {
+	return s.opts.KeyVersion
+}
+
+func (s *VaultTransitSigner) Sign(message []byte) (solana.Signature, error) {
+	return s.SignWithContext(context.Background(), message)
+}
+
+// SignWithContext signs the message with the transit key,
+// and verifies the signature with the cached public key.
+func (s *VaultTransitSigner) SignWithContext(ctx context.Context, message []byte) (solana.Signature, error) {
+	var resp struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	err := s.do(ctx, http.MethodPost, "sign/"+s.opts.KeyName, map[string]interface{}{
+		"input":       base64.StdEncoding.EncodeToString(message),
+		"key_version": s.opts.KeyVersion,
+	}, &resp)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	// The signature is formatted as "vault:v<version>:<base64>".
+	parts := strings.Split(resp.Data.Signature, ":")
+	if len(parts) != 3 || parts[0] != "vault" {
+		return solana.Signature{}, fmt.Errorf("unexpected vault signature format: %q", resp.Data.Signature)
+	}
+	raw, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("unable to decode vault signature: %w", err)
+	}
+	if len(raw) != 64 {
+		return solana.Signature{}, fmt.Errorf("invalid vault signature length: %d", len(raw))
+	}
+	var sig solana.Signature
+	copy(sig[:], raw)
+	if !s.pubkey.Verify(message, sig) {
+		return solana.Signature{}, fmt.Errorf("vault signature does not match public key %s", s.pubkey)
+	}
+	return sig, nil
+}
+
+func (s *VaultTransitSigner) loadPublicKey(ctx context.Context) error {
+	var resp struct {
+		Data struct {
+			Type          string `json:"type"`
+			LatestVersion int    `json:"latest_version"`
+			Keys          map[string]struct {
+				PublicKey string `json:"public_key"`
+			} `json:"keys"`
+		} `json:"data"`
+	}
+	if err := s.do(ctx, http.MethodGet, "keys/"+s.opts.KeyName, nil, &resp); err != nil {
+		return err
+	}
+	if resp.Data.Type != "ed25519" {
+		return fmt.Errorf("vault key %q is of type %q, expected ed25519", s.opts.KeyName, resp.Data.Type)
+	}
+	if s.opts.KeyVersion == 0 {
+		s.opts.KeyVersion = resp.Data.LatestVersion
+	}
+	key, ok := resp.Data.Keys[strconv.Itoa(s.opts.KeyVersion)]
+	if !ok {
+		return fmt.Errorf("vault key %q has no version %d", s.opts.KeyName, s.opts.KeyVersion)
+	}
+	raw, err := base64.StdEncoding.DecodeString(key.PublicKey)
+	if err != nil {
+		return fmt.Errorf("unable to decode vault public key: %w", err)
+	}
+	if len(raw) != 32 {
+		return fmt.Errorf("invalid vault public key length: %d", len(raw))
+	}
+	s.pubkey = solana.PublicKeyFromBytes(raw)
+	return nil
+}
+
+func (s *VaultTransitSigner) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+	url := strings.TrimRight(s.opts.Address, "/") + "/v1/" + strings.Trim(s.opts.Mount, "/") + "/" + path
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", s.opts.Token)
+	if s.opts.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.opts.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := s.opts.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		if json.Unmarshal(respBody, &vaultErr) == nil && len(vaultErr.Errors) > 0 {
+			return fmt.Errorf("vault %s %s: %s: %s", method, path, resp.Status, strings.Join(vaultErr.Errors, "; "))
+		}
+		return fmt.Errorf("vault %s %s: %s", method, path, resp.Status)
+	}
+	return json.Unmarshal(respBody, out)
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signer
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+// mockVault serves the transit endpoints of two versions of an ed25519 key.
+func mockVault(t *testing.T, keys map[int]solana.PrivateKey) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "s.token", r.Header.Get("X-Vault-Token"))
+		switch r.URL.Path {
+		case "/v1/transit/keys/solana":
+			versions := map[string]interface{}{}
+			for version, key := range keys {
+				versions[fmt.Sprint(version)] = map[string]string{
+					"public_key": base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()),
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"type":           "ed25519",
+					"latest_version": len(keys),
+					"keys":           versions,
+				},
+			})
+		case "/v1/transit/sign/solana":
+			var req struct {
+				Input      string `json:"input"`
+				KeyVersion int    `json:"key_version"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			input, err := base64.StdEncoding.DecodeString(req.Input)
+			require.NoError(t, err)
+			sig := ed25519.Sign(ed25519.PrivateKey(keys[req.KeyVersion]), input)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]string{
+					"signature": fmt.Sprintf("vault:v%d:%s", req.KeyVersion, base64.StdEncoding.EncodeToString(sig)),
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":["not found"]}`))
+		}
+	}))
+}
+
+func TestVaultTransitSigner(t *testing.T) {
+	keys := map[int]solana.PrivateKey{
+		1: solana.NewWallet().PrivateKey,
+		2: solana.NewWallet().PrivateKey,
+	}
+	server := mockVault(t, keys)
+	defer server.Close()
+	ctx := context.Background()
+
+	// The latest version is pinned.
+	s, err := NewVaultTransitSigner(ctx, VaultTransitOpts{Address: server.URL, Token: "s.token", KeyName: "solana"})
+	require.NoError(t, err)
+	require.Equal(t, 2, s.KeyVersion())
+	require.Equal(t, keys[2].PublicKey(), s.PublicKey())
+	sig, err := s.Sign([]byte("hello"))
+	require.NoError(t, err)
+	require.True(t, keys[2].PublicKey().Verify([]byte("hello"), sig))
+
+	// An explicit version.
+	s, err = NewVaultTransitSigner(ctx, VaultTransitOpts{Address: server.URL, Token: "s.token", KeyName: "solana", KeyVersion: 1})
+	require.NoError(t, err)
+	require.Equal(t, keys[1].PublicKey(), s.PublicKey())
+	sig, err = s.Sign([]byte("hello"))
+	require.NoError(t, err)
+	require.True(t, keys[1].PublicKey().Verify([]byte("hello"), sig))
+
+	_, err = NewVaultTransitSigner(ctx, VaultTransitOpts{Address: server.URL, Token: "s.token", KeyName: "solana", KeyVersion: 3})
+	require.Error(t, err)
+	_, err = NewVaultTransitSigner(ctx, VaultTransitOpts{Address: server.URL, Token: "s.token", KeyName: "other"})
+	require.EqualError(t, err, "vault GET keys/other: 404 Not Found: not found")
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignWithSigners(t *testing.T) {
+	signers := []PrivateKey{
+		NewWallet().PrivateKey,
+		NewWallet().PrivateKey,
+	}
+	instructions := []Instruction{
+		&testTransactionInstructions{
+			accounts: []*AccountMeta{
+				{PublicKey: signers[0].PublicKey(), IsSigner: true, IsWritable: true},
+				{PublicKey: signers[1].PublicKey(), IsSigner: true, IsWritable: false},
+			},
+			data:      []byte{0xaa, 0xbb},
+			programID: MustPublicKeyFromBase58("11111111111111111111111111111111"),
+		},
+	}
+	blockhash, err := HashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	require.NoError(t, err)
+	trx, err := NewTransaction(instructions, blockhash)
+	require.NoError(t, err)
+
+	_, err = trx.SignWithSigners(signers[0])
+	require.Error(t, err)
+
+	// The order of the signers does not matter; unneeded signers are ignored.
+	signatures, err := trx.SignWithSigners(NewWallet().PrivateKey, signers[1], signers[0])
+	require.NoError(t, err)
+	require.Len(t, signatures, 2)
+	require.NoError(t, trx.VerifySignatures())
+}
+
+func TestTransactionBuilderSignerResolvers(t *testing.T) {
+	payer := NewWallet().PrivateKey
+	other := NewWallet().PrivateKey
+	third := NewWallet().PrivateKey
+	instruction := &testTransactionInstructions{
+		accounts: []*AccountMeta{
+			{PublicKey: payer.PublicKey(), IsSigner: true, IsWritable: true},
+			{PublicKey: other.PublicKey(), IsSigner: true, IsWritable: false},
+			{PublicKey: third.PublicKey(), IsSigner: true, IsWritable: false},
+		},
+		data:      []byte{0xaa},
+		programID: SystemProgramID,
+	}
+	ctx := context.Background()
+
+	// The missing signers are all listed.
+	_, err := NewTransactionBuilder().
+		AddInstruction(instruction).
+		SetRecentBlockHash(Hash{1}).
+		AddSignerResolver(StaticSigners(payer)).
+		BuildAndSign(ctx)
+	var missing *MissingSignersError
+	require.True(t, errors.As(err, &missing))
+	require.Equal(t, []PublicKey{other.PublicKey(), third.PublicKey()}, missing.Missing)
+	require.Contains(t, err.Error(), other.PublicKey().String())
+	require.Contains(t, err.Error(), third.PublicKey().String())
+
+	// The resolvers are asked in order.
+	var asked []PublicKey
+	remote := SignerResolverFunc(func(ctx context.Context, key PublicKey) (Signer, error) {
+		asked = append(asked, key)
+		if key.Equals(other.PublicKey()) {
+			return other, nil
+		}
+		if key.Equals(third.PublicKey()) {
+			return third, nil
+		}
+		return nil, nil
+	})
+	tx, err := NewTransactionBuilder().
+		AddInstruction(instruction).
+		SetRecentBlockHash(Hash{1}).
+		AddSignerResolver(StaticSigners(payer)).
+		AddSignerResolver(remote).
+		BuildAndSign(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.VerifySignatures())
+	require.Equal(t, []PublicKey{other.PublicKey(), third.PublicKey()}, asked)
+
+	// The errors of the resolvers are returned.
+	failing := SignerResolverFunc(func(ctx context.Context, key PublicKey) (Signer, error) {
+		return nil, errors.New("unavailable")
+	})
+	_, err = tx.SignWithResolvers(ctx, failing)
+	require.EqualError(t, err, `unable to resolve signer "`+payer.PublicKey().String()+`": unavailable`)
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// SigningRequest is a request for a detached signature of a transaction message,
+// e.g. to be sent to an MPC/TSS provider that returns the signature asynchronously.
+// Once obtained, the signature is added to the transaction with ApplySignature.
+type SigningRequest struct {
+	// The public key that must sign the message.
+	Signer PublicKey `json:"signer"`
+
+	// The index of the signature in the transaction.
+	Index int `json:"index"`
+
+	// The exact message bytes to sign.
+	Message []byte `json:"message"`
+}
+
+// MessageBase64 returns the message to sign, base64-encoded.
+func (req *SigningRequest) MessageBase64() string {
+	return base64.StdEncoding.EncodeToString(req.Message)
+}
+
+// Verify returns true if the signature is a valid signature
+// of the message by the signer.
+func (req *SigningRequest) Verify(sig Signature) bool {
+	return req.Signer.Verify(req.Message, sig)
+}
+
+// SigningRequest returns the request for the signature of the provided signer.
+func (tx *Transaction) SigningRequest(signer PublicKey) (*SigningRequest, error) {
+	index := tx.signerIndex(signer)
+	if index < 0 {
+		return nil, fmt.Errorf("%s is not a signer of the transaction", signer)
+	}
+	messageContent, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode message for signing: %w", err)
+	}
+	return &SigningRequest{
+		Signer:  signer,
+		Index:   index,
+		Message: messageContent,
+	}, nil
+}
+
+// SigningRequests returns the requests for the signatures
+// that the transaction is still missing.
+func (tx *Transaction) SigningRequests() ([]*SigningRequest, error) {
+	messageContent, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode message for signing: %w", err)
+	}
+	var out []*SigningRequest
+	for i, key := range tx.Message.signerKeys() {
+		if i < len(tx.Signatures) && !tx.Signatures[i].IsZero() {
+			continue
+		}
+		out = append(out, &SigningRequest{
+			Signer:  key,
+			Index:   i,
+			Message: messageContent,
+		})
+	}
+	return out, nil
+}
+
+// ApplySignature verifies the detached signature of the provided signer
+// against the current message, and places it at the index of the signer.
+// It fails if the message was modified after the signature was requested.
+func (tx *Transaction) ApplySignature(signer PublicKey, sig Signature) error {
+	index := tx.signerIndex(signer)
+	if index < 0 {
+		return fmt.Errorf("%s is not a signer of the transaction", signer)
+	}
+	messageContent, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("unable to encode message: %w", err)
+	}
+	if !signer.Verify(messageContent, sig) {
+		return fmt.Errorf("invalid signature by %s", signer)
+	}
+	numSigners := int(tx.Message.Header.NumRequiredSignatures)
+	if len(tx.Signatures) < numSigners {
+		signatures := make([]Signature, numSigners)
+		copy(signatures, tx.Signatures)
+		tx.Signatures = signatures
+	}
+	tx.Signatures[index] = sig
+	return nil
+}
+
+// IsFullySigned returns true if the transaction has a (non-zero)
+// signature for each of its signers. The signatures are not verified;
+// use VerifySignatures for that.
+func (tx *Transaction) IsFullySigned() bool {
+	numSigners := int(tx.Message.Header.NumRequiredSignatures)
+	if len(tx.Signatures) != numSigners {
+		return false
+	}
+	for _, sig := range tx.Signatures {
+		if sig.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+func (tx *Transaction) signerIndex(signer PublicKey) int {
+	for i, key := range tx.Message.signerKeys() {
+		if key.Equals(signer) {
+			return i
+		}
+	}
+	return -1
+}
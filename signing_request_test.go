@@ -0,0 +1,81 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningRequest(t *testing.T) {
+	signers := []PrivateKey{
+		NewWallet().PrivateKey,
+		NewWallet().PrivateKey,
+	}
+	instructions := []Instruction{
+		&testTransactionInstructions{
+			accounts: []*AccountMeta{
+				{PublicKey: signers[0].PublicKey(), IsSigner: true, IsWritable: true},
+				{PublicKey: signers[1].PublicKey(), IsSigner: true, IsWritable: false},
+			},
+			data:      []byte{0xaa, 0xbb},
+			programID: MustPublicKeyFromBase58("11111111111111111111111111111111"),
+		},
+	}
+	blockhash, err := HashFromBase58("A9QnpgfhCkmiBSjgBuWk76Wo3HxzxvDopUq9x6UUMmjn")
+	require.NoError(t, err)
+	trx, err := NewTransaction(instructions, blockhash)
+	require.NoError(t, err)
+
+	requests, err := trx.SigningRequests()
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	require.False(t, trx.IsFullySigned())
+
+	// Sign out of order, as an external provider would.
+	for i := len(requests) - 1; i >= 0; i-- {
+		req := requests[i]
+		require.Equal(t, signers[i].PublicKey(), req.Signer)
+		require.Equal(t, i, req.Index)
+
+		wrong, err := signers[(i+1)%2].Sign(req.Message)
+		require.NoError(t, err)
+		require.False(t, req.Verify(wrong))
+		require.Error(t, trx.ApplySignature(req.Signer, wrong))
+
+		sig, err := signers[i].Sign(req.Message)
+		require.NoError(t, err)
+		require.True(t, req.Verify(sig))
+		require.NoError(t, trx.ApplySignature(req.Signer, sig))
+	}
+	require.True(t, trx.IsFullySigned())
+	require.NoError(t, trx.VerifySignatures())
+
+	requests, err = trx.SigningRequests()
+	require.NoError(t, err)
+	require.Empty(t, requests)
+
+	_, err = trx.SigningRequest(NewWallet().PublicKey())
+	require.Error(t, err)
+
+	// A signature of a different message is rejected.
+	req, err := trx.SigningRequest(signers[0].PublicKey())
+	require.NoError(t, err)
+	trx.Message.RecentBlockhash = Hash{1}
+	sig, err := signers[0].Sign(req.Message)
+	require.NoError(t, err)
+	require.Error(t, trx.ApplySignature(signers[0].PublicKey(), sig))
+}
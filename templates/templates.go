@@ -0,0 +1,242 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package templates builds the transactions of common account creation
+// flows (create + initialize) in a single call.
+//
+// Every template returns an unsigned-by-the-payer transaction, together with
+// the keypairs generated for the new accounts; the transaction is already
+// signed by those keypairs, so that only the payer (and the other
+// authorities, if any) must sign it before sending it.
+package templates
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/noncepool"
+	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	"github.com/gagliardetto/solana-go/programs/stake"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	tokenmetadata "github.com/gagliardetto/solana-go/programs/token-metadata"
+)
+
+// Template is a transaction built by a template.
+type Template struct {
+	Transaction *solana.Transaction
+
+	// The main account created by the transaction
+	// (the mint, the token account, the nonce account, or the stake account).
+	Account solana.PublicKey
+
+	// The keypairs generated for the new accounts; they already
+	// signed the transaction, and must be stored by the caller.
+	NewAccounts []solana.PrivateKey
+}
+
+// build builds the transaction and signs it with the generated keypairs.
+func build(
+	payer solana.PublicKey,
+	recentBlockhash solana.Hash,
+	account solana.PublicKey,
+	instructions []solana.Instruction,
+	newAccounts ...solana.PrivateKey,
+) (*Template, error) {
+	tx, err := solana.NewTransaction(instructions, recentBlockhash, solana.TransactionPayer(payer))
+	if err != nil {
+		return nil, err
+	}
+	// Reserve a slot for every signature, so that the other
+	// signers can add theirs with PartialSign.
+	tx.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+	_, err = tx.PartialSign(func(key solana.PublicKey) *solana.PrivateKey {
+		for i := range newAccounts {
+			if newAccounts[i].PublicKey().Equals(key) {
+				return &newAccounts[i]
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Template{
+		Transaction: tx,
+		Account:     account,
+		NewAccounts: newAccounts,
+	}, nil
+}
+
+// MintOpts are the options of CreateMint.
+type MintOpts struct {
+	Decimals        uint8
+	MintAuthority   solana.PublicKey
+	FreezeAuthority *solana.PublicKey
+
+	// Optional: the metadata of the mint; when set, the transaction
+	// also creates the metadata account, and the mint authority
+	// must sign it.
+	Metadata *tokenmetadata.Data
+	// The update authority of the metadata; defaults to the mint authority.
+	UpdateAuthority solana.PublicKey
+	IsMutable       bool
+}
+
+// CreateMint returns the transaction that creates and initializes a new mint
+// (and its metadata account, if opts.Metadata is set).
+func CreateMint(payer solana.PublicKey, recentBlockhash solana.Hash, opts MintOpts) (*Template, error) {
+	if opts.MintAuthority.IsZero() {
+		return nil, errors.New("mint authority is not set")
+	}
+	mint, err := solana.NewRandomPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	initialize := token.NewInitializeMint2InstructionBuilder().
+		SetDecimals(opts.Decimals).
+		SetMintAuthority(opts.MintAuthority).
+		SetMintAccount(mint.PublicKey())
+	if opts.FreezeAuthority != nil {
+		initialize.SetFreezeAuthority(*opts.FreezeAuthority)
+	}
+	instructions := []solana.Instruction{
+		system.NewCreateAccountInstruction(
+			solana.MinimumBalanceForRentExemption(token.MINT_SIZE),
+			token.MINT_SIZE,
+			solana.TokenProgramID,
+			payer,
+			mint.PublicKey(),
+		).Build(),
+		initialize.Build(),
+	}
+	if opts.Metadata != nil {
+		updateAuthority := opts.UpdateAuthority
+		if updateAuthority.IsZero() {
+			updateAuthority = opts.MintAuthority
+		}
+		metadata, err := tokenmetadata.NewCreateMetadataAccountV3Instruction(
+			*opts.Metadata,
+			opts.IsMutable,
+			mint.PublicKey(),
+			opts.MintAuthority,
+			payer,
+			updateAuthority,
+		)
+		if err != nil {
+			return nil, err
+		}
+		instructions = append(instructions, metadata.Build())
+	}
+	return build(payer, recentBlockhash, mint.PublicKey(), instructions, mint)
+}
+
+// Funding describes the tokens transferred to a new token account.
+type Funding struct {
+	Amount uint64
+
+	// For the wrapped SOL mint, the amount is in lamports,
+	// and is transferred from the payer.
+	// For the other mints, the tokens are transferred from the source
+	// token account, that must be signed by the authority.
+	Source    solana.PublicKey
+	Authority solana.PublicKey
+	Decimals  uint8
+}
+
+// CreateTokenAccount returns the transaction that creates the associated
+// token account of the owner for the mint, and funds it (if fund is not nil).
+// No keypair is generated.
+func CreateTokenAccount(
+	payer solana.PublicKey,
+	recentBlockhash solana.Hash,
+	owner solana.PublicKey,
+	mint solana.PublicKey,
+	fund *Funding,
+) (*Template, error) {
+	account, _, err := solana.FindAssociatedTokenAddress(owner, mint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive the associated token address: %w", err)
+	}
+	instructions := []solana.Instruction{
+		associatedtokenaccount.NewCreateInstruction(payer, owner, mint).Build(),
+	}
+	if fund != nil && fund.Amount > 0 {
+		if mint.Equals(solana.WrappedSol) {
+			instructions = append(instructions,
+				system.NewTransferInstruction(fund.Amount, payer, account).Build(),
+				token.NewSyncNativeInstruction(account).Build(),
+			)
+		} else {
+			if fund.Source.IsZero() || fund.Authority.IsZero() {
+				return nil, errors.New("the source and the authority of the funding are not set")
+			}
+			instructions = append(instructions,
+				token.NewTransferCheckedInstruction(
+					fund.Amount,
+					fund.Decimals,
+					fund.Source,
+					mint,
+					account,
+					fund.Authority,
+					nil,
+				).Build(),
+			)
+		}
+	}
+	return build(payer, recentBlockhash, account, instructions)
+}
+
+// CreateNonceAccount returns the transaction that creates and initializes
+// a new durable nonce account, rent-exempt and controlled by the authority.
+func CreateNonceAccount(payer solana.PublicKey, recentBlockhash solana.Hash, authority solana.PublicKey) (*Template, error) {
+	nonce, err := solana.NewRandomPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	instructions := noncepool.CreateNonceAccountInstructions(
+		payer,
+		nonce.PublicKey(),
+		authority,
+		solana.MinimumBalanceForRentExemption(system.NonceAccountSize),
+	)
+	return build(payer, recentBlockhash, nonce.PublicKey(), instructions, nonce)
+}
+
+// CreateStakeAccount returns the transaction that creates a new stake account
+// funded with the provided lamports (that must exceed the rent-exempt reserve),
+// initializes it with the authority as staker and withdrawer, and delegates it
+// to the vote account; the authority must sign the transaction.
+func CreateStakeAccount(
+	payer solana.PublicKey,
+	recentBlockhash solana.Hash,
+	authority solana.PublicKey,
+	lamports uint64,
+	voteAccount solana.PublicKey,
+) (*Template, error) {
+	if reserve := solana.MinimumBalanceForRentExemption(stake.AccountSize); lamports <= reserve {
+		return nil, fmt.Errorf("%d lamports do not exceed the rent-exempt reserve of %d", lamports, reserve)
+	}
+	account, err := solana.NewRandomPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	instructions := []solana.Instruction{
+		system.NewCreateAccountInstruction(lamports, stake.AccountSize, stake.ProgramID, payer, account.PublicKey()).Build(),
+		stake.NewInitializeInstruction(authority, authority, stake.Lockup{}, account.PublicKey()).Build(),
+		stake.NewDelegateStakeInstruction(account.PublicKey(), voteAccount, authority).Build(),
+	}
+	return build(payer, recentBlockhash, account.PublicKey(), instructions, account)
+}
@@ -0,0 +1,182 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/stake"
+	"github.com/gagliardetto/solana-go/programs/token"
+	tokenmetadata "github.com/gagliardetto/solana-go/programs/token-metadata"
+	"github.com/stretchr/testify/require"
+)
+
+func programIDs(t *testing.T, tx *solana.Transaction) []solana.PublicKey {
+	var out []solana.PublicKey
+	for _, inst := range tx.Message.Instructions {
+		programID, err := tx.Message.Program(inst.ProgramIDIndex)
+		require.NoError(t, err)
+		out = append(out, programID)
+	}
+	return out
+}
+
+// requireSigned checks that the transaction is signed by the new accounts,
+// and that it can be completed by the payer.
+func requireSigned(t *testing.T, tmpl *Template, payer solana.PrivateKey, others ...solana.PrivateKey) {
+	tx := tmpl.Transaction
+	require.Len(t, tx.Signatures, int(tx.Message.Header.NumRequiredSignatures))
+	for _, account := range tmpl.NewAccounts {
+		require.True(t, tx.IsSigner(account.PublicKey()))
+	}
+	signers := append([]solana.PrivateKey{payer}, others...)
+	_, err := tx.PartialSign(func(key solana.PublicKey) *solana.PrivateKey {
+		for i := range signers {
+			if signers[i].PublicKey().Equals(key) {
+				return &signers[i]
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, tx.VerifySignatures())
+}
+
+func TestCreateMint(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	authority := solana.NewWallet().PrivateKey
+	blockhash := solana.Hash{1}
+
+	tmpl, err := CreateMint(payer.PublicKey(), blockhash, MintOpts{
+		Decimals:      6,
+		MintAuthority: authority.PublicKey(),
+		Metadata: &tokenmetadata.Data{
+			Name:   "Token",
+			Symbol: "TKN",
+			Uri:    "https://example.com/token.json",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, tmpl.NewAccounts, 1)
+	require.Equal(t, tmpl.NewAccounts[0].PublicKey(), tmpl.Account)
+	require.Equal(t,
+		[]solana.PublicKey{solana.SystemProgramID, solana.TokenProgramID, solana.TokenMetadataProgramID},
+		programIDs(t, tmpl.Transaction),
+	)
+
+	metadata, _, err := solana.FindTokenMetadataAddress(tmpl.Account)
+	require.NoError(t, err)
+	writable, err := tmpl.Transaction.Message.IsWritable(metadata)
+	require.NoError(t, err)
+	require.True(t, writable)
+	require.True(t, tmpl.Transaction.IsSigner(authority.PublicKey()))
+
+	data := tmpl.Transaction.Message.Instructions[2].Data
+	dec := bin.NewBorshDecoder(data)
+	discriminator, err := dec.ReadUint8()
+	require.NoError(t, err)
+	require.Equal(t, tokenmetadata.Instruction_CreateMetadataAccountV3, discriminator)
+	name, err := dec.ReadString()
+	require.NoError(t, err)
+	require.Equal(t, "Token", name)
+
+	requireSigned(t, tmpl, payer, authority)
+}
+
+func TestCreateMint_NoMetadata(t *testing.T) {
+	payer := solana.NewWallet().PublicKey()
+	tmpl, err := CreateMint(payer, solana.Hash{1}, MintOpts{MintAuthority: payer})
+	require.NoError(t, err)
+	require.Equal(t,
+		[]solana.PublicKey{solana.SystemProgramID, solana.TokenProgramID},
+		programIDs(t, tmpl.Transaction),
+	)
+
+	_, err = CreateMint(payer, solana.Hash{1}, MintOpts{})
+	require.Error(t, err)
+}
+
+func TestCreateTokenAccount(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	owner := solana.NewWallet().PublicKey()
+
+	{
+		tmpl, err := CreateTokenAccount(payer.PublicKey(), solana.Hash{1}, owner, solana.WrappedSol, &Funding{Amount: 1_000_000})
+		require.NoError(t, err)
+		require.Empty(t, tmpl.NewAccounts)
+		ata, _, err := solana.FindAssociatedTokenAddress(owner, solana.WrappedSol)
+		require.NoError(t, err)
+		require.Equal(t, ata, tmpl.Account)
+		require.Equal(t,
+			[]solana.PublicKey{solana.SPLAssociatedTokenAccountProgramID, solana.SystemProgramID, solana.TokenProgramID},
+			programIDs(t, tmpl.Transaction),
+		)
+		requireSigned(t, tmpl, payer)
+	}
+	{
+		mint := solana.NewWallet().PublicKey()
+		funder := solana.NewWallet().PrivateKey
+		source := solana.NewWallet().PublicKey()
+		tmpl, err := CreateTokenAccount(payer.PublicKey(), solana.Hash{1}, owner, mint, &Funding{
+			Amount:    500,
+			Decimals:  2,
+			Source:    source,
+			Authority: funder.PublicKey(),
+		})
+		require.NoError(t, err)
+		require.Equal(t,
+			[]solana.PublicKey{solana.SPLAssociatedTokenAccountProgramID, solana.TokenProgramID},
+			programIDs(t, tmpl.Transaction),
+		)
+		data := tmpl.Transaction.Message.Instructions[1].Data
+		require.Equal(t, token.Instruction_TransferChecked, data[0])
+		requireSigned(t, tmpl, payer, funder)
+
+		_, err = CreateTokenAccount(payer.PublicKey(), solana.Hash{1}, owner, mint, &Funding{Amount: 500})
+		require.Error(t, err)
+	}
+}
+
+func TestCreateNonceAccount(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	tmpl, err := CreateNonceAccount(payer.PublicKey(), solana.Hash{1}, payer.PublicKey())
+	require.NoError(t, err)
+	require.Len(t, tmpl.NewAccounts, 1)
+	require.Equal(t,
+		[]solana.PublicKey{solana.SystemProgramID, solana.SystemProgramID},
+		programIDs(t, tmpl.Transaction),
+	)
+	requireSigned(t, tmpl, payer)
+}
+
+func TestCreateStakeAccount(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	authority := solana.NewWallet().PrivateKey
+	vote := solana.NewWallet().PublicKey()
+
+	_, err := CreateStakeAccount(payer.PublicKey(), solana.Hash{1}, authority.PublicKey(), 1, vote)
+	require.Error(t, err)
+
+	tmpl, err := CreateStakeAccount(payer.PublicKey(), solana.Hash{1}, authority.PublicKey(), solana.LAMPORTS_PER_SOL, vote)
+	require.NoError(t, err)
+	require.Len(t, tmpl.NewAccounts, 1)
+	require.Equal(t,
+		[]solana.PublicKey{solana.SystemProgramID, stake.ProgramID, stake.ProgramID},
+		programIDs(t, tmpl.Transaction),
+	)
+	requireSigned(t, tmpl, payer, authority)
+}
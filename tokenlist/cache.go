@@ -0,0 +1,106 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenlist
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+type cacheEntry struct {
+	info      *TokenInfo
+	expiresAt time.Time
+}
+
+// CachingResolver caches the results of another resolver.
+// Unknown mints are cached too (for NegativeTTL), so that repeatedly
+// labeling an unknown token does not hit the underlying resolver every time.
+// Other errors are not cached.
+type CachingResolver struct {
+	resolver    Resolver
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	mu      sync.RWMutex
+	entries map[solana.PublicKey]cacheEntry
+	now     func() time.Time
+}
+
+// NewCachingResolver wraps the provided resolver with a cache;
+// a zero ttl caches forever, and a zero negativeTTL disables the caching of unknown mints.
+func NewCachingResolver(resolver Resolver, ttl time.Duration, negativeTTL time.Duration) *CachingResolver {
+	return &CachingResolver{
+		resolver:    resolver,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		entries:     make(map[solana.PublicKey]cacheEntry),
+		now:         time.Now,
+	}
+}
+
+func (res *CachingResolver) Resolve(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+	now := res.now()
+	res.mu.RLock()
+	entry, ok := res.entries[mint]
+	res.mu.RUnlock()
+	if ok && (entry.expiresAt.IsZero() || now.Before(entry.expiresAt)) {
+		if entry.info == nil {
+			return nil, ErrNotFound
+		}
+		return entry.info, nil
+	}
+
+	info, err := res.resolver.Resolve(ctx, mint)
+	switch {
+	case err == nil:
+		res.store(mint, info, res.ttl, now)
+		return info, nil
+	case errors.Is(err, ErrNotFound):
+		if res.negativeTTL > 0 {
+			res.store(mint, nil, res.negativeTTL, now)
+		}
+		return nil, err
+	default:
+		return nil, err
+	}
+}
+
+func (res *CachingResolver) store(mint solana.PublicKey, info *TokenInfo, ttl time.Duration, now time.Time) {
+	entry := cacheEntry{info: info}
+	if ttl > 0 {
+		entry.expiresAt = now.Add(ttl)
+	}
+	res.mu.Lock()
+	res.entries[mint] = entry
+	res.mu.Unlock()
+}
+
+// Invalidate removes the provided mint from the cache.
+func (res *CachingResolver) Invalidate(mint solana.PublicKey) {
+	res.mu.Lock()
+	delete(res.entries, mint)
+	res.mu.Unlock()
+}
+
+// Purge empties the cache.
+func (res *CachingResolver) Purge() {
+	res.mu.Lock()
+	res.entries = make(map[solana.PublicKey]cacheEntry)
+	res.mu.Unlock()
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenlist
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gagliardetto/solana-go"
+	token2022 "github.com/gagliardetto/solana-go/programs/token-2022"
+	"github.com/gagliardetto/solana-go/programs/tokenregistry"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// NewMetadataResolver returns a resolver that reads the on-chain metadata
+// of the mint (Token-2022 metadata extension, or Metaplex metadata),
+// together with the decimals of the mint.
+// LogoURI is left empty: the URI of the metadata points to
+// an off-chain JSON document, not to an image.
+func NewMetadataResolver(rpcClient *rpc.Client) Resolver {
+	return ResolverFunc(func(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+		account, err := rpcClient.GetAccountInfo(ctx, mint)
+		if err != nil {
+			if errors.Is(err, rpc.ErrNotFound) {
+				return nil, ErrNotFound
+			}
+			return nil, err
+		}
+		meta, err := token2022.GetTokenMetadataOfMintAccount(ctx, rpcClient, mint, account)
+		if err != nil {
+			if errors.Is(err, rpc.ErrNotFound) {
+				return nil, ErrNotFound
+			}
+			return nil, err
+		}
+		// GetTokenMetadataOfMintAccount has already checked that the account is a mint.
+		mintState, err := token2022.DecodeMint(account.GetBinary())
+		if err != nil {
+			return nil, err
+		}
+		return &TokenInfo{
+			Mint:     mint,
+			Symbol:   meta.Symbol,
+			Name:     meta.Name,
+			Decimals: mintState.Decimals,
+		}, nil
+	})
+}
+
+// NewTokenRegistryResolver returns a resolver that reads the entries
+// of the on-chain token registry program.
+func NewTokenRegistryResolver(rpcClient *rpc.Client) Resolver {
+	return ResolverFunc(func(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+		meta, err := tokenregistry.GetTokenRegistryEntry(ctx, rpcClient, mint)
+		if err != nil {
+			if errors.Is(err, rpc.ErrNotFound) {
+				return nil, ErrNotFound
+			}
+			return nil, err
+		}
+		return &TokenInfo{
+			Mint:    mint,
+			Symbol:  meta.Symbol.String(),
+			Name:    meta.Name.String(),
+			LogoURI: meta.Logo.String(),
+		}, nil
+	})
+}
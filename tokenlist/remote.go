@@ -0,0 +1,194 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenlist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// SolanaLabsTokenListURL is the (archived) Solana Labs token list.
+const SolanaLabsTokenListURL = "https://raw.githubusercontent.com/solana-labs/token-list/main/src/tokens/solana.tokenlist.json"
+
+// Chain IDs used by the Solana Labs token list format.
+const (
+	ChainIDMainnetBeta = 101
+	ChainIDTestnet     = 102
+	ChainIDDevnet      = 103
+)
+
+// DefaultRemoteListRetryInterval is the default RetryInterval of RemoteListOpts.
+const DefaultRemoteListRetryInterval = 30 * time.Second
+
+type RemoteListOpts struct {
+	// The HTTP client used to fetch the list; defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Only include the tokens of this chain ID (e.g. ChainIDMainnetBeta);
+	// zero includes all the tokens.
+	ChainID int
+
+	// How often the list is fetched again; zero means never.
+	RefreshInterval time.Duration
+
+	// How long to wait after a failed fetch before fetching again;
+	// defaults to DefaultRemoteListRetryInterval.
+	RetryInterval time.Duration
+}
+
+// RemoteListResolver resolves mints from a remote JSON token list.
+// It accepts both the Solana Labs token list format (`{"tokens": [...]}`)
+// and a plain array of tokens. The list is fetched on the first Resolve call.
+// While the list is refreshed, the previous one is served.
+type RemoteListResolver struct {
+	url  string
+	opts RemoteListOpts
+
+	mu         sync.Mutex
+	list       *StaticResolver
+	loadedAt   time.Time
+	refreshing bool
+	failedAt   time.Time
+	err        error // of the last fetch
+	now        func() time.Time
+}
+
+func NewRemoteListResolver(url string, opts *RemoteListOpts) *RemoteListResolver {
+	res := &RemoteListResolver{
+		url: url,
+		now: time.Now,
+	}
+	if opts != nil {
+		res.opts = *opts
+	}
+	if res.opts.HTTPClient == nil {
+		res.opts.HTTPClient = http.DefaultClient
+	}
+	if res.opts.RetryInterval <= 0 {
+		res.opts.RetryInterval = DefaultRemoteListRetryInterval
+	}
+	return res
+}
+
+func (res *RemoteListResolver) Resolve(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+	list, err := res.getList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return list.Resolve(ctx, mint)
+}
+
+// getList returns the list, fetching it if missing or stale.
+// The lock is not held during the fetch, so that the lookups
+// are not blocked by it.
+func (res *RemoteListResolver) getList(ctx context.Context) (*StaticResolver, error) {
+	res.mu.Lock()
+	now := res.now()
+	list := res.list
+	stale := res.opts.RefreshInterval > 0 && now.Sub(res.loadedAt) > res.opts.RefreshInterval
+	failedRecently := !res.failedAt.IsZero() && now.Sub(res.failedAt) < res.opts.RetryInterval
+	if list != nil && (!stale || res.refreshing || failedRecently) {
+		res.mu.Unlock()
+		return list, nil
+	}
+	if list == nil && failedRecently {
+		err := res.err
+		res.mu.Unlock()
+		return nil, err
+	}
+	res.refreshing = true
+	res.mu.Unlock()
+
+	fetched, err := res.fetch(ctx)
+
+	res.mu.Lock()
+	defer res.mu.Unlock()
+	res.refreshing = false
+	if err != nil {
+		if ctx.Err() == nil {
+			// Back off, unless the caller gave up.
+			res.failedAt = res.now()
+			res.err = err
+		}
+		if res.list != nil {
+			// Keep serving the previous list.
+			return res.list, nil
+		}
+		return nil, err
+	}
+	res.list = fetched
+	res.loadedAt = res.now()
+	res.failedAt = time.Time{}
+	res.err = nil
+	return fetched, nil
+}
+
+type remoteToken struct {
+	TokenInfo
+	ChainID int `json:"chainId"`
+}
+
+func (res *RemoteListResolver) fetch(ctx context.Context) (*StaticResolver, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, res.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := res.opts.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch token list: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch token list: unexpected status %s", resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read token list: %w", err)
+	}
+	tokens, err := parseTokenList(body)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]TokenInfo, 0, len(tokens))
+	for _, token := range tokens {
+		if res.opts.ChainID != 0 && token.ChainID != 0 && token.ChainID != res.opts.ChainID {
+			continue
+		}
+		infos = append(infos, token.TokenInfo)
+	}
+	return NewStaticResolver(infos...), nil
+}
+
+func parseTokenList(body []byte) ([]remoteToken, error) {
+	var tokens []remoteToken
+	if err := json.Unmarshal(body, &tokens); err == nil {
+		return tokens, nil
+	}
+	var list struct {
+		Tokens []remoteToken `json:"tokens"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("unable to decode token list: %w", err)
+	}
+	return list.Tokens, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenlist
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// StaticResolver resolves mints from a fixed, in-memory list.
+type StaticResolver struct {
+	tokens map[solana.PublicKey]TokenInfo
+}
+
+func NewStaticResolver(tokens ...TokenInfo) *StaticResolver {
+	res := &StaticResolver{
+		tokens: make(map[solana.PublicKey]TokenInfo, len(tokens)),
+	}
+	for _, token := range tokens {
+		res.tokens[token.Mint] = token
+	}
+	return res
+}
+
+func (res *StaticResolver) Resolve(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+	token, ok := res.tokens[mint]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &token, nil
+}
+
+// Len returns the number of tokens in the list.
+func (res *StaticResolver) Len() int {
+	return len(res.tokens)
+}
+
+// KnownTokens is a small bundled list of well-known mainnet-beta tokens.
+var KnownTokens = []TokenInfo{
+	{
+		Mint:     solana.SolMint,
+		Symbol:   "SOL",
+		Name:     "Wrapped SOL",
+		Decimals: 9,
+		LogoURI:  "https://raw.githubusercontent.com/solana-labs/token-list/main/assets/mainnet/So11111111111111111111111111111111111111112/logo.png",
+	},
+	{
+		Mint:     solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"),
+		Symbol:   "USDC",
+		Name:     "USD Coin",
+		Decimals: 6,
+		LogoURI:  "https://raw.githubusercontent.com/solana-labs/token-list/main/assets/mainnet/EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v/logo.png",
+		Tags:     []string{"stablecoin"},
+	},
+	{
+		Mint:     solana.MustPublicKeyFromBase58("Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB"),
+		Symbol:   "USDT",
+		Name:     "USDT",
+		Decimals: 6,
+		LogoURI:  "https://raw.githubusercontent.com/solana-labs/token-list/main/assets/mainnet/Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB/logo.svg",
+		Tags:     []string{"stablecoin"},
+	},
+	{
+		Mint:     solana.MustPublicKeyFromBase58("2b1kV6DkPAnxd5ixfnxCpjxmKwqjjaYmCZfHsFu24GXo"),
+		Symbol:   "PYUSD",
+		Name:     "PayPal USD",
+		Decimals: 6,
+		Tags:     []string{"stablecoin", "token-2022"},
+	},
+	{
+		Mint:     solana.MustPublicKeyFromBase58("mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So"),
+		Symbol:   "mSOL",
+		Name:     "Marinade staked SOL",
+		Decimals: 9,
+	},
+	{
+		Mint:     solana.MustPublicKeyFromBase58("DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263"),
+		Symbol:   "BONK",
+		Name:     "Bonk",
+		Decimals: 5,
+	},
+}
+
+// NewKnownTokensResolver returns a resolver for the bundled KnownTokens list.
+func NewKnownTokensResolver() *StaticResolver {
+	return NewStaticResolver(KnownTokens...)
+}
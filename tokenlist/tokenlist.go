@@ -0,0 +1,81 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tokenlist resolves token mints to their symbol, name and logo,
+// so that balances and pretty-printed transactions can be labeled.
+package tokenlist
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ErrNotFound is returned by a Resolver that does not know the mint.
+var ErrNotFound = errors.New("token not found")
+
+type TokenInfo struct {
+	Mint     solana.PublicKey `json:"address"`
+	Symbol   string           `json:"symbol"`
+	Name     string           `json:"name"`
+	Decimals uint8            `json:"decimals"`
+	LogoURI  string           `json:"logoURI,omitempty"`
+	Tags     []string         `json:"tags,omitempty"`
+}
+
+// Resolver resolves a mint to its token info.
+// Resolve must return ErrNotFound (possibly wrapped) if the mint is unknown.
+type Resolver interface {
+	Resolve(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error)
+}
+
+// ResolverFunc is an adapter to use a function as a Resolver.
+type ResolverFunc func(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error)
+
+func (fn ResolverFunc) Resolve(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+	return fn(ctx, mint)
+}
+
+type chain []Resolver
+
+// Chain returns a Resolver that tries each of the provided resolvers in order,
+// returning the first match. Errors other than ErrNotFound are returned immediately.
+func Chain(resolvers ...Resolver) Resolver {
+	return chain(resolvers)
+}
+
+func (c chain) Resolve(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+	for _, resolver := range c {
+		info, err := resolver.Resolve(ctx, mint)
+		if err == nil {
+			return info, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Label returns the symbol of the mint if the resolver knows it,
+// or the shortened mint address otherwise (e.g. "EPjF...Dt1v").
+func Label(ctx context.Context, resolver Resolver, mint solana.PublicKey) string {
+	if resolver != nil {
+		if info, err := resolver.Resolve(ctx, mint); err == nil && info.Symbol != "" {
+			return info.Symbol
+		}
+	}
+	return mint.Short(4)
+}
@@ -0,0 +1,214 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenlist
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	usdc    = solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	unknown = solana.MustPublicKeyFromBase58("Q6XprfkF8RQQKoQVG33xT88H7wi8Uk1B1CC7YAs69Gi")
+)
+
+func TestKnownTokensResolver(t *testing.T) {
+	ctx := context.Background()
+	res := NewKnownTokensResolver()
+	require.Equal(t, len(KnownTokens), res.Len())
+
+	info, err := res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, "USDC", info.Symbol)
+	require.Equal(t, uint8(6), info.Decimals)
+
+	_, err = res.Resolve(ctx, unknown)
+	require.True(t, errors.Is(err, ErrNotFound))
+
+	require.Equal(t, "USDC", Label(ctx, res, usdc))
+	require.Equal(t, unknown.Short(4), Label(ctx, res, unknown))
+	require.Equal(t, unknown.Short(4), Label(ctx, nil, unknown))
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	custom := NewStaticResolver(TokenInfo{Mint: unknown, Symbol: "CUSTOM"})
+	res := Chain(NewKnownTokensResolver(), custom)
+
+	info, err := res.Resolve(ctx, unknown)
+	require.NoError(t, err)
+	require.Equal(t, "CUSTOM", info.Symbol)
+
+	_, err = res.Resolve(ctx, solana.SystemProgramID)
+	require.True(t, errors.Is(err, ErrNotFound))
+
+	// Other errors stop the chain.
+	failing := ResolverFunc(func(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+		return nil, errors.New("boom")
+	})
+	_, err = Chain(failing, custom).Resolve(ctx, unknown)
+	require.EqualError(t, err, "boom")
+}
+
+func TestCachingResolver(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	inner := ResolverFunc(func(ctx context.Context, mint solana.PublicKey) (*TokenInfo, error) {
+		calls++
+		if mint.Equals(usdc) {
+			return &TokenInfo{Mint: usdc, Symbol: "USDC"}, nil
+		}
+		return nil, ErrNotFound
+	})
+	now := time.Unix(1_700_000_000, 0)
+	res := NewCachingResolver(inner, time.Minute, time.Second)
+	res.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		info, err := res.Resolve(ctx, usdc)
+		require.NoError(t, err)
+		require.Equal(t, "USDC", info.Symbol)
+	}
+	require.Equal(t, 1, calls)
+
+	for i := 0; i < 3; i++ {
+		_, err := res.Resolve(ctx, unknown)
+		require.True(t, errors.Is(err, ErrNotFound))
+	}
+	require.Equal(t, 2, calls)
+
+	// The negative entry expires first.
+	now = now.Add(2 * time.Second)
+	_, err := res.Resolve(ctx, unknown)
+	require.True(t, errors.Is(err, ErrNotFound))
+	_, err = res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	now = now.Add(time.Minute)
+	_, err = res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, 4, calls)
+
+	res.Invalidate(usdc)
+	_, err = res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, 5, calls)
+}
+
+func TestRemoteListResolver(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{
+			"name": "Test List",
+			"tokens": [
+				{"chainId": 101, "address": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "symbol": "USDC", "name": "USD Coin", "decimals": 6, "logoURI": "https://example.com/usdc.png", "tags": ["stablecoin"]},
+				{"chainId": 103, "address": "Q6XprfkF8RQQKoQVG33xT88H7wi8Uk1B1CC7YAs69Gi", "symbol": "DEV", "name": "Devnet Token", "decimals": 9}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	res := NewRemoteListResolver(server.URL, &RemoteListOpts{ChainID: ChainIDMainnetBeta})
+
+	info, err := res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, &TokenInfo{
+		Mint:     usdc,
+		Symbol:   "USDC",
+		Name:     "USD Coin",
+		Decimals: 6,
+		LogoURI:  "https://example.com/usdc.png",
+		Tags:     []string{"stablecoin"},
+	}, info)
+
+	// Filtered out by chain ID.
+	_, err = res.Resolve(ctx, unknown)
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.Equal(t, 1, requests)
+}
+
+func TestRemoteListResolver_Refresh(t *testing.T) {
+	requests := 0
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`[{"address": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "symbol": "USDC", "decimals": 6}]`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	res := NewRemoteListResolver(server.URL, &RemoteListOpts{
+		RefreshInterval: time.Hour,
+		RetryInterval:   time.Minute,
+	})
+	res.now = func() time.Time { return now }
+
+	// Failed fetches are not retried before RetryInterval.
+	fail = true
+	_, err := res.Resolve(ctx, usdc)
+	require.Error(t, err)
+	_, err = res.Resolve(ctx, usdc)
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+
+	now = now.Add(2 * time.Minute)
+	fail = false
+	_, err = res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+
+	// A failed refresh keeps serving the previous list, and backs off too.
+	now = now.Add(2 * time.Hour)
+	fail = true
+	_, err = res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	_, err = res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, 3, requests)
+
+	now = now.Add(2 * time.Minute)
+	fail = false
+	_, err = res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, 4, requests)
+	_, err = res.Resolve(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, 4, requests)
+}
+
+func TestParseTokenList_Array(t *testing.T) {
+	tokens, err := parseTokenList([]byte(`[{"address": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "symbol": "USDC", "decimals": 6}]`))
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, usdc, tokens[0].Mint)
+
+	_, err = parseTokenList([]byte(`not json`))
+	require.Error(t, err)
+}
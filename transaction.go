@@ -20,7 +20,9 @@ package solana
 import (
 	"bytes"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/davecgh/go-spew/spew"
@@ -153,9 +155,11 @@ func TransactionAddressTables(tables map[PublicKey]PublicKeySlice) TransactionOp
 var debugNewTransaction = false
 
 type TransactionBuilder struct {
-	instructions    []Instruction
-	recentBlockHash Hash
-	opts            []TransactionOption
+	instructions      []Instruction
+	recentBlockHash   Hash
+	blockhashProvider BlockhashProvider
+	signerResolvers   []SignerResolver
+	opts              []TransactionOption
 }
 
 // NewTransactionBuilder creates a new instruction builder.
@@ -169,6 +173,19 @@ func (builder *TransactionBuilder) AddInstruction(instruction Instruction) *Tran
 	return builder
 }
 
+// PrependInstructions adds the provided instructions to the builder, before
+// the instructions already added; typically the compute budget instructions
+// (see the programs/compute-budget package), which must come first:
+//
+//	builder.PrependInstructions(
+//		computebudget.NewSetComputeUnitLimitInstruction(200_000).Build(),
+//		computebudget.NewSetComputeUnitPriceInstruction(10_000).Build(),
+//	)
+func (builder *TransactionBuilder) PrependInstructions(instructions ...Instruction) *TransactionBuilder {
+	builder.instructions = append(append([]Instruction(nil), instructions...), builder.instructions...)
+	return builder
+}
+
 // SetRecentBlockHash sets the recent blockhash for the instruction builder.
 func (builder *TransactionBuilder) SetRecentBlockHash(recentBlockHash Hash) *TransactionBuilder {
 	builder.recentBlockHash = recentBlockHash
@@ -182,7 +199,7 @@ func (builder *TransactionBuilder) WithOpt(opt TransactionOption) *TransactionBu
 }
 
 // Set transaction fee payer.
-// If not set, defaults to first signer account of the first instruction.
+// If not set, defaults to first signer account of the first instruction having one.
 func (builder *TransactionBuilder) SetFeePayer(feePayer PublicKey) *TransactionBuilder {
 	builder.opts = append(builder.opts, TransactionPayer(feePayer))
 	return builder
@@ -214,11 +231,18 @@ func NewTransaction(instructions []Instruction, recentBlockHash Hash, opts ...Tr
 
 	feePayer := options.payer
 	if feePayer.IsZero() {
+		// The first instruction with a signer, as the compute
+		// budget instructions that usually come first have none.
 		found := false
-		for _, act := range instructions[0].Accounts() {
-			if act.IsSigner {
-				feePayer = act.PublicKey
-				found = true
+		for _, instruction := range instructions {
+			for _, act := range instruction.Accounts() {
+				if act.IsSigner {
+					feePayer = act.PublicKey
+					found = true
+					break
+				}
+			}
+			if found {
 				break
 			}
 		}
@@ -473,9 +497,30 @@ func (tx Transaction) MarshalWithEncoder(encoder *bin.Encoder) error {
 	return encoder.WriteBytes(out, false)
 }
 
+// readCompactU16 reads a "Compact-u16" length, rejecting the encodings
+// longer than 3 bytes or larger than 0xffff (which the decoder of the
+// binary package would return as huge or negative lengths).
+func readCompactU16(decoder *bin.Decoder) (int, error) {
+	value := 0
+	for size := 0; size < 3; size++ {
+		b, err := decoder.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		value |= int(b&0x7f) << (size * 7)
+		if b&0x80 == 0 {
+			if value > math.MaxUint16 {
+				return 0, fmt.Errorf("compact-u16 value %d overflows", value)
+			}
+			return value, nil
+		}
+	}
+	return 0, errors.New("compact-u16 encoding longer than 3 bytes")
+}
+
 func (tx *Transaction) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
 	{
-		numSignatures, err := decoder.ReadCompactU16()
+		numSignatures, err := readCompactU16(decoder)
 		if err != nil {
 			return fmt.Errorf("unable to read numSignatures: %w", err)
 		}
@@ -500,6 +545,11 @@ func (tx *Transaction) UnmarshalWithDecoder(decoder *bin.Decoder) (err error) {
 	return nil
 }
 
+// PartialSign signs the transaction with the keys returned by the getter
+// (nil for the keys to leave unsigned). The signatures of a transaction
+// already holding one signature per signer (e.g. a decoded transaction, or a
+// transaction signed again after a change of its message) are replaced at the
+// positions of the signers; otherwise the new signatures are appended.
 func (tx *Transaction) PartialSign(getter privateKeyGetter) (out []Signature, err error) {
 	messageContent, err := tx.Message.MarshalBinary()
 	if err != nil {
@@ -507,15 +557,20 @@ func (tx *Transaction) PartialSign(getter privateKeyGetter) (out []Signature, er
 	}
 	signerKeys := tx.Message.signerKeys()
 
+	replace := len(tx.Signatures) == len(signerKeys)
 	signedSignatures := []Signature{}
-	for _, key := range signerKeys {
+	for i, key := range signerKeys {
 		privateKey := getter(key)
 		if privateKey != nil {
 			s, err := privateKey.Sign(messageContent)
 			if err != nil {
 				return nil, fmt.Errorf("failed to signed with key %q: %w", key.String(), err)
 			}
-			signedSignatures = append(signedSignatures, s)
+			if replace {
+				tx.Signatures[i] = s
+			} else {
+				signedSignatures = append(signedSignatures, s)
+			}
 		}
 	}
 	tx.Signatures = append(tx.Signatures, signedSignatures...)
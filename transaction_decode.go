@@ -0,0 +1,184 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/mr-tron/base58"
+)
+
+// AddressTablesFetcher fetches the addresses stored in address lookup tables.
+// See the address-lookup-table program package for an implementation backed by an RPC client.
+type AddressTablesFetcher interface {
+	FetchAddressTables(ctx context.Context, tableIDs PublicKeySlice) (map[PublicKey]PublicKeySlice, error)
+}
+
+// AddressTablesFetcherFunc is a function implementing the AddressTablesFetcher interface.
+type AddressTablesFetcherFunc func(ctx context.Context, tableIDs PublicKeySlice) (map[PublicKey]PublicKeySlice, error)
+
+func (f AddressTablesFetcherFunc) FetchAddressTables(ctx context.Context, tableIDs PublicKeySlice) (map[PublicKey]PublicKeySlice, error) {
+	return f(ctx, tableIDs)
+}
+
+// InputEncoding is the encoding a transaction was decoded from.
+type InputEncoding string
+
+const (
+	InputEncodingBinary InputEncoding = "binary"
+	InputEncodingBase58 InputEncoding = "base58"
+	InputEncodingBase64 InputEncoding = "base64"
+	InputEncodingHex    InputEncoding = "hex"
+)
+
+type DecodeAnyTransactionOpts struct {
+	// If set, the address lookup tables of versioned transactions
+	// are fetched, and the lookups resolved.
+	AddressTables AddressTablesFetcher
+}
+
+// DecodedTransaction is a transaction decoded by DecodeAnyTransaction.
+type DecodedTransaction struct {
+	*Transaction
+	// The encoding detected for the input.
+	Encoding InputEncoding
+	// Whether the address table lookups of the transaction were resolved
+	// (always true for transactions without lookups).
+	LookupsResolved bool
+}
+
+// Version returns the version of the transaction message.
+func (decoded *DecodedTransaction) Version() MessageVersion {
+	return decoded.Message.GetVersion()
+}
+
+// String returns a human-readable representation of the transaction.
+func (decoded *DecodedTransaction) String() string {
+	version := "legacy"
+	if decoded.Message.IsVersioned() {
+		version = "v0"
+	}
+	header := fmt.Sprintf("Transaction (%s, decoded from %s", version, decoded.Encoding)
+	if decoded.Message.NumLookups() > 0 && !decoded.LookupsResolved {
+		header += ", lookups not resolved"
+	}
+	return header + ")\n" + decoded.Transaction.String()
+}
+
+// DecodeAnyTransaction decodes a legacy or versioned transaction from raw bytes,
+// or from their base58, base64 or hex (optionally 0x-prefixed) encoding;
+// the encoding is detected automatically, and surrounding whitespace or
+// quotes are ignored, so that input pasted from explorers, logs or RPC
+// responses can be used as is.
+// The input must contain exactly one transaction, with no trailing bytes.
+func DecodeAnyTransaction(ctx context.Context, input []byte, opts *DecodeAnyTransactionOpts) (*DecodedTransaction, error) {
+	decoded, err := decodeAnyTransaction(input)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.Message.NumLookups() == 0 {
+		decoded.LookupsResolved = true
+		return decoded, nil
+	}
+	if opts == nil || opts.AddressTables == nil {
+		return decoded, nil
+	}
+	if err := decoded.Message.FetchAndResolveLookups(ctx, opts.AddressTables); err != nil {
+		return nil, err
+	}
+	decoded.LookupsResolved = true
+	return decoded, nil
+}
+
+func decodeAnyTransaction(input []byte) (*DecodedTransaction, error) {
+	if len(input) == 0 {
+		return nil, errors.New("empty input")
+	}
+	// Binary input is tried first unless it looks like text.
+	if !utf8.Valid(input) || bytes.IndexFunc(input, isNonPrintable) >= 0 {
+		tx, err := decodeExactTransaction(input)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode binary transaction: %w", err)
+		}
+		return &DecodedTransaction{Transaction: tx, Encoding: InputEncodingBinary}, nil
+	}
+
+	text := strings.Trim(strings.TrimSpace(string(input)), `"'`)
+	var errs []string
+	for _, candidate := range []struct {
+		encoding InputEncoding
+		decode   func(string) ([]byte, error)
+	}{
+		{InputEncodingHex, decodeHexInput},
+		{InputEncodingBase64, decodeBase64Input},
+		{InputEncodingBase58, base58.Decode},
+	} {
+		data, err := candidate.decode(text)
+		if err != nil {
+			continue
+		}
+		tx, err := decodeExactTransaction(data)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", candidate.encoding, err))
+			continue
+		}
+		return &DecodedTransaction{Transaction: tx, Encoding: candidate.encoding}, nil
+	}
+	if len(errs) == 0 {
+		return nil, errors.New("input is not base58, base64 or hex")
+	}
+	return nil, fmt.Errorf("unable to decode transaction (%s)", strings.Join(errs, "; "))
+}
+
+func isNonPrintable(r rune) bool {
+	return r < 0x20 && r != '\n' && r != '\r' && r != '\t'
+}
+
+func decodeHexInput(text string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(text, "0x"), "0X"))
+}
+
+func decodeBase64Input(text string) ([]byte, error) {
+	// Base64 can be wrapped on multiple lines.
+	text = strings.Join(strings.Fields(text), "")
+	if data, err := base64.StdEncoding.DecodeString(text); err == nil {
+		return data, nil
+	}
+	return base64.RawStdEncoding.DecodeString(text)
+}
+
+// decodeExactTransaction decodes a transaction that must span all the data.
+func decodeExactTransaction(data []byte) (*Transaction, error) {
+	decoder := bin.NewBinDecoder(data)
+	tx := new(Transaction)
+	if err := tx.UnmarshalWithDecoder(decoder); err != nil {
+		return nil, err
+	}
+	if decoder.HasRemaining() {
+		return nil, fmt.Errorf("%d trailing bytes", decoder.Remaining())
+	}
+	if len(tx.Signatures) == 0 || len(tx.Signatures) != int(tx.Message.Header.NumRequiredSignatures) {
+		return nil, fmt.Errorf("expected %d signatures, got %d", tx.Message.Header.NumRequiredSignatures, len(tx.Signatures))
+	}
+	return tx, nil
+}
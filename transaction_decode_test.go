@@ -0,0 +1,134 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAnyTransaction(t *testing.T) {
+	// The v0 transaction of TestTransactionV0.
+	txB64 := "Alkhq/BfGdBeok4oBP21xAwT4oO/R5PvkKqbCTq4sHHRsto+uDQCFcdp8hXh1g5D3mTh8GAJW8xE+EDD27f9IweTkH2Afiu4h5aM+Xbo0mklc0/Vi1xawd7SZVbstXDLtWdoJaf4Zt+20F/SasURzw/P4dkD+Q6BjgUNHT+vg5gOgAIBAQgaJV0Ch/DG6XwNcizWbI7STLgSbIOrg0Dl67Oo30WU1uA/NIbYLPRmuLarIJ4J0CcN3IWEm4Gf8675KhnXef2LaDXzjFgWVSbAO2yyTF6dK1oO3gTExie957LXDwu6oJMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKU1qZKSEGTSTocWDaOHx8NbXdvJK7geQfqEBBBUSN1LfoiB9oYLDSHJL9rjAlchZhn+fd/23ACfq0oIGla54pt5JT0MdBTJhQI+z7dnVsisw2xWwW+vFSTs97l0tJPxmv9kxpXbHYZFenDpT2s6CT75/9QNFVTkHFLMK+UG6VlyFnQmYh1aMkGtq3c6TIOsk32S6XMUnN9DQgFGQq4lwEAwIAAgwCAAAAgJaYAAAAAAADAgAFDAIAAACAlpgAAAAAAAMCAAYMAgAAAICWmAAAAAAABAAMSGVsbG8gRmFiaW8hAX5s37FH6IeB4QeMYxD4LtpXf1DaupH/ro7W+kEQnofaAgECAQA="
+	raw, err := base64.StdEncoding.DecodeString(txB64)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		input    []byte
+		encoding InputEncoding
+	}{
+		{raw, InputEncodingBinary},
+		{[]byte(txB64), InputEncodingBase64},
+		{[]byte("  \"" + txB64 + "\"\n"), InputEncodingBase64},
+		{[]byte(base58.Encode(raw)), InputEncodingBase58},
+		{[]byte(hex.EncodeToString(raw)), InputEncodingHex},
+		{[]byte("0x" + hex.EncodeToString(raw)), InputEncodingHex},
+	} {
+		decoded, err := DecodeAnyTransaction(ctx, tt.input, nil)
+		require.NoError(t, err, tt.encoding)
+		require.Equal(t, tt.encoding, decoded.Encoding)
+		require.Equal(t, MessageVersionV0, decoded.Version())
+		require.False(t, decoded.LookupsResolved)
+		require.Equal(t, "2nMjR8mdczMJZZ1XeQ5Y37GxfrRQmaV74eypnD9ggpQMmaWfETq9C5DoGKha4bMamu9tFQQArBAgxzQ5vnng1ZdG", decoded.Signatures[0].String())
+	}
+
+	table := MPK("9WWfC3y4uCNofr2qEFHSVUXkCxW99JiYkMWmSZvVt8j3")
+	fetcher := AddressTablesFetcherFunc(func(ctx context.Context, tableIDs PublicKeySlice) (map[PublicKey]PublicKeySlice, error) {
+		require.Equal(t, PublicKeySlice{table}, tableIDs)
+		return map[PublicKey]PublicKeySlice{
+			table: {
+				MPK("2jGpE3ADYRoJPMjyGC4tvqqDfobvdvwGr3vhd66zA1rc"),
+				MPK("FKN5imdi7yadX4axe4hxaqBET4n6DBDRF5LKo5aBF53j"),
+				MPK("3or4uF7ZyuQW5GGmcmdXDJasNiSZUURF2az1UrRPYQTg"),
+				MPK("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr"),
+			},
+		}, nil
+	})
+	decoded, err := DecodeAnyTransaction(ctx, []byte(txB64), &DecodeAnyTransactionOpts{AddressTables: fetcher})
+	require.NoError(t, err)
+	require.True(t, decoded.LookupsResolved)
+	require.Len(t, decoded.Message.AccountKeys, 11)
+	require.Contains(t, decoded.String(), "Transaction (v0, decoded from base64)\n")
+
+	_, err = DecodeAnyTransaction(ctx, []byte(txB64), &DecodeAnyTransactionOpts{
+		AddressTables: AddressTablesFetcherFunc(func(ctx context.Context, tableIDs PublicKeySlice) (map[PublicKey]PublicKeySlice, error) {
+			return nil, errors.New("unavailable")
+		}),
+	})
+	require.EqualError(t, err, "unable to fetch address tables: unavailable")
+}
+
+func TestDecodeAnyTransaction_Errors(t *testing.T) {
+	ctx := context.Background()
+	_, err := DecodeAnyTransaction(ctx, nil, nil)
+	require.EqualError(t, err, "empty input")
+	_, err = DecodeAnyTransaction(ctx, []byte("not a transaction!"), nil)
+	require.EqualError(t, err, "input is not base58, base64 or hex")
+
+	tx, err := NewTransaction(
+		[]Instruction{NewInstruction(SystemProgramID, AccountMetaSlice{Meta(MPK("2m4eNwBVqu6SgFk23HgE3W5MW89yT5z1vspz2WsiFBHF")).SIGNER().WRITE()}, []byte{1})},
+		Hash{},
+	)
+	require.NoError(t, err)
+	tx.Signatures = []Signature{{}}
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	decoded, err := DecodeAnyTransaction(ctx, raw, nil)
+	require.NoError(t, err)
+	require.True(t, decoded.LookupsResolved)
+	require.Equal(t, MessageVersionLegacy, decoded.Version())
+
+	_, err = DecodeAnyTransaction(ctx, append(raw, 0), nil)
+	require.EqualError(t, err, "unable to decode binary transaction: 1 trailing bytes")
+}
+
+func TestDecodeAnyTransaction_Malformed(t *testing.T) {
+	ctx := context.Background()
+	// A compact-u16 signature count with too many continuation bytes,
+	// which used to decode to a negative length.
+	_, err := DecodeAnyTransaction(ctx, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x00}, nil)
+	require.EqualError(t, err, "unable to decode binary transaction: unable to read numSignatures: compact-u16 encoding longer than 3 bytes")
+	_, err = DecodeAnyTransaction(ctx, []byte{0xff, 0xff, 0x7f, 0x00}, nil)
+	require.EqualError(t, err, "unable to decode binary transaction: unable to read numSignatures: compact-u16 value 2097151 overflows")
+
+	// Random corruptions of a valid transaction never panic.
+	tx, err := NewTransaction(
+		[]Instruction{NewInstruction(SystemProgramID, AccountMetaSlice{Meta(MPK("2m4eNwBVqu6SgFk23HgE3W5MW89yT5z1vspz2WsiFBHF")).SIGNER().WRITE()}, []byte{1, 2, 3})},
+		Hash{},
+	)
+	require.NoError(t, err)
+	tx.Signatures = []Signature{{}}
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		input := append([]byte{}, raw...)
+		for n := rng.Intn(4) + 1; n > 0; n-- {
+			input[rng.Intn(len(input))] = byte(rng.Intn(256))
+		}
+		input = input[:rng.Intn(len(input))+1]
+		require.NotPanics(t, func() {
+			DecodeAnyTransaction(ctx, input, nil)
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package solana
 
 import (
+	"context"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	bin "github.com/gagliardetto/binary"
 	"github.com/stretchr/testify/require"
 )
 
@@ -118,3 +120,68 @@ func TestTransactionV0(t *testing.T) {
 		require.Equal(t, txB64, encoded)
 	}
 }
+
+func TestTransactionV0_RoundTrip(t *testing.T) {
+	payer := NewWallet()
+	table := MPK("8Vaso6eE1pWktDHwy2qQBB1fhjmBgwzhoXQKe1sxtFjn")
+	writable, readonly := NewWallet().PublicKey(), NewWallet().PublicKey()
+	tables := map[PublicKey]PublicKeySlice{table: {readonly, writable}}
+
+	tx, err := NewTransaction(
+		[]Instruction{
+			NewInstruction(
+				SystemProgramID,
+				AccountMetaSlice{Meta(payer.PublicKey()).WRITE().SIGNER(), Meta(writable).WRITE(), Meta(readonly)},
+				[]byte{1},
+			),
+		},
+		Hash{1},
+		TransactionPayer(payer.PublicKey()),
+		TransactionAddressTables(tables),
+	)
+	require.NoError(t, err)
+	require.True(t, tx.Message.IsVersioned())
+	require.Equal(t, MessageAddressTableLookupSlice{{
+		AccountKey:      table,
+		WritableIndexes: []uint8{1},
+		ReadonlyIndexes: []uint8{0},
+	}}, tx.Message.GetAddressTableLookups())
+
+	_, err = tx.Sign(func(key PublicKey) *PrivateKey { return &payer.PrivateKey })
+	require.NoError(t, err)
+	require.NoError(t, tx.VerifySignatures())
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	decoded := new(Transaction)
+	require.NoError(t, decoded.UnmarshalWithDecoder(bin.NewBinDecoder(raw)))
+	require.Equal(t, MessageVersionV0, decoded.Message.GetVersion())
+	require.NoError(t, decoded.VerifySignatures())
+
+	fetcher := AddressTablesFetcherFunc(func(ctx context.Context, tableIDs PublicKeySlice) (map[PublicKey]PublicKeySlice, error) {
+		require.Equal(t, PublicKeySlice{table}, tableIDs)
+		return tables, nil
+	})
+	require.NoError(t, decoded.Message.FetchAndResolveLookups(context.Background(), fetcher))
+	keys, err := decoded.Message.GetAllKeys()
+	require.NoError(t, err)
+	require.Equal(t, PublicKeySlice{payer.PublicKey(), SystemProgramID, writable, readonly}, keys)
+	isWritable, err := decoded.IsWritable(writable)
+	require.NoError(t, err)
+	require.True(t, isWritable)
+	isWritable, err = decoded.IsWritable(readonly)
+	require.NoError(t, err)
+	require.False(t, isWritable)
+
+	// The resolved message encodes as the original one, and can be signed again
+	// (e.g. after a change of its blockhash).
+	reencoded, err := decoded.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, raw, reencoded)
+	decoded.Message.RecentBlockhash = Hash{2}
+	signatures, err := decoded.Sign(func(key PublicKey) *PrivateKey { return &payer.PrivateKey })
+	require.NoError(t, err)
+	require.Len(t, signatures, 1)
+	require.NotEqual(t, tx.Signatures[0], signatures[0])
+	require.NoError(t, decoded.VerifySignatures())
+}
@@ -116,7 +116,7 @@ func (v *Vault) PrintPublicKeys() {
 func (v *Vault) PrintPrivateKeys() {
 	fmt.Printf("Private keys contained within (%d in total):\n", len(v.KeyBag))
 	for _, key := range v.KeyBag {
-		fmt.Printf("- %s (corresponds to %s)\n", key, key.PublicKey())
+		fmt.Printf("- %s (corresponds to %s)\n", key.Base58(), key.PublicKey())
 	}
 }
 
@@ -0,0 +1,109 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+)
+
+// ErrWalletMessageModified is returned by ApplyWalletBase64 when the wallet
+// returned a transaction whose message differs from the one that was sent to it
+// (e.g. because the wallet added a priority fee); use TransactionFromWalletBase64
+// to accept the transaction of the wallet as is.
+var ErrWalletMessageModified = errors.New("the wallet modified the transaction message")
+
+// ToWalletBase64 returns the transaction in the form expected by the
+// signTransaction method of the browser wallets (wallet-standard): the
+// base64-encoded wire transaction, with a signature slot for every signer
+// of the message. The missing signatures are left zeroed.
+// The transaction can be partially signed (see ApplySignature);
+// the signatures that are present are verified.
+func (tx *Transaction) ToWalletBase64() (string, error) {
+	numSigners := int(tx.Message.Header.NumRequiredSignatures)
+	if len(tx.Signatures) > numSigners {
+		return "", fmt.Errorf("got %d signatures, but %d signers", len(tx.Signatures), numSigners)
+	}
+	messageContent, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("unable to encode message: %w", err)
+	}
+	signatures := make([]Signature, numSigners)
+	copy(signatures, tx.Signatures)
+	for i, key := range tx.Message.signerKeys() {
+		if !signatures[i].IsZero() && !key.Verify(messageContent, signatures[i]) {
+			return "", fmt.Errorf("signature at index %d is not a valid signature by %s", i, key)
+		}
+	}
+	out := Transaction{
+		Signatures: signatures,
+		Message:    tx.Message,
+	}
+	return out.ToBase64()
+}
+
+// TransactionFromWalletBase64 decodes the signed transaction returned
+// by a browser wallet, in the form produced by ToWalletBase64.
+// The signatures are not verified; use VerifySignatures for that.
+func TransactionFromWalletBase64(b64 string) (*Transaction, error) {
+	data, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	tx, err := TransactionFromDecoder(bin.NewBinDecoder(data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode transaction: %w", err)
+	}
+	if numSigners := int(tx.Message.Header.NumRequiredSignatures); len(tx.Signatures) != numSigners {
+		return nil, fmt.Errorf("got %d signatures, but %d signers", len(tx.Signatures), numSigners)
+	}
+	return tx, nil
+}
+
+// ApplyWalletBase64 adds to the transaction the signatures of the signed
+// transaction returned by a browser wallet (see ToWalletBase64).
+// The signatures are verified, and ErrWalletMessageModified is returned
+// if the wallet changed the message.
+func (tx *Transaction) ApplyWalletBase64(b64 string) error {
+	signed, err := TransactionFromWalletBase64(b64)
+	if err != nil {
+		return err
+	}
+	expected, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("unable to encode message: %w", err)
+	}
+	got, err := signed.Message.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("unable to encode message: %w", err)
+	}
+	if !bytes.Equal(expected, got) {
+		return ErrWalletMessageModified
+	}
+	signerKeys := tx.Message.signerKeys()
+	for i, sig := range signed.Signatures {
+		if sig.IsZero() {
+			continue
+		}
+		if err := tx.ApplySignature(signerKeys[i], sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 github.com/gagliardetto
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletBase64(t *testing.T) {
+	service := NewWallet().PrivateKey
+	user := NewWallet().PrivateKey
+	newTx := func() *Transaction {
+		tx, err := NewTransaction([]Instruction{
+			&testTransactionInstructions{
+				accounts: []*AccountMeta{
+					{PublicKey: service.PublicKey(), IsSigner: true, IsWritable: true},
+					{PublicKey: user.PublicKey(), IsSigner: true, IsWritable: false},
+				},
+				data:      []byte{0xaa},
+				programID: SystemProgramID,
+			},
+		}, Hash{1})
+		require.NoError(t, err)
+		return tx
+	}
+
+	tx := newTx()
+	messageContent, err := tx.Message.MarshalBinary()
+	require.NoError(t, err)
+	serviceSig, err := service.Sign(messageContent)
+	require.NoError(t, err)
+	require.NoError(t, tx.ApplySignature(service.PublicKey(), serviceSig))
+
+	b64, err := tx.ToWalletBase64()
+	require.NoError(t, err)
+
+	// The wallet receives a slot for every signer, and signs at its index.
+	walletTx, err := TransactionFromWalletBase64(b64)
+	require.NoError(t, err)
+	require.Len(t, walletTx.Signatures, 2)
+	require.True(t, walletTx.Signatures[1].IsZero())
+	walletTx.Signatures[1], err = user.Sign(messageContent)
+	require.NoError(t, err)
+	signed, err := walletTx.ToWalletBase64()
+	require.NoError(t, err)
+
+	require.NoError(t, tx.ApplyWalletBase64(signed))
+	require.True(t, tx.IsFullySigned())
+	require.NoError(t, tx.VerifySignatures())
+
+	// An unsigned transaction gets zeroed signatures.
+	unsigned, err := newTx().ToWalletBase64()
+	require.NoError(t, err)
+	walletTx, err = TransactionFromWalletBase64(unsigned)
+	require.NoError(t, err)
+	require.Equal(t, []Signature{{}, {}}, walletTx.Signatures)
+
+	// A signature at the wrong index is rejected.
+	misplaced := newTx()
+	misplaced.Signatures = []Signature{walletTx.Signatures[1]}
+	misplaced.Signatures[0], err = user.Sign(messageContent)
+	require.NoError(t, err)
+	_, err = misplaced.ToWalletBase64()
+	require.Error(t, err)
+
+	// The wallet changed the message.
+	other := newTx()
+	other.Message.RecentBlockhash = Hash{2}
+	changed, err := other.ToWalletBase64()
+	require.NoError(t, err)
+	require.Equal(t, ErrWalletMessageModified, newTx().ApplyWalletBase64(changed))
+
+	_, err = TransactionFromWalletBase64("not base64!")
+	require.Error(t, err)
+}